@@ -1,10 +1,12 @@
 package api
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -12,9 +14,12 @@ import (
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/hashing/factory"
 	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
 	"github.com/multiversx/mx-chain-proxy-go/config"
@@ -29,38 +34,148 @@ type validatorInput struct {
 	Validator validator.Func
 }
 
+// ArgsCreateServer holds the arguments needed for creating the HTTP server of the proxy
+type ArgsCreateServer struct {
+	VersionsRegistry             data.VersionsRegistryHandler
+	Port                         int
+	ApiLoggingConfig             config.ApiLoggingConfig
+	RateLimiterConfig            config.RateLimiterConfig
+	FieldsFilterConfig           config.FieldsFilterConfig
+	RequestDeadlineConfig        config.RequestDeadlineConfig
+	JsonRpcConfig                config.JsonRpcConfig
+	OpenApiConfig                config.OpenApiConfig
+	RoutesConfig                 config.RoutesConfig
+	CacheControlConfig           config.CacheControlConfig
+	ETagConfig                   config.ETagConfig
+	DrainConfig                  config.DrainConfig
+	DrainStatusHandler           middleware.DrainStatusHandler
+	AuditLogConfig               config.AuditLogConfig
+	AuditLogHandler              middleware.AuditLogHandler
+	ClientStatsConfig            config.ClientStatsConfig
+	ClientStatsHandler           middleware.ClientStatsHandler
+	AccessLogConfig              config.AccessLogConfig
+	AccessLogHandler             middleware.AccessLogHandler
+	LoadSheddingConfig           config.LoadSheddingConfig
+	LoadSheddingHandler          middleware.LoadSheddingHandler
+	EpochChangeConfig            config.EpochChangeConfig
+	EpochChangeHandler           middleware.EpochChangeHandler
+	ReadinessHandler             ReadinessHandler
+	ResponseSigningKey           crypto.PrivateKey
+	CredentialsConfig            config.CredentialsConfig
+	StatusMetricsExtractor       middleware.StatusMetricsExtractor
+	RuntimeConfigRegistry        RuntimeConfigRegistry
+	TrustedProxies               []string
+	RateLimitTimeWindowInSeconds int
+	IsProfileModeActivated       bool
+	ShouldStartSwaggerUI         bool
+}
+
 // CreateServer creates a HTTP server
-func CreateServer(
-	versionsRegistry data.VersionsRegistryHandler,
-	port int,
-	apiLoggingConfig config.ApiLoggingConfig,
-	credentialsConfig config.CredentialsConfig,
-	statusMetricsExtractor middleware.StatusMetricsExtractor,
-	rateLimitTimeWindowInSeconds int,
-	isProfileModeActivated bool,
-	shouldStartSwaggerUI bool,
-) (*http.Server, error) {
-	ws := gin.Default()
-	ws.Use(cors.Default())
-
-	err := registerValidators()
+func CreateServer(args ArgsCreateServer) (*http.Server, error) {
+	if check.IfNil(args.ReadinessHandler) {
+		return nil, ErrNilReadinessHandler
+	}
+	if check.IfNil(args.RuntimeConfigRegistry) {
+		return nil, ErrNilRuntimeConfigRegistry
+	}
+
+	ws, err := createEngine(args)
 	if err != nil {
 		return nil, err
 	}
+	ws.Use(cors.New(createCorsConfig(args.ClientStatsConfig)))
 
-	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, statusMetricsExtractor, rateLimitTimeWindowInSeconds, isProfileModeActivated, shouldStartSwaggerUI)
+	err = registerValidators()
 	if err != nil {
 		return nil, err
 	}
 
+	// the background routines of the middlewares are stopped when the server shuts down
+	ctx, cancel := context.WithCancel(context.Background())
+	err = registerRoutes(ctx, ws, args)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	versionNegotiation, err := createVersionNegotiation(ws, args.VersionsRegistry)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
-		Handler: ws,
+		Addr:    fmt.Sprintf(":%d", args.Port),
+		Handler: versionNegotiation,
 	}
+	httpServer.RegisterOnShutdown(cancel)
 
 	return httpServer, nil
 }
 
+// createEngine returns the gin engine. If enabled, the structured access log replaces the default access log.
+// The client IP address is read from the forwarding headers only for the requests coming from the trusted proxies
+func createEngine(args ArgsCreateServer) (*gin.Engine, error) {
+	ws, err := createEngineWithLogger(args.AccessLogConfig, args.AccessLogHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	// a nil list disables the forwarding headers, while gin trusts all the proxies by default
+	trustedProxies := args.TrustedProxies
+	if len(trustedProxies) == 0 {
+		trustedProxies = nil
+	}
+	err = ws.SetTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+func createEngineWithLogger(accessLogConfig config.AccessLogConfig, accessLogHandler middleware.AccessLogHandler) (*gin.Engine, error) {
+	if !accessLogConfig.Enabled {
+		return gin.Default(), nil
+	}
+
+	accessLog, err := middleware.NewAccessLog(accessLogHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := gin.New()
+	ws.Use(gin.Recovery(), accessLog.MiddlewareHandlerFunc())
+
+	return ws, nil
+}
+
+func createCorsConfig(clientStatsConfig config.ClientStatsConfig) cors.Config {
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AllowAllOrigins = true
+	corsConfig.AddAllowHeaders(middleware.ApiVersionHeader)
+	corsConfig.AddExposeHeaders(middleware.ApiVersionHeader)
+	corsConfig.AddAllowHeaders(middleware.RequestTimeoutHeader)
+	corsConfig.AddAllowHeaders(middleware.IfNoneMatchHeader)
+	corsConfig.AddExposeHeaders(middleware.ETagHeader)
+	corsConfig.AddAllowHeaders(middleware.RequestIDHeader)
+	corsConfig.AddExposeHeaders(middleware.RequestIDHeader)
+	if clientStatsConfig.Enabled {
+		corsConfig.AddAllowHeaders(clientStatsConfig.KeyHeader)
+	}
+
+	return corsConfig
+}
+
+func createVersionNegotiation(ws *gin.Engine, versionsRegistry data.VersionsRegistryHandler) (http.Handler, error) {
+	versionsMap, err := versionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	return middleware.NewVersionNegotiation(ws, getVersions(versionsMap))
+}
+
 func registerValidators() error {
 	validators := []validatorInput{
 		{Name: "skValidator", Validator: skValidator},
@@ -76,62 +191,176 @@ func registerValidators() error {
 	return nil
 }
 
-func registerRoutes(
-	ws *gin.Engine,
-	versionsRegistry data.VersionsRegistryHandler,
-	apiLoggingConfig config.ApiLoggingConfig,
-	credentialsConfig config.CredentialsConfig,
-	statusMetricsExtractor middleware.StatusMetricsExtractor,
-	rateLimitTimeWindowInSeconds int,
-	isProfileModeActivated bool,
-	shouldStartSwaggerUI bool,
-) error {
-	versionsMap, err := versionsRegistry.GetAllVersions()
+func registerRoutes(ctx context.Context, ws *gin.Engine, args ArgsCreateServer) error {
+	versionsMap, err := args.VersionsRegistry.GetAllVersions()
 	if err != nil {
 		return err
 	}
 
-	if shouldStartSwaggerUI {
+	runtimeConfig := &runtimeConfigHandler{
+		versions: getVersions(versionsMap),
+	}
+
+	if args.ShouldStartSwaggerUI {
 		ws.Use(static.ServeRoot("/", "config/swagger"))
 	}
 
-	if apiLoggingConfig.LoggingEnabled {
-		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(apiLoggingConfig.ThresholdInMicroSeconds) * time.Microsecond)
+	if args.ApiLoggingConfig.LoggingEnabled {
+		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(args.ApiLoggingConfig.ThresholdInMicroSeconds) * time.Microsecond)
 		ws.Use(responseLoggerMiddleware.MiddlewareHandlerFunc())
 	}
 
+	// the audit entries should also record the requests rejected by the middlewares below
+	if args.AuditLogConfig.Enabled {
+		auditLog, errCreate := middleware.NewAuditLog(args.AuditLogHandler, args.AuditLogConfig.Routes)
+		if errCreate != nil {
+			return errCreate
+		}
+		ws.Use(auditLog.MiddlewareHandlerFunc())
+	}
+
+	if args.ClientStatsConfig.Enabled {
+		clientStats, errCreate := middleware.NewClientStats(args.ClientStatsHandler, args.ClientStatsConfig.KeyHeader, args.ClientStatsConfig.Routes)
+		if errCreate != nil {
+			return errCreate
+		}
+		ws.Use(clientStats.MiddlewareHandlerFunc())
+	}
+
+	drainMode, err := middleware.NewDrainMode(args.DrainStatusHandler, args.DrainConfig.WriteRoutes)
+	if err != nil {
+		return err
+	}
+	ws.Use(drainMode.MiddlewareHandlerFunc())
+
+	if args.LoadSheddingConfig.Enabled {
+		retryAfter := time.Duration(args.LoadSheddingConfig.RetryAfterInSec) * time.Second
+		loadShedding, errCreate := middleware.NewLoadShedding(args.LoadSheddingHandler, retryAfter, args.LoadSheddingConfig.CriticalRoutes)
+		if errCreate != nil {
+			return errCreate
+		}
+		ws.Use(loadShedding.MiddlewareHandlerFunc())
+	}
+
+	if args.EpochChangeConfig.Enabled {
+		retryAfter := time.Duration(args.EpochChangeConfig.RetryAfterInSec) * time.Second
+		epochChange, errCreate := middleware.NewEpochChange(args.EpochChangeHandler, retryAfter)
+		if errCreate != nil {
+			return errCreate
+		}
+		ws.Use(epochChange.MiddlewareHandlerFunc())
+	}
+
+	// the ETag is computed out of the body sent to the client, so it has to wrap the middlewares altering the body
+	if args.ETagConfig.Enabled {
+		ws.Use(middleware.NewETag().MiddlewareHandlerFunc())
+	}
+
+	if !check.IfNil(args.ResponseSigningKey) {
+		responseSigner, errCreate := middleware.NewResponseSigner(args.ResponseSigningKey, &singlesig.Ed25519Signer{})
+		if errCreate != nil {
+			return errCreate
+		}
+		ws.Use(responseSigner.MiddlewareHandlerFunc())
+	}
+
+	if args.RateLimiterConfig.Enabled {
+		tokenBucketRateLimiter, errCreate := createTokenBucketRateLimiter(ctx, args.RateLimiterConfig)
+		if errCreate != nil {
+			return errCreate
+		}
+		ws.Use(tokenBucketRateLimiter.MiddlewareHandlerFunc())
+		runtimeConfig.tokenBucketRateLimiter = tokenBucketRateLimiter
+	}
+
+	if args.FieldsFilterConfig.Enabled {
+		fieldsFilter := middleware.NewFieldsFilter(args.FieldsFilterConfig.ExcludedRoutes)
+		ws.Use(fieldsFilter.MiddlewareHandlerFunc())
+	}
+
+	if args.RequestDeadlineConfig.Enabled {
+		requestDeadline, errCreate := middleware.NewRequestDeadline(time.Duration(args.RequestDeadlineConfig.MaxTimeoutInMs) * time.Millisecond)
+		if errCreate != nil {
+			return errCreate
+		}
+		ws.Use(requestDeadline.MiddlewareHandlerFunc())
+	}
+
+	jsonRpcLimits, err := middleware.NewJsonRpcLimits(args.JsonRpcConfig.MaxBodySizeInBytes, args.JsonRpcConfig.MaxBatchSize)
+	if err != nil {
+		return err
+	}
+	ws.Use(jsonRpcLimits.MiddlewareHandlerFunc())
+
+	if args.CacheControlConfig.Enabled {
+		cacheControl, errCreate := middleware.NewCacheControl(createCacheControlArgs(args.CacheControlConfig))
+		if errCreate != nil {
+			return errCreate
+		}
+		ws.Use(cacheControl.MiddlewareHandlerFunc())
+		runtimeConfig.cacheControl = cacheControl
+	}
+
 	// TODO: maybe add a flag when starting proxy if metrics should be exposed or not
-	metricsMiddleware, err := middleware.NewMetricsMiddleware(statusMetricsExtractor)
+	metricsMiddleware, err := middleware.NewMetricsMiddleware(args.StatusMetricsExtractor)
 	if err != nil {
 		return err
 	}
 
+	disabledRoutes, err := middleware.NewDisabledRoutes(createDisabledRoutesArgs(args.RoutesConfig, runtimeConfig.versions))
+	if err != nil {
+		return err
+	}
+	ws.NoRoute(disabledRoutes.NoRouteHandlerFunc())
+	runtimeConfig.disabledRoutes = disabledRoutes
+
 	for version, versionData := range versionsMap {
 		limitsMap := getLimitsMapForVersion(versionData)
-		rateLimitTimeWindowDuration := time.Duration(rateLimitTimeWindowInSeconds) * time.Second
+		rateLimitTimeWindowDuration := time.Duration(args.RateLimitTimeWindowInSeconds) * time.Second
 		rateLimiter, err := middleware.NewRateLimiter(limitsMap, rateLimitTimeWindowDuration)
 		if err != nil {
 			return err
 		}
-		startRateLimiterReset(rateLimitTimeWindowInSeconds, rateLimiter, version)
+		startRateLimiterReset(ctx, args.RateLimitTimeWindowInSeconds, rateLimiter, version)
+		// the disabled routes are still registered and rejected on each request, so they can be toggled at runtime
 		versionGroup := ws.Group(version)
+		versionGroup.Use(disabledRoutes.MiddlewareHandlerFunc())
 		for path, group := range versionData.ApiHandler.GetAllGroups() {
 			subGroup := versionGroup.Group(path)
+			errAccessControl := addIPAccessControl(subGroup, versionData.ApiConfig, path)
+			if errAccessControl != nil {
+				return fmt.Errorf("%w for the %s group of version %s", errAccessControl, path, version)
+			}
 			group.RegisterRoutes(
 				subGroup,
 				versionData.ApiConfig,
-				getAuthenticationFunc(credentialsConfig),
+				getAuthenticationFunc(args.CredentialsConfig),
 				rateLimiter.MiddlewareHandlerFunc(),
 				metricsMiddleware.MiddlewareHandlerFunc(),
 			)
 		}
 	}
 
-	if isProfileModeActivated {
+	if args.OpenApiConfig.Enabled {
+		registerOpenApiRoute(ws, versionsMap, disabledRoutes)
+	}
+
+	registerProbeRoutes(ws, args.ReadinessHandler)
+
+	if args.IsProfileModeActivated {
 		pprof.Register(ws)
 	}
 
-	return nil
+	return args.RuntimeConfigRegistry.RegisterRuntimeConfigHandler(runtimeConfig)
+}
+
+func getVersions(versionsMap map[string]*data.VersionData) []string {
+	versions := make([]string, 0, len(versionsMap))
+	for version := range versionsMap {
+		versions = append(versions, version)
+	}
+
+	return versions
 }
 
 func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.HandlerFunc {
@@ -195,6 +424,23 @@ func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.Handl
 	return authenticationFunction
 }
 
+// addIPAccessControl restricts the client IPs able to reach the routes of the group, if the group defines allowed or
+// denied CIDR ranges. The check runs before the authentication and the handlers of the routes
+func addIPAccessControl(group *gin.RouterGroup, apiConfig data.ApiRoutesConfig, path string) error {
+	packageConfig := apiConfig.APIPackages[strings.TrimPrefix(path, "/")]
+	if len(packageConfig.AllowedCIDRs) == 0 && len(packageConfig.DeniedCIDRs) == 0 {
+		return nil
+	}
+
+	ipAccessControl, err := middleware.NewIPAccessControl(packageConfig.AllowedCIDRs, packageConfig.DeniedCIDRs)
+	if err != nil {
+		return err
+	}
+	group.Use(ipAccessControl.MiddlewareHandlerFunc())
+
+	return nil
+}
+
 func getLimitsMapForVersion(versionData *data.VersionData) map[string]uint64 {
 	limitsMap := make(map[string]uint64)
 	for packageName, packageConfig := range versionData.ApiConfig.APIPackages {
@@ -209,13 +455,44 @@ func getLimitsMapForVersion(versionData *data.VersionData) map[string]uint64 {
 	return limitsMap
 }
 
-func startRateLimiterReset(rateLimiterDuration int, rl middleware.RateLimiterHandler, version string) {
-	go func() {
-		for {
-			time.Sleep(time.Duration(rateLimiterDuration) * time.Second)
-			rl.ResetMap(version)
+func startRateLimiterReset(ctx context.Context, rateLimiterDuration int, rl middleware.RateLimiterHandler, version string) {
+	go runPeriodically(ctx, time.Duration(rateLimiterDuration)*time.Second, func() {
+		rl.ResetMap(version)
+	})
+}
+
+func createTokenBucketRateLimiter(ctx context.Context, rateLimiterConfig config.RateLimiterConfig) (middleware.TokenBucketRateLimiterHandler, error) {
+	if rateLimiterConfig.IdleBucketsCleanupIntervalInSec <= 0 {
+		return nil, fmt.Errorf("invalid value %d for IdleBucketsCleanupIntervalInSec. It must be greater "+
+			"than zero", rateLimiterConfig.IdleBucketsCleanupIntervalInSec)
+	}
+
+	tokenBucketRateLimiter, err := middleware.NewTokenBucketRateLimiter(createTokenBucketRateLimiterArgs(rateLimiterConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	cleanupInterval := time.Duration(rateLimiterConfig.IdleBucketsCleanupIntervalInSec) * time.Second
+	go runPeriodically(ctx, cleanupInterval, func() {
+		tokenBucketRateLimiter.CleanIdleBuckets(cleanupInterval)
+	})
+
+	return tokenBucketRateLimiter, nil
+}
+
+// runPeriodically calls the provided handler at each interval, until the context is done
+func runPeriodically(ctx context.Context, interval time.Duration, handler func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			handler()
+		case <-ctx.Done():
+			return
 		}
-	}()
+	}
 }
 
 // skValidator validates a secret key from user input for correctness
@@ -99,6 +99,41 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		return nil, err
 	}
 
+	proxyGroup, err := groups.NewProxyGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	observerGroup, err := groups.NewObserverGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeGroup, err := groups.NewBridgeGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionsGroup, err := groups.NewCollectionsGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	utilsGroup, err := groups.NewUtilsGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	adminGroup, err := groups.NewAdminGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]data.GroupHandler{
 		"/actions":     actionsGroup,
 		"/address":     accountsGroup,
@@ -114,6 +149,13 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		"/vm-values":   vmValuesGroup,
 		"/proof":       proofGroup,
 		"/about":       aboutGroup,
+		"/proxy":       proxyGroup,
+		"/jsonrpc":     jsonRpcGroup,
+		"/observer":    observerGroup,
+		"/bridge":      bridgeGroup,
+		"/collections": collectionsGroup,
+		"/utils":       utilsGroup,
+		"/admin":       adminGroup,
 	}, nil
 }
 
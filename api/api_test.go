@@ -0,0 +1,96 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPeriodically_ShouldStopWhenContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	numCalls := uint32(0)
+	chDone := make(chan struct{})
+	go func() {
+		api.RunPeriodically(ctx, time.Millisecond, func() {
+			atomic.AddUint32(&numCalls, 1)
+		})
+		close(chDone)
+	}()
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&numCalls) > 0
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	select {
+	case <-chDone:
+	case <-time.After(time.Second):
+		require.Fail(t, "the periodic routine did not stop")
+	}
+}
+
+func createEngineWithIPAccessControl(t *testing.T, trustedProxies []string) *gin.Engine {
+	ws, err := api.CreateEngine(api.ArgsCreateServer{TrustedProxies: trustedProxies})
+	require.Nil(t, err)
+
+	ipAccessControl, err := middleware.NewIPAccessControl([]string{"10.0.0.0/8"}, nil)
+	require.Nil(t, err)
+	ws.GET("/actions/reload", ipAccessControl.MiddlewareHandlerFunc(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return ws
+}
+
+func TestCreateEngine_SpoofedForwardingHeadersShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	ws := createEngineWithIPAccessControl(t, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/actions/reload", nil)
+	req.RemoteAddr = "203.0.113.7:4242"
+	req.Header.Set("X-Forwarded-For", "10.0.0.1")
+	req.Header.Set("X-Real-IP", "10.0.0.1")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestCreateEngine_ForwardingHeadersFromTrustedProxyShouldBeHonored(t *testing.T) {
+	t.Parallel()
+
+	ws := createEngineWithIPAccessControl(t, []string{"192.168.1.1"})
+
+	req, _ := http.NewRequest(http.MethodGet, "/actions/reload", nil)
+	req.RemoteAddr = "192.168.1.1:4242"
+	req.Header.Set("X-Forwarded-For", "10.0.0.1")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	req, _ = http.NewRequest(http.MethodGet, "/actions/reload", nil)
+	req.RemoteAddr = "203.0.113.7:4242"
+	req.Header.Set("X-Forwarded-For", "10.0.0.1")
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestCreateEngine_InvalidTrustedProxyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws, err := api.CreateEngine(api.ArgsCreateServer{TrustedProxies: []string{"not an address"}})
+	require.NotNil(t, err)
+	require.Nil(t, ws)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CustomGroupArgs holds the components a custom route group is created with
+type CustomGroupArgs struct {
+	// Facade is the facade of the API version the group is added to. It can be type asserted to the facade handlers
+	// of the groups package, such as groups.TransactionFacadeHandler or groups.NetworkFacadeHandler
+	Facade data.FacadeHandler
+	// Processor is the core processor, used for calling the observers
+	Processor process.Processor
+}
+
+// CustomGroupFactory creates a custom route group for an API version
+type CustomGroupFactory func(args CustomGroupArgs) (data.GroupHandler, error)
+
+var (
+	mutCustomGroups       sync.RWMutex
+	customGroupsFactories = make(map[string]CustomGroupFactory)
+)
+
+// RegisterCustomGroup registers the factory of a route group added by an external module, without patching the facade
+// or the groups of the proxy. It is meant to be called from the init function of the module, imported by the main
+// package. The group is added to all the API versions, under the provided path, and its routes should be declared
+// in the API config of each version
+func RegisterCustomGroup(path string, factory CustomGroupFactory) error {
+	if !strings.HasPrefix(path, "/") || len(path) < 2 {
+		return fmt.Errorf("%w, invalid custom group path %q", ErrInvalidCustomGroup, path)
+	}
+	if factory == nil {
+		return fmt.Errorf("%w, nil factory for custom group %s", ErrInvalidCustomGroup, path)
+	}
+
+	mutCustomGroups.Lock()
+	defer mutCustomGroups.Unlock()
+
+	_, exists := customGroupsFactories[path]
+	if exists {
+		return fmt.Errorf("%w, custom group %s", ErrGroupAlreadyRegistered, path)
+	}
+
+	customGroupsFactories[path] = factory
+
+	return nil
+}
+
+// AddCustomGroups creates the registered custom route groups and adds them to the provided API handler. A custom
+// group cannot replace one of the base groups
+func AddCustomGroups(apiHandler data.ApiHandler, args CustomGroupArgs) error {
+	if check.IfNil(apiHandler) {
+		return ErrNilApiHandler
+	}
+
+	mutCustomGroups.RLock()
+	defer mutCustomGroups.RUnlock()
+
+	paths := make([]string, 0, len(customGroupsFactories))
+	for path := range customGroupsFactories {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		group, err := customGroupsFactories[path](args)
+		if err != nil {
+			return fmt.Errorf("%w while creating the custom group %s", err, path)
+		}
+
+		err = apiHandler.AddGroup(path, group)
+		if err != nil {
+			return fmt.Errorf("%w while adding the custom group %s", err, path)
+		}
+
+		log.Info("custom route group added", "path", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package api_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	apiMock "github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createCustomGroupFactory(args *api.CustomGroupArgs) api.CustomGroupFactory {
+	return func(providedArgs api.CustomGroupArgs) (data.GroupHandler, error) {
+		*args = providedArgs
+		return groups.NewCustomGroup([]*data.EndpointHandlerData{
+			{Path: "/status", Handler: func(_ *gin.Context) {}, Method: http.MethodGet},
+		})
+	}
+}
+
+func TestRegisterCustomGroup(t *testing.T) {
+	defer api.ResetCustomGroups()
+
+	factory := createCustomGroupFactory(&api.CustomGroupArgs{})
+	require.True(t, errors.Is(api.RegisterCustomGroup("", factory), api.ErrInvalidCustomGroup))
+	require.True(t, errors.Is(api.RegisterCustomGroup("/", factory), api.ErrInvalidCustomGroup))
+	require.True(t, errors.Is(api.RegisterCustomGroup("custom", factory), api.ErrInvalidCustomGroup))
+	require.True(t, errors.Is(api.RegisterCustomGroup("/custom", nil), api.ErrInvalidCustomGroup))
+
+	require.NoError(t, api.RegisterCustomGroup("/custom", factory))
+	require.True(t, errors.Is(api.RegisterCustomGroup("/custom", factory), api.ErrGroupAlreadyRegistered))
+}
+
+func TestAddCustomGroups(t *testing.T) {
+	t.Run("nil api handler should error", func(t *testing.T) {
+		require.Equal(t, api.ErrNilApiHandler, api.AddCustomGroups(nil, api.CustomGroupArgs{}))
+	})
+	t.Run("factory error should error", func(t *testing.T) {
+		defer api.ResetCustomGroups()
+
+		expectedErr := errors.New("expected error")
+		_ = api.RegisterCustomGroup("/custom", func(_ api.CustomGroupArgs) (data.GroupHandler, error) {
+			return nil, expectedErr
+		})
+		apiHandler, _ := api.NewApiHandler(&apiMock.FacadeStub{})
+
+		err := api.AddCustomGroups(apiHandler, api.CustomGroupArgs{})
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("base group path should error", func(t *testing.T) {
+		defer api.ResetCustomGroups()
+
+		_ = api.RegisterCustomGroup("/address", createCustomGroupFactory(&api.CustomGroupArgs{}))
+		apiHandler, _ := api.NewApiHandler(&apiMock.FacadeStub{})
+
+		err := api.AddCustomGroups(apiHandler, api.CustomGroupArgs{})
+		require.True(t, errors.Is(err, api.ErrGroupAlreadyRegistered))
+	})
+	t.Run("should work", func(t *testing.T) {
+		defer api.ResetCustomGroups()
+
+		providedArgs := api.CustomGroupArgs{}
+		_ = api.RegisterCustomGroup("/custom", createCustomGroupFactory(&providedArgs))
+		facade := &apiMock.FacadeStub{}
+		proc := &mock.ProcessorStub{}
+		apiHandler, _ := api.NewApiHandler(facade)
+
+		err := api.AddCustomGroups(apiHandler, api.CustomGroupArgs{Facade: facade, Processor: proc})
+		require.NoError(t, err)
+		require.True(t, providedArgs.Facade == facade)
+		require.True(t, providedArgs.Processor == proc)
+
+		group, err := apiHandler.GetGroup("/custom")
+		require.NoError(t, err)
+		require.NotNil(t, group)
+	})
+}
@@ -13,3 +13,24 @@ var ErrGroupDoesNotExist = errors.New("group does not exist")
 
 // ErrNilFacade signals that a nil facade has been provided
 var ErrNilFacade = errors.New("nil facade")
+
+// ErrNilReadinessHandler signals that a nil readiness handler has been provided
+var ErrNilReadinessHandler = errors.New("nil readiness handler")
+
+// ErrProxyNotReady signals that the proxy is not ready to serve requests yet
+var ErrProxyNotReady = errors.New("proxy is not ready yet")
+
+// ErrNilRuntimeConfigRegistry signals that a nil runtime config registry has been provided
+var ErrNilRuntimeConfigRegistry = errors.New("nil runtime config registry")
+
+// ErrInvalidCustomGroup signals that an invalid custom route group has been registered
+var ErrInvalidCustomGroup = errors.New("invalid custom group")
+
+// ErrNilApiHandler signals that a nil api handler has been provided
+var ErrNilApiHandler = errors.New("nil api handler")
+
+// ErrNilHttpServer signals that a nil http server has been provided
+var ErrNilHttpServer = errors.New("nil http server")
+
+// ErrInvalidHeavyListenerConfig signals that an invalid heavy endpoints listener configuration has been provided
+var ErrInvalidHeavyListenerConfig = errors.New("invalid heavy endpoints listener config")
@@ -29,6 +29,12 @@ var ErrGetESDTTokenData = errors.New("cannot get ESDT token data")
 // ErrGetGuardianData signals an error in fetching an address guardian data
 var ErrGetGuardianData = errors.New("cannot get guardian data")
 
+// ErrGetStakingPortfolio signals an error in fetching the staking portfolio of an address
+var ErrGetStakingPortfolio = errors.New("cannot get staking portfolio")
+
+// ErrGetAccountDelegations signals an error in fetching the delegations of an address
+var ErrGetAccountDelegations = errors.New("cannot get account delegations")
+
 // ErrGetESDTsWithRole signals an error in fetching an tokens with role for an address
 var ErrGetESDTsWithRole = errors.New("cannot get ESDTs with role")
 
@@ -56,6 +62,9 @@ var ErrCannotParseNonce = errors.New("cannot parse nonce")
 // ErrCannotParseRound signals that the round cannot be parsed
 var ErrCannotParseRound = errors.New("cannot parse round")
 
+// ErrCannotParseRange signals that the start or the end of a range cannot be parsed
+var ErrCannotParseRange = errors.New("cannot parse range")
+
 // ErrCannotParseEpoch signals that the epoch cannot be parsed
 var ErrCannotParseEpoch = errors.New("cannot parse epoch")
 
@@ -80,12 +89,21 @@ var ErrValidatorQueryParameterCheckSignature = errors.New("invalid query paramet
 // ErrInvalidSignatureHex signals a wrong hex value was provided for the signature
 var ErrInvalidSignatureHex = errors.New("invalid signature, could not decode hex value")
 
+// ErrInvalidSignature signals that a signature of a transaction does not match it
+var ErrInvalidSignature = errors.New("invalid signature")
+
 // ErrInvalidGuardianSignatureHex signals a wrong hex value provided for the guardian signature
 var ErrInvalidGuardianSignatureHex = errors.New("invalid guardian signature, could not decode hex value")
 
 // ErrInvalidGuardianAddress signals a wrong format for receiver address was provided
 var ErrInvalidGuardianAddress = errors.New("invalid guardian address")
 
+// ErrMissingGuardianAddress signals that a guarded transaction was provided without the guardian address
+var ErrMissingGuardianAddress = errors.New("missing guardian address for guarded transaction")
+
+// ErrGuardianFieldsOnNonGuardedTx signals that the guardian fields were provided for a transaction without the guarded option set
+var ErrGuardianFieldsOnNonGuardedTx = errors.New("guardian fields provided for a transaction without the guarded option set")
+
 // ErrTxGenerationFailed signals an error generating a transaction
 var ErrTxGenerationFailed = errors.New("transaction generation failed")
 
@@ -101,6 +119,9 @@ var ErrTransactionNotFound = errors.New("transaction not found")
 // ErrSCRsNoFound signals that smart contract results were not found
 var ErrSCRsNoFound = errors.New("smart contract results not found")
 
+// ErrNonceAlreadyUsed signals that the nonce of the sent transaction was already used by another transaction of the sender
+var ErrNonceAlreadyUsed = errors.New("transaction nonce already used")
+
 // ErrTransactionsNotFoundInPool signals that no transaction was not found in pool
 var ErrTransactionsNotFoundInPool = errors.New("transactions not found in pool")
 
@@ -110,6 +131,18 @@ var ErrTransactionHashMissing = errors.New("transaction hash missing")
 // ErrFaucetNotEnabled signals that the faucet mechanism is not enabled
 var ErrFaucetNotEnabled = errors.New("faucet not enabled")
 
+// ErrEmptyRawTransaction signals that an empty raw transaction has been provided
+var ErrEmptyRawTransaction = errors.New("empty raw transaction")
+
+// ErrNonceManagerNotEnabled signals that the nonce manager is not enabled
+var ErrNonceManagerNotEnabled = errors.New("nonce manager not enabled")
+
+// ErrInvalidRangeParams signals that invalid range parameters have been provided
+var ErrInvalidRangeParams = errors.New("invalid range parameters")
+
+// ErrInvalidTimestampParam signals that an invalid timestamp parameter has been provided
+var ErrInvalidTimestampParam = errors.New("invalid timestamp parameter")
+
 // ErrInvalidBlockNonceParam signals that an invalid block's nonce parameter has been provided
 var ErrInvalidBlockNonceParam = errors.New("invalid block nonce parameter")
 
@@ -149,6 +182,27 @@ var ErrIsDataTrieMigrated = errors.New("could not verify the migration status of
 // ErrInvalidIterateKeysRequestData signals that an invalid input has been provided
 var ErrInvalidIterateKeysRequestData = errors.New("invalid iterate keys request data")
 
+// ErrInvalidSinceParam signals that an invalid since parameter has been provided
+var ErrInvalidSinceParam = errors.New("invalid since parameter, expected a timestamp in unix milliseconds")
+
+// ErrInvalidESDTTypeFilter signals that an invalid ESDT type filter has been provided
+var ErrInvalidESDTTypeFilter = errors.New("invalid type parameter, accepted values: fungible, nft, sft, meta")
+
+// ErrGetTransactionsHistory signals an error in fetching the transactions history of an address
+var ErrGetTransactionsHistory = errors.New("cannot get transactions history")
+
+// ErrGetTransfersHistory signals an error in fetching the transfers history of an address
+var ErrGetTransfersHistory = errors.New("cannot get transfers history")
+
+// ErrExportAccountData signals an error in exporting the data trie of an address
+var ErrExportAccountData = errors.New("cannot export the account data")
+
+// ErrReloadConfig signals an error in reloading the main config file
+var ErrReloadConfig = errors.New("cannot reload config")
+
+// ErrSetFaultInjectionScenario signals an error in setting the fault injection scenario
+var ErrSetFaultInjectionScenario = errors.New("cannot set fault injection scenario")
+
 // ErrInvalidTxFields signals that one or more field of a transaction are invalid
 type ErrInvalidTxFields struct {
 	Message string
@@ -159,3 +213,90 @@ type ErrInvalidTxFields struct {
 func (eitx *ErrInvalidTxFields) Error() string {
 	return fmt.Sprintf("%s : %s", eitx.Message, eitx.Reason)
 }
+
+// ErrInvalidBLSKey signals that an invalid BLS key has been provided
+var ErrInvalidBLSKey = errors.New("invalid BLS key")
+
+// ErrGetESDTSupplies signals an error in getting the supplies of a list of esdt tokens
+var ErrGetESDTSupplies = errors.New("cannot get esdt supplies")
+
+// ErrInvalidTokensArray signals that an invalid list of tokens has been provided
+var ErrInvalidTokensArray = errors.New("invalid tokens array")
+
+// ErrGetESDTSupplyHistory signals an error in getting the supply history of an esdt token
+var ErrGetESDTSupplyHistory = errors.New("cannot get esdt supply history")
+
+// ErrGetESDTRoles signals an error in getting the special roles of an esdt token
+var ErrGetESDTRoles = errors.New("cannot get esdt roles")
+
+// ErrGetESDTOwnership signals an error in getting the ownership of an esdt token
+var ErrGetESDTOwnership = errors.New("cannot get esdt ownership")
+
+// ErrGetDelegationProviders signals an error in getting the staking providers
+var ErrGetDelegationProviders = errors.New("cannot get delegation providers")
+
+// ErrGetESDTPendingIssuances signals an error in getting the pending esdt issuances
+var ErrGetESDTPendingIssuances = errors.New("cannot get pending esdt issuances")
+
+// ErrGetShardsOfAddresses signals an error in computing the shards of a list of addresses
+var ErrGetShardsOfAddresses = errors.New("cannot get the shards of the addresses")
+
+// ErrWebhooksNotEnabled signals that the transactions webhooks are not enabled
+var ErrWebhooksNotEnabled = errors.New("webhooks not enabled")
+
+// ErrWatchTransaction signals an error in registering a transaction for a webhook
+var ErrWatchTransaction = errors.New("cannot watch the transaction")
+
+// ErrWatchAddresses signals an error in registering addresses on the watchlist of a webhook
+var ErrWatchAddresses = errors.New("cannot watch the addresses")
+
+// ErrUnwatchAddress signals an error in removing an address from the watchlist of a webhook
+var ErrUnwatchAddress = errors.New("cannot unwatch the address")
+
+// ErrGetWatchedAddresses signals an error in fetching the watchlist of a webhook
+var ErrGetWatchedAddresses = errors.New("cannot get the watched addresses")
+
+// ErrWatchTransactionsStatus signals an error in watching the status of the provided transactions
+var ErrWatchTransactionsStatus = errors.New("cannot watch the status of the transactions")
+
+// ErrEmptyTransactionsHashes signals that no transaction hash has been provided
+var ErrEmptyTransactionsHashes = errors.New("empty transactions hashes")
+
+// ErrTransactionWaitNotEnabled signals that waiting for the execution of the sent transactions is not enabled
+var ErrTransactionWaitNotEnabled = errors.New("transaction wait not enabled")
+
+// ErrWaitForTransactionExecution signals an error in waiting for the execution of a sent transaction
+var ErrWaitForTransactionExecution = errors.New("cannot wait for the transaction execution")
+
+// ErrObserversFeedNotEnabled signals that the observers feed is not enabled
+var ErrObserversFeedNotEnabled = errors.New("observers feed not enabled")
+
+// ErrGetBridgeDeposits signals an error in fetching the bridge deposits of an address
+var ErrGetBridgeDeposits = errors.New("cannot get bridge deposits")
+
+// ErrGetConsensusGroup signals an error in computing the consensus group of a round
+var ErrGetConsensusGroup = errors.New("cannot get the consensus group")
+
+// ErrGetRelayedTransaction signals an error in fetching the relayed transaction wrapping an inner transaction
+var ErrGetRelayedTransaction = errors.New("cannot get the relayed transaction")
+
+// ErrGetBlockFees signals an error in fetching the fees of a block
+var ErrGetBlockFees = errors.New("cannot get the block fees")
+
+// ErrGetCollectionNFTs signals an error in fetching the NFTs of a collection
+var ErrGetCollectionNFTs = errors.New("cannot get the NFTs of the collection")
+
+// ErrEmptyCollection signals that an empty collection identifier was provided
+var ErrEmptyCollection = errors.New("collection is empty")
+
+// ErrConvertAddresses signals an error in converting the addresses between the bech32 and the hex formats
+var ErrConvertAddresses = errors.New("cannot convert addresses")
+
+// ErrClientStatsNotEnabled signals that the client statistics are not enabled
+var ErrClientStatsNotEnabled = errors.New("client statistics are not enabled")
+
+// ErrGetClientStats signals an error in fetching the statistics of a client key
+var ErrGetClientStats = errors.New("cannot get the client statistics")
+
+// ErrEmptyClientKey signals that an empty client key was provided
+var ErrEmptyClientKey = errors.New("client key is empty")
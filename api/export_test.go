@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ResetCustomGroups removes all the registered custom groups
+func ResetCustomGroups() {
+	mutCustomGroups.Lock()
+	customGroupsFactories = make(map[string]CustomGroupFactory)
+	mutCustomGroups.Unlock()
+}
+
+// RunPeriodically -
+func RunPeriodically(ctx context.Context, interval time.Duration, handler func()) {
+	runPeriodically(ctx, interval, handler)
+}
+
+// CreateEngine -
+func CreateEngine(args ArgsCreateServer) (*gin.Engine, error) {
+	return createEngine(args)
+}
@@ -25,8 +25,8 @@ func NewAboutGroup(facadeHandler data.FacadeHandler) (*aboutGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "", Handler: ag.getAboutInfo, Method: http.MethodGet},
-		{Path: "/nodes-versions", Handler: ag.getNodesVersions, Method: http.MethodGet},
+		{Path: "", Handler: ag.getAboutInfo, Method: http.MethodGet, Cacheability: data.CacheabilityLongLived},
+		{Path: "/nodes-versions", Handler: ag.getNodesVersions, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
 	}
 	ag.baseGroup.endpoints = baseRoutesHandlers
 
@@ -3,13 +3,23 @@ package groups
 import (
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+const (
+	keyValuePairsField   = "pairs"
+	paginationField      = "pagination"
+	defaultExportNumKeys = 1000
+	maxExportNumKeys     = 10000
+)
+
 type accountsGroup struct {
 	facade AccountsFacadeHandler
 	*baseGroup
@@ -28,22 +38,28 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/:address", Handler: ag.getAccount, Method: http.MethodGet},
-		{Path: "/:address/balance", Handler: ag.getBalance, Method: http.MethodGet},
-		{Path: "/:address/username", Handler: ag.getUsername, Method: http.MethodGet},
-		{Path: "/:address/nonce", Handler: ag.getNonce, Method: http.MethodGet},
-		{Path: "/:address/shard", Handler: ag.getShard, Method: http.MethodGet},
-		{Path: "/:address/code-hash", Handler: ag.getCodeHash, Method: http.MethodGet},
-		{Path: "/:address/keys", Handler: ag.getKeyValuePairs, Method: http.MethodGet},
-		{Path: "/:address/key/:key", Handler: ag.getValueForKey, Method: http.MethodGet},
-		{Path: "/:address/esdt", Handler: ag.getESDTTokens, Method: http.MethodGet},
-		{Path: "/:address/esdt/:tokenIdentifier", Handler: ag.getESDTTokenData, Method: http.MethodGet},
-		{Path: "/:address/esdts-with-role/:role", Handler: ag.getESDTsWithRole, Method: http.MethodGet},
-		{Path: "/:address/esdts/roles", Handler: ag.getESDTsRoles, Method: http.MethodGet},
-		{Path: "/:address/registered-nfts", Handler: ag.getRegisteredNFTs, Method: http.MethodGet},
-		{Path: "/:address/nft/:tokenIdentifier/nonce/:nonce", Handler: ag.getESDTNftTokenData, Method: http.MethodGet},
-		{Path: "/:address/guardian-data", Handler: ag.getGuardianData, Method: http.MethodGet},
-		{Path: "/:address/is-data-trie-migrated", Handler: ag.isDataTrieMigrated, Method: http.MethodGet},
+		{Path: "/:address", Handler: ag.getAccount, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/balance", Handler: ag.getBalance, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/username", Handler: ag.getUsername, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/nonce", Handler: ag.getNonce, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/:address/shard", Handler: ag.getShard, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/code-hash", Handler: ag.getCodeHash, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/keys", Handler: ag.getKeyValuePairs, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/key/:key", Handler: ag.getValueForKey, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/esdt", Handler: ag.getESDTTokens, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/esdts", Handler: ag.getESDTTokensList, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/esdt/:tokenIdentifier", Handler: ag.getESDTTokenData, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/esdts-with-role/:role", Handler: ag.getESDTsWithRole, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/esdts/roles", Handler: ag.getESDTsRoles, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/registered-nfts", Handler: ag.getRegisteredNFTs, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/nft/:tokenIdentifier/nonce/:nonce", Handler: ag.getESDTNftTokenData, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/guardian-data", Handler: ag.getGuardianData, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/staking", Handler: ag.getStakingPortfolio, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/delegations", Handler: ag.getAccountDelegations, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/is-data-trie-migrated", Handler: ag.isDataTrieMigrated, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/transactions", Handler: ag.getTransactionsHistory, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/transfers", Handler: ag.getTransfersHistory, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/:address/export", Handler: ag.exportAccountData, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
 		{Path: "/iterate-keys", Handler: ag.iterateKeys, Method: http.MethodPost},
 		{Path: "/bulk", Handler: ag.getAccounts, Method: http.MethodPost},
 	}
@@ -61,16 +77,84 @@ func (group *accountsGroup) respondWithAccount(c *gin.Context, transform func(*d
 		return
 	}
 
-	model, err := group.facade.GetAccount(address, options)
+	withQuorum, err := parseBoolUrlParam(c, common.UrlParameterQuorum)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	withHexAddresses, err := parseHexAddressFormat(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	if withQuorum {
+		group.respondWithAccountQuorum(c, address, options, withHexAddresses, transform)
+		return
+	}
+
+	model, err := group.facade.GetAccount(c.Request.Context(), address, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccount, err)
+		return
+	}
+
+	formattedModel := *model
+	if withHexAddresses {
+		formattedModel.Account, err = group.accountWithHexAddresses(model.Account)
+		if err != nil {
+			shared.RespondWithInternalError(c, errors.ErrGetAccount, err)
+			return
+		}
+	}
+
+	response := transform(&formattedModel)
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
+func (group *accountsGroup) respondWithAccountQuorum(
+	c *gin.Context,
+	address string,
+	options common.AccountQueryOptions,
+	withHexAddresses bool,
+	transform func(*data.AccountModel) gin.H,
+) {
+	model, err := group.facade.GetAccountWithQuorum(address, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetAccount, err)
 		return
 	}
 
-	response := transform(model)
+	formattedModel := model.AccountModel
+	if withHexAddresses {
+		formattedModel.Account, err = group.accountWithHexAddresses(model.Account)
+		if err != nil {
+			shared.RespondWithInternalError(c, errors.ErrGetAccount, err)
+			return
+		}
+	}
+
+	response := transform(&formattedModel)
+	response["quorum"] = model.Quorum
 	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
+// accountWithHexAddresses returns a copy of the account holding its address and its owner address as hex public keys
+func (group *accountsGroup) accountWithHexAddresses(account data.Account) (data.Account, error) {
+	var err error
+	account.Address, err = group.facade.EncodeAddressAsHex(account.Address)
+	if err != nil {
+		return data.Account{}, err
+	}
+	if len(account.OwnerAddress) > 0 {
+		account.OwnerAddress, err = group.facade.EncodeAddressAsHex(account.OwnerAddress)
+		if err != nil {
+			return data.Account{}, err
+		}
+	}
+
+	return account, nil
+}
+
 // getAccount returns an accountResponse containing information
 // about the account correlated with provided address
 func (group *accountsGroup) getAccount(c *gin.Context) {
@@ -138,15 +222,57 @@ func (group *accountsGroup) getAccounts(c *gin.Context) {
 		return
 	}
 
+	withHexAddresses, err := parseHexAddressFormat(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidFields, err)
+		return
+	}
+
 	response, err := group.facade.GetAccounts(addresses, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrCannotGetAddresses, err)
 		return
 	}
 
+	if withHexAddresses {
+		response, err = group.accountsWithHexAddresses(response)
+		if err != nil {
+			shared.RespondWithInternalError(c, errors.ErrCannotGetAddresses, err)
+			return
+		}
+	}
+
 	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
+// accountsWithHexAddresses returns a copy of the accounts, keyed by their hex public keys and holding their addresses
+// as hex public keys
+func (group *accountsGroup) accountsWithHexAddresses(model *data.AccountsModel) (*data.AccountsModel, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	accounts := make(map[string]*data.Account, len(model.Accounts))
+	for address, account := range model.Accounts {
+		hexAddress, err := group.facade.EncodeAddressAsHex(address)
+		if err != nil {
+			return nil, err
+		}
+		if account == nil {
+			accounts[hexAddress] = nil
+			continue
+		}
+
+		hexAccount, err := group.accountWithHexAddresses(*account)
+		if err != nil {
+			return nil, err
+		}
+		accounts[hexAddress] = &hexAccount
+	}
+
+	return &data.AccountsModel{Accounts: accounts}, nil
+}
+
 // getKeyValuePairs returns the key-value pairs for the address parameter
 func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 	addr := c.Param("address")
@@ -161,15 +287,69 @@ func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
+	paginationOptions, err := parsePaginationOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
 	keyValuePairs, err := group.facade.GetKeyValuePairs(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
 		return
 	}
 
+	// the whole storage is returned, unless a page is explicitly requested
+	isPaginationRequested := paginationOptions.Page != 0
+	if isPaginationRequested {
+		keyValuePairs = paginateKeyValuePairsResponse(keyValuePairs, paginationOptions)
+	}
+
 	c.JSON(http.StatusOK, keyValuePairs)
 }
 
+// paginateKeyValuePairsResponse returns a copy of the provided response, holding only the pairs of the requested page,
+// sorted by their key, along with the total counts. The other fields of the data payload (such as the block info) are
+// kept, while the original response is left untouched
+func paginateKeyValuePairsResponse(response *data.GenericAPIResponse, options common.PaginationOptions) *data.GenericAPIResponse {
+	if response == nil {
+		return response
+	}
+	payload, ok := response.Data.(map[string]interface{})
+	if !ok {
+		return response
+	}
+	pairs, ok := payload[keyValuePairsField].(map[string]interface{})
+	if !ok {
+		return response
+	}
+
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	start, end := computePageBounds(len(keys), options)
+	pagePairs := make(map[string]interface{}, end-start)
+	for _, key := range keys[start:end] {
+		pagePairs[key] = pairs[key]
+	}
+
+	pagePayload := make(map[string]interface{}, len(payload)+1)
+	for field, value := range payload {
+		pagePayload[field] = value
+	}
+	pagePayload[keyValuePairsField] = pagePairs
+	pagePayload[paginationField] = newPaginationInfo(len(keys), options)
+
+	return &data.GenericAPIResponse{
+		Data:  pagePayload,
+		Error: response.Error,
+		Code:  response.Code,
+	}
+}
+
 // getValueForKey returns the value for the given address and key
 func (group *accountsGroup) getValueForKey(c *gin.Context) {
 	addr := c.Param("address")
@@ -382,6 +562,48 @@ func (group *accountsGroup) getGuardianData(c *gin.Context) {
 	c.JSON(http.StatusOK, guardianData)
 }
 
+// getStakingPortfolio returns the consolidated staking positions of an account. The staking providers to be queried
+// can be provided as a comma separated list
+func (group *accountsGroup) getStakingPortfolio(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetStakingPortfolio, errors.ErrEmptyAddress)
+		return
+	}
+
+	stakingProviders := make([]string, 0)
+	providersParam := parseStringUrlParam(c, common.UrlParameterProviders)
+	if len(providersParam) > 0 {
+		stakingProviders = strings.Split(providersParam, ",")
+	}
+
+	portfolio, err := group.facade.GetStakingPortfolio(addr, stakingProviders)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStakingPortfolio, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, portfolio)
+}
+
+// getAccountDelegations returns the active delegations, the undelegation queues and the claimable rewards of an
+// account, across all the staking providers it delegated to
+func (group *accountsGroup) getAccountDelegations(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountDelegations, errors.ErrEmptyAddress)
+		return
+	}
+
+	delegations, err := group.facade.GetAccountDelegations(addr)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountDelegations, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, delegations)
+}
+
 // getESDTTokens returns the tokens list from this account
 func (group *accountsGroup) getESDTTokens(c *gin.Context) {
 	addr := c.Param("address")
@@ -404,6 +626,79 @@ func (group *accountsGroup) getESDTTokens(c *gin.Context) {
 	c.JSON(http.StatusOK, tokens)
 }
 
+// getESDTTokensList returns a page of the ESDT tokens of the given address, optionally filtered by type and by a search
+// term matching the token identifier or name. The tokens are sorted by their identifier
+func (group *accountsGroup) getESDTTokensList(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseAccountQueryOptions(c, addr)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
+		return
+	}
+
+	filter := common.ESDTTokensFilterOptions{
+		TokenType: parseStringUrlParam(c, common.UrlParameterTokenType),
+		Search:    parseStringUrlParam(c, common.UrlParameterSearch),
+	}
+	if len(filter.TokenType) > 0 && !data.IsValidESDTTypeFilter(filter.TokenType) {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, errors.ErrInvalidESDTTypeFilter)
+		return
+	}
+
+	paginationOptions, err := parsePaginationOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTTokenData, err)
+		return
+	}
+	isPaginationRequested := paginationOptions.Page != 0
+	if !isPaginationRequested {
+		paginationOptions = common.PaginationOptions{Page: firstPage, Size: defaultPageSize}
+	}
+
+	tokens, err := group.facade.GetESDTTokensList(addr, options, filter)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetESDTTokenData, err)
+		return
+	}
+
+	// the exports hold the entire list, unless a page is explicitly requested
+	format := shared.NegotiateListFormat(c)
+	if shared.IsListExportFormat(format) && tokens != nil {
+		if isPaginationRequested {
+			tokens = paginateESDTTokensListResponse(tokens, paginationOptions)
+		}
+
+		shared.RespondWithList(c, format, tokens.Data.ESDTs)
+		return
+	}
+
+	c.JSON(http.StatusOK, paginateESDTTokensListResponse(tokens, paginationOptions))
+}
+
+// paginateESDTTokensListResponse returns a copy of the provided response, holding only the tokens of the requested page,
+// along with the total counts. The original response is left untouched
+func paginateESDTTokensListResponse(response *data.AccountESDTTokensListResponse, options common.PaginationOptions) *data.AccountESDTTokensListResponse {
+	if response == nil {
+		return response
+	}
+
+	tokensList := response.Data
+	start, end := computePageBounds(len(tokensList.ESDTs), options)
+	tokensList.ESDTs = tokensList.ESDTs[start:end]
+	tokensList.Pagination = newPaginationInfo(len(response.Data.ESDTs), options)
+
+	return &data.AccountESDTTokensListResponse{
+		Data:  tokensList,
+		Error: response.Error,
+		Code:  response.Code,
+	}
+}
+
 func (group *accountsGroup) isDataTrieMigrated(c *gin.Context) {
 	addr := c.Param("address")
 	if addr == "" {
@@ -426,6 +721,90 @@ func (group *accountsGroup) isDataTrieMigrated(c *gin.Context) {
 	c.JSON(http.StatusOK, isMigrated)
 }
 
+// getTransactionsHistory returns the requested page of the historical transactions sent or received by the address
+func (group *accountsGroup) getTransactionsHistory(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsHistory, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseTransactionsHistoryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsHistory, err)
+		return
+	}
+
+	history, err := group.facade.GetTransactionsHistory(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTransactionsHistory, err)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"transactions": history.Transactions, "pagination": history.Pagination},
+		"",
+		data.ReturnCodeSuccess,
+	)
+}
+
+// getTransfersHistory returns the requested page of the historical transfers of the address, including the smart contract
+// results moving value to or from it
+func (group *accountsGroup) getTransfersHistory(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetTransfersHistory, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := parseTransactionsHistoryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetTransfersHistory, err)
+		return
+	}
+
+	history, err := group.facade.GetTransfersHistory(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTransfersHistory, err)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"transfers": history.Transfers, "pagination": history.Pagination},
+		"",
+		data.ReturnCodeSuccess,
+	)
+}
+
+// exportAccountData returns a chunk of the key-value pairs of the data trie of an address, along with the cursor to be
+// provided for the next chunk
+func (group *accountsGroup) exportAccountData(c *gin.Context) {
+	address := c.Param("address")
+	options, err := parseAccountQueryOptions(c, address)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrExportAccountData, err)
+		return
+	}
+
+	exportOptions, err := parseAccountExportOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrExportAccountData, err)
+		return
+	}
+
+	export, err := group.facade.ExportAccountData(address, exportOptions, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrExportAccountData, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, export, "", data.ReturnCodeSuccess)
+}
+
 func (group *accountsGroup) iterateKeys(c *gin.Context) {
 	var iterateKeysRequest = &data.IterateKeysRequest{}
 	err := c.ShouldBindJSON(iterateKeysRequest)
@@ -2,6 +2,7 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -198,7 +199,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 
 	returnedError := "i am an error"
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -222,7 +223,7 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address: address,
@@ -251,6 +252,48 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_WithHexFormat(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{
+				Account: data.Account{
+					Address:      address,
+					OwnerAddress: "erd1owner",
+					Balance:      "100",
+				},
+			}, nil
+		},
+		EncodeAddressAsHexCalled: func(address string) (string, error) {
+			return "hex-" + address, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/erd1contract?format=hex", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "hex-erd1contract", response.Data.Account.Address)
+	assert.Equal(t, "hex-erd1owner", response.Data.Account.OwnerAddress)
+	assert.Equal(t, "100", response.Data.Account.Balance)
+
+	req, _ = http.NewRequest("GET", "/address/erd1contract?format=base64", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = accountResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, groups.ErrInvalidAddressFormat.Error())
+}
+
 //------- GetAccounts
 
 func TestGetAccount_FailsWhenInvalidRequest(t *testing.T) {
@@ -339,13 +382,44 @@ func TestGetAccounts_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountsResponse.Error)
 }
 
+func TestGetAccounts_WithHexFormat(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountsHandler: func(addresses []string, _ common.AccountQueryOptions) (*data.AccountsModel, error) {
+			return &data.AccountsModel{
+				Accounts: map[string]*data.Account{
+					"erd1alice": {Address: "erd1alice", Balance: "100"},
+				},
+			}, nil
+		},
+		EncodeAddressAsHexCalled: func(address string) (string, error) {
+			return "hex-" + address, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("POST", "/address/bulk?format=hex", bytes.NewBuffer([]byte(`["erd1alice"]`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountsResponse := accountsResponse{}
+	loadResponse(resp.Body, &accountsResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, map[string]*data.Account{
+		"hex-erd1alice": {Address: "hex-erd1alice", Balance: "100"},
+	}, accountsResponse.Data.Accounts)
+}
+
 //------- GetBalance
 
 func TestGetBalance_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address: address,
@@ -372,6 +446,87 @@ func TestGetBalance_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, balanceResponse.Error)
 }
 
+func TestGetBalance_WithQuorum(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("quorum read not reached")
+		facade := &mock.FacadeStub{
+			GetAccountWithQuorumCalled: func(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/balance?quorum=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		quorum := data.QuorumReadInfo{NumResponses: 3, NumAgreements: 2, MinAgreements: 2, Confidence: 0.66}
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+			GetAccountWithQuorumCalled: func(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error) {
+				return &data.AccountQuorumModel{
+					AccountModel: data.AccountModel{
+						Account: data.Account{Address: address, Balance: "100"},
+					},
+					Quorum: quorum,
+				}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/balance?quorum=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Balance string              `json:"balance"`
+				Quorum  data.QuorumReadInfo `json:"quorum"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "100", response.Data.Balance)
+		assert.Equal(t, quorum, response.Data.Quorum)
+		assert.Empty(t, response.Error)
+	})
+	t.Run("invalid quorum parameter should err", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/balance?quorum=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
 //------- GetUsername
 
 func TestGetUsername_ReturnsSuccessfully(t *testing.T) {
@@ -379,7 +534,7 @@ func TestGetUsername_ReturnsSuccessfully(t *testing.T) {
 
 	expectedUsername := "testUser"
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address:  address,
@@ -413,7 +568,7 @@ func TestGetNonce_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address: address,
@@ -547,6 +702,355 @@ func TestGetESDTTokens_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, shardResponse.Error)
 }
 
+func TestGetESDTTokensList(t *testing.T) {
+	t.Parallel()
+
+	tokens := make([]json.RawMessage, 0, 250)
+	for i := 0; i < 250; i++ {
+		tokens = append(tokens, json.RawMessage(fmt.Sprintf(`{"tokenIdentifier":"TKN-%03d"}`, i)))
+	}
+
+	t.Run("invalid type should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/esdts?type=coins", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(apiResp.Error, apiErrors.ErrInvalidESDTTypeFilter.Error()))
+	})
+
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/esdts?size=1001", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetESDTTokensListCalled: func(_ string, _ common.AccountQueryOptions, _ common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/esdts", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(apiResp.Error, expectedErr.Error()))
+	})
+
+	t.Run("default page should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetESDTTokensListCalled: func(address string, _ common.AccountQueryOptions, filter common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error) {
+				assert.Equal(t, "test", address)
+				assert.Equal(t, common.ESDTTokensFilterOptions{TokenType: data.ESDTTypeFungible, Search: "tkn"}, filter)
+				return &data.AccountESDTTokensListResponse{Data: data.AccountESDTTokensList{ESDTs: tokens}, Code: data.ReturnCodeSuccess}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/esdts?type=fungible&search=tkn", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.AccountESDTTokensListResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Len(t, apiResp.Data.ESDTs, 100)
+		assert.Equal(t, &data.PaginationInfo{Page: 1, Size: 100, TotalItems: 250, TotalPages: 3}, apiResp.Data.Pagination)
+	})
+
+	t.Run("requested page should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetESDTTokensListCalled: func(_ string, _ common.AccountQueryOptions, _ common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error) {
+				return &data.AccountESDTTokensListResponse{Data: data.AccountESDTTokensList{ESDTs: tokens}, Code: data.ReturnCodeSuccess}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/esdts?page=3&size=120", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.AccountESDTTokensListResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Len(t, apiResp.Data.ESDTs, 10)
+		assert.JSONEq(t, `{"tokenIdentifier":"TKN-240"}`, string(apiResp.Data.ESDTs[0]))
+		assert.Equal(t, &data.PaginationInfo{Page: 3, Size: 120, TotalItems: 250, TotalPages: 3}, apiResp.Data.Pagination)
+	})
+}
+
+func TestGetESDTTokensList_Export(t *testing.T) {
+	t.Parallel()
+
+	tokens := make([]json.RawMessage, 0, 150)
+	for i := 0; i < 150; i++ {
+		tokens = append(tokens, json.RawMessage(fmt.Sprintf(`{"tokenIdentifier":"TKN-%03d","balance":"%d"}`, i, i)))
+	}
+	facade := &mock.FacadeStub{
+		GetESDTTokensListCalled: func(_ string, _ common.AccountQueryOptions, _ common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error) {
+			return &data.AccountESDTTokensListResponse{
+				Data: data.AccountESDTTokensList{ESDTs: tokens},
+			}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	t.Run("entire list when no page is requested", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/address/test/esdts", nil)
+		req.Header.Set("Accept", "text/csv")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, lines, 151)
+		assert.Equal(t, "balance,tokenIdentifier", lines[0])
+		assert.Equal(t, "0,TKN-000", lines[1])
+	})
+	t.Run("requested page", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/address/test/esdts?page=2&size=10", nil)
+		req.Header.Set("Accept", "application/x-ndjson")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, lines, 10)
+		assert.Equal(t, `{"tokenIdentifier":"TKN-010","balance":"10"}`, lines[0])
+	})
+}
+
+func TestGetTransactionsHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid after should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transactions?after=yesterday", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(apiResp.Error, apiErrors.ErrGetTransactionsHistory.Error()))
+	})
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetTransactionsHistoryCalled: func(_ string, _ common.TransactionsHistoryOptions) (*data.TransactionsHistory, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(apiResp.Error, expectedErr.Error()))
+	})
+
+	t.Run("default options should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsHistoryCalled: func(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error) {
+				assert.Equal(t, "test", address)
+				assert.Equal(t, common.TransactionsHistoryOptions{Page: 1, Size: 100}, options)
+
+				return &data.TransactionsHistory{}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsHistoryCalled: func(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error) {
+				assert.Equal(t, common.TransactionsHistoryOptions{Page: 2, Size: 10, After: 100, Before: 200}, options)
+
+				return &data.TransactionsHistory{
+					Transactions: []data.DatabaseTransaction{{Hash: "hash"}},
+					Pagination:   data.NewPaginationInfo(2, 10, 11),
+				}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transactions?page=2&size=10&after=100&before=200", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type historyResponse struct {
+			Data struct {
+				Transactions []data.DatabaseTransaction `json:"transactions"`
+				Pagination   data.PaginationInfo        `json:"pagination"`
+			} `json:"data"`
+		}
+		apiResp := historyResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, apiResp.Data.Transactions, 1)
+		assert.Equal(t, "hash", apiResp.Data.Transactions[0].Hash)
+		assert.Equal(t, data.PaginationInfo{Page: 2, Size: 10, TotalItems: 11, TotalPages: 2}, apiResp.Data.Pagination)
+	})
+}
+
+func TestGetTransfersHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transfers?size=0", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(apiResp.Error, apiErrors.ErrGetTransfersHistory.Error()))
+	})
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			GetTransfersHistoryCalled: func(_ string, _ common.TransactionsHistoryOptions) (*data.TransfersHistory, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transfers", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(apiResp.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransfersHistoryCalled: func(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error) {
+				assert.Equal(t, "test", address)
+				assert.Equal(t, common.TransactionsHistoryOptions{Page: 1, Size: 100, After: 100}, options)
+
+				return &data.TransfersHistory{
+					Transfers: []data.Transfer{
+						{
+							Hash:           "scr",
+							Type:           data.TransferTypeSmartContractResult,
+							Direction:      data.TransferDirectionIncoming,
+							Value:          "1000",
+							OriginalTxHash: "tx",
+						},
+					},
+					Pagination: data.NewPaginationInfo(1, 100, 1),
+				}, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/transfers?after=100", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type transfersResponse struct {
+			Data struct {
+				Transfers  []data.Transfer     `json:"transfers"`
+				Pagination data.PaginationInfo `json:"pagination"`
+			} `json:"data"`
+		}
+		apiResp := transfersResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		require.Len(t, apiResp.Data.Transfers, 1)
+		assert.Equal(t, "scr", apiResp.Data.Transfers[0].Hash)
+		assert.Equal(t, data.TransferDirectionIncoming, apiResp.Data.Transfers[0].Direction)
+		assert.Equal(t, "tx", apiResp.Data.Transfers[0].OriginalTxHash)
+	})
+}
+
 // ---- GetGuardianData
 
 func TestGetGuardianData(t *testing.T) {
@@ -608,6 +1112,147 @@ func TestGetGuardianData(t *testing.T) {
 	})
 }
 
+// ---- GetStakingPortfolio
+
+func TestGetStakingPortfolio(t *testing.T) {
+	t.Parallel()
+
+	t.Run("internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetStakingPortfolioCalled: func(address string, stakingProviders []string) (*data.GenericAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+		req, _ := http.NewRequest("GET", "/address/test/staking", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStakingPortfolio.Error()))
+	})
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPortfolio := &data.StakingPortfolio{
+			Address:          "test",
+			ValidatorStake:   &data.ValidatorStakePosition{TotalStaked: "2500", TopUp: "0", NumNodes: 1},
+			Delegations:      []*data.DelegationPosition{{Contract: "provider1", ActiveStake: "10", UnStakedValue: "0", ClaimableRewards: "1"}},
+			TotalActiveStake: "2510",
+		}
+		var providedAddress string
+		var providedStakingProviders []string
+		facade := &mock.FacadeStub{
+			GetStakingPortfolioCalled: func(address string, stakingProviders []string) (*data.GenericAPIResponse, error) {
+				providedAddress = address
+				providedStakingProviders = stakingProviders
+				return &data.GenericAPIResponse{
+					Data: data.StakingPortfolioResponseData{Portfolio: expectedPortfolio},
+				}, nil
+			},
+		}
+
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+		req, _ := http.NewRequest("GET", "/address/test/staking?providers=provider1,provider2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := struct {
+			GeneralResponse
+			Data data.StakingPortfolioResponseData `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedPortfolio, response.Data.Portfolio)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, "test", providedAddress)
+		assert.Equal(t, []string{"provider1", "provider2"}, providedStakingProviders)
+	})
+}
+
+// ---- GetAccountDelegations
+
+func TestGetAccountDelegations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetAccountDelegationsCalled: func(address string) (*data.GenericAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+		req, _ := http.NewRequest("GET", "/address/test/delegations", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAccountDelegations.Error()))
+	})
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDelegations := &data.AccountDelegations{
+			Address: "test",
+			Delegations: []*data.AccountDelegation{
+				{
+					Contract:         "provider1",
+					ActiveStake:      "10",
+					ClaimableRewards: "1",
+					TotalUnDelegated: "5",
+					UnBondable:       "0",
+					UnDelegatedList:  []*data.UnDelegatedValue{{Value: "5", RemainingEpochs: 3}},
+				},
+			},
+			TotalActiveStake:      "10",
+			TotalUnDelegated:      "5",
+			TotalClaimableRewards: "1",
+		}
+		var providedAddress string
+		facade := &mock.FacadeStub{
+			GetAccountDelegationsCalled: func(address string) (*data.GenericAPIResponse, error) {
+				providedAddress = address
+				return &data.GenericAPIResponse{
+					Data: data.AccountDelegationsResponseData{Delegations: expectedDelegations},
+				}, nil
+			},
+		}
+
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+		req, _ := http.NewRequest("GET", "/address/test/delegations", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := struct {
+			GeneralResponse
+			Data data.AccountDelegationsResponseData `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedDelegations, response.Data.Delegations)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, "test", providedAddress)
+	})
+}
+
 // ---- GetESDTsRoles
 
 func TestGetESDTsRoles_FailsWhenFacadeErrors(t *testing.T) {
@@ -966,6 +1611,60 @@ func TestGetKeyValuePairs_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, actualResponse.Error)
 }
 
+func TestGetKeyValuePairs_WithPagination(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetKeyValuePairsHandler: func(_ string, _ common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"pairs": map[string]interface{}{
+						"key3": "value3",
+						"key1": "value1",
+						"key2": "value2",
+					},
+					"blockInfo": map[string]interface{}{"nonce": float64(37)},
+				},
+				Code: data.ReturnCodeSuccess,
+			}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	t.Run("invalid pagination should error", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/address/test/keys?size=0", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetKeyValuePairs.Error()))
+	})
+	t.Run("should return the requested page, sorted by key", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/address/test/keys?page=2&size=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		payload := response.Data.(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"key3": "value3"}, payload["pairs"])
+		assert.Equal(t, map[string]interface{}{"nonce": float64(37)}, payload["blockInfo"])
+		pagination := payload["pagination"].(map[string]interface{})
+		assert.Equal(t, float64(2), pagination["page"])
+		assert.Equal(t, float64(3), pagination["totalItems"])
+	})
+}
+
 // ---- get code hash
 
 func TestGetCodeHash_FailWhenFacadeErrors(t *testing.T) {
@@ -1172,3 +1871,81 @@ func TestAccountsGroup_IterateKeys(t *testing.T) {
 		assert.Equal(t, 2, len(respIterState))
 	})
 }
+
+func TestExportAccountData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressGroup, err := groups.NewAccountsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		for _, url := range []string{"/address/test/export?numKeys=0", "/address/test/export?numKeys=10001", "/address/test/export?cursor=invalid"} {
+			req, _ := http.NewRequest("GET", url, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			apiResp := data.GenericAPIResponse{}
+			loadResponse(resp.Body, &apiResp)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(apiResp.Error, apiErrors.ErrExportAccountData.Error()))
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("internal err")
+		facade := &mock.FacadeStub{
+			ExportAccountDataCalled: func(_ string, _ common.AccountExportOptions, _ common.AccountQueryOptions) (*data.AccountDataExport, error) {
+				return nil, expectedErr
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/export", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(apiResp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cursor := &data.AccountExportCursor{IteratorState: [][]byte{[]byte("state")}, BlockNonce: 37}
+		encodedCursor, _ := data.EncodeAccountExportCursor(cursor)
+		export := &data.AccountDataExport{
+			Pairs:      map[string]string{"key": "value"},
+			BlockInfo:  data.BlockInfo{Nonce: 37},
+			NextCursor: "next",
+		}
+		facade := &mock.FacadeStub{
+			ExportAccountDataCalled: func(address string, exportOptions common.AccountExportOptions, _ common.AccountQueryOptions) (*data.AccountDataExport, error) {
+				assert.Equal(t, "test", address)
+				assert.Equal(t, common.AccountExportOptions{NumKeys: 50, Cursor: cursor}, exportOptions)
+
+				return export, nil
+			},
+		}
+		addressGroup, err := groups.NewAccountsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(addressGroup, addressPath)
+
+		req, _ := http.NewRequest("GET", "/address/test/export?numKeys=50&cursor="+encodedCursor, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := struct {
+			Data data.AccountDataExport `json:"data"`
+		}{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *export, apiResp.Data)
+	})
+}
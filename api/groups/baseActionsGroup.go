@@ -1,9 +1,11 @@
 package groups
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -28,6 +30,12 @@ func NewActionsGroup(facadeHandler data.FacadeHandler) (*actionsGroup, error) {
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/reload-observers", Handler: ng.updateObservers, Method: http.MethodPost},
 		{Path: "/reload-full-history-observers", Handler: ng.updateFullHistoryObservers, Method: http.MethodPost},
+		{Path: "/reload-config", Handler: ng.reloadConfig, Method: http.MethodPost},
+		{Path: "/drain", Handler: ng.startDrain, Method: http.MethodPost},
+		{Path: "/drain-status", Handler: ng.getDrainStatus, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/fault-injection", Handler: ng.setFaultInjectionScenario, Method: http.MethodPost},
+		{Path: "/fault-injection", Handler: ng.clearFaultInjectionScenario, Method: http.MethodDelete},
+		{Path: "/fault-injection", Handler: ng.getFaultInjectionStatus, Method: http.MethodGet},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -44,6 +52,63 @@ func (group *actionsGroup) updateFullHistoryObservers(c *gin.Context) {
 	group.handleUpdateResponding(result, c)
 }
 
+// reloadConfig loads the main config file again, applying the observers lists, the rate limits, the cache max ages
+// and the route toggles. An invalid file is rejected, the running config being kept
+func (group *actionsGroup) reloadConfig(c *gin.Context) {
+	status, err := group.facade.ReloadConfig()
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, fmt.Sprintf("%s: %s", errors.ErrReloadConfig.Error(), err.Error()), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": status}, "", data.ReturnCodeSuccess)
+}
+
+// startDrain will switch the proxy in drain mode: the write requests are rejected, while the read requests are still
+// served until the reads window elapses
+func (group *actionsGroup) startDrain(c *gin.Context) {
+	drainStatus := group.facade.StartDrain()
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": drainStatus}, "", data.ReturnCodeSuccess)
+}
+
+// getDrainStatus returns the current drain status of the proxy
+func (group *actionsGroup) getDrainStatus(c *gin.Context) {
+	drainStatus := group.facade.GetDrainStatus()
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": drainStatus}, "", data.ReturnCodeSuccess)
+}
+
+// setFaultInjectionScenario activates the provided fault injection scenario for the calls towards the observers
+func (group *actionsGroup) setFaultInjectionScenario(c *gin.Context) {
+	scenario := &data.FaultInjectionScenario{}
+	err := c.ShouldBindJSON(scenario)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	group.respondWithFaultInjectionStatus(c, scenario)
+}
+
+// clearFaultInjectionScenario stops the fault injection
+func (group *actionsGroup) clearFaultInjectionScenario(c *gin.Context) {
+	group.respondWithFaultInjectionStatus(c, nil)
+}
+
+func (group *actionsGroup) respondWithFaultInjectionStatus(c *gin.Context, scenario *data.FaultInjectionScenario) {
+	err := group.facade.SetFaultInjectionScenario(scenario)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrSetFaultInjectionScenario, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": group.facade.GetFaultInjectionStatus()}, "", data.ReturnCodeSuccess)
+}
+
+// getFaultInjectionStatus returns the fault injection state of the proxy
+func (group *actionsGroup) getFaultInjectionStatus(c *gin.Context) {
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": group.facade.GetFaultInjectionStatus()}, "", data.ReturnCodeSuccess)
+}
+
 func (group *actionsGroup) handleUpdateResponding(result data.NodesReloadResponse, c *gin.Context) {
 	if result.Error != "" {
 		httpCode := http.StatusInternalServerError
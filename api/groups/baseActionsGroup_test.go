@@ -1,10 +1,14 @@
 package groups_test
 
 import (
+	"bytes"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -205,3 +209,305 @@ func TestActions_ReloadFullHistoryObserversShouldWork(t *testing.T) {
 	assert.Equal(t, description, response.Data.(string))
 	assert.Equal(t, "", response.Error)
 }
+
+type configReloadResponseData struct {
+	Status data.ConfigReloadStatus `json:"status"`
+}
+
+type configReloadResponse struct {
+	Data  configReloadResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+func TestActions_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid reloaded config")
+		facade := &mock.FacadeStub{
+			ReloadConfigCalled: func() (*data.ConfigReloadStatus, error) {
+				return nil, expectedErr
+			},
+		}
+
+		actionsGroup, err := groups.NewActionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(actionsGroup, actionsPath)
+
+		req, _ := http.NewRequest("POST", "/actions/reload-config", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &configReloadResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrReloadConfig.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStatus := &data.ConfigReloadStatus{
+			Observers: data.NodesReloadStatus{
+				Reloaded:    true,
+				Description: "observers reloaded",
+			},
+			FullHistoryObservers: data.NodesReloadStatus{
+				Description: "no full history observers in config, not reloaded",
+			},
+		}
+		facade := &mock.FacadeStub{
+			ReloadConfigCalled: func() (*data.ConfigReloadStatus, error) {
+				return expectedStatus, nil
+			},
+		}
+
+		actionsGroup, err := groups.NewActionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(actionsGroup, actionsPath)
+
+		req, _ := http.NewRequest("POST", "/actions/reload-config", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &configReloadResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *expectedStatus, response.Data.Status)
+		assert.Empty(t, response.Error)
+	})
+}
+
+type drainStatusResponseData struct {
+	Status data.DrainStatus `json:"status"`
+}
+
+type drainStatusResponse struct {
+	Data  drainStatusResponseData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+func TestActions_StartDrain(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := &data.DrainStatus{
+		IsDraining:              true,
+		DrainStartTimestamp:     100,
+		ReadsWindowEndTimestamp: 130,
+		AcceptsReads:            true,
+		AcceptsWrites:           false,
+	}
+	startDrainCalled := false
+	facade := &mock.FacadeStub{
+		StartDrainCalled: func() *data.DrainStatus {
+			startDrainCalled = true
+			return expectedStatus
+		},
+	}
+
+	actionsGroup, err := groups.NewActionsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(actionsGroup, actionsPath)
+
+	req, _ := http.NewRequest("POST", "/actions/drain", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &drainStatusResponse{}
+	loadResponse(resp.Body, response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, startDrainCalled)
+	assert.Equal(t, *expectedStatus, response.Data.Status)
+	assert.Empty(t, response.Error)
+}
+
+func TestActions_GetDrainStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := &data.DrainStatus{
+		AcceptsReads:  true,
+		AcceptsWrites: true,
+	}
+	facade := &mock.FacadeStub{
+		StartDrainCalled: func() *data.DrainStatus {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+		GetDrainStatusCalled: func() *data.DrainStatus {
+			return expectedStatus
+		},
+	}
+
+	actionsGroup, err := groups.NewActionsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(actionsGroup, actionsPath)
+
+	req, _ := http.NewRequest("GET", "/actions/drain-status", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &drainStatusResponse{}
+	loadResponse(resp.Body, response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedStatus, response.Data.Status)
+}
+
+type faultInjectionStatusResponseData struct {
+	Status data.FaultInjectionStatus `json:"status"`
+}
+
+type faultInjectionStatusResponse struct {
+	Data  faultInjectionStatusResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
+func TestActions_SetFaultInjectionScenario(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SetFaultInjectionScenarioCalled: func(scenario *data.FaultInjectionScenario) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		actionsGroup, err := groups.NewActionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(actionsGroup, actionsPath)
+
+		req, _ := http.NewRequest("POST", "/actions/fault-injection", bytes.NewBufferString("not a json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &faultInjectionStatusResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("fault injection not enabled")
+		facade := &mock.FacadeStub{
+			SetFaultInjectionScenarioCalled: func(scenario *data.FaultInjectionScenario) error {
+				return expectedErr
+			},
+		}
+		actionsGroup, err := groups.NewActionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(actionsGroup, actionsPath)
+
+		req, _ := http.NewRequest("POST", "/actions/fault-injection", bytes.NewBufferString(`{"errorPercentage":50}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &faultInjectionStatusResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrSetFaultInjectionScenario.Error()+": "+expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedScenario *data.FaultInjectionScenario
+		facade := &mock.FacadeStub{
+			SetFaultInjectionScenarioCalled: func(scenario *data.FaultInjectionScenario) error {
+				providedScenario = scenario
+				return nil
+			},
+			GetFaultInjectionStatusCalled: func() *data.FaultInjectionStatus {
+				return &data.FaultInjectionStatus{
+					Enabled:  true,
+					Scenario: providedScenario,
+				}
+			},
+		}
+		actionsGroup, err := groups.NewActionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(actionsGroup, actionsPath)
+
+		body := `{"pathPrefixes":["/address"],"delayInMs":200,"delayPercentage":10,"errorPercentage":50,"seed":7}`
+		req, _ := http.NewRequest("POST", "/actions/fault-injection", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		expectedScenario := &data.FaultInjectionScenario{
+			PathPrefixes:    []string{"/address"},
+			DelayInMs:       200,
+			DelayPercentage: 10,
+			ErrorPercentage: 50,
+			Seed:            7,
+		}
+		response := &faultInjectionStatusResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedScenario, providedScenario)
+		assert.True(t, response.Data.Status.Enabled)
+		assert.Equal(t, expectedScenario, response.Data.Status.Scenario)
+	})
+}
+
+func TestActions_ClearFaultInjectionScenario(t *testing.T) {
+	t.Parallel()
+
+	setScenarioCalled := false
+	facade := &mock.FacadeStub{
+		SetFaultInjectionScenarioCalled: func(scenario *data.FaultInjectionScenario) error {
+			setScenarioCalled = true
+			assert.Nil(t, scenario)
+			return nil
+		},
+		GetFaultInjectionStatusCalled: func() *data.FaultInjectionStatus {
+			return &data.FaultInjectionStatus{Enabled: true}
+		},
+	}
+	actionsGroup, err := groups.NewActionsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(actionsGroup, actionsPath)
+
+	req, _ := http.NewRequest("DELETE", "/actions/fault-injection", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &faultInjectionStatusResponse{}
+	loadResponse(resp.Body, response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, setScenarioCalled)
+	assert.True(t, response.Data.Status.Enabled)
+	assert.Nil(t, response.Data.Status.Scenario)
+}
+
+func TestActions_GetFaultInjectionStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := &data.FaultInjectionStatus{
+		Enabled: true,
+		Scenario: &data.FaultInjectionScenario{
+			TruncatePercentage: 100,
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetFaultInjectionStatusCalled: func() *data.FaultInjectionStatus {
+			return expectedStatus
+		},
+	}
+	actionsGroup, err := groups.NewActionsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(actionsGroup, actionsPath)
+
+	req, _ := http.NewRequest("GET", "/actions/fault-injection", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &faultInjectionStatusResponse{}
+	loadResponse(resp.Body, response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedStatus, response.Data.Status)
+}
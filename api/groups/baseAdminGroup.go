@@ -0,0 +1,70 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type adminGroup struct {
+	facade AdminFacadeHandler
+	*baseGroup
+}
+
+// NewAdminGroup returns a new instance of adminGroup
+func NewAdminGroup(facadeHandler data.FacadeHandler) (*adminGroup, error) {
+	facade, ok := facadeHandler.(AdminFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	ag := &adminGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/clients/:key/stats", Handler: ag.getClientStats, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/sc-queries/costs", Handler: ag.getSCQueryCosts, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+	}
+	ag.baseGroup.endpoints = baseRoutesHandlers
+
+	return ag, nil
+}
+
+// getClientStats returns the statistics of the transactions relayed on behalf of a client key
+func (group *adminGroup) getClientStats(c *gin.Context) {
+	if !group.facade.IsClientStatsEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			errors.ErrClientStatsNotEnabled.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	clientKey := c.Param("key")
+	if clientKey == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetClientStats, errors.ErrEmptyClientKey)
+		return
+	}
+
+	stats, err := group.facade.GetClientStats(clientKey)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetClientStats, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"stats": stats}, "", data.ReturnCodeSuccess)
+}
+
+// getSCQueryCosts returns the cost of the smart contract queries of each tracked contract, the most expensive first
+func (group *adminGroup) getSCQueryCosts(c *gin.Context) {
+	costs := group.facade.GetSCQueryCosts()
+	shared.RespondWith(c, http.StatusOK, gin.H{"contracts": costs.Contracts}, "", data.ReturnCodeSuccess)
+}
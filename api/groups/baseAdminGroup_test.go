@@ -0,0 +1,143 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const adminPath = "/admin"
+
+type clientStatsResponse struct {
+	Data struct {
+		Stats data.ClientStats `json:"stats"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewAdminGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewAdminGroup(wrongFacade)
+
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestAdminGroup_GetClientStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		adminGroup, err := groups.NewAdminGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/clients/key/stats", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrClientStatsNotEnabled.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			IsClientStatsEnabledCalled: func() bool {
+				return true
+			},
+			GetClientStatsCalled: func(clientKey string) (*data.ClientStats, error) {
+				return nil, expectedErr
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/clients/key/stats", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetClientStats.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStats := &data.ClientStats{
+			Relayed:                  10,
+			Executed:                 7,
+			Failed:                   1,
+			Pending:                  2,
+			AverageTimeToExecutionMs: 6500,
+		}
+		facade := &mock.FacadeStub{
+			IsClientStatsEnabledCalled: func() bool {
+				return true
+			},
+			GetClientStatsCalled: func(clientKey string) (*data.ClientStats, error) {
+				assert.Equal(t, "my-key", clientKey)
+				return expectedStats, nil
+			},
+		}
+		adminGroup, err := groups.NewAdminGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(adminGroup, adminPath)
+
+		req, _ := http.NewRequest("GET", "/admin/clients/my-key/stats", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &clientStatsResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *expectedStats, response.Data.Stats)
+	})
+}
+
+func TestAdminGroup_GetSCQueryCosts(t *testing.T) {
+	t.Parallel()
+
+	expectedCosts := &data.SCQueryCosts{
+		Contracts: []data.SCQueryContractCost{
+			{ScAddress: "erd1contract", NumQueries: 10, NumRejected: 2, TotalExecutionTimeMs: 500, AverageExecutionTimeMs: 50},
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetSCQueryCostsCalled: func() *data.SCQueryCosts {
+			return expectedCosts
+		},
+	}
+	adminGroup, err := groups.NewAdminGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(adminGroup, adminPath)
+
+	req, _ := http.NewRequest("GET", "/admin/sc-queries/costs", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &struct {
+		Data  data.SCQueryCosts `json:"data"`
+		Error string            `json:"error"`
+	}{}
+	loadResponse(resp.Body, response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedCosts, response.Data)
+}
@@ -1,9 +1,11 @@
 package groups
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -27,10 +29,12 @@ func NewBlockGroup(facadeHandler data.FacadeHandler) (*blockGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/:shard/by-nonce/:nonce", Handler: bg.byNonceHandler, Method: http.MethodGet},
-		{Path: "/:shard/by-hash/:hash", Handler: bg.byHashHandler, Method: http.MethodGet},
-		{Path: "/:shard/altered-accounts/by-nonce/:nonce", Handler: bg.alteredAccountsByNonceHandler, Method: http.MethodGet},
-		{Path: "/:shard/altered-accounts/by-hash/:hash", Handler: bg.alteredAccountsByHashHandler, Method: http.MethodGet},
+		{Path: "/:shard/by-nonce/:nonce", Handler: bg.byNonceHandler, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived, WithETag: true},
+		{Path: "/:shard/by-hash/:hash", Handler: bg.byHashHandler, Method: http.MethodGet, Cacheability: data.CacheabilityImmutable, WithETag: true},
+		{Path: "/by-hash/:hash", Handler: bg.byHashFromAnyShardHandler, Method: http.MethodGet, Cacheability: data.CacheabilityImmutable, WithETag: true},
+		{Path: "/:shard/by-nonce-range/:start/:end", Handler: bg.byNonceRangeHandler, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived, WithETag: true},
+		{Path: "/:shard/altered-accounts/by-nonce/:nonce", Handler: bg.alteredAccountsByNonceHandler, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived, WithETag: true},
+		{Path: "/:shard/altered-accounts/by-hash/:hash", Handler: bg.alteredAccountsByHashHandler, Method: http.MethodGet, Cacheability: data.CacheabilityImmutable, WithETag: true},
 	}
 	bg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -63,19 +67,65 @@ func (group *blockGroup) byHashHandler(c *gin.Context) {
 		return
 	}
 
+	if shared.IsProtobufRequested(c) {
+		respondWithBlockProtobuf(c, func() ([]byte, error) {
+			return group.facade.GetBlockProtobufByHash(shardID, hash)
+		})
+		return
+	}
+
 	options, err := parseBlockQueryOptions(c)
 	if err != nil {
 		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetBlockByHash(shardID, hash, options)
+	format := shared.NegotiateListFormat(c)
+	if shared.IsListExportFormat(format) {
+		options.WithTransactions = true
+	}
+
+	blockByHashResponse, err := group.facade.GetBlockByHash(c.Request.Context(), shardID, hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByHashResponse)
+	respondWithBlock(c, format, blockByHashResponse)
+}
+
+// byHashFromAnyShardHandler will handle the fetching and returning a block based on its hash, when the shard is not known
+func (group *blockGroup) byHashFromAnyShardHandler(c *gin.Context) {
+	hash, err := shared.FetchHashFromRequest(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrInvalidBlockHashParam.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	format := shared.NegotiateListFormat(c)
+	if shared.IsListExportFormat(format) {
+		options.WithTransactions = true
+	}
+
+	blockByHashResponse, err := group.facade.GetBlockByHashFromAnyShard(hash, options)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	respondWithBlock(c, format, blockByHashResponse)
 }
 
 // byNonceHandler will handle the fetching and returning a block based on its nonce
@@ -104,19 +154,95 @@ func (group *blockGroup) byNonceHandler(c *gin.Context) {
 		return
 	}
 
+	if shared.IsProtobufRequested(c) {
+		respondWithBlockProtobuf(c, func() ([]byte, error) {
+			return group.facade.GetBlockProtobufByNonce(shardID, nonce)
+		})
+		return
+	}
+
 	options, err := parseBlockQueryOptions(c)
 	if err != nil {
 		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetBlockByNonce(shardID, nonce, options)
+	format := shared.NegotiateListFormat(c)
+	if shared.IsListExportFormat(format) {
+		options.WithTransactions = true
+	}
+
+	blockByNonceResponse, err := group.facade.GetBlockByNonce(c.Request.Context(), shardID, nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByNonceResponse)
+	respondWithBlock(c, format, blockByNonceResponse)
+}
+
+// respondWithBlock writes the block as JSON or, if an export format was requested, only its transactions
+func respondWithBlock(c *gin.Context, format string, blockResponse *data.BlockApiResponse) {
+	if !shared.IsListExportFormat(format) || blockResponse == nil {
+		c.JSON(http.StatusOK, blockResponse)
+		return
+	}
+
+	transactions := make([]*transaction.ApiTransactionResult, 0)
+	for _, miniBlock := range blockResponse.Data.Block.MiniBlocks {
+		if miniBlock == nil {
+			continue
+		}
+
+		transactions = append(transactions, miniBlock.Transactions...)
+	}
+
+	shared.RespondWithList(c, format, transactions)
+}
+
+// respondWithBlockProtobuf writes the protocol representation of the block, as returned by the provided getter. The
+// protocol representation holds the block header only, the query options not being applicable to it
+func respondWithBlockProtobuf(c *gin.Context, getBlockBytes func() ([]byte, error)) {
+	blockBytes, err := getBlockBytes()
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWithProtobuf(c, blockBytes)
+}
+
+// byNonceRangeHandler will handle the fetching and returning of the blocks of a shard within a nonce range
+func (group *blockGroup) byNonceRangeHandler(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseShardID.Error())
+		return
+	}
+
+	startNonce, endNonce, err := shared.FetchRangeFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseRange.Error())
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	blocksByNonceRangeResponse, err := group.facade.GetBlocksByNonceRange(shardID, startNonce, endNonce, options)
+	if errors.Is(err, data.ErrInvalidBlocksRange) {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, blocksByNonceRangeResponse)
 }
 
 func (group *blockGroup) alteredAccountsByNonceHandler(c *gin.Context) {
@@ -1,17 +1,22 @@
 package groups_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
@@ -95,7 +100,7 @@ func TestGetBlockByNonce_FailWhenFacadeGetBlockByNonceFails(t *testing.T) {
 
 	returnedError := errors.New("i am an error")
 	facade := &mock.FacadeStub{
-		GetBlockByNonceCalled: func(_ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+		GetBlockByNonceCalled: func(_ context.Context, _ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 			return &data.BlockApiResponse{}, returnedError
 		},
 	}
@@ -122,7 +127,7 @@ func TestGetBlockByNonce_ReturnsSuccessfully(t *testing.T) {
 	nonce := uint64(37)
 	hash := "hashhh"
 	facade := &mock.FacadeStub{
-		GetBlockByNonceCalled: func(_ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+		GetBlockByNonceCalled: func(_ context.Context, _ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 			return &data.BlockApiResponse{
 				Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce, Hash: hash}},
 			}, nil
@@ -215,7 +220,7 @@ func TestGetBlockByHash_FailWhenFacadeGetBlockByHashFails(t *testing.T) {
 
 	returnedError := errors.New("i am an error")
 	facade := &mock.FacadeStub{
-		GetBlockByHashCalled: func(_ uint32, _ string, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+		GetBlockByHashCalled: func(_ context.Context, _ uint32, _ string, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 			return &data.BlockApiResponse{}, returnedError
 		},
 	}
@@ -242,7 +247,7 @@ func TestGetBlockByHash_ReturnsSuccessfully(t *testing.T) {
 	nonce := uint64(37)
 	hash := "hashhh"
 	facade := &mock.FacadeStub{
-		GetBlockByHashCalled: func(_ uint32, _ string, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+		GetBlockByHashCalled: func(_ context.Context, _ uint32, _ string, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 			return &data.BlockApiResponse{
 				Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce, Hash: hash}},
 			}, nil
@@ -267,6 +272,86 @@ func TestGetBlockByHash_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, apiResp.Error)
 }
 
+func TestGetBlockByHashFromAnyShard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/by-hash/invalid-hash", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrInvalidBlockHashParam.Error(), apiResp.Error)
+	})
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		returnedError := errors.New("i am an error")
+		facade := &mock.FacadeStub{
+			GetBlockByHashFromAnyShardCalled: func(_ string, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				return nil, returnedError
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/by-hash/aaaa", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, returnedError.Error(), apiResp.Error)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		shardID := uint32(2)
+		facade := &mock.FacadeStub{
+			GetBlockByHashFromAnyShardCalled: func(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				assert.Equal(t, "aaaa", hash)
+				assert.True(t, options.WithTransactions)
+
+				return &data.BlockApiResponse{
+					Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: 37, Hash: hash}, Shard: &shardID},
+				}, nil
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/by-hash/aaaa?withTxs=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlockApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint64(37), apiResp.Data.Block.Nonce)
+		assert.Equal(t, &shardID, apiResp.Data.Shard)
+		assert.Empty(t, apiResp.Error)
+	})
+}
+
 func getAlteredAccounts(t *testing.T, ws *gin.Engine, url string, expectedRespCode int) *data.AlteredAccountsApiResponse {
 	req, _ := http.NewRequest("GET", url, nil)
 	resp := httptest.NewRecorder()
@@ -470,3 +555,177 @@ func TestGetAlteredAccountsByHash(t *testing.T) {
 		require.Equal(t, expectedApiResponse, apiResp)
 	})
 }
+
+func TestBlockGroup_getBlocksByNonceRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := groups.NewBlockGroup(&mock.FacadeStub{})
+		ws := startProxyServer(bg, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-nonce-range/invalid/10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, apiErrors.ErrCannotParseRange.Error(), apiResp.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("local error")
+		bg, _ := groups.NewBlockGroup(&mock.FacadeStub{
+			GetBlocksByNonceRangeCalled: func(_ uint32, _ uint64, _ uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+				return nil, expectedErr
+			},
+		})
+		ws := startProxyServer(bg, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-nonce-range/1/10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Equal(t, expectedErr.Error(), apiResp.Error)
+	})
+	t.Run("invalid range should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := fmt.Errorf("%w: start 10 is greater than end 1", data.ErrInvalidBlocksRange)
+		bg, _ := groups.NewBlockGroup(&mock.FacadeStub{
+			GetBlocksByNonceRangeCalled: func(_ uint32, _ uint64, _ uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+				return nil, expectedErr
+			},
+		})
+		ws := startProxyServer(bg, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/by-nonce-range/10/1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, data.ReturnCodeRequestError, apiResp.Code)
+		require.Equal(t, expectedErr.Error(), apiResp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blocks := []*api.Block{{Nonce: 1}, {Nonce: 2}}
+		bg, _ := groups.NewBlockGroup(&mock.FacadeStub{
+			GetBlocksByNonceRangeCalled: func(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+				require.Equal(t, uint32(1), shardID)
+				require.Equal(t, uint64(1), startNonce)
+				require.Equal(t, uint64(2), endNonce)
+				require.True(t, options.WithTransactions)
+
+				return &data.BlocksApiResponse{
+					Data: data.BlocksApiResponsePayload{
+						Blocks: blocks,
+					},
+				}, nil
+			},
+		})
+		ws := startProxyServer(bg, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/1/by-nonce-range/1/2?withTxs=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.BlocksApiResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, blocks, apiResp.Data.Blocks)
+	})
+}
+
+func TestGetBlockByNonce_ExportTransactions(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(_ context.Context, _ uint32, _ uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			assert.True(t, options.WithTransactions)
+
+			return &data.BlockApiResponse{
+				Data: data.BlockApiResponsePayload{
+					Block: api.Block{
+						MiniBlocks: []*api.MiniBlock{
+							{Transactions: []*transaction.ApiTransactionResult{{Hash: "h1"}}},
+							{Transactions: []*transaction.ApiTransactionResult{{Hash: "h2"}}},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(blockGroup, blockPath)
+
+	req, _ := http.NewRequest("GET", "/block/0/by-nonce/1", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"hash":"h1"`)
+	assert.Contains(t, lines[1], `"hash":"h2"`)
+}
+
+func TestGetBlock_Protobuf(t *testing.T) {
+	t.Parallel()
+
+	blockBytes := []byte("protobuf block")
+	facade := &mock.FacadeStub{
+		GetBlockProtobufByNonceCalled: func(shardID uint32, nonce uint64) ([]byte, error) {
+			assert.Equal(t, uint32(1), shardID)
+			assert.Equal(t, uint64(37), nonce)
+			return blockBytes, nil
+		},
+		GetBlockProtobufByHashCalled: func(shardID uint32, hash string) ([]byte, error) {
+			return nil, errors.New("expected error")
+		},
+	}
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(blockGroup, blockPath)
+
+	t.Run("by nonce", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/block/1/by-nonce/37", nil)
+		req.Header.Set("Accept", shared.MIMEProtobuf)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, shared.MIMEProtobuf, resp.Header().Get("Content-Type"))
+		assert.Equal(t, blockBytes, resp.Body.Bytes())
+	})
+	t.Run("by hash with facade error", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/block/1/by-hash/aaaa", nil)
+		req.Header.Set("Accept", shared.MIMEProtobuf)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, "expected error", response.Error)
+	})
+}
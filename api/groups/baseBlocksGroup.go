@@ -1,14 +1,18 @@
 package groups
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+const blockNotificationEvent = "block"
+
 type blocksGroup struct {
 	facade BlocksFacadeHandler
 	*baseGroup
@@ -25,7 +29,9 @@ func NewBlocksGroup(facadeHandler data.FacadeHandler) (*blocksGroup, error) {
 		baseGroup: &baseGroup{},
 	}
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/by-round/:round", Handler: bbg.byRoundHandler, Method: http.MethodGet},
+		{Path: "/by-round/:round", Handler: bbg.byRoundHandler, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived, WithETag: true},
+		{Path: "/by-round-range/:start/:end", Handler: bbg.byRoundRangeHandler, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived, WithETag: true},
+		{Path: "/stream", Handler: bbg.streamHandler, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
 	}
 	bbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -53,3 +59,64 @@ func (bbp *blocksGroup) byRoundHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, blockByRoundResponse)
 }
+
+func (bbp *blocksGroup) byRoundRangeHandler(c *gin.Context) {
+	startRound, endRound, err := shared.FetchRangeFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseRange.Error())
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	blocksByRoundRangeResponse, err := bbp.facade.GetBlocksByRoundRange(startRound, endRound, options)
+	if errors.Is(err, data.ErrInvalidBlocksRange) {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, blocksByRoundRangeResponse)
+}
+
+// streamHandler sends, as server-sent events, the notification of each new block of the shard provided as URL
+// parameter, as received through the observers feed. The stream lasts until the client closes the connection
+func (bbp *blocksGroup) streamHandler(c *gin.Context) {
+	if !bbp.facade.IsObserversFeedEnabled() {
+		shared.RespondWith(c, http.StatusBadRequest, nil, apiErrors.ErrObserversFeedNotEnabled.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shardID, err := parseUint32UrlParam(c, common.UrlParameterShard)
+	if err != nil || !shardID.HasValue {
+		shared.RespondWithBadRequest(c, apiErrors.ErrInvalidShardIDParam.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	chanNotifications := bbp.facade.WatchBlocks(ctx, shardID.Value)
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "text/event-stream")
+	c.Writer.WriteHeaderNow()
+	c.Writer.Flush()
+	for {
+		select {
+		case notification, ok := <-chanNotifications:
+			if !ok {
+				return
+			}
+
+			c.SSEvent(blockNotificationEvent, notification)
+			c.Writer.Flush()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
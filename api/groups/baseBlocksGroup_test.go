@@ -1,7 +1,9 @@
 package groups_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -207,3 +209,171 @@ func TestGetBlocksByRound_DifferentWithTxsQueryParams_ExpectWithTxsFlagIsSetCorr
 		require.Empty(t, apiResp.Error)
 	}
 }
+
+func TestGetBlocksByRoundRange_InvalidRange_ExpectFail(t *testing.T) {
+	t.Parallel()
+
+	bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{})
+
+	proxyServer := startProxyServer(bg, blocksPath)
+
+	request, _ := http.NewRequest("GET", "/blocks/by-round-range/1/invalid_round", nil)
+	response := httptest.NewRecorder()
+	proxyServer.ServeHTTP(response, request)
+
+	apiResp := data.GenericAPIResponse{}
+	loadResponse(response.Body, &apiResp)
+
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Empty(t, apiResp.Data)
+	require.Equal(t, apiErrors.ErrCannotParseRange.Error(), apiResp.Error)
+}
+
+func TestGetBlocksByRoundRange_FacadeError_ExpectFail(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local error")
+	bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
+		GetBlocksByRoundRangeCalled: func(_ uint64, _ uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+			return nil, expectedErr
+		},
+	})
+
+	proxyServer := startProxyServer(bg, blocksPath)
+
+	request, _ := http.NewRequest("GET", "/blocks/by-round-range/1/5", nil)
+	response := httptest.NewRecorder()
+	proxyServer.ServeHTTP(response, request)
+
+	apiResp := data.GenericAPIResponse{}
+	loadResponse(response.Body, &apiResp)
+
+	require.Equal(t, http.StatusInternalServerError, response.Code)
+	require.Empty(t, apiResp.Data)
+	require.Equal(t, expectedErr.Error(), apiResp.Error)
+}
+
+func TestGetBlocksByRoundRange_FacadeInvalidRangeError_ExpectBadRequest(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := fmt.Errorf("%w: start 5 is greater than end 1", data.ErrInvalidBlocksRange)
+	bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
+		GetBlocksByRoundRangeCalled: func(_ uint64, _ uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+			return nil, expectedErr
+		},
+	})
+
+	proxyServer := startProxyServer(bg, blocksPath)
+
+	request, _ := http.NewRequest("GET", "/blocks/by-round-range/5/1", nil)
+	response := httptest.NewRecorder()
+	proxyServer.ServeHTTP(response, request)
+
+	apiResp := data.GenericAPIResponse{}
+	loadResponse(response.Body, &apiResp)
+
+	require.Equal(t, http.StatusBadRequest, response.Code)
+	require.Equal(t, data.ReturnCodeRequestError, apiResp.Code)
+	require.Equal(t, expectedErr.Error(), apiResp.Error)
+}
+
+func TestGetBlocksByRoundRange_ExpectSuccessful(t *testing.T) {
+	t.Parallel()
+
+	blocks := []*api.Block{{Round: 4, Hash: "blockHash1"}, {Round: 5, Hash: "blockHash2"}}
+	bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
+		GetBlocksByRoundRangeCalled: func(startRound uint64, endRound uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+			require.Equal(t, uint64(4), startRound)
+			require.Equal(t, uint64(5), endRound)
+
+			return &data.BlocksApiResponse{
+				Data: data.BlocksApiResponsePayload{
+					Blocks: blocks,
+				},
+			}, nil
+		},
+	})
+
+	proxyServer := startProxyServer(bg, blocksPath)
+
+	request, _ := http.NewRequest("GET", "/blocks/by-round-range/4/5", nil)
+	response := httptest.NewRecorder()
+	proxyServer.ServeHTTP(response, request)
+
+	apiResp := data.BlocksApiResponse{}
+	loadResponse(response.Body, &apiResp)
+
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, blocks, apiResp.Data.Blocks)
+	require.Empty(t, apiResp.Error)
+}
+
+func TestBlocksStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("observers feed not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{})
+		proxyServer := startProxyServer(bg, blocksPath)
+
+		request, _ := http.NewRequest("GET", "/blocks/stream?shard=1", nil)
+		response := httptest.NewRecorder()
+		proxyServer.ServeHTTP(response, request)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(response.Body, &apiResp)
+
+		require.Equal(t, http.StatusBadRequest, response.Code)
+		require.Equal(t, apiErrors.ErrObserversFeedNotEnabled.Error(), apiResp.Error)
+	})
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
+			IsObserversFeedEnabledCalled: func() bool {
+				return true
+			},
+		})
+		proxyServer := startProxyServer(bg, blocksPath)
+
+		for _, url := range []string{"/blocks/stream", "/blocks/stream?shard=invalid"} {
+			request, _ := http.NewRequest("GET", url, nil)
+			response := httptest.NewRecorder()
+			proxyServer.ServeHTTP(response, request)
+
+			apiResp := data.GenericAPIResponse{}
+			loadResponse(response.Body, &apiResp)
+
+			require.Equal(t, http.StatusBadRequest, response.Code)
+			require.Equal(t, apiErrors.ErrInvalidShardIDParam.Error(), apiResp.Error)
+		}
+	})
+	t.Run("should stream the block notifications", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
+			IsObserversFeedEnabledCalled: func() bool {
+				return true
+			},
+			WatchBlocksCalled: func(_ context.Context, shardID uint32) <-chan *data.BlockNotification {
+				require.Equal(t, uint32(1), shardID)
+
+				chanNotifications := make(chan *data.BlockNotification, 1)
+				chanNotifications <- &data.BlockNotification{ShardID: 1, Nonce: 10, Hash: "hash10", Round: 11, TxCount: 2}
+				close(chanNotifications)
+
+				return chanNotifications
+			},
+		})
+		proxyServer := startProxyServer(bg, blocksPath)
+
+		request, _ := http.NewRequest("GET", "/blocks/stream?shard=1", nil)
+		response := httptest.NewRecorder()
+		proxyServer.ServeHTTP(response, request)
+
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Equal(t, "text/event-stream", response.Header().Get("Content-Type"))
+		require.Equal(t, "event:block\ndata:{\"shard\":1,\"nonce\":10,\"hash\":\"hash10\",\"round\":11,\"txCount\":2}\n\n", response.Body.String())
+	})
+}
@@ -0,0 +1,53 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type bridgeGroup struct {
+	facade BridgeFacadeHandler
+	*baseGroup
+}
+
+// NewBridgeGroup returns a new instance of bridgeGroup
+func NewBridgeGroup(facadeHandler data.FacadeHandler) (*bridgeGroup, error) {
+	facade, ok := facadeHandler.(BridgeFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	bg := &bridgeGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/deposits/:address", Handler: bg.getBridgeDeposits, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+	}
+	bg.baseGroup.endpoints = baseRoutesHandlers
+
+	return bg, nil
+}
+
+// getBridgeDeposits returns the pending and executed deposits of an address towards the bridged chains, as found in
+// the recent batches of the bridge safe contracts
+func (group *bridgeGroup) getBridgeDeposits(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetBridgeDeposits, errors.ErrEmptyAddress)
+		return
+	}
+
+	deposits, err := group.facade.GetBridgeDeposits(addr)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetBridgeDeposits, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deposits)
+}
@@ -0,0 +1,100 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bridgePath = "/bridge"
+
+type bridgeDepositsResponse struct {
+	Data  data.BridgeDepositsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestNewBridgeGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewBridgeGroup(wrongFacade)
+
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestBridgeGroup_GetBridgeDeposits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetBridgeDepositsCalled: func(address string) (*data.GenericAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		bridgeGroup, err := groups.NewBridgeGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(bridgeGroup, bridgePath)
+
+		req, _ := http.NewRequest("GET", "/bridge/deposits/erd1address", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBridgeDeposits.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDeposits := &data.BridgeDeposits{
+			Address: "erd1address",
+			Deposits: []*data.BridgeDeposit{
+				{
+					Chain:        "Ethereum",
+					BatchID:      7,
+					DepositNonce: 120,
+					Sender:       "erd1address",
+					Recipient:    "0xabcd",
+					Token:        "WEGLD-bd4d79",
+					Amount:       "1000",
+					Status:       data.BridgeDepositStatusPending,
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetBridgeDepositsCalled: func(address string) (*data.GenericAPIResponse, error) {
+				assert.Equal(t, "erd1address", address)
+				return &data.GenericAPIResponse{
+					Data: data.BridgeDepositsResponseData{Deposits: expectedDeposits},
+					Code: data.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		bridgeGroup, err := groups.NewBridgeGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(bridgeGroup, bridgePath)
+
+		req, _ := http.NewRequest("GET", "/bridge/deposits/erd1address", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &bridgeDepositsResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedDeposits, response.Data.Deposits)
+	})
+}
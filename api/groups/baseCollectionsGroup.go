@@ -0,0 +1,69 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type collectionsGroup struct {
+	facade CollectionsFacadeHandler
+	*baseGroup
+}
+
+// NewCollectionsGroup returns a new instance of collectionsGroup
+func NewCollectionsGroup(facadeHandler data.FacadeHandler) (*collectionsGroup, error) {
+	facade, ok := facadeHandler.(CollectionsFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	cg := &collectionsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:collection/nfts", Handler: cg.getCollectionNFTs, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+	}
+	cg.baseGroup.endpoints = baseRoutesHandlers
+
+	return cg, nil
+}
+
+// getCollectionNFTs returns the requested page of the NFTs of a collection, sorted by their nonce, along with their
+// metadata and current owners
+func (group *collectionsGroup) getCollectionNFTs(c *gin.Context) {
+	collection := c.Param("collection")
+	if collection == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetCollectionNFTs, errors.ErrEmptyCollection)
+		return
+	}
+
+	options, err := parsePaginationOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetCollectionNFTs, err)
+		return
+	}
+	if options.Page == 0 {
+		options = common.PaginationOptions{Page: firstPage, Size: defaultPageSize}
+	}
+
+	nfts, err := group.facade.GetCollectionNFTs(collection, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetCollectionNFTs, err)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"nfts": nfts.NFTs, "pagination": nfts.Pagination},
+		"",
+		data.ReturnCodeSuccess,
+	)
+}
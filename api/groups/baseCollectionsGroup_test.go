@@ -0,0 +1,123 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const collectionsPath = "/collections"
+
+type collectionNFTsResponseData struct {
+	NFTs       []data.CollectionNFT `json:"nfts"`
+	Pagination data.PaginationInfo  `json:"pagination"`
+}
+
+type collectionNFTsResponse struct {
+	Data  collectionNFTsResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func TestNewCollectionsGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewCollectionsGroup(wrongFacade)
+
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestCollectionsGroup_GetCollectionNFTs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid pagination should error", func(t *testing.T) {
+		t.Parallel()
+
+		collectionsGroup, err := groups.NewCollectionsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(collectionsGroup, collectionsPath)
+
+		req, _ := http.NewRequest("GET", "/collections/MYNFT-1a2b3c/nfts?size=1001", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetCollectionNFTs.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetCollectionNFTsCalled: func(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error) {
+				return nil, expectedErr
+			},
+		}
+		collectionsGroup, err := groups.NewCollectionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(collectionsGroup, collectionsPath)
+
+		req, _ := http.NewRequest("GET", "/collections/MYNFT-1a2b3c/nfts", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetCollectionNFTs.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedNFTs := []data.CollectionNFT{
+			{
+				Identifier: "MYNFT-1a2b3c-0a",
+				Collection: "MYNFT-1a2b3c",
+				Nonce:      10,
+				Type:       "NonFungibleESDT",
+				Name:       "my nft",
+				Creator:    "erd1creator",
+				Royalties:  500,
+				URIs:       []string{"aHR0cHM6Ly9leGFtcGxlLmNvbQ=="},
+				Owner:      "erd1owner",
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetCollectionNFTsCalled: func(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error) {
+				assert.Equal(t, "MYNFT-1a2b3c", collection)
+				assert.Equal(t, common.PaginationOptions{Page: 2, Size: 100}, options)
+
+				return &data.CollectionNFTs{
+					NFTs:       expectedNFTs,
+					Pagination: data.NewPaginationInfo(2, 100, 101),
+				}, nil
+			},
+		}
+		collectionsGroup, err := groups.NewCollectionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(collectionsGroup, collectionsPath)
+
+		req, _ := http.NewRequest("GET", "/collections/MYNFT-1a2b3c/nfts?page=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &collectionNFTsResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedNFTs, response.Data.NFTs)
+		assert.Equal(t, *data.NewPaginationInfo(2, 100, 101), response.Data.Pagination)
+	})
+}
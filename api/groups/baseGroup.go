@@ -93,7 +93,7 @@ func (bg *baseGroup) RegisterRoutes(
 		properties := getEndpointProperties(ws, handlerData.Path, apiConfig)
 		if !properties.isFoundInConfig {
 			log.Warn("endpoint not found in config", "path", handlerData.Path)
-			ws.Handle(handlerData.Method, handlerData.Path, handlerData.Handler)
+			ws.Handle(handlerData.Method, handlerData.Path, responseHintsHandler(handlerData), handlerData.Handler)
 			continue
 		}
 
@@ -103,6 +103,7 @@ func (bg *baseGroup) RegisterRoutes(
 		}
 
 		middlewares := make([]gin.HandlerFunc, 0)
+		middlewares = append(middlewares, responseHintsHandler(handlerData))
 		if properties.isSecured {
 			middlewares = append(middlewares, authenticationFunc)
 		}
@@ -118,6 +119,22 @@ func (bg *baseGroup) RegisterRoutes(
 	}
 }
 
+// responseHintsHandler stores the cacheability class declared by the endpoint and whether its responses should carry
+// an ETag in the context of the request, so the Cache-Control and ETag headers can be set accordingly
+func responseHintsHandler(handlerData *data.EndpointHandlerData) gin.HandlerFunc {
+	cacheability := handlerData.Cacheability
+	withETag := handlerData.WithETag
+
+	return func(c *gin.Context) {
+		if len(cacheability) > 0 {
+			c.Set(data.CacheabilityContextKey, cacheability)
+		}
+		if withETag {
+			c.Set(data.ETagContextKey, true)
+		}
+	}
+}
+
 func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig) endpointProperties {
 	basePath := ws.BasePath()
 
@@ -3,10 +3,12 @@ package groups
 import (
 	"encoding/hex"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -28,8 +30,9 @@ func NewHyperBlockGroup(facadeHandler data.FacadeHandler) (*hyperBlockGroup, err
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/by-hash/:hash", Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet},
-		{Path: "/by-nonce/:nonce", Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet},
+		{Path: "/by-hash/:hash", Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet, Cacheability: data.CacheabilityImmutable, WithETag: true},
+		{Path: "/by-nonce/:nonce", Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived, WithETag: true},
+		{Path: "/by-timestamp/:unix", Handler: hbg.hyperBlockByTimestampHandler, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived, WithETag: true},
 	}
 	hbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -51,13 +54,19 @@ func (group *hyperBlockGroup) hyperBlockByHashHandler(c *gin.Context) {
 		return
 	}
 
+	paginationOptions, err := parsePaginationOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
 	blockByHashResponse, err := group.facade.GetHyperBlockByHash(hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByHashResponse)
+	c.JSON(http.StatusOK, paginateHyperblockResponse(blockByHashResponse, paginationOptions))
 }
 
 // hyperBlockByNonceHandler handles "by-nonce" requests
@@ -74,11 +83,67 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 		return
 	}
 
+	paginationOptions, err := parsePaginationOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
 	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	c.JSON(http.StatusOK, blockByNonceResponse)
+	c.JSON(http.StatusOK, paginateHyperblockResponse(blockByNonceResponse, paginationOptions))
+}
+
+// hyperBlockByTimestampHandler handles "by-timestamp" requests
+func (group *hyperBlockGroup) hyperBlockByTimestampHandler(c *gin.Context) {
+	timestamp, err := strconv.ParseInt(c.Param("unix"), 10, 64)
+	if err != nil || timestamp < 0 {
+		shared.RespondWithBadRequest(c, apiErrors.ErrInvalidTimestampParam.Error())
+		return
+	}
+
+	options, err := parseHyperblockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	paginationOptions, err := parsePaginationOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	blockByTimestampResponse, err := group.facade.GetHyperBlockByTimestamp(timestamp, options)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, paginateHyperblockResponse(blockByTimestampResponse, paginationOptions))
+}
+
+// paginateHyperblockResponse returns a copy of the provided response, holding only the transactions of the requested
+// page, along with the total counts. The original response is left untouched
+func paginateHyperblockResponse(response *data.HyperblockApiResponse, options common.PaginationOptions) *data.HyperblockApiResponse {
+	if response == nil || options.Page == 0 {
+		return response
+	}
+
+	hyperblock := response.Data.Hyperblock
+	start, end := computePageBounds(len(hyperblock.Transactions), options)
+	hyperblock.Transactions = hyperblock.Transactions[start:end]
+
+	return &data.HyperblockApiResponse{
+		Data: data.HyperblockApiResponsePayload{
+			Hyperblock: hyperblock,
+			Pagination: newPaginationInfo(len(response.Data.Hyperblock.Transactions), options),
+		},
+		Error: response.Error,
+		Code:  response.Code,
+	}
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -95,6 +96,96 @@ func TestGetHyperblockByNonce(t *testing.T) {
 	require.Equal(t, "invalid block hash parameter", response.Error)
 }
 
+func TestGetHyperblockByTimestamp(t *testing.T) {
+	facade := &mock.FacadeStub{
+		GetHyperBlockByTimestampCalled: func(timestamp int64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			if timestamp == 1700000000 {
+				return data.NewHyperblockApiResponse(api.Hyperblock{
+					Nonce: 42,
+				}), nil
+			}
+
+			return nil, fmt.Errorf("fooError")
+		},
+	}
+
+	// Get with success
+	response := data.HyperblockApiResponse{}
+	statusCode := doGet(t, facade, "/hyperblock/by-timestamp/1700000000", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "successful", string(response.Code))
+	require.Equal(t, 42, int(response.Data.Hyperblock.Nonce))
+
+	// Block missing
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-timestamp/1", &response)
+	require.Equal(t, http.StatusInternalServerError, statusCode)
+	require.Equal(t, "internal_issue", string(response.Code))
+	require.Equal(t, "fooError", response.Error)
+
+	// Bad timestamp
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-timestamp/-5", &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, "bad_request", string(response.Code))
+	require.Equal(t, "invalid timestamp parameter", response.Error)
+}
+
+func TestGetHyperblockByNonce_Pagination(t *testing.T) {
+	transactions := make([]*transaction.ApiTransactionResult, 0, 5)
+	for i := 0; i < 5; i++ {
+		transactions = append(transactions, &transaction.ApiTransactionResult{Hash: fmt.Sprintf("tx%d", i)})
+	}
+	facade := &mock.FacadeStub{
+		GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(api.Hyperblock{
+				Nonce:        nonce,
+				NumTxs:       uint32(len(transactions)),
+				Transactions: transactions,
+			}), nil
+		},
+	}
+
+	// No pagination
+	response := data.HyperblockApiResponse{}
+	statusCode := doGet(t, facade, "/hyperblock/by-nonce/42", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Len(t, response.Data.Hyperblock.Transactions, 5)
+	require.Nil(t, response.Data.Pagination)
+
+	// Second page
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?page=2&size=2", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Len(t, response.Data.Hyperblock.Transactions, 2)
+	require.Equal(t, "tx2", response.Data.Hyperblock.Transactions[0].Hash)
+	require.Equal(t, "tx3", response.Data.Hyperblock.Transactions[1].Hash)
+	require.Equal(t, &data.PaginationInfo{Page: 2, Size: 2, TotalItems: 5, TotalPages: 3}, response.Data.Pagination)
+
+	// Last, incomplete page
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?page=3&size=2", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Len(t, response.Data.Hyperblock.Transactions, 1)
+	require.Equal(t, "tx4", response.Data.Hyperblock.Transactions[0].Hash)
+
+	// Page out of range
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?page=10&size=2", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Empty(t, response.Data.Hyperblock.Transactions)
+	require.Equal(t, 5, response.Data.Pagination.TotalItems)
+
+	// The facade response should not be altered
+	require.Len(t, transactions, 5)
+
+	// Invalid size
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?page=1&size=0", &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, groups.ErrInvalidPaginationParams.Error())
+}
+
 func doGet(t *testing.T, facade interface{}, url string, response interface{}) int {
 	hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
 	require.NoError(t, err)
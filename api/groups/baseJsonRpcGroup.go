@@ -0,0 +1,294 @@
+package groups
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	jsonRpcSendTransaction = "sendTransaction"
+	jsonRpcGetAccount      = "getAccount"
+	jsonRpcGetBlockByNonce = "getBlockByNonce"
+	jsonRpcQueryContract   = "queryContract"
+)
+
+type jsonRpcMethodHandler func(ctx context.Context, params json.RawMessage) (interface{}, *data.JsonRpcError)
+
+type jsonRpcGroup struct {
+	facade  JsonRpcFacadeHandler
+	methods map[string]jsonRpcMethodHandler
+	*baseGroup
+}
+
+// NewJsonRpcGroup returns a new instance of jsonRpcGroup
+func NewJsonRpcGroup(facadeHandler data.FacadeHandler) (*jsonRpcGroup, error) {
+	facade, ok := facadeHandler.(JsonRpcFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	jrg := &jsonRpcGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+	jrg.methods = map[string]jsonRpcMethodHandler{
+		jsonRpcSendTransaction: jrg.sendTransaction,
+		jsonRpcGetAccount:      jrg.getAccount,
+		jsonRpcGetBlockByNonce: jrg.getBlockByNonce,
+		jsonRpcQueryContract:   jrg.queryContract,
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "", Handler: jrg.handleJsonRpc, Method: http.MethodPost},
+	}
+	jrg.baseGroup.endpoints = baseRoutesHandlers
+
+	return jrg, nil
+}
+
+// handleJsonRpc handles a JSON-RPC 2.0 request or a batch of requests. The JSON-RPC errors are returned with
+// 200 OK, as the protocol requires. The body size and the batch size are bounded by the JSON-RPC limits middleware
+func (group *jsonRpcGroup) handleJsonRpc(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, data.JsonRpcParseErrorCode, err.Error()))
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		group.handleJsonRpcBatch(c, body)
+		return
+	}
+
+	request := &data.JsonRpcRequest{}
+	err = json.Unmarshal(body, request)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, data.JsonRpcParseErrorCode, err.Error()))
+		return
+	}
+
+	response := group.processRequest(c.Request.Context(), request)
+	if request.IsNotification() {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (group *jsonRpcGroup) handleJsonRpcBatch(c *gin.Context, body []byte) {
+	var rawRequests []json.RawMessage
+	err := json.Unmarshal(body, &rawRequests)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, data.JsonRpcParseErrorCode, err.Error()))
+		return
+	}
+	if len(rawRequests) == 0 {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, data.JsonRpcInvalidRequestCode, "empty batch"))
+		return
+	}
+
+	responses := make([]*data.JsonRpcResponse, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		request := &data.JsonRpcRequest{}
+		err = json.Unmarshal(rawRequest, request)
+		if err != nil {
+			responses = append(responses, newJsonRpcErrorResponse(nil, data.JsonRpcInvalidRequestCode, err.Error()))
+			continue
+		}
+
+		response := group.processRequest(c.Request.Context(), request)
+		if request.IsNotification() {
+			continue
+		}
+		responses = append(responses, response)
+	}
+
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+func (group *jsonRpcGroup) processRequest(ctx context.Context, request *data.JsonRpcRequest) *data.JsonRpcResponse {
+	if request.JsonRpc != data.JsonRpcVersion || len(request.Method) == 0 {
+		return newJsonRpcErrorResponse(request.ID, data.JsonRpcInvalidRequestCode, "invalid JSON-RPC 2.0 request")
+	}
+
+	handler, found := group.methods[request.Method]
+	if !found {
+		return newJsonRpcErrorResponse(request.ID, data.JsonRpcMethodNotFoundCode, fmt.Sprintf("method %s not found", request.Method))
+	}
+
+	result, rpcErr := handler(ctx, request.Params)
+	if rpcErr != nil {
+		return &data.JsonRpcResponse{
+			JsonRpc: data.JsonRpcVersion,
+			Error:   rpcErr,
+			ID:      request.ID,
+		}
+	}
+
+	return &data.JsonRpcResponse{
+		JsonRpc: data.JsonRpcVersion,
+		Result:  result,
+		ID:      request.ID,
+	}
+}
+
+func (group *jsonRpcGroup) sendTransaction(_ context.Context, params json.RawMessage) (interface{}, *data.JsonRpcError) {
+	tx := &data.Transaction{}
+	rpcErr := decodeJsonRpcParams(params, []string{"transaction"}, tx)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	_, txHash, err := group.facade.SendTransaction(tx)
+	if err != nil {
+		return nil, newJsonRpcServerError(err)
+	}
+
+	return gin.H{"txHash": txHash}, nil
+}
+
+func (group *jsonRpcGroup) getAccount(ctx context.Context, params json.RawMessage) (interface{}, *data.JsonRpcError) {
+	address := ""
+	rpcErr := decodeJsonRpcParams(params, []string{"address"}, &address)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(address) == 0 {
+		return nil, newJsonRpcInvalidParamsError("empty address")
+	}
+
+	model, err := group.facade.GetAccount(ctx, address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, newJsonRpcServerError(err)
+	}
+
+	return gin.H{"account": model.Account, "blockInfo": model.BlockInfo}, nil
+}
+
+func (group *jsonRpcGroup) getBlockByNonce(ctx context.Context, params json.RawMessage) (interface{}, *data.JsonRpcError) {
+	var shardID uint32
+	var nonce uint64
+	options := common.BlockQueryOptions{}
+	rpcErr := decodeJsonRpcParams(
+		params,
+		[]string{"shardID", "nonce", "withTxs", "withLogs"},
+		&shardID, &nonce, &options.WithTransactions, &options.WithLogs,
+	)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	blockResponse, err := group.facade.GetBlockByNonce(ctx, shardID, nonce, options)
+	if err != nil {
+		return nil, newJsonRpcServerError(err)
+	}
+
+	return blockResponse.Data, nil
+}
+
+func (group *jsonRpcGroup) queryContract(ctx context.Context, params json.RawMessage) (interface{}, *data.JsonRpcError) {
+	request := &VMValueRequest{}
+	rpcErr := decodeJsonRpcParams(params, []string{"query"}, request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	query, err := createSCQuery(request)
+	if err != nil {
+		return nil, newJsonRpcInvalidParamsError(err.Error())
+	}
+
+	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(ctx, query)
+	if err != nil {
+		return nil, newJsonRpcServerError(err)
+	}
+
+	return gin.H{"data": vmOutput, "blockInfo": blockInfo}, nil
+}
+
+// decodeJsonRpcParams decodes the positional (array) or named (object) params into the provided targets. The names
+// define the order of the positional params. Missing params are left unchanged
+func decodeJsonRpcParams(params json.RawMessage, names []string, targets ...interface{}) *data.JsonRpcError {
+	params = bytes.TrimSpace(params)
+	if len(params) == 0 {
+		return nil
+	}
+
+	rawValues := make([]json.RawMessage, len(names))
+	switch params[0] {
+	case '[':
+		var positionalParams []json.RawMessage
+		err := json.Unmarshal(params, &positionalParams)
+		if err != nil {
+			return newJsonRpcInvalidParamsError(err.Error())
+		}
+		if len(positionalParams) > len(names) {
+			return newJsonRpcInvalidParamsError(fmt.Sprintf("too many params, maximum %d expected", len(names)))
+		}
+		copy(rawValues, positionalParams)
+	case '{':
+		var namedParams map[string]json.RawMessage
+		err := json.Unmarshal(params, &namedParams)
+		if err != nil {
+			return newJsonRpcInvalidParamsError(err.Error())
+		}
+		for i, name := range names {
+			rawValues[i] = namedParams[name]
+		}
+	default:
+		return newJsonRpcInvalidParamsError("params should be an array or an object")
+	}
+
+	for i, rawValue := range rawValues {
+		if len(rawValue) == 0 {
+			continue
+		}
+
+		err := json.Unmarshal(rawValue, targets[i])
+		if err != nil {
+			return newJsonRpcInvalidParamsError(fmt.Sprintf("invalid %s param: %s", names[i], err.Error()))
+		}
+	}
+
+	return nil
+}
+
+func newJsonRpcErrorResponse(id json.RawMessage, code int, message string) *data.JsonRpcResponse {
+	return &data.JsonRpcResponse{
+		JsonRpc: data.JsonRpcVersion,
+		Error: &data.JsonRpcError{
+			Code:    code,
+			Message: message,
+		},
+		ID: id,
+	}
+}
+
+func newJsonRpcInvalidParamsError(message string) *data.JsonRpcError {
+	return &data.JsonRpcError{
+		Code:    data.JsonRpcInvalidParamsCode,
+		Message: message,
+	}
+}
+
+func newJsonRpcServerError(err error) *data.JsonRpcError {
+	return &data.JsonRpcError{
+		Code:    data.JsonRpcServerErrorCode,
+		Message: err.Error(),
+	}
+}
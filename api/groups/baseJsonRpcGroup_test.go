@@ -0,0 +1,231 @@
+package groups_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const jsonRpcPath = "/jsonrpc"
+
+type jsonRpcTestResponse struct {
+	JsonRpc string                 `json:"jsonrpc"`
+	Result  map[string]interface{} `json:"result"`
+	Error   *data.JsonRpcError     `json:"error"`
+	ID      json.RawMessage        `json:"id"`
+}
+
+func doJsonRpcRequest(facade *mock.FacadeStub, body string) *httptest.ResponseRecorder {
+	jsonRpcGroup, _ := groups.NewJsonRpcGroup(facade)
+	ws := startProxyServer(jsonRpcGroup, jsonRpcPath)
+
+	req, _ := http.NewRequest(http.MethodPost, jsonRpcPath, bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewJsonRpcGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewJsonRpcGroup(&mock.WrongFacade{})
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+		require.NotNil(t, group)
+		require.NoError(t, err)
+	})
+}
+
+func TestJsonRpcGroup_InvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parse error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doJsonRpcRequest(&mock.FacadeStub{}, `{"jsonrpc":`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, data.JsonRpcParseErrorCode, response.Error.Code)
+		assert.Equal(t, "null", string(response.ID))
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doJsonRpcRequest(&mock.FacadeStub{}, `{"jsonrpc":"1.0","method":"getAccount","id":1}`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, data.JsonRpcInvalidRequestCode, response.Error.Code)
+		assert.Equal(t, "1", string(response.ID))
+	})
+
+	t.Run("method not found", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doJsonRpcRequest(&mock.FacadeStub{}, `{"jsonrpc":"2.0","method":"eth_call","id":"a"}`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, data.JsonRpcMethodNotFoundCode, response.Error.Code)
+		assert.Equal(t, `"a"`, string(response.ID))
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doJsonRpcRequest(&mock.FacadeStub{}, `{"jsonrpc":"2.0","method":"getBlockByNonce","params":[0,"nonce"],"id":1}`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, data.JsonRpcInvalidParamsCode, response.Error.Code)
+	})
+
+	t.Run("notification should not respond", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{}, nil
+			},
+		}
+		resp := doJsonRpcRequest(facade, `{"jsonrpc":"2.0","method":"getAccount","params":["erd1"]}`)
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Empty(t, resp.Body.Bytes())
+	})
+}
+
+func TestJsonRpcGroup_Methods(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sendTransaction", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				assert.Equal(t, uint64(7), tx.Nonce)
+				assert.Equal(t, "erd1sender", tx.Sender)
+				return http.StatusOK, "txhash", nil
+			},
+		}
+		resp := doJsonRpcRequest(facade, `{"jsonrpc":"2.0","method":"sendTransaction","params":{"transaction":{"nonce":7,"sender":"erd1sender"}},"id":1}`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		require.Nil(t, response.Error)
+		assert.Equal(t, "txhash", response.Result["txHash"])
+	})
+
+	t.Run("getAccount with facade error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				assert.Equal(t, "erd1", address)
+				return nil, expectedErr
+			},
+		}
+		resp := doJsonRpcRequest(facade, `{"jsonrpc":"2.0","method":"getAccount","params":["erd1"],"id":1}`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, data.JsonRpcServerErrorCode, response.Error.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error.Message)
+	})
+
+	t.Run("getBlockByNonce", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(_ context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint64(37), nonce)
+				assert.Equal(t, common.BlockQueryOptions{WithTransactions: true}, options)
+				return &data.BlockApiResponse{}, nil
+			},
+		}
+		resp := doJsonRpcRequest(facade, `{"jsonrpc":"2.0","method":"getBlockByNonce","params":[1,37,true],"id":1}`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		require.Nil(t, response.Error)
+		assert.Contains(t, response.Result, "block")
+	})
+
+	t.Run("queryContract", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				assert.Equal(t, "erd1sc", query.ScAddress)
+				assert.Equal(t, "getSum", query.FuncName)
+				assert.Equal(t, [][]byte{{0x01}}, query.Arguments)
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{Nonce: 5}, nil
+			},
+		}
+		resp := doJsonRpcRequest(facade, `{"jsonrpc":"2.0","method":"queryContract","params":[{"scAddress":"erd1sc","funcName":"getSum","args":["01"]}],"id":1}`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		require.Nil(t, response.Error)
+		assert.Contains(t, response.Result, "data")
+		assert.Equal(t, float64(5), response.Result["blockInfo"].(map[string]interface{})["nonce"])
+	})
+
+	t.Run("queryContract with block coordinates", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				assert.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, query.BlockNonce)
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{Nonce: 37}, nil
+			},
+		}
+		resp := doJsonRpcRequest(facade, `{"jsonrpc":"2.0","method":"queryContract","params":{"query":{"scAddress":"erd1sc","funcName":"getSum","blockNonce":37}},"id":1}`)
+		response := &jsonRpcTestResponse{}
+		loadResponse(resp.Body, response)
+		require.Nil(t, response.Error)
+		assert.Equal(t, float64(37), response.Result["blockInfo"].(map[string]interface{})["nonce"])
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Address: address}}, nil
+			},
+		}
+		body := `[
+			{"jsonrpc":"2.0","method":"getAccount","params":{"address":"erd1a"},"id":1},
+			{"jsonrpc":"2.0","method":"getAccount","params":["erd1b"]},
+			{"jsonrpc":"2.0","method":"unknown","id":2},
+			1
+		]`
+		resp := doJsonRpcRequest(facade, body)
+		var responses []*jsonRpcTestResponse
+		loadResponse(resp.Body, &responses)
+		require.Len(t, responses, 3)
+		assert.Equal(t, "erd1a", responses[0].Result["account"].(map[string]interface{})["address"])
+		assert.Equal(t, data.JsonRpcMethodNotFoundCode, responses[1].Error.Code)
+		assert.Equal(t, data.JsonRpcInvalidRequestCode, responses[2].Error.Code)
+	})
+}
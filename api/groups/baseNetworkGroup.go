@@ -3,13 +3,22 @@ package groups
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+// maxAddressesForShardsLookup is the maximum number of addresses accepted by the shard-of endpoint, as the number of
+// returned pairs grows quadratically
+const maxAddressesForShardsLookup = 20
+
+// maxTokensForSuppliesLookup is the maximum number of tokens accepted by the esdt supplies endpoint
+const maxTokensForSuppliesLookup = 100
+
 type networkGroup struct {
 	facade NetworkFacadeHandler
 	*baseGroup
@@ -28,22 +37,34 @@ func NewNetworkGroup(facadeHandler data.FacadeHandler) (*networkGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/status/:shard", Handler: ng.getNetworkStatusData, Method: http.MethodGet},
-		{Path: "/config", Handler: ng.getNetworkConfigData, Method: http.MethodGet},
-		{Path: "/economics", Handler: ng.getEconomicsData, Method: http.MethodGet},
-		{Path: "/esdts", Handler: ng.getEsdts, Method: http.MethodGet},
-		{Path: "/esdt/fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.FungibleTokens), Method: http.MethodGet},
-		{Path: "/esdt/semi-fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.SemiFungibleTokens), Method: http.MethodGet},
-		{Path: "/esdt/non-fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.NonFungibleTokens), Method: http.MethodGet},
-		{Path: "/esdt/supply/:token", Handler: ng.getESDTSupply, Method: http.MethodGet},
-		{Path: "/enable-epochs", Handler: ng.getEnableEpochs, Method: http.MethodGet},
-		{Path: "/direct-staked-info", Handler: ng.getDirectStakedInfo, Method: http.MethodGet},
-		{Path: "/delegated-info", Handler: ng.getDelegatedInfo, Method: http.MethodGet},
-		{Path: "/ratings", Handler: ng.getRatingsConfig, Method: http.MethodGet},
-		{Path: "/genesis-nodes", Handler: ng.getGenesisNodes, Method: http.MethodGet},
-		{Path: "/gas-configs", Handler: ng.getGasConfigs, Method: http.MethodGet},
-		{Path: "/trie-statistics/:shard", Handler: ng.getTrieStatistics, Method: http.MethodGet},
-		{Path: "/epoch-start/:shard/by-epoch/:epoch", Handler: ng.getEpochStartData, Method: http.MethodGet},
+		{Path: "/status/:shard", Handler: ng.getNetworkStatusData, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/config", Handler: ng.getNetworkConfigData, Method: http.MethodGet, Cacheability: data.CacheabilityLongLived},
+		{Path: "/economics", Handler: ng.getEconomicsData, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/economics/history", Handler: ng.getEconomicsDataHistory, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdts", Handler: ng.getEsdts, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdt/fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.FungibleTokens), Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdt/semi-fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.SemiFungibleTokens), Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdt/non-fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.NonFungibleTokens), Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdt/supply/:token", Handler: ng.getESDTSupply, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdt/supplies", Handler: ng.getESDTSupplies, Method: http.MethodPost},
+		{Path: "/esdt/:token/roles", Handler: ng.getESDTRoles, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdt/:token/supply-history", Handler: ng.getESDTSupplyHistory, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdt/:token/ownership", Handler: ng.getESDTOwnership, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/esdt/pending-issuances", Handler: ng.getESDTPendingIssuances, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/enable-epochs", Handler: ng.getEnableEpochs, Method: http.MethodGet, Cacheability: data.CacheabilityLongLived},
+		{Path: "/direct-staked-info", Handler: ng.getDirectStakedInfo, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/delegated-info", Handler: ng.getDelegatedInfo, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/delegation-providers", Handler: ng.getDelegationProviders, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/ratings", Handler: ng.getRatingsConfig, Method: http.MethodGet, Cacheability: data.CacheabilityLongLived},
+		{Path: "/genesis-nodes", Handler: ng.getGenesisNodes, Method: http.MethodGet, Cacheability: data.CacheabilityLongLived},
+		{Path: "/gas-configs", Handler: ng.getGasConfigs, Method: http.MethodGet, Cacheability: data.CacheabilityLongLived},
+		{Path: "/trie-statistics/:shard", Handler: ng.getTrieStatistics, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/sync-progress", Handler: ng.getSyncProgress, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/epoch-start/:shard/by-epoch/:epoch", Handler: ng.getEpochStartData, Method: http.MethodGet, Cacheability: data.CacheabilityImmutable},
+		{Path: "/shard-of", Handler: ng.getShardsOfAddresses, Method: http.MethodGet, Cacheability: data.CacheabilityLongLived},
+		{Path: "/latest-blocks", Handler: ng.getLatestBlocks, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/consensus/:shard/:round", Handler: ng.getConsensusGroup, Method: http.MethodGet, Cacheability: data.CacheabilityImmutable},
+		{Path: "/fees/:shard/by-nonce/:nonce", Handler: ng.getBlockFeesByNonce, Method: http.MethodGet, Cacheability: data.CacheabilityImmutable},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -89,6 +110,12 @@ func (group *networkGroup) getEconomicsData(c *gin.Context) {
 	c.JSON(http.StatusOK, economicsData)
 }
 
+// getEconomicsDataHistory will expose the last economics data metrics samples, from the oldest to the newest one
+func (group *networkGroup) getEconomicsDataHistory(c *gin.Context) {
+	history := group.facade.GetEconomicsDataMetricsHistory()
+	shared.RespondWith(c, http.StatusOK, gin.H{"history": history}, "", data.ReturnCodeSuccess)
+}
+
 func (group *networkGroup) getEsdtHandlerFunc(tokenType string) func(c *gin.Context) {
 	return func(c *gin.Context) {
 		tokens, err := group.facade.GetAllIssuedESDTs(tokenType)
@@ -123,6 +150,23 @@ func (group *networkGroup) getDelegatedInfo(c *gin.Context) {
 	c.JSON(http.StatusOK, delegatedInfo)
 }
 
+// getDelegationProviders will expose all the staking providers along with their metadata
+func (group *networkGroup) getDelegationProviders(c *gin.Context) {
+	providers, err := group.facade.GetDelegationProviders()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetDelegationProviders.Error(), err.Error()),
+			data.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, providers)
+}
+
 // getEsdts will expose all the issued ESDTs
 func (group *networkGroup) getEsdts(c *gin.Context) {
 	allIssuedESDTs, err := group.facade.GetAllIssuedESDTs("")
@@ -166,6 +210,127 @@ func (group *networkGroup) getESDTSupply(c *gin.Context) {
 	c.JSON(http.StatusOK, esdtSupply)
 }
 
+// getESDTSupplies returns the supplies of all the tokens provided in the request body
+func (group *networkGroup) getESDTSupplies(c *gin.Context) {
+	request := &data.ESDTSuppliesRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil || len(request.Tokens) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTSupplies, errors.ErrInvalidTokensArray)
+		return
+	}
+	if len(request.Tokens) > maxTokensForSuppliesLookup {
+		err = fmt.Errorf("%w, maximum %d tokens can be provided", errors.ErrInvalidTokensArray, maxTokensForSuppliesLookup)
+		shared.RespondWithValidationError(c, errors.ErrGetESDTSupplies, err)
+		return
+	}
+	for _, token := range request.Tokens {
+		if token == "" {
+			shared.RespondWithValidationError(c, errors.ErrGetESDTSupplies, errors.ErrEmptyTokenIdentifier)
+			return
+		}
+	}
+
+	esdtSupplies, err := group.facade.GetESDTSupplies(request.Tokens)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, esdtSupplies)
+}
+
+// getESDTRoles returns the addresses with special roles for the provided token
+func (group *networkGroup) getESDTRoles(c *gin.Context) {
+	tokenIdentifier := c.Param("token")
+	if tokenIdentifier == "" {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTRoles.Error(), errors.ErrEmptyTokenIdentifier.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	esdtRoles, err := group.facade.GetESDTRoles(tokenIdentifier)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, esdtRoles)
+}
+
+// getESDTSupplyHistory returns the per-epoch supplies of the provided token, as sampled by the proxy
+func (group *networkGroup) getESDTSupplyHistory(c *gin.Context) {
+	tokenIdentifier := c.Param("token")
+	if tokenIdentifier == "" {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTSupplyHistory.Error(), errors.ErrEmptyTokenIdentifier.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	statusCode, supplyHistory, err := group.facade.GetESDTSupplyHistory(tokenIdentifier)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, supplyHistory)
+}
+
+// getESDTOwnership returns the owner and the pending ownership transfers for the provided token
+func (group *networkGroup) getESDTOwnership(c *gin.Context) {
+	tokenIdentifier := c.Param("token")
+	if tokenIdentifier == "" {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTOwnership.Error(), errors.ErrEmptyTokenIdentifier.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	esdtOwnership, err := group.facade.GetESDTOwnership(tokenIdentifier)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTOwnership.Error(), err.Error()),
+			data.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, esdtOwnership)
+}
+
+// getESDTPendingIssuances returns the token issuances not yet executed on the metachain
+func (group *networkGroup) getESDTPendingIssuances(c *gin.Context) {
+	pendingIssuances, err := group.facade.GetESDTPendingIssuances()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTPendingIssuances.Error(), err.Error()),
+			data.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	c.JSON(http.StatusOK, pendingIssuances)
+}
+
 // getRatingsConfig will expose the ratings configuration
 func (group *networkGroup) getRatingsConfig(c *gin.Context) {
 	networkConfigResults, err := group.facade.GetRatingsConfig()
@@ -216,6 +381,11 @@ func (group *networkGroup) getTrieStatistics(c *gin.Context) {
 	c.JSON(http.StatusOK, trieStatistics)
 }
 
+// getSyncProgress will expose the synchronization progress of the observers, grouped by shard
+func (group *networkGroup) getSyncProgress(c *gin.Context) {
+	shared.RespondWith(c, http.StatusOK, group.facade.GetSyncProgress(), "", data.ReturnCodeSuccess)
+}
+
 // getEpochStartData will expose epoch-start data for a given shard and epoch
 func (group *networkGroup) getEpochStartData(c *gin.Context) {
 	epoch, err := shared.FetchEpochFromRequest(c)
@@ -238,3 +408,92 @@ func (group *networkGroup) getEpochStartData(c *gin.Context) {
 
 	c.JSON(http.StatusOK, epochStartData)
 }
+
+// getConsensusGroup returns the consensus group and the leader of a past round of the provided shard, along with the
+// members which signed the block of the round, if any
+func (group *networkGroup) getConsensusGroup(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetConsensusGroup, errors.ErrCannotParseShardID)
+		return
+	}
+
+	round, err := shared.FetchRoundFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetConsensusGroup, errors.ErrCannotParseRound)
+		return
+	}
+
+	consensusGroup, err := group.facade.GetConsensusGroup(shardID, round)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetConsensusGroup, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, consensusGroup)
+}
+
+// getBlockFeesByNonce returns the fees collected in the block with the provided nonce of a shard and the developer
+// rewards, without the rest of the block
+func (group *networkGroup) getBlockFeesByNonce(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetBlockFees, errors.ErrCannotParseShardID)
+		return
+	}
+
+	nonce, err := shared.FetchNonceFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetBlockFees, errors.ErrCannotParseNonce)
+		return
+	}
+
+	fees, err := group.facade.GetBlockFeesByNonce(shardID, nonce)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetBlockFees, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, data.BlockFeesResponseData{Fees: fees}, "", data.ReturnCodeSuccess)
+}
+
+// getShardsOfAddresses returns the shard of each of the addresses provided as a comma separated list and whether each
+// pair of them is intra-shard
+func (group *networkGroup) getShardsOfAddresses(c *gin.Context) {
+	addressesParam := parseStringUrlParam(c, common.UrlParameterAddresses)
+	if len(addressesParam) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrGetShardsOfAddresses, errors.ErrEmptyAddress)
+		return
+	}
+
+	addresses := strings.Split(addressesParam, ",")
+	if len(addresses) > maxAddressesForShardsLookup {
+		err := fmt.Errorf("%w, maximum %d addresses can be provided", errors.ErrInvalidAddressesArray, maxAddressesForShardsLookup)
+		shared.RespondWithValidationError(c, errors.ErrGetShardsOfAddresses, err)
+		return
+	}
+
+	addressesShards, err := group.facade.GetShardsOfAddresses(addresses)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetShardsOfAddresses, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, addressesShards, "", data.ReturnCodeSuccess)
+}
+
+// getLatestBlocks returns the latest block of each shard, as received through the observers feed
+func (group *networkGroup) getLatestBlocks(c *gin.Context) {
+	if !group.facade.IsObserversFeedEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			errors.ErrObserversFeedNotEnabled.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, group.facade.GetLatestBlocks(), "", data.ReturnCodeSuccess)
+}
@@ -1,12 +1,16 @@
 package groups_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -219,6 +223,44 @@ func TestGetEconomicsData_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedResp.Data, ecDataResp.Data) //extra safe
 }
 
+type economicsHistoryResponseData struct {
+	History []*data.EconomicMetricsSample `json:"history"`
+}
+
+type economicsHistoryResponse struct {
+	Data  economicsHistoryResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+func TestGetEconomicsDataHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHistory := []*data.EconomicMetricsSample{
+		{Timestamp: 100, Metrics: map[string]interface{}{"erd_total_supply": "12345"}},
+		{Timestamp: 700, Metrics: map[string]interface{}{"erd_total_supply": "12346"}},
+	}
+	facade := &mock.FacadeStub{
+		GetEconomicsDataMetricsHistoryCalled: func() []*data.EconomicMetricsSample {
+			return expectedHistory
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/economics/history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	historyResp := economicsHistoryResponse{}
+	loadResponse(resp.Body, &historyResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedHistory, historyResp.Data.History)
+	assert.Empty(t, historyResp.Error)
+}
+
 func TestGetAllIssuedESDTs_ShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -279,6 +321,294 @@ func TestGetAllIssuedESDTs_ShouldWork(t *testing.T) {
 	}
 }
 
+func TestGetESDTRoles_ShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("internal error")
+	facade := &mock.FacadeStub{
+		GetESDTRolesCalled: func(_ string) (*data.ESDTRolesResponse, error) {
+			return nil, expectedErr
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/esdt/TKN-abcdef/roles", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtRoles := data.ESDTRolesResponse{}
+	loadResponse(resp.Body, &esdtRoles)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), esdtRoles.Error)
+}
+
+func TestGetESDTRoles_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResp := &data.ESDTRolesResponse{
+		Data: data.ESDTRoles{
+			Addresses: []*data.ESDTAddressRoles{{Address: "erd1a", Roles: []string{"ESDTRoleLocalMint"}}},
+			Roles:     map[string][]string{"ESDTRoleLocalMint": {"erd1a"}},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+	facade := &mock.FacadeStub{
+		GetESDTRolesCalled: func(token string) (*data.ESDTRolesResponse, error) {
+			assert.Equal(t, "TKN-abcdef", token)
+			return expectedResp, nil
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/esdt/TKN-abcdef/roles", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtRoles := &data.ESDTRolesResponse{}
+	loadResponse(resp.Body, esdtRoles)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResp, esdtRoles)
+}
+
+func TestGetESDTSupplyHistory_ShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("the ESDT supply history is disabled")
+	facade := &mock.FacadeStub{
+		GetESDTSupplyHistoryCalled: func(_ string) (int, *data.ESDTSupplyHistoryResponse, error) {
+			return http.StatusBadRequest, nil, expectedErr
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/esdt/TKN-abcdef/supply-history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	supplyHistory := data.ESDTSupplyHistoryResponse{}
+	loadResponse(resp.Body, &supplyHistory)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, expectedErr.Error(), supplyHistory.Error)
+}
+
+func TestGetESDTSupplyHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResp := &data.ESDTSupplyHistoryResponse{
+		Data: data.ESDTSupplyHistory{
+			Token: "TKN-abcdef",
+			Epochs: []*data.ESDTSupplyEpoch{
+				{Epoch: 1, Supply: "100", TotalMinted: "100", TotalBurned: "0"},
+				{Epoch: 2, Supply: "90", Minted: "0", Burned: "10", TotalMinted: "100", TotalBurned: "10"},
+			},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+	facade := &mock.FacadeStub{
+		GetESDTSupplyHistoryCalled: func(token string) (int, *data.ESDTSupplyHistoryResponse, error) {
+			assert.Equal(t, "TKN-abcdef", token)
+			return http.StatusOK, expectedResp, nil
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/esdt/TKN-abcdef/supply-history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	supplyHistory := &data.ESDTSupplyHistoryResponse{}
+	loadResponse(resp.Body, supplyHistory)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResp, supplyHistory)
+}
+
+func TestGetESDTOwnership_ShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("internal error")
+	facade := &mock.FacadeStub{
+		GetESDTOwnershipCalled: func(_ string) (*data.ESDTOwnershipResponse, error) {
+			return nil, expectedErr
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/esdt/TKN-abcdef/ownership", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtOwnership := data.ESDTOwnershipResponse{}
+	loadResponse(resp.Body, &esdtOwnership)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(esdtOwnership.Error, expectedErr.Error()))
+}
+
+func TestGetESDTOwnership_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResp := &data.ESDTOwnershipResponse{
+		Data: data.ESDTOwnership{
+			Token:            "TKN-abcdef",
+			Owner:            "erd1a",
+			PendingTransfers: []*data.ESDTPendingOwnershipTransfer{{TxHash: "h1", Sender: "erd1a", Nonce: 3, NewOwner: "erd1b"}},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+	facade := &mock.FacadeStub{
+		GetESDTOwnershipCalled: func(token string) (*data.ESDTOwnershipResponse, error) {
+			assert.Equal(t, "TKN-abcdef", token)
+			return expectedResp, nil
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/esdt/TKN-abcdef/ownership", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtOwnership := &data.ESDTOwnershipResponse{}
+	loadResponse(resp.Body, esdtOwnership)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResp, esdtOwnership)
+}
+
+func TestGetESDTPendingIssuances_ShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("internal error")
+	facade := &mock.FacadeStub{
+		GetESDTPendingIssuancesCalled: func() (*data.ESDTPendingIssuancesResponse, error) {
+			return nil, expectedErr
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/esdt/pending-issuances", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	pendingIssuances := data.ESDTPendingIssuancesResponse{}
+	loadResponse(resp.Body, &pendingIssuances)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(pendingIssuances.Error, expectedErr.Error()))
+}
+
+func TestGetESDTPendingIssuances_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResp := &data.ESDTPendingIssuancesResponse{
+		Data: data.ESDTPendingIssuances{
+			Issuances: []*data.ESDTPendingIssuance{{TxHash: "h1", Sender: "erd1a", Nonce: 3, Function: "issue", TokenName: "MyToken", TokenTicker: "MTK", Value: "5"}},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+	facade := &mock.FacadeStub{
+		GetESDTPendingIssuancesCalled: func() (*data.ESDTPendingIssuancesResponse, error) {
+			return expectedResp, nil
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/esdt/pending-issuances", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	pendingIssuances := &data.ESDTPendingIssuancesResponse{}
+	loadResponse(resp.Body, pendingIssuances)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResp, pendingIssuances)
+}
+
+func TestGetESDTSupplies_InvalidRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	tooManyTokens := make([]string, 101)
+	for i := range tooManyTokens {
+		tooManyTokens[i] = fmt.Sprintf("TKN-%d", i)
+	}
+	tooManyTokensBody, _ := json.Marshal(&data.ESDTSuppliesRequest{Tokens: tooManyTokens})
+
+	bodies := map[string]string{
+		"invalid json":    "not a json",
+		"no tokens":       `{"tokens":[]}`,
+		"empty token":     `{"tokens":["TKN-abcdef",""]}`,
+		"too many tokens": string(tooManyTokensBody),
+	}
+	for name, body := range bodies {
+		req, _ := http.NewRequest("POST", "/network/esdt/supplies", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, apiResp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, name)
+		assert.Contains(t, apiResp.Error, apiErrors.ErrGetESDTSupplies.Error(), name)
+	}
+}
+
+func TestGetESDTSupplies_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResp := &data.ESDTSuppliesResponse{
+		Data: data.ESDTSupplies{
+			Supplies: map[string]data.ESDTSupply{
+				"TKN-abcdef": {Supply: "100", Minted: "0", Burned: "0", InitialMinted: "100"},
+				"NFT-abcdef": {Supply: "2", InitialMinted: "0"},
+			},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+	facade := &mock.FacadeStub{
+		GetESDTSuppliesCalled: func(tokens []string) (*data.ESDTSuppliesResponse, error) {
+			assert.Equal(t, []string{"TKN-abcdef", "NFT-abcdef"}, tokens)
+			return expectedResp, nil
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	body := `{"tokens":["TKN-abcdef","NFT-abcdef"]}`
+	req, _ := http.NewRequest("POST", "/network/esdt/supplies", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtSupplies := &data.ESDTSuppliesResponse{}
+	loadResponse(resp.Body, esdtSupplies)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResp, esdtSupplies)
+}
+
 func TestGetDelegatedInfo_ShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -608,3 +938,432 @@ func TestGetTriesStatistics_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedResp, response)
 	assert.Equal(t, expectedResp.Data, response.Data)
 }
+
+func TestGetShardsOfAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing addresses should err", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/shard-of", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.AddressesShardsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetShardsOfAddresses.Error())
+	})
+	t.Run("too many addresses should err", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		addresses := strings.Repeat("erd1a,", 20) + "erd1b"
+		req, _ := http.NewRequest("GET", "/network/shard-of?addresses="+addresses, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.AddressesShardsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidAddressesArray.Error())
+	})
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid address")
+		facade := &mock.FacadeStub{
+			GetShardsOfAddressesCalled: func(addresses []string) (*data.AddressesShards, error) {
+				return nil, expectedErr
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/shard-of?addresses=erd1a,erd1b", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.AddressesShardsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedAddressesShards := data.AddressesShards{
+			Addresses: []*data.AddressShard{{Address: "erd1a", ShardID: 0}, {Address: "erd1b", ShardID: 1}},
+			Pairs:     []*data.AddressesPair{{First: "erd1a", Second: "erd1b", IsIntraShard: false}},
+		}
+		facade := &mock.FacadeStub{
+			GetShardsOfAddressesCalled: func(addresses []string) (*data.AddressesShards, error) {
+				assert.Equal(t, []string{"erd1a", "erd1b"}, addresses)
+				return &expectedAddressesShards, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/shard-of?addresses=erd1a,erd1b", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.AddressesShardsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedAddressesShards, response.Data)
+	})
+}
+
+func TestGetLatestBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("observers feed not enabled should err", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/latest-blocks", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.LatestBlocksResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrObserversFeedNotEnabled.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBlocks := []*data.FeedBlock{
+			{ShardID: 0, Nonce: 10, Hash: "aa"},
+			{ShardID: 1, Nonce: 12, Hash: "bb", IsFinalized: true},
+		}
+		facade := &mock.FacadeStub{
+			IsObserversFeedEnabledCalled: func() bool {
+				return true
+			},
+			GetLatestBlocksCalled: func() *data.LatestBlocksResponseData {
+				return &data.LatestBlocksResponseData{Blocks: expectedBlocks}
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/latest-blocks", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.LatestBlocksResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedBlocks, response.Data.Blocks)
+	})
+}
+
+func TestGetSyncProgress(t *testing.T) {
+	t.Parallel()
+
+	expectedProgress := &data.SyncProgress{
+		IsSynced: true,
+		Shards: []*data.ShardSyncProgress{
+			{
+				ShardID:            0,
+				IsSynced:           true,
+				NumSyncedObservers: 1,
+				HighestNonce:       100,
+				Observers: []*data.ObserverSyncProgress{
+					{Address: "observer0", IsSynced: true, Nonce: 100, ProbableHighestNonce: 100},
+				},
+			},
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetSyncProgressCalled: func() *data.SyncProgress {
+			return expectedProgress
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/sync-progress", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data  *data.SyncProgress `json:"data"`
+		Error string             `json:"error"`
+		Code  data.ReturnCode    `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProgress, response.Data)
+}
+
+type consensusGroupResponse struct {
+	Data  data.ConsensusGroupResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestGetConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard should err", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/consensus/invalid/37", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetConsensusGroup.Error())
+		assert.Contains(t, response.Error, apiErrors.ErrCannotParseShardID.Error())
+	})
+	t.Run("invalid round should err", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/consensus/1/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrCannotParseRound.Error())
+	})
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetConsensusGroupCalled: func(shardID uint32, round uint64) (*data.GenericAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/consensus/1/37", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetConsensusGroup.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedConsensusGroup := &data.ConsensusGroup{
+			ShardID: 4294967295,
+			Round:   37,
+			Leader:  "aa",
+			Members: []*data.ConsensusMember{
+				{PublicKey: "aa", Index: 3, Rating: 5000001, Chance: 100, Signed: true},
+			},
+			BlockProduced: true,
+			NumSigners:    1,
+		}
+		facade := &mock.FacadeStub{
+			GetConsensusGroupCalled: func(shardID uint32, round uint64) (*data.GenericAPIResponse, error) {
+				assert.Equal(t, uint32(4294967295), shardID)
+				assert.Equal(t, uint64(37), round)
+				return &data.GenericAPIResponse{
+					Data: data.ConsensusGroupResponseData{Consensus: expectedConsensusGroup},
+					Code: data.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/consensus/4294967295/37", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &consensusGroupResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedConsensusGroup, response.Data.Consensus)
+	})
+}
+
+type blockFeesResponse struct {
+	Data  data.BlockFeesResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func TestGetBlockFeesByNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard should err", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/fees/invalid/by-nonce/37", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetBlockFees.Error())
+		assert.Contains(t, response.Error, apiErrors.ErrCannotParseShardID.Error())
+	})
+	t.Run("invalid nonce should err", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/fees/1/by-nonce/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrCannotParseNonce.Error())
+	})
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetBlockFeesByNonceCalled: func(shardID uint32, nonce uint64) (*data.BlockFees, error) {
+				return nil, expectedErr
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/fees/1/by-nonce/37", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetBlockFees.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFees := &data.BlockFees{
+			Shard:           1,
+			Nonce:           37,
+			Hash:            "aabb",
+			AccumulatedFees: "1000",
+			DeveloperFees:   "300",
+		}
+		facade := &mock.FacadeStub{
+			GetBlockFeesByNonceCalled: func(shardID uint32, nonce uint64) (*data.BlockFees, error) {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint64(37), nonce)
+				return expectedFees, nil
+			},
+		}
+		networkGroup, err := groups.NewNetworkGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(networkGroup, networkPath)
+
+		req, _ := http.NewRequest("GET", "/network/fees/1/by-nonce/37", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &blockFeesResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedFees, response.Data.Fees)
+	})
+}
+
+func TestGetDelegationProviders_ShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("internal error")
+	facade := &mock.FacadeStub{
+		GetDelegationProvidersCalled: func() (*data.DelegationProvidersResponse, error) {
+			return nil, expectedErr
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/delegation-providers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	providers := data.DelegationProvidersResponse{}
+	loadResponse(resp.Body, &providers)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(providers.Error, expectedErr.Error()))
+}
+
+func TestGetDelegationProviders_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResp := &data.DelegationProvidersResponse{
+		Data: data.DelegationProviders{
+			Providers: []*data.DelegationProvider{{Contract: "erd1qqq", Name: "provider", ServiceFee: 1000, TotalActiveStake: "5", NumDelegators: 2}},
+			Timestamp: 1700000000,
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+	facade := &mock.FacadeStub{
+		GetDelegationProvidersCalled: func() (*data.DelegationProvidersResponse, error) {
+			return expectedResp, nil
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/delegation-providers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	providers := &data.DelegationProvidersResponse{}
+	loadResponse(resp.Body, providers)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResp, providers)
+}
@@ -1,11 +1,13 @@
 package groups
 
 import (
+	"math"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -27,9 +29,10 @@ func NewNodeGroup(facadeHandler data.FacadeHandler) (*nodeGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/heartbeatstatus", Handler: ng.getHeartbeatData, Method: http.MethodGet},
+		{Path: "/heartbeatstatus", Handler: ng.getHeartbeatData, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/heartbeatstatus/changes", Handler: ng.getHeartbeatChanges, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
 		{Path: "/old-storage-token/:token/nonce/:nonce", Handler: ng.isOldStorageForToken, Method: http.MethodGet},
-		{Path: "/waiting-epochs-left/:key", Handler: ng.waitingEpochsLeft, Method: http.MethodGet},
+		{Path: "/waiting-epochs-left/:key", Handler: ng.waitingEpochsLeft, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -47,6 +50,24 @@ func (group *nodeGroup) getHeartbeatData(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"heartbeats": heartbeatResults.Heartbeats}, "", data.ReturnCodeSuccess)
 }
 
+// getHeartbeatChanges will expose the heartbeats that changed since the moment provided in the since URL parameter, as
+// unix milliseconds. The timestamp from the response should be used as the since parameter of the next request
+func (group *nodeGroup) getHeartbeatChanges(c *gin.Context) {
+	since, err := parseUint64UrlParam(c, common.UrlParameterSince)
+	if err != nil || since.Value > math.MaxInt64 {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, apiErrors.ErrInvalidSinceParam)
+		return
+	}
+
+	heartbeatChanges, err := group.facade.GetHeartbeatChanges(int64(since.Value))
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, heartbeatChanges, "", data.ReturnCodeSuccess)
+}
+
 func (group *nodeGroup) isOldStorageForToken(c *gin.Context) {
 	// TODO: when the old storage tokens liquidity issue is solved on the protocol, mark this endpoint as deprecated
 	// and remove the processing code
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -202,3 +203,76 @@ func TestBaseNodeGroup_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 		assert.Equal(t, providedData, result.Data)
 	})
 }
+
+func TestHeartbeat_GetHeartbeatChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid since parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(nodeGroup, nodePath)
+
+		req, _ := http.NewRequest("GET", "/node/heartbeatstatus/changes?since=yesterday", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, apiResp.Error, apiErrors.ErrInvalidSinceParam.Error())
+	})
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetHeartbeatChangesCalled: func(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+				return nil, errors.New("heartbeat error")
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(nodeGroup, nodePath)
+
+		req, _ := http.NewRequest("GET", "/node/heartbeatstatus/changes", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetHeartbeatChangesCalled: func(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+				assert.Equal(t, int64(1700000000000), sinceMillis)
+				return &data.HeartbeatChangesResponse{
+					Heartbeats:        []data.PubKeyHeartbeat{{PublicKey: "pk1"}},
+					RemovedPublicKeys: []string{"pk2"},
+					Timestamp:         1700000025000,
+				}, nil
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(nodeGroup, nodePath)
+
+		req, _ := http.NewRequest("GET", "/node/heartbeatstatus/changes?since=1700000000000", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type heartbeatChangesApiResponse struct {
+			Data data.HeartbeatChangesResponse `json:"data"`
+			Code string                        `json:"code"`
+		}
+		var result heartbeatChangesApiResponse
+		loadResponse(resp.Body, &result)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "pk1", result.Data.Heartbeats[0].PublicKey)
+		assert.Equal(t, []string{"pk2"}, result.Data.RemovedPublicKeys)
+		assert.Equal(t, int64(1700000025000), result.Data.Timestamp)
+	})
+}
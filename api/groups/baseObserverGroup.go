@@ -0,0 +1,115 @@
+package groups
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const maxRawRequestBodySize = 10 * 1024 * 1024
+
+// skippedRawResponseHeaders holds the observer response headers that are not relayed, as they are either connection
+// specific or already handled by the proxy middlewares
+var skippedRawResponseHeaders = map[string]struct{}{
+	"Connection":        {},
+	"Keep-Alive":        {},
+	"Transfer-Encoding": {},
+	"Trailer":           {},
+	"Upgrade":           {},
+}
+
+type observerGroup struct {
+	facade ObserverFacadeHandler
+	*baseGroup
+}
+
+// NewObserverGroup returns a new instance of observerGroup
+func NewObserverGroup(facadeHandler data.FacadeHandler) (*observerGroup, error) {
+	facade, ok := facadeHandler.(ObserverFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	og := &observerGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:shard/raw/*path", Handler: og.forwardRawRequest, Method: http.MethodGet},
+		{Path: "/:shard/raw/*path", Handler: og.forwardRawRequest, Method: http.MethodPost},
+	}
+	og.baseGroup.endpoints = baseRoutesHandlers
+
+	return og, nil
+}
+
+// forwardRawRequest transparently forwards the request to an observer of the provided shard and relays its response
+func (og *observerGroup) forwardRawRequest(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrCannotParseShardID, err)
+		return
+	}
+
+	body, err := readRawRequestBody(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrValidation, err)
+		return
+	}
+
+	request := &data.RawObserverRequest{
+		Method:   c.Request.Method,
+		Path:     c.Param("path"),
+		RawQuery: c.Request.URL.RawQuery,
+		Header:   c.Request.Header,
+		Body:     body,
+	}
+	statusCode, resp, err := og.facade.ForwardRawObserverRequest(c.Request.Context(), shardID, request)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("observer group: close raw response body", "error", errNotCritical.Error())
+		}
+	}()
+
+	for name, values := range resp.Header {
+		if isSkippedRawResponseHeader(name) {
+			continue
+		}
+		c.Writer.Header()[name] = values
+	}
+	c.Status(statusCode)
+
+	_, err = io.Copy(c.Writer, resp.Body)
+	if err != nil {
+		log.Warn("observer group: relay raw response", "error", err.Error())
+	}
+}
+
+func readRawRequestBody(c *gin.Context) ([]byte, error) {
+	if c.Request.Body == nil {
+		return nil, nil
+	}
+
+	return io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxRawRequestBodySize))
+}
+
+func isSkippedRawResponseHeader(name string) bool {
+	_, isSkipped := skippedRawResponseHeaders[http.CanonicalHeaderKey(name)]
+	if isSkipped {
+		return true
+	}
+
+	return strings.HasPrefix(http.CanonicalHeaderKey(name), "Access-Control-")
+}
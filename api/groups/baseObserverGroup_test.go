@@ -0,0 +1,119 @@
+package groups_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const observerPath = "/observer"
+
+func TestNewObserverGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewObserverGroup(wrongFacade)
+
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestObserverGroup_ForwardRawRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ForwardRawObserverRequestCalled: func(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error) {
+				require.Fail(t, "should have not been called")
+				return 0, nil, nil
+			},
+		}
+		observerGroup, err := groups.NewObserverGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(observerGroup, observerPath)
+
+		req, _ := http.NewRequest("GET", "/observer/invalid/raw/node/status", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrCannotParseShardID.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("sending request error")
+		facade := &mock.FacadeStub{
+			ForwardRawObserverRequestCalled: func(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error) {
+				return http.StatusBadGateway, nil, expectedErr
+			},
+		}
+		observerGroup, err := groups.NewObserverGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(observerGroup, observerPath)
+
+		req, _ := http.NewRequest("GET", "/observer/0/raw/node/status", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadGateway, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should relay the observer response", func(t *testing.T) {
+		t.Parallel()
+
+		observerResponse := `{"data":{"new":"endpoint"},"error":"","code":"successful"}`
+		var providedRequest *data.RawObserverRequest
+		facade := &mock.FacadeStub{
+			ForwardRawObserverRequestCalled: func(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error) {
+				assert.Equal(t, uint32(4294967295), shardID)
+				providedRequest = request
+				return http.StatusAccepted, &http.Response{
+					StatusCode: http.StatusAccepted,
+					Header: http.Header{
+						"Content-Type":  []string{"application/json"},
+						"X-Node-Header": []string{"value"},
+						"Connection":    []string{"close"},
+					},
+					Body: io.NopCloser(strings.NewReader(observerResponse)),
+				}, nil
+			},
+		}
+		observerGroup, err := groups.NewObserverGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(observerGroup, observerPath)
+
+		req, _ := http.NewRequest("POST", "/observer/4294967295/raw/new/endpoint?with=param", bytes.NewBufferString(`{"key":"value"}`))
+		req.Header.Set("X-Client-Header", "client")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusAccepted, resp.Code)
+		assert.Equal(t, observerResponse, resp.Body.String())
+		assert.Equal(t, "value", resp.Header().Get("X-Node-Header"))
+		assert.Empty(t, resp.Header().Get("Connection"))
+
+		require.NotNil(t, providedRequest)
+		assert.Equal(t, http.MethodPost, providedRequest.Method)
+		assert.Equal(t, "/new/endpoint", providedRequest.Path)
+		assert.Equal(t, "with=param", providedRequest.RawQuery)
+		assert.Equal(t, "client", providedRequest.Header.Get("X-Client-Header"))
+		assert.Equal(t, []byte(`{"key":"value"}`), providedRequest.Body)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -51,7 +52,13 @@ func (pg *proofGroup) getProof(c *gin.Context) {
 		return
 	}
 
-	getProofResp, err := pg.facade.GetProof(rootHash, address)
+	verify, err := parseBoolUrlParam(c, common.UrlParameterVerify)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	getProofResp, err := pg.fetchProof(rootHash, address, verify)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -60,6 +67,14 @@ func (pg *proofGroup) getProof(c *gin.Context) {
 	c.JSON(http.StatusOK, getProofResp)
 }
 
+func (pg *proofGroup) fetchProof(rootHash string, address string, verify bool) (*data.GenericAPIResponse, error) {
+	if verify {
+		return pg.facade.GetVerifiedProof(rootHash, address)
+	}
+
+	return pg.facade.GetProof(rootHash, address)
+}
+
 func (pg *proofGroup) getProofDataTrie(c *gin.Context) {
 	rootHash := c.Param("roothash")
 	if rootHash == "" {
@@ -95,7 +110,13 @@ func (pg *proofGroup) getProofCurrentRootHash(c *gin.Context) {
 		return
 	}
 
-	getProofResp, err := pg.facade.GetProofCurrentRootHash(address)
+	verify, err := parseBoolUrlParam(c, common.UrlParameterVerify)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	getProofResp, err := pg.fetchProofCurrentRootHash(address, verify)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -104,6 +125,14 @@ func (pg *proofGroup) getProofCurrentRootHash(c *gin.Context) {
 	c.JSON(http.StatusOK, getProofResp)
 }
 
+func (pg *proofGroup) fetchProofCurrentRootHash(address string, verify bool) (*data.GenericAPIResponse, error) {
+	if verify {
+		return pg.facade.GetVerifiedProofCurrentRootHash(address)
+	}
+
+	return pg.facade.GetProofCurrentRootHash(address)
+}
+
 func (pg *proofGroup) verifyProof(c *gin.Context) {
 	proofParams := &data.VerifyProofRequest{}
 	err := c.ShouldBindJSON(proofParams)
@@ -260,3 +260,56 @@ func TestGetProofDataTrie(t *testing.T) {
 	assert.Equal(t, "valid", proof1)
 	assert.Equal(t, "proof", proof2)
 }
+
+func TestGetProof_WithVerify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid verify parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(proofGroup, "/proof")
+
+		req, _ := http.NewRequest("GET", "/proof/address/address?verify=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should call the verified variants", func(t *testing.T) {
+		t.Parallel()
+
+		verifiedData := map[string]interface{}{"proof": []interface{}{"valid", "proof"}, "verified": true}
+		facade := &mock.FacadeStub{
+			GetProofCalled: func(_ string, _ string) (*data.GenericAPIResponse, error) {
+				assert.Fail(t, "should have called the verified variant")
+				return nil, nil
+			},
+			GetVerifiedProofCalled: func(rootHash string, address string) (*data.GenericAPIResponse, error) {
+				assert.Equal(t, "rootHash", rootHash)
+				assert.Equal(t, "address", address)
+				return &data.GenericAPIResponse{Data: verifiedData}, nil
+			},
+			GetVerifiedProofCurrentRootHashCalled: func(address string) (*data.GenericAPIResponse, error) {
+				assert.Equal(t, "address", address)
+				return &data.GenericAPIResponse{Data: verifiedData}, nil
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(proofGroup, "/proof")
+
+		for _, path := range []string{"/proof/root-hash/rootHash/address/address?verify=true", "/proof/address/address?verify=true"} {
+			req, _ := http.NewRequest("GET", path, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := Response{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusOK, resp.Code, path)
+			assert.Equal(t, true, response.Data["verified"], path)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type proxyGroup struct {
+	facade ProxyFacadeHandler
+	*baseGroup
+}
+
+// NewProxyGroup returns a new instance of proxyGroup
+func NewProxyGroup(facadeHandler data.FacadeHandler) (*proxyGroup, error) {
+	facade, ok := facadeHandler.(ProxyFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	pg := &proxyGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/public-key", Handler: pg.getPublicKey, Method: http.MethodGet, Cacheability: data.CacheabilityLongLived},
+	}
+	pg.baseGroup.endpoints = baseRoutesHandlers
+
+	return pg, nil
+}
+
+// getPublicKey returns the public key that can be used for verifying the signatures of the proxy responses
+func (pg *proxyGroup) getPublicKey(c *gin.Context) {
+	publicKey, err := pg.facade.GetProxyPublicKey()
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.JSON(http.StatusOK, publicKey)
+}
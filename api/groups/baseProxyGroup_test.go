@@ -0,0 +1,103 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type proxyPublicKeyResponse struct {
+	Data  data.ProxyPublicKey `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
+func TestNewProxyGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		wrongFacade := &mock.WrongFacade{}
+		group, err := groups.NewProxyGroup(wrongFacade)
+		require.Nil(t, group)
+		require.Equal(t, groups.ErrWrongTypeAssertion, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		group, err := groups.NewProxyGroup(&mock.FacadeStub{})
+		require.Nil(t, err)
+		require.NotNil(t, group)
+	})
+}
+
+func TestProxyGroup_GetPublicKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("error")
+		facade := &mock.FacadeStub{
+			GetProxyPublicKeyCalled: func() (*data.GenericAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		proxyGroup, err := groups.NewProxyGroup(facade)
+		require.NoError(t, err)
+
+		ws := startProxyServer(proxyGroup, "/proxy")
+
+		req, _ := http.NewRequest("GET", "/proxy/public-key", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := proxyPublicKeyResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, apiResp.Error, expectedErr.Error())
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedKey := data.ProxyPublicKey{
+			PublicKey:       "aabbcc",
+			SignatureScheme: "ed25519",
+			SignatureHeader: "X-Proxy-Signature",
+		}
+		facade := &mock.FacadeStub{
+			GetProxyPublicKeyCalled: func() (*data.GenericAPIResponse, error) {
+				return &data.GenericAPIResponse{
+					Data: expectedKey,
+					Code: data.ReturnCodeSuccess,
+				}, nil
+			},
+		}
+		proxyGroup, err := groups.NewProxyGroup(facade)
+		require.NoError(t, err)
+
+		ws := startProxyServer(proxyGroup, "/proxy")
+
+		req, _ := http.NewRequest("GET", "/proxy/public-key", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := proxyPublicKeyResponse{}
+		loadResponse(resp.Body, &apiResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedKey, apiResp.Data)
+		assert.Empty(t, apiResp.Error)
+	})
+}
@@ -26,8 +26,9 @@ func NewStatusGroup(facadeHandler data.FacadeHandler) (*statusGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/metrics", Handler: ng.getMetrics, Method: http.MethodGet},
-		{Path: "/prometheus-metrics", Handler: ng.getPrometheusMetrics, Method: http.MethodGet},
+		{Path: "/metrics", Handler: ng.getMetrics, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/prometheus-metrics", Handler: ng.getPrometheusMetrics, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/caches", Handler: ng.getCachesMetrics, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -41,6 +42,13 @@ func (group *statusGroup) getMetrics(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"metrics": metricsResults}, "", data.ReturnCodeSuccess)
 }
 
+// getCachesMetrics will expose the hits, the misses and the evictions of each cache in json format
+func (group *statusGroup) getCachesMetrics(c *gin.Context) {
+	cachesMetrics := group.facade.GetCachesMetrics()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"caches": cachesMetrics}, "", data.ReturnCodeSuccess)
+}
+
 // getPrometheusMetrics will expose proxy metrics in prometheus format
 func (group *statusGroup) getPrometheusMetrics(c *gin.Context) {
 	metricsResults := group.facade.GetMetricsForPrometheus()
@@ -88,3 +88,38 @@ func TestGetPrometheusMetrics_ShouldWork(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.Code)
 	require.Equal(t, expectedMetrics, string(bodyBytes))
 }
+
+func TestGetCachesMetrics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedMetrics := map[string]*data.CacheMetrics{
+		"sc-query": {
+			NumHits:      3,
+			NumMisses:    1,
+			NumEvictions: 2,
+			HitRatio:     0.75,
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetCachesMetricsCalled: func() map[string]*data.CacheMetrics {
+			return expectedMetrics
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(statusGroup, statusPath)
+
+	req, _ := http.NewRequest("GET", "/status/caches", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp struct {
+		Data struct {
+			Caches map[string]*data.CacheMetrics `json:"caches"`
+		}
+	}
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, expectedMetrics, apiResp.Data.Caches)
+}
@@ -1,15 +1,20 @@
 package groups
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"golang.org/x/net/websocket"
 )
 
 type transactionGroup struct {
@@ -31,14 +36,24 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/send", Handler: tg.sendTransaction, Method: http.MethodPost},
+		{Path: "/send-raw", Handler: tg.sendRawTransaction, Method: http.MethodPost},
 		{Path: "/simulate", Handler: tg.simulateTransaction, Method: http.MethodPost},
 		{Path: "/send-multiple", Handler: tg.sendMultipleTransactions, Method: http.MethodPost},
 		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost},
+		{Path: "/send-managed", Handler: tg.sendManagedTransaction, Method: http.MethodPost},
+		{Path: "/nonces/reserve", Handler: tg.reserveNonces, Method: http.MethodPost},
+		{Path: "/nonces/release", Handler: tg.releaseNonces, Method: http.MethodPost},
+		{Path: "/webhooks/watch", Handler: tg.watchTransaction, Method: http.MethodPost},
+		{Path: "/webhooks/watchlist", Handler: tg.watchAddresses, Method: http.MethodPost},
+		{Path: "/webhooks/watchlist/:webhook", Handler: tg.getWatchedAddresses, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/webhooks/watchlist/:webhook/:address", Handler: tg.unwatchAddress, Method: http.MethodDelete},
+		{Path: "/status-stream", Handler: tg.getTransactionsStatusStream, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
 		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost},
-		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet},
-		{Path: "/:txhash/process-status", Handler: tg.getProcessedTransactionStatus, Method: http.MethodGet},
-		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet},
-		{Path: "/pool", Handler: tg.getTransactionsPool, Method: http.MethodGet},
+		{Path: "/fee", Handler: tg.computeTransactionFee, Method: http.MethodPost},
+		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/:txhash/process-status", Handler: tg.getProcessedTransactionStatus, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
+		{Path: "/pool", Handler: tg.getTransactionsPool, Method: http.MethodGet, Cacheability: data.CacheabilityNoStore},
 	}
 	tg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -60,7 +75,75 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
+	options, err := parseTransactionSendOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	if options.Wait && !group.facade.IsTransactionWaitEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			errors.ErrTransactionWaitNotEnabled.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
 	statusCode, txHash, err := group.facade.SendTransaction(&tx)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), getSendTransactionReturnCode(statusCode))
+		return
+	}
+
+	if !options.Wait {
+		shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
+		return
+	}
+
+	// the transaction was already sent, so its hash is returned even if the waiting fails
+	execution, err := group.facade.WaitForTransactionExecution(c.Request.Context(), txHash, options.Timeout)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			gin.H{"txHash": txHash},
+			fmt.Sprintf("%s: %s", errors.ErrWaitForTransactionExecution.Error(), err.Error()),
+			data.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash, "execution": execution}, "", data.ReturnCodeSuccess)
+}
+
+// getSendTransactionReturnCode returns the specific return code of the transactions rejected for using an already
+// used nonce, which are the only ones answered with 409 Conflict
+func getSendTransactionReturnCode(statusCode int) data.ReturnCode {
+	if statusCode == http.StatusConflict {
+		return data.ReturnCodeNonceAlreadyUsed
+	}
+
+	return data.ReturnCodeInternalError
+}
+
+// sendRawTransaction will receive the signed transaction bytes, as produced by the protocol marshalizer, and propagate
+// the transaction for processing
+func (group *transactionGroup) sendRawTransaction(c *gin.Context) {
+	txBytes, err := io.ReadAll(c.Request.Body)
+	if err != nil || len(txBytes) == 0 {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyRawTransaction.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	statusCode, txHash, err := group.facade.SendRawTransaction(txBytes)
 	if err != nil {
 		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -69,6 +152,282 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
 }
 
+// sendManagedTransaction will receive an unsigned transaction of a hosted sender, for which the proxy assigns the nonce,
+// signs it and relays it to the observers
+func (group *transactionGroup) sendManagedTransaction(c *gin.Context) {
+	if !group.facade.IsNonceManagerEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			errors.ErrNonceManagerNotEnabled.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	var tx = data.Transaction{}
+	err := c.ShouldBindJSON(&tx)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	statusCode, txHash, err := group.facade.SendManagedTransaction(&tx)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash, "nonce": tx.Nonce}, "", data.ReturnCodeSuccess)
+}
+
+// reserveNonces will reserve a range of nonces of a hosted sender, for the workers signing its transactions themselves
+func (group *transactionGroup) reserveNonces(c *gin.Context) {
+	if !group.facade.IsNonceManagerEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			errors.ErrNonceManagerNotEnabled.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	var request = data.NonceReservationRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	statusCode, reservation, err := group.facade.ReserveNonces(&request)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"reservation": reservation}, "", data.ReturnCodeSuccess)
+}
+
+// releaseNonces will release a nonces reservation of a hosted sender, giving back its unused nonces
+func (group *transactionGroup) releaseNonces(c *gin.Context) {
+	if !group.facade.IsNonceManagerEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			errors.ErrNonceManagerNotEnabled.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	var request = data.NonceReleaseRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	statusCode, err := group.facade.ReleaseNonces(&request)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"released": true}, "", data.ReturnCodeSuccess)
+}
+
+// watchTransaction registers a transaction, by hash, for a configured webhook, which will be notified once the
+// transaction reaches a final status
+func (group *transactionGroup) watchTransaction(c *gin.Context) {
+	if !group.checkWebhooksEnabled(c) {
+		return
+	}
+
+	var request = data.WebhookWatchRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	err = group.facade.WatchTransaction(request.Webhook, request.TxHash)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrWatchTransaction.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"webhook": request.Webhook, "txHash": request.TxHash}, "", data.ReturnCodeSuccess)
+}
+
+// watchAddresses registers addresses on the watchlist of a configured webhook, which will be notified each time the
+// balance or the nonce of one of them changes
+func (group *transactionGroup) watchAddresses(c *gin.Context) {
+	if !group.checkWebhooksEnabled(c) {
+		return
+	}
+
+	var request = data.AddressWatchlistRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	err = group.facade.WatchAddresses(request.Webhook, request.Addresses)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrWatchAddresses.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"webhook": request.Webhook, "addresses": request.Addresses}, "", data.ReturnCodeSuccess)
+}
+
+// getWatchedAddresses returns the addresses on the watchlist of a configured webhook
+func (group *transactionGroup) getWatchedAddresses(c *gin.Context) {
+	if !group.checkWebhooksEnabled(c) {
+		return
+	}
+
+	webhook := c.Param("webhook")
+	addresses, err := group.facade.GetWatchedAddresses(webhook)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetWatchedAddresses.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"webhook": webhook, "addresses": addresses}, "", data.ReturnCodeSuccess)
+}
+
+// unwatchAddress removes an address from the watchlist of a configured webhook
+func (group *transactionGroup) unwatchAddress(c *gin.Context) {
+	if !group.checkWebhooksEnabled(c) {
+		return
+	}
+
+	webhook := c.Param("webhook")
+	address := c.Param("address")
+	err := group.facade.UnwatchAddress(webhook, address)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrUnwatchAddress.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"webhook": webhook, "address": address}, "", data.ReturnCodeSuccess)
+}
+
+func (group *transactionGroup) checkWebhooksEnabled(c *gin.Context) bool {
+	if group.facade.IsWebhooksEnabled() {
+		return true
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusBadRequest,
+		nil,
+		errors.ErrWebhooksNotEnabled.Error(),
+		data.ReturnCodeRequestError,
+	)
+	return false
+}
+
+// getTransactionsStatusStream upgrades the connection to a WebSocket one and writes the final status of each of the
+// transactions provided as a comma separated list, as a JSON message, as soon as it is known. The connection is closed
+// once all the statuses were written
+func (group *transactionGroup) getTransactionsStatusStream(c *gin.Context) {
+	hashesParam := parseStringUrlParam(c, common.UrlParameterHashes)
+	if len(hashesParam) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrWatchTransactionsStatus, errors.ErrEmptyTransactionsHashes)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	chanEvents, err := group.facade.WatchTransactionsStatus(ctx, strings.Split(hashesParam, ","))
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrWatchTransactionsStatus, err)
+		return
+	}
+
+	// the origin is not checked, as the stream only exposes public data
+	server := websocket.Server{
+		Handler: func(conn *websocket.Conn) {
+			go func() {
+				// the clients are not expected to send anything, so a read error means the connection was closed
+				_, _ = io.Copy(io.Discard, conn)
+				cancel()
+			}()
+
+			for event := range chanEvents {
+				errSend := websocket.JSON.Send(conn, event)
+				if errSend != nil {
+					log.Debug("getTransactionsStatusStream: cannot send event", "error", errSend.Error())
+					return
+				}
+			}
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
 // sendUserFunds will receive an address from the client and propagate a transaction for sending some ERD to that address
 func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 	if !group.facade.IsFaucetEnabled() {
@@ -110,7 +469,8 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"message": "ok"}, "", data.ReturnCodeSuccess)
 }
 
-// sendMultipleTransactions will send multiple transactions at once
+// sendMultipleTransactions will send multiple transactions at once. On dry-run, the transactions are only checked and
+// optionally simulated, returning a verdict for each of them
 func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	var txs []*data.Transaction
 	err := c.ShouldBindJSON(&txs)
@@ -125,6 +485,17 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
+	dryRun, dryRunOptions, err := parseTransactionsDryRunOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	if dryRun {
+		dryRunResponse := group.facade.DryRunMultipleTransactions(txs, dryRunOptions)
+		shared.RespondWith(c, http.StatusOK, dryRunResponse, "", data.ReturnCodeSuccess)
+		return
+	}
+
 	response, err := group.facade.SendMultipleTransactions(txs)
 	if err != nil {
 		shared.RespondWith(
@@ -143,6 +514,7 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		gin.H{
 			"numOfSentTxs": response.NumOfTxs,
 			"txsHashes":    response.TxsHashes,
+			"failedTxs":    response.FailedTxs,
 		},
 		"",
 		data.ReturnCodeSuccess,
@@ -197,6 +569,23 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 		return
 	}
 
+	options, err := parseTransactionCostOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	if options.WithDetails {
+		detailedCost, errCost := group.facade.TransactionCostDetailedRequest(&tx)
+		if errCost != nil {
+			shared.RespondWith(c, http.StatusInternalServerError, nil, errCost.Error(), data.ReturnCodeInternalError)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, detailedCost, "", data.ReturnCodeSuccess)
+		return
+	}
+
 	cost, err := group.facade.TransactionCostRequest(&tx)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
@@ -206,6 +595,34 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, cost, "", data.ReturnCodeSuccess)
 }
 
+// computeTransactionFee will return the fee of a transaction, computed by the proxy from the network config
+func (group *transactionGroup) computeTransactionFee(c *gin.Context) {
+	var tx = data.Transaction{}
+	err := c.ShouldBindJSON(&tx)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	fee, statusCode, err := group.facade.ComputeTransactionFee(&tx)
+	if err != nil {
+		returnCode := data.ReturnCodeInternalError
+		if statusCode == http.StatusBadRequest {
+			returnCode = data.ReturnCodeRequestError
+		}
+		shared.RespondWith(c, statusCode, nil, err.Error(), returnCode)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, fee, "", data.ReturnCodeSuccess)
+}
+
 // getTransactionStatus will return the transaction's status
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
@@ -234,8 +651,13 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 	}
 
 	sndAddr := c.Request.URL.Query().Get("sender")
+	if shared.IsProtobufRequested(c) {
+		group.getTransactionProtobuf(c, txHash, sndAddr)
+		return
+	}
+
 	if sndAddr != "" {
-		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, options.WithResults)
+		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, options)
 		return
 	}
 
@@ -245,7 +667,45 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
+	response, err := createTransactionResponse(group.facade, tx, options)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
+// createTransactionResponse returns the response data of the transaction. If requested and the transaction is the
+// inner transaction of a relayed one, the wrapping relayed transaction is returned as well, under the
+// relayedTransaction field. Locating it costs a lookup of the original transaction on all the shards
+func createTransactionResponse(facade TransactionFacadeHandler, tx *transaction.ApiTransactionResult, options common.TransactionQueryOptions) (gin.H, error) {
+	response := gin.H{"transaction": tx}
+	if !options.WithRelayed {
+		return response, nil
+	}
+
+	relayedTx, err := facade.GetRelayedTransactionOfInner(tx, options.WithResults)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errors.ErrGetRelayedTransaction, err.Error())
+	}
+	if relayedTx != nil {
+		response["relayedTransaction"] = relayedTx
+	}
+
+	return response, nil
+}
+
+// getTransactionProtobuf writes the protocol representation of the transaction, which holds neither its status nor
+// its results
+func (group *transactionGroup) getTransactionProtobuf(c *gin.Context, txHash string, sndAddr string) {
+	txBytes, err := group.facade.GetTransactionProtobuf(txHash, sndAddr)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWithProtobuf(c, txBytes)
 }
 
 func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
@@ -264,8 +724,8 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"status": status.Status, "reason": status.Reason}, "", data.ReturnCodeSuccess)
 }
 
-func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
-	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, options common.TransactionQueryOptions) {
+	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, options.WithResults)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
 		if statusCode == http.StatusBadRequest {
@@ -275,7 +735,13 @@ func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHa
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
+	response, err := createTransactionResponse(ef, tx, options)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
 // getTransactionsPool should return transactions from pool
@@ -294,7 +760,7 @@ func (group *transactionGroup) getTransactionsPool(c *gin.Context) {
 
 	if options.Sender == "" {
 		if options.ShardID == "" {
-			getTxPool(c, group.facade, options.Fields)
+			getTxPool(c, group.facade, options.Fields, options.Cursor)
 			return
 		}
 
@@ -303,7 +769,7 @@ func (group *transactionGroup) getTransactionsPool(c *gin.Context) {
 			shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrBadUrlParams.Error(), data.ReturnCodeRequestError)
 			return
 		}
-		getTxPoolForShard(c, group.facade, uint32(shardID), options.Fields)
+		getTxPoolForShard(c, group.facade, uint32(shardID), options.Fields, options.Cursor)
 		return
 	}
 
@@ -364,24 +830,95 @@ func validateFields(fields string) error {
 	return nil
 }
 
-func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
-	txPool, err := ef.GetTransactionsPool(fields)
+func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string, cursor uint64) {
+	txPool, err := ef.GetTransactionsPool(c.Request.Context(), fields, cursor)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
+	format := shared.NegotiateListFormat(c)
+	if shared.IsListExportFormat(format) {
+		shared.RespondWithList(c, format, txPoolExportRows(txPool))
+		return
+	}
+
 	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPool}, "", data.ReturnCodeSuccess)
 }
 
-func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string) {
-	txPool, err := ef.GetTransactionsPoolForShard(shardID, fields)
+// getTxPoolForShard relays the transactions pool response of the observer as it is received, as it can be very large.
+// The requests continuing a truncated response are decoded instead, in order to skip the already delivered transactions
+func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string, cursor uint64) {
+	format := shared.NegotiateListFormat(c)
+	if shared.IsListExportFormat(format) {
+		exportTxPoolForShard(c, ef, shardID, fields, cursor, format)
+		return
+	}
+	if cursor > 0 {
+		txPool, err := ef.GetTransactionsPoolForShard(c.Request.Context(), shardID, fields, cursor)
+		if err != nil {
+			shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPool}, "", data.ReturnCodeSuccess)
+		return
+	}
+
+	txPoolBody, err := ef.GetTransactionsPoolForShardStream(c.Request.Context(), shardID, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPool}, "", data.ReturnCodeSuccess)
+	defer func() {
+		errNotCritical := txPoolBody.Close()
+		if errNotCritical != nil {
+			log.Warn("getTxPoolForShard: close body", "error", errNotCritical.Error())
+		}
+	}()
+
+	c.DataFromReader(http.StatusOK, -1, gin.MIMEJSON, txPoolBody, nil)
+}
+
+// exportTxPoolForShard writes the transactions pool of the shard in the requested export format. Unlike the JSON
+// response, the observer response has to be decoded, in order to be converted
+func exportTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string, cursor uint64, format string) {
+	txPool, err := ef.GetTransactionsPoolForShard(c.Request.Context(), shardID, fields, cursor)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWithList(c, format, txPoolExportRows(txPool))
+}
+
+// txPoolExportRows returns the transactions of the pool as a single list, each transaction holding its pool category
+func txPoolExportRows(txPool *data.TransactionsPool) []map[string]interface{} {
+	if txPool == nil {
+		return make([]map[string]interface{}, 0)
+	}
+
+	rows := make([]map[string]interface{}, 0, len(txPool.RegularTransactions)+len(txPool.SmartContractResults)+len(txPool.Rewards))
+	rows = appendTxPoolExportRows(rows, txPool.RegularTransactions, "regularTransaction")
+	rows = appendTxPoolExportRows(rows, txPool.SmartContractResults, "smartContractResult")
+	rows = appendTxPoolExportRows(rows, txPool.Rewards, "reward")
+
+	return rows
+}
+
+func appendTxPoolExportRows(rows []map[string]interface{}, txs []data.WrappedTransaction, category string) []map[string]interface{} {
+	for _, tx := range txs {
+		row := make(map[string]interface{}, len(tx.TxFields)+1)
+		for field, value := range tx.TxFields {
+			row[field] = value
+		}
+		row["category"] = category
+
+		rows = append(rows, row)
+	}
+
+	return rows
 }
 
 func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
@@ -411,5 +948,11 @@ func getTxPoolForSender(c *gin.Context, ef TransactionFacadeHandler, sender, fie
 		return
 	}
 
+	format := shared.NegotiateListFormat(c)
+	if shared.IsListExportFormat(format) && txPool != nil {
+		shared.RespondWithList(c, format, appendTxPoolExportRows(nil, txPool.Transactions, "regularTransaction"))
+		return
+	}
+
 	shared.RespondWith(c, http.StatusOK, gin.H{"txPool": txPool}, "", data.ReturnCodeSuccess)
 }
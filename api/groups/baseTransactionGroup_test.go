@@ -2,19 +2,28 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
 )
 
 const transactionsPath = "/transaction"
@@ -157,6 +166,30 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 	assert.Contains(t, response.Error, errorString)
 }
 
+func TestSendTransaction_NonceAlreadyUsedShouldReturnSpecificCode(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusConflict, "", apiErrors.ErrNonceAlreadyUsed
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(`{"sender":"aa", "receiver":"bb", "nonce":3}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := data.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+	assert.Equal(t, data.ReturnCodeNonceAlreadyUsed, response.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrNonceAlreadyUsed.Error())
+}
+
 func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
@@ -199,6 +232,105 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, string(data.ReturnCodeSuccess), response.GeneralResponse.Code)
 }
 
+func TestSendTransaction_WaitForExecution(t *testing.T) {
+	t.Parallel()
+
+	txJson := `{"nonce": 1, "sender": "erd1sender", "receiver": "erd1receiver", "value": "10", "signature": "aabbccdd"}`
+	sendTransaction := func(facade *mock.FacadeStub, url string) (*httptest.ResponseRecorder, *data.GenericAPIResponse) {
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", url, bytes.NewBuffer([]byte(txJson)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		return resp, response
+	}
+
+	t.Run("invalid timeout should error before sending", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsTransactionWaitEnabledCalled: func() bool {
+				return true
+			},
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				require.Fail(t, "should have not been called")
+				return 0, "", nil
+			},
+		}
+		for _, url := range []string{"/transaction/send?wait=maybe", "/transaction/send?wait=true&timeout=30", "/transaction/send?wait=true&timeout=-1s"} {
+			resp, response := sendTransaction(facade, url)
+			assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+			assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error(), url)
+		}
+	})
+	t.Run("wait not enabled should error before sending", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				require.Fail(t, "should have not been called")
+				return 0, "", nil
+			},
+		}
+		resp, response := sendTransaction(facade, "/transaction/send?wait=true")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrTransactionWaitNotEnabled.Error(), response.Error)
+	})
+	t.Run("wait error should return the hash", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			IsTransactionWaitEnabledCalled: func() bool {
+				return true
+			},
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusOK, "hash", nil
+			},
+			WaitForTransactionExecutionCalled: func(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error) {
+				return nil, expectedErr
+			},
+		}
+		resp, response := sendTransaction(facade, "/transaction/send?wait=true")
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrWaitForTransactionExecution.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+		assert.Equal(t, map[string]interface{}{"txHash": "hash"}, response.Data)
+	})
+	t.Run("should return the execution result", func(t *testing.T) {
+		t.Parallel()
+
+		var providedTimeout time.Duration
+		facade := &mock.FacadeStub{
+			IsTransactionWaitEnabledCalled: func() bool {
+				return true
+			},
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusOK, "hash", nil
+			},
+			WaitForTransactionExecutionCalled: func(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error) {
+				assert.Equal(t, "hash", txHash)
+				providedTimeout = timeout
+				return &data.TransactionExecutionResult{Status: "success"}, nil
+			},
+		}
+		resp, response := sendTransaction(facade, "/transaction/send?wait=true&timeout=30s")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, 30*time.Second, providedTimeout)
+		assert.Equal(t, map[string]interface{}{
+			"txHash":    "hash",
+			"execution": map[string]interface{}{"status": "success", "timedOut": false},
+		}, response.Data)
+	})
+}
+
 func TestSimulateTransaction_WrongParametersShouldErrorOnValidation(t *testing.T) {
 	t.Parallel()
 
@@ -318,6 +450,119 @@ func TestSimulateTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, expectedResult.Data, response.Data)
 }
 
+func TestRequestTransactionCost(t *testing.T) {
+	t.Parallel()
+
+	jsonStr := `{"nonce": 1, "sender": "sender", "receiver": "receiver", "value": "10", "signature": "aabbccdd"}`
+
+	t.Run("invalid withDetails parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/cost?withDetails=not-a-bool", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+
+	t.Run("detailed cost facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			TransactionCostDetailedRequestCalled: func(tx *data.Transaction) (*data.TxCostDetailedResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/cost?withDetails=true", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+
+	t.Run("should return the detailed cost", func(t *testing.T) {
+		t.Parallel()
+
+		expectedCost := &data.TxCostDetailedResponse{
+			TxCost:     1500000,
+			ReturnData: [][]byte{[]byte("ok")},
+			GasBreakdown: []*data.SCRGasBreakdown{
+				{Hash: "scr1", Sender: "sender", Receiver: "contract", GasLimit: 500000, GasPrice: 1000000000},
+			},
+		}
+		facade := &mock.FacadeStub{
+			TransactionCostRequestHandler: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+			TransactionCostDetailedRequestCalled: func(tx *data.Transaction) (*data.TxCostDetailedResponse, error) {
+				return expectedCost, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/cost?withDetails=true", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			GeneralResponse
+			Data data.TxCostDetailedResponse `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedCost, response.Data)
+	})
+
+	t.Run("should return the regular cost", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			TransactionCostRequestHandler: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{TxCost: 50000}, nil
+			},
+			TransactionCostDetailedRequestCalled: func(tx *data.Transaction) (*data.TxCostDetailedResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/cost", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.ResponseTxCost{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint64(50000), response.Data.TxCost)
+	})
+}
+
 func TestSendMultipleTransactions_WrongParametersShouldErrorOnValidation(t *testing.T) {
 	t.Parallel()
 
@@ -400,156 +645,928 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, uint64(10), response.Data.Num)
 }
 
-func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
+type multiTxsDryRunResponse struct {
+	Data  data.MultipleTransactionsDryRunResponseData `json:"data"`
+	Error string                                      `json:"error"`
+	Code  string                                      `json:"code"`
+}
+
+func TestSendRawTransaction(t *testing.T) {
 	t.Parallel()
 
-	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
-	errorString := "send user funds error"
+	t.Run("empty body should error", func(t *testing.T) {
+		t.Parallel()
 
-	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			return errors.New(errorString)
-		},
-	}
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	transactionsGroup, err := groups.NewTransactionGroup(facade)
-	require.NoError(t, err)
-	ws := startProxyServer(transactionsGroup, transactionsPath)
+		req, _ := http.NewRequest("POST", "/transaction/send-raw", bytes.NewBuffer(nil))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	jsonStr := fmt.Sprintf(
-		`{"receiver":"%s"}`, receiver)
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
 
-	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrEmptyRawTransaction.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		expectedErr := errors.New("invalid raw transaction")
+		facade := &mock.FacadeStub{
+			SendRawTransactionCalled: func(txBytes []byte) (int, string, error) {
+				return http.StatusBadRequest, "", expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send-raw", bytes.NewBuffer([]byte{0x08, 0x01}))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should return the transaction hash", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendRawTransactionCalled: func(txBytes []byte) (int, string, error) {
+				assert.Equal(t, []byte{0x08, 0x01}, txBytes)
+				return http.StatusOK, "hash", nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send-raw", bytes.NewBuffer([]byte{0x08, 0x01}))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				TxHash string `json:"txHash"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "hash", response.Data.TxHash)
+	})
+}
+
+func TestComputeTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	jsonStr := `{"nonce": 1, "sender": "sender", "receiver": "receiver", "value": "10", "gasLimit": 50000, "gasPrice": 1000000000}`
+
+	t.Run("invalid fee should be a bad request", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("insufficient gas limit")
+		facade := &mock.FacadeStub{
+			ComputeTransactionFeeCalled: func(tx *data.Transaction) (*data.TransactionFeeResponseData, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, data.ReturnCodeRequestError, response.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+
+	t.Run("should return the fee", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFee := data.TransactionFeeResponseData{Fee: "50000000000000", GasLimit: 50000, GasPrice: 1000000000, MoveBalanceGas: 50000}
+		facade := &mock.FacadeStub{
+			ComputeTransactionFeeCalled: func(tx *data.Transaction) (*data.TransactionFeeResponseData, int, error) {
+				assert.Equal(t, uint64(50000), tx.GasLimit)
+				return &expectedFee, http.StatusOK, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/fee", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			GeneralResponse
+			Data data.TransactionFeeResponseData `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedFee, response.Data)
+	})
+}
+
+func TestSendMultipleTransactions_DryRun(t *testing.T) {
+	t.Parallel()
+
+	jsonStr := `[{"nonce": 1, "sender": "erd1sender", "receiver": "erd1receiver", "value": "10", "signature": "aabb"}, {"nonce": 2}]`
+	t.Run("simulate without dry-run should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+				require.Fail(t, "should have not been called")
+				return data.MultipleTransactionsResponseData{}, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send-multiple?simulate=true", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := multiTxsDryRunResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("dry-run should not send the transactions", func(t *testing.T) {
+		t.Parallel()
+
+		senderShard := uint32(1)
+		expectedResponse := &data.MultipleTransactionsDryRunResponseData{
+			NumValid:   1,
+			NumInvalid: 1,
+			Verdicts: []*data.TransactionDryRunVerdict{
+				{Index: 0, Valid: true, Hash: "hash0", SenderShard: &senderShard},
+				{Index: 1, Error: "invalid sender address"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			SendMultipleTransactionsHandler: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+				require.Fail(t, "should have not been called")
+				return data.MultipleTransactionsResponseData{}, nil
+			},
+			DryRunMultipleTransactionsCalled: func(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData {
+				assert.Len(t, txs, 2)
+				assert.Equal(t, common.TransactionsDryRunOptions{Simulate: true, CheckSignature: false}, options)
+				return expectedResponse
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/send-multiple?dryRun=true&simulate=true&checkSignature=false", bytes.NewBuffer([]byte(jsonStr)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := multiTxsDryRunResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedResponse, response.Data)
+	})
+}
+
+func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	errorString := "send user funds error"
+
+	facade := &mock.FacadeStub{
+		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+			return errors.New(errorString)
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(
+		`{"receiver":"%s"}`, receiver)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, errorString)
+}
+
+func TestSendUserFunds_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	facade := &mock.FacadeStub{
+		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+			return nil
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(
+		`{"receiver":"%s"}`, receiver)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, response.Error, "")
+}
+
+func TestSendUserFunds_NilValue(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	var callValue *big.Int
+	facade := &mock.FacadeStub{
+		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+			callValue = value
+			return nil
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(
+		`{"receiver":"%s"}`, receiver)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Nil(t, callValue)
+}
+
+func TestSendUserFunds_CorrectValue(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	var callValue *big.Int
+	facade := &mock.FacadeStub{
+		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+			callValue = value
+			return nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	expectedValue, _ := big.NewInt(0).SetString("100000000000000", 10)
+	jsonStr := fmt.Sprintf(
+		`{"receiver":"%s", "value": %d}`, receiver, expectedValue)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, expectedValue, callValue)
+}
+
+func TestSendUserFunds_FaucetNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	facade := &mock.FacadeStub{
+		IsFaucetEnabledHandler: func() bool {
+			return false
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	value := "100000000000000"
+	jsonStr := fmt.Sprintf(
+		`{"receiver":"%s", "value": %s}`, receiver, value)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, apiErrors.ErrFaucetNotEnabled.Error(), response.Error)
+}
+
+func TestSendManagedTransaction_NonceManagerNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-managed", bytes.NewBuffer([]byte(`{"sender":"erd1sender"}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrNonceManagerNotEnabled.Error(), response.Error)
+}
+
+func TestSendManagedTransaction_ErrorWhenFacadeSendManagedTransactionError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("sender not managed")
+	facade := &mock.FacadeStub{
+		IsNonceManagerEnabledCalled: func() bool {
+			return true
+		},
+		SendManagedTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusBadRequest, "", expectedErr
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-managed", bytes.NewBuffer([]byte(`{"sender":"erd1sender"}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestSendManagedTransaction_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		IsNonceManagerEnabledCalled: func() bool {
+			return true
+		},
+		SendManagedTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			assert.Equal(t, "erd1sender", tx.Sender)
+			tx.Nonce = 7
+			return http.StatusOK, "hash", nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-managed", bytes.NewBuffer([]byte(`{"sender":"erd1sender"}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			TxHash string `json:"txHash"`
+			Nonce  uint64 `json:"nonce"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "hash", response.Data.TxHash)
+	assert.Equal(t, uint64(7), response.Data.Nonce)
+}
+
+func TestReserveNonces(t *testing.T) {
+	t.Parallel()
+
+	requestBody := `{"sender":"erd1sender","numNonces":10,"ttlInSec":30}`
+
+	t.Run("nonce manager not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/nonces/reserve", bytes.NewBuffer([]byte(requestBody)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrNonceManagerNotEnabled.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("sender not managed")
+		facade := &mock.FacadeStub{
+			IsNonceManagerEnabledCalled: func() bool {
+				return true
+			},
+			ReserveNoncesCalled: func(request *data.NonceReservationRequest) (int, *data.NonceReservation, error) {
+				return http.StatusBadRequest, nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/nonces/reserve", bytes.NewBuffer([]byte(requestBody)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should return the reservation", func(t *testing.T) {
+		t.Parallel()
+
+		expectedReservation := data.NonceReservation{ReservationID: "id", Sender: "erd1sender", FromNonce: 5, ToNonce: 14, ExpiresAt: 1000}
+		facade := &mock.FacadeStub{
+			IsNonceManagerEnabledCalled: func() bool {
+				return true
+			},
+			ReserveNoncesCalled: func(request *data.NonceReservationRequest) (int, *data.NonceReservation, error) {
+				assert.Equal(t, data.NonceReservationRequest{Sender: "erd1sender", NumNonces: 10, TTLInSec: 30}, *request)
+				return http.StatusOK, &expectedReservation, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/nonces/reserve", bytes.NewBuffer([]byte(requestBody)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Reservation data.NonceReservation `json:"reservation"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedReservation, response.Data.Reservation)
+	})
+}
+
+func TestReleaseNonces(t *testing.T) {
+	t.Parallel()
+
+	requestBody := `{"sender":"erd1sender","reservationId":"id","numUsed":3}`
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("nonce reservation not found")
+		facade := &mock.FacadeStub{
+			IsNonceManagerEnabledCalled: func() bool {
+				return true
+			},
+			ReleaseNoncesCalled: func(request *data.NonceReleaseRequest) (int, error) {
+				return http.StatusNotFound, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/nonces/release", bytes.NewBuffer([]byte(requestBody)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should release the reservation", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			IsNonceManagerEnabledCalled: func() bool {
+				return true
+			},
+			ReleaseNoncesCalled: func(request *data.NonceReleaseRequest) (int, error) {
+				wasCalled = true
+				assert.Equal(t, data.NonceReleaseRequest{Sender: "erd1sender", ReservationID: "id", NumUsed: 3}, *request)
+				return http.StatusOK, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("POST", "/transaction/nonces/release", bytes.NewBuffer([]byte(requestBody)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestWatchTransaction_WebhooksNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/webhooks/watch", bytes.NewBuffer([]byte(`{"webhook":"back-office","txHash":"aa"}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrWebhooksNotEnabled.Error(), response.Error)
+}
+
+func TestWatchTransaction_ErrorWhenFacadeWatchTransactionError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("unknown webhook")
+	facade := &mock.FacadeStub{
+		IsWebhooksEnabledCalled: func() bool {
+			return true
+		},
+		WatchTransactionCalled: func(webhook string, txHash string) error {
+			return expectedErr
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/webhooks/watch", bytes.NewBuffer([]byte(`{"webhook":"other","txHash":"aa"}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrWatchTransaction.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestWatchTransaction_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	facade := &mock.FacadeStub{
+		IsWebhooksEnabledCalled: func() bool {
+			return true
+		},
+		WatchTransactionCalled: func(webhook string, txHash string) error {
+			wasCalled = true
+			assert.Equal(t, "back-office", webhook)
+			assert.Equal(t, "aa", txHash)
+			return nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/webhooks/watch", bytes.NewBuffer([]byte(`{"webhook":"back-office","txHash":"aa"}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, wasCalled)
+}
+
+func TestWatchAddresses_WebhooksNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/webhooks/watchlist", bytes.NewBuffer([]byte(`{"webhook":"back-office","addresses":["erd1a"]}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrWebhooksNotEnabled.Error(), response.Error)
+}
+
+func TestWatchAddresses_ErrorWhenFacadeWatchAddressesError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("too many watched addresses")
+	facade := &mock.FacadeStub{
+		IsWebhooksEnabledCalled: func() bool {
+			return true
+		},
+		WatchAddressesCalled: func(webhook string, addresses []string) error {
+			return expectedErr
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/webhooks/watchlist", bytes.NewBuffer([]byte(`{"webhook":"back-office","addresses":["erd1a"]}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrWatchAddresses.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestWatchAddresses_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	facade := &mock.FacadeStub{
+		IsWebhooksEnabledCalled: func() bool {
+			return true
+		},
+		WatchAddressesCalled: func(webhook string, addresses []string) error {
+			wasCalled = true
+			assert.Equal(t, "back-office", webhook)
+			assert.Equal(t, []string{"erd1a", "erd1b"}, addresses)
+			return nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/webhooks/watchlist", bytes.NewBuffer([]byte(`{"webhook":"back-office","addresses":["erd1a","erd1b"]}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, wasCalled)
+}
+
+func TestGetWatchedAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("unknown webhook")
+		facade := &mock.FacadeStub{
+			IsWebhooksEnabledCalled: func() bool {
+				return true
+			},
+			GetWatchedAddressesCalled: func(webhook string) ([]string, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/webhooks/watchlist/other", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetWatchedAddresses.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsWebhooksEnabledCalled: func() bool {
+				return true
+			},
+			GetWatchedAddressesCalled: func(webhook string) ([]string, error) {
+				assert.Equal(t, "back-office", webhook)
+				return []string{"erd1a", "erd1b"}, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/webhooks/watchlist/back-office", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	response := GeneralResponse{}
-	loadResponse(resp.Body, &response)
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
 
-	assert.Equal(t, http.StatusInternalServerError, resp.Code)
-	assert.Contains(t, response.Error, errorString)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, map[string]interface{}{
+			"webhook":   "back-office",
+			"addresses": []interface{}{"erd1a", "erd1b"},
+		}, response.Data)
+	})
 }
 
-func TestSendUserFunds_ReturnsSuccessfully(t *testing.T) {
+func TestUnwatchAddress(t *testing.T) {
 	t.Parallel()
 
-	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
 
-	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			return nil
-		},
-	}
+		expectedErr := errors.New("address not watched")
+		facade := &mock.FacadeStub{
+			IsWebhooksEnabledCalled: func() bool {
+				return true
+			},
+			UnwatchAddressCalled: func(webhook string, address string) error {
+				return expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	transactionsGroup, err := groups.NewTransactionGroup(facade)
-	require.NoError(t, err)
-	ws := startProxyServer(transactionsGroup, transactionsPath)
+		req, _ := http.NewRequest("DELETE", "/transaction/webhooks/watchlist/back-office/erd1a", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	jsonStr := fmt.Sprintf(
-		`{"receiver":"%s"}`, receiver)
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
 
-	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrUnwatchAddress.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			IsWebhooksEnabledCalled: func() bool {
+				return true
+			},
+			UnwatchAddressCalled: func(webhook string, address string) error {
+				wasCalled = true
+				assert.Equal(t, "back-office", webhook)
+				assert.Equal(t, "erd1a", address)
+				return nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	response := GeneralResponse{}
-	loadResponse(resp.Body, &response)
+		req, _ := http.NewRequest("DELETE", "/transaction/webhooks/watchlist/back-office/erd1a", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	assert.Equal(t, http.StatusOK, resp.Code)
-	assert.Equal(t, response.Error, "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
 }
 
-func TestSendUserFunds_NilValue(t *testing.T) {
+func TestGetTransactionsStatusStream_EmptyHashesShouldError(t *testing.T) {
 	t.Parallel()
 
-	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
-
-	var callValue *big.Int
-	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			callValue = value
-			return nil
-		},
-	}
-
-	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
 	require.NoError(t, err)
 	ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	jsonStr := fmt.Sprintf(
-		`{"receiver":"%s"}`, receiver)
-
-	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
-
+	req, _ := http.NewRequest("GET", "/transaction/status-stream", nil)
 	resp := httptest.NewRecorder()
 	ws.ServeHTTP(resp, req)
 
 	response := GeneralResponse{}
 	loadResponse(resp.Body, &response)
 
-	assert.Nil(t, callValue)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrWatchTransactionsStatus.Error())
+	assert.Contains(t, response.Error, apiErrors.ErrEmptyTransactionsHashes.Error())
 }
 
-func TestSendUserFunds_CorrectValue(t *testing.T) {
+func TestGetTransactionsStatusStream_ErrorWhenFacadeWatchTransactionsStatusError(t *testing.T) {
 	t.Parallel()
 
-	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
-
-	var callValue *big.Int
+	expectedErr := errors.New("too many transactions")
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			callValue = value
-			return nil
+		WatchTransactionsStatusCalled: func(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error) {
+			return nil, expectedErr
 		},
 	}
 	transactionsGroup, err := groups.NewTransactionGroup(facade)
 	require.NoError(t, err)
 	ws := startProxyServer(transactionsGroup, transactionsPath)
 
-	expectedValue, _ := big.NewInt(0).SetString("100000000000000", 10)
-	jsonStr := fmt.Sprintf(
-		`{"receiver":"%s", "value": %d}`, receiver, expectedValue)
-
-	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
-
+	req, _ := http.NewRequest("GET", "/transaction/status-stream?hashes=aa,bb", nil)
 	resp := httptest.NewRecorder()
 	ws.ServeHTTP(resp, req)
 
 	response := GeneralResponse{}
 	loadResponse(resp.Body, &response)
 
-	assert.Equal(t, expectedValue, callValue)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrWatchTransactionsStatus.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
 }
 
-func TestSendUserFunds_FaucetNotEnabled(t *testing.T) {
+func TestGetTransactionsStatusStream_ShouldStreamTheEvents(t *testing.T) {
 	t.Parallel()
 
-	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
-
+	expectedEvents := []*data.TransactionStatusEvent{
+		{TxHash: "aa", Status: "success", Timestamp: 10},
+		{TxHash: "bb", Status: "pending", TimedOut: true, Timestamp: 11},
+	}
 	facade := &mock.FacadeStub{
-		IsFaucetEnabledHandler: func() bool {
-			return false
+		WatchTransactionsStatusCalled: func(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error) {
+			assert.Equal(t, []string{"aa", "bb"}, txHashes)
+
+			chanEvents := make(chan *data.TransactionStatusEvent, len(expectedEvents))
+			for _, event := range expectedEvents {
+				chanEvents <- event
+			}
+			close(chanEvents)
+
+			return chanEvents, nil
 		},
 	}
 	transactionsGroup, err := groups.NewTransactionGroup(facade)
 	require.NoError(t, err)
-	ws := startProxyServer(transactionsGroup, transactionsPath)
+	server := httptest.NewServer(startProxyServer(transactionsGroup, transactionsPath))
+	defer server.Close()
 
-	value := "100000000000000"
-	jsonStr := fmt.Sprintf(
-		`{"receiver":"%s", "value": %s}`, receiver, value)
-
-	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
-
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
-
-	response := GeneralResponse{}
-	loadResponse(resp.Body, &response)
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/transaction/status-stream?hashes=aa,bb"
+	conn, err := websocket.Dial(wsURL, "", server.URL)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	for _, expectedEvent := range expectedEvents {
+		event := &data.TransactionStatusEvent{}
+		require.NoError(t, websocket.JSON.Receive(conn, event))
+		assert.Equal(t, expectedEvent, event)
+	}
 
-	assert.Equal(t, apiErrors.ErrFaucetNotEnabled.Error(), response.Error)
+	err = websocket.JSON.Receive(conn, &data.TransactionStatusEvent{})
+	assert.Equal(t, io.EOF, err)
 }
 
 func TestGetTransactionsPool_InvalidOptions(t *testing.T) {
@@ -599,7 +1616,7 @@ func TestGetTransactionsPool_ReturnsSuccessfully(t *testing.T) {
 		RegularTransactions: []data.WrappedTransaction{providedTx},
 	}
 	facade := &mock.FacadeStub{
-		GetTransactionsPoolHandler: func(fields string) (*data.TransactionsPool, error) {
+		GetTransactionsPoolHandler: func(_ context.Context, fields string, cursor uint64) (*data.TransactionsPool, error) {
 			return providedTxPool, nil
 		},
 	}
@@ -621,6 +1638,38 @@ func TestGetTransactionsPool_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, providedTxPool, &response.Data.TxPool)
 }
 
+func TestGetTransactionsPool_WithCursorReturnsTruncatedPool(t *testing.T) {
+	t.Parallel()
+
+	providedTxPool := &data.TransactionsPool{
+		RegularTransactions: []data.WrappedTransaction{{TxFields: map[string]interface{}{"hash": "hash"}}},
+		Truncated:           true,
+		NextCursor:          11,
+	}
+	facade := &mock.FacadeStub{
+		GetTransactionsPoolForShardHandler: func(_ context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error) {
+			require.Equal(t, uint32(1), shardID)
+			require.Equal(t, uint64(10), cursor)
+			return providedTxPool, nil
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?shard-id=1&cursor=10", nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResp{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, providedTxPool, &response.Data.TxPool)
+}
+
 func TestGetTransactionsPoolForShard_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
@@ -634,8 +1683,12 @@ func TestGetTransactionsPoolForShard_ReturnsSuccessfully(t *testing.T) {
 		RegularTransactions: []data.WrappedTransaction{providedTx},
 	}
 	facade := &mock.FacadeStub{
-		GetTransactionsPoolForShardHandler: func(shardID uint32, fields string) (*data.TransactionsPool, error) {
-			return providedTxPool, nil
+		GetTransactionsPoolForShardStreamCalled: func(_ context.Context, shardID uint32, fields string) (io.ReadCloser, error) {
+			observerResponse, _ := json.Marshal(data.TransactionsPoolApiResponse{
+				Data: data.TransactionsPoolResponseData{Transactions: *providedTxPool},
+				Code: string(data.ReturnCodeSuccess),
+			})
+			return io.NopCloser(bytes.NewReader(observerResponse)), nil
 		},
 	}
 
@@ -829,3 +1882,210 @@ func TestTransactionGroup_getProcessedTransactionStatus(t *testing.T) {
 		assert.Equal(t, status.Reason, response.Data.Reason)
 	})
 }
+
+func TestGetTransactionsPool_ExportFormats(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionsPoolHandler: func(_ context.Context, fields string, cursor uint64) (*data.TransactionsPool, error) {
+			return &data.TransactionsPool{
+				RegularTransactions: []data.WrappedTransaction{
+					{TxFields: map[string]interface{}{"hash": "h1", "nonce": 1}},
+				},
+				Rewards: []data.WrappedTransaction{
+					{TxFields: map[string]interface{}{"hash": "h2", "value": "10"}},
+				},
+			}, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+		req.Header.Set("Accept", "text/csv")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/csv", resp.Header().Get("Content-Type"))
+		expectedBody := "category,hash,nonce,value\n" +
+			"regularTransaction,h1,1,\n" +
+			"reward,h2,,10\n"
+		assert.Equal(t, expectedBody, resp.Body.String())
+	})
+	t.Run("ndjson", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+		req.Header.Set("Accept", "application/x-ndjson")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		expectedBody := `{"category":"regularTransaction","hash":"h1","nonce":1}` + "\n" +
+			`{"category":"reward","hash":"h2","value":"10"}` + "\n"
+		assert.Equal(t, expectedBody, resp.Body.String())
+	})
+	t.Run("json should remain the default", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+		req.Header.Set("Accept", "text/html")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txsPoolResp := txPoolResp{}
+		loadResponse(resp.Body, &txsPoolResp)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Len(t, txsPoolResp.Data.TxPool.RegularTransactions, 1)
+	})
+}
+
+func TestGetTransaction_Protobuf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionProtobufCalled: func(_ string, _ string) ([]byte, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/hash", nil)
+		req.Header.Set("Accept", shared.MIMEProtobuf)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, "expected error", response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txBytes := []byte("protobuf transaction")
+		facade := &mock.FacadeStub{
+			GetTransactionProtobufCalled: func(txHash string, sndAddr string) ([]byte, error) {
+				assert.Equal(t, "hash", txHash)
+				assert.Equal(t, "sender", sndAddr)
+				return txBytes, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?sender=sender", nil)
+		req.Header.Set("Accept", shared.MIMEProtobuf)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, shared.MIMEProtobuf, resp.Header().Get("Content-Type"))
+		assert.Equal(t, txBytes, resp.Body.Bytes())
+	})
+}
+
+func TestGetTransaction_InnerTransactionOfRelayed(t *testing.T) {
+	t.Parallel()
+
+	innerTx := &transaction.ApiTransactionResult{Hash: "innerHash", OriginalTransactionHash: "relayedHash"}
+	relayedTx := &transaction.ApiTransactionResult{Hash: "relayedHash"}
+	facade := &mock.FacadeStub{
+		GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return innerTx, nil
+		},
+		GetRelayedTransactionOfInnerHandler: func(tx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error) {
+			assert.Equal(t, innerTx, tx)
+			assert.True(t, withResults)
+			return relayedTx, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/innerHash?withResults=true&withRelayed=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Transaction        transaction.ApiTransactionResult `json:"transaction"`
+			RelayedTransaction transaction.ApiTransactionResult `json:"relayedTransaction"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "innerHash", response.Data.Transaction.Hash)
+	assert.Equal(t, "relayedHash", response.Data.RelayedTransaction.Hash)
+}
+
+func TestGetTransaction_RelayedTransactionLookup(t *testing.T) {
+	t.Parallel()
+
+	innerTx := &transaction.ApiTransactionResult{Hash: "innerHash", OriginalTransactionHash: "relayedHash"}
+
+	t.Run("without withRelayed should not look for the relayed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return innerTx, nil
+			},
+			GetRelayedTransactionOfInnerHandler: func(tx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/innerHash", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.NotContains(t, response.Data, "relayedTransaction")
+	})
+	t.Run("lookup error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return innerTx, nil
+			},
+			GetRelayedTransactionOfInnerHandler: func(tx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/innerHash?withRelayed=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetRelayedTransaction.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+}
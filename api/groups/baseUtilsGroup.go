@@ -0,0 +1,77 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type utilsGroup struct {
+	facade UtilsFacadeHandler
+	*baseGroup
+}
+
+// NewUtilsGroup returns a new instance of utilsGroup
+func NewUtilsGroup(facadeHandler data.FacadeHandler) (*utilsGroup, error) {
+	facade, ok := facadeHandler.(UtilsFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	ug := &utilsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/address/convert/:address", Handler: ug.convertAddress, Method: http.MethodGet, Cacheability: data.CacheabilityImmutable},
+		{Path: "/address/convert", Handler: ug.convertAddresses, Method: http.MethodPost},
+	}
+	ug.baseGroup.endpoints = baseRoutesHandlers
+
+	return ug, nil
+}
+
+// convertAddress converts the provided address from bech32 to hex or from hex to bech32
+func (group *utilsGroup) convertAddress(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrConvertAddresses, errors.ErrEmptyAddress)
+		return
+	}
+
+	conversions, err := group.facade.ConvertAddresses([]string{address})
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrConvertAddresses, err)
+		return
+	}
+	if len(conversions[0].Error) > 0 {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: %s", errors.ErrConvertAddresses.Error(), conversions[0].Error))
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"conversion": conversions[0]}, "", data.ReturnCodeSuccess)
+}
+
+// convertAddresses converts each of the provided addresses from bech32 to hex or from hex to bech32. The invalid
+// addresses do not fail the request, their conversions holding the error instead
+func (group *utilsGroup) convertAddresses(c *gin.Context) {
+	request := data.AddressConversionRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrConvertAddresses, err)
+		return
+	}
+
+	conversions, err := group.facade.ConvertAddresses(request.Addresses)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrConvertAddresses, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"conversions": conversions}, "", data.ReturnCodeSuccess)
+}
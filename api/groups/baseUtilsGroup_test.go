@@ -0,0 +1,162 @@
+package groups_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const utilsPath = "/utils"
+
+type addressConversionResponse struct {
+	Data struct {
+		Conversion data.AddressConversion `json:"conversion"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type addressConversionsResponse struct {
+	Data struct {
+		Conversions []data.AddressConversion `json:"conversions"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewUtilsGroup_WrongFacadeShouldErr(t *testing.T) {
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewUtilsGroup(wrongFacade)
+
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestUtilsGroup_ConvertAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ConvertAddressesCalled: func(addresses []string) ([]data.AddressConversion, error) {
+				return []data.AddressConversion{{Input: addresses[0], Error: "invalid address"}}, nil
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("GET", "/utils/address/convert/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &addressConversionResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrConvertAddresses.Error())
+		assert.Contains(t, response.Error, "invalid address")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedConversion := data.AddressConversion{Input: "erd1alice", Bech32: "erd1alice", Hex: "0a"}
+		facade := &mock.FacadeStub{
+			ConvertAddressesCalled: func(addresses []string) ([]data.AddressConversion, error) {
+				assert.Equal(t, []string{"erd1alice"}, addresses)
+				return []data.AddressConversion{expectedConversion}, nil
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("GET", "/utils/address/convert/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &addressConversionResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedConversion, response.Data.Conversion)
+	})
+}
+
+func TestUtilsGroup_ConvertAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		utilsGroup, err := groups.NewUtilsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("POST", "/utils/address/convert", bytes.NewBufferString("invalid"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &addressConversionsResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrConvertAddresses.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("too many addresses")
+		facade := &mock.FacadeStub{
+			ConvertAddressesCalled: func(addresses []string) ([]data.AddressConversion, error) {
+				return nil, expectedErr
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("POST", "/utils/address/convert", bytes.NewBufferString(`{"addresses":["erd1alice"]}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &addressConversionsResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedConversions := []data.AddressConversion{
+			{Input: "erd1alice", Bech32: "erd1alice", Hex: "0a"},
+			{Input: "0b", Bech32: "erd1bob", Hex: "0b"},
+			{Input: "invalid", Error: "invalid address"},
+		}
+		facade := &mock.FacadeStub{
+			ConvertAddressesCalled: func(addresses []string) ([]data.AddressConversion, error) {
+				assert.Equal(t, []string{"erd1alice", "0b", "invalid"}, addresses)
+				return expectedConversions, nil
+			},
+		}
+		utilsGroup, err := groups.NewUtilsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(utilsGroup, utilsPath)
+
+		req, _ := http.NewRequest("POST", "/utils/address/convert", bytes.NewBufferString(`{"addresses":["erd1alice","0b","invalid"]}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &addressConversionsResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedConversions, response.Data.Conversions)
+	})
+}
@@ -1,9 +1,11 @@
 package groups
 
 import (
+	"encoding/hex"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -26,8 +28,9 @@ func NewValidatorGroup(facadeHandler data.FacadeHandler) (*validatorGroup, error
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/statistics", Handler: vg.statistics, Method: http.MethodGet},
-		{Path: "/auction", Handler: vg.auctionList, Method: http.MethodGet},
+		{Path: "/statistics", Handler: vg.statistics, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/statistics/:blsKey", Handler: vg.statisticsForKey, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
+		{Path: "/auction", Handler: vg.auctionList, Method: http.MethodGet, Cacheability: data.CacheabilityShortLived},
 	}
 	vg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -45,6 +48,24 @@ func (group *validatorGroup) statistics(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"statistics": validatorStatistics}, "", data.ReturnCodeSuccess)
 }
 
+// statisticsForKey returns the statistics of the validator with the provided BLS key, served from the cached statistics
+func (group *validatorGroup) statisticsForKey(c *gin.Context) {
+	blsKey := c.Param("blsKey")
+	_, err := hex.DecodeString(blsKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidBLSKey, err)
+		return
+	}
+
+	validatorStatistics, err := group.facade.ValidatorStatisticsForKey(blsKey)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"statistics": validatorStatistics}, "", data.ReturnCodeSuccess)
+}
+
 func (group *validatorGroup) auctionList(c *gin.Context) {
 	auctionList, err := group.facade.AuctionList()
 	if err != nil {
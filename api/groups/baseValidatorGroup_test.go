@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -100,6 +101,80 @@ func TestValidatorStatistics_ShouldWork(t *testing.T) {
 	assert.Equal(t, response.Data.Statistics["statistics"], valStatsMap["statistics"])
 }
 
+func TestValidatorStatisticsForKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(validatorGroup, validatorPath)
+
+		req, _ := http.NewRequest("GET", "/validator/statistics/not-hex", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBLSKey.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		errStr := "expected err"
+		facade := &mock.FacadeStub{
+			ValidatorStatisticsForKeyCalled: func(blsKey string) (*data.ValidatorApiResponse, error) {
+				return nil, errors.New(errStr)
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(validatorGroup, validatorPath)
+
+		req, _ := http.NewRequest("GET", "/validator/statistics/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, errStr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStats := &data.ValidatorApiResponse{TempRating: 50.7, ShardId: 1}
+		facade := &mock.FacadeStub{
+			ValidatorStatisticsForKeyCalled: func(blsKey string) (*data.ValidatorApiResponse, error) {
+				assert.Equal(t, "aabb", blsKey)
+				return expectedStats, nil
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(validatorGroup, validatorPath)
+
+		req, _ := http.NewRequest("GET", "/validator/statistics/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data struct {
+				Statistics *data.ValidatorApiResponse `json:"statistics"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedStats, response.Data.Statistics)
+	})
+}
+
 func TestValidatorGroup_GetAuctionList(t *testing.T) {
 	t.Parallel()
 
@@ -1,7 +1,9 @@
 package groups
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -23,6 +25,8 @@ type VMValueRequest struct {
 	SameScState    bool     `json:"sameScState"`
 	ShouldBeSynced bool     `json:"shouldBeSynced"`
 	Args           []string `json:"args"`
+	BlockNonce     *uint64  `json:"blockNonce,omitempty"`
+	BlockHash      string   `json:"blockHash,omitempty"`
 }
 
 type vmValuesGroup struct {
@@ -72,7 +76,7 @@ func (group *vmValuesGroup) doGetVMValue(context *gin.Context, asType vm.ReturnD
 	vmOutput, blockInfo, err := group.doExecuteQuery(context)
 
 	if err != nil {
-		returnBadRequest(context, "doGetVMValue", err)
+		returnQueryError(context, "doGetVMValue", err)
 		return
 	}
 
@@ -89,7 +93,7 @@ func (group *vmValuesGroup) doGetVMValue(context *gin.Context, asType vm.ReturnD
 func (group *vmValuesGroup) executeQuery(context *gin.Context) {
 	vmOutput, blockInfo, err := group.doExecuteQuery(context)
 	if err != nil {
-		returnBadRequest(context, "executeQuery", err)
+		returnQueryError(context, "executeQuery", err)
 		return
 	}
 
@@ -108,12 +112,22 @@ func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputAp
 		return nil, data.BlockInfo{}, err
 	}
 
-	command.BlockNonce, command.BlockHash, err = extractBlockCoordinates(context)
+	blockNonce, blockHash, err := extractBlockCoordinates(context)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
 	}
 
-	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(command)
+	areUrlCoordinatesSet := blockNonce.HasValue || len(blockHash) > 0
+	if areUrlCoordinatesSet {
+		areBodyCoordinatesSet := command.BlockNonce.HasValue || len(command.BlockHash) > 0
+		if areBodyCoordinatesSet {
+			return nil, data.BlockInfo{}, ErrBlockCoordinatesProvidedTwice
+		}
+
+		command.BlockNonce, command.BlockHash = blockNonce, blockHash
+	}
+
+	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(context.Request.Context(), command)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
 	}
@@ -132,6 +146,20 @@ func createSCQuery(request *VMValueRequest) (*data.SCQuery, error) {
 		arguments[i] = append(arguments[i], argBytes...)
 	}
 
+	blockNonce := core.OptionalUint64{}
+	if request.BlockNonce != nil {
+		blockNonce = core.OptionalUint64{Value: *request.BlockNonce, HasValue: true}
+	}
+
+	var blockHash []byte
+	if len(request.BlockHash) > 0 {
+		var err error
+		blockHash, err = hex.DecodeString(request.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, err.Error())
+		}
+	}
+
 	return &data.SCQuery{
 		ScAddress:      request.ScAddress,
 		FuncName:       request.FuncName,
@@ -140,6 +168,8 @@ func createSCQuery(request *VMValueRequest) (*data.SCQuery, error) {
 		SameScState:    request.SameScState,
 		ShouldBeSynced: request.ShouldBeSynced,
 		Arguments:      arguments,
+		BlockNonce:     blockNonce,
+		BlockHash:      blockHash,
 	}, nil
 }
 
@@ -162,6 +192,23 @@ func returnBadRequest(context *gin.Context, errScope string, err error) {
 	shared.RespondWith(context, http.StatusBadRequest, nil, message, data.ReturnCodeRequestError)
 }
 
+// returnQueryError responds with 504 Gateway Timeout if the deadline requested by the client expired before the
+// observers answered, and with 400 Bad Request otherwise
+func returnQueryError(c *gin.Context, errScope string, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		message := fmt.Sprintf("%s: %s", errScope, err)
+		shared.RespondWith(c, http.StatusGatewayTimeout, nil, message, data.ReturnCodeInternalError)
+		return
+	}
+	if errors.Is(err, data.ErrSCQueryLimitReached) {
+		message := fmt.Sprintf("%s: %s", errScope, err)
+		shared.RespondWith(c, http.StatusTooManyRequests, nil, message, data.ReturnCodeRequestError)
+		return
+	}
+
+	returnBadRequest(c, errScope, err)
+}
+
 func returnOkResponse(context *gin.Context, dataToReturn interface{}, blockInfo interface{}) {
 	shared.RespondWith(context, http.StatusOK, gin.H{"data": dataToReturn, "blockInfo": blockInfo}, "", data.ReturnCodeSuccess)
 }
@@ -2,6 +2,7 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
@@ -55,7 +57,7 @@ func TestGetHex_ShouldWork(t *testing.T) {
 	valueBuff, _ := hex.DecodeString("DEADBEEF")
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{valueBuff},
 			}, data.BlockInfo{}, nil
@@ -82,7 +84,7 @@ func TestGetString_ShouldWork(t *testing.T) {
 	valueBuff := "DEADBEEF"
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{[]byte(valueBuff)},
 			}, data.BlockInfo{}, nil
@@ -109,7 +111,7 @@ func TestGetInt_ShouldWork(t *testing.T) {
 	value := "1234567"
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			returnData := big.NewInt(0)
 			returnData.SetString(value, 10)
 			return &vm.VMOutputApi{
@@ -136,7 +138,7 @@ func TestQuery_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{big.NewInt(42).Bytes()},
@@ -168,7 +170,7 @@ func TestQuery_ShouldWorkWithCoordinates(t *testing.T) {
 		RootHash: "block rootHash",
 	}
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			require.Equal(t, providedNonce, query.BlockNonce.Value)
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{big.NewInt(42).Bytes()},
@@ -191,6 +193,80 @@ func TestQuery_ShouldWorkWithCoordinates(t *testing.T) {
 	require.Equal(t, providedBlockInfo, response.Data.BlockInfo)
 }
 
+func TestQuery_ShouldWorkWithCoordinatesInBody(t *testing.T) {
+	t.Parallel()
+
+	providedNonce := uint64(123)
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+			require.Equal(t, core.OptionalUint64{Value: providedNonce, HasValue: true}, query.BlockNonce)
+			require.Equal(t, []byte{0xaa, 0xbb}, query.BlockHash)
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, data.BlockInfo{Nonce: providedNonce}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		Args:       []string{},
+		BlockNonce: &providedNonce,
+		BlockHash:  "aabb",
+	}
+
+	response := vmOutputGenericResponse{}
+	statusCode := doPost(t, facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, providedNonce, response.Data.BlockInfo.Nonce)
+}
+
+func TestQuery_CoordinatesInBodyAndUrlShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+			require.Fail(t, "should have not been called")
+			return nil, data.BlockInfo{}, nil
+		},
+	}
+
+	providedNonce := uint64(123)
+	request := groups.VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		Args:       []string{},
+		BlockNonce: &providedNonce,
+	}
+
+	response := vmOutputGenericResponse{}
+	statusCode := doPost(t, facade, "/vm-values/query?blockHash=aabb", request, &response)
+
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, groups.ErrBlockCoordinatesProvidedTwice.Error())
+}
+
+func TestAllRoutes_WhenBadBlockHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("'bad hash' is not a valid block hash")
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		BlockHash: "bad hash",
+	}
+
+	requireErrorOnAllRoutes(t, facade, request, errExpected)
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := groups.VMValueRequest{
 		ScAddress: DummyScAddress,
@@ -208,7 +284,7 @@ func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("some random error")
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return nil, data.BlockInfo{}, errExpected
 		},
 	}
@@ -227,7 +303,7 @@ func TestAllRoutes_WhenBadArgumentsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid hex string")
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
@@ -246,7 +322,7 @@ func TestAllRoutes_WhenNoVMReturnDataShouldErr(t *testing.T) {
 
 	errExpected := errors.New("no return data")
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
@@ -260,11 +336,54 @@ func TestAllRoutes_WhenNoVMReturnDataShouldErr(t *testing.T) {
 	requireErrorOnGetSingleValueRoutes(t, &facade, request, errExpected)
 }
 
+func TestAllRoutes_DeadlineExceededShouldReturnGatewayTimeout(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+			return nil, data.BlockInfo{}, context.DeadlineExceeded
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(t, facade, "/vm-values/query", &request, &response)
+	require.Equal(t, http.StatusGatewayTimeout, statusCode)
+	require.Contains(t, response.Error, context.DeadlineExceeded.Error())
+}
+
+func TestAllRoutes_LimitReachedShouldReturnTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	errExpected := fmt.Errorf("%w, contract %s", data.ErrSCQueryLimitReached, DummyScAddress)
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+			return nil, data.BlockInfo{}, errExpected
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(t, facade, "/vm-values/query", &request, &response)
+	require.Equal(t, http.StatusTooManyRequests, statusCode)
+	require.Contains(t, response.Error, errExpected.Error())
+}
+
 func TestAllRoutes_WhenBadJsonShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
@@ -276,7 +395,7 @@ func TestAllRoutes_WithSameScStateAndShouldBySyncedFilled(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(ctx context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			require.True(t, query.ShouldBeSynced)
 			require.True(t, query.SameScState)
 			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
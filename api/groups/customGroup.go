@@ -0,0 +1,25 @@
+package groups
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type customGroup struct {
+	*baseGroup
+}
+
+// NewCustomGroup returns a new route group holding the provided endpoints. It is meant for the route groups added by
+// external modules, which cannot embed the base group of this package
+func NewCustomGroup(endpoints []*data.EndpointHandlerData) (*customGroup, error) {
+	for _, endpoint := range endpoints {
+		if endpoint == nil || endpoint.Handler == nil {
+			return nil, ErrNilGinHandler
+		}
+	}
+
+	return &customGroup{
+		baseGroup: &baseGroup{
+			endpoints: endpoints,
+		},
+	}, nil
+}
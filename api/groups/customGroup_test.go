@@ -0,0 +1,42 @@
+package groups_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCustomGroup(t *testing.T) {
+	t.Parallel()
+
+	group, err := groups.NewCustomGroup([]*data.EndpointHandlerData{{Path: "/status", Method: http.MethodGet}})
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrNilGinHandler, err)
+
+	group, err = groups.NewCustomGroup([]*data.EndpointHandlerData{nil})
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrNilGinHandler, err)
+
+	statusHandler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, data.GenericAPIResponse{Data: "custom", Code: data.ReturnCodeSuccess})
+	}
+	group, err = groups.NewCustomGroup([]*data.EndpointHandlerData{{Path: "/status", Handler: statusHandler, Method: http.MethodGet}})
+	require.NoError(t, err)
+	require.False(t, check.IfNil(group))
+
+	ws := startProxyServer(group, "/custom")
+	req, _ := http.NewRequest(http.MethodGet, "/custom/status", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := data.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, "custom", response.Data)
+}
@@ -16,3 +16,15 @@ var ErrWrongTypeAssertion = errors.New("wrong type assertion")
 
 // ErrForcedShardIDCannotBeProvided signals that the forced shard id cannot be provided for a different address other than the system account address
 var ErrForcedShardIDCannotBeProvided = errors.New("forced shard id parameter can only be provided for system accounts")
+
+// ErrInvalidPaginationParams signals that invalid pagination parameters have been provided
+var ErrInvalidPaginationParams = errors.New("invalid pagination parameters")
+
+// ErrBlockCoordinatesProvidedTwice signals that the block coordinates of a query were provided both as url parameters and in the request body
+var ErrBlockCoordinatesProvidedTwice = errors.New("block coordinates can be provided either as url parameters or in the request body, not both")
+
+// ErrInvalidNumKeysParam signals that an invalid number of keys has been requested
+var ErrInvalidNumKeysParam = errors.New("invalid numKeys parameter")
+
+// ErrInvalidAddressFormat signals that an invalid address format has been requested
+var ErrInvalidAddressFormat = errors.New("invalid address format, expected bech32 or hex")
@@ -1,7 +1,11 @@
 package groups
 
 import (
+	"context"
+	"io"
 	"math/big"
+	"net/http"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
@@ -11,11 +15,13 @@ import (
 
 // AccountsFacadeHandler interface defines methods that can be used from the facade
 type AccountsFacadeHandler interface {
-	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccountWithQuorum(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error)
 	GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetShardIDForAddress(address string) (uint32, error)
 	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokensList(address string, options common.AccountQueryOptions, filter common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error)
 	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
 	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -24,14 +30,24 @@ type AccountsFacadeHandler interface {
 	GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetStakingPortfolio(address string, stakingProviders []string) (*data.GenericAPIResponse, error)
+	GetAccountDelegations(address string) (*data.GenericAPIResponse, error)
 	IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IterateKeys(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	ExportAccountData(address string, exportOptions common.AccountExportOptions, options common.AccountQueryOptions) (*data.AccountDataExport, error)
+	GetTransactionsHistory(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error)
+	GetTransfersHistory(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error)
+	EncodeAddressAsHex(address string) (string, error)
 }
 
 // BlockFacadeHandler interface defines methods that can be used from the facade
 type BlockFacadeHandler interface {
-	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockProtobufByNonce(shardID uint32, nonce uint64) ([]byte, error)
+	GetBlockProtobufByHash(shardID uint32, hash string) ([]byte, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHashFromAnyShard(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlocksByNonceRange(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 	GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 }
@@ -39,6 +55,9 @@ type BlockFacadeHandler interface {
 // BlocksFacadeHandler interface defines methods that can be used from the facade
 type BlocksFacadeHandler interface {
 	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRoundRange(startRound uint64, endRound uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	IsObserversFeedEnabled() bool
+	WatchBlocks(ctx context.Context, shardID uint32) <-chan *data.BlockNotification
 }
 
 // InternalFacadeHandler interface defines methods that can be used from facade context variable
@@ -54,6 +73,7 @@ type InternalFacadeHandler interface {
 type HyperBlockFacadeHandler interface {
 	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByTimestamp(timestamp int64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 }
 
 // NetworkFacadeHandler interface defines methods that can be used from the facade
@@ -61,21 +81,35 @@ type NetworkFacadeHandler interface {
 	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
 	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
 	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
+	GetEconomicsDataMetricsHistory() []*data.EconomicMetricsSample
 	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
 	GetDirectStakedInfo() (*data.GenericAPIResponse, error)
 	GetDelegatedInfo() (*data.GenericAPIResponse, error)
+	GetDelegationProviders() (*data.DelegationProvidersResponse, error)
 	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
 	GetESDTSupply(token string) (*data.ESDTSupplyResponse, error)
+	GetESDTSupplies(tokens []string) (*data.ESDTSuppliesResponse, error)
+	GetESDTRoles(token string) (*data.ESDTRolesResponse, error)
+	GetESDTSupplyHistory(token string) (int, *data.ESDTSupplyHistoryResponse, error)
+	GetESDTPendingIssuances() (*data.ESDTPendingIssuancesResponse, error)
+	GetESDTOwnership(token string) (*data.ESDTOwnershipResponse, error)
 	GetRatingsConfig() (*data.GenericAPIResponse, error)
 	GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error)
 	GetGasConfigs() (*data.GenericAPIResponse, error)
 	GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
+	GetSyncProgress() *data.SyncProgress
 	GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetShardsOfAddresses(addresses []string) (*data.AddressesShards, error)
+	IsObserversFeedEnabled() bool
+	GetLatestBlocks() *data.LatestBlocksResponseData
+	GetConsensusGroup(shardID uint32, round uint64) (*data.GenericAPIResponse, error)
+	GetBlockFeesByNonce(shardID uint32, nonce uint64) (*data.BlockFees, error)
 }
 
 // NodeFacadeHandler interface defines methods that can be used from the facade
 type NodeFacadeHandler interface {
 	GetHeartbeatData() (*data.HeartbeatResponse, error)
+	GetHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error)
 	IsOldStorageForToken(tokenID string, nonce uint64) (bool, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
 }
@@ -84,22 +118,42 @@ type NodeFacadeHandler interface {
 type StatusFacadeHandler interface {
 	GetMetrics() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheus() string
+	GetCachesMetrics() map[string]*data.CacheMetrics
 }
 
 // TransactionFacadeHandler interface defines methods that can be used from the facade
 type TransactionFacadeHandler interface {
 	SendTransaction(tx *data.Transaction) (int, string, error)
+	SendRawTransaction(txBytes []byte) (int, string, error)
 	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	DryRunMultipleTransactions(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData
 	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	IsFaucetEnabled() bool
 	SendUserFunds(receiver string, value *big.Int) error
+	IsNonceManagerEnabled() bool
+	SendManagedTransaction(tx *data.Transaction) (int, string, error)
+	ReserveNonces(request *data.NonceReservationRequest) (int, *data.NonceReservation, error)
+	ReleaseNonces(request *data.NonceReleaseRequest) (int, error)
+	IsWebhooksEnabled() bool
+	WatchTransaction(webhook string, txHash string) error
+	WatchAddresses(webhook string, addresses []string) error
+	UnwatchAddress(webhook string, address string) error
+	GetWatchedAddresses(webhook string) ([]string, error)
+	IsTransactionWaitEnabled() bool
+	WaitForTransactionExecution(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error)
+	WatchTransactionsStatus(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error)
 	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	TransactionCostDetailedRequest(tx *data.Transaction) (*data.TxCostDetailedResponse, error)
+	ComputeTransactionFee(tx *data.Transaction) (*data.TransactionFeeResponseData, int, error)
 	GetTransactionStatus(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetRelayedTransactionOfInner(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
-	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionProtobuf(txHash string, sndAddr string) ([]byte, error)
+	GetTransactionsPool(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShardStream(ctx context.Context, shardID uint32, fields string) (io.ReadCloser, error)
 	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
@@ -110,24 +164,59 @@ type ProofFacadeHandler interface {
 	GetProof(rootHash string, address string) (*data.GenericAPIResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error)
 	GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
+	GetVerifiedProof(rootHash string, address string) (*data.GenericAPIResponse, error)
+	GetVerifiedProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
 	VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
 }
 
 // ValidatorFacadeHandler interface defines methods that can be used from the facade
 type ValidatorFacadeHandler interface {
 	ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error)
+	ValidatorStatisticsForKey(blsKey string) (*data.ValidatorApiResponse, error)
 	AuctionList() ([]*data.AuctionListValidatorAPIResponse, error)
 }
 
 // VmValuesFacadeHandler interface defines methods that can be used from the facade
 type VmValuesFacadeHandler interface {
-	ExecuteSCQuery(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteSCQuery(context.Context, *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
 }
 
 // ActionsFacadeHandler interface defines methods that can be used from the facade
 type ActionsFacadeHandler interface {
 	ReloadObservers() data.NodesReloadResponse
 	ReloadFullHistoryObservers() data.NodesReloadResponse
+	ReloadConfig() (*data.ConfigReloadStatus, error)
+	StartDrain() *data.DrainStatus
+	GetDrainStatus() *data.DrainStatus
+	SetFaultInjectionScenario(scenario *data.FaultInjectionScenario) error
+	GetFaultInjectionStatus() *data.FaultInjectionStatus
+}
+
+// UtilsFacadeHandler defines the utility methods that can be used from the facade
+type UtilsFacadeHandler interface {
+	ConvertAddresses(addresses []string) ([]data.AddressConversion, error)
+}
+
+// AdminFacadeHandler defines the methods that can be used from the facade by the operators of the proxy
+type AdminFacadeHandler interface {
+	IsClientStatsEnabled() bool
+	GetClientStats(clientKey string) (*data.ClientStats, error)
+	GetSCQueryCosts() *data.SCQueryCosts
+}
+
+// CollectionsFacadeHandler defines the methods that can be used from the facade for the ESDT collections
+type CollectionsFacadeHandler interface {
+	GetCollectionNFTs(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error)
+}
+
+// BridgeFacadeHandler defines the methods that can be used from the facade for the bridge deposits
+type BridgeFacadeHandler interface {
+	GetBridgeDeposits(address string) (*data.GenericAPIResponse, error)
+}
+
+// ObserverFacadeHandler defines the methods that can be used from the facade for reaching the observers directly
+type ObserverFacadeHandler interface {
+	ForwardRawObserverRequest(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error)
 }
 
 // AboutFacadeHandler defines the methods that can be used from the facade
@@ -135,3 +224,16 @@ type AboutFacadeHandler interface {
 	GetAboutInfo() (*data.GenericAPIResponse, error)
 	GetNodesVersions() (*data.GenericAPIResponse, error)
 }
+
+// ProxyFacadeHandler defines the methods related to the proxy itself that can be used from the facade
+type ProxyFacadeHandler interface {
+	GetProxyPublicKey() (*data.GenericAPIResponse, error)
+}
+
+// JsonRpcFacadeHandler defines the methods that can be used from the facade by the JSON-RPC endpoint
+type JsonRpcFacadeHandler interface {
+	SendTransaction(tx *data.Transaction) (int, string, error)
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	ExecuteSCQuery(context.Context, *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+}
@@ -0,0 +1,32 @@
+package groups
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	firstPage       = 1
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// computePageBounds returns the start (inclusive) and end (exclusive) indices of the requested page, for a list
+// with the provided number of items. A page past the end of the list will result in an empty interval
+func computePageBounds(numItems int, options common.PaginationOptions) (int, int) {
+	start := int(options.Page-firstPage) * int(options.Size)
+	if start > numItems {
+		start = numItems
+	}
+
+	end := start + int(options.Size)
+	if end > numItems {
+		end = numItems
+	}
+
+	return start, end
+}
+
+func newPaginationInfo(numItems int, options common.PaginationOptions) *data.PaginationInfo {
+	return data.NewPaginationInfo(options.Page, options.Size, numItems)
+}
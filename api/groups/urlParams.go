@@ -2,11 +2,14 @@ package groups
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // SystemAccountAddressBech is the const for the system account address
@@ -28,7 +31,26 @@ func parseBlockQueryOptions(c *gin.Context) (common.BlockQueryOptions, error) {
 		return common.BlockQueryOptions{}, err
 	}
 
-	options := common.BlockQueryOptions{WithTransactions: withTxs, WithLogs: withLogs, ForHyperblock: forHyperblock}
+	withTokenTransfers, err := parseBoolUrlParam(c, common.UrlParameterWithTokenTransfers)
+	if err != nil {
+		return common.BlockQueryOptions{}, err
+	}
+	if withTokenTransfers && !withTxs {
+		return common.BlockQueryOptions{}, fmt.Errorf("%s requires %s", common.UrlParameterWithTokenTransfers, common.UrlParameterWithTransactions)
+	}
+
+	withFees, err := parseBoolUrlParam(c, common.UrlParameterWithFees)
+	if err != nil {
+		return common.BlockQueryOptions{}, err
+	}
+
+	options := common.BlockQueryOptions{
+		WithTransactions:   withTxs,
+		WithLogs:           withLogs,
+		ForHyperblock:      forHyperblock,
+		WithTokenTransfers: withTokenTransfers,
+		WithFees:           withFees,
+	}
 	return options, nil
 }
 
@@ -129,7 +151,15 @@ func parseTransactionQueryOptions(c *gin.Context) (common.TransactionQueryOption
 		return common.TransactionQueryOptions{}, err
 	}
 
-	options := common.TransactionQueryOptions{WithResults: withResults}
+	withRelayed, err := parseBoolUrlParam(c, common.UrlParameterWithRelayed)
+	if err != nil {
+		return common.TransactionQueryOptions{}, err
+	}
+
+	options := common.TransactionQueryOptions{
+		WithResults: withResults,
+		WithRelayed: withRelayed,
+	}
 	return options, nil
 }
 
@@ -143,6 +173,163 @@ func parseTransactionSimulationOptions(c *gin.Context) (common.TransactionSimula
 	return options, nil
 }
 
+func parseTransactionCostOptions(c *gin.Context) (common.TransactionCostOptions, error) {
+	withDetails, err := parseBoolUrlParam(c, common.UrlParameterWithDetails)
+	if err != nil {
+		return common.TransactionCostOptions{}, err
+	}
+
+	return common.TransactionCostOptions{WithDetails: withDetails}, nil
+}
+
+func parseTransactionSendOptions(c *gin.Context) (common.TransactionSendOptions, error) {
+	wait, err := parseBoolUrlParam(c, common.UrlParameterWait)
+	if err != nil {
+		return common.TransactionSendOptions{}, err
+	}
+
+	timeout := time.Duration(0)
+	timeoutParam := parseStringUrlParam(c, common.UrlParameterTimeout)
+	if len(timeoutParam) > 0 {
+		timeout, err = time.ParseDuration(timeoutParam)
+		if err != nil {
+			return common.TransactionSendOptions{}, fmt.Errorf("invalid %s: %s", common.UrlParameterTimeout, err.Error())
+		}
+		if timeout <= 0 {
+			return common.TransactionSendOptions{}, fmt.Errorf("%s should be positive", common.UrlParameterTimeout)
+		}
+	}
+
+	return common.TransactionSendOptions{Wait: wait, Timeout: timeout}, nil
+}
+
+// parseTransactionsDryRunOptions returns whether a dry-run of the send-multiple request was asked for, along with the
+// options of the dry-run
+func parseTransactionsDryRunOptions(c *gin.Context) (bool, common.TransactionsDryRunOptions, error) {
+	dryRun, err := parseBoolUrlParam(c, common.UrlParameterDryRun)
+	if err != nil {
+		return false, common.TransactionsDryRunOptions{}, err
+	}
+
+	simulate, err := parseBoolUrlParam(c, common.UrlParameterSimulate)
+	if err != nil {
+		return false, common.TransactionsDryRunOptions{}, err
+	}
+	if simulate && !dryRun {
+		return false, common.TransactionsDryRunOptions{}, fmt.Errorf("%s can only be used together with %s", common.UrlParameterSimulate, common.UrlParameterDryRun)
+	}
+
+	checkSignature, err := parseBoolUrlParamWithDefault(c, common.UrlParameterCheckSignature, true)
+	if err != nil {
+		return false, common.TransactionsDryRunOptions{}, err
+	}
+
+	return dryRun, common.TransactionsDryRunOptions{Simulate: simulate, CheckSignature: checkSignature}, nil
+}
+
+func parsePaginationOptions(c *gin.Context) (common.PaginationOptions, error) {
+	page, err := parseUint32UrlParam(c, common.UrlParameterPage)
+	if err != nil {
+		return common.PaginationOptions{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.PaginationOptions{}, err
+	}
+
+	if !page.HasValue && !size.HasValue {
+		return common.PaginationOptions{}, nil
+	}
+
+	options := common.PaginationOptions{
+		Page: firstPage,
+		Size: defaultPageSize,
+	}
+	if page.HasValue {
+		options.Page = page.Value
+	}
+	if size.HasValue {
+		options.Size = size.Value
+	}
+
+	if options.Page < firstPage {
+		return common.PaginationOptions{}, fmt.Errorf("%w, page must be at least %d", ErrInvalidPaginationParams, firstPage)
+	}
+	if options.Size == 0 || options.Size > maxPageSize {
+		return common.PaginationOptions{}, fmt.Errorf("%w, size must be between 1 and %d", ErrInvalidPaginationParams, maxPageSize)
+	}
+
+	return options, nil
+}
+
+func parseTransactionsHistoryOptions(c *gin.Context) (common.TransactionsHistoryOptions, error) {
+	paginationOptions, err := parsePaginationOptions(c)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, err
+	}
+	if paginationOptions.Page == 0 {
+		paginationOptions = common.PaginationOptions{Page: firstPage, Size: defaultPageSize}
+	}
+
+	after, err := parseUint64UrlParam(c, common.UrlParameterAfter)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, err
+	}
+
+	before, err := parseUint64UrlParam(c, common.UrlParameterBefore)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, err
+	}
+
+	return common.TransactionsHistoryOptions{
+		Page:   paginationOptions.Page,
+		Size:   paginationOptions.Size,
+		After:  after.Value,
+		Before: before.Value,
+	}, nil
+}
+
+func parseAccountExportOptions(c *gin.Context) (common.AccountExportOptions, error) {
+	numKeys, err := parseUint32UrlParam(c, common.UrlParameterNumKeys)
+	if err != nil {
+		return common.AccountExportOptions{}, err
+	}
+
+	options := common.AccountExportOptions{
+		NumKeys: defaultExportNumKeys,
+	}
+	if numKeys.HasValue {
+		options.NumKeys = numKeys.Value
+	}
+	if options.NumKeys == 0 || options.NumKeys > maxExportNumKeys {
+		return common.AccountExportOptions{}, fmt.Errorf("%w, it must be between 1 and %d", ErrInvalidNumKeysParam, maxExportNumKeys)
+	}
+
+	cursor := parseStringUrlParam(c, common.UrlParameterCursor)
+	if len(cursor) > 0 {
+		options.Cursor, err = data.DecodeAccountExportCursor(cursor)
+		if err != nil {
+			return common.AccountExportOptions{}, err
+		}
+	}
+
+	return options, nil
+}
+
+// parseHexAddressFormat returns true if the addresses of the response were requested as hex public keys
+func parseHexAddressFormat(c *gin.Context) (bool, error) {
+	format := parseStringUrlParam(c, common.UrlParameterAddressFormat)
+	switch format {
+	case "", common.AddressFormatBech32:
+		return false, nil
+	case common.AddressFormatHex:
+		return true, nil
+	default:
+		return false, ErrInvalidAddressFormat
+	}
+}
+
 func parseBoolUrlParam(c *gin.Context, name string) (bool, error) {
 	return parseBoolUrlParamWithDefault(c, name, false)
 }
@@ -219,12 +406,18 @@ func parseTransactionsPoolQueryOptions(c *gin.Context) (common.TransactionsPoolO
 		return common.TransactionsPoolOptions{}, err
 	}
 
+	cursor, err := parseUint64UrlParam(c, common.UrlParameterCursor)
+	if err != nil {
+		return common.TransactionsPoolOptions{}, err
+	}
+
 	return common.TransactionsPoolOptions{
 		ShardID:   parseStringUrlParam(c, common.UrlParameterShardID),
 		Sender:    parseStringUrlParam(c, common.UrlParameterSender),
 		Fields:    parseStringUrlParam(c, common.UrlParameterFields),
 		LastNonce: lastNonce,
 		NonceGaps: nonceGaps,
+		Cursor:    cursor.Value,
 	}, nil
 }
 
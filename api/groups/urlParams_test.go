@@ -26,6 +26,14 @@ func TestParseBlockQueryOptions(t *testing.T) {
 	options, err = parseBlockQueryOptions(createDummyGinContextWithQuery("withTxs=foobar"))
 	require.NotNil(t, err)
 	require.Empty(t, options)
+
+	options, err = parseBlockQueryOptions(createDummyGinContextWithQuery("withTxs=true&withTokenTransfers=true"))
+	require.Nil(t, err)
+	require.Equal(t, common.BlockQueryOptions{WithTransactions: true, WithTokenTransfers: true}, options)
+
+	options, err = parseBlockQueryOptions(createDummyGinContextWithQuery("withTokenTransfers=true"))
+	require.NotNil(t, err)
+	require.Empty(t, options)
 }
 
 func TestParseAccountOptions(t *testing.T) {
@@ -168,6 +176,36 @@ func TestParseTransactionSimulationOptions(t *testing.T) {
 	require.Empty(t, options)
 }
 
+func TestParsePaginationOptions(t *testing.T) {
+	options, err := parsePaginationOptions(createDummyGinContextWithQuery(""))
+	require.Nil(t, err)
+	require.Equal(t, common.PaginationOptions{}, options)
+
+	options, err = parsePaginationOptions(createDummyGinContextWithQuery("page=3&size=20"))
+	require.Nil(t, err)
+	require.Equal(t, common.PaginationOptions{Page: 3, Size: 20}, options)
+
+	options, err = parsePaginationOptions(createDummyGinContextWithQuery("page=2"))
+	require.Nil(t, err)
+	require.Equal(t, common.PaginationOptions{Page: 2, Size: defaultPageSize}, options)
+
+	options, err = parsePaginationOptions(createDummyGinContextWithQuery("size=5"))
+	require.Nil(t, err)
+	require.Equal(t, common.PaginationOptions{Page: firstPage, Size: 5}, options)
+
+	options, err = parsePaginationOptions(createDummyGinContextWithQuery("page=0"))
+	require.ErrorIs(t, err, ErrInvalidPaginationParams)
+	require.Empty(t, options)
+
+	options, err = parsePaginationOptions(createDummyGinContextWithQuery(fmt.Sprintf("size=%d", maxPageSize+1)))
+	require.ErrorIs(t, err, ErrInvalidPaginationParams)
+	require.Empty(t, options)
+
+	options, err = parsePaginationOptions(createDummyGinContextWithQuery("page=foobar"))
+	require.NotNil(t, err)
+	require.Empty(t, options)
+}
+
 func TestParseBoolUrlParam(t *testing.T) {
 	c := createDummyGinContextWithQuery("a=true&b=false&c=foobar&d")
 
@@ -273,17 +311,22 @@ func TestParseTransactionsPoolQueryOptions(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, expectedValue, value)
 
-	c = createDummyGinContextWithQuery("by-sender=some_sender&fields=sender,receiver&last-nonce=true&nonce-gaps=true&shard-id=333")
+	c = createDummyGinContextWithQuery("by-sender=some_sender&fields=sender,receiver&last-nonce=true&nonce-gaps=true&shard-id=333&cursor=150")
 	expectedValue = common.TransactionsPoolOptions{
 		ShardID:   "333",
 		Sender:    "some_sender",
 		Fields:    "sender,receiver",
 		LastNonce: true,
 		NonceGaps: true,
+		Cursor:    150,
 	}
 	value, err = parseTransactionsPoolQueryOptions(c)
 	require.Nil(t, err)
 	require.Equal(t, expectedValue, value)
+
+	c = createDummyGinContextWithQuery("cursor=invalid")
+	_, err = parseTransactionsPoolQueryOptions(c)
+	require.Error(t, err)
 }
 
 func TestParseStringUrlParam(t *testing.T) {
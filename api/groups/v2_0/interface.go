@@ -0,0 +1,12 @@
+package v2_0
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionFacadeHandlerV2_0 interface defines methods that can be used from facade context variable
+type TransactionFacadeHandlerV2_0 interface {
+	GetTransactionV2(txHash string, sender string, withResults bool) (*data.ApiTransactionResultV2, int, error)
+	GetRelayedTransactionOfInner(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error)
+}
@@ -0,0 +1,105 @@
+package v2_0
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type transactionGroupV2_0 struct {
+	baseTransactionGroup data.GroupHandler
+	facade               TransactionFacadeHandlerV2_0
+}
+
+// NewTransactionGroupV2_0 returns a new instance of transactionGroupV2_0
+func NewTransactionGroupV2_0(baseTransactionGroup data.GroupHandler, facadeHandler data.FacadeHandler) (*transactionGroupV2_0, error) {
+	if check.IfNil(baseTransactionGroup) {
+		return nil, fmt.Errorf("nil base transaction group for v2.0")
+	}
+
+	facade, ok := facadeHandler.(TransactionFacadeHandlerV2_0)
+	if !ok {
+		return nil, groups.ErrWrongTypeAssertion
+	}
+
+	tg := &transactionGroupV2_0{
+		baseTransactionGroup: baseTransactionGroup,
+		facade:               facade,
+	}
+
+	err := tg.baseTransactionGroup.UpdateEndpoint("/:txhash", data.EndpointHandlerData{
+		Path:         "/:txhash",
+		Handler:      tg.getTransaction,
+		Method:       http.MethodGet,
+		Cacheability: data.CacheabilityNoStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tg, nil
+}
+
+// getTransaction returns the transaction in the v2 shape, which also holds its processed status
+func (tg *transactionGroupV2_0) getTransaction(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrTransactionHashMissing.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	withResults, err := parseBoolUrlParam(c, common.UrlParameterWithResults)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrValidationQueryParameterWithResult.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	withRelayed, err := parseBoolUrlParam(c, common.UrlParameterWithRelayed)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, fmt.Sprintf("%s: %s", errors.ErrBadUrlParams.Error(), err.Error()), data.ReturnCodeRequestError)
+		return
+	}
+
+	sender := c.Request.URL.Query().Get("sender")
+	tx, statusCode, err := tg.facade.GetTransactionV2(txHash, sender, withResults)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	response := gin.H{"transaction": tx}
+	if withRelayed {
+		relayedTx, errRelayed := tg.facade.GetRelayedTransactionOfInner(tx.ApiTransactionResult, withResults)
+		if errRelayed != nil {
+			shared.RespondWith(c, http.StatusInternalServerError, nil, fmt.Sprintf("%s: %s", errors.ErrGetRelayedTransaction.Error(), errRelayed.Error()), data.ReturnCodeInternalError)
+			return
+		}
+		if relayedTx != nil {
+			response["relayedTransaction"] = relayedTx
+		}
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
+}
+
+// Group returns the base transaction group
+func (tg *transactionGroupV2_0) Group() data.GroupHandler {
+	return tg.baseTransactionGroup
+}
+
+func parseBoolUrlParam(c *gin.Context, name string) (bool, error) {
+	param := c.Request.URL.Query().Get(name)
+	if param == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(param)
+}
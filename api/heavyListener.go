@@ -0,0 +1,85 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// CreateHeavyListenerServer creates the HTTP server of the heavy endpoints listener, sharing the routes of the provided
+// main server. From now on, the main server rejects the heavy routes, while the returned server serves only them (and
+// the probes), with its own timeouts and concurrency limit
+func CreateHeavyListenerServer(
+	mainServer *http.Server,
+	versionsRegistry data.VersionsRegistryHandler,
+	heavyListenerConfig config.HeavyListenerConfig,
+) (*http.Server, error) {
+	if mainServer == nil || mainServer.Handler == nil {
+		return nil, ErrNilHttpServer
+	}
+	err := checkHeavyListenerConfig(heavyListenerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	versionsMap, err := versionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+	versions := getVersions(versionsMap)
+	probes := []string{ReadinessPath, LivenessPath}
+
+	mainHandler, err := middleware.NewHeavyRoutesFilter(middleware.ArgsHeavyRoutesFilter{
+		Handler:          mainServer.Handler,
+		Versions:         versions,
+		Routes:           heavyListenerConfig.Routes,
+		ServeHeavyRoutes: false,
+		AlwaysServed:     probes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	heavyHandler, err := middleware.NewHeavyRoutesFilter(middleware.ArgsHeavyRoutesFilter{
+		Handler:          mainServer.Handler,
+		Versions:         versions,
+		Routes:           heavyListenerConfig.Routes,
+		ServeHeavyRoutes: true,
+		AlwaysServed:     probes,
+		MaxConcurrent:    heavyListenerConfig.MaxConcurrentRequests,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mainServer.Handler = mainHandler
+
+	return &http.Server{
+		Addr:         fmt.Sprintf(":%d", heavyListenerConfig.Port),
+		Handler:      heavyHandler,
+		ReadTimeout:  time.Duration(heavyListenerConfig.ReadTimeoutInSec) * time.Second,
+		WriteTimeout: time.Duration(heavyListenerConfig.WriteTimeoutInSec) * time.Second,
+		IdleTimeout:  time.Duration(heavyListenerConfig.IdleTimeoutInSec) * time.Second,
+	}, nil
+}
+
+func checkHeavyListenerConfig(cfg config.HeavyListenerConfig) error {
+	if cfg.Port <= 0 {
+		return fmt.Errorf("%w, Port should be positive", ErrInvalidHeavyListenerConfig)
+	}
+	if len(cfg.Routes) == 0 {
+		return fmt.Errorf("%w, at least one route should be provided", ErrInvalidHeavyListenerConfig)
+	}
+	if cfg.ReadTimeoutInSec < 0 || cfg.WriteTimeoutInSec < 0 || cfg.IdleTimeoutInSec < 0 {
+		return fmt.Errorf("%w, the timeouts should not be negative", ErrInvalidHeavyListenerConfig)
+	}
+	if cfg.MaxConcurrentRequests < 0 {
+		return fmt.Errorf("%w, MaxConcurrentRequests should not be negative", ErrInvalidHeavyListenerConfig)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package api_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type versionsRegistryStub struct {
+	versions map[string]*data.VersionData
+}
+
+func (stub *versionsRegistryStub) AddVersion(version string, versionData *data.VersionData) error {
+	stub.versions[version] = versionData
+	return nil
+}
+
+func (stub *versionsRegistryStub) GetAllVersions() (map[string]*data.VersionData, error) {
+	return stub.versions, nil
+}
+
+func (stub *versionsRegistryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createHeavyListenerConfig() config.HeavyListenerConfig {
+	return config.HeavyListenerConfig{
+		Enabled:               true,
+		Port:                  8079,
+		Routes:                []string{"/transaction/pool", "/hyperblock"},
+		ReadTimeoutInSec:      10,
+		WriteTimeoutInSec:     120,
+		IdleTimeoutInSec:      60,
+		MaxConcurrentRequests: 5,
+	}
+}
+
+func TestCreateHeavyListenerServer(t *testing.T) {
+	t.Parallel()
+
+	versionsRegistry := &versionsRegistryStub{
+		versions: map[string]*data.VersionData{"v1.0": {}},
+	}
+	createMainServer := func() *http.Server {
+		return &http.Server{
+			Addr: ":8080",
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}),
+		}
+	}
+
+	t.Run("nil main server should error", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := api.CreateHeavyListenerServer(nil, versionsRegistry, createHeavyListenerConfig())
+		require.Nil(t, server)
+		require.Equal(t, api.ErrNilHttpServer, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidConfig := func(modifier func(cfg *config.HeavyListenerConfig)) {
+			cfg := createHeavyListenerConfig()
+			modifier(&cfg)
+			server, err := api.CreateHeavyListenerServer(createMainServer(), versionsRegistry, cfg)
+			require.Nil(t, server)
+			require.True(t, errors.Is(err, api.ErrInvalidHeavyListenerConfig))
+		}
+
+		testInvalidConfig(func(cfg *config.HeavyListenerConfig) { cfg.Port = 0 })
+		testInvalidConfig(func(cfg *config.HeavyListenerConfig) { cfg.Routes = nil })
+		testInvalidConfig(func(cfg *config.HeavyListenerConfig) { cfg.WriteTimeoutInSec = -1 })
+		testInvalidConfig(func(cfg *config.HeavyListenerConfig) { cfg.MaxConcurrentRequests = -1 })
+	})
+	t.Run("should split the routes", func(t *testing.T) {
+		t.Parallel()
+
+		mainServer := createMainServer()
+		heavyServer, err := api.CreateHeavyListenerServer(mainServer, versionsRegistry, createHeavyListenerConfig())
+		require.NoError(t, err)
+		assert.Equal(t, ":8079", heavyServer.Addr)
+		assert.Equal(t, 120*time.Second, heavyServer.WriteTimeout)
+
+		serve := func(server *http.Server, path string) int {
+			resp := httptest.NewRecorder()
+			server.Handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, path, nil))
+			return resp.Code
+		}
+
+		assert.Equal(t, http.StatusNotFound, serve(mainServer, "/v1.0/transaction/pool"))
+		assert.Equal(t, http.StatusOK, serve(heavyServer, "/v1.0/transaction/pool"))
+		assert.Equal(t, http.StatusOK, serve(mainServer, "/v1.0/transaction/send"))
+		assert.Equal(t, http.StatusNotFound, serve(heavyServer, "/v1.0/transaction/send"))
+		assert.Equal(t, http.StatusOK, serve(mainServer, api.LivenessPath))
+		assert.Equal(t, http.StatusOK, serve(heavyServer, api.LivenessPath))
+	})
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ReadinessHandler defines what a component reporting the readiness of the proxy should do
+type ReadinessHandler interface {
+	GetReadinessStatus() *data.ReadinessStatus
+	IsInterfaceNil() bool
+}
+
+// DisabledRoutesHandler defines what a component holding the routes disabled by the operator should do
+type DisabledRoutesHandler interface {
+	IsGroupDisabled(group string) bool
+	IsRouteDisabled(route string) bool
+	UpdateRoutes(args middleware.ArgsDisabledRoutes) error
+	MiddlewareHandlerFunc() gin.HandlerFunc
+	NoRouteHandlerFunc() gin.HandlerFunc
+	IsInterfaceNil() bool
+}
+
+// RuntimeConfigRegistry defines the component collecting the handlers that apply the reloaded main config at runtime
+type RuntimeConfigRegistry interface {
+	RegisterRuntimeConfigHandler(handler config.RuntimeConfigHandler) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// RequestIDHeader is the header holding the ID of the request. If not provided by the client, it is generated
+const RequestIDHeader = "X-Request-ID"
+
+const (
+	requestIDNumBytes  = 16
+	maxRequestIDLength = 128
+)
+
+type accessLog struct {
+	accessLogHandler AccessLogHandler
+	getTimeHandler   func() time.Time
+}
+
+// NewAccessLog returns a new instance of accessLog
+func NewAccessLog(accessLogHandler AccessLogHandler) (*accessLog, error) {
+	if check.IfNil(accessLogHandler) {
+		return nil, ErrNilAccessLogHandler
+	}
+
+	return &accessLog{
+		accessLogHandler: accessLogHandler,
+		getTimeHandler:   time.Now,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware recording an access log entry for each request. The request ID is
+// sent back on the response, while the observers called with the context of the request are reported by the entry
+func (al *accessLog) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startTime := al.getTimeHandler()
+		requestID := getOrCreateRequestID(c)
+		c.Header(RequestIDHeader, requestID)
+
+		recorder := common.NewObserverCallsRecorder()
+		c.Request = c.Request.WithContext(common.ContextWithObserverCallsRecorder(c.Request.Context(), recorder))
+
+		c.Next()
+
+		entry := &data.AccessLogEntry{
+			Timestamp:    startTime.UTC().Format(time.RFC3339Nano),
+			RequestID:    requestID,
+			Method:       c.Request.Method,
+			Route:        c.FullPath(),
+			Path:         c.Request.URL.Path,
+			ClientIP:     c.ClientIP(),
+			StatusCode:   c.Writer.Status(),
+			LatencyMs:    float64(al.getTimeHandler().Sub(startTime).Microseconds()) / 1000,
+			ResponseSize: c.Writer.Size(),
+			Observers:    recorder.GetCalls(),
+		}
+		if len(entry.Observers) > 0 {
+			entry.UpstreamStatus = entry.Observers[len(entry.Observers)-1].StatusCode
+		}
+
+		al.accessLogHandler.LogEntry(entry)
+	}
+}
+
+func getOrCreateRequestID(c *gin.Context) string {
+	requestID := c.GetHeader(RequestIDHeader)
+	if len(requestID) > 0 && len(requestID) <= maxRequestIDLength && isPrintableASCII(requestID) {
+		return requestID
+	}
+
+	buff := make([]byte, requestIDNumBytes)
+	_, _ = rand.Read(buff)
+
+	return hex.EncodeToString(buff)
+}
+
+func isPrintableASCII(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < ' ' || value[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (al *accessLog) IsInterfaceNil() bool {
+	return al == nil
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+type accessLogHandlerStub struct {
+	mutEntries sync.Mutex
+	entries    []*data.AccessLogEntry
+}
+
+func (stub *accessLogHandlerStub) LogEntry(entry *data.AccessLogEntry) {
+	stub.mutEntries.Lock()
+	stub.entries = append(stub.entries, entry)
+	stub.mutEntries.Unlock()
+}
+
+func (stub *accessLogHandlerStub) getEntries() []*data.AccessLogEntry {
+	stub.mutEntries.Lock()
+	defer stub.mutEntries.Unlock()
+
+	return stub.entries
+}
+
+func (stub *accessLogHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewAccessLog(t *testing.T) {
+	t.Parallel()
+
+	al, err := NewAccessLog(nil)
+	require.True(t, check.IfNil(al))
+	require.Equal(t, ErrNilAccessLogHandler, err)
+
+	al, err = NewAccessLog(&accessLogHandlerStub{})
+	require.False(t, check.IfNil(al))
+	require.NoError(t, err)
+}
+
+func TestAccessLog_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	handler := &accessLogHandlerStub{}
+	al, _ := NewAccessLog(handler)
+
+	ws := gin.New()
+	ws.Use(al.MiddlewareHandlerFunc())
+	ws.GET("/v1.0/address/:address", func(c *gin.Context) {
+		common.RecordObserverCall(c.Request.Context(), "http://observer0", http.StatusOK)
+		common.RecordObserverCall(c.Request.Context(), "http://observer1", http.StatusBadGateway)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "observer failed"})
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/v1.0/address/erd1", nil)
+	req.Header.Set(RequestIDHeader, "client-request-id")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, "client-request-id", resp.Header().Get(RequestIDHeader))
+
+	entries := handler.getEntries()
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	require.Equal(t, "client-request-id", entry.RequestID)
+	require.Equal(t, http.MethodGet, entry.Method)
+	require.Equal(t, "/v1.0/address/:address", entry.Route)
+	require.Equal(t, "/v1.0/address/erd1", entry.Path)
+	require.Equal(t, http.StatusInternalServerError, entry.StatusCode)
+	require.Equal(t, resp.Body.Len(), entry.ResponseSize)
+	require.Equal(t, []*data.ObserverCall{
+		{Address: "http://observer0", StatusCode: http.StatusOK},
+		{Address: "http://observer1", StatusCode: http.StatusBadGateway},
+	}, entry.Observers)
+	require.Equal(t, http.StatusBadGateway, entry.UpstreamStatus)
+
+	// the request ID is generated when missing or invalid
+	req, _ = http.NewRequest(http.MethodGet, "/v1.0/address/erd1", nil)
+	req.Header.Set(RequestIDHeader, "invalid\x01id")
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	entries = handler.getEntries()
+	require.Len(t, entries, 2)
+	require.Len(t, entries[1].RequestID, 2*requestIDNumBytes)
+	require.Equal(t, entries[1].RequestID, resp.Header().Get(RequestIDHeader))
+}
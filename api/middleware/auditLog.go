@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// auditedResponse holds the fields of the responses reported by the audit entries
+type auditedResponse struct {
+	Data struct {
+		TxHash    string         `json:"txHash"`
+		TxsHashes map[int]string `json:"txsHashes"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+type auditLog struct {
+	auditLogHandler AuditLogHandler
+	routes          []string
+	getTimeHandler  func() time.Time
+}
+
+// NewAuditLog returns a new instance of auditLog. The provided routes are the ones audited, when called with a
+// mutating method
+func NewAuditLog(auditLogHandler AuditLogHandler, routes []string) (*auditLog, error) {
+	if check.IfNil(auditLogHandler) {
+		return nil, ErrNilAuditLogHandler
+	}
+
+	return &auditLog{
+		auditLogHandler: auditLogHandler,
+		routes:          routes,
+		getTimeHandler:  time.Now,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware recording an audit entry for each mutating request of the audited
+// routes, holding the client identity, the resulting transactions hashes and the outcome of the request
+func (al *auditLog) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !isMutatingMethod(c.Request.Method) || !al.isAuditedRoute(c.FullPath()) {
+			return
+		}
+
+		startTime := al.getTimeHandler()
+		bw := &bodyWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
+		c.Writer = bw
+
+		c.Next()
+
+		al.auditLogHandler.LogEntry(al.createEntry(c, startTime, bw.body.Bytes()))
+	}
+}
+
+func (al *auditLog) createEntry(c *gin.Context, startTime time.Time, responseBody []byte) *data.AuditEntry {
+	statusCode := c.Writer.Status()
+	entry := &data.AuditEntry{
+		Timestamp:  startTime.UTC().Format(time.RFC3339Nano),
+		Method:     c.Request.Method,
+		Path:       c.Request.URL.Path,
+		ClientIP:   c.ClientIP(),
+		StatusCode: statusCode,
+		Outcome:    data.AuditOutcomeSuccess,
+		DurationMs: al.getTimeHandler().Sub(startTime).Milliseconds(),
+	}
+	entry.User, _, _ = c.Request.BasicAuth()
+	if statusCode >= http.StatusBadRequest {
+		entry.Outcome = data.AuditOutcomeFailure
+	}
+
+	response := &auditedResponse{}
+	err := json.Unmarshal(responseBody, response)
+	if err != nil {
+		return entry
+	}
+
+	entry.Error = response.Error
+	entry.TxHashes = getAuditedTxHashes(response)
+
+	return entry
+}
+
+// getAuditedTxHashes returns the hash of the sent transaction, or the hashes of the sent transactions ordered by their
+// index in the request
+func getAuditedTxHashes(response *auditedResponse) []string {
+	if len(response.Data.TxHash) > 0 {
+		return []string{response.Data.TxHash}
+	}
+	if len(response.Data.TxsHashes) == 0 {
+		return nil
+	}
+
+	indexes := make([]int, 0, len(response.Data.TxsHashes))
+	for index := range response.Data.TxsHashes {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	txHashes := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		txHashes = append(txHashes, response.Data.TxsHashes[index])
+	}
+
+	return txHashes
+}
+
+func (al *auditLog) isAuditedRoute(route string) bool {
+	if len(route) == 0 {
+		return false
+	}
+
+	for _, auditedRoute := range al.routes {
+		if strings.HasSuffix(route, auditedRoute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (al *auditLog) IsInterfaceNil() bool {
+	return al == nil
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type auditLogHandlerStub struct {
+	mutEntries sync.Mutex
+	entries    []*data.AuditEntry
+}
+
+func (stub *auditLogHandlerStub) LogEntry(entry *data.AuditEntry) {
+	stub.mutEntries.Lock()
+	stub.entries = append(stub.entries, entry)
+	stub.mutEntries.Unlock()
+}
+
+func (stub *auditLogHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func startAuditLogServer(t *testing.T, handler AuditLogHandler) *gin.Engine {
+	al, err := NewAuditLog(handler, []string{"/transaction/send", "/transaction/send-multiple", "/actions/fault-injection"})
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(al.MiddlewareHandlerFunc())
+	ws.POST("/v1.0/transaction/send", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": gin.H{"txHash": "hash0"}, "code": "successful"})
+	})
+	ws.POST("/v1.0/transaction/send-multiple", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": gin.H{"txsSent": 3, "txsHashes": gin.H{"10": "hash10", "2": "hash2", "0": "hash0"}}, "code": "successful"})
+	})
+	ws.POST("/v1.0/transaction/simulate", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+	ws.GET("/v1.0/actions/fault-injection", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+	ws.DELETE("/v1.0/actions/fault-injection", func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "expected error", "code": "internal_issue"})
+	})
+
+	return ws
+}
+
+func doAuditLogRequest(ws *gin.Engine, method string, path string, user string) int {
+	req, _ := http.NewRequest(method, path, nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	if len(user) > 0 {
+		req.SetBasicAuth(user, "password")
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewAuditLog(t *testing.T) {
+	t.Parallel()
+
+	al, err := NewAuditLog(nil, nil)
+	require.True(t, check.IfNil(al))
+	require.Equal(t, ErrNilAuditLogHandler, err)
+
+	al, err = NewAuditLog(&auditLogHandlerStub{}, nil)
+	require.False(t, check.IfNil(al))
+	require.NoError(t, err)
+}
+
+func TestAuditLog_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not audited requests should not be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &auditLogHandlerStub{}
+		ws := startAuditLogServer(t, handler)
+		assert.Equal(t, http.StatusOK, doAuditLogRequest(ws, http.MethodPost, "/v1.0/transaction/simulate", ""))
+		assert.Equal(t, http.StatusOK, doAuditLogRequest(ws, http.MethodGet, "/v1.0/actions/fault-injection", "admin"))
+		assert.Equal(t, http.StatusNotFound, doAuditLogRequest(ws, http.MethodPost, "/v1.0/transaction/unknown/send", ""))
+		assert.Empty(t, handler.entries)
+	})
+	t.Run("sent transaction should be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &auditLogHandlerStub{}
+		ws := startAuditLogServer(t, handler)
+		assert.Equal(t, http.StatusOK, doAuditLogRequest(ws, http.MethodPost, "/v1.0/transaction/send", ""))
+		require.Len(t, handler.entries, 1)
+
+		entry := handler.entries[0]
+		assert.Equal(t, http.MethodPost, entry.Method)
+		assert.Equal(t, "/v1.0/transaction/send", entry.Path)
+		assert.Equal(t, "10.0.0.1", entry.ClientIP)
+		assert.Empty(t, entry.User)
+		assert.Equal(t, []string{"hash0"}, entry.TxHashes)
+		assert.Equal(t, http.StatusOK, entry.StatusCode)
+		assert.Equal(t, data.AuditOutcomeSuccess, entry.Outcome)
+		assert.NotEmpty(t, entry.Timestamp)
+	})
+	t.Run("sent transactions should be recorded in the order of the request", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &auditLogHandlerStub{}
+		ws := startAuditLogServer(t, handler)
+		assert.Equal(t, http.StatusOK, doAuditLogRequest(ws, http.MethodPost, "/v1.0/transaction/send-multiple", ""))
+		require.Len(t, handler.entries, 1)
+		assert.Equal(t, []string{"hash0", "hash2", "hash10"}, handler.entries[0].TxHashes)
+	})
+	t.Run("failed admin mutation should be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &auditLogHandlerStub{}
+		ws := startAuditLogServer(t, handler)
+		assert.Equal(t, http.StatusInternalServerError, doAuditLogRequest(ws, http.MethodDelete, "/v1.0/actions/fault-injection", "admin"))
+		require.Len(t, handler.entries, 1)
+
+		entry := handler.entries[0]
+		assert.Equal(t, "admin", entry.User)
+		assert.Empty(t, entry.TxHashes)
+		assert.Equal(t, http.StatusInternalServerError, entry.StatusCode)
+		assert.Equal(t, data.AuditOutcomeFailure, entry.Outcome)
+		assert.Equal(t, "expected error", entry.Error)
+	})
+}
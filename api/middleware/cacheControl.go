@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	cacheControlHeader          = "Cache-Control"
+	noStoreCacheControl         = "no-store"
+	immutableCacheControl       = "public, max-age=31536000, immutable"
+	publicMaxAgeCacheControlFmt = "public, max-age=%d"
+)
+
+// ArgsCacheControl holds the arguments needed for creating a new cacheControl middleware
+type ArgsCacheControl struct {
+	ShortLivedMaxAge time.Duration
+	LongLivedMaxAge  time.Duration
+}
+
+type cacheControl struct {
+	mutHeaderValues sync.RWMutex
+	headerValues    map[data.CacheabilityClass]string
+}
+
+// NewCacheControl returns a new instance of cacheControl
+func NewCacheControl(args ArgsCacheControl) (*cacheControl, error) {
+	headerValues, err := createCacheControlHeaderValues(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cacheControl{
+		headerValues: headerValues,
+	}, nil
+}
+
+func createCacheControlHeaderValues(args ArgsCacheControl) (map[data.CacheabilityClass]string, error) {
+	if args.ShortLivedMaxAge < time.Second {
+		return nil, fmt.Errorf("%w for the short lived responses: %v", ErrInvalidCacheMaxAge, args.ShortLivedMaxAge)
+	}
+	if args.LongLivedMaxAge < args.ShortLivedMaxAge {
+		return nil, fmt.Errorf("%w for the long lived responses: %v", ErrInvalidCacheMaxAge, args.LongLivedMaxAge)
+	}
+
+	return map[data.CacheabilityClass]string{
+		data.CacheabilityNoStore:    noStoreCacheControl,
+		data.CacheabilityShortLived: fmt.Sprintf(publicMaxAgeCacheControlFmt, int(args.ShortLivedMaxAge.Seconds())),
+		data.CacheabilityLongLived:  fmt.Sprintf(publicMaxAgeCacheControlFmt, int(args.LongLivedMaxAge.Seconds())),
+		data.CacheabilityImmutable:  immutableCacheControl,
+	}, nil
+}
+
+// UpdateMaxAges replaces the max ages sent for the next requests, the in-flight ones keeping the previous values
+func (cc *cacheControl) UpdateMaxAges(args ArgsCacheControl) error {
+	headerValues, err := createCacheControlHeaderValues(args)
+	if err != nil {
+		return err
+	}
+
+	cc.mutHeaderValues.Lock()
+	cc.headerValues = headerValues
+	cc.mutHeaderValues.Unlock()
+
+	return nil
+}
+
+func (cc *cacheControl) getHeaderValues() map[data.CacheabilityClass]string {
+	cc.mutHeaderValues.RLock()
+	defer cc.mutHeaderValues.RUnlock()
+
+	return cc.headerValues
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that sets the Cache-Control header of the response, based on the
+// cacheability class declared by the endpoint. Only the successful and the not modified responses are cacheable, all
+// the others being sent with no-store. The endpoints that do not declare a cacheability class are left untouched
+func (cc *cacheControl) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer = &cacheControlWriter{
+			ResponseWriter: c.Writer,
+			context:        c,
+			headerValues:   cc.getHeaderValues(),
+		}
+
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cc *cacheControl) IsInterfaceNil() bool {
+	return cc == nil
+}
+
+type cacheControlWriter struct {
+	gin.ResponseWriter
+	context      *gin.Context
+	headerValues map[data.CacheabilityClass]string
+}
+
+// WriteHeader sets the Cache-Control header right before the status code is recorded
+func (w *cacheControlWriter) WriteHeader(code int) {
+	w.setCacheControlHeader(code)
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// WriteHeaderNow sets the Cache-Control header right before the headers are sent
+func (w *cacheControlWriter) WriteHeaderNow() {
+	w.setCacheControlHeader(w.ResponseWriter.Status())
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+// Write sets the Cache-Control header, if the status code was not explicitly written, then writes the response
+func (w *cacheControlWriter) Write(b []byte) (int, error) {
+	w.setCacheControlHeader(w.ResponseWriter.Status())
+	return w.ResponseWriter.Write(b)
+}
+
+// WriteString sets the Cache-Control header, if the status code was not explicitly written, then writes the response
+func (w *cacheControlWriter) WriteString(s string) (int, error) {
+	w.setCacheControlHeader(w.ResponseWriter.Status())
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *cacheControlWriter) setCacheControlHeader(code int) {
+	if w.ResponseWriter.Written() {
+		return
+	}
+
+	value, found := w.context.Get(data.CacheabilityContextKey)
+	if !found {
+		return
+	}
+	class, ok := value.(data.CacheabilityClass)
+	if !ok {
+		return
+	}
+
+	headerValue := noStoreCacheControl
+	isCacheable := code == http.StatusOK || code == http.StatusNotModified
+	if isCacheable {
+		headerValue, ok = w.headerValues[class]
+		if !ok {
+			return
+		}
+	}
+
+	w.Header().Set(cacheControlHeader, headerValue)
+}
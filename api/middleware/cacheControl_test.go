@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsCacheControl() ArgsCacheControl {
+	return ArgsCacheControl{
+		ShortLivedMaxAge: 6 * time.Second,
+		LongLivedMaxAge:  10 * time.Minute,
+	}
+}
+
+func doCacheControlRequest(t *testing.T, cacheability data.CacheabilityClass, code int) *httptest.ResponseRecorder {
+	cc, err := NewCacheControl(createArgsCacheControl())
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(cc.MiddlewareHandlerFunc())
+	ws.GET("/v1.0/block/by-hash/:hash", func(c *gin.Context) {
+		if len(cacheability) > 0 {
+			c.Set(data.CacheabilityContextKey, cacheability)
+		}
+		c.JSON(code, gin.H{"data": "ok"})
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/v1.0/block/by-hash/aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewCacheControl(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid short lived max age should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCacheControl()
+		args.ShortLivedMaxAge = time.Millisecond
+		cc, err := NewCacheControl(args)
+		assert.True(t, check.IfNil(cc))
+		assert.ErrorIs(t, err, ErrInvalidCacheMaxAge)
+	})
+	t.Run("long lived max age lower than the short lived one should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCacheControl()
+		args.LongLivedMaxAge = time.Second
+		cc, err := NewCacheControl(args)
+		assert.True(t, check.IfNil(cc))
+		assert.ErrorIs(t, err, ErrInvalidCacheMaxAge)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cc, err := NewCacheControl(createArgsCacheControl())
+		assert.False(t, check.IfNil(cc))
+		assert.NoError(t, err)
+	})
+}
+
+func TestCacheControl_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("endpoint without cacheability class should not set the header", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doCacheControlRequest(t, "", http.StatusOK)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(cacheControlHeader))
+	})
+	t.Run("should set the header according to the cacheability class", func(t *testing.T) {
+		t.Parallel()
+
+		expectedHeaders := map[data.CacheabilityClass]string{
+			data.CacheabilityNoStore:    "no-store",
+			data.CacheabilityShortLived: "public, max-age=6",
+			data.CacheabilityLongLived:  "public, max-age=600",
+			data.CacheabilityImmutable:  "public, max-age=31536000, immutable",
+		}
+		for cacheability, expectedHeader := range expectedHeaders {
+			resp := doCacheControlRequest(t, cacheability, http.StatusOK)
+			assert.Equal(t, expectedHeader, resp.Header().Get(cacheControlHeader), cacheability)
+		}
+	})
+	t.Run("unsuccessful responses should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		for _, code := range []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError} {
+			resp := doCacheControlRequest(t, data.CacheabilityImmutable, code)
+			assert.Equal(t, code, resp.Code)
+			assert.Equal(t, "no-store", resp.Header().Get(cacheControlHeader))
+		}
+	})
+	t.Run("unknown cacheability class should not set the header", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doCacheControlRequest(t, "unknown", http.StatusOK)
+		assert.Empty(t, resp.Header().Get(cacheControlHeader))
+	})
+}
+
+func TestCacheControl_UpdateMaxAges(t *testing.T) {
+	t.Parallel()
+
+	cc, _ := NewCacheControl(createArgsCacheControl())
+	ws := gin.New()
+	ws.Use(cc.MiddlewareHandlerFunc())
+	ws.GET("/v1.0/network/status/:shard", func(c *gin.Context) {
+		c.Set(data.CacheabilityContextKey, data.CacheabilityShortLived)
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+	getHeader := func() string {
+		req, _ := http.NewRequest(http.MethodGet, "/v1.0/network/status/0", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp.Header().Get(cacheControlHeader)
+	}
+
+	args := createArgsCacheControl()
+	args.ShortLivedMaxAge = 0
+	err := cc.UpdateMaxAges(args)
+	assert.ErrorIs(t, err, ErrInvalidCacheMaxAge)
+	assert.Equal(t, "public, max-age=6", getHeader())
+
+	args.ShortLivedMaxAge = 12 * time.Second
+	err = cc.UpdateMaxAges(args)
+	require.NoError(t, err)
+	assert.Equal(t, "public, max-age=12", getHeader())
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type clientStats struct {
+	clientStatsHandler ClientStatsHandler
+	keyHeader          string
+	routes             []string
+}
+
+// NewClientStats returns a new instance of clientStats. The provided routes are the send routes whose transactions
+// are counted for the client key found in the provided header
+func NewClientStats(clientStatsHandler ClientStatsHandler, keyHeader string, routes []string) (*clientStats, error) {
+	if check.IfNil(clientStatsHandler) {
+		return nil, ErrNilClientStatsHandler
+	}
+	if len(keyHeader) == 0 {
+		return nil, ErrEmptyClientKeyHeader
+	}
+
+	return &clientStats{
+		clientStatsHandler: clientStatsHandler,
+		keyHeader:          keyHeader,
+		routes:             routes,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware registering the transactions sent by the successful POST requests
+// of the tracked routes, on behalf of the client key of the request. The requests without a client key are skipped
+func (cs *clientStats) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodPost || !cs.isTrackedRoute(c.FullPath()) {
+			return
+		}
+		clientKey := c.GetHeader(cs.keyHeader)
+		if len(clientKey) == 0 {
+			return
+		}
+
+		bw := &bodyWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
+		c.Writer = bw
+
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+
+		response := &auditedResponse{}
+		err := json.Unmarshal(bw.body.Bytes(), response)
+		if err != nil {
+			return
+		}
+
+		cs.clientStatsHandler.RegisterSentTransactions(clientKey, getAuditedTxHashes(response))
+	}
+}
+
+func (cs *clientStats) isTrackedRoute(route string) bool {
+	if len(route) == 0 {
+		return false
+	}
+
+	for _, trackedRoute := range cs.routes {
+		if strings.HasSuffix(route, trackedRoute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cs *clientStats) IsInterfaceNil() bool {
+	return cs == nil
+}
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type clientStatsHandlerStub struct {
+	mutSent sync.Mutex
+	sent    map[string][]string
+}
+
+func (stub *clientStatsHandlerStub) RegisterSentTransactions(clientKey string, txHashes []string) {
+	stub.mutSent.Lock()
+	if stub.sent == nil {
+		stub.sent = make(map[string][]string)
+	}
+	stub.sent[clientKey] = append(stub.sent[clientKey], txHashes...)
+	stub.mutSent.Unlock()
+}
+
+func (stub *clientStatsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func startClientStatsServer(t *testing.T, handler ClientStatsHandler) *gin.Engine {
+	cs, err := NewClientStats(handler, "X-Api-Key", []string{"/transaction/send", "/transaction/send-multiple"})
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(cs.MiddlewareHandlerFunc())
+	ws.POST("/v1.0/transaction/send", func(c *gin.Context) {
+		if c.Query("fail") == "true" {
+			c.JSON(http.StatusBadRequest, gin.H{"data": gin.H{"txHash": "hash0"}, "error": "expected error", "code": "bad_request"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"data": gin.H{"txHash": "hash0"}, "code": "successful"})
+	})
+	ws.POST("/v1.0/transaction/send-multiple", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": gin.H{"txsSent": 2, "txsHashes": gin.H{"1": "hash1", "0": "hash0"}}, "code": "successful"})
+	})
+	ws.POST("/v1.0/transaction/simulate", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": gin.H{"txHash": "hash0"}, "code": "successful"})
+	})
+
+	return ws
+}
+
+func doClientStatsRequest(ws *gin.Engine, path string, clientKey string) int {
+	req, _ := http.NewRequest(http.MethodPost, path, nil)
+	if len(clientKey) > 0 {
+		req.Header.Set("X-Api-Key", clientKey)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewClientStats(t *testing.T) {
+	t.Parallel()
+
+	cs, err := NewClientStats(nil, "X-Api-Key", nil)
+	require.True(t, check.IfNil(cs))
+	require.Equal(t, ErrNilClientStatsHandler, err)
+
+	cs, err = NewClientStats(&clientStatsHandlerStub{}, "", nil)
+	require.True(t, check.IfNil(cs))
+	require.Equal(t, ErrEmptyClientKeyHeader, err)
+
+	cs, err = NewClientStats(&clientStatsHandlerStub{}, "X-Api-Key", nil)
+	require.False(t, check.IfNil(cs))
+	require.NoError(t, err)
+}
+
+func TestClientStats_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requests without key or on other routes should not be registered", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &clientStatsHandlerStub{}
+		ws := startClientStatsServer(t, handler)
+		assert.Equal(t, http.StatusOK, doClientStatsRequest(ws, "/v1.0/transaction/send", ""))
+		assert.Equal(t, http.StatusOK, doClientStatsRequest(ws, "/v1.0/transaction/simulate", "client"))
+		assert.Empty(t, handler.sent)
+	})
+	t.Run("failed request should not be registered", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &clientStatsHandlerStub{}
+		ws := startClientStatsServer(t, handler)
+		assert.Equal(t, http.StatusBadRequest, doClientStatsRequest(ws, "/v1.0/transaction/send?fail=true", "client"))
+		assert.Empty(t, handler.sent)
+	})
+	t.Run("sent transactions should be registered for the client key", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &clientStatsHandlerStub{}
+		ws := startClientStatsServer(t, handler)
+		assert.Equal(t, http.StatusOK, doClientStatsRequest(ws, "/v1.0/transaction/send", "client"))
+		assert.Equal(t, http.StatusOK, doClientStatsRequest(ws, "/v1.0/transaction/send-multiple", "client"))
+		assert.Equal(t, http.StatusOK, doClientStatsRequest(ws, "/v1.0/transaction/send", "other"))
+		assert.Equal(t, map[string][]string{
+			"client": {"hash0", "hash0", "hash1"},
+			"other":  {"hash0"},
+		}, handler.sent)
+	})
+}
@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const disabledRouteMsg = "this route is disabled on this proxy"
+
+// ArgsDisabledRoutes holds the arguments needed for creating a new disabledRoutes instance
+type ArgsDisabledRoutes struct {
+	DisabledRoutes []string
+	ExposedRoutes  []string
+	Versions       []string
+	StatusCode     int
+}
+
+type disabledRoutes struct {
+	mutRoutes      sync.RWMutex
+	disabledRoutes []string
+	exposedRoutes  []string
+	versions       map[string]struct{}
+	statusCode     int
+}
+
+// NewDisabledRoutes returns a new instance of disabledRoutes. The routes are unversioned and prefixed by their group,
+// as in /transaction/send, while a group alone, as in /validator, stands for all the routes of the group. When exposed
+// routes are provided, all the other routes are disabled. A status code of 0 defaults to 404 Not Found
+func NewDisabledRoutes(args ArgsDisabledRoutes) (*disabledRoutes, error) {
+	dr := &disabledRoutes{}
+	err := dr.UpdateRoutes(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return dr, nil
+}
+
+// UpdateRoutes replaces the disabled and the exposed routes, along with the status code, for the next requests
+func (dr *disabledRoutes) UpdateRoutes(args ArgsDisabledRoutes) error {
+	statusCode := args.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusNotFound
+	}
+	if statusCode != http.StatusNotFound && statusCode != http.StatusForbidden {
+		return ErrInvalidDisabledRoutesStatusCode
+	}
+
+	versions := make(map[string]struct{})
+	for _, version := range args.Versions {
+		if len(version) > 0 {
+			versions[version] = struct{}{}
+		}
+	}
+
+	dr.mutRoutes.Lock()
+	dr.disabledRoutes = args.DisabledRoutes
+	dr.exposedRoutes = args.ExposedRoutes
+	dr.versions = versions
+	dr.statusCode = statusCode
+	dr.mutRoutes.Unlock()
+
+	return nil
+}
+
+// IsGroupDisabled returns true if none of the routes of the provided group, such as /address, is enabled
+func (dr *disabledRoutes) IsGroupDisabled(group string) bool {
+	dr.mutRoutes.RLock()
+	defer dr.mutRoutes.RUnlock()
+
+	if isRouteListed(dr.disabledRoutes, group) {
+		return true
+	}
+	if len(dr.exposedRoutes) == 0 {
+		return false
+	}
+
+	for _, exposedRoute := range dr.exposedRoutes {
+		if exposedRoute == group || strings.HasPrefix(exposedRoute, group+"/") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsRouteDisabled returns true if the provided route, as defined in the api config files and prefixed by its group,
+// is disabled
+func (dr *disabledRoutes) IsRouteDisabled(route string) bool {
+	dr.mutRoutes.RLock()
+	defer dr.mutRoutes.RUnlock()
+
+	return dr.isRouteDisabled(route)
+}
+
+func (dr *disabledRoutes) isRouteDisabled(route string) bool {
+	if isRouteListed(dr.disabledRoutes, route) {
+		return true
+	}
+
+	return len(dr.exposedRoutes) > 0 && !isRouteListed(dr.exposedRoutes, route)
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects the requests towards the registered routes that are
+// disabled. As the routes are checked on each request, they can be disabled or enabled again at runtime
+func (dr *disabledRoutes) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			return
+		}
+
+		dr.mutRoutes.RLock()
+		isDisabled := dr.isRouteDisabled(dr.removeVersion(route))
+		statusCode := dr.statusCode
+		dr.mutRoutes.RUnlock()
+		if !isDisabled {
+			return
+		}
+
+		abortDisabledRoute(c, statusCode)
+	}
+}
+
+// NoRouteHandlerFunc returns the gin handler of the requests not matching any registered route. The requests towards
+// a disabled route are answered with the configured status code and a structured error, while the other ones are left
+// to the default not found response
+func (dr *disabledRoutes) NoRouteHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		dr.mutRoutes.RLock()
+		path := dr.removeVersion(c.Request.URL.Path)
+		isDisabled := isPathMatched(dr.disabledRoutes, path) ||
+			(len(dr.exposedRoutes) > 0 && !isPathMatched(dr.exposedRoutes, path))
+		statusCode := dr.statusCode
+		dr.mutRoutes.RUnlock()
+		if !isDisabled {
+			return
+		}
+
+		abortDisabledRoute(c, statusCode)
+	}
+}
+
+func abortDisabledRoute(c *gin.Context, statusCode int) {
+	c.AbortWithStatusJSON(statusCode, data.GenericAPIResponse{
+		Data:  nil,
+		Error: disabledRouteMsg,
+		Code:  data.ReturnCodeRequestError,
+	})
+}
+
+func (dr *disabledRoutes) removeVersion(path string) string {
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	_, isVersion := dr.versions[segments[0]]
+	if !isVersion {
+		return path
+	}
+	if len(segments) == 1 {
+		return "/"
+	}
+
+	return "/" + segments[1]
+}
+
+// isRouteListed returns true if the route, or one of its parents, is in the provided list
+func isRouteListed(list []string, route string) bool {
+	for _, listedRoute := range list {
+		if route == listedRoute || strings.HasPrefix(route, listedRoute+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPathMatched returns true if the request path falls under one of the routes of the provided list, whose parameter
+// segments (such as :address) match any value
+func isPathMatched(list []string, path string) bool {
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, listedRoute := range list {
+		if areSegmentsMatched(strings.Split(strings.Trim(listedRoute, "/"), "/"), pathSegments) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func areSegmentsMatched(routeSegments []string, pathSegments []string) bool {
+	if len(routeSegments) > len(pathSegments) {
+		return false
+	}
+
+	for idx, routeSegment := range routeSegments {
+		if strings.HasPrefix(routeSegment, "*") {
+			return true
+		}
+		if strings.HasPrefix(routeSegment, ":") {
+			continue
+		}
+		if routeSegment != pathSegments[idx] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dr *disabledRoutes) IsInterfaceNil() bool {
+	return dr == nil
+}
@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDisabledRoutes(t *testing.T) {
+	t.Parallel()
+
+	dr, err := NewDisabledRoutes(ArgsDisabledRoutes{StatusCode: http.StatusInternalServerError})
+	require.True(t, check.IfNil(dr))
+	require.Equal(t, ErrInvalidDisabledRoutesStatusCode, err)
+
+	dr, err = NewDisabledRoutes(ArgsDisabledRoutes{})
+	require.NoError(t, err)
+	require.False(t, check.IfNil(dr))
+	require.Equal(t, http.StatusNotFound, dr.statusCode)
+
+	dr, err = NewDisabledRoutes(ArgsDisabledRoutes{StatusCode: http.StatusForbidden})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, dr.statusCode)
+}
+
+func TestDisabledRoutes_IsRouteDisabled(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled routes", func(t *testing.T) {
+		t.Parallel()
+
+		dr, _ := NewDisabledRoutes(ArgsDisabledRoutes{
+			DisabledRoutes: []string{"/validator", "/transaction/send", "/network/status"},
+		})
+
+		assert.True(t, dr.IsGroupDisabled("/validator"))
+		assert.False(t, dr.IsGroupDisabled("/transaction"))
+		assert.True(t, dr.IsRouteDisabled("/validator/statistics"))
+		assert.True(t, dr.IsRouteDisabled("/transaction/send"))
+		assert.False(t, dr.IsRouteDisabled("/transaction/send-multiple"))
+		assert.True(t, dr.IsRouteDisabled("/network/status/:shard"))
+		assert.False(t, dr.IsRouteDisabled("/network/config"))
+	})
+	t.Run("exposed routes", func(t *testing.T) {
+		t.Parallel()
+
+		dr, _ := NewDisabledRoutes(ArgsDisabledRoutes{
+			ExposedRoutes: []string{"/transaction/send", "/address"},
+		})
+
+		assert.False(t, dr.IsGroupDisabled("/transaction"))
+		assert.False(t, dr.IsGroupDisabled("/address"))
+		assert.True(t, dr.IsGroupDisabled("/network"))
+		assert.False(t, dr.IsRouteDisabled("/transaction/send"))
+		assert.True(t, dr.IsRouteDisabled("/transaction/send-multiple"))
+		assert.False(t, dr.IsRouteDisabled("/address/:address"))
+		assert.True(t, dr.IsRouteDisabled("/network/config"))
+	})
+	t.Run("disabled route within exposed group", func(t *testing.T) {
+		t.Parallel()
+
+		dr, _ := NewDisabledRoutes(ArgsDisabledRoutes{
+			DisabledRoutes: []string{"/address/:address/keys"},
+			ExposedRoutes:  []string{"/address"},
+		})
+
+		assert.False(t, dr.IsGroupDisabled("/address"))
+		assert.False(t, dr.IsRouteDisabled("/address/:address"))
+		assert.True(t, dr.IsRouteDisabled("/address/:address/keys"))
+	})
+}
+
+func TestDisabledRoutes_NoRouteHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	startServer := func(args ArgsDisabledRoutes) *gin.Engine {
+		dr, err := NewDisabledRoutes(args)
+		require.NoError(t, err)
+
+		ws := gin.New()
+		ws.NoRoute(dr.NoRouteHandlerFunc())
+		ws.GET("/v1.0/network/config", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"data": "ok"})
+		})
+
+		return ws
+	}
+	doRequest := func(ws *gin.Engine, path string) (int, *data.GenericAPIResponse) {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &data.GenericAPIResponse{}
+		err := json.Unmarshal(resp.Body.Bytes(), response)
+		if err != nil {
+			return resp.Code, nil
+		}
+
+		return resp.Code, response
+	}
+
+	t.Run("disabled routes", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startServer(ArgsDisabledRoutes{
+			DisabledRoutes: []string{"/validator", "/address/:address/keys"},
+			Versions:       []string{"", "v1.0"},
+			StatusCode:     http.StatusForbidden,
+		})
+
+		code, response := doRequest(ws, "/v1.0/validator/statistics")
+		assert.Equal(t, http.StatusForbidden, code)
+		require.NotNil(t, response)
+		assert.Equal(t, disabledRouteMsg, response.Error)
+		assert.Equal(t, data.ReturnCodeRequestError, response.Code)
+
+		code, response = doRequest(ws, "/address/erd1abc/keys")
+		assert.Equal(t, http.StatusForbidden, code)
+		require.NotNil(t, response)
+		assert.Equal(t, disabledRouteMsg, response.Error)
+
+		// unknown routes are answered with the default not found response
+		code, response = doRequest(ws, "/v1.0/address/erd1abc/unknown")
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Nil(t, response)
+
+		code, _ = doRequest(ws, "/v1.0/network/config")
+		assert.Equal(t, http.StatusOK, code)
+	})
+	t.Run("exposed routes", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startServer(ArgsDisabledRoutes{
+			ExposedRoutes: []string{"/transaction/send", "/network"},
+			Versions:      []string{"v1.0"},
+		})
+
+		code, response := doRequest(ws, "/v1.0/address/erd1abc")
+		assert.Equal(t, http.StatusNotFound, code)
+		require.NotNil(t, response)
+		assert.Equal(t, disabledRouteMsg, response.Error)
+
+		code, _ = doRequest(ws, "/v1.0/network/config")
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
+
+func TestDisabledRoutes_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	dr, _ := NewDisabledRoutes(ArgsDisabledRoutes{
+		DisabledRoutes: []string{"/transaction/send"},
+		Versions:       []string{"v1.0"},
+	})
+
+	ws := gin.New()
+	versionGroup := ws.Group("v1.0")
+	versionGroup.Use(dr.MiddlewareHandlerFunc())
+	okHandler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	}
+	versionGroup.POST("/transaction/send", okHandler)
+	versionGroup.GET("/address/:address", okHandler)
+	doRequest := func(method string, path string) int {
+		req, _ := http.NewRequest(method, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp.Code
+	}
+
+	assert.Equal(t, http.StatusNotFound, doRequest(http.MethodPost, "/v1.0/transaction/send"))
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodGet, "/v1.0/address/erd1abc"))
+
+	err := dr.UpdateRoutes(ArgsDisabledRoutes{StatusCode: http.StatusInternalServerError})
+	require.Equal(t, ErrInvalidDisabledRoutesStatusCode, err)
+	assert.Equal(t, http.StatusNotFound, doRequest(http.MethodPost, "/v1.0/transaction/send"))
+
+	err = dr.UpdateRoutes(ArgsDisabledRoutes{
+		DisabledRoutes: []string{"/address"},
+		Versions:       []string{"v1.0"},
+		StatusCode:     http.StatusForbidden,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodPost, "/v1.0/transaction/send"))
+	assert.Equal(t, http.StatusForbidden, doRequest(http.MethodGet, "/v1.0/address/erd1abc"))
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	actionsRoutePrefix     = "/actions/"
+	drainWritesRejectedMsg = "the proxy is draining and does not accept write requests"
+	drainReadsRejectedMsg  = "the proxy is draining and does not accept requests anymore"
+)
+
+type drainMode struct {
+	drainStatusHandler DrainStatusHandler
+	writeRoutes        []string
+}
+
+// NewDrainMode returns a new instance of drainMode. The provided routes are the ones considered write requests
+func NewDrainMode(drainStatusHandler DrainStatusHandler, writeRoutes []string) (*drainMode, error) {
+	if check.IfNil(drainStatusHandler) {
+		return nil, ErrNilDrainStatusHandler
+	}
+
+	return &drainMode{
+		drainStatusHandler: drainStatusHandler,
+		writeRoutes:        writeRoutes,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects the requests with 503 Service Unavailable while the
+// proxy is draining. The write requests are rejected as soon as the drain started, while the other requests are
+// rejected only after the reads window elapsed. The actions requests are always served
+func (dm *drainMode) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if strings.Contains(route, actionsRoutePrefix) {
+			return
+		}
+
+		if !dm.drainStatusHandler.IsReadAllowed() {
+			dm.abortRequest(c, drainReadsRejectedMsg)
+			return
+		}
+		if !dm.drainStatusHandler.IsWriteAllowed() && dm.isWriteRoute(route) {
+			dm.abortRequest(c, drainWritesRejectedMsg)
+			return
+		}
+	}
+}
+
+func (dm *drainMode) abortRequest(c *gin.Context, message string) {
+	c.AbortWithStatusJSON(http.StatusServiceUnavailable, data.GenericAPIResponse{
+		Data:  nil,
+		Error: message,
+		Code:  data.ReturnCodeInternalError,
+	})
+}
+
+func (dm *drainMode) isWriteRoute(route string) bool {
+	if len(route) == 0 {
+		return false
+	}
+
+	for _, writeRoute := range dm.writeRoutes {
+		if strings.HasSuffix(route, writeRoute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dm *drainMode) IsInterfaceNil() bool {
+	return dm == nil
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type drainStatusHandlerStub struct {
+	isWriteAllowed bool
+	isReadAllowed  bool
+}
+
+func (stub *drainStatusHandlerStub) IsWriteAllowed() bool {
+	return stub.isWriteAllowed
+}
+
+func (stub *drainStatusHandlerStub) IsReadAllowed() bool {
+	return stub.isReadAllowed
+}
+
+func (stub *drainStatusHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func startDrainModeServer(t *testing.T, handler DrainStatusHandler) *gin.Engine {
+	dm, err := NewDrainMode(handler, []string{"/transaction/send"})
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(dm.MiddlewareHandlerFunc())
+	okHandler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	}
+	ws.POST("/v1.0/transaction/send", okHandler)
+	ws.GET("/v1.0/address/:address", okHandler)
+	ws.GET("/v1.0/actions/drain-status", okHandler)
+
+	return ws
+}
+
+func doDrainModeRequest(ws *gin.Engine, method string, path string) int {
+	req, _ := http.NewRequest(method, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewDrainMode(t *testing.T) {
+	t.Parallel()
+
+	dm, err := NewDrainMode(nil, nil)
+	require.True(t, check.IfNil(dm))
+	require.Equal(t, ErrNilDrainStatusHandler, err)
+
+	dm, err = NewDrainMode(&drainStatusHandlerStub{}, nil)
+	require.False(t, check.IfNil(dm))
+	require.NoError(t, err)
+}
+
+func TestDrainMode_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not draining should serve all requests", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startDrainModeServer(t, &drainStatusHandlerStub{isWriteAllowed: true, isReadAllowed: true})
+		assert.Equal(t, http.StatusOK, doDrainModeRequest(ws, http.MethodPost, "/v1.0/transaction/send"))
+		assert.Equal(t, http.StatusOK, doDrainModeRequest(ws, http.MethodGet, "/v1.0/address/erd1"))
+		assert.Equal(t, http.StatusOK, doDrainModeRequest(ws, http.MethodGet, "/v1.0/actions/drain-status"))
+	})
+
+	t.Run("draining within the reads window should reject only the writes", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startDrainModeServer(t, &drainStatusHandlerStub{isWriteAllowed: false, isReadAllowed: true})
+		assert.Equal(t, http.StatusServiceUnavailable, doDrainModeRequest(ws, http.MethodPost, "/v1.0/transaction/send"))
+		assert.Equal(t, http.StatusOK, doDrainModeRequest(ws, http.MethodGet, "/v1.0/address/erd1"))
+		assert.Equal(t, http.StatusOK, doDrainModeRequest(ws, http.MethodGet, "/v1.0/actions/drain-status"))
+	})
+
+	t.Run("draining after the reads window should reject all but the actions", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startDrainModeServer(t, &drainStatusHandlerStub{isWriteAllowed: false, isReadAllowed: false})
+		assert.Equal(t, http.StatusServiceUnavailable, doDrainModeRequest(ws, http.MethodPost, "/v1.0/transaction/send"))
+		assert.Equal(t, http.StatusServiceUnavailable, doDrainModeRequest(ws, http.MethodGet, "/v1.0/address/erd1"))
+		assert.Equal(t, http.StatusOK, doDrainModeRequest(ws, http.MethodGet, "/v1.0/actions/drain-status"))
+	})
+}
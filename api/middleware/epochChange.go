@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type epochChange struct {
+	epochChangeHandler EpochChangeHandler
+	retryAfterSeconds  string
+}
+
+// NewEpochChange returns a new instance of epochChange
+func NewEpochChange(epochChangeHandler EpochChangeHandler, retryAfter time.Duration) (*epochChange, error) {
+	if check.IfNil(epochChangeHandler) {
+		return nil, ErrNilEpochChangeHandler
+	}
+	if retryAfter < time.Second {
+		return nil, ErrInvalidRetryAfter
+	}
+
+	return &epochChange{
+		epochChangeHandler: epochChangeHandler,
+		retryAfterSeconds:  strconv.Itoa(int(retryAfter.Seconds())),
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that adds a Retry-After header to the server error responses sent
+// while the epoch change is pending, so the clients retry the requests the observers could not serve once the new
+// epoch is settled
+func (ec *epochChange) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer = &epochChangeWriter{
+			ResponseWriter: c.Writer,
+			epochChange:    ec,
+		}
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ec *epochChange) IsInterfaceNil() bool {
+	return ec == nil
+}
+
+type epochChangeWriter struct {
+	gin.ResponseWriter
+	epochChange *epochChange
+}
+
+// WriteHeader sets the Retry-After header before writing the status code of a server error sent during the epoch change
+func (w *epochChangeWriter) WriteHeader(statusCode int) {
+	isServerError := statusCode >= http.StatusInternalServerError
+	if isServerError && len(w.Header().Get(retryAfterHeader)) == 0 && w.epochChange.epochChangeHandler.IsEpochChangePending() {
+		w.Header().Set(retryAfterHeader, w.epochChange.retryAfterSeconds)
+	}
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
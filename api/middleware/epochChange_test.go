@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type epochChangeHandlerStub struct {
+	isPending bool
+}
+
+func (stub *epochChangeHandlerStub) IsEpochChangePending() bool {
+	return stub.isPending
+}
+
+func (stub *epochChangeHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func startEpochChangeServer(t *testing.T, handler EpochChangeHandler) *gin.Engine {
+	ec, err := NewEpochChange(handler, 6*time.Second)
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(ec.MiddlewareHandlerFunc())
+	ws.GET("/ok", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+	ws.GET("/fail", func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "old epoch"})
+	})
+	ws.GET("/bad", func(c *gin.Context) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "bad request"})
+	})
+
+	return ws
+}
+
+func doEpochChangeRequest(ws *gin.Engine, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewEpochChange(t *testing.T) {
+	t.Parallel()
+
+	ec, err := NewEpochChange(nil, time.Second)
+	require.Nil(t, ec)
+	require.Equal(t, ErrNilEpochChangeHandler, err)
+
+	ec, err = NewEpochChange(&epochChangeHandlerStub{}, time.Millisecond)
+	require.Nil(t, ec)
+	require.Equal(t, ErrInvalidRetryAfter, err)
+
+	ec, err = NewEpochChange(&epochChangeHandlerStub{}, time.Second)
+	require.NoError(t, err)
+	require.False(t, ec.IsInterfaceNil())
+}
+
+func TestEpochChange_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	handler := &epochChangeHandlerStub{}
+	ws := startEpochChangeServer(t, handler)
+
+	resp := doEpochChangeRequest(ws, "/fail")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Empty(t, resp.Header().Get(retryAfterHeader))
+
+	handler.isPending = true
+	resp = doEpochChangeRequest(ws, "/fail")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, "6", resp.Header().Get(retryAfterHeader))
+
+	resp = doEpochChangeRequest(ws, "/bad")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Empty(t, resp.Header().Get(retryAfterHeader))
+
+	resp = doEpochChangeRequest(ws, "/ok")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resp.Header().Get(retryAfterHeader))
+}
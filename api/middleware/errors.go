@@ -7,3 +7,60 @@ var ErrNilLimitsMapForEndpoints = errors.New("nil limits map")
 
 // ErrNilStatusMetricsExtractor signals that a nil status metrics extractor has been provided
 var ErrNilStatusMetricsExtractor = errors.New("nil status metrics extractor")
+
+// ErrInvalidTokenBucketLimits signals that invalid token bucket limits have been provided
+var ErrInvalidTokenBucketLimits = errors.New("invalid token bucket limits")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilDrainStatusHandler signals that a nil drain status handler has been provided
+var ErrNilDrainStatusHandler = errors.New("nil drain status handler")
+
+// ErrNilHttpHandler signals that a nil http handler has been provided
+var ErrNilHttpHandler = errors.New("nil http handler")
+
+// ErrInvalidMaxRequestTimeout signals that an invalid maximum request timeout has been provided
+var ErrInvalidMaxRequestTimeout = errors.New("invalid maximum request timeout")
+
+// ErrInvalidCacheMaxAge signals that an invalid cache max age has been provided
+var ErrInvalidCacheMaxAge = errors.New("invalid cache max age")
+
+// ErrInvalidDisabledRoutesStatusCode signals that an invalid status code has been provided for the disabled routes
+var ErrInvalidDisabledRoutesStatusCode = errors.New("invalid disabled routes status code, only 404 and 403 are allowed")
+
+// ErrInvalidCIDR signals that an invalid CIDR range or IP address has been provided
+var ErrInvalidCIDR = errors.New("invalid CIDR range")
+
+// ErrNilAuditLogHandler signals that a nil audit log handler has been provided
+var ErrNilAuditLogHandler = errors.New("nil audit log handler")
+
+// ErrNilLoadSheddingHandler signals that a nil load shedding handler has been provided
+var ErrNilLoadSheddingHandler = errors.New("nil load shedding handler")
+
+// ErrNilEpochChangeHandler signals that a nil epoch change handler has been provided
+var ErrNilEpochChangeHandler = errors.New("nil epoch change handler")
+
+// ErrInvalidRetryAfter signals that an invalid Retry-After duration has been provided
+var ErrInvalidRetryAfter = errors.New("invalid Retry-After duration")
+
+// ErrNilAccessLogHandler signals that a nil access log handler has been provided
+var ErrNilAccessLogHandler = errors.New("nil access log handler")
+
+// ErrNilClientStatsHandler signals that a nil client statistics handler has been provided
+var ErrNilClientStatsHandler = errors.New("nil client statistics handler")
+
+// ErrEmptyClientKeyHeader signals that an empty client key header has been provided
+var ErrEmptyClientKeyHeader = errors.New("empty client key header")
+
+// ErrNoHeavyRoutes signals that no heavy route has been provided
+var ErrNoHeavyRoutes = errors.New("no heavy route provided")
+
+// ErrInvalidMaxConcurrentRequests signals that an invalid maximum number of concurrent requests has been provided
+var ErrInvalidMaxConcurrentRequests = errors.New("invalid maximum number of concurrent requests")
+
+// ErrInvalidJsonRpcLimits signals that invalid JSON-RPC limits have been provided
+var ErrInvalidJsonRpcLimits = errors.New("invalid JSON-RPC limits")
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// ETagHeader is the header holding the entity tag of the response
+	ETagHeader = "ETag"
+
+	// IfNoneMatchHeader is the header holding the entity tags of the responses already held by the client
+	IfNoneMatchHeader = "If-None-Match"
+
+	weakETagPrefix = "W/"
+	anyETag        = "*"
+)
+
+type eTag struct{}
+
+// NewETag returns a new instance of eTag
+func NewETag() *eTag {
+	return &eTag{}
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that computes a strong ETag out of the body of the successful
+// responses of the endpoints which opted in and replies with 304 Not Modified when the client already holds the
+// response, as signaled by the If-None-Match header. This spares the polling clients from transferring the same large
+// payloads, such as the hyperblocks, over and over again
+func (et *eTag) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ew := &eTagWriter{ResponseWriter: c.Writer, context: c, body: bytes.NewBuffer(nil)}
+		c.Writer = ew
+
+		c.Next()
+
+		c.Writer = ew.ResponseWriter
+		if !ew.isBuffering {
+			return
+		}
+
+		responseBytes := ew.body.Bytes()
+		tag := computeETag(responseBytes)
+		c.Header(ETagHeader, tag)
+
+		if matchesETag(c.GetHeader(IfNoneMatchHeader), tag) {
+			c.Header("Content-Type", "")
+			c.Header("Content-Length", "")
+			c.Writer.WriteHeader(http.StatusNotModified)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+
+		_, err := c.Writer.Write(responseBytes)
+		if err != nil {
+			log.Debug("etag: cannot write response", "error", err.Error())
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (et *eTag) IsInterfaceNil() bool {
+	return et == nil
+}
+
+func computeETag(responseBytes []byte) string {
+	hash := sha256.Sum256(responseBytes)
+
+	return `"` + hex.EncodeToString(hash[:]) + `"`
+}
+
+// matchesETag uses the weak comparison, as required by RFC 9110 for the If-None-Match header
+func matchesETag(ifNoneMatch string, tag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == anyETag {
+			return true
+		}
+
+		if strings.TrimPrefix(candidate, weakETagPrefix) == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+type eTagWriter struct {
+	gin.ResponseWriter
+	context     *gin.Context
+	body        *bytes.Buffer
+	isDecided   bool
+	isBuffering bool
+}
+
+// Write buffers the successful responses of the endpoints which opted in, so their ETag can be computed
+func (w *eTagWriter) Write(b []byte) (int, error) {
+	if w.shouldBuffer() {
+		return w.body.Write(b)
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// WriteString buffers the successful responses of the endpoints which opted in, so their ETag can be computed
+func (w *eTagWriter) WriteString(s string) (int, error) {
+	if w.shouldBuffer() {
+		return w.body.WriteString(s)
+	}
+
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *eTagWriter) shouldBuffer() bool {
+	if w.isDecided {
+		return w.isBuffering
+	}
+	w.isDecided = true
+
+	isSuccessfulGet := w.context.Request.Method == http.MethodGet && w.ResponseWriter.Status() == http.StatusOK
+	w.isBuffering = isSuccessfulGet && w.context.GetBool(data.ETagContextKey)
+
+	return w.isBuffering
+}
@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func doETagRequest(withETag bool, code int, ifNoneMatch string) *httptest.ResponseRecorder {
+	ws := gin.New()
+	ws.Use(NewETag().MiddlewareHandlerFunc())
+	ws.GET("/v1.0/hyperblock/by-nonce/:nonce", func(c *gin.Context) {
+		if withETag {
+			c.Set(data.ETagContextKey, true)
+		}
+		c.JSON(code, gin.H{"data": c.Param("nonce")})
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/v1.0/hyperblock/by-nonce/37", nil)
+	if len(ifNoneMatch) > 0 {
+		req.Header.Set(IfNoneMatchHeader, ifNoneMatch)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewETag(t *testing.T) {
+	t.Parallel()
+
+	et := NewETag()
+	assert.False(t, check.IfNil(et))
+}
+
+func TestETag_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	expectedBody := `{"data":"37"}`
+	expectedETag := computeETag([]byte(expectedBody))
+
+	t.Run("endpoint without ETag should not set the header", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doETagRequest(false, http.StatusOK, expectedETag)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(ETagHeader))
+		assert.Equal(t, expectedBody, resp.Body.String())
+	})
+	t.Run("unsuccessful response should not set the header", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doETagRequest(true, http.StatusInternalServerError, expectedETag)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Empty(t, resp.Header().Get(ETagHeader))
+		assert.Equal(t, expectedBody, resp.Body.String())
+	})
+	t.Run("should set the ETag header", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doETagRequest(true, http.StatusOK, "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedETag, resp.Header().Get(ETagHeader))
+		assert.Equal(t, expectedBody, resp.Body.String())
+	})
+	t.Run("not matching If-None-Match should send the body", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doETagRequest(true, http.StatusOK, `"aabbcc", W/"ddeeff"`)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedETag, resp.Header().Get(ETagHeader))
+		assert.Equal(t, expectedBody, resp.Body.String())
+	})
+	t.Run("matching If-None-Match should reply with not modified", func(t *testing.T) {
+		t.Parallel()
+
+		for _, ifNoneMatch := range []string{expectedETag, `"aabbcc", ` + expectedETag, "W/" + expectedETag, "*"} {
+			resp := doETagRequest(true, http.StatusOK, ifNoneMatch)
+			assert.Equal(t, http.StatusNotModified, resp.Code, ifNoneMatch)
+			assert.Equal(t, expectedETag, resp.Header().Get(ETagHeader), ifNoneMatch)
+			assert.Empty(t, resp.Body.String(), ifNoneMatch)
+			assert.Empty(t, resp.Header().Get("Content-Type"), ifNoneMatch)
+		}
+	})
+}
+
+func TestETag_MiddlewareHandlerFuncWithCacheControl(t *testing.T) {
+	t.Parallel()
+
+	cc, _ := NewCacheControl(createArgsCacheControl())
+	ws := gin.New()
+	ws.Use(NewETag().MiddlewareHandlerFunc())
+	ws.Use(cc.MiddlewareHandlerFunc())
+	ws.GET("/v1.0/block/by-hash/:hash", func(c *gin.Context) {
+		c.Set(data.ETagContextKey, true)
+		c.Set(data.CacheabilityContextKey, data.CacheabilityImmutable)
+		c.JSON(http.StatusOK, gin.H{"data": c.Param("hash")})
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/v1.0/block/by-hash/aa", nil)
+	req.Header.Set(IfNoneMatchHeader, computeETag([]byte(`{"data":"aa"}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+	assert.Equal(t, immutableCacheControl, resp.Header().Get(cacheControlHeader))
+}
@@ -0,0 +1,196 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	fieldsUrlParameter = "fields"
+	fieldsSeparator    = ","
+	fieldPathSeparator = "."
+	dataResponseKey    = "data"
+
+	eventStreamContentType = "text/event-stream"
+)
+
+// fieldsTree holds the projection to be applied on a JSON object. A nil sub-tree means that the whole value is kept
+type fieldsTree map[string]fieldsTree
+
+type fieldsFilter struct {
+	excludedRoutes []string
+}
+
+// NewFieldsFilter returns a new instance of fieldsFilter. The provided routes will be skipped as their handlers
+// already use the fields URL parameter for their own purposes
+func NewFieldsFilter(excludedRoutes []string) *fieldsFilter {
+	return &fieldsFilter{
+		excludedRoutes: excludedRoutes,
+	}
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that prunes the JSON response of GET requests, keeping only
+// the fields provided in the fields URL parameter. The fields are dot separated paths relative to the data field
+// of the response (e.g. ?fields=account.balance,account.nonce)
+func (ff *fieldsFilter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fieldsParam := c.Request.URL.Query().Get(fieldsUrlParameter)
+		if c.Request.Method != http.MethodGet || len(fieldsParam) == 0 || ff.isExcludedRoute(c.FullPath()) {
+			return
+		}
+
+		bw := &bufferedWriter{body: bytes.NewBuffer(nil), ResponseWriter: c.Writer}
+		c.Writer = bw
+
+		c.Next()
+
+		c.Writer = bw.ResponseWriter
+		if bw.isEventStream() {
+			return
+		}
+
+		responseBytes := bw.body.Bytes()
+		if bw.Status() == http.StatusOK {
+			responseBytes = filterResponseFields(responseBytes, parseFieldsTree(fieldsParam))
+		}
+
+		_, err := c.Writer.Write(responseBytes)
+		if err != nil {
+			log.Debug("fields filter: cannot write response", "error", err.Error())
+		}
+	}
+}
+
+func (ff *fieldsFilter) isExcludedRoute(route string) bool {
+	for _, excludedRoute := range ff.excludedRoutes {
+		if strings.HasSuffix(route, excludedRoute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ff *fieldsFilter) IsInterfaceNil() bool {
+	return ff == nil
+}
+
+func parseFieldsTree(fieldsParam string) fieldsTree {
+	tree := make(fieldsTree)
+	for _, field := range strings.Split(fieldsParam, fieldsSeparator) {
+		field = strings.TrimSpace(field)
+		if len(field) == 0 {
+			continue
+		}
+
+		currentTree := tree
+		pathElements := strings.Split(field, fieldPathSeparator)
+		for idx, element := range pathElements {
+			subTree, found := currentTree[element]
+			isLastElement := idx == len(pathElements)-1
+			if found && subTree == nil {
+				// a parent of this path was already requested as a whole
+				break
+			}
+			if isLastElement {
+				currentTree[element] = nil
+				break
+			}
+			if !found {
+				subTree = make(fieldsTree)
+				currentTree[element] = subTree
+			}
+			currentTree = subTree
+		}
+	}
+
+	return tree
+}
+
+func filterResponseFields(responseBytes []byte, tree fieldsTree) []byte {
+	if len(tree) == 0 {
+		return responseBytes
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(responseBytes))
+	decoder.UseNumber()
+
+	response := make(map[string]interface{})
+	err := decoder.Decode(&response)
+	if err != nil {
+		return responseBytes
+	}
+
+	responseData, found := response[dataResponseKey]
+	if !found {
+		return responseBytes
+	}
+	response[dataResponseKey] = projectValue(responseData, tree)
+
+	filteredBytes, err := json.Marshal(response)
+	if err != nil {
+		return responseBytes
+	}
+
+	return filteredBytes
+}
+
+func projectValue(value interface{}, tree fieldsTree) interface{} {
+	if tree == nil {
+		return value
+	}
+
+	switch castValue := value.(type) {
+	case map[string]interface{}:
+		projected := make(map[string]interface{}, len(tree))
+		for key, subTree := range tree {
+			subValue, found := castValue[key]
+			if !found {
+				continue
+			}
+			projected[key] = projectValue(subValue, subTree)
+		}
+		return projected
+	case []interface{}:
+		projected := make([]interface{}, 0, len(castValue))
+		for _, element := range castValue {
+			projected = append(projected, projectValue(element, tree))
+		}
+		return projected
+	default:
+		return value
+	}
+}
+
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+// Write buffers the response so it can be filtered before being sent to the client. The server-sent events are
+// written right away, as their stream does not end
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.isEventStream() {
+		return w.ResponseWriter.Write(b)
+	}
+
+	return w.body.Write(b)
+}
+
+// WriteString buffers the response so it can be filtered before being sent to the client
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	if w.isEventStream() {
+		return w.ResponseWriter.WriteString(s)
+	}
+
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) isEventStream() bool {
+	return strings.HasPrefix(w.Header().Get("Content-Type"), eventStreamContentType)
+}
@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAccountResponse = gin.H{
+	"data": gin.H{
+		"account": gin.H{
+			"address": "erd1",
+			"nonce":   37,
+			"balance": "100000000000000000000000000000",
+		},
+		"blockInfo": gin.H{
+			"nonce": 10,
+			"hash":  "aaaa",
+		},
+	},
+	"error": "",
+	"code":  "successful",
+}
+
+func startFieldsFilterServer(excludedRoutes []string) *gin.Engine {
+	ws := gin.New()
+	ws.Use(NewFieldsFilter(excludedRoutes).MiddlewareHandlerFunc())
+	ws.GET("/address/:address", func(c *gin.Context) {
+		c.JSON(http.StatusOK, testAccountResponse)
+	})
+	ws.GET("/transaction/pool", func(c *gin.Context) {
+		c.JSON(http.StatusOK, testAccountResponse)
+	})
+	ws.GET("/blocks", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"data": gin.H{
+				"blocks": []gin.H{
+					{"nonce": 1, "hash": "aa", "round": 1},
+					{"nonce": 2, "hash": "bb", "round": 2},
+				},
+			},
+		})
+	})
+	ws.GET("/stream", func(c *gin.Context) {
+		c.SSEvent("block", gin.H{"nonce": 1, "hash": "aa"})
+	})
+	ws.GET("/bad", func(c *gin.Context) {
+		c.JSON(http.StatusBadRequest, testAccountResponse)
+	})
+
+	return ws
+}
+
+func doFieldsFilterRequest(ws *gin.Engine, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewFieldsFilter(t *testing.T) {
+	t.Parallel()
+
+	ff := NewFieldsFilter(nil)
+	assert.False(t, check.IfNil(ff))
+}
+
+func TestFieldsFilter_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	ws := startFieldsFilterServer([]string{"/transaction/pool"})
+
+	t.Run("no fields should not filter", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doFieldsFilterRequest(ws, "/address/erd1")
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Contains(t, resp.Body.String(), "blockInfo")
+		assert.Contains(t, resp.Body.String(), "address")
+	})
+	t.Run("should keep only the requested fields", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doFieldsFilterRequest(ws, "/address/erd1?fields=account.balance,account.nonce")
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t,
+			`{"code":"successful","data":{"account":{"balance":"100000000000000000000000000000","nonce":37}},"error":""}`,
+			resp.Body.String(),
+		)
+	})
+	t.Run("whole object requested should keep all its fields", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doFieldsFilterRequest(ws, "/address/erd1?fields=blockInfo.nonce,blockInfo")
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t,
+			`{"code":"successful","data":{"blockInfo":{"hash":"aaaa","nonce":10}},"error":""}`,
+			resp.Body.String(),
+		)
+	})
+	t.Run("should filter each element of an array", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doFieldsFilterRequest(ws, "/blocks?fields=blocks.hash")
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, `{"data":{"blocks":[{"hash":"aa"},{"hash":"bb"}]}}`, resp.Body.String())
+	})
+	t.Run("excluded route should not filter", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doFieldsFilterRequest(ws, "/transaction/pool?fields=account.balance")
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Contains(t, resp.Body.String(), "blockInfo")
+	})
+	t.Run("unsuccessful response should not filter", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doFieldsFilterRequest(ws, "/bad?fields=account.balance")
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "blockInfo")
+	})
+	t.Run("server-sent events should not be filtered", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doFieldsFilterRequest(ws, "/stream?fields=hash")
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "event:block\ndata:{\"hash\":\"aa\",\"nonce\":1}\n\n", resp.Body.String())
+	})
+}
+
+func TestParseFieldsTree(t *testing.T) {
+	t.Parallel()
+
+	tree := parseFieldsTree("a.b, a.c.d,,e,a.c")
+	expectedTree := fieldsTree{
+		"a": fieldsTree{
+			"b": nil,
+			"c": nil,
+		},
+		"e": nil,
+	}
+	assert.Equal(t, expectedTree, tree)
+}
@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	heavyRouteMovedMsg   = "this route is served on the heavy endpoints listener"
+	heavyRouteMissingMsg = "this route is not served on the heavy endpoints listener"
+	heavyListenerBusyMsg = "too many concurrent requests on the heavy endpoints listener"
+)
+
+type heavyRoutesFilter struct {
+	handler          http.Handler
+	versions         map[string]struct{}
+	routes           []string
+	serveHeavyRoutes bool
+	alwaysServed     map[string]struct{}
+	slots            chan struct{}
+}
+
+// ArgsHeavyRoutesFilter holds the arguments needed for creating a new heavyRoutesFilter
+type ArgsHeavyRoutesFilter struct {
+	Handler          http.Handler
+	Versions         []string
+	Routes           []string
+	ServeHeavyRoutes bool
+	AlwaysServed     []string
+	MaxConcurrent    int
+}
+
+// NewHeavyRoutesFilter returns a new instance of heavyRoutesFilter. It has to wrap the whole web server, so the requests
+// are split before the routing takes place. The routes are matched by prefix, with or without their version segment.
+// The filter of the main listener rejects the heavy routes, while the filter of the heavy endpoints listener serves
+// only them, at most MaxConcurrent at a time (0 means unlimited). The AlwaysServed paths, such as the probes, are
+// served by both listeners
+func NewHeavyRoutesFilter(args ArgsHeavyRoutesFilter) (*heavyRoutesFilter, error) {
+	if args.Handler == nil {
+		return nil, ErrNilHttpHandler
+	}
+	if len(args.Routes) == 0 {
+		return nil, ErrNoHeavyRoutes
+	}
+	if args.MaxConcurrent < 0 {
+		return nil, ErrInvalidMaxConcurrentRequests
+	}
+
+	versionsMap := make(map[string]struct{})
+	for _, version := range args.Versions {
+		if len(version) == 0 {
+			continue
+		}
+
+		versionsMap[version] = struct{}{}
+	}
+
+	alwaysServed := make(map[string]struct{})
+	for _, path := range args.AlwaysServed {
+		alwaysServed[path] = struct{}{}
+	}
+
+	filter := &heavyRoutesFilter{
+		handler:          args.Handler,
+		versions:         versionsMap,
+		routes:           args.Routes,
+		serveHeavyRoutes: args.ServeHeavyRoutes,
+		alwaysServed:     alwaysServed,
+	}
+	if args.MaxConcurrent > 0 {
+		filter.slots = make(chan struct{}, args.MaxConcurrent)
+	}
+
+	return filter, nil
+}
+
+// ServeHTTP serves the request if it belongs to this listener. Otherwise, it responds with 404 Not Found
+func (filter *heavyRoutesFilter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_, isAlwaysServed := filter.alwaysServed[r.URL.Path]
+	if isAlwaysServed {
+		filter.handler.ServeHTTP(w, r)
+		return
+	}
+
+	isHeavyRoute := filter.isHeavyRoute(r.URL.Path)
+	if isHeavyRoute != filter.serveHeavyRoutes {
+		message := heavyRouteMovedMsg
+		if filter.serveHeavyRoutes {
+			message = heavyRouteMissingMsg
+		}
+		writeJSONError(w, http.StatusNotFound, message, data.ReturnCodeRequestError)
+		return
+	}
+
+	if filter.slots == nil {
+		filter.handler.ServeHTTP(w, r)
+		return
+	}
+
+	select {
+	case filter.slots <- struct{}{}:
+		defer func() { <-filter.slots }()
+		filter.handler.ServeHTTP(w, r)
+	default:
+		writeJSONError(w, http.StatusServiceUnavailable, heavyListenerBusyMsg, data.ReturnCodeInternalError)
+	}
+}
+
+func (filter *heavyRoutesFilter) isHeavyRoute(path string) bool {
+	path = filter.trimVersion(path)
+	for _, route := range filter.routes {
+		route = strings.TrimSuffix(route, "/")
+		if path == route || strings.HasPrefix(path, route+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (filter *heavyRoutesFilter) trimVersion(path string) string {
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	_, isVersion := filter.versions[segments[0]]
+	if !isVersion {
+		return path
+	}
+	if len(segments) == 1 {
+		return "/"
+	}
+
+	return "/" + segments[1]
+}
+
+func writeJSONError(w http.ResponseWriter, status int, message string, code data.ReturnCode) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data.GenericAPIResponse{
+		Error: message,
+		Code:  code,
+	})
+}
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsHeavyRoutesFilter(handler http.Handler, serveHeavyRoutes bool) ArgsHeavyRoutesFilter {
+	return ArgsHeavyRoutesFilter{
+		Handler:          handler,
+		Versions:         []string{"", "v1.0", "v_next"},
+		Routes:           []string{"/transaction/pool", "/hyperblock/"},
+		ServeHeavyRoutes: serveHeavyRoutes,
+		AlwaysServed:     []string{"/live"},
+	}
+}
+
+func TestNewHeavyRoutesFilter(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHeavyRoutesFilter(nil, false)
+	filter, err := NewHeavyRoutesFilter(args)
+	require.Nil(t, filter)
+	require.Equal(t, ErrNilHttpHandler, err)
+
+	args = createArgsHeavyRoutesFilter(http.NewServeMux(), false)
+	args.Routes = nil
+	filter, err = NewHeavyRoutesFilter(args)
+	require.Nil(t, filter)
+	require.Equal(t, ErrNoHeavyRoutes, err)
+
+	args = createArgsHeavyRoutesFilter(http.NewServeMux(), false)
+	args.MaxConcurrent = -1
+	filter, err = NewHeavyRoutesFilter(args)
+	require.Nil(t, filter)
+	require.Equal(t, ErrInvalidMaxConcurrentRequests, err)
+
+	args = createArgsHeavyRoutesFilter(http.NewServeMux(), false)
+	filter, err = NewHeavyRoutesFilter(args)
+	require.NoError(t, err)
+	require.Len(t, filter.versions, 2)
+	require.Nil(t, filter.slots)
+}
+
+func TestHeavyRoutesFilter_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mainFilter, _ := NewHeavyRoutesFilter(createArgsHeavyRoutesFilter(handler, false))
+	heavyFilter, _ := NewHeavyRoutesFilter(createArgsHeavyRoutesFilter(handler, true))
+
+	testCases := []struct {
+		path         string
+		isHeavy      bool
+		alwaysServed bool
+	}{
+		{path: "/transaction/pool", isHeavy: true},
+		{path: "/v1.0/transaction/pool", isHeavy: true},
+		{path: "/v_next/hyperblock/by-nonce/10", isHeavy: true},
+		{path: "/hyperblock", isHeavy: true},
+		{path: "/transaction/pool-stats", isHeavy: false},
+		{path: "/v1.0/transaction/send", isHeavy: false},
+		{path: "/v2.0/hyperblock/by-nonce/10", isHeavy: false},
+		{path: "/live", alwaysServed: true},
+	}
+
+	for _, tc := range testCases {
+		mainResp := httptest.NewRecorder()
+		mainFilter.ServeHTTP(mainResp, httptest.NewRequest(http.MethodGet, tc.path, nil))
+		heavyResp := httptest.NewRecorder()
+		heavyFilter.ServeHTTP(heavyResp, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+		expectedMainCode, expectedHeavyCode := http.StatusOK, http.StatusNotFound
+		if tc.isHeavy {
+			expectedMainCode, expectedHeavyCode = http.StatusNotFound, http.StatusOK
+		}
+		if tc.alwaysServed {
+			expectedMainCode, expectedHeavyCode = http.StatusOK, http.StatusOK
+		}
+		assert.Equal(t, expectedMainCode, mainResp.Code, tc.path)
+		assert.Equal(t, expectedHeavyCode, heavyResp.Code, tc.path)
+	}
+}
+
+func TestHeavyRoutesFilter_MaxConcurrent(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+	args := createArgsHeavyRoutesFilter(handler, true)
+	args.MaxConcurrent = 1
+	filter, _ := NewHeavyRoutesFilter(args)
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	firstResp := httptest.NewRecorder()
+	go func() {
+		filter.ServeHTTP(firstResp, httptest.NewRequest(http.MethodGet, "/transaction/pool", nil))
+		wg.Done()
+	}()
+	<-started
+
+	resp := httptest.NewRecorder()
+	filter.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/transaction/pool", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, http.StatusOK, firstResp.Code)
+
+	// the slot is released once the request has been served
+	go func() {
+		<-started
+	}()
+	resp = httptest.NewRecorder()
+	filter.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/transaction/pool", nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // RateLimiterHandler defines the actions that an implementation of rate limiter handler should do
@@ -23,3 +24,54 @@ type MiddlewareProcessor interface {
 	MiddlewareHandlerFunc() gin.HandlerFunc
 	IsInterfaceNil() bool
 }
+
+// TokenBucketRateLimiterHandler defines the actions that an implementation of token bucket rate limiter should do
+type TokenBucketRateLimiterHandler interface {
+	MiddlewareProcessor
+	CleanIdleBuckets(idleDuration time.Duration)
+	UpdateLimits(args ArgsTokenBucketRateLimiter) error
+}
+
+// CacheControlHandler defines the actions that an implementation of the Cache-Control middleware should do
+type CacheControlHandler interface {
+	MiddlewareProcessor
+	UpdateMaxAges(args ArgsCacheControl) error
+}
+
+// DrainStatusHandler defines what a component holding the drain status of the proxy should do
+type DrainStatusHandler interface {
+	IsWriteAllowed() bool
+	IsReadAllowed() bool
+	IsInterfaceNil() bool
+}
+
+// AccessLogHandler defines what a component writing the access log entries should do
+type AccessLogHandler interface {
+	LogEntry(entry *data.AccessLogEntry)
+	IsInterfaceNil() bool
+}
+
+// LoadSheddingHandler defines what a component deciding the shards whose read traffic is shed should do
+type LoadSheddingHandler interface {
+	IsShardShed(shardID uint32) bool
+	ComputeShardOfAddress(address string) (uint32, error)
+	IsInterfaceNil() bool
+}
+
+// EpochChangeHandler defines what a component detecting the window around the epoch change should do
+type EpochChangeHandler interface {
+	IsEpochChangePending() bool
+	IsInterfaceNil() bool
+}
+
+// AuditLogHandler defines what a component writing the audit entries of the mutating requests should do
+type AuditLogHandler interface {
+	LogEntry(entry *data.AuditEntry)
+	IsInterfaceNil() bool
+}
+
+// ClientStatsHandler defines what a component counting the transactions relayed on behalf of each client key should do
+type ClientStatsHandler interface {
+	RegisterSentTransactions(clientKey string, txHashes []string)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const accessDeniedMsg = "access to this route is not allowed from your IP address"
+
+type ipAccessControl struct {
+	allowed []netip.Prefix
+	denied  []netip.Prefix
+}
+
+// NewIPAccessControl returns a new instance of ipAccessControl. The entries are CIDR ranges, such as 10.0.0.0/8, or
+// single IP addresses. A client IP found in the denied ranges is always rejected, while, when allowed ranges are
+// provided, the client IPs outside them are rejected as well
+func NewIPAccessControl(allowed []string, denied []string) (*ipAccessControl, error) {
+	allowedPrefixes, err := parsePrefixes(allowed)
+	if err != nil {
+		return nil, err
+	}
+	deniedPrefixes, err := parsePrefixes(denied)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ipAccessControl{
+		allowed: allowedPrefixes,
+		denied:  deniedPrefixes,
+	}, nil
+}
+
+func parsePrefixes(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		prefix, err := netip.ParsePrefix(entry)
+		if err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+
+		addr, errAddr := netip.ParseAddr(entry)
+		if errAddr != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCIDR, entry)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+
+	return prefixes, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects with 403 Forbidden the requests coming from client IPs
+// not allowed to reach the routes it is attached to. The client IPs that cannot be parsed are rejected
+func (iac *ipAccessControl) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientIP, err := netip.ParseAddr(c.ClientIP())
+		if err != nil || !iac.isAllowed(clientIP.Unmap()) {
+			c.AbortWithStatusJSON(http.StatusForbidden, data.GenericAPIResponse{
+				Data:  nil,
+				Error: accessDeniedMsg,
+				Code:  data.ReturnCodeRequestError,
+			})
+			return
+		}
+	}
+}
+
+func (iac *ipAccessControl) isAllowed(clientIP netip.Addr) bool {
+	if containsAddr(iac.denied, clientIP) {
+		return false
+	}
+	if len(iac.allowed) == 0 {
+		return true
+	}
+
+	return containsAddr(iac.allowed, clientIP)
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (iac *ipAccessControl) IsInterfaceNil() bool {
+	return iac == nil
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startIPAccessControlServer(t *testing.T, allowed []string, denied []string) *gin.Engine {
+	iac, err := NewIPAccessControl(allowed, denied)
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(iac.MiddlewareHandlerFunc())
+	ws.GET("/v1.0/actions/drain-status", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+
+	return ws
+}
+
+func doIPAccessControlRequest(ws *gin.Engine, remoteAddr string) int {
+	req, _ := http.NewRequest(http.MethodGet, "/v1.0/actions/drain-status", nil)
+	req.RemoteAddr = remoteAddr
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewIPAccessControl(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid allowed entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		iac, err := NewIPAccessControl([]string{"10.0.0.0/8", "not an ip"}, nil)
+		require.True(t, check.IfNil(iac))
+		require.True(t, errors.Is(err, ErrInvalidCIDR))
+		require.Contains(t, err.Error(), "not an ip")
+	})
+	t.Run("invalid denied entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		iac, err := NewIPAccessControl(nil, []string{"10.0.0.0/33"})
+		require.True(t, check.IfNil(iac))
+		require.True(t, errors.Is(err, ErrInvalidCIDR))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		iac, err := NewIPAccessControl([]string{"10.0.0.0/8", "192.168.1.10", "fd00::/8"}, []string{"10.0.0.1"})
+		require.False(t, check.IfNil(iac))
+		require.NoError(t, err)
+	})
+}
+
+func TestIPAccessControl_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("allowed ranges only should reject the other IPs", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startIPAccessControlServer(t, []string{"10.0.0.0/8", "192.168.1.10"}, nil)
+		assert.Equal(t, http.StatusOK, doIPAccessControlRequest(ws, "10.20.30.40:1234"))
+		assert.Equal(t, http.StatusOK, doIPAccessControlRequest(ws, "192.168.1.10:1234"))
+		assert.Equal(t, http.StatusForbidden, doIPAccessControlRequest(ws, "192.168.1.11:1234"))
+		assert.Equal(t, http.StatusForbidden, doIPAccessControlRequest(ws, "[2001:db8::1]:1234"))
+	})
+	t.Run("denied ranges only should allow the other IPs", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startIPAccessControlServer(t, nil, []string{"203.0.113.0/24"})
+		assert.Equal(t, http.StatusForbidden, doIPAccessControlRequest(ws, "203.0.113.7:1234"))
+		assert.Equal(t, http.StatusOK, doIPAccessControlRequest(ws, "198.51.100.7:1234"))
+	})
+	t.Run("denied ranges should take precedence", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startIPAccessControlServer(t, []string{"10.0.0.0/8"}, []string{"10.0.0.0/24"})
+		assert.Equal(t, http.StatusForbidden, doIPAccessControlRequest(ws, "10.0.0.5:1234"))
+		assert.Equal(t, http.StatusOK, doIPAccessControlRequest(ws, "10.0.1.5:1234"))
+	})
+	t.Run("IPv4 mapped IPv6 address should match the IPv4 ranges", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startIPAccessControlServer(t, []string{"127.0.0.0/8"}, nil)
+		assert.Equal(t, http.StatusOK, doIPAccessControlRequest(ws, "[::ffff:127.0.0.1]:1234"))
+	})
+	t.Run("unknown client IP should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startIPAccessControlServer(t, nil, []string{"203.0.113.0/24"})
+		assert.Equal(t, http.StatusForbidden, doIPAccessControlRequest(ws, "invalid"))
+	})
+}
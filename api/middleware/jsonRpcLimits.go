@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	jsonRpcRoute                     = "/jsonrpc"
+	defaultJsonRpcMaxBodySizeInBytes = 1024 * 1024
+	defaultJsonRpcMaxBatchSize       = 20
+)
+
+type jsonRpcLimits struct {
+	maxBodySizeInBytes int64
+	maxBatchSize       int
+}
+
+// NewJsonRpcLimits returns a new instance of jsonRpcLimits. The zero values are replaced by the default limits
+func NewJsonRpcLimits(maxBodySizeInBytes int64, maxBatchSize int) (*jsonRpcLimits, error) {
+	if maxBodySizeInBytes < 0 {
+		return nil, fmt.Errorf("%w, max body size: %d", ErrInvalidJsonRpcLimits, maxBodySizeInBytes)
+	}
+	if maxBatchSize < 0 {
+		return nil, fmt.Errorf("%w, max batch size: %d", ErrInvalidJsonRpcLimits, maxBatchSize)
+	}
+
+	if maxBodySizeInBytes == 0 {
+		maxBodySizeInBytes = defaultJsonRpcMaxBodySizeInBytes
+	}
+	if maxBatchSize == 0 {
+		maxBatchSize = defaultJsonRpcMaxBatchSize
+	}
+
+	return &jsonRpcLimits{
+		maxBodySizeInBytes: maxBodySizeInBytes,
+		maxBatchSize:       maxBatchSize,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware bounding the body size and the batch size of the JSON-RPC
+// requests. The requests over the limits are rejected with an Invalid Request error, returned with 200 OK as the
+// protocol requires, before any of their entries is processed
+func (jrl *jsonRpcLimits) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodPost || !strings.HasSuffix(c.FullPath(), jsonRpcRoute) {
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, jrl.maxBodySizeInBytes))
+		if err != nil {
+			abortWithJsonRpcInvalidRequest(c, fmt.Sprintf("the request body exceeds %d bytes", jrl.maxBodySizeInBytes))
+			return
+		}
+
+		trimmedBody := bytes.TrimSpace(body)
+		if len(trimmedBody) > 0 && trimmedBody[0] == '[' {
+			var rawRequests []json.RawMessage
+			// the malformed batches are reported by the JSON-RPC handler
+			err = json.Unmarshal(trimmedBody, &rawRequests)
+			if err == nil && len(rawRequests) > jrl.maxBatchSize {
+				abortWithJsonRpcInvalidRequest(c, fmt.Sprintf("the batch holds %d requests, more than the maximum of %d", len(rawRequests), jrl.maxBatchSize))
+				return
+			}
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+}
+
+func abortWithJsonRpcInvalidRequest(c *gin.Context, message string) {
+	c.AbortWithStatusJSON(http.StatusOK, &data.JsonRpcResponse{
+		JsonRpc: data.JsonRpcVersion,
+		Error: &data.JsonRpcError{
+			Code:    data.JsonRpcInvalidRequestCode,
+			Message: message,
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func doJsonRpcLimitsRequest(t *testing.T, jrl *jsonRpcLimits, body string) (*data.JsonRpcResponse, string) {
+	receivedBody := ""
+	ws := gin.New()
+	ws.Use(jrl.MiddlewareHandlerFunc())
+	ws.POST("/v1.0/jsonrpc", func(c *gin.Context) {
+		buff, _ := io.ReadAll(c.Request.Body)
+		receivedBody = string(buff)
+		c.JSON(http.StatusOK, &data.JsonRpcResponse{JsonRpc: data.JsonRpcVersion})
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/v1.0/jsonrpc", strings.NewReader(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	response := &data.JsonRpcResponse{}
+	err := json.Unmarshal(resp.Body.Bytes(), response)
+	require.NoError(t, err)
+
+	return response, receivedBody
+}
+
+func TestNewJsonRpcLimits(t *testing.T) {
+	t.Parallel()
+
+	jrl, err := NewJsonRpcLimits(-1, 10)
+	require.True(t, errors.Is(err, ErrInvalidJsonRpcLimits))
+	require.Nil(t, jrl)
+
+	jrl, err = NewJsonRpcLimits(10, -1)
+	require.True(t, errors.Is(err, ErrInvalidJsonRpcLimits))
+	require.Nil(t, jrl)
+
+	jrl, err = NewJsonRpcLimits(0, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(defaultJsonRpcMaxBodySizeInBytes), jrl.maxBodySizeInBytes)
+	require.Equal(t, defaultJsonRpcMaxBatchSize, jrl.maxBatchSize)
+}
+
+func TestJsonRpcLimits_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	createBatch := func(numRequests int) string {
+		requests := make([]string, 0, numRequests)
+		for i := 0; i < numRequests; i++ {
+			requests = append(requests, `{"jsonrpc":"2.0","method":"getAccount","params":["erd1"],"id":1}`)
+		}
+
+		return "[" + strings.Join(requests, ",") + "]"
+	}
+
+	t.Run("requests within the limits should pass", func(t *testing.T) {
+		t.Parallel()
+
+		jrl, _ := NewJsonRpcLimits(1024, 3)
+		batch := createBatch(3)
+		response, receivedBody := doJsonRpcLimitsRequest(t, jrl, batch)
+		require.Nil(t, response.Error)
+		require.Equal(t, batch, receivedBody)
+
+		// the malformed batches are left to the handler
+		response, receivedBody = doJsonRpcLimitsRequest(t, jrl, "[not json")
+		require.Nil(t, response.Error)
+		require.Equal(t, "[not json", receivedBody)
+	})
+	t.Run("oversized batch should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		jrl, _ := NewJsonRpcLimits(1024, 3)
+		response, receivedBody := doJsonRpcLimitsRequest(t, jrl, createBatch(4))
+		require.NotNil(t, response.Error)
+		require.Equal(t, data.JsonRpcInvalidRequestCode, response.Error.Code)
+		require.Contains(t, response.Error.Message, "more than the maximum of 3")
+		require.Empty(t, receivedBody)
+	})
+	t.Run("oversized body should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		jrl, _ := NewJsonRpcLimits(100, 3)
+		response, receivedBody := doJsonRpcLimitsRequest(t, jrl, createBatch(2))
+		require.NotNil(t, response.Error)
+		require.Equal(t, data.JsonRpcInvalidRequestCode, response.Error.Code)
+		require.Contains(t, response.Error.Message, "exceeds 100 bytes")
+		require.Empty(t, receivedBody)
+	})
+}
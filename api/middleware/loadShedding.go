@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	shardRouteParam     = "shard"
+	addressRouteParam   = "address"
+	loadShedRejectedMsg = "too few healthy observers in shard %d, the read requests are temporarily rejected"
+)
+
+type loadShedding struct {
+	loadSheddingHandler LoadSheddingHandler
+	retryAfterSeconds   string
+	criticalRoutes      []string
+}
+
+// NewLoadShedding returns a new instance of loadShedding. The provided routes are the critical ones, never shed
+func NewLoadShedding(loadSheddingHandler LoadSheddingHandler, retryAfter time.Duration, criticalRoutes []string) (*loadShedding, error) {
+	if check.IfNil(loadSheddingHandler) {
+		return nil, ErrNilLoadSheddingHandler
+	}
+	if retryAfter < time.Second {
+		return nil, ErrInvalidRetryAfter
+	}
+
+	return &loadShedding{
+		loadSheddingHandler: loadSheddingHandler,
+		retryAfterSeconds:   strconv.Itoa(int(retryAfter.Seconds())),
+		criticalRoutes:      criticalRoutes,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects with 503 Service Unavailable the read requests
+// targeting a shed shard, so the remaining capacity of its observers is kept for the transaction sends. The targeted
+// shard is found out of the shard or the address route parameters. The write requests and the critical routes are
+// always served
+func (ls *loadShedding) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet || ls.isCriticalRoute(c.FullPath()) {
+			return
+		}
+
+		shardID, isShardRequest := ls.getRequestShard(c)
+		if !isShardRequest || !ls.loadSheddingHandler.IsShardShed(shardID) {
+			return
+		}
+
+		c.Header(retryAfterHeader, ls.retryAfterSeconds)
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, data.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf(loadShedRejectedMsg, shardID),
+			Code:  data.ReturnCodeInternalError,
+		})
+	}
+}
+
+// getRequestShard returns the shard targeted by the request. The invalid parameters are left to be reported by the
+// route handlers
+func (ls *loadShedding) getRequestShard(c *gin.Context) (uint32, bool) {
+	shardParam := c.Param(shardRouteParam)
+	if len(shardParam) > 0 {
+		shardID, err := strconv.ParseUint(shardParam, 10, 32)
+		return uint32(shardID), err == nil
+	}
+
+	addressParam := c.Param(addressRouteParam)
+	if len(addressParam) > 0 {
+		shardID, err := ls.loadSheddingHandler.ComputeShardOfAddress(addressParam)
+		return shardID, err == nil
+	}
+
+	return 0, false
+}
+
+func (ls *loadShedding) isCriticalRoute(route string) bool {
+	if len(route) == 0 {
+		return false
+	}
+
+	for _, criticalRoute := range ls.criticalRoutes {
+		if strings.HasSuffix(route, criticalRoute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ls *loadShedding) IsInterfaceNil() bool {
+	return ls == nil
+}
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type loadSheddingHandlerStub struct {
+	shedShards     map[uint32]struct{}
+	addressesShard map[string]uint32
+}
+
+func (stub *loadSheddingHandlerStub) IsShardShed(shardID uint32) bool {
+	_, isShed := stub.shedShards[shardID]
+	return isShed
+}
+
+func (stub *loadSheddingHandlerStub) ComputeShardOfAddress(address string) (uint32, error) {
+	shardID, found := stub.addressesShard[address]
+	if !found {
+		return 0, errors.New("invalid address")
+	}
+
+	return shardID, nil
+}
+
+func (stub *loadSheddingHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func startLoadSheddingServer(t *testing.T, handler LoadSheddingHandler) *gin.Engine {
+	ls, err := NewLoadShedding(handler, 10*time.Second, []string{"/address/:address/nonce"})
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(ls.MiddlewareHandlerFunc())
+	okHandler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	}
+	ws.POST("/v1.0/transaction/send", okHandler)
+	ws.GET("/v1.0/address/:address", okHandler)
+	ws.GET("/v1.0/address/:address/nonce", okHandler)
+	ws.GET("/v1.0/block/:shard/by-nonce/:nonce", okHandler)
+	ws.GET("/v1.0/network/config", okHandler)
+
+	return ws
+}
+
+func doLoadSheddingRequest(ws *gin.Engine, method string, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewLoadShedding(t *testing.T) {
+	t.Parallel()
+
+	ls, err := NewLoadShedding(nil, time.Second, nil)
+	require.True(t, check.IfNil(ls))
+	require.Equal(t, ErrNilLoadSheddingHandler, err)
+
+	ls, err = NewLoadShedding(&loadSheddingHandlerStub{}, time.Millisecond, nil)
+	require.True(t, check.IfNil(ls))
+	require.Equal(t, ErrInvalidRetryAfter, err)
+
+	ls, err = NewLoadShedding(&loadSheddingHandlerStub{}, time.Second, nil)
+	require.False(t, check.IfNil(ls))
+	require.NoError(t, err)
+}
+
+func TestLoadShedding_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	handler := &loadSheddingHandlerStub{
+		shedShards: map[uint32]struct{}{1: {}},
+		addressesShard: map[string]uint32{
+			"erd0": 0,
+			"erd1": 1,
+		},
+	}
+	ws := startLoadSheddingServer(t, handler)
+
+	resp := doLoadSheddingRequest(ws, http.MethodGet, "/v1.0/address/erd1")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, "10", resp.Header().Get(retryAfterHeader))
+
+	resp = doLoadSheddingRequest(ws, http.MethodGet, "/v1.0/block/1/by-nonce/5")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, "10", resp.Header().Get(retryAfterHeader))
+
+	// critical routes, writes, healthy shards, unknown shards and invalid parameters are served
+	assert.Equal(t, http.StatusOK, doLoadSheddingRequest(ws, http.MethodGet, "/v1.0/address/erd1/nonce").Code)
+	assert.Equal(t, http.StatusOK, doLoadSheddingRequest(ws, http.MethodPost, "/v1.0/transaction/send").Code)
+	assert.Equal(t, http.StatusOK, doLoadSheddingRequest(ws, http.MethodGet, "/v1.0/address/erd0").Code)
+	assert.Equal(t, http.StatusOK, doLoadSheddingRequest(ws, http.MethodGet, "/v1.0/block/0/by-nonce/5").Code)
+	assert.Equal(t, http.StatusOK, doLoadSheddingRequest(ws, http.MethodGet, "/v1.0/network/config").Code)
+	assert.Equal(t, http.StatusOK, doLoadSheddingRequest(ws, http.MethodGet, "/v1.0/address/invalid").Code)
+	assert.Equal(t, http.StatusOK, doLoadSheddingRequest(ws, http.MethodGet, "/v1.0/block/x/by-nonce/5").Code)
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// RequestTimeoutHeader is the header holding the number of milliseconds the client is willing to wait for the response
+const RequestTimeoutHeader = "X-Request-Timeout"
+
+type requestDeadline struct {
+	maxTimeout time.Duration
+}
+
+// NewRequestDeadline returns a new instance of requestDeadline. The timeouts requested by the clients are capped to
+// the provided maximum timeout
+func NewRequestDeadline(maxTimeout time.Duration) (*requestDeadline, error) {
+	if maxTimeout <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMaxRequestTimeout, maxTimeout)
+	}
+
+	return &requestDeadline{
+		maxTimeout: maxTimeout,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that sets the deadline requested through the X-Request-Timeout
+// header on the context of the request, so the calls towards the observers made while serving it are canceled once
+// the deadline expires. The context of the request is already canceled when the client closes the connection
+func (rd *requestDeadline) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeoutHeader := c.GetHeader(RequestTimeoutHeader)
+		if len(timeoutHeader) == 0 {
+			return
+		}
+
+		timeoutInMs, err := strconv.ParseUint(timeoutHeader, 10, 32)
+		if err != nil || timeoutInMs == 0 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, data.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("invalid %s header: %s", RequestTimeoutHeader, timeoutHeader),
+				Code:  data.ReturnCodeRequestError,
+			})
+			return
+		}
+
+		timeout := time.Duration(timeoutInMs) * time.Millisecond
+		if timeout > rd.maxTimeout {
+			timeout = rd.maxTimeout
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rd *requestDeadline) IsInterfaceNil() bool {
+	return rd == nil
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func doRequestDeadlineRequest(t *testing.T, maxTimeout time.Duration, timeoutHeader string) (int, time.Duration, bool) {
+	rd, err := NewRequestDeadline(maxTimeout)
+	require.NoError(t, err)
+
+	remaining := time.Duration(0)
+	hasDeadline := false
+	ws := gin.New()
+	ws.Use(rd.MiddlewareHandlerFunc())
+	ws.GET("/v1.0/address/:address", func(c *gin.Context) {
+		var deadline time.Time
+		deadline, hasDeadline = c.Request.Context().Deadline()
+		remaining = time.Until(deadline)
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/v1.0/address/erd1", nil)
+	if len(timeoutHeader) > 0 {
+		req.Header.Set(RequestTimeoutHeader, timeoutHeader)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code, remaining, hasDeadline
+}
+
+func TestNewRequestDeadline(t *testing.T) {
+	t.Parallel()
+
+	rd, err := NewRequestDeadline(0)
+	assert.True(t, check.IfNil(rd))
+	assert.ErrorIs(t, err, ErrInvalidMaxRequestTimeout)
+
+	rd, err = NewRequestDeadline(time.Second)
+	assert.False(t, check.IfNil(rd))
+	assert.NoError(t, err)
+}
+
+func TestRequestDeadline_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing header should not set a deadline", func(t *testing.T) {
+		t.Parallel()
+
+		code, _, hasDeadline := doRequestDeadlineRequest(t, time.Minute, "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.False(t, hasDeadline)
+	})
+	t.Run("invalid header should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, header := range []string{"abc", "-5", "0", "1.5"} {
+			code, _, _ := doRequestDeadlineRequest(t, time.Minute, header)
+			assert.Equal(t, http.StatusBadRequest, code, header)
+		}
+	})
+	t.Run("should set the requested deadline", func(t *testing.T) {
+		t.Parallel()
+
+		code, remaining, hasDeadline := doRequestDeadlineRequest(t, time.Minute, "5000")
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, hasDeadline)
+		assert.True(t, remaining > 4*time.Second && remaining <= 5*time.Second)
+	})
+	t.Run("should cap the requested deadline", func(t *testing.T) {
+		t.Parallel()
+
+		code, remaining, hasDeadline := doRequestDeadlineRequest(t, 2*time.Second, "50000")
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, hasDeadline)
+		assert.True(t, remaining > time.Second && remaining <= 2*time.Second)
+	})
+	t.Run("expired deadline should cancel the context of the request", func(t *testing.T) {
+		t.Parallel()
+
+		rd, _ := NewRequestDeadline(time.Minute)
+		var ctxErr error
+		ws := gin.New()
+		ws.Use(rd.MiddlewareHandlerFunc())
+		ws.GET("/v1.0/vm-values/query", func(c *gin.Context) {
+			<-c.Request.Context().Done()
+			ctxErr = c.Request.Context().Err()
+			c.Status(http.StatusGatewayTimeout)
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "/v1.0/vm-values/query", nil)
+		req.Header.Set(RequestTimeoutHeader, "10")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusGatewayTimeout, resp.Code)
+		assert.Equal(t, context.DeadlineExceeded, ctxErr)
+	})
+}
@@ -127,6 +127,9 @@ type bodyWriter struct {
 }
 
 func (w bodyWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+	// the server-sent events are not kept for logging, as their stream does not end
+	if !strings.HasPrefix(w.Header().Get("Content-Type"), eventStreamContentType) {
+		w.body.Write(b)
+	}
 	return w.ResponseWriter.Write(b)
 }
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+const jsonContentType = "application/json"
+
+type responseSigner struct {
+	privateKey crypto.PrivateKey
+	signer     crypto.SingleSigner
+}
+
+// NewResponseSigner returns a new instance of responseSigner
+func NewResponseSigner(privateKey crypto.PrivateKey, signer crypto.SingleSigner) (*responseSigner, error) {
+	if check.IfNil(privateKey) {
+		return nil, ErrNilPrivateKey
+	}
+	if check.IfNil(signer) {
+		return nil, ErrNilSingleSigner
+	}
+
+	return &responseSigner{
+		privateKey: privateKey,
+		signer:     signer,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that signs the response body. JSON responses are canonicalized
+// (compact form, with sorted keys) before signing and the canonical form is the one sent to the client, so the
+// signature can be verified directly over the received bytes
+func (rs *responseSigner) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		bw := &bufferedWriter{body: bytes.NewBuffer(nil), ResponseWriter: c.Writer}
+		c.Writer = bw
+
+		c.Next()
+
+		c.Writer = bw.ResponseWriter
+		// the server-sent events were already written, unsigned, as their stream does not end
+		if bw.isEventStream() {
+			return
+		}
+
+		responseBytes := bw.body.Bytes()
+		if strings.HasPrefix(c.Writer.Header().Get("Content-Type"), jsonContentType) {
+			responseBytes = canonicalizeJSON(responseBytes)
+		}
+
+		signature, err := rs.signer.Sign(rs.privateKey, responseBytes)
+		if err != nil {
+			log.Warn("response signer: cannot sign response", "path", c.Request.URL.Path, "error", err.Error())
+		} else {
+			c.Header(common.ProxySignatureHeader, hex.EncodeToString(signature))
+		}
+
+		_, err = c.Writer.Write(responseBytes)
+		if err != nil {
+			log.Debug("response signer: cannot write response", "error", err.Error())
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *responseSigner) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+func canonicalizeJSON(responseBytes []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(responseBytes))
+	decoder.UseNumber()
+
+	var response interface{}
+	err := decoder.Decode(&response)
+	if err != nil {
+		return responseBytes
+	}
+
+	canonicalBytes, err := json.Marshal(response)
+	if err != nil {
+		return responseBytes
+	}
+
+	return canonicalBytes
+}
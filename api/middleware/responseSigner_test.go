@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type singleSignerStub struct {
+	SignCalled func(private crypto.PrivateKey, msg []byte) ([]byte, error)
+}
+
+func (sss *singleSignerStub) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	return sss.SignCalled(private, msg)
+}
+
+func (sss *singleSignerStub) Verify(_ crypto.PublicKey, _ []byte, _ []byte) error {
+	return nil
+}
+
+func (sss *singleSignerStub) IsInterfaceNil() bool {
+	return sss == nil
+}
+
+func startResponseSignerServer(t *testing.T, privateKey crypto.PrivateKey, signer crypto.SingleSigner) *gin.Engine {
+	rs, err := NewResponseSigner(privateKey, signer)
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(rs.MiddlewareHandlerFunc())
+	ws.GET("/json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": gin.H{"z": 1, "a": "b"}, "code": "successful", "error": ""})
+	})
+	ws.GET("/text", func(c *gin.Context) {
+		c.String(http.StatusOK, "plain text")
+	})
+
+	return ws
+}
+
+func doResponseSignerRequest(ws *gin.Engine, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewResponseSigner(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	privateKey, _ := keyGen.GeneratePair()
+
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := NewResponseSigner(nil, &singlesig.Ed25519Signer{})
+		require.True(t, check.IfNil(rs))
+		require.Equal(t, ErrNilPrivateKey, err)
+	})
+
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := NewResponseSigner(privateKey, nil)
+		require.True(t, check.IfNil(rs))
+		require.Equal(t, ErrNilSingleSigner, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := NewResponseSigner(privateKey, &singlesig.Ed25519Signer{})
+		require.NoError(t, err)
+		require.False(t, check.IfNil(rs))
+	})
+}
+
+func TestResponseSigner_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	privateKey, publicKey := keyGen.GeneratePair()
+	signer := &singlesig.Ed25519Signer{}
+
+	t.Run("json response should be canonicalized and signed", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startResponseSignerServer(t, privateKey, signer)
+		resp := doResponseSignerRequest(ws, "/json")
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		body := resp.Body.Bytes()
+		assert.Equal(t, `{"code":"successful","data":{"a":"b","z":1},"error":""}`, string(body))
+
+		signature, err := hex.DecodeString(resp.Header().Get(common.ProxySignatureHeader))
+		require.NoError(t, err)
+		assert.NoError(t, signer.Verify(publicKey, body, signature))
+	})
+
+	t.Run("non json response should be signed as is", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startResponseSignerServer(t, privateKey, signer)
+		resp := doResponseSignerRequest(ws, "/text")
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		body := resp.Body.Bytes()
+		assert.Equal(t, "plain text", string(body))
+
+		signature, err := hex.DecodeString(resp.Header().Get(common.ProxySignatureHeader))
+		require.NoError(t, err)
+		assert.NoError(t, signer.Verify(publicKey, body, signature))
+	})
+
+	t.Run("signing error should still return the response without signature", func(t *testing.T) {
+		t.Parallel()
+
+		signerStub := &singleSignerStub{
+			SignCalled: func(_ crypto.PrivateKey, _ []byte) ([]byte, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		ws := startResponseSignerServer(t, privateKey, signerStub)
+		resp := doResponseSignerRequest(ws, "/text")
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "plain text", resp.Body.String())
+		assert.Empty(t, resp.Header().Get(common.ProxySignatureHeader))
+	})
+}
@@ -0,0 +1,200 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const retryAfterHeader = "Retry-After"
+
+// ArgsTokenBucketRateLimiter holds the arguments needed for creating a new token bucket rate limiter
+type ArgsTokenBucketRateLimiter struct {
+	RequestsPerSecond      float64
+	Burst                  uint32
+	HeavyRequestsPerSecond float64
+	HeavyBurst             uint32
+	HeavyRoutes            []string
+}
+
+type bucketLimits struct {
+	rate  float64
+	burst float64
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+type tokenBucketRateLimiter struct {
+	mutBuckets     sync.Mutex
+	buckets        map[string]*tokenBucket
+	mutLimits      sync.RWMutex
+	standardLimits bucketLimits
+	heavyLimits    bucketLimits
+	heavyRoutes    []string
+	getTimeHandler func() time.Time
+}
+
+// NewTokenBucketRateLimiter returns a new instance of tokenBucketRateLimiter
+func NewTokenBucketRateLimiter(args ArgsTokenBucketRateLimiter) (*tokenBucketRateLimiter, error) {
+	err := checkTokenBucketArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tbrl := &tokenBucketRateLimiter{
+		buckets:        make(map[string]*tokenBucket),
+		getTimeHandler: time.Now,
+	}
+	tbrl.setLimits(args)
+
+	return tbrl, nil
+}
+
+func checkTokenBucketArgs(args ArgsTokenBucketRateLimiter) error {
+	if args.RequestsPerSecond <= 0 || args.Burst == 0 {
+		return fmt.Errorf("%w for standard routes", ErrInvalidTokenBucketLimits)
+	}
+	if args.HeavyRequestsPerSecond <= 0 || args.HeavyBurst == 0 {
+		return fmt.Errorf("%w for heavy routes", ErrInvalidTokenBucketLimits)
+	}
+
+	return nil
+}
+
+func (tbrl *tokenBucketRateLimiter) setLimits(args ArgsTokenBucketRateLimiter) {
+	tbrl.mutLimits.Lock()
+	defer tbrl.mutLimits.Unlock()
+
+	tbrl.standardLimits = bucketLimits{
+		rate:  args.RequestsPerSecond,
+		burst: float64(args.Burst),
+	}
+	tbrl.heavyLimits = bucketLimits{
+		rate:  args.HeavyRequestsPerSecond,
+		burst: float64(args.HeavyBurst),
+	}
+	tbrl.heavyRoutes = args.HeavyRoutes
+}
+
+// UpdateLimits replaces the limits applied to the next requests. The existing buckets are kept, their tokens being
+// capped to the new burst values on their next refill
+func (tbrl *tokenBucketRateLimiter) UpdateLimits(args ArgsTokenBucketRateLimiter) error {
+	err := checkTokenBucketArgs(args)
+	if err != nil {
+		return err
+	}
+
+	tbrl.setLimits(args)
+
+	return nil
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that limits the requests of each client IP on each route
+func (tbrl *tokenBucketRateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			// unknown routes are not limited, gin will reply with 404 anyway
+			return
+		}
+
+		limits := tbrl.getLimits(route)
+
+		key := fmt.Sprintf("%s_%s", route, c.ClientIP())
+		retryAfter, isAllowed := tbrl.take(key, limits)
+		if isAllowed {
+			return
+		}
+
+		retryAfterSeconds := int(math.Ceil(retryAfter.Seconds()))
+		c.Header(retryAfterHeader, strconv.Itoa(retryAfterSeconds))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, data.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("your IP exceeded the limit of %.2f requests per second for this endpoint", limits.rate),
+			Code:  data.ReturnCode(ReturnCodeRequestError),
+		})
+	}
+}
+
+func (tbrl *tokenBucketRateLimiter) getLimits(route string) bucketLimits {
+	tbrl.mutLimits.RLock()
+	defer tbrl.mutLimits.RUnlock()
+
+	if tbrl.isHeavyRoute(route) {
+		return tbrl.heavyLimits
+	}
+
+	return tbrl.standardLimits
+}
+
+func (tbrl *tokenBucketRateLimiter) isHeavyRoute(route string) bool {
+	for _, heavyRoute := range tbrl.heavyRoutes {
+		if strings.HasSuffix(route, heavyRoute) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (tbrl *tokenBucketRateLimiter) take(key string, limits bucketLimits) (time.Duration, bool) {
+	now := tbrl.getTimeHandler()
+
+	tbrl.mutBuckets.Lock()
+	defer tbrl.mutBuckets.Unlock()
+
+	bucket, found := tbrl.buckets[key]
+	if !found {
+		bucket = &tokenBucket{
+			tokens:     limits.burst,
+			lastRefill: now,
+		}
+		tbrl.buckets[key] = bucket
+	}
+
+	elapsed := now.Sub(bucket.lastRefill).Seconds()
+	bucket.tokens = math.Min(limits.burst, bucket.tokens+elapsed*limits.rate)
+	bucket.lastRefill = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return 0, true
+	}
+
+	missingTokens := 1 - bucket.tokens
+	retryAfter := time.Duration(missingTokens / limits.rate * float64(time.Second))
+
+	return retryAfter, false
+}
+
+// CleanIdleBuckets removes the buckets that were not used for at least the provided duration. Should be called
+// periodically so the buckets map will not grow indefinitely
+func (tbrl *tokenBucketRateLimiter) CleanIdleBuckets(idleDuration time.Duration) {
+	now := tbrl.getTimeHandler()
+
+	tbrl.mutBuckets.Lock()
+	for key, bucket := range tbrl.buckets {
+		if now.Sub(bucket.lastRefill) >= idleDuration {
+			delete(tbrl.buckets, key)
+		}
+	}
+	numBuckets := len(tbrl.buckets)
+	tbrl.mutBuckets.Unlock()
+
+	log.Debug("token bucket rate limiter cleaned idle buckets", "remaining buckets", numBuckets)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tbrl *tokenBucketRateLimiter) IsInterfaceNil() bool {
+	return tbrl == nil
+}
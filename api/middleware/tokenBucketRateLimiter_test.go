@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsTokenBucketRateLimiter() ArgsTokenBucketRateLimiter {
+	return ArgsTokenBucketRateLimiter{
+		RequestsPerSecond:      1,
+		Burst:                  2,
+		HeavyRequestsPerSecond: 0.5,
+		HeavyBurst:             1,
+		HeavyRoutes:            []string{"/transaction/pool"},
+	}
+}
+
+func startTokenBucketServer(limiter *tokenBucketRateLimiter) *gin.Engine {
+	ws := gin.New()
+	ws.Use(limiter.MiddlewareHandlerFunc())
+	okHandler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	}
+	ws.GET("/address/:address", okHandler)
+	ws.GET("/v1.0/transaction/pool", okHandler)
+
+	return ws
+}
+
+func doRequest(ws *gin.Engine, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewTokenBucketRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid standard limits should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenBucketRateLimiter()
+		args.Burst = 0
+		tbrl, err := NewTokenBucketRateLimiter(args)
+		require.True(t, errors.Is(err, ErrInvalidTokenBucketLimits))
+		require.True(t, check.IfNil(tbrl))
+	})
+	t.Run("invalid heavy limits should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenBucketRateLimiter()
+		args.HeavyRequestsPerSecond = 0
+		tbrl, err := NewTokenBucketRateLimiter(args)
+		require.True(t, errors.Is(err, ErrInvalidTokenBucketLimits))
+		require.True(t, check.IfNil(tbrl))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tbrl, err := NewTokenBucketRateLimiter(createMockArgsTokenBucketRateLimiter())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(tbrl))
+	})
+}
+
+func TestTokenBucketRateLimiter_StandardRouteShouldConsumeBurstAndRefill(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tbrl, _ := NewTokenBucketRateLimiter(createMockArgsTokenBucketRateLimiter())
+	tbrl.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+	ws := startTokenBucketServer(tbrl)
+
+	assert.Equal(t, http.StatusOK, doRequest(ws, "/address/erd1").Code)
+	assert.Equal(t, http.StatusOK, doRequest(ws, "/address/erd1").Code)
+
+	resp := doRequest(ws, "/address/erd1")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "1", resp.Header().Get(retryAfterHeader))
+
+	currentTime = currentTime.Add(time.Second)
+	assert.Equal(t, http.StatusOK, doRequest(ws, "/address/erd1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(ws, "/address/erd1").Code)
+}
+
+func TestTokenBucketRateLimiter_HeavyRouteShouldUseSeparateLimits(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tbrl, _ := NewTokenBucketRateLimiter(createMockArgsTokenBucketRateLimiter())
+	tbrl.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+	ws := startTokenBucketServer(tbrl)
+
+	assert.Equal(t, http.StatusOK, doRequest(ws, "/v1.0/transaction/pool").Code)
+
+	resp := doRequest(ws, "/v1.0/transaction/pool")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "2", resp.Header().Get(retryAfterHeader))
+
+	// the standard route bucket is not affected
+	assert.Equal(t, http.StatusOK, doRequest(ws, "/address/erd1").Code)
+}
+
+func TestTokenBucketRateLimiter_CleanIdleBuckets(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tbrl, _ := NewTokenBucketRateLimiter(createMockArgsTokenBucketRateLimiter())
+	tbrl.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	_, _ = tbrl.take("key1", tbrl.standardLimits)
+	currentTime = currentTime.Add(time.Minute)
+	_, _ = tbrl.take("key2", tbrl.standardLimits)
+
+	tbrl.CleanIdleBuckets(time.Minute)
+	require.Len(t, tbrl.buckets, 1)
+	require.NotNil(t, tbrl.buckets["key2"])
+}
+
+func TestTokenBucketRateLimiter_UpdateLimits(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tbrl, _ := NewTokenBucketRateLimiter(createMockArgsTokenBucketRateLimiter())
+	tbrl.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+	ws := startTokenBucketServer(tbrl)
+
+	assert.Equal(t, http.StatusOK, doRequest(ws, "/address/erd1").Code)
+	assert.Equal(t, http.StatusOK, doRequest(ws, "/address/erd1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(ws, "/address/erd1").Code)
+
+	args := createMockArgsTokenBucketRateLimiter()
+	args.Burst = 0
+	err := tbrl.UpdateLimits(args)
+	require.True(t, errors.Is(err, ErrInvalidTokenBucketLimits))
+
+	args.RequestsPerSecond = 10
+	args.Burst = 5
+	err = tbrl.UpdateLimits(args)
+	require.NoError(t, err)
+
+	// the existing bucket is kept and refilled using the new limits
+	currentTime = currentTime.Add(time.Second)
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, doRequest(ws, "/address/erd1").Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(ws, "/address/erd1").Code)
+}
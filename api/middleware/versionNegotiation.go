@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ApiVersionHeader is the header used by the clients to select the API version of an unversioned route, such as
+// /transaction/:txhash instead of /v2.0/transaction/:txhash. The served version is echoed back under the same header
+const ApiVersionHeader = "X-Api-Version"
+
+type versionNegotiation struct {
+	handler  http.Handler
+	versions map[string]struct{}
+}
+
+// NewVersionNegotiation returns a new instance of versionNegotiation. It has to wrap the whole web server, as the
+// requests are routed towards the negotiated version by prefixing their path, before the routing takes place
+func NewVersionNegotiation(handler http.Handler, versions []string) (*versionNegotiation, error) {
+	if handler == nil {
+		return nil, ErrNilHttpHandler
+	}
+
+	versionsMap := make(map[string]struct{})
+	for _, version := range versions {
+		if len(version) == 0 {
+			continue
+		}
+
+		versionsMap[version] = struct{}{}
+	}
+
+	return &versionNegotiation{
+		handler:  handler,
+		versions: versionsMap,
+	}, nil
+}
+
+// ServeHTTP routes the unversioned requests carrying the version header towards the requested version
+func (vn *versionNegotiation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestedVersion := r.Header.Get(ApiVersionHeader)
+	if len(requestedVersion) == 0 || vn.isVersionedPath(r.URL.Path) {
+		vn.handler.ServeHTTP(w, r)
+		return
+	}
+
+	version := "v" + strings.TrimPrefix(strings.ToLower(requestedVersion), "v")
+	_, isKnownVersion := vn.versions[version]
+	if !isKnownVersion {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(data.GenericAPIResponse{
+			Error: fmt.Sprintf("unsupported api version %s", requestedVersion),
+			Code:  data.ReturnCodeRequestError,
+		})
+		return
+	}
+
+	r.URL.Path = "/" + version + r.URL.Path
+	if len(r.URL.RawPath) > 0 {
+		r.URL.RawPath = "/" + version + r.URL.RawPath
+	}
+	w.Header().Set(ApiVersionHeader, version)
+
+	vn.handler.ServeHTTP(w, r)
+}
+
+func (vn *versionNegotiation) isVersionedPath(path string) bool {
+	firstSegment := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)[0]
+	_, isVersion := vn.versions[firstSegment]
+
+	return isVersion
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVersionNegotiation(t *testing.T) {
+	t.Parallel()
+
+	vn, err := NewVersionNegotiation(nil, []string{"v1.0"})
+	require.Nil(t, vn)
+	require.Equal(t, ErrNilHttpHandler, err)
+
+	vn, err = NewVersionNegotiation(http.NewServeMux(), []string{"", "v1.0"})
+	require.NoError(t, err)
+	require.Len(t, vn.versions, 1)
+}
+
+func TestVersionNegotiation_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	servedPath := ""
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		servedPath = r.URL.Path
+	})
+	vn, _ := NewVersionNegotiation(handler, []string{"", "v1.0", "v1", "v2.0", "v2"})
+
+	testCases := []struct {
+		name            string
+		path            string
+		header          string
+		expectedPath    string
+		expectedVersion string
+	}{
+		{name: "no header should not change the path", path: "/transaction/hash", expectedPath: "/transaction/hash"},
+		{name: "versioned path should ignore the header", path: "/v1.0/transaction/hash", header: "2", expectedPath: "/v1.0/transaction/hash"},
+		{name: "major version", path: "/transaction/hash", header: "2", expectedPath: "/v2/transaction/hash", expectedVersion: "v2"},
+		{name: "full version", path: "/transaction/hash", header: "v2.0", expectedPath: "/v2.0/transaction/hash", expectedVersion: "v2.0"},
+		{name: "upper case version", path: "/transaction/hash", header: "V1", expectedPath: "/v1/transaction/hash", expectedVersion: "v1"},
+	}
+
+	for _, tc := range testCases {
+		servedPath = ""
+		req, _ := http.NewRequest(http.MethodGet, tc.path, nil)
+		if len(tc.header) > 0 {
+			req.Header.Set(ApiVersionHeader, tc.header)
+		}
+		resp := httptest.NewRecorder()
+		vn.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code, tc.name)
+		assert.Equal(t, tc.expectedPath, servedPath, tc.name)
+		assert.Equal(t, tc.expectedVersion, resp.Header().Get(ApiVersionHeader), tc.name)
+	}
+
+	t.Run("unknown version should error", func(t *testing.T) {
+		servedPath = ""
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/hash", nil)
+		req.Header.Set(ApiVersionHeader, "3")
+		resp := httptest.NewRecorder()
+		vn.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "unsupported api version 3")
+		assert.Empty(t, servedPath)
+	})
+}
@@ -1,7 +1,11 @@
 package mock
 
 import (
+	"context"
+	"io"
 	"math/big"
+	"net/http"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -13,7 +17,7 @@ import (
 // FacadeStub is the mock implementation of a node's router handler
 type FacadeStub struct {
 	IsFaucetEnabledHandler                       func() bool
-	GetAccountHandler                            func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccountHandler                            func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetAccountsHandler                           func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
 	GetShardIDForAddressHandler                  func(address string) (uint32, error)
 	GetValueForKeyHandler                        func(address string, key string, options common.AccountQueryOptions) (string, error)
@@ -25,8 +29,9 @@ type FacadeStub struct {
 	GetAllESDTTokensCalled                       func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetTransactionsHandler                       func(address string) ([]data.DatabaseTransaction, error)
 	GetTransactionHandler                        func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPoolHandler                   func(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShardHandler           func(shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetRelayedTransactionOfInnerHandler          func(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsPoolHandler                   func(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShardHandler           func(ctx context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error)
 	GetTransactionsPoolForSenderHandler          func(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSenderHandler             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderHandler func(sender string) (*data.TransactionsPoolNonceGaps, error)
@@ -34,7 +39,7 @@ type FacadeStub struct {
 	SendMultipleTransactionsHandler              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionHandler                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	SendUserFundsCalled                          func(receiver string, value *big.Int) error
-	ExecuteSCQueryHandler                        func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteSCQueryHandler                        func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
 	GetHeartbeatDataHandler                      func() (*data.HeartbeatResponse, error)
 	ValidatorStatisticsHandler                   func() (map[string]*data.ValidatorApiResponse, error)
 	AuctionListHandler                           func() ([]*data.AuctionListValidatorAPIResponse, error)
@@ -50,9 +55,11 @@ type FacadeStub struct {
 	GetDelegatedInfoCalled                       func() (*data.GenericAPIResponse, error)
 	GetRatingsConfigCalled                       func() (*data.GenericAPIResponse, error)
 	GetTransactionByHashAndSenderAddressHandler  func(txHash string, sndAddr string, withResults bool) (*transaction.ApiTransactionResult, int, error)
-	GetBlockByHashCalled                         func(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByNonceCalled                        func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHashCalled                         func(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonceCalled                        func(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetBlocksByRoundCalled                       func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRoundRangeCalled                  func(startRound uint64, endRound uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByNonceRangeCalled                  func(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 	GetInternalBlockByHashCalled                 func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonceCalled                func(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalMiniBlockByHashCalled             func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
@@ -60,6 +67,7 @@ type FacadeStub struct {
 	GetInternalStartOfEpochValidatorsInfoCalled  func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
 	GetHyperBlockByHashCalled                    func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonceCalled                   func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByTimestampCalled               func(timestamp int64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	ReloadObserversCalled                        func() data.NodesReloadResponse
 	ReloadFullHistoryObserversCalled             func() data.NodesReloadResponse
 	GetProofCalled                               func(string, string) (*data.GenericAPIResponse, error)
@@ -70,6 +78,7 @@ type FacadeStub struct {
 	GetESDTSupplyCalled                          func(token string) (*data.ESDTSupplyResponse, error)
 	GetMetricsCalled                             func() map[string]*data.EndpointMetrics
 	GetPrometheusMetricsCalled                   func() string
+	GetCachesMetricsCalled                       func() map[string]*data.CacheMetrics
 	GetGenesisNodesPubKeysCalled                 func() (*data.GenericAPIResponse, error)
 	GetGasConfigsCalled                          func() (*data.GenericAPIResponse, error)
 	IsOldStorageForTokenCalled                   func(tokenID string, nonce uint64) (bool, error)
@@ -83,7 +92,67 @@ type FacadeStub struct {
 	GetGuardianDataCalled                        func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigratedCalled                     func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IterateKeysCalled                            func(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	ExportAccountDataCalled                      func(address string, exportOptions common.AccountExportOptions, options common.AccountQueryOptions) (*data.AccountDataExport, error)
 	GetWaitingEpochsLeftForPublicKeyCalled       func(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
+	GetProxyPublicKeyCalled                      func() (*data.GenericAPIResponse, error)
+	TransactionCostDetailedRequestCalled         func(tx *data.Transaction) (*data.TxCostDetailedResponse, error)
+	GetStakingPortfolioCalled                    func(address string, stakingProviders []string) (*data.GenericAPIResponse, error)
+	GetBlockByHashFromAnyShardCalled             func(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHeartbeatChangesCalled                    func(sinceMillis int64) (*data.HeartbeatChangesResponse, error)
+	GetESDTTokensListCalled                      func(address string, options common.AccountQueryOptions, filter common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error)
+	StartDrainCalled                             func() *data.DrainStatus
+	GetDrainStatusCalled                         func() *data.DrainStatus
+	GetTransactionsPoolForShardStreamCalled      func(ctx context.Context, shardID uint32, fields string) (io.ReadCloser, error)
+	GetTransactionsHistoryCalled                 func(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error)
+	GetTransfersHistoryCalled                    func(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error)
+	ConvertAddressesCalled                       func(addresses []string) ([]data.AddressConversion, error)
+	EncodeAddressAsHexCalled                     func(address string) (string, error)
+	IsNonceManagerEnabledCalled                  func() bool
+	SendManagedTransactionCalled                 func(tx *data.Transaction) (int, string, error)
+	ReserveNoncesCalled                          func(request *data.NonceReservationRequest) (int, *data.NonceReservation, error)
+	ReleaseNoncesCalled                          func(request *data.NonceReleaseRequest) (int, error)
+	SetFaultInjectionScenarioCalled              func(scenario *data.FaultInjectionScenario) error
+	GetFaultInjectionStatusCalled                func() *data.FaultInjectionStatus
+	GetEconomicsDataMetricsHistoryCalled         func() []*data.EconomicMetricsSample
+	ForwardRawObserverRequestCalled              func(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error)
+	ValidatorStatisticsForKeyCalled              func(blsKey string) (*data.ValidatorApiResponse, error)
+	GetESDTRolesCalled                           func(token string) (*data.ESDTRolesResponse, error)
+	GetESDTSupplyHistoryCalled                   func(token string) (int, *data.ESDTSupplyHistoryResponse, error)
+	GetAccountWithQuorumCalled                   func(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error)
+	GetShardsOfAddressesCalled                   func(addresses []string) (*data.AddressesShards, error)
+	IsWebhooksEnabledCalled                      func() bool
+	WatchTransactionCalled                       func(webhook string, txHash string) error
+	WatchAddressesCalled                         func(webhook string, addresses []string) error
+	UnwatchAddressCalled                         func(webhook string, address string) error
+	GetWatchedAddressesCalled                    func(webhook string) ([]string, error)
+	IsObserversFeedEnabledCalled                 func() bool
+	GetLatestBlocksCalled                        func() *data.LatestBlocksResponseData
+	WatchBlocksCalled                            func(ctx context.Context, shardID uint32) <-chan *data.BlockNotification
+	GetESDTSuppliesCalled                        func(tokens []string) (*data.ESDTSuppliesResponse, error)
+	GetVerifiedProofCalled                       func(rootHash string, address string) (*data.GenericAPIResponse, error)
+	GetVerifiedProofCurrentRootHashCalled        func(address string) (*data.GenericAPIResponse, error)
+	GetSyncProgressCalled                        func() *data.SyncProgress
+	GetTransactionProtobufCalled                 func(txHash string, sndAddr string) ([]byte, error)
+	GetBlockProtobufByNonceCalled                func(shardID uint32, nonce uint64) ([]byte, error)
+	GetBlockProtobufByHashCalled                 func(shardID uint32, hash string) ([]byte, error)
+	ReloadConfigCalled                           func() (*data.ConfigReloadStatus, error)
+	GetAccountDelegationsCalled                  func(address string) (*data.GenericAPIResponse, error)
+	IsTransactionWaitEnabledCalled               func() bool
+	WaitForTransactionExecutionCalled            func(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error)
+	GetESDTPendingIssuancesCalled                func() (*data.ESDTPendingIssuancesResponse, error)
+	GetDelegationProvidersCalled                 func() (*data.DelegationProvidersResponse, error)
+	GetESDTOwnershipCalled                       func(token string) (*data.ESDTOwnershipResponse, error)
+	WatchTransactionsStatusCalled                func(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error)
+	GetBridgeDepositsCalled                      func(address string) (*data.GenericAPIResponse, error)
+	GetCollectionNFTsCalled                      func(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error)
+	GetConsensusGroupCalled                      func(shardID uint32, round uint64) (*data.GenericAPIResponse, error)
+	GetBlockFeesByNonceCalled                    func(shardID uint32, nonce uint64) (*data.BlockFees, error)
+	DryRunMultipleTransactionsCalled             func(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData
+	ComputeTransactionFeeCalled                  func(tx *data.Transaction) (*data.TransactionFeeResponseData, int, error)
+	SendRawTransactionCalled                     func(txBytes []byte) (int, string, error)
+	IsClientStatsEnabledCalled                   func() bool
+	GetClientStatsCalled                         func(clientKey string) (*data.ClientStats, error)
+	GetSCQueryCostsCalled                        func() *data.SCQueryCosts
 }
 
 // GetProof -
@@ -268,8 +337,8 @@ func (f *FacadeStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, err
 }
 
 // GetAccount -
-func (f *FacadeStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	return f.GetAccountHandler(address, options)
+func (f *FacadeStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	return f.GetAccountHandler(ctx, address, options)
 }
 
 // GetAccounts -
@@ -348,19 +417,28 @@ func (f *FacadeStub) GetTransaction(txHash string, withResults bool) (*transacti
 	return f.GetTransactionHandler(txHash, withResults)
 }
 
+// GetRelayedTransactionOfInner -
+func (f *FacadeStub) GetRelayedTransactionOfInner(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error) {
+	if f.GetRelayedTransactionOfInnerHandler != nil {
+		return f.GetRelayedTransactionOfInnerHandler(innerTx, withResults)
+	}
+
+	return nil, nil
+}
+
 // GetTransactionsPool -
-func (f *FacadeStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (f *FacadeStub) GetTransactionsPool(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolHandler != nil {
-		return f.GetTransactionsPoolHandler(fields)
+		return f.GetTransactionsPoolHandler(ctx, fields, cursor)
 	}
 
 	return nil, nil
 }
 
 // GetTransactionsPoolForShard -
-func (f *FacadeStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (f *FacadeStub) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolForShardHandler != nil {
-		return f.GetTransactionsPoolForShardHandler(shardID, fields)
+		return f.GetTransactionsPoolForShardHandler(ctx, shardID, fields, cursor)
 	}
 
 	return nil, nil
@@ -434,8 +512,8 @@ func (f *FacadeStub) SendUserFunds(receiver string, value *big.Int) error {
 }
 
 // ExecuteSCQuery -
-func (f *FacadeStub) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
-	return f.ExecuteSCQueryHandler(query)
+func (f *FacadeStub) ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	return f.ExecuteSCQueryHandler(ctx, query)
 }
 
 // GetHeartbeatData -
@@ -444,13 +522,13 @@ func (f *FacadeStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
 }
 
 // GetBlockByHash -
-func (f *FacadeStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return f.GetBlockByHashCalled(shardID, hash, options)
+func (f *FacadeStub) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return f.GetBlockByHashCalled(ctx, shardID, hash, options)
 }
 
 // GetBlockByNonce -
-func (f *FacadeStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return f.GetBlockByNonceCalled(shardID, nonce, options)
+func (f *FacadeStub) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return f.GetBlockByNonceCalled(ctx, shardID, nonce, options)
 }
 
 // GetBlocksByRound -
@@ -461,6 +539,22 @@ func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOpt
 	return nil, nil
 }
 
+// GetBlocksByRoundRange -
+func (f *FacadeStub) GetBlocksByRoundRange(startRound uint64, endRound uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	if f.GetBlocksByRoundRangeCalled != nil {
+		return f.GetBlocksByRoundRangeCalled(startRound, endRound, options)
+	}
+	return nil, nil
+}
+
+// GetBlocksByNonceRange -
+func (f *FacadeStub) GetBlocksByNonceRange(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	if f.GetBlocksByNonceRangeCalled != nil {
+		return f.GetBlocksByNonceRangeCalled(shardID, startNonce, endNonce, options)
+	}
+	return nil, nil
+}
+
 // GetInternalBlockByHash -
 func (f *FacadeStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByHashCalled(shardID, hash, format)
@@ -491,11 +585,25 @@ func (f *FacadeStub) GetHyperBlockByNonce(nonce uint64, options common.Hyperbloc
 	return f.GetHyperBlockByNonceCalled(nonce, options)
 }
 
+// GetHyperBlockByTimestamp -
+func (f *FacadeStub) GetHyperBlockByTimestamp(timestamp int64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return f.GetHyperBlockByTimestampCalled(timestamp, options)
+}
+
 // GetMetrics -
 func (f *FacadeStub) GetMetrics() map[string]*data.EndpointMetrics {
 	return f.GetMetricsCalled()
 }
 
+// GetCachesMetrics -
+func (f *FacadeStub) GetCachesMetrics() map[string]*data.CacheMetrics {
+	if f.GetCachesMetricsCalled != nil {
+		return f.GetCachesMetricsCalled()
+	}
+
+	return nil
+}
+
 // GetMetricsForPrometheus -
 func (f *FacadeStub) GetMetricsForPrometheus() string {
 	return f.GetPrometheusMetricsCalled()
@@ -579,6 +687,15 @@ func (f *FacadeStub) IterateKeys(address string, numKeys uint, iteratorState [][
 	return &data.GenericAPIResponse{}, nil
 }
 
+// ExportAccountData -
+func (f *FacadeStub) ExportAccountData(address string, exportOptions common.AccountExportOptions, options common.AccountQueryOptions) (*data.AccountDataExport, error) {
+	if f.ExportAccountDataCalled != nil {
+		return f.ExportAccountDataCalled(address, exportOptions, options)
+	}
+
+	return &data.AccountDataExport{}, nil
+}
+
 // GetWaitingEpochsLeftForPublicKey -
 func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if f.GetWaitingEpochsLeftForPublicKeyCalled != nil {
@@ -587,6 +704,537 @@ func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.W
 	return &data.WaitingEpochsLeftApiResponse{}, nil
 }
 
+// GetProxyPublicKey -
+func (f *FacadeStub) GetProxyPublicKey() (*data.GenericAPIResponse, error) {
+	if f.GetProxyPublicKeyCalled != nil {
+		return f.GetProxyPublicKeyCalled()
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// TransactionCostDetailedRequest -
+func (f *FacadeStub) TransactionCostDetailedRequest(tx *data.Transaction) (*data.TxCostDetailedResponse, error) {
+	if f.TransactionCostDetailedRequestCalled != nil {
+		return f.TransactionCostDetailedRequestCalled(tx)
+	}
+
+	return &data.TxCostDetailedResponse{}, nil
+}
+
+// GetStakingPortfolio -
+func (f *FacadeStub) GetStakingPortfolio(address string, stakingProviders []string) (*data.GenericAPIResponse, error) {
+	if f.GetStakingPortfolioCalled != nil {
+		return f.GetStakingPortfolioCalled(address, stakingProviders)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// GetBlockByHashFromAnyShard -
+func (f *FacadeStub) GetBlockByHashFromAnyShard(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if f.GetBlockByHashFromAnyShardCalled != nil {
+		return f.GetBlockByHashFromAnyShardCalled(hash, options)
+	}
+
+	return &data.BlockApiResponse{}, nil
+}
+
+// GetHeartbeatChanges -
+func (f *FacadeStub) GetHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+	if f.GetHeartbeatChangesCalled != nil {
+		return f.GetHeartbeatChangesCalled(sinceMillis)
+	}
+
+	return &data.HeartbeatChangesResponse{}, nil
+}
+
+// GetESDTTokensList -
+func (f *FacadeStub) GetESDTTokensList(address string, options common.AccountQueryOptions, filter common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error) {
+	if f.GetESDTTokensListCalled != nil {
+		return f.GetESDTTokensListCalled(address, options, filter)
+	}
+
+	return &data.AccountESDTTokensListResponse{}, nil
+}
+
+// StartDrain -
+func (f *FacadeStub) StartDrain() *data.DrainStatus {
+	if f.StartDrainCalled != nil {
+		return f.StartDrainCalled()
+	}
+
+	return &data.DrainStatus{}
+}
+
+// GetDrainStatus -
+func (f *FacadeStub) GetDrainStatus() *data.DrainStatus {
+	if f.GetDrainStatusCalled != nil {
+		return f.GetDrainStatusCalled()
+	}
+
+	return &data.DrainStatus{}
+}
+
+// GetTransactionsPoolForShardStream -
+func (f *FacadeStub) GetTransactionsPoolForShardStream(ctx context.Context, shardID uint32, fields string) (io.ReadCloser, error) {
+	if f.GetTransactionsPoolForShardStreamCalled != nil {
+		return f.GetTransactionsPoolForShardStreamCalled(ctx, shardID, fields)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsHistory -
+func (f *FacadeStub) GetTransactionsHistory(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error) {
+	if f.GetTransactionsHistoryCalled != nil {
+		return f.GetTransactionsHistoryCalled(address, options)
+	}
+
+	return &data.TransactionsHistory{}, nil
+}
+
+// GetTransfersHistory -
+func (f *FacadeStub) GetTransfersHistory(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error) {
+	if f.GetTransfersHistoryCalled != nil {
+		return f.GetTransfersHistoryCalled(address, options)
+	}
+
+	return &data.TransfersHistory{}, nil
+}
+
+// ConvertAddresses -
+func (f *FacadeStub) ConvertAddresses(addresses []string) ([]data.AddressConversion, error) {
+	if f.ConvertAddressesCalled != nil {
+		return f.ConvertAddressesCalled(addresses)
+	}
+
+	return make([]data.AddressConversion, 0), nil
+}
+
+// EncodeAddressAsHex -
+func (f *FacadeStub) EncodeAddressAsHex(address string) (string, error) {
+	if f.EncodeAddressAsHexCalled != nil {
+		return f.EncodeAddressAsHexCalled(address)
+	}
+
+	return "", nil
+}
+
+// IsNonceManagerEnabled -
+func (f *FacadeStub) IsNonceManagerEnabled() bool {
+	if f.IsNonceManagerEnabledCalled != nil {
+		return f.IsNonceManagerEnabledCalled()
+	}
+
+	return false
+}
+
+// SendManagedTransaction -
+func (f *FacadeStub) SendManagedTransaction(tx *data.Transaction) (int, string, error) {
+	if f.SendManagedTransactionCalled != nil {
+		return f.SendManagedTransactionCalled(tx)
+	}
+
+	return http.StatusOK, "", nil
+}
+
+// ReserveNonces -
+func (f *FacadeStub) ReserveNonces(request *data.NonceReservationRequest) (int, *data.NonceReservation, error) {
+	if f.ReserveNoncesCalled != nil {
+		return f.ReserveNoncesCalled(request)
+	}
+
+	return http.StatusOK, &data.NonceReservation{}, nil
+}
+
+// ReleaseNonces -
+func (f *FacadeStub) ReleaseNonces(request *data.NonceReleaseRequest) (int, error) {
+	if f.ReleaseNoncesCalled != nil {
+		return f.ReleaseNoncesCalled(request)
+	}
+
+	return http.StatusOK, nil
+}
+
+// SetFaultInjectionScenario -
+func (f *FacadeStub) SetFaultInjectionScenario(scenario *data.FaultInjectionScenario) error {
+	if f.SetFaultInjectionScenarioCalled != nil {
+		return f.SetFaultInjectionScenarioCalled(scenario)
+	}
+
+	return nil
+}
+
+// GetFaultInjectionStatus -
+func (f *FacadeStub) GetFaultInjectionStatus() *data.FaultInjectionStatus {
+	if f.GetFaultInjectionStatusCalled != nil {
+		return f.GetFaultInjectionStatusCalled()
+	}
+
+	return &data.FaultInjectionStatus{}
+}
+
+// GetEconomicsDataMetricsHistory -
+func (f *FacadeStub) GetEconomicsDataMetricsHistory() []*data.EconomicMetricsSample {
+	if f.GetEconomicsDataMetricsHistoryCalled != nil {
+		return f.GetEconomicsDataMetricsHistoryCalled()
+	}
+
+	return make([]*data.EconomicMetricsSample, 0)
+}
+
+// ForwardRawObserverRequest -
+func (f *FacadeStub) ForwardRawObserverRequest(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error) {
+	if f.ForwardRawObserverRequestCalled != nil {
+		return f.ForwardRawObserverRequestCalled(ctx, shardID, request)
+	}
+
+	return http.StatusOK, &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+// ValidatorStatisticsForKey -
+func (f *FacadeStub) ValidatorStatisticsForKey(blsKey string) (*data.ValidatorApiResponse, error) {
+	if f.ValidatorStatisticsForKeyCalled != nil {
+		return f.ValidatorStatisticsForKeyCalled(blsKey)
+	}
+
+	return nil, nil
+}
+
+// GetESDTRoles -
+func (f *FacadeStub) GetESDTRoles(token string) (*data.ESDTRolesResponse, error) {
+	if f.GetESDTRolesCalled != nil {
+		return f.GetESDTRolesCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetESDTSupplyHistory -
+func (f *FacadeStub) GetESDTSupplyHistory(token string) (int, *data.ESDTSupplyHistoryResponse, error) {
+	if f.GetESDTSupplyHistoryCalled != nil {
+		return f.GetESDTSupplyHistoryCalled(token)
+	}
+
+	return http.StatusOK, nil, nil
+}
+
+// GetAccountWithQuorum -
+func (f *FacadeStub) GetAccountWithQuorum(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error) {
+	if f.GetAccountWithQuorumCalled != nil {
+		return f.GetAccountWithQuorumCalled(address, options)
+	}
+
+	return &data.AccountQuorumModel{}, nil
+}
+
+// GetShardsOfAddresses -
+func (f *FacadeStub) GetShardsOfAddresses(addresses []string) (*data.AddressesShards, error) {
+	if f.GetShardsOfAddressesCalled != nil {
+		return f.GetShardsOfAddressesCalled(addresses)
+	}
+
+	return &data.AddressesShards{}, nil
+}
+
+// IsWebhooksEnabled -
+func (f *FacadeStub) IsWebhooksEnabled() bool {
+	if f.IsWebhooksEnabledCalled != nil {
+		return f.IsWebhooksEnabledCalled()
+	}
+
+	return false
+}
+
+// WatchTransaction -
+func (f *FacadeStub) WatchTransaction(webhook string, txHash string) error {
+	if f.WatchTransactionCalled != nil {
+		return f.WatchTransactionCalled(webhook, txHash)
+	}
+
+	return nil
+}
+
+// WatchAddresses -
+func (f *FacadeStub) WatchAddresses(webhook string, addresses []string) error {
+	if f.WatchAddressesCalled != nil {
+		return f.WatchAddressesCalled(webhook, addresses)
+	}
+
+	return nil
+}
+
+// UnwatchAddress -
+func (f *FacadeStub) UnwatchAddress(webhook string, address string) error {
+	if f.UnwatchAddressCalled != nil {
+		return f.UnwatchAddressCalled(webhook, address)
+	}
+
+	return nil
+}
+
+// GetWatchedAddresses -
+func (f *FacadeStub) GetWatchedAddresses(webhook string) ([]string, error) {
+	if f.GetWatchedAddressesCalled != nil {
+		return f.GetWatchedAddressesCalled(webhook)
+	}
+
+	return nil, nil
+}
+
+// IsObserversFeedEnabled -
+func (f *FacadeStub) IsObserversFeedEnabled() bool {
+	if f.IsObserversFeedEnabledCalled != nil {
+		return f.IsObserversFeedEnabledCalled()
+	}
+
+	return false
+}
+
+// GetLatestBlocks -
+func (f *FacadeStub) GetLatestBlocks() *data.LatestBlocksResponseData {
+	if f.GetLatestBlocksCalled != nil {
+		return f.GetLatestBlocksCalled()
+	}
+
+	return &data.LatestBlocksResponseData{}
+}
+
+// WatchBlocks -
+func (f *FacadeStub) WatchBlocks(ctx context.Context, shardID uint32) <-chan *data.BlockNotification {
+	if f.WatchBlocksCalled != nil {
+		return f.WatchBlocksCalled(ctx, shardID)
+	}
+
+	return make(chan *data.BlockNotification)
+}
+
+// GetESDTSupplies -
+func (f *FacadeStub) GetESDTSupplies(tokens []string) (*data.ESDTSuppliesResponse, error) {
+	if f.GetESDTSuppliesCalled != nil {
+		return f.GetESDTSuppliesCalled(tokens)
+	}
+
+	return nil, nil
+}
+
+// GetVerifiedProof -
+func (f *FacadeStub) GetVerifiedProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+	if f.GetVerifiedProofCalled != nil {
+		return f.GetVerifiedProofCalled(rootHash, address)
+	}
+
+	return nil, nil
+}
+
+// GetVerifiedProofCurrentRootHash -
+func (f *FacadeStub) GetVerifiedProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+	if f.GetVerifiedProofCurrentRootHashCalled != nil {
+		return f.GetVerifiedProofCurrentRootHashCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetSyncProgress -
+func (f *FacadeStub) GetSyncProgress() *data.SyncProgress {
+	if f.GetSyncProgressCalled != nil {
+		return f.GetSyncProgressCalled()
+	}
+
+	return &data.SyncProgress{}
+}
+
+// GetTransactionProtobuf -
+func (f *FacadeStub) GetTransactionProtobuf(txHash string, sndAddr string) ([]byte, error) {
+	if f.GetTransactionProtobufCalled != nil {
+		return f.GetTransactionProtobufCalled(txHash, sndAddr)
+	}
+
+	return nil, nil
+}
+
+// GetBlockProtobufByNonce -
+func (f *FacadeStub) GetBlockProtobufByNonce(shardID uint32, nonce uint64) ([]byte, error) {
+	if f.GetBlockProtobufByNonceCalled != nil {
+		return f.GetBlockProtobufByNonceCalled(shardID, nonce)
+	}
+
+	return nil, nil
+}
+
+// GetBlockProtobufByHash -
+func (f *FacadeStub) GetBlockProtobufByHash(shardID uint32, hash string) ([]byte, error) {
+	if f.GetBlockProtobufByHashCalled != nil {
+		return f.GetBlockProtobufByHashCalled(shardID, hash)
+	}
+
+	return nil, nil
+}
+
+// ReloadConfig -
+func (f *FacadeStub) ReloadConfig() (*data.ConfigReloadStatus, error) {
+	if f.ReloadConfigCalled != nil {
+		return f.ReloadConfigCalled()
+	}
+
+	return &data.ConfigReloadStatus{}, nil
+}
+
+// GetAccountDelegations -
+func (f *FacadeStub) GetAccountDelegations(address string) (*data.GenericAPIResponse, error) {
+	if f.GetAccountDelegationsCalled != nil {
+		return f.GetAccountDelegationsCalled(address)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// IsTransactionWaitEnabled -
+func (f *FacadeStub) IsTransactionWaitEnabled() bool {
+	if f.IsTransactionWaitEnabledCalled != nil {
+		return f.IsTransactionWaitEnabledCalled()
+	}
+
+	return false
+}
+
+// WaitForTransactionExecution -
+func (f *FacadeStub) WaitForTransactionExecution(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error) {
+	if f.WaitForTransactionExecutionCalled != nil {
+		return f.WaitForTransactionExecutionCalled(ctx, txHash, timeout)
+	}
+
+	return &data.TransactionExecutionResult{}, nil
+}
+
+// GetDelegationProviders -
+func (f *FacadeStub) GetDelegationProviders() (*data.DelegationProvidersResponse, error) {
+	if f.GetDelegationProvidersCalled != nil {
+		return f.GetDelegationProvidersCalled()
+	}
+
+	return &data.DelegationProvidersResponse{}, nil
+}
+
+// GetESDTPendingIssuances -
+func (f *FacadeStub) GetESDTPendingIssuances() (*data.ESDTPendingIssuancesResponse, error) {
+	if f.GetESDTPendingIssuancesCalled != nil {
+		return f.GetESDTPendingIssuancesCalled()
+	}
+
+	return &data.ESDTPendingIssuancesResponse{}, nil
+}
+
+// GetESDTOwnership -
+func (f *FacadeStub) GetESDTOwnership(token string) (*data.ESDTOwnershipResponse, error) {
+	if f.GetESDTOwnershipCalled != nil {
+		return f.GetESDTOwnershipCalled(token)
+	}
+
+	return &data.ESDTOwnershipResponse{}, nil
+}
+
+// WatchTransactionsStatus -
+func (f *FacadeStub) WatchTransactionsStatus(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error) {
+	if f.WatchTransactionsStatusCalled != nil {
+		return f.WatchTransactionsStatusCalled(ctx, txHashes)
+	}
+
+	return nil, nil
+}
+
+// GetCollectionNFTs -
+func (f *FacadeStub) GetCollectionNFTs(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error) {
+	if f.GetCollectionNFTsCalled != nil {
+		return f.GetCollectionNFTsCalled(collection, options)
+	}
+
+	return &data.CollectionNFTs{}, nil
+}
+
+// IsClientStatsEnabled -
+func (f *FacadeStub) IsClientStatsEnabled() bool {
+	if f.IsClientStatsEnabledCalled != nil {
+		return f.IsClientStatsEnabledCalled()
+	}
+
+	return false
+}
+
+// GetClientStats -
+func (f *FacadeStub) GetClientStats(clientKey string) (*data.ClientStats, error) {
+	if f.GetClientStatsCalled != nil {
+		return f.GetClientStatsCalled(clientKey)
+	}
+
+	return &data.ClientStats{}, nil
+}
+
+// GetSCQueryCosts -
+func (f *FacadeStub) GetSCQueryCosts() *data.SCQueryCosts {
+	if f.GetSCQueryCostsCalled != nil {
+		return f.GetSCQueryCostsCalled()
+	}
+
+	return &data.SCQueryCosts{}
+}
+
+// GetBridgeDeposits -
+func (f *FacadeStub) GetBridgeDeposits(address string) (*data.GenericAPIResponse, error) {
+	if f.GetBridgeDepositsCalled != nil {
+		return f.GetBridgeDepositsCalled(address)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// GetConsensusGroup -
+func (f *FacadeStub) GetConsensusGroup(shardID uint32, round uint64) (*data.GenericAPIResponse, error) {
+	if f.GetConsensusGroupCalled != nil {
+		return f.GetConsensusGroupCalled(shardID, round)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// GetBlockFeesByNonce -
+func (f *FacadeStub) GetBlockFeesByNonce(shardID uint32, nonce uint64) (*data.BlockFees, error) {
+	if f.GetBlockFeesByNonceCalled != nil {
+		return f.GetBlockFeesByNonceCalled(shardID, nonce)
+	}
+
+	return &data.BlockFees{}, nil
+}
+
+// DryRunMultipleTransactions -
+func (f *FacadeStub) DryRunMultipleTransactions(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData {
+	if f.DryRunMultipleTransactionsCalled != nil {
+		return f.DryRunMultipleTransactionsCalled(txs, options)
+	}
+
+	return &data.MultipleTransactionsDryRunResponseData{}
+}
+
+// ComputeTransactionFee -
+func (f *FacadeStub) ComputeTransactionFee(tx *data.Transaction) (*data.TransactionFeeResponseData, int, error) {
+	if f.ComputeTransactionFeeCalled != nil {
+		return f.ComputeTransactionFeeCalled(tx)
+	}
+
+	return &data.TransactionFeeResponseData{}, http.StatusOK, nil
+}
+
+// SendRawTransaction -
+func (f *FacadeStub) SendRawTransaction(txBytes []byte) (int, string, error) {
+	if f.SendRawTransactionCalled != nil {
+		return f.SendRawTransactionCalled(txBytes)
+	}
+
+	return http.StatusOK, "", nil
+}
+
 // WrongFacade is a struct that can be used as a wrong implementation of the node router handler
 type WrongFacade struct {
 }
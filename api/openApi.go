@@ -0,0 +1,120 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/openapi"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// OpenApiDocumentPath is the route serving the OpenAPI document generated from the registered routes
+const OpenApiDocumentPath = "/swagger.json"
+
+const openApiDocumentTitle = "MultiversX Gateway API"
+
+// knownRouteTypes holds the data types exchanged on the routes with a known contract, keyed by the method and the
+// unversioned route path. The other routes are described with the generic response envelope
+var knownRouteTypes = createKnownRouteTypes(RouteDefinitions)
+
+func createKnownRouteTypes(definitions []RouteDefinition) map[string]openapi.OperationArgs {
+	routeTypes := make(map[string]openapi.OperationArgs, len(definitions))
+	for _, definition := range definitions {
+		routeTypes[definition.Method+" "+definition.Path] = openapi.OperationArgs{
+			RequestType:      definition.RequestType,
+			ResponseDataType: definition.ResponseDataType,
+		}
+	}
+
+	return routeTypes
+}
+
+// registerOpenApiRoute serves the OpenAPI document generated out of the routes already registered on the web server.
+// The disabled routes are skipped on each request, as they can be toggled at runtime, so the document only describes
+// the routes enabled on this proxy
+func registerOpenApiRoute(ws *gin.Engine, versionsMap map[string]*data.VersionData, disabledRoutes DisabledRoutesHandler) {
+	routes := ws.Routes()
+	ws.GET(OpenApiDocumentPath, func(c *gin.Context) {
+		document := createOpenApiDocument(filterEnabledRoutes(routes, disabledRoutes), versionsMap)
+		c.JSON(http.StatusOK, document)
+	})
+}
+
+func filterEnabledRoutes(routes gin.RoutesInfo, disabledRoutes DisabledRoutesHandler) gin.RoutesInfo {
+	enabledRoutes := make(gin.RoutesInfo, 0, len(routes))
+	for _, route := range routes {
+		_, packageName, routeName, ok := splitVersionedPath(route.Path)
+		if ok && disabledRoutes.IsRouteDisabled("/"+packageName+routeName) {
+			continue
+		}
+
+		enabledRoutes = append(enabledRoutes, route)
+	}
+
+	return enabledRoutes
+}
+
+func createOpenApiDocument(routes gin.RoutesInfo, versionsMap map[string]*data.VersionData) *openapi.Document {
+	versions := make([]string, 0, len(versionsMap))
+	for version := range versionsMap {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	builder := openapi.NewDocumentBuilder(openapi.Info{
+		Title:       openApiDocumentTitle,
+		Description: "Generated from the routes enabled on this proxy instance",
+		Version:     strings.Join(versions, ", "),
+	})
+	for _, route := range routes {
+		version, packageName, routeName, ok := splitVersionedPath(route.Path)
+		if !ok {
+			continue
+		}
+		versionData, found := versionsMap[version]
+		if !found {
+			continue
+		}
+
+		operationArgs := knownRouteTypes[route.Method+" /"+packageName+routeName]
+		operationArgs.Method = route.Method
+		operationArgs.Path = route.Path
+		operationArgs.Tag = packageName
+		operationArgs.IsSecured = isRouteSecured(versionData.ApiConfig, packageName, routeName)
+		builder.AddOperation(operationArgs)
+	}
+
+	return builder.Document()
+}
+
+// splitVersionedPath splits a path such as /v1.0/address/:address into v1.0, address and /:address
+func splitVersionedPath(path string) (string, string, string, bool) {
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(segments) < 2 {
+		return "", "", "", false
+	}
+
+	routeName := ""
+	if len(segments) == 3 {
+		routeName = "/" + segments[2]
+	}
+
+	return segments[0], segments[1], routeName, true
+}
+
+func isRouteSecured(apiConfig data.ApiRoutesConfig, packageName string, routeName string) bool {
+	packageConfig, found := apiConfig.APIPackages[packageName]
+	if !found {
+		return false
+	}
+
+	for _, routeConfig := range packageConfig.Routes {
+		if routeConfig.Name == routeName {
+			return routeConfig.Secured
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	basicAuthSecurityScheme = "basicAuth"
+	jsonContentType         = "application/json"
+	envelopeSchemaName      = "GenericAPIResponse"
+)
+
+// OperationArgs holds the arguments needed to describe a single route
+type OperationArgs struct {
+	Method string
+	// Path is the gin path of the route, such as /v1.0/address/:address or /v1.0/observer/:shard/raw/*path
+	Path      string
+	Tag       string
+	IsSecured bool
+	// RequestType is an instance of the type expected in the request body, if any
+	RequestType interface{}
+	// ResponseDataType is an instance of the type returned under the data field of the response, if known
+	ResponseDataType interface{}
+}
+
+type documentBuilder struct {
+	document *Document
+}
+
+// NewDocumentBuilder returns a new instance of documentBuilder
+func NewDocumentBuilder(info Info) *documentBuilder {
+	builder := &documentBuilder{
+		document: &Document{
+			OpenAPI: Version,
+			Info:    info,
+			Paths:   make(map[string]*PathItem),
+			Components: Components{
+				Schemas: make(map[string]*Schema),
+			},
+		},
+	}
+	builder.document.Components.Schemas[envelopeSchemaName] = builder.buildStructSchema(reflect.TypeOf(genericAPIResponse{}))
+
+	return builder
+}
+
+// genericAPIResponse mirrors the envelope of all the responses, so the document does not depend on the data package
+type genericAPIResponse struct {
+	Data  interface{} `json:"data"`
+	Error string      `json:"error"`
+	Code  string      `json:"code"`
+}
+
+// AddOperation adds the described route to the document
+func (builder *documentBuilder) AddOperation(args OperationArgs) {
+	path, parameters := convertPath(args.Path)
+	method := strings.ToLower(args.Method)
+
+	pathItem, exists := builder.document.Paths[path]
+	if !exists {
+		pathItem = &PathItem{}
+		builder.document.Paths[path] = pathItem
+	}
+
+	operation := &Operation{
+		OperationID: operationID(args.Method, path),
+		Parameters:  parameters,
+		Responses:   builder.createResponses(args.ResponseDataType),
+	}
+	if len(args.Tag) > 0 {
+		operation.Tags = []string{args.Tag}
+	}
+	if args.RequestType != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				jsonContentType: {Schema: builder.schemaFromType(reflect.TypeOf(args.RequestType))},
+			},
+		}
+	}
+	if args.IsSecured {
+		builder.addBasicAuthSecurityScheme()
+		operation.Security = []map[string][]string{{basicAuthSecurityScheme: {}}}
+	}
+
+	(*pathItem)[method] = operation
+}
+
+func (builder *documentBuilder) createResponses(responseDataType interface{}) map[string]*Response {
+	envelopeRef := &Schema{Ref: componentsSchemasPrefix + envelopeSchemaName}
+	successSchema := envelopeRef
+	if responseDataType != nil {
+		successSchema = &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"data":  builder.schemaFromType(reflect.TypeOf(responseDataType)),
+				"error": {Type: "string"},
+				"code":  {Type: "string"},
+			},
+		}
+	}
+
+	return map[string]*Response{
+		"200": {
+			Description: "successful operation",
+			Content:     map[string]*MediaType{jsonContentType: {Schema: successSchema}},
+		},
+		"default": {
+			Description: "failed operation",
+			Content:     map[string]*MediaType{jsonContentType: {Schema: envelopeRef}},
+		},
+	}
+}
+
+func (builder *documentBuilder) addBasicAuthSecurityScheme() {
+	if builder.document.Components.SecuritySchemes == nil {
+		builder.document.Components.SecuritySchemes = make(map[string]*SecurityScheme)
+	}
+
+	builder.document.Components.SecuritySchemes[basicAuthSecurityScheme] = &SecurityScheme{
+		Type:   "http",
+		Scheme: "basic",
+	}
+}
+
+// Document returns the built document
+func (builder *documentBuilder) Document() *Document {
+	return builder.document
+}
+
+// convertPath converts the gin path parameters (:name and *name) into OpenAPI path parameters ({name})
+func convertPath(ginPath string) (string, []*Parameter) {
+	segments := strings.Split(ginPath, "/")
+	parameters := make([]*Parameter, 0)
+	for idx, segment := range segments {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+
+		name := segment[1:]
+		segments[idx] = fmt.Sprintf("{%s}", name)
+		parameters = append(parameters, &Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	return strings.Join(segments, "/"), parameters
+}
+
+func operationID(method string, path string) string {
+	replacer := strings.NewReplacer("/", "_", "{", "", "}", "", "-", "_", ".", "_")
+	id := strings.Trim(replacer.Replace(path), "_")
+
+	return strings.ToLower(method) + "_" + id
+}
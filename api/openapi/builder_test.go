@@ -0,0 +1,93 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testNode struct {
+	Name     string      `json:"name"`
+	Value    uint64      `json:"value,omitempty"`
+	Skipped  string      `json:"-"`
+	Payload  []byte      `json:"payload"`
+	Children []*testNode `json:"children"`
+	testEmbedded
+}
+
+type testEmbedded struct {
+	Flag bool `json:"flag"`
+}
+
+func TestConvertPath(t *testing.T) {
+	t.Parallel()
+
+	path, parameters := convertPath("/v1.0/observer/:shard/raw/*path")
+	require.Equal(t, "/v1.0/observer/{shard}/raw/{path}", path)
+	require.Len(t, parameters, 2)
+	require.Equal(t, "shard", parameters[0].Name)
+	require.Equal(t, "path", parameters[1].Name)
+	require.Equal(t, "path", parameters[1].In)
+	require.True(t, parameters[1].Required)
+
+	path, parameters = convertPath("/v1.0/network/config")
+	require.Equal(t, "/v1.0/network/config", path)
+	require.Empty(t, parameters)
+}
+
+func TestDocumentBuilder_AddOperation(t *testing.T) {
+	t.Parallel()
+
+	builder := NewDocumentBuilder(Info{Title: "title", Version: "v1.0"})
+	builder.AddOperation(OperationArgs{
+		Method: http.MethodGet,
+		Path:   "/v1.0/node/:name",
+		Tag:    "node",
+		ResponseDataType: struct {
+			Node testNode `json:"node"`
+		}{},
+	})
+	builder.AddOperation(OperationArgs{
+		Method:      http.MethodPost,
+		Path:        "/v1.0/node/:name",
+		Tag:         "node",
+		IsSecured:   true,
+		RequestType: []testNode{},
+	})
+	document := builder.Document()
+
+	require.Equal(t, Version, document.OpenAPI)
+	require.Equal(t, "title", document.Info.Title)
+	pathItem := document.Paths["/v1.0/node/{name}"]
+	require.NotNil(t, pathItem)
+	require.Len(t, *pathItem, 2)
+
+	getOperation := (*pathItem)["get"]
+	require.Equal(t, "get_v1_0_node_name", getOperation.OperationID)
+	require.Equal(t, []string{"node"}, getOperation.Tags)
+	require.Nil(t, getOperation.RequestBody)
+	require.Empty(t, getOperation.Security)
+	dataSchema := getOperation.Responses["200"].Content[jsonContentType].Schema.Properties["data"]
+	require.Equal(t, componentsSchemasPrefix+"openapi.testNode", dataSchema.Properties["node"].Ref)
+	require.Equal(t, componentsSchemasPrefix+envelopeSchemaName, getOperation.Responses["default"].Content[jsonContentType].Schema.Ref)
+
+	postOperation := (*pathItem)["post"]
+	requestSchema := postOperation.RequestBody.Content[jsonContentType].Schema
+	require.Equal(t, "array", requestSchema.Type)
+	require.Equal(t, componentsSchemasPrefix+"openapi.testNode", requestSchema.Items.Ref)
+	require.Equal(t, []map[string][]string{{basicAuthSecurityScheme: {}}}, postOperation.Security)
+	require.Equal(t, "basic", document.Components.SecuritySchemes[basicAuthSecurityScheme].Scheme)
+
+	nodeSchema := document.Components.Schemas["openapi.testNode"]
+	require.Len(t, nodeSchema.Properties, 5)
+	require.Equal(t, "string", nodeSchema.Properties["name"].Type)
+	require.Equal(t, "int64", nodeSchema.Properties["value"].Format)
+	require.Equal(t, "byte", nodeSchema.Properties["payload"].Format)
+	require.Equal(t, componentsSchemasPrefix+"openapi.testNode", nodeSchema.Properties["children"].Items.Ref)
+	require.Equal(t, "boolean", nodeSchema.Properties["flag"].Type)
+
+	_, err := json.Marshal(document)
+	require.Nil(t, err)
+}
@@ -0,0 +1,132 @@
+package openapi
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	bigIntType     = reflect.TypeOf(big.Int{})
+)
+
+// schemaFromType returns the schema of the provided type. The named structs are added to the components of the
+// document and referenced, so the recursive types are supported
+func (builder *documentBuilder) schemaFromType(typ reflect.Type) *Schema {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case typ == rawMessageType:
+		return &Schema{}
+	case typ == bigIntType:
+		return &Schema{Type: "integer"}
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: builder.schemaFromType(typ.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: builder.schemaFromType(typ.Elem())}
+	case reflect.Struct:
+		return builder.schemaFromStruct(typ)
+	default:
+		return &Schema{}
+	}
+}
+
+func (builder *documentBuilder) schemaFromStruct(typ reflect.Type) *Schema {
+	name := typ.Name()
+	if len(name) == 0 {
+		return builder.buildStructSchema(typ)
+	}
+
+	name = componentName(typ)
+	_, exists := builder.document.Components.Schemas[name]
+	if !exists {
+		// reserve the name before walking the fields, in order to stop on recursive types
+		builder.document.Components.Schemas[name] = &Schema{Type: "object"}
+		builder.document.Components.Schemas[name] = builder.buildStructSchema(typ)
+	}
+
+	return &Schema{Ref: componentsSchemasPrefix + name}
+}
+
+func (builder *documentBuilder) buildStructSchema(typ reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	builder.addStructFields(schema, typ)
+
+	return schema
+}
+
+func (builder *documentBuilder) addStructFields(schema *Schema, typ reflect.Type) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, isSkipped := jsonFieldName(field)
+		if isSkipped {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		// the fields of the embedded structs are promoted, even if the embedded struct itself is not exported
+		isEmbeddedStruct := field.Anonymous && fieldType.Kind() == reflect.Struct && len(name) == 0
+		if isEmbeddedStruct {
+			builder.addStructFields(schema, fieldType)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		schema.Properties[name] = builder.schemaFromType(field.Type)
+	}
+}
+
+// jsonFieldName returns the name set in the json tag of the field, if any, and whether the field is skipped
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+
+	return strings.Split(tag, ",")[0], false
+}
+
+// componentName returns the name of the schema component of a named type, prefixed by its package name so the
+// types with the same name from different packages do not collide
+func componentName(typ reflect.Type) string {
+	pkgPath := typ.PkgPath()
+	pkgName := pkgPath[strings.LastIndex(pkgPath, "/")+1:]
+	if len(pkgName) == 0 || pkgName == "data" {
+		return typ.Name()
+	}
+
+	return pkgName + "." + typ.Name()
+}
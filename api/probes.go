@@ -0,0 +1,44 @@
+package api
+
+import (
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// ReadinessPath is the route reporting whether the proxy is ready to serve requests. It responds with 503 Service
+	// Unavailable while the proxy is warming up its caches or while a shard has no healthy observer, so the load
+	// balancers do not route traffic towards a half-connected proxy
+	ReadinessPath = "/ready"
+
+	// LivenessPath is the route reporting whether the proxy process is alive
+	LivenessPath = "/live"
+)
+
+func registerProbeRoutes(ws *gin.Engine, readinessHandler ReadinessHandler) {
+	startTime := time.Now()
+
+	ws.GET(ReadinessPath, func(c *gin.Context) {
+		status := readinessHandler.GetReadinessStatus()
+		if !status.IsReady {
+			shared.RespondWith(c, http.StatusServiceUnavailable, status, ErrProxyNotReady.Error(), data.ReturnCodeInternalError)
+			return
+		}
+
+		shared.RespondWith(c, http.StatusOK, status, "", data.ReturnCodeSuccess)
+	})
+
+	ws.GET(LivenessPath, func(c *gin.Context) {
+		status := &data.LivenessStatus{
+			IsAlive:       true,
+			UptimeInSec:   int64(time.Since(startTime).Seconds()),
+			NumGoroutines: runtime.NumGoroutine(),
+		}
+		shared.RespondWith(c, http.StatusOK, status, "", data.ReturnCodeSuccess)
+	})
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// RouteDefinition describes the contract of a route: the data types exchanged on it. The definitions are used to
+// describe the routes in the generated OpenAPI document and to generate the typed Go client of the client package
+type RouteDefinition struct {
+	// Name is the name of the client method calling the route
+	Name   string
+	Method string
+	// Path is the unversioned gin path of the route, such as /address/:address
+	Path string
+	// RequestType is an instance of the type expected in the request body, if any
+	RequestType interface{}
+	// ResponseDataType is an instance of the type returned under the data field of the response, if known
+	ResponseDataType interface{}
+}
+
+// RouteDefinitions holds the routes with a known contract. The other routes are described with the generic response
+// envelope. The client package has to be generated again, with go generate, after changing them
+var RouteDefinitions = []RouteDefinition{
+	{
+		Name:   "GetAccount",
+		Method: http.MethodGet,
+		Path:   "/address/:address",
+		ResponseDataType: struct {
+			Account   data.Account   `json:"account"`
+			BlockInfo data.BlockInfo `json:"blockInfo"`
+		}{},
+	},
+	{
+		Name:   "GetBalance",
+		Method: http.MethodGet,
+		Path:   "/address/:address/balance",
+		ResponseDataType: struct {
+			Balance   string         `json:"balance"`
+			BlockInfo data.BlockInfo `json:"blockInfo"`
+		}{},
+	},
+	{
+		Name:   "GetNonce",
+		Method: http.MethodGet,
+		Path:   "/address/:address/nonce",
+		ResponseDataType: struct {
+			Nonce     uint64         `json:"nonce"`
+			BlockInfo data.BlockInfo `json:"blockInfo"`
+		}{},
+	},
+	{Name: "GetGuardianData", Method: http.MethodGet, Path: "/address/:address/guardian-data", ResponseDataType: data.GuardianDataModel{}},
+	{Name: "ExportAccountData", Method: http.MethodGet, Path: "/address/:address/export", ResponseDataType: data.AccountDataExport{}},
+	{Name: "GetAccounts", Method: http.MethodPost, Path: "/address/bulk", RequestType: []string{}, ResponseDataType: data.AccountsModel{}},
+	{Name: "IterateKeys", Method: http.MethodPost, Path: "/address/iterate-keys", RequestType: data.IterateKeysRequest{}},
+	{
+		Name:        "SendTransaction",
+		Method:      http.MethodPost,
+		Path:        "/transaction/send",
+		RequestType: data.Transaction{},
+		ResponseDataType: struct {
+			TxHash string `json:"txHash"`
+		}{},
+	},
+	{
+		Name:        "SendMultipleTransactions",
+		Method:      http.MethodPost,
+		Path:        "/transaction/send-multiple",
+		RequestType: []data.Transaction{},
+		ResponseDataType: struct {
+			NumOfSentTxs uint64         `json:"numOfSentTxs"`
+			TxsHashes    map[int]string `json:"txsHashes"`
+		}{},
+	},
+	{Name: "SimulateTransaction", Method: http.MethodPost, Path: "/transaction/simulate", RequestType: data.Transaction{}},
+	{Name: "ComputeTransactionCost", Method: http.MethodPost, Path: "/transaction/cost", RequestType: data.Transaction{}, ResponseDataType: data.TxCostResponseData{}},
+	{Name: "SendUserFunds", Method: http.MethodPost, Path: "/transaction/send-user-funds", RequestType: data.FundsRequest{}},
+	{Name: "WatchTransaction", Method: http.MethodPost, Path: "/transaction/webhooks/watch", RequestType: data.WebhookWatchRequest{}},
+	{
+		Name:   "GetTransaction",
+		Method: http.MethodGet,
+		Path:   "/transaction/:txhash",
+		ResponseDataType: struct {
+			Transaction transaction.ApiTransactionResult `json:"transaction"`
+		}{},
+	},
+	{Name: "QueryVmValueHex", Method: http.MethodPost, Path: "/vm-values/hex", RequestType: groups.VMValueRequest{}},
+	{Name: "QueryVmValueString", Method: http.MethodPost, Path: "/vm-values/string", RequestType: groups.VMValueRequest{}},
+	{Name: "QueryVmValueInt", Method: http.MethodPost, Path: "/vm-values/int", RequestType: groups.VMValueRequest{}},
+	{Name: "QueryVmValue", Method: http.MethodPost, Path: "/vm-values/query", RequestType: groups.VMValueRequest{}},
+	{Name: "VerifyProof", Method: http.MethodPost, Path: "/proof/verify", RequestType: data.VerifyProofRequest{}},
+	{Name: "InjectFault", Method: http.MethodPost, Path: "/actions/fault-injection", RequestType: data.FaultInjectionScenario{}},
+	{Name: "GetBlockByNonce", Method: http.MethodGet, Path: "/block/:shard/by-nonce/:nonce", ResponseDataType: data.BlockApiResponsePayload{}},
+	{Name: "GetBlockByHash", Method: http.MethodGet, Path: "/block/:shard/by-hash/:hash", ResponseDataType: data.BlockApiResponsePayload{}},
+	{Name: "GetHyperblockByNonce", Method: http.MethodGet, Path: "/hyperblock/by-nonce/:nonce", ResponseDataType: data.HyperblockApiResponsePayload{}},
+	{Name: "GetHyperblockByHash", Method: http.MethodGet, Path: "/hyperblock/by-hash/:hash", ResponseDataType: data.HyperblockApiResponsePayload{}},
+	{Name: "GetESDTSupplies", Method: http.MethodPost, Path: "/network/esdt/supplies", RequestType: data.ESDTSuppliesRequest{}, ResponseDataType: data.ESDTSupplies{}},
+	{Name: "GetShardsOfAddresses", Method: http.MethodGet, Path: "/network/shard-of", ResponseDataType: data.AddressesShards{}},
+	{Name: "GetLatestBlocks", Method: http.MethodGet, Path: "/network/latest-blocks", ResponseDataType: data.LatestBlocksResponseData{}},
+	{Name: "GetSyncProgress", Method: http.MethodGet, Path: "/network/sync-progress", ResponseDataType: data.SyncProgress{}},
+	{Name: "GetBlockFeesByNonce", Method: http.MethodGet, Path: "/network/fees/:shard/by-nonce/:nonce", ResponseDataType: data.BlockFeesResponseData{}},
+}
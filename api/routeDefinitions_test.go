@@ -0,0 +1,70 @@
+package api_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api"
+	apiMock "github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/versions/factory"
+	"github.com/stretchr/testify/require"
+)
+
+func isRouteListed(apiConfig *data.ApiRoutesConfig, path string) bool {
+	trimmedPath := strings.TrimPrefix(path, "/")
+	separatorIndex := strings.Index(trimmedPath, "/")
+	if separatorIndex < 0 {
+		return false
+	}
+
+	packageConfig, found := apiConfig.APIPackages[trimmedPath[:separatorIndex]]
+	if !found {
+		return false
+	}
+	for _, route := range packageConfig.Routes {
+		if route.Name == trimmedPath[separatorIndex:] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestRouteDefinitions_ShouldMatchRegisteredAndConfiguredRoutes(t *testing.T) {
+	t.Parallel()
+
+	apiConfigParser, err := factory.NewApiConfigParser("../cmd/proxy/config/apiConfig")
+	require.Nil(t, err)
+	apiConfig, err := apiConfigParser.GetConfigForVersion("v1_0")
+	require.Nil(t, err)
+
+	// some of the listed routes, such as the proof ones, are closed by default, so all of them are opened for the check
+	for packageName, packageConfig := range apiConfig.APIPackages {
+		for idx := range packageConfig.Routes {
+			packageConfig.Routes[idx].Open = true
+		}
+		apiConfig.APIPackages[packageName] = packageConfig
+	}
+
+	apiHandler, err := api.NewApiHandler(&apiMock.FacadeStub{})
+	require.Nil(t, err)
+
+	ws := gin.New()
+	noOpHandler := func(c *gin.Context) {}
+	for path, group := range apiHandler.GetAllGroups() {
+		group.RegisterRoutes(ws.Group(path), *apiConfig, noOpHandler, noOpHandler, noOpHandler)
+	}
+
+	registeredRoutes := make(map[string]struct{})
+	for _, route := range ws.Routes() {
+		registeredRoutes[route.Method+" "+route.Path] = struct{}{}
+	}
+
+	for _, definition := range api.RouteDefinitions {
+		_, isRegistered := registeredRoutes[definition.Method+" "+definition.Path]
+		require.True(t, isRegistered, "%s %s of %s is not registered", definition.Method, definition.Path, definition.Name)
+		require.True(t, isRouteListed(apiConfig, definition.Path), "%s of %s is not listed in v1_0.toml", definition.Path, definition.Name)
+	}
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+// runtimeConfigHandler applies the reloaded rate limits, cache max ages and route toggles on the middlewares of the
+// running web server. The middlewares disabled at startup cannot be enabled at runtime, as they are not part of the
+// requests chain, so the Enabled flags require a restart
+type runtimeConfigHandler struct {
+	versions               []string
+	tokenBucketRateLimiter middleware.TokenBucketRateLimiterHandler
+	cacheControl           middleware.CacheControlHandler
+	disabledRoutes         DisabledRoutesHandler
+}
+
+// CheckConfig returns an error if the reloadable settings of the provided config are not valid
+func (rch *runtimeConfigHandler) CheckConfig(cfg *config.Config) error {
+	if !check.IfNil(rch.tokenBucketRateLimiter) {
+		_, err := middleware.NewTokenBucketRateLimiter(createTokenBucketRateLimiterArgs(cfg.RateLimiter))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !check.IfNil(rch.cacheControl) {
+		_, err := middleware.NewCacheControl(createCacheControlArgs(cfg.CacheControl))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := middleware.NewDisabledRoutes(createDisabledRoutesArgs(cfg.Routes, rch.versions))
+
+	return err
+}
+
+// ApplyConfig updates the middlewares with the reloadable settings of the provided config
+func (rch *runtimeConfigHandler) ApplyConfig(cfg *config.Config) error {
+	if !check.IfNil(rch.tokenBucketRateLimiter) {
+		err := rch.tokenBucketRateLimiter.UpdateLimits(createTokenBucketRateLimiterArgs(cfg.RateLimiter))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !check.IfNil(rch.cacheControl) {
+		err := rch.cacheControl.UpdateMaxAges(createCacheControlArgs(cfg.CacheControl))
+		if err != nil {
+			return err
+		}
+	}
+
+	return rch.disabledRoutes.UpdateRoutes(createDisabledRoutesArgs(cfg.Routes, rch.versions))
+}
+
+func createTokenBucketRateLimiterArgs(rateLimiterConfig config.RateLimiterConfig) middleware.ArgsTokenBucketRateLimiter {
+	return middleware.ArgsTokenBucketRateLimiter{
+		RequestsPerSecond:      rateLimiterConfig.RequestsPerSecond,
+		Burst:                  rateLimiterConfig.Burst,
+		HeavyRequestsPerSecond: rateLimiterConfig.HeavyRequestsPerSecond,
+		HeavyBurst:             rateLimiterConfig.HeavyBurst,
+		HeavyRoutes:            rateLimiterConfig.HeavyRoutes,
+	}
+}
+
+func createCacheControlArgs(cacheControlConfig config.CacheControlConfig) middleware.ArgsCacheControl {
+	return middleware.ArgsCacheControl{
+		ShortLivedMaxAge: time.Duration(cacheControlConfig.ShortLivedMaxAgeInSec) * time.Second,
+		LongLivedMaxAge:  time.Duration(cacheControlConfig.LongLivedMaxAgeInSec) * time.Second,
+	}
+}
+
+func createDisabledRoutesArgs(routesConfig config.RoutesConfig, versions []string) middleware.ArgsDisabledRoutes {
+	return middleware.ArgsDisabledRoutes{
+		DisabledRoutes: routesConfig.DisabledRoutes,
+		ExposedRoutes:  routesConfig.ExposedRoutes,
+		Versions:       versions,
+		StatusCode:     routesConfig.DisabledStatusCode,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rch *runtimeConfigHandler) IsInterfaceNil() bool {
+	return rch == nil
+}
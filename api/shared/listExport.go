@@ -0,0 +1,184 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// MIMECSV is the content type of the CSV export format
+	MIMECSV = "text/csv"
+	// MIMENDJSON is the content type of the newline delimited JSON export format
+	MIMENDJSON = "application/x-ndjson"
+
+	numRowsBetweenFlushes = 100
+)
+
+var log = logger.GetOrCreate("api/shared")
+
+var errNotAList = errors.New("the provided value is not a list")
+
+// NegotiateListFormat returns the export format requested through the Accept header of a list endpoint. The returned
+// value is MIMECSV, MIMENDJSON or gin.MIMEJSON, the latter being used when no export format is explicitly requested
+func NegotiateListFormat(c *gin.Context) string {
+	format := c.NegotiateFormat(gin.MIMEJSON, MIMECSV, MIMENDJSON)
+	if format != MIMECSV && format != MIMENDJSON {
+		return gin.MIMEJSON
+	}
+
+	return format
+}
+
+// IsListExportFormat returns true if the provided format is one of the list export formats
+func IsListExportFormat(format string) bool {
+	return format == MIMECSV || format == MIMENDJSON
+}
+
+// RespondWithList streams the items of the provided slice in the given export format: NDJSON writes each item on its
+// own line, while CSV flattens each item to its top level fields, writing the nested objects and arrays as JSON strings.
+// The CSV columns are the sorted union of the fields of all the items
+func RespondWithList(c *gin.Context, format string, items interface{}) {
+	values := reflect.ValueOf(items)
+	if values.Kind() != reflect.Slice {
+		RespondWith(c, http.StatusInternalServerError, nil, errNotAList.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	c.Header("Content-Type", format)
+	c.Status(http.StatusOK)
+
+	var err error
+	switch format {
+	case MIMECSV:
+		err = writeCSV(c, values)
+	default:
+		err = writeNDJSON(c, values)
+	}
+	if err != nil {
+		log.Debug("cannot write list export", "format", format, "path", c.FullPath(), "error", err.Error())
+	}
+}
+
+func writeNDJSON(c *gin.Context, values reflect.Value) error {
+	encoder := json.NewEncoder(c.Writer)
+	for i := 0; i < values.Len(); i++ {
+		err := encoder.Encode(values.Index(i).Interface())
+		if err != nil {
+			return err
+		}
+
+		flushIfNeeded(c, i)
+	}
+
+	return nil
+}
+
+func writeCSV(c *gin.Context, values reflect.Value) error {
+	rows := make([]map[string]interface{}, 0, values.Len())
+	columnsMap := make(map[string]struct{})
+	for i := 0; i < values.Len(); i++ {
+		row, err := flattenItem(values.Index(i).Interface())
+		if err != nil {
+			return err
+		}
+
+		for column := range row {
+			columnsMap[column] = struct{}{}
+		}
+		rows = append(rows, row)
+	}
+
+	columns := make([]string, 0, len(columnsMap))
+	for column := range columnsMap {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	writer := csv.NewWriter(c.Writer)
+	err := writer.Write(columns)
+	if err != nil {
+		return err
+	}
+
+	record := make([]string, len(columns))
+	for i, row := range rows {
+		for j, column := range columns {
+			record[j], err = csvCellValue(row[column])
+			if err != nil {
+				return err
+			}
+		}
+
+		err = writer.Write(record)
+		if err != nil {
+			return err
+		}
+
+		if (i+1)%numRowsBetweenFlushes == 0 {
+			writer.Flush()
+			c.Writer.Flush()
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// flattenItem returns the top level fields of the provided item. An item that is not a JSON object results in a single
+// "value" field
+func flattenItem(item interface{}) (map[string]interface{}, error) {
+	itemBytes, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(itemBytes))
+	decoder.UseNumber()
+
+	var decodedItem interface{}
+	err = decoder.Decode(&decodedItem)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, isObject := decodedItem.(map[string]interface{})
+	if !isObject {
+		return map[string]interface{}{"value": decodedItem}, nil
+	}
+
+	return fields, nil
+}
+
+func csvCellValue(value interface{}) (string, error) {
+	switch castedValue := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return castedValue, nil
+	case json.Number:
+		return castedValue.String(), nil
+	case bool:
+		if castedValue {
+			return "true", nil
+		}
+		return "false", nil
+	default:
+		valueBytes, err := json.Marshal(castedValue)
+		return string(valueBytes), err
+	}
+}
+
+func flushIfNeeded(c *gin.Context, rowIndex int) {
+	if (rowIndex+1)%numRowsBetweenFlushes == 0 {
+		c.Writer.Flush()
+	}
+}
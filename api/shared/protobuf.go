@@ -0,0 +1,20 @@
+package shared
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MIMEProtobuf is the content type of the protobuf responses
+const MIMEProtobuf = "application/protobuf"
+
+// IsProtobufRequested returns true if the Accept header of the request prefers the protobuf format over JSON
+func IsProtobufRequested(c *gin.Context) bool {
+	return c.NegotiateFormat(gin.MIMEJSON, MIMEProtobuf) == MIMEProtobuf
+}
+
+// RespondWithProtobuf writes the provided protobuf marshaled bytes as the response body
+func RespondWithProtobuf(c *gin.Context, payload []byte) {
+	c.Data(http.StatusOK, MIMEProtobuf, payload)
+}
@@ -43,6 +43,27 @@ func FetchRoundFromRequest(c *gin.Context) (uint64, error) {
 	return strconv.ParseUint(roundStr, 10, 64)
 }
 
+// FetchRangeFromRequest will try to fetch the start and the end of a range from the request
+func FetchRangeFromRequest(c *gin.Context) (uint64, uint64, error) {
+	startStr := c.Param("start")
+	endStr := c.Param("end")
+	if startStr == "" || endStr == "" {
+		return 0, 0, errors.ErrInvalidRangeParams
+	}
+
+	start, err := strconv.ParseUint(startStr, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end, err := strconv.ParseUint(endStr, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return start, end, nil
+}
+
 // FetchEpochFromRequest will try to fetch the epoch from the request
 func FetchEpochFromRequest(c *gin.Context) (uint32, error) {
 	epochStr := c.Param("epoch")
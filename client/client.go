@@ -0,0 +1,140 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+//go:generate go run ../cmd/clientgen -output routes_generated.go
+
+const successfulReturnCode = "successful"
+
+// RequestOption customizes a request sent by the client
+type RequestOption func(req *http.Request)
+
+// WithQueryParam sets an URL parameter of the request, such as withTxs=true on the hyperblock requests
+func WithQueryParam(name string, value string) RequestOption {
+	return func(req *http.Request) {
+		query := req.URL.Query()
+		query.Set(name, value)
+		req.URL.RawQuery = query.Encode()
+	}
+}
+
+// WithHeader sets a header of the request, such as the client key or the Basic Authentication of the secured routes
+func WithHeader(name string, value string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set(name, value)
+	}
+}
+
+// APIError is returned when the proxy does not respond with the successful return code
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+// Error returns the string representation of the error
+func (err *APIError) Error() string {
+	return fmt.Sprintf("proxy responded with http code %d, return code %s: %s", err.StatusCode, err.Code, err.Message)
+}
+
+type responseEnvelope struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+// Client is a typed client of the proxy REST API. Its route methods are generated out of the route definitions the
+// proxy serves (see api.RouteDefinitions), so the request and response types are the ones used by the proxy itself
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a client of the proxy served on the provided base URL, which can hold a version prefix, such as
+// http://127.0.0.1:8079/v1.0. A nil http client is replaced by the default one
+func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidBaseURL, err.Error())
+	}
+	if len(parsedURL.Scheme) == 0 || len(parsedURL.Host) == 0 {
+		return nil, fmt.Errorf("%w, %s should be an absolute URL", ErrInvalidBaseURL, baseURL)
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+	}, nil
+}
+
+// call sends the request to the provided path and decodes the data field of the response into responseData
+func (c *Client) call(
+	ctx context.Context,
+	method string,
+	path string,
+	request interface{},
+	responseData interface{},
+	options []RequestOption,
+) error {
+	var body io.Reader
+	if request != nil {
+		requestBytes, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(requestBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	for _, option := range options {
+		option(req)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	envelope := &responseEnvelope{}
+	err = json.NewDecoder(resp.Body).Decode(envelope)
+	if err != nil {
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("cannot decode the response: %s", err.Error()),
+		}
+	}
+	if resp.StatusCode != http.StatusOK || envelope.Code != successfulReturnCode {
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Code:       envelope.Code,
+			Message:    envelope.Error,
+		}
+	}
+	if len(envelope.Data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(envelope.Data, responseData)
+}
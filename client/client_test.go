@@ -0,0 +1,119 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/client"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func startTestServer(t *testing.T, handler http.HandlerFunc) *client.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	proxyClient, err := client.NewClient(server.URL+"/v1.0/", nil)
+	require.NoError(t, err)
+
+	return proxyClient
+}
+
+func respond(w http.ResponseWriter, statusCode int, responseData interface{}, errMessage string, code data.ReturnCode) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(data.GenericAPIResponse{Data: responseData, Error: errMessage, Code: code})
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	proxyClient, err := client.NewClient("not an url", nil)
+	require.Nil(t, proxyClient)
+	require.True(t, errors.Is(err, client.ErrInvalidBaseURL))
+
+	proxyClient, err = client.NewClient("http://127.0.0.1:8079", nil)
+	require.NoError(t, err)
+	require.NotNil(t, proxyClient)
+}
+
+func TestClient_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should decode the account", func(t *testing.T) {
+		t.Parallel()
+
+		proxyClient := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodGet, r.Method)
+			require.Equal(t, "/v1.0/address/erd1%2Ftest", r.URL.EscapedPath())
+			require.Equal(t, "true", r.URL.Query().Get("onFinalBlock"))
+
+			respond(w, http.StatusOK, map[string]interface{}{
+				"account":   data.Account{Address: "erd1/test", Nonce: 37, Balance: "1000"},
+				"blockInfo": data.BlockInfo{Nonce: 10, Hash: "hash"},
+			}, "", data.ReturnCodeSuccess)
+		})
+
+		response, err := proxyClient.GetAccount(context.Background(), "erd1/test", client.WithQueryParam("onFinalBlock", "true"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(37), response.Account.Nonce)
+		require.Equal(t, "1000", response.Account.Balance)
+		require.Equal(t, data.BlockInfo{Nonce: 10, Hash: "hash"}, response.BlockInfo)
+	})
+	t.Run("error response should return an APIError", func(t *testing.T) {
+		t.Parallel()
+
+		proxyClient := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			respond(w, http.StatusBadRequest, nil, "invalid address", data.ReturnCodeRequestError)
+		})
+
+		response, err := proxyClient.GetAccount(context.Background(), "invalid")
+		require.Nil(t, response)
+
+		apiErr := &client.APIError{}
+		require.True(t, errors.As(err, &apiErr))
+		require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		require.Equal(t, string(data.ReturnCodeRequestError), apiErr.Code)
+		require.Equal(t, "invalid address", apiErr.Message)
+	})
+}
+
+func TestClient_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	proxyClient := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/v1.0/transaction/send", r.URL.Path)
+		require.Equal(t, "client-key", r.Header.Get("X-Client-Key"))
+
+		tx := &data.Transaction{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(tx))
+		require.Equal(t, uint64(5), tx.Nonce)
+
+		respond(w, http.StatusOK, map[string]interface{}{"txHash": "hash"}, "", data.ReturnCodeSuccess)
+	})
+
+	response, err := proxyClient.SendTransaction(context.Background(), data.Transaction{Nonce: 5}, client.WithHeader("X-Client-Key", "client-key"))
+	require.NoError(t, err)
+	require.Equal(t, "hash", response.TxHash)
+}
+
+func TestClient_QueryVmValue(t *testing.T) {
+	t.Parallel()
+
+	proxyClient := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		request := &client.VMValueRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		require.Equal(t, "getSum", request.FuncName)
+
+		respond(w, http.StatusOK, map[string]interface{}{"data": "result"}, "", data.ReturnCodeSuccess)
+	})
+
+	response, err := proxyClient.QueryVmValue(context.Background(), client.VMValueRequest{ScAddress: "erd1", FuncName: "getSum"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":"result"}`, string(response))
+}
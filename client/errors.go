@@ -0,0 +1,6 @@
+package client
+
+import "errors"
+
+// ErrInvalidBaseURL signals that an invalid base URL of the proxy has been provided
+var ErrInvalidBaseURL = errors.New("invalid base URL")
@@ -0,0 +1,9 @@
+package generator
+
+import "errors"
+
+// ErrEmptyRouteName signals that a route definition without name has been provided
+var ErrEmptyRouteName = errors.New("empty route name")
+
+// ErrDuplicatedRouteName signals that more route definitions share the same name
+var ErrDuplicatedRouteName = errors.New("duplicated route name")
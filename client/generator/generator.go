@@ -0,0 +1,315 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/api"
+)
+
+// serverPackagesPrefix is the import path prefix of the proxy server packages. The client does not depend on them, so
+// the types they define are mirrored by types declared in the client package
+const serverPackagesPrefix = "github.com/multiversx/mx-chain-proxy-go/api"
+
+const generatedFileHeader = "// Code generated by clientgen out of api.RouteDefinitions. DO NOT EDIT.\n\n"
+
+type routeMethod struct {
+	definition   api.RouteDefinition
+	pathParams   []string
+	requestType  string
+	responseType string
+}
+
+type generator struct {
+	imports       map[string]string
+	importNames   map[string]string
+	declaredTypes map[reflect.Type]string
+	declarations  bytes.Buffer
+}
+
+// Generate returns the source code of the typed client methods calling the provided routes
+func Generate(definitions []api.RouteDefinition) ([]byte, error) {
+	g := &generator{
+		imports:       map[string]string{"context": "context", "net/http": "http"},
+		importNames:   map[string]string{"context": "context", "http": "net/http"},
+		declaredTypes: make(map[reflect.Type]string),
+	}
+
+	methods := make([]*routeMethod, 0, len(definitions))
+	names := make(map[string]struct{}, len(definitions))
+	for _, definition := range definitions {
+		if len(definition.Name) == 0 {
+			return nil, fmt.Errorf("%w for %s %s", ErrEmptyRouteName, definition.Method, definition.Path)
+		}
+		_, found := names[definition.Name]
+		if found {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedRouteName, definition.Name)
+		}
+		names[definition.Name] = struct{}{}
+
+		methods = append(methods, g.createRouteMethod(definition))
+	}
+
+	var methodsSource bytes.Buffer
+	for _, method := range methods {
+		g.writeMethod(&methodsSource, method)
+	}
+
+	var source bytes.Buffer
+	source.WriteString(generatedFileHeader)
+	source.WriteString("package client\n\n")
+	g.writeImports(&source)
+	source.Write(g.declarations.Bytes())
+	source.Write(methodsSource.Bytes())
+
+	return format.Source(source.Bytes())
+}
+
+func (g *generator) createRouteMethod(definition api.RouteDefinition) *routeMethod {
+	method := &routeMethod{
+		definition: definition,
+	}
+	for _, segment := range strings.Split(definition.Path, "/") {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			method.pathParams = append(method.pathParams, segment[1:])
+		}
+	}
+	if len(method.pathParams) > 0 {
+		g.addImport("net/url")
+	}
+
+	if definition.RequestType != nil {
+		method.requestType = g.declaredTypeExpression(definition.Name+"Request", reflect.TypeOf(definition.RequestType))
+	}
+	if definition.ResponseDataType != nil {
+		method.responseType = g.declaredTypeExpression(definition.Name+"Response", reflect.TypeOf(definition.ResponseDataType))
+	} else {
+		g.addImport("encoding/json")
+	}
+
+	return method
+}
+
+// declaredTypeExpression returns the expression of the provided type, declaring the anonymous structs under the
+// provided name
+func (g *generator) declaredTypeExpression(name string, t reflect.Type) string {
+	if t.Kind() != reflect.Struct || len(t.Name()) > 0 {
+		return g.typeExpression(t)
+	}
+
+	comment := fmt.Sprintf("holds the data exchanged by the %s client method", strings.TrimSuffix(strings.TrimSuffix(name, "Request"), "Response"))
+	g.declareStruct(name, comment, t)
+
+	return name
+}
+
+// declareStruct declares the provided struct under the provided name
+func (g *generator) declareStruct(name string, comment string, t reflect.Type) {
+	g.declaredTypes[t] = name
+	body := g.structExpression(t)
+
+	g.declarations.WriteString(fmt.Sprintf("// %s %s\n", name, comment))
+	g.declarations.WriteString(fmt.Sprintf("type %s %s\n\n", name, body))
+}
+
+func (g *generator) typeExpression(t reflect.Type) string {
+	name, found := g.declaredTypes[t]
+	if found {
+		return name
+	}
+	if len(t.Name()) > 0 && isServerType(t) && t.Kind() == reflect.Struct {
+		g.declareStruct(t.Name(), "mirrors the "+path.Base(t.PkgPath())+"."+t.Name()+" type of the proxy", t)
+		return t.Name()
+	}
+	if len(t.Name()) > 0 && !isServerType(t) {
+		if len(t.PkgPath()) == 0 {
+			return t.Name()
+		}
+
+		return g.addImport(t.PkgPath()) + "." + t.Name()
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + g.typeExpression(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeExpression(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), g.typeExpression(t.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", g.typeExpression(t.Key()), g.typeExpression(t.Elem()))
+	case reflect.Struct:
+		return g.structExpression(t)
+	case reflect.Interface:
+		return "interface{}"
+	default:
+		return t.Kind().String()
+	}
+}
+
+func (g *generator) structExpression(t reflect.Type) string {
+	var expression strings.Builder
+	expression.WriteString("struct {\n")
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if !field.Anonymous {
+			expression.WriteString(field.Name + " ")
+		}
+		expression.WriteString(g.typeExpression(field.Type))
+		if len(field.Tag) > 0 {
+			expression.WriteString(" " + quoteTag(string(field.Tag)))
+		}
+		expression.WriteString("\n")
+	}
+	expression.WriteString("}")
+
+	return expression.String()
+}
+
+// addImport records the provided import path and returns the name under which its package is referred
+func (g *generator) addImport(importPath string) string {
+	name, found := g.imports[importPath]
+	if found {
+		return name
+	}
+
+	baseName := path.Base(importPath)
+	name = baseName
+	for idx := 1; ; idx++ {
+		_, taken := g.importNames[name]
+		if !taken {
+			break
+		}
+		name = fmt.Sprintf("%s%d", baseName, idx)
+	}
+
+	g.imports[importPath] = name
+	g.importNames[name] = importPath
+
+	return name
+}
+
+func (g *generator) writeImports(source *bytes.Buffer) {
+	importPaths := make([]string, 0, len(g.imports))
+	for importPath := range g.imports {
+		importPaths = append(importPaths, importPath)
+	}
+	source.WriteString("import (\n")
+	for idx, importPath := range sortImports(importPaths) {
+		if idx > 0 && isStandardImport(importPaths[idx-1]) != isStandardImport(importPath) {
+			source.WriteString("\n")
+		}
+		name := g.imports[importPath]
+		if name == path.Base(importPath) {
+			source.WriteString(strconv.Quote(importPath) + "\n")
+			continue
+		}
+
+		source.WriteString(name + " " + strconv.Quote(importPath) + "\n")
+	}
+	source.WriteString(")\n\n")
+}
+
+func (g *generator) writeMethod(source *bytes.Buffer, method *routeMethod) {
+	definition := method.definition
+	arguments := []string{"ctx context.Context"}
+	for _, param := range method.pathParams {
+		arguments = append(arguments, param+" string")
+	}
+	requestArgument := "nil"
+	if len(method.requestType) > 0 {
+		arguments = append(arguments, "request "+method.requestType)
+		requestArgument = "request"
+	}
+	arguments = append(arguments, "options ...RequestOption")
+
+	source.WriteString(fmt.Sprintf("// %s calls the %s %s route\n", definition.Name, definition.Method, definition.Path))
+	if len(method.responseType) == 0 {
+		source.WriteString(fmt.Sprintf("func (c *Client) %s(%s) (json.RawMessage, error) {\n", definition.Name, strings.Join(arguments, ", ")))
+		source.WriteString("var response json.RawMessage\n")
+		source.WriteString(fmt.Sprintf("err := c.call(ctx, %s, %s, %s, &response, options)\n", methodExpression(definition.Method), pathExpression(definition.Path), requestArgument))
+		source.WriteString("if err != nil {\nreturn nil, err\n}\n\nreturn response, nil\n}\n\n")
+		return
+	}
+
+	source.WriteString(fmt.Sprintf("func (c *Client) %s(%s) (*%s, error) {\n", definition.Name, strings.Join(arguments, ", "), method.responseType))
+	source.WriteString(fmt.Sprintf("response := &%s{}\n", method.responseType))
+	source.WriteString(fmt.Sprintf("err := c.call(ctx, %s, %s, %s, response, options)\n", methodExpression(definition.Method), pathExpression(definition.Path), requestArgument))
+	source.WriteString("if err != nil {\nreturn nil, err\n}\n\nreturn response, nil\n}\n\n")
+}
+
+// pathExpression returns the expression building the provided gin path, with its parameters escaped
+func pathExpression(ginPath string) string {
+	parts := make([]string, 0)
+	literal := ""
+	for _, segment := range strings.Split(strings.TrimPrefix(ginPath, "/"), "/") {
+		literal += "/"
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			parts = append(parts, strconv.Quote(literal), "url.PathEscape("+segment[1:]+")")
+			literal = ""
+			continue
+		}
+
+		literal += segment
+	}
+	if len(literal) > 0 {
+		parts = append(parts, strconv.Quote(literal))
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+// sortImports sorts the provided import paths in place, placing the standard library ones first
+func sortImports(importPaths []string) []string {
+	sort.Slice(importPaths, func(i, j int) bool {
+		isStandardI, isStandardJ := isStandardImport(importPaths[i]), isStandardImport(importPaths[j])
+		if isStandardI != isStandardJ {
+			return isStandardI
+		}
+
+		return importPaths[i] < importPaths[j]
+	})
+
+	return importPaths
+}
+
+func isStandardImport(importPath string) bool {
+	return !strings.Contains(strings.Split(importPath, "/")[0], ".")
+}
+
+func methodExpression(method string) string {
+	switch method {
+	case "GET":
+		return "http.MethodGet"
+	case "POST":
+		return "http.MethodPost"
+	case "PUT":
+		return "http.MethodPut"
+	case "DELETE":
+		return "http.MethodDelete"
+	default:
+		return strconv.Quote(method)
+	}
+}
+
+func quoteTag(tag string) string {
+	if strings.Contains(tag, "`") {
+		return strconv.Quote(tag)
+	}
+
+	return "`" + tag + "`"
+}
+
+func isServerType(t reflect.Type) bool {
+	return strings.HasPrefix(t.PkgPath(), serverPackagesPrefix)
+}
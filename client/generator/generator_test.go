@@ -0,0 +1,66 @@
+package generator_test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/client/generator"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty route name should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := generator.Generate([]api.RouteDefinition{{Method: http.MethodGet, Path: "/node/status"}})
+		require.Nil(t, source)
+		require.True(t, errors.Is(err, generator.ErrEmptyRouteName))
+	})
+	t.Run("duplicated route name should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := generator.Generate([]api.RouteDefinition{
+			{Name: "GetStatus", Method: http.MethodGet, Path: "/node/status"},
+			{Name: "GetStatus", Method: http.MethodGet, Path: "/network/status/:shard"},
+		})
+		require.Nil(t, source)
+		require.True(t, errors.Is(err, generator.ErrDuplicatedRouteName))
+	})
+	t.Run("should generate the route methods", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := generator.Generate([]api.RouteDefinition{
+			{
+				Name:        "SendTransaction",
+				Method:      http.MethodPost,
+				Path:        "/transaction/send",
+				RequestType: data.Transaction{},
+				ResponseDataType: struct {
+					TxHash string `json:"txHash"`
+				}{},
+			},
+			{Name: "GetNodeStatus", Method: http.MethodGet, Path: "/network/status/:shard"},
+		})
+		require.NoError(t, err)
+		require.Contains(t, string(source), "type SendTransactionResponse struct {\n\tTxHash string `json:\"txHash\"`\n}")
+		require.Contains(t, string(source), "func (c *Client) SendTransaction(ctx context.Context, request data.Transaction, options ...RequestOption) (*SendTransactionResponse, error) {")
+		require.Contains(t, string(source), "func (c *Client) GetNodeStatus(ctx context.Context, shard string, options ...RequestOption) (json.RawMessage, error) {")
+		require.Contains(t, string(source), `c.call(ctx, http.MethodGet, "/network/status/"+url.PathEscape(shard), nil, &response, options)`)
+	})
+	t.Run("generated client should be up to date", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := generator.Generate(api.RouteDefinitions)
+		require.NoError(t, err)
+
+		generatedSource, err := os.ReadFile("../routes_generated.go")
+		require.NoError(t, err)
+		require.Equal(t, string(generatedSource), string(source), "the client should be generated again, by running go generate in the client package")
+	})
+}
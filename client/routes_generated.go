@@ -0,0 +1,379 @@
+// Code generated by clientgen out of api.RouteDefinitions. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// GetAccountResponse holds the data exchanged by the GetAccount client method
+type GetAccountResponse struct {
+	Account   data.Account   `json:"account"`
+	BlockInfo data.BlockInfo `json:"blockInfo"`
+}
+
+// GetBalanceResponse holds the data exchanged by the GetBalance client method
+type GetBalanceResponse struct {
+	Balance   string         `json:"balance"`
+	BlockInfo data.BlockInfo `json:"blockInfo"`
+}
+
+// GetNonceResponse holds the data exchanged by the GetNonce client method
+type GetNonceResponse struct {
+	Nonce     uint64         `json:"nonce"`
+	BlockInfo data.BlockInfo `json:"blockInfo"`
+}
+
+// SendTransactionResponse holds the data exchanged by the SendTransaction client method
+type SendTransactionResponse struct {
+	TxHash string `json:"txHash"`
+}
+
+// SendMultipleTransactionsResponse holds the data exchanged by the SendMultipleTransactions client method
+type SendMultipleTransactionsResponse struct {
+	NumOfSentTxs uint64         `json:"numOfSentTxs"`
+	TxsHashes    map[int]string `json:"txsHashes"`
+}
+
+// GetTransactionResponse holds the data exchanged by the GetTransaction client method
+type GetTransactionResponse struct {
+	Transaction transaction.ApiTransactionResult `json:"transaction"`
+}
+
+// VMValueRequest mirrors the groups.VMValueRequest type of the proxy
+type VMValueRequest struct {
+	ScAddress      string   `json:"scAddress"`
+	FuncName       string   `json:"funcName"`
+	CallerAddr     string   `json:"caller"`
+	CallValue      string   `json:"value"`
+	SameScState    bool     `json:"sameScState"`
+	ShouldBeSynced bool     `json:"shouldBeSynced"`
+	Args           []string `json:"args"`
+	BlockNonce     *uint64  `json:"blockNonce,omitempty"`
+	BlockHash      string   `json:"blockHash,omitempty"`
+}
+
+// GetAccount calls the GET /address/:address route
+func (c *Client) GetAccount(ctx context.Context, address string, options ...RequestOption) (*GetAccountResponse, error) {
+	response := &GetAccountResponse{}
+	err := c.call(ctx, http.MethodGet, "/address/"+url.PathEscape(address), nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetBalance calls the GET /address/:address/balance route
+func (c *Client) GetBalance(ctx context.Context, address string, options ...RequestOption) (*GetBalanceResponse, error) {
+	response := &GetBalanceResponse{}
+	err := c.call(ctx, http.MethodGet, "/address/"+url.PathEscape(address)+"/balance", nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetNonce calls the GET /address/:address/nonce route
+func (c *Client) GetNonce(ctx context.Context, address string, options ...RequestOption) (*GetNonceResponse, error) {
+	response := &GetNonceResponse{}
+	err := c.call(ctx, http.MethodGet, "/address/"+url.PathEscape(address)+"/nonce", nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetGuardianData calls the GET /address/:address/guardian-data route
+func (c *Client) GetGuardianData(ctx context.Context, address string, options ...RequestOption) (*data.GuardianDataModel, error) {
+	response := &data.GuardianDataModel{}
+	err := c.call(ctx, http.MethodGet, "/address/"+url.PathEscape(address)+"/guardian-data", nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ExportAccountData calls the GET /address/:address/export route
+func (c *Client) ExportAccountData(ctx context.Context, address string, options ...RequestOption) (*data.AccountDataExport, error) {
+	response := &data.AccountDataExport{}
+	err := c.call(ctx, http.MethodGet, "/address/"+url.PathEscape(address)+"/export", nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetAccounts calls the POST /address/bulk route
+func (c *Client) GetAccounts(ctx context.Context, request []string, options ...RequestOption) (*data.AccountsModel, error) {
+	response := &data.AccountsModel{}
+	err := c.call(ctx, http.MethodPost, "/address/bulk", request, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// IterateKeys calls the POST /address/iterate-keys route
+func (c *Client) IterateKeys(ctx context.Context, request data.IterateKeysRequest, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/address/iterate-keys", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SendTransaction calls the POST /transaction/send route
+func (c *Client) SendTransaction(ctx context.Context, request data.Transaction, options ...RequestOption) (*SendTransactionResponse, error) {
+	response := &SendTransactionResponse{}
+	err := c.call(ctx, http.MethodPost, "/transaction/send", request, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SendMultipleTransactions calls the POST /transaction/send-multiple route
+func (c *Client) SendMultipleTransactions(ctx context.Context, request []data.Transaction, options ...RequestOption) (*SendMultipleTransactionsResponse, error) {
+	response := &SendMultipleTransactionsResponse{}
+	err := c.call(ctx, http.MethodPost, "/transaction/send-multiple", request, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SimulateTransaction calls the POST /transaction/simulate route
+func (c *Client) SimulateTransaction(ctx context.Context, request data.Transaction, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/transaction/simulate", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ComputeTransactionCost calls the POST /transaction/cost route
+func (c *Client) ComputeTransactionCost(ctx context.Context, request data.Transaction, options ...RequestOption) (*data.TxCostResponseData, error) {
+	response := &data.TxCostResponseData{}
+	err := c.call(ctx, http.MethodPost, "/transaction/cost", request, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SendUserFunds calls the POST /transaction/send-user-funds route
+func (c *Client) SendUserFunds(ctx context.Context, request data.FundsRequest, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/transaction/send-user-funds", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// WatchTransaction calls the POST /transaction/webhooks/watch route
+func (c *Client) WatchTransaction(ctx context.Context, request data.WebhookWatchRequest, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/transaction/webhooks/watch", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetTransaction calls the GET /transaction/:txhash route
+func (c *Client) GetTransaction(ctx context.Context, txhash string, options ...RequestOption) (*GetTransactionResponse, error) {
+	response := &GetTransactionResponse{}
+	err := c.call(ctx, http.MethodGet, "/transaction/"+url.PathEscape(txhash), nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// QueryVmValueHex calls the POST /vm-values/hex route
+func (c *Client) QueryVmValueHex(ctx context.Context, request VMValueRequest, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/vm-values/hex", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// QueryVmValueString calls the POST /vm-values/string route
+func (c *Client) QueryVmValueString(ctx context.Context, request VMValueRequest, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/vm-values/string", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// QueryVmValueInt calls the POST /vm-values/int route
+func (c *Client) QueryVmValueInt(ctx context.Context, request VMValueRequest, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/vm-values/int", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// QueryVmValue calls the POST /vm-values/query route
+func (c *Client) QueryVmValue(ctx context.Context, request VMValueRequest, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/vm-values/query", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// VerifyProof calls the POST /proof/verify route
+func (c *Client) VerifyProof(ctx context.Context, request data.VerifyProofRequest, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/proof/verify", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// InjectFault calls the POST /actions/fault-injection route
+func (c *Client) InjectFault(ctx context.Context, request data.FaultInjectionScenario, options ...RequestOption) (json.RawMessage, error) {
+	var response json.RawMessage
+	err := c.call(ctx, http.MethodPost, "/actions/fault-injection", request, &response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetBlockByNonce calls the GET /block/:shard/by-nonce/:nonce route
+func (c *Client) GetBlockByNonce(ctx context.Context, shard string, nonce string, options ...RequestOption) (*data.BlockApiResponsePayload, error) {
+	response := &data.BlockApiResponsePayload{}
+	err := c.call(ctx, http.MethodGet, "/block/"+url.PathEscape(shard)+"/by-nonce/"+url.PathEscape(nonce), nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetBlockByHash calls the GET /block/:shard/by-hash/:hash route
+func (c *Client) GetBlockByHash(ctx context.Context, shard string, hash string, options ...RequestOption) (*data.BlockApiResponsePayload, error) {
+	response := &data.BlockApiResponsePayload{}
+	err := c.call(ctx, http.MethodGet, "/block/"+url.PathEscape(shard)+"/by-hash/"+url.PathEscape(hash), nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetHyperblockByNonce calls the GET /hyperblock/by-nonce/:nonce route
+func (c *Client) GetHyperblockByNonce(ctx context.Context, nonce string, options ...RequestOption) (*data.HyperblockApiResponsePayload, error) {
+	response := &data.HyperblockApiResponsePayload{}
+	err := c.call(ctx, http.MethodGet, "/hyperblock/by-nonce/"+url.PathEscape(nonce), nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetHyperblockByHash calls the GET /hyperblock/by-hash/:hash route
+func (c *Client) GetHyperblockByHash(ctx context.Context, hash string, options ...RequestOption) (*data.HyperblockApiResponsePayload, error) {
+	response := &data.HyperblockApiResponsePayload{}
+	err := c.call(ctx, http.MethodGet, "/hyperblock/by-hash/"+url.PathEscape(hash), nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetESDTSupplies calls the POST /network/esdt/supplies route
+func (c *Client) GetESDTSupplies(ctx context.Context, request data.ESDTSuppliesRequest, options ...RequestOption) (*data.ESDTSupplies, error) {
+	response := &data.ESDTSupplies{}
+	err := c.call(ctx, http.MethodPost, "/network/esdt/supplies", request, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetShardsOfAddresses calls the GET /network/shard-of route
+func (c *Client) GetShardsOfAddresses(ctx context.Context, options ...RequestOption) (*data.AddressesShards, error) {
+	response := &data.AddressesShards{}
+	err := c.call(ctx, http.MethodGet, "/network/shard-of", nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetLatestBlocks calls the GET /network/latest-blocks route
+func (c *Client) GetLatestBlocks(ctx context.Context, options ...RequestOption) (*data.LatestBlocksResponseData, error) {
+	response := &data.LatestBlocksResponseData{}
+	err := c.call(ctx, http.MethodGet, "/network/latest-blocks", nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetSyncProgress calls the GET /network/sync-progress route
+func (c *Client) GetSyncProgress(ctx context.Context, options ...RequestOption) (*data.SyncProgress, error) {
+	response := &data.SyncProgress{}
+	err := c.call(ctx, http.MethodGet, "/network/sync-progress", nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetBlockFeesByNonce calls the GET /network/fees/:shard/by-nonce/:nonce route
+func (c *Client) GetBlockFeesByNonce(ctx context.Context, shard string, nonce string, options ...RequestOption) (*data.BlockFeesResponseData, error) {
+	response := &data.BlockFeesResponseData{}
+	err := c.call(ctx, http.MethodGet, "/network/fees/"+url.PathEscape(shard)+"/by-nonce/"+url.PathEscape(nonce), nil, response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/client/generator"
+)
+
+// clientgen generates the typed methods of the Go client out of the route definitions of the proxy. It is run through
+// go generate, from the client package
+func main() {
+	output := flag.String("output", "routes_generated.go", "the file the generated client methods are written to")
+	flag.Parse()
+
+	source, err := generator.Generate(api.RouteDefinitions)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot generate the client: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	err = os.WriteFile(*output, source, 0644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot write the client: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
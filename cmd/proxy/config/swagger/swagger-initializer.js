@@ -12,12 +12,15 @@ window.onload = function() {
 
   // the following lines will be replaced by docker/configurator, when it runs in a docker-container
   window.ui = SwaggerUIBundle({
-    url: "openapi.json",
+    urls: [
+      { url: "openapi.json", name: "MultiversX Gateway API" },
+      { url: "swagger.json", name: "Enabled routes (generated)" }
+    ],
     dom_id: '#swagger-ui',
     deepLinking: true,
     presets: [
       SwaggerUIBundle.presets.apis,
-      SwaggerUIStandalonePreset.slice(1)
+      SwaggerUIStandalonePreset
     ],
     plugins: [
       SwaggerUIBundle.plugins.DownloadUrl,
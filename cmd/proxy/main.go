@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math/big"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"syscall"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -17,16 +19,20 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
 	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
 	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-logger-go/file"
 	"github.com/multiversx/mx-chain-proxy-go/api"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
 	"github.com/multiversx/mx-chain-proxy-go/metrics"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process"
-	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/database"
 	processFactory "github.com/multiversx/mx-chain-proxy-go/process/factory"
 	"github.com/multiversx/mx-chain-proxy-go/testing"
 	versionsFactory "github.com/multiversx/mx-chain-proxy-go/versions/factory"
@@ -39,6 +45,7 @@ const (
 	logFileLifeSpanInSec = 86400
 	logFileMaxSizeInMB   = 1024
 	addressHRP           = "erd"
+	minShutdownTimeout   = time.Second
 )
 
 // commitID and appVersion should be populated at build time using ldflags
@@ -281,17 +288,74 @@ func startProxy(ctx *cli.Context) error {
 
 	shouldStartSwaggerUI := ctx.GlobalBool(startSwaggerUI.Name)
 	skipStatusCheck := ctx.GlobalBool(noStatusCheck.Name)
-	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, statusMetricsProvider, closableComponents, skipStatusCheck)
+	responseSigningKey, err := loadResponseSigningKey(generalConfig.ResponseSigning)
 	if err != nil {
 		return err
 	}
 
-	httpServer, err := startWebServer(versionsRegistry, generalConfig, *credentialsConfig, statusMetricsProvider, isProfileModeActivated, shouldStartSwaggerUI)
+	drainProc, err := process.NewDrainProcessor(time.Duration(generalConfig.Drain.ReadsWindowInSec) * time.Second)
 	if err != nil {
 		return err
 	}
 
-	waitForServerShutdown(httpServer, closableComponents)
+	auditLog, err := process.NewAuditLog(generalConfig.AuditLog)
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(auditLog)
+
+	clientStatsProc, err := process.NewClientStatsProcessor(generalConfig.ClientStats)
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(clientStatsProc)
+	clientStatsProc.StartPersisting()
+
+	accessLog, err := process.NewAccessLog(generalConfig.AccessLog)
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(accessLog)
+
+	warmUpProc, err := process.NewWarmUpProcessor(generalConfig.WarmUp)
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(warmUpProc)
+
+	readinessProc, err := process.NewReadinessProcessor(warmUpProc, generalConfig.Readiness)
+	if err != nil {
+		return err
+	}
+
+	loadSheddingProc, err := process.NewLoadSheddingProcessor(generalConfig.LoadShedding, readinessProc)
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(loadSheddingProc)
+
+	epochChangeProc, err := process.NewEpochChangeProcessor(generalConfig.EpochChange)
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(epochChangeProc)
+
+	configReloadProc := process.NewConfigReloadProcessor(configurationFileName, *generalConfig)
+
+	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, statusMetricsProvider, closableComponents, skipStatusCheck, responseSigningKey, drainProc, auditLog, clientStatsProc, warmUpProc, readinessProc, loadSheddingProc, epochChangeProc, configReloadProc)
+	if err != nil {
+		return err
+	}
+
+	httpServers, err := startWebServer(versionsRegistry, generalConfig, *credentialsConfig, statusMetricsProvider, responseSigningKey, drainProc, auditLog, clientStatsProc, accessLog, loadSheddingProc, epochChangeProc, readinessProc, configReloadProc, isProfileModeActivated, shouldStartSwaggerUI)
+	if err != nil {
+		return err
+	}
+
+	go reloadConfigOnSignal(configReloadProc)
+
+	shutdownTimeout := time.Duration(generalConfig.Drain.ShutdownTimeoutInSec) * time.Second
+	waitForServerShutdown(httpServers, closableComponents, shutdownTimeout)
 
 	log.Debug("closing proxy")
 	if !check.IfNilReflect(fileLogging) {
@@ -318,6 +382,15 @@ func createVersionsRegistryTestOrProduction(
 	statusMetricsHandler data.StatusMetricsProvider,
 	closableComponents *data.ClosableComponentsHandler,
 	skipStatusCheck bool,
+	responseSigningKey crypto.PrivateKey,
+	drainProc *process.DrainProcessor,
+	auditLog *process.AuditLog,
+	clientStatsProc *process.ClientStatsProcessor,
+	warmUpProc *process.WarmUpProcessor,
+	readinessProc *process.ReadinessProcessor,
+	loadSheddingProc *process.LoadSheddingProcessor,
+	epochChangeProc *process.EpochChangeProcessor,
+	configReloadProc *process.ConfigReloadProcessor,
 ) (data.VersionsRegistryHandler, error) {
 
 	var testHTTPServerEnabled bool
@@ -383,6 +456,15 @@ func createVersionsRegistryTestOrProduction(
 			ctx.GlobalString(apiConfigDirectory.Name),
 			closableComponents,
 			skipStatusCheck,
+			responseSigningKey,
+			drainProc,
+			auditLog,
+			clientStatsProc,
+			warmUpProc,
+			readinessProc,
+			loadSheddingProc,
+			epochChangeProc,
+			configReloadProc,
 		)
 	}
 
@@ -394,6 +476,15 @@ func createVersionsRegistryTestOrProduction(
 		ctx.GlobalString(apiConfigDirectory.Name),
 		closableComponents,
 		skipStatusCheck,
+		responseSigningKey,
+		drainProc,
+		auditLog,
+		clientStatsProc,
+		warmUpProc,
+		readinessProc,
+		loadSheddingProc,
+		epochChangeProc,
+		configReloadProc,
 	)
 }
 
@@ -405,6 +496,15 @@ func createVersionsRegistry(
 	apiConfigDirectoryPath string,
 	closableComponents *data.ClosableComponentsHandler,
 	skipStatusCheck bool,
+	responseSigningKey crypto.PrivateKey,
+	drainProc *process.DrainProcessor,
+	auditLog *process.AuditLog,
+	clientStatsProc *process.ClientStatsProcessor,
+	warmUpProc *process.WarmUpProcessor,
+	readinessProc *process.ReadinessProcessor,
+	loadSheddingProc *process.LoadSheddingProcessor,
+	epochChangeProc *process.EpochChangeProcessor,
+	configReloadProc *process.ConfigReloadProcessor,
 ) (data.VersionsRegistryHandler, error) {
 	pubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(cfg.AddressPubkeyConverter.Length, addressHRP)
 	if err != nil {
@@ -447,20 +547,50 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	bp, err := process.NewBaseProcessor(
-		cfg.GeneralSettings.RequestTimeoutSec,
-		shardCoord,
-		observersProvider,
-		fullHistoryNodesProvider,
-		pubKeyConverter,
-		skipStatusCheck,
-	)
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        cfg.GeneralSettings.RequestTimeoutSec,
+		ShardCoordinator:         shardCoord,
+		ObserversProvider:        observersProvider,
+		FullHistoryNodesProvider: fullHistoryNodesProvider,
+		PubKeyConverter:          pubKeyConverter,
+		NoStatusCheck:            skipStatusCheck,
+		HttpClientConfig:         cfg.ObserversHttpClient,
+		UpstreamProxies:          cfg.UpstreamProxies.Addresses,
+		ShadowTrafficConfig:      cfg.ShadowTraffic,
+		TopologySnapshotConfig:   cfg.TopologySnapshot,
+		CapabilitiesConfig:       cfg.ObserversCapabilities,
+		HedgedRequestsConfig:     cfg.HedgedRequests,
+		ResponseSizeLimitsConfig: cfg.ResponseSizeLimits,
+		ConsistencyConfig:        cfg.ObserversConsistency,
+		ShardsTopologyConfig:     cfg.ShardsTopology,
+	})
+	if err != nil {
+		return nil, err
+	}
+	faultInjectionProc := process.NewFaultInjectionProcessor(cfg.FaultInjection.Enabled)
+	err = bp.SetFaultInjectionProcessor(faultInjectionProc)
+	if err != nil {
+		return nil, err
+	}
+	err = readinessProc.SetObserversHealthHandler(bp)
+	if err != nil {
+		return nil, err
+	}
+	err = loadSheddingProc.SetAddressShardComputer(bp)
+	if err != nil {
+		return nil, err
+	}
+	err = bp.SetEpochChangeProcessor(epochChangeProc)
+	if err != nil {
+		return nil, err
+	}
+	err = configReloadProc.SetObserversReloader(bp)
 	if err != nil {
 		return nil, err
 	}
 	bp.StartNodesSyncStateChecks()
 
-	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter)
+	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter, cfg.QuorumReads)
 	if err != nil {
 		return nil, err
 	}
@@ -478,36 +608,97 @@ func createVersionsRegistry(
 		hasher,
 		marshalizer,
 		cfg.GeneralSettings.AllowEntireTxPoolFetch,
+		cfg.SendTransactionQuorum,
+		cfg.TransactionsPolicy,
+		auditLog,
+		cfg.DuplicateNonceCheck,
+		accntProc,
+		cfg.SignatureCheck,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
+	rawPassThroughProc, err := process.NewRawPassThroughProcessor(bp)
 	if err != nil {
 		return nil, err
 	}
 
-	htbCacher := cache.NewHeartbeatMemoryCacher()
-	cacheValidity := time.Duration(cfg.GeneralSettings.HeartbeatCacheValidityDurationSec) * time.Second
+	nonceManagerProc, err := processFactory.CreateNonceManagerProcessor(accntProc, txProc, shardCoord, pubKeyConverter, cfg.NonceManager)
+	if err != nil {
+		return nil, err
+	}
 
-	nodeGroupProc, err := process.NewNodeGroupProcessor(bp, htbCacher, cacheValidity)
+	observersFeedProc, err := processFactory.CreateObserversFeedProcessor(marshalizer, cfg.ObserversFeed)
 	if err != nil {
 		return nil, err
 	}
+	closableComponents.Add(observersFeedProc)
+	observersFeedProc.StartConsuming()
 
-	valStatsCacher := cache.NewValidatorsStatsMemoryCacher()
-	cacheValidity = time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
+	txStatusWatcher, err := process.NewTransactionStatusWatcher(bp, pubKeyConverter, txProc, observersFeedProc, cfg.TxStatusWatcher)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(txStatusWatcher)
+	txStatusWatcher.StartWatching()
 
-	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity)
+	err = clientStatsProc.SetStatusWatcher(txStatusWatcher)
 	if err != nil {
 		return nil, err
 	}
 
-	economicMetricsCacher := cache.NewGenericApiResponseMemoryCacher()
-	cacheValidity = time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
+	txWaitProc, err := processFactory.CreateTransactionWaitProcessor(txProc, txStatusWatcher, cfg.TransactionWait)
+	if err != nil {
+		return nil, err
+	}
 
-	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, cacheValidity)
+	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter, cfg.SCQueryCache, cfg.SCQueryGuard)
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatCacheValidity := time.Duration(cfg.GeneralSettings.HeartbeatCacheValidityDurationSec) * time.Second
+	valStatsCacheValidity := time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
+	economicsCacheValidity := time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
+	cachers, err := processFactory.CreateCachers(processFactory.ArgsCachers{
+		Config:                      cfg.CacheBackend,
+		HeartbeatCacheValidity:      heartbeatCacheValidity,
+		ValidatorStatsCacheValidity: valStatsCacheValidity,
+		EconomicsCacheValidity:      economicsCacheValidity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cachers.SharedStore != nil {
+		closableComponents.Add(cachers.SharedStore)
+		err = scQueryProc.SetSharedCacheStore(cachers.SharedStore)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	htbCacher := cachers.HeartbeatCacher
+	nodeGroupProc, err := process.NewNodeGroupProcessor(bp, htbCacher, heartbeatCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.ShardsTopology.Enabled && cfg.ShardsTopology.UseHeartbeats {
+		err = bp.SetHeartbeatsProvider(nodeGroupProc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	valStatsCacher := cachers.ValidatorStatsCacher
+	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, valStatsCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	economicMetricsCacher := cachers.EconomicMetricsCacher
+	networkMetricsCacheValidity := time.Duration(cfg.GeneralSettings.NetworkMetricsCacheValidityDurationSec) * time.Second
+	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, economicsCacheValidity, cfg.GeneralSettings.EconomicsMetricsHistorySize, networkMetricsCacheValidity)
 	if err != nil {
 		return nil, err
 	}
@@ -518,10 +709,44 @@ func createVersionsRegistry(
 	valStatsProc.StartCacheUpdate()
 	nodeStatusProc.StartCacheUpdate()
 
-	blockProc, err := process.NewBlockProcessor(bp)
+	statusMetricsHandler.RegisterCache("heartbeat", htbCacher.GetCacheMetrics)
+	statusMetricsHandler.RegisterCache("validator-statistics", valStatsCacher.GetCacheMetrics)
+	statusMetricsHandler.RegisterCache("economics", economicMetricsCacher.GetCacheMetrics)
+	statusMetricsHandler.RegisterCache("network-metrics", nodeStatusProc.GetNetworkMetricsCacheMetrics)
+	if cfg.SCQueryCache.Enabled {
+		statusMetricsHandler.RegisterCache("sc-query", scQueryProc.GetCacheMetrics)
+	}
+
+	webhooksProc, err := processFactory.CreateWebhooksProcessor(txStatusWatcher, accntProc, nodeStatusProc, cfg.Webhooks)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(webhooksProc)
+	webhooksProc.StartWatchingAddresses()
+
+	err = addWarmUpTasks(warmUpProc, nodeStatusProc, htbCacher)
+	if err != nil {
+		return nil, err
+	}
+	warmUpProc.Start()
+	loadSheddingProc.Start()
+	err = epochChangeProc.SetNetworkProvider(nodeStatusProc)
+	if err != nil {
+		return nil, err
+	}
+	epochChangeProc.Start()
+
+	blockProc, err := process.NewBlockProcessor(bp, cfg.HyperblockCache)
+	if err != nil {
+		return nil, err
+	}
+	err = blockProc.SetBlocksNetworkProvider(nodeStatusProc)
 	if err != nil {
 		return nil, err
 	}
+	if cfg.HyperblockCache.Enabled {
+		statusMetricsHandler.RegisterCache("hyperblock", blockProc.GetHyperblockCacheMetrics)
+	}
 
 	blocksPrc, err := process.NewBlocksProcessor(bp)
 	if err != nil {
@@ -533,7 +758,57 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	esdtSuppliesProc, err := process.NewESDTSupplyProcessor(bp, scQueryProc)
+	esdtSuppliesProc, err := process.NewESDTSupplyProcessor(bp, scQueryProc, cfg.ESDTSupplyHistory)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(esdtSuppliesProc)
+	esdtSuppliesProc.StartSupplyHistoryTracking()
+
+	stakingPortfolioProc, err := process.NewStakingPortfolioProcessor(scQueryProc, pubKeyConverter, cfg.GeneralSettings.LegacyDelegationContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationProvidersCacheValidity := time.Duration(cfg.GeneralSettings.DelegationProvidersCacheValidityDurationSec) * time.Second
+	delegationProc, err := process.NewDelegationProcessor(scQueryProc, pubKeyConverter, delegationProvidersCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(delegationProc)
+	delegationProc.StartProvidersCacheUpdate()
+
+	esdtIssuanceProc, err := process.NewESDTIssuanceProcessor(bp, scQueryProc, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeProc, err := process.NewBridgeProcessor(scQueryProc, pubKeyConverter, cfg.Bridge)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusProc, err := process.NewConsensusProcessor(blockProc, nodeStatusProc, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	esConnector, err := createElasticSearchConnector(cfg.ElasticSearch)
+	if err != nil {
+		return nil, err
+	}
+
+	txsHistoryProc, err := process.NewTransactionsHistoryProcessor(esConnector, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionsProc, err := process.NewCollectionsProcessor(esConnector)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverterProc, err := process.NewAddressConverterProcessor(pubKeyConverter)
 	if err != nil {
 		return nil, err
 	}
@@ -548,6 +823,11 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	proxyPublicKeyProc, err := createProxyPublicKeyProcessor(responseSigningKey)
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := versionsFactory.FacadeArgs{
 		ActionsProcessor:             bp,
 		AccountProcessor:             accntProc,
@@ -564,6 +844,26 @@ func createVersionsRegistry(
 		ESDTSuppliesProcessor:        esdtSuppliesProc,
 		StatusProcessor:              statusProc,
 		AboutInfoProcessor:           aboutInfoProc,
+		ProxyPublicKeyProcessor:      proxyPublicKeyProc,
+		StakingPortfolioProcessor:    stakingPortfolioProc,
+		DrainProcessor:               drainProc,
+		TransactionsHistoryProcessor: txsHistoryProc,
+		NonceManagerProcessor:        nonceManagerProc,
+		FaultInjectionProcessor:      faultInjectionProc,
+		RawPassThroughProcessor:      rawPassThroughProc,
+		WebhooksProcessor:            webhooksProc,
+		ObserversFeedProcessor:       observersFeedProc,
+		ConfigReloadProcessor:        configReloadProc,
+		DelegationProcessor:          delegationProc,
+		TransactionWaitProcessor:     txWaitProc,
+		ESDTIssuanceProcessor:        esdtIssuanceProc,
+		TransactionStatusWatcher:     txStatusWatcher,
+		BridgeProcessor:              bridgeProc,
+		ConsensusProcessor:           consensusProc,
+		CollectionsProcessor:         collectionsProc,
+		AddressConverterProcessor:    addressConverterProc,
+		ClientStatsProcessor:         clientStatsProc,
+		Processor:                    bp,
 	}
 
 	apiConfigParser, err := versionsFactory.NewApiConfigParser(apiConfigDirectoryPath)
@@ -574,14 +874,60 @@ func createVersionsRegistry(
 	return versionsFactory.CreateVersionsRegistry(facadeArgs, apiConfigParser)
 }
 
+// addWarmUpTasks registers the caches to be populated before the proxy reports itself as ready. The economics metrics
+// and the heartbeats are fetched by their own cache update routines, so their tasks only wait for the first update
+func addWarmUpTasks(
+	warmUpProc *process.WarmUpProcessor,
+	nodeStatusProc *process.NodeStatusProcessor,
+	heartbeatsCacher process.HeartbeatCacheHandler,
+) error {
+	err := warmUpProc.AddTask("network config", func() error {
+		_, errGet := nodeStatusProc.GetNetworkConfigMetrics()
+		return errGet
+	})
+	if err != nil {
+		return err
+	}
+
+	err = warmUpProc.AddTask("enable epochs", func() error {
+		_, errGet := nodeStatusProc.GetEnableEpochsMetrics()
+		return errGet
+	})
+	if err != nil {
+		return err
+	}
+
+	err = warmUpProc.AddTask("economics", func() error {
+		_, errGet := nodeStatusProc.GetEconomicsDataMetrics()
+		return errGet
+	})
+	if err != nil {
+		return err
+	}
+
+	return warmUpProc.AddTask("shard topology", func() error {
+		_, errGet := heartbeatsCacher.LoadHeartbeats()
+		return errGet
+	})
+}
+
 func startWebServer(
 	versionsRegistry data.VersionsRegistryHandler,
 	generalConfig *config.Config,
 	credentialsConfig config.CredentialsConfig,
 	statusMetricsProvider data.StatusMetricsProvider,
+	responseSigningKey crypto.PrivateKey,
+	drainProc *process.DrainProcessor,
+	auditLog *process.AuditLog,
+	clientStatsProc *process.ClientStatsProcessor,
+	accessLog *process.AccessLog,
+	loadSheddingProc *process.LoadSheddingProcessor,
+	epochChangeProc *process.EpochChangeProcessor,
+	readinessProc *process.ReadinessProcessor,
+	configReloadProc *process.ConfigReloadProcessor,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
-) (*http.Server, error) {
+) ([]*http.Server, error) {
 	var err error
 	var httpServer *http.Server
 
@@ -591,42 +937,168 @@ func startWebServer(
 		return nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
 			"than zero", generalConfig.GeneralSettings.RateLimitWindowDurationSeconds)
 	}
-	httpServer, err = api.CreateServer(
-		versionsRegistry,
-		port,
-		generalConfig.ApiLogging,
-		credentialsConfig,
-		statusMetricsProvider,
-		generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
-		isProfileModeActivated,
-		shouldStartSwaggerUI,
-	)
+	httpServer, err = api.CreateServer(api.ArgsCreateServer{
+		VersionsRegistry:             versionsRegistry,
+		Port:                         port,
+		ApiLoggingConfig:             generalConfig.ApiLogging,
+		RateLimiterConfig:            generalConfig.RateLimiter,
+		FieldsFilterConfig:           generalConfig.FieldsFilter,
+		RequestDeadlineConfig:        generalConfig.RequestDeadline,
+		JsonRpcConfig:                generalConfig.JsonRpc,
+		OpenApiConfig:                generalConfig.OpenApi,
+		RoutesConfig:                 generalConfig.Routes,
+		CacheControlConfig:           generalConfig.CacheControl,
+		ETagConfig:                   generalConfig.ETag,
+		DrainConfig:                  generalConfig.Drain,
+		DrainStatusHandler:           drainProc,
+		AuditLogConfig:               generalConfig.AuditLog,
+		AuditLogHandler:              auditLog,
+		ClientStatsConfig:            generalConfig.ClientStats,
+		ClientStatsHandler:           clientStatsProc,
+		AccessLogConfig:              generalConfig.AccessLog,
+		AccessLogHandler:             accessLog,
+		LoadSheddingConfig:           generalConfig.LoadShedding,
+		LoadSheddingHandler:          loadSheddingProc,
+		EpochChangeConfig:            generalConfig.EpochChange,
+		EpochChangeHandler:           epochChangeProc,
+		ReadinessHandler:             readinessProc,
+		ResponseSigningKey:           responseSigningKey,
+		CredentialsConfig:            credentialsConfig,
+		StatusMetricsExtractor:       statusMetricsProvider,
+		RuntimeConfigRegistry:        configReloadProc,
+		TrustedProxies:               generalConfig.GeneralSettings.TrustedProxies,
+		RateLimitTimeWindowInSeconds: generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
+		IsProfileModeActivated:       isProfileModeActivated,
+		ShouldStartSwaggerUI:         shouldStartSwaggerUI,
+	})
 
 	if err != nil {
 		return nil, err
 	}
-	go func() {
-		err = httpServer.ListenAndServe()
-		if err != nil {
-			log.Error("cannot ListenAndServe()", "err", err)
-			os.Exit(1)
+
+	httpServers := []*http.Server{httpServer}
+	if generalConfig.HeavyListener.Enabled {
+		if generalConfig.HeavyListener.Port == port {
+			return nil, fmt.Errorf("the heavy endpoints listener port %d should differ from ServerPort", port)
 		}
-	}()
 
-	return httpServer, nil
+		heavyServer, errCreate := api.CreateHeavyListenerServer(httpServer, versionsRegistry, generalConfig.HeavyListener)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+		httpServers = append(httpServers, heavyServer)
+		log.Info("heavy endpoints listener enabled", "port", generalConfig.HeavyListener.Port, "routes", generalConfig.HeavyListener.Routes)
+	}
+
+	for _, server := range httpServers {
+		go listenAndServe(server)
+	}
+
+	return httpServers, nil
+}
+
+func listenAndServe(httpServer *http.Server) {
+	err := httpServer.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		log.Error("cannot ListenAndServe()", "address", httpServer.Addr, "err", err)
+		os.Exit(1)
+	}
+}
+
+func loadResponseSigningKey(cfg config.ResponseSigningConfig) (crypto.PrivateKey, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	skHexBytes, _, err := core.LoadSkPkFromPemFile(cfg.PrivateKeyPemFile, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the response signing key from %s", err, cfg.PrivateKeyPemFile)
+	}
+
+	skBytes, err := hex.DecodeString(string(skHexBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	privateKey, err := keyGen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("response signing enabled", "pem file", cfg.PrivateKeyPemFile)
+
+	return privateKey, nil
+}
+
+// elasticSearchConnector defines the queries served from the Elasticsearch indices
+type elasticSearchConnector interface {
+	process.TransactionsHistoryConnector
+	process.CollectionsConnector
 }
 
-func waitForServerShutdown(httpServer *http.Server, closableComponents *data.ClosableComponentsHandler) {
-	quit := make(chan os.Signal)
+func createElasticSearchConnector(cfg config.ElasticSearchConfig) (elasticSearchConnector, error) {
+	if !cfg.Enabled {
+		return database.NewDisabledElasticSearchConnector(), nil
+	}
+
+	esConnector, err := database.NewElasticSearchConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("transactions history and collections will be fetched from Elasticsearch", "url", cfg.URL)
+	return esConnector, nil
+}
+
+func createProxyPublicKeyProcessor(responseSigningKey crypto.PrivateKey) (facade.ProxyPublicKeyProcessor, error) {
+	if check.IfNil(responseSigningKey) {
+		return process.NewProxyPublicKeyProcessor(nil), nil
+	}
+
+	publicKeyBytes, err := responseSigningKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return process.NewProxyPublicKeyProcessor(publicKeyBytes), nil
+}
+
+// reloadConfigOnSignal reloads the main config file each time the process receives SIGHUP
+func reloadConfigOnSignal(configReloadProc *process.ConfigReloadProcessor) {
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	for range reload {
+		log.Info("SIGHUP received, reloading the main config")
+		_, err := configReloadProc.ReloadConfig()
+		if err != nil {
+			log.Error("main config not reloaded, the running config is kept", "error", err)
+		}
+	}
+}
+
+func waitForServerShutdown(httpServers []*http.Server, closableComponents *data.ClosableComponentsHandler, shutdownTimeout time.Duration) {
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, os.Kill)
 	<-quit
 
-	closableComponents.Close()
+	if shutdownTimeout < minShutdownTimeout {
+		shutdownTimeout = minShutdownTimeout
+	}
 
-	shutdownContext, cancel := context.WithTimeout(context.Background(), time.Second)
+	// the in-flight requests (and their observer calls) should finish before closing the components used by them
+	log.Info("shutting down the web server", "timeout", shutdownTimeout)
+	shutdownContext, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
-	_ = httpServer.Shutdown(shutdownContext)
-	_ = httpServer.Close()
+	for _, httpServer := range httpServers {
+		err := httpServer.Shutdown(shutdownContext)
+		if err != nil {
+			log.Warn("web server did not shut down gracefully", "address", httpServer.Addr, "error", err)
+		}
+		_ = httpServer.Close()
+	}
+
+	closableComponents.Close()
 }
 
 // getNumOfShards will delay the start of proxy until it successfully gets the number of shards
@@ -16,3 +16,9 @@ const (
 	// Proto output format returns the bytes of the proto object
 	Proto OutputFormat = 1
 )
+
+// ProxySignatureHeader is the name of the response header holding the hex encoded signature of the response body
+const ProxySignatureHeader = "X-Proxy-Signature"
+
+// ProxySignatureScheme is the signature scheme used for signing the proxy responses
+const ProxySignatureScheme = "ed25519"
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type observerCallsRecorderKey struct{}
+
+// ObserverCallsRecorder collects the requests sent towards the observers while serving a client request
+type ObserverCallsRecorder struct {
+	mutCalls sync.Mutex
+	calls    []*data.ObserverCall
+}
+
+// NewObserverCallsRecorder creates a new instance of ObserverCallsRecorder
+func NewObserverCallsRecorder() *ObserverCallsRecorder {
+	return &ObserverCallsRecorder{
+		calls: make([]*data.ObserverCall, 0),
+	}
+}
+
+// GetCalls returns the recorded observer calls, in the order they completed
+func (ocr *ObserverCallsRecorder) GetCalls() []*data.ObserverCall {
+	ocr.mutCalls.Lock()
+	defer ocr.mutCalls.Unlock()
+
+	calls := make([]*data.ObserverCall, len(ocr.calls))
+	copy(calls, ocr.calls)
+
+	return calls
+}
+
+// ContextWithObserverCallsRecorder returns a copy of the context holding the recorder
+func ContextWithObserverCallsRecorder(ctx context.Context, recorder *ObserverCallsRecorder) context.Context {
+	return context.WithValue(ctx, observerCallsRecorderKey{}, recorder)
+}
+
+// RecordObserverCall records the observer call on the recorder held by the context, if any
+func RecordObserverCall(ctx context.Context, address string, statusCode int) {
+	recorder, ok := ctx.Value(observerCallsRecorderKey{}).(*ObserverCallsRecorder)
+	if !ok || recorder == nil {
+		return
+	}
+
+	recorder.mutCalls.Lock()
+	recorder.calls = append(recorder.calls, &data.ObserverCall{
+		Address:    address,
+		StatusCode: statusCode,
+	})
+	recorder.mutCalls.Unlock()
+}
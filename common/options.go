@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 const (
@@ -16,6 +18,10 @@ const (
 	UrlParameterWithLogs = "withLogs"
 	// UrlParameterForHyperblock represents the name of an URL parameter
 	UrlParameterForHyperblock = "forHyperblock"
+	// UrlParameterWithTokenTransfers represents the name of an URL parameter
+	UrlParameterWithTokenTransfers = "withTokenTransfers"
+	// UrlParameterWithFees represents the name of an URL parameter
+	UrlParameterWithFees = "withFees"
 	// UrlParameterNotarizedAtSource represents the name of an URL parameter
 	UrlParameterNotarizedAtSource = "notarizedAtSource"
 	// UrlParameterOnFinalBlock represents the name of an URL parameter
@@ -34,6 +40,8 @@ const (
 	UrlParameterCheckSignature = "checkSignature"
 	// UrlParameterWithResults represents the name of an URL parameter
 	UrlParameterWithResults = "withResults"
+	// UrlParameterWithRelayed represents the name of an URL parameter
+	UrlParameterWithRelayed = "withRelayed"
 	// UrlParameterShardID represents the name of an URL parameter
 	UrlParameterShardID = "shard-id"
 	// UrlParameterForcedShardID represents the name of an URL parameter
@@ -46,19 +54,78 @@ const (
 	UrlParameterLastNonce = "last-nonce"
 	// UrlParameterNonceGaps represents the name of an URL parameter
 	UrlParameterNonceGaps = "nonce-gaps"
+	// UrlParameterCursor represents the name of an URL parameter
+	UrlParameterCursor = "cursor"
+	// UrlParameterHashes represents the name of an URL parameter
+	UrlParameterHashes = "hashes"
 	// UrlParameterTokensFilter represents the name of an URL parameter
 	UrlParameterTokensFilter = "tokens"
 	// UrlParameterWithAlteredAccounts represents the name of an URL parameter
 	UrlParameterWithAlteredAccounts = "withAlteredAccounts"
 	// UrlParameterWithKeys represents the name of an URL parameter
 	UrlParameterWithKeys = "withKeys"
+	// UrlParameterQuorum represents the name of an URL parameter
+	UrlParameterQuorum = "quorum"
+	// UrlParameterWithDetails represents the name of an URL parameter
+	UrlParameterWithDetails = "withDetails"
+	// UrlParameterPage represents the name of an URL parameter
+	UrlParameterPage = "page"
+	// UrlParameterSize represents the name of an URL parameter
+	UrlParameterSize = "size"
+	// UrlParameterNumKeys represents the name of an URL parameter
+	UrlParameterNumKeys = "numKeys"
+	// UrlParameterProviders represents the name of an URL parameter
+	UrlParameterProviders = "providers"
+	// UrlParameterSince represents the name of an URL parameter
+	UrlParameterSince = "since"
+	// UrlParameterTokenType represents the name of an URL parameter
+	UrlParameterTokenType = "type"
+	// UrlParameterSearch represents the name of an URL parameter
+	UrlParameterSearch = "search"
+	// UrlParameterVerify represents the name of an URL parameter
+	UrlParameterVerify = "verify"
+	// UrlParameterAfter represents the name of an URL parameter
+	UrlParameterAfter = "after"
+	// UrlParameterBefore represents the name of an URL parameter
+	UrlParameterBefore = "before"
+	// UrlParameterAddresses represents the name of an URL parameter
+	UrlParameterAddresses = "addresses"
+	// UrlParameterWait represents the name of an URL parameter
+	UrlParameterWait = "wait"
+	// UrlParameterTimeout represents the name of an URL parameter
+	UrlParameterTimeout = "timeout"
+	// UrlParameterDryRun represents the name of an URL parameter
+	UrlParameterDryRun = "dryRun"
+	// UrlParameterSimulate represents the name of an URL parameter
+	UrlParameterSimulate = "simulate"
+	// UrlParameterAddressFormat represents the name of an URL parameter
+	UrlParameterAddressFormat = "format"
+	// UrlParameterShard represents the name of an URL parameter
+	UrlParameterShard = "shard"
 )
 
+const (
+	// AddressFormatBech32 is the default format of the addresses returned by the proxy
+	AddressFormatBech32 = "bech32"
+	// AddressFormatHex is the format of the addresses returned as hex public keys
+	AddressFormatHex = "hex"
+)
+
+// ESDTTokensFilterOptions holds the options used for filtering the ESDT tokens of an account
+type ESDTTokensFilterOptions struct {
+	TokenType string
+	Search    string
+}
+
 // BlockQueryOptions holds options for block queries
 type BlockQueryOptions struct {
 	WithTransactions bool
 	WithLogs         bool
 	ForHyperblock    bool
+	// WithTokenTransfers is handled by the proxy, so it is not forwarded to the observers
+	WithTokenTransfers bool
+	// WithFees is handled by the proxy, which completes the fees of the blocks out of their internal headers
+	WithFees bool
 }
 
 // HyperblockQueryOptions holds options for hyperblock queries
@@ -72,6 +139,8 @@ type HyperblockQueryOptions struct {
 // TransactionQueryOptions holds options for transaction queries
 type TransactionQueryOptions struct {
 	WithResults bool
+	// WithRelayed is handled by the proxy, which then also fetches the relayed transaction wrapping an inner one
+	WithRelayed bool
 }
 
 // TransactionSimulationOptions holds options for transaction simulation requests
@@ -79,6 +148,45 @@ type TransactionSimulationOptions struct {
 	CheckSignature bool
 }
 
+// PaginationOptions holds options for paginated responses. A zero page means that no pagination was requested
+type PaginationOptions struct {
+	Page uint32
+	Size uint32
+}
+
+// AccountExportOptions holds the options used when exporting the data trie of an account. A nil cursor starts a new
+// export
+type AccountExportOptions struct {
+	NumKeys uint32
+	Cursor  *data.AccountExportCursor
+}
+
+// TransactionsHistoryOptions holds the options used when fetching the transactions history of an address. The time
+// filters are unix timestamps (in seconds) and a zero value means that the filter is not applied
+type TransactionsHistoryOptions struct {
+	Page   uint32
+	Size   uint32
+	After  uint64
+	Before uint64
+}
+
+// TransactionCostOptions holds options for transaction cost requests
+type TransactionCostOptions struct {
+	WithDetails bool
+}
+
+// TransactionSendOptions holds options for transaction send requests
+type TransactionSendOptions struct {
+	Wait    bool
+	Timeout time.Duration
+}
+
+// TransactionsDryRunOptions holds options for the dry-run of send-multiple requests
+type TransactionsDryRunOptions struct {
+	Simulate       bool
+	CheckSignature bool
+}
+
 // TransactionsPoolOptions holds options for transactions pool requests
 type TransactionsPoolOptions struct {
 	ShardID   string
@@ -86,6 +194,7 @@ type TransactionsPoolOptions struct {
 	Fields    string
 	LastNonce bool
 	NonceGaps bool
+	Cursor    uint64
 }
 
 // GetAlteredAccountsForBlockOptions specifies the options for returning altered accounts for a given block
@@ -6,18 +6,23 @@ import (
 
 // GeneralSettingsConfig will hold the general settings for a node
 type GeneralSettingsConfig struct {
-	ServerPort                               int
-	RequestTimeoutSec                        int
-	HeartbeatCacheValidityDurationSec        int
-	ValStatsCacheValidityDurationSec         int
-	EconomicsMetricsCacheValidityDurationSec int
-	FaucetValue                              string
-	RateLimitWindowDurationSeconds           int
-	BalancedObservers                        bool
-	BalancedFullHistoryNodes                 bool
-	AllowEntireTxPoolFetch                   bool
-	NumShardsTimeoutInSec                    int
-	TimeBetweenNodesRequestsInSec            int
+	ServerPort                                  int
+	RequestTimeoutSec                           int
+	HeartbeatCacheValidityDurationSec           int
+	ValStatsCacheValidityDurationSec            int
+	EconomicsMetricsCacheValidityDurationSec    int
+	EconomicsMetricsHistorySize                 int
+	NetworkMetricsCacheValidityDurationSec      int
+	DelegationProvidersCacheValidityDurationSec int
+	FaucetValue                                 string
+	RateLimitWindowDurationSeconds              int
+	BalancedObservers                           bool
+	BalancedFullHistoryNodes                    bool
+	AllowEntireTxPoolFetch                      bool
+	NumShardsTimeoutInSec                       int
+	TimeBetweenNodesRequestsInSec               int
+	LegacyDelegationContractAddress             string
+	TrustedProxies                              []string
 }
 
 // Config will hold the whole config file's data
@@ -27,6 +32,51 @@ type Config struct {
 	Marshalizer            TypeConfig
 	Hasher                 TypeConfig
 	ApiLogging             ApiLoggingConfig
+	RateLimiter            RateLimiterConfig
+	FieldsFilter           FieldsFilterConfig
+	RequestDeadline        RequestDeadlineConfig
+	JsonRpc                JsonRpcConfig
+	OpenApi                OpenApiConfig
+	Routes                 RoutesConfig
+	CacheControl           CacheControlConfig
+	ETag                   ETagConfig
+	ObserversHttpClient    ObserversHttpClientConfig
+	ResponseSigning        ResponseSigningConfig
+	UpstreamProxies        UpstreamProxiesConfig
+	ShadowTraffic          ShadowTrafficConfig
+	TopologySnapshot       TopologySnapshotConfig
+	ObserversCapabilities  ObserversCapabilitiesConfig
+	HedgedRequests         HedgedRequestsConfig
+	ResponseSizeLimits     ResponseSizeLimitsConfig
+	ObserversConsistency   ObserversConsistencyConfig
+	ShardsTopology         ShardsTopologyConfig
+	Drain                  DrainConfig
+	SendTransactionQuorum  SendTransactionQuorumConfig
+	QuorumReads            QuorumReadsConfig
+	WarmUp                 WarmUpConfig
+	Readiness              ReadinessConfig
+	LoadShedding           LoadSheddingConfig
+	EpochChange            EpochChangeConfig
+	TransactionsPolicy     TransactionsPolicyConfig
+	DuplicateNonceCheck    DuplicateNonceCheckConfig
+	SignatureCheck         SignatureCheckConfig
+	ElasticSearch          ElasticSearchConfig
+	NonceManager           NonceManagerConfig
+	FaultInjection         FaultInjectionConfig
+	TxStatusWatcher        TransactionStatusWatcherConfig
+	Webhooks               WebhooksConfig
+	TransactionWait        TransactionWaitConfig
+	ObserversFeed          ObserversFeedConfig
+	Bridge                 BridgeConfig
+	SCQueryCache           SCQueryCacheConfig
+	SCQueryGuard           SCQueryGuardConfig
+	HyperblockCache        HyperblockCacheConfig
+	ESDTSupplyHistory      ESDTSupplyHistoryConfig
+	AuditLog               AuditLogConfig
+	AccessLog              AccessLogConfig
+	CacheBackend           CacheBackendConfig
+	ClientStats            ClientStatsConfig
+	HeavyListener          HeavyListenerConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
@@ -49,8 +99,462 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// RateLimiterConfig holds the configuration related to the token bucket rate limiter applied per client IP and route
+type RateLimiterConfig struct {
+	Enabled                         bool
+	RequestsPerSecond               float64
+	Burst                           uint32
+	HeavyRequestsPerSecond          float64
+	HeavyBurst                      uint32
+	HeavyRoutes                     []string
+	IdleBucketsCleanupIntervalInSec int
+}
+
+// FieldsFilterConfig holds the configuration related to the server-side filtering of the response fields
+type FieldsFilterConfig struct {
+	Enabled        bool
+	ExcludedRoutes []string
+}
+
+// RequestDeadlineConfig holds the configuration related to the deadlines requested by the clients
+type RequestDeadlineConfig struct {
+	Enabled        bool
+	MaxTimeoutInMs int
+}
+
+// JsonRpcConfig holds the limits of the JSON-RPC requests
+type JsonRpcConfig struct {
+	MaxBodySizeInBytes int64
+	MaxBatchSize       int
+}
+
+// RoutesConfig holds the API groups and routes disabled on top of the per version API configuration files
+type RoutesConfig struct {
+	DisabledRoutes     []string
+	ExposedRoutes      []string
+	DisabledStatusCode int
+}
+
+// OpenApiConfig holds the configuration related to the OpenAPI document generated from the enabled routes
+type OpenApiConfig struct {
+	Enabled bool
+}
+
+// CacheControlConfig holds the configuration of the Cache-Control headers sent along the responses
+type CacheControlConfig struct {
+	Enabled               bool
+	ShortLivedMaxAgeInSec int
+	LongLivedMaxAgeInSec  int
+}
+
+// ETagConfig holds the configuration of the ETags computed for the responses of the block and hyperblock endpoints
+type ETagConfig struct {
+	Enabled bool
+}
+
+// WarmUpConfig holds the configuration of the warm-up phase run at startup
+type WarmUpConfig struct {
+	Enabled           bool
+	MaxDurationInSec  int
+	RetryIntervalInMs int
+}
+
+// ReadinessConfig holds the configuration related to the readiness of the proxy
+type ReadinessConfig struct {
+	MaxObserverSilenceInSec int
+}
+
+// LoadSheddingConfig holds the configuration of the read traffic shedding applied to the shards whose observers are
+// degraded
+type LoadSheddingConfig struct {
+	Enabled             bool
+	MinHealthyObservers int
+	CheckIntervalInMs   int
+	RetryAfterInSec     int
+	CriticalRoutes      []string
+}
+
+// EpochChangeConfig holds the configuration of the handling of the requests failing because of the epoch change
+type EpochChangeConfig struct {
+	Enabled           bool
+	WindowInRounds    uint64
+	CheckIntervalInMs int
+	RetryDelayInMs    int
+	MaxRetries        int
+	RetryAfterInSec   int
+	ErrorMessages     []string
+}
+
+// ObserversHttpClientConfig holds the configuration of the http clients used for communicating with the observers
+type ObserversHttpClientConfig struct {
+	MaxIdleConnsPerHost        int
+	MaxConnsPerHost            int
+	IdleConnTimeoutInSec       int
+	DisableHTTP2               bool
+	StreamingThresholdInBytes  int
+	MaxInFlightRequestsPerHost int
+	InFlightBudgets            []ObserverInFlightBudgetConfig
+	TLS                        []ObserverTLSConfig
+	Auth                       []ObserverAuthConfig
+}
+
+// ObserverInFlightBudgetConfig holds the maximum number of requests in progress towards each observer of a set. The
+// entry applies to the listed observer addresses and to all the observers of the listed shards
+type ObserverInFlightBudgetConfig struct {
+	Addresses           []string
+	ShardIDs            []uint32
+	MaxInFlightRequests int
+}
+
+// ObserverTLSConfig holds the TLS settings used for reaching a set of observers over HTTPS. The entry applies to the
+// listed observer addresses and to all the observers of the listed shards
+type ObserverTLSConfig struct {
+	Addresses             []string
+	ShardIDs              []uint32
+	CACertificateFile     string
+	ClientCertificateFile string
+	ClientKeyFile         string
+	ServerName            string
+}
+
+// ObserverAuthConfig holds the authentication header attached to the requests towards a set of observers. The entry
+// applies to the listed observer addresses and to all the observers of the listed shards
+type ObserverAuthConfig struct {
+	Addresses     []string
+	ShardIDs      []uint32
+	Type          string
+	HeaderName    string
+	KeyID         string
+	SecretFile    string
+	TokenTTLInSec int
+}
+
+// ResponseSigningConfig holds the configuration related to the signing of the proxy responses
+type ResponseSigningConfig struct {
+	Enabled           bool
+	PrivateKeyPemFile string
+}
+
+// UpstreamProxiesConfig holds the addresses of other proxy instances to be used when the local observers are lagging
+// or unavailable
+type UpstreamProxiesConfig struct {
+	Addresses []string
+}
+
+// ShadowTrafficConfig holds the configuration related to the duplication of the read requests towards canary observers
+type ShadowTrafficConfig struct {
+	Enabled               bool
+	Percentage            int
+	MaxConcurrentRequests int
+	CanaryObservers       []*data.NodeData
+}
+
+// TopologySnapshotConfig holds the configuration of the observers topology snapshot persisted on disk
+type TopologySnapshotConfig struct {
+	Enabled           bool
+	FilePath          string
+	SaveIntervalInSec int
+	MaxAgeInSec       int
+}
+
+// ObserversCapabilitiesConfig holds the minimum node versions supporting the optional API capabilities of the observers
+type ObserversCapabilitiesConfig struct {
+	Enabled      bool
+	Capabilities []ObserverCapabilityConfig
+}
+
+// ObserverCapabilityConfig holds the minimum node version supporting an optional API capability
+type ObserverCapabilityConfig struct {
+	Name       string
+	MinVersion string
+}
+
+// HedgedRequestsConfig holds the settings of the hedged requests issued towards the observers by the read endpoints
+type HedgedRequestsConfig struct {
+	Enabled         bool
+	DelayPercentile float64
+	MinDelayInMs    int
+	MaxDelayInMs    int
+}
+
+// ResponseSizeLimitsConfig holds the maximum sizes of the observers responses, per class of observer endpoints
+type ResponseSizeLimitsConfig struct {
+	Enabled bool
+	Classes []ResponseSizeLimitClassConfig
+}
+
+// ResponseSizeLimitClassConfig holds the maximum size of the observers responses for the endpoints starting with one of
+// the listed paths. The oversized responses are either rejected or truncated
+type ResponseSizeLimitClassConfig struct {
+	Name           string
+	Paths          []string
+	MaxSizeInBytes int
+	Truncate       bool
+}
+
+// ObserversConsistencyConfig holds the configuration of the monitor cross-checking the block hashes reported by the
+// observers of each shard, which evicts from rotation the observers diverging from the shard quorum
+type ObserversConsistencyConfig struct {
+	Enabled               bool
+	NonceOffset           uint64
+	MinQuorum             int
+	EvictionDurationInSec int
+}
+
+// ShardsTopologyConfig holds the configuration of the automatic handling of the changes of the number of shards, which
+// refreshes the shard coordinator at epoch change and re-homes the observers to the shards they report
+type ShardsTopologyConfig struct {
+	Enabled       bool
+	UseHeartbeats bool
+}
+
+// DrainConfig holds the configuration related to the maintenance (drain) mode used before shutting down the proxy
+type DrainConfig struct {
+	ReadsWindowInSec     int
+	ShutdownTimeoutInSec int
+	WriteRoutes          []string
+}
+
+// SendTransactionQuorumConfig holds the configuration related to sending a transaction to more observers of the shard
+type SendTransactionQuorumConfig struct {
+	NumObservers        int
+	MinAcknowledgements int
+}
+
+// QuorumReadsConfig holds the configuration related to reading an account from more observers of the shard
+type QuorumReadsConfig struct {
+	NumObservers  int
+	MinAgreements int
+}
+
+// TransactionsPolicyConfig holds the allow and deny lists applied on the transactions relayed by the proxy
+type TransactionsPolicyConfig struct {
+	Enabled          bool
+	AllowedSenders   []string
+	DeniedSenders    []string
+	AllowedReceivers []string
+	DeniedReceivers  []string
+	AllowedFunctions []string
+	DeniedFunctions  []string
+	MaxValue         string
+}
+
+// DuplicateNonceCheckConfig holds the configuration of the early rejection of the transactions whose nonce was already used
+type DuplicateNonceCheckConfig struct {
+	Enabled bool
+}
+
+// SignatureCheckConfig holds the configuration of the verification of the signatures of the transactions by the proxy
+type SignatureCheckConfig struct {
+	Enabled bool
+}
+
+// ElasticSearchConfig holds the configuration of the Elasticsearch backend used for the transactions history
+type ElasticSearchConfig struct {
+	Enabled           bool
+	URL               string
+	Username          string
+	Password          string
+	RequestTimeoutSec int
+}
+
+// NonceManagerConfig holds the configuration of the nonce manager, which assigns the nonces of the transactions sent on
+// behalf of the hosted senders
+type NonceManagerConfig struct {
+	Enabled                bool
+	SendersPemFile         string
+	MaxReservationSize     uint64
+	MaxReservationTTLInSec int
+}
+
 // CredentialsConfig holds the credential pairs
 type CredentialsConfig struct {
 	Credentials []data.Credential
 	Hasher      TypeConfig
 }
+
+// FaultInjectionConfig holds the configuration of the fault injection (chaos) mode, used by the integrators for testing
+// their retry logic against the proxy
+type FaultInjectionConfig struct {
+	Enabled bool
+}
+
+// WebhooksConfig holds the configuration of the webhooks notified when the watched transactions reach a final status
+// and when the balance or the nonce of the watched addresses change
+type WebhooksConfig struct {
+	Enabled                    bool
+	PollingIntervalInMs        int
+	WatchTimeoutInSec          int
+	MaxWatchedTransactions     int
+	RequestTimeoutInSec        int
+	MaxDeliveryAttempts        int
+	MaxWatchedAddresses        int
+	AddressesCheckIntervalInMs int
+	Webhooks                   []WebhookConfig
+}
+
+// TransactionStatusWatcherConfig holds the settings of the component tracking the process status of the watched
+// transactions, shared by the transaction wait, the webhooks and the status streams
+type TransactionStatusWatcherConfig struct {
+	TickIntervalInMs          int
+	MaxWatchedTransactions    int
+	MaxQueriesPerShardPerTick int
+	MaxTransactionsPerStream  int
+	StreamTimeoutInSec        int
+}
+
+// TransactionWaitConfig holds the settings used when waiting for the execution of the sent transactions
+type TransactionWaitConfig struct {
+	Enabled             bool
+	PollingIntervalInMs int
+	DefaultTimeoutInSec int
+	MaxTimeoutInSec     int
+}
+
+// WebhookConfig holds the definition of a single webhook
+type WebhookConfig struct {
+	Name    string
+	URL     string
+	Senders []string
+}
+
+// ObserversFeedConfig holds the configuration of the outport (WebSocket) feed consumed from the designated observers
+type ObserversFeedConfig struct {
+	Enabled                bool
+	RetryDurationInSec     int
+	MaxTrackedTransactions int
+	Observers              []*data.NodeData
+}
+
+// BridgeConfig holds the settings of the bridge deposits aggregation
+type BridgeConfig struct {
+	MaxBatchesPerContract int
+	SafeContracts         []BridgeSafeContractConfig
+}
+
+// SCQueryCacheConfig holds the configuration of the cache of the smart contract query results, which serves the
+// identical view calls issued within the same block interval without reaching the observers
+type SCQueryCacheConfig struct {
+	Enabled           bool
+	TTLInMilliseconds int
+	MaxEntries        int
+}
+
+// HyperblockCacheConfig holds the configuration of the cache of the assembled hyperblocks
+type HyperblockCacheConfig struct {
+	Enabled              bool
+	MaxEntries           int
+	NonceEntriesTTLInSec int
+}
+
+// SCQueryGuardConfig holds the configuration of the cost accounting of the smart contract queries and of the limits
+// applied per contract, protecting the observers from the abusive view calls patterns
+type SCQueryGuardConfig struct {
+	Enabled                                  bool
+	MaxQueriesPerSecondPerContract           int
+	MaxExecutionTimePerSecondPerContractInMs int
+	MaxResponseSizeInBytes                   int
+	MaxTrackedContracts                      int
+}
+
+// CacheBackendConfig holds the configuration of the backend of the economics, heartbeats, validator statistics and
+// smart contract query caches
+type CacheBackendConfig struct {
+	Type  string
+	Redis RedisConfig
+}
+
+// RedisConfig holds the settings of the connection towards the Redis server shared by the proxy instances
+type RedisConfig struct {
+	Address              string
+	Password             string
+	Database             int
+	KeyPrefix            string
+	DialTimeoutInMs      int
+	OperationTimeoutInMs int
+	MaxIdleConnections   int
+	UseTLS               bool
+	CACertificateFile    string
+	ServerName           string
+}
+
+// ESDTSupplyHistoryConfig holds the configuration of the per-epoch history of the ESDT supplies, sampled by the proxy
+// from the supply endpoints of the observers
+type ESDTSupplyHistoryConfig struct {
+	Enabled             bool
+	Tokens              []string
+	MaxTrackedTokens    int
+	MaxEpochs           int
+	SampleIntervalInSec int
+}
+
+// BridgeSafeContractConfig holds the address of the bridge safe contract towards a chain
+type BridgeSafeContractConfig struct {
+	Chain   string
+	Address string
+}
+
+// AuditLogConfig holds the configuration related to the audit log of the mutating requests
+type AuditLogConfig struct {
+	Enabled         bool
+	Sink            string
+	FilePath        string
+	MaxFileSizeInMB int
+	MaxBackupFiles  int
+	SyslogNetwork   string
+	SyslogAddress   string
+	SyslogTag       string
+	Routes          []string
+}
+
+// ClientStatsConfig holds the configuration of the statistics of the transactions relayed on behalf of each client
+// key, identified by a request header
+type ClientStatsConfig struct {
+	Enabled                bool
+	KeyHeader              string
+	Routes                 []string
+	PollingIntervalInMs    int
+	WatchTimeoutInSec      int
+	MaxWatchedTransactions int
+	MaxClients             int
+	PersistenceFilePath    string
+	PersistIntervalInSec   int
+}
+
+// RuntimeConfigHandler defines a component able to apply the reloadable settings of the main config at runtime
+type RuntimeConfigHandler interface {
+	CheckConfig(cfg *Config) error
+	ApplyConfig(cfg *Config) error
+	IsInterfaceNil() bool
+}
+
+// AccessLogConfig holds the configuration of the structured JSON access log, replacing the default access log of the
+// web server
+type AccessLogConfig struct {
+	Enabled           bool
+	Sink              string
+	FilePath          string
+	MaxFileSizeInMB   int
+	MaxBackupFiles    int
+	DefaultSampleRate float64
+	AlwaysLogErrors   bool
+	Routes            []AccessLogRouteConfig
+}
+
+// AccessLogRouteConfig holds the sample rate of the access log entries of a route
+type AccessLogRouteConfig struct {
+	Route      string
+	SampleRate float64
+}
+
+// HeavyListenerConfig holds the configuration of the second web server listener, serving the heavy routes with their
+// own timeouts and concurrency limit, so their traffic can be firewalled and scaled independently of the main listener
+type HeavyListenerConfig struct {
+	Enabled               bool
+	Port                  int
+	Routes                []string
+	ReadTimeoutInSec      int
+	WriteTimeoutInSec     int
+	IdleTimeoutInSec      int
+	MaxConcurrentRequests int
+}
@@ -0,0 +1,19 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_ShippedConfigShouldLoad(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{}
+	err := core.LoadTomlFile(cfg, "../cmd/proxy/config/config.toml")
+	require.NoError(t, err)
+	require.NotEmpty(t, cfg.Observers)
+	require.Equal(t, 20.0, cfg.RateLimiter.RequestsPerSecond)
+}
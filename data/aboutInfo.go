@@ -21,3 +21,10 @@ type NodeVersionAPIResponse struct {
 	Error string `json:"error"`
 	Code  string `json:"code"`
 }
+
+// ProxyPublicKey defines the structure needed for exposing the public key used for signing the proxy responses
+type ProxyPublicKey struct {
+	PublicKey       string `json:"publicKey"`
+	SignatureScheme string `json:"signatureScheme"`
+	SignatureHeader string `json:"signatureHeader"`
+}
@@ -0,0 +1,23 @@
+package data
+
+// ObserverCall holds the details of a request sent towards an observer while serving a client request
+type ObserverCall struct {
+	Address    string `json:"address"`
+	StatusCode int    `json:"statusCode"`
+}
+
+// AccessLogEntry holds the record of a served request, as written in the access log
+type AccessLogEntry struct {
+	Timestamp      string          `json:"timestamp"`
+	RequestID      string          `json:"requestID"`
+	Method         string          `json:"method"`
+	Route          string          `json:"route"`
+	Path           string          `json:"path"`
+	ClientIP       string          `json:"clientIP"`
+	StatusCode     int             `json:"statusCode"`
+	LatencyMs      float64         `json:"latencyMs"`
+	ResponseSize   int             `json:"responseSize"`
+	Observers      []*ObserverCall `json:"observers,omitempty"`
+	UpstreamStatus int             `json:"upstreamStatus,omitempty"`
+	SampleRate     float64         `json:"sampleRate"`
+}
@@ -1,6 +1,12 @@
 package data
 
-import "github.com/multiversx/mx-chain-core-go/data/validator"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/validator"
+)
 
 // AccountModel defines an account model (with associated information)
 type AccountModel struct {
@@ -8,11 +14,73 @@ type AccountModel struct {
 	BlockInfo BlockInfo `json:"blockInfo"`
 }
 
+// AccountQuorumModel defines an account model read from more observers, along with the details of their agreement
+type AccountQuorumModel struct {
+	AccountModel
+	Quorum QuorumReadInfo `json:"quorum"`
+}
+
+// QuorumReadInfo holds the details of the agreement between the observers queried in a quorum read. The confidence is
+// the ratio between the observers agreeing on the returned value and the observers that responded
+type QuorumReadInfo struct {
+	NumResponses  int     `json:"numResponses"`
+	NumAgreements int     `json:"numAgreements"`
+	MinAgreements int     `json:"minAgreements"`
+	Confidence    float64 `json:"confidence"`
+}
+
 // AccountsModel defines the model of the accounts response
 type AccountsModel struct {
 	Accounts map[string]*Account `json:"accounts"`
 }
 
+// AddressesShardsResponse is a response holding the shards of a list of addresses
+type AddressesShardsResponse struct {
+	Data  AddressesShards `json:"data"`
+	Error string          `json:"error"`
+	Code  ReturnCode      `json:"code"`
+}
+
+// AddressesShards holds the shard of each address of a list and whether each pair of addresses is intra-shard
+type AddressesShards struct {
+	Addresses         []*AddressShard  `json:"addresses"`
+	Pairs             []*AddressesPair `json:"pairs"`
+	AreAllInSameShard bool             `json:"allInSameShard"`
+}
+
+// AddressShard holds the shard of an address
+type AddressShard struct {
+	Address string `json:"address"`
+	ShardID uint32 `json:"shardID"`
+}
+
+// AddressesPair holds whether two addresses are in the same shard
+type AddressesPair struct {
+	First        string `json:"first"`
+	Second       string `json:"second"`
+	IsIntraShard bool   `json:"isIntraShard"`
+}
+
+// GuardianDataModel defines the model of the guardian data of an account, as returned by the observers
+type GuardianDataModel struct {
+	GuardianData GuardianData `json:"guardianData"`
+	BlockInfo    BlockInfo    `json:"blockInfo"`
+}
+
+// GuardianData holds the active and pending guardians of an account and whether the account is guarded
+type GuardianData struct {
+	ActiveGuardian  *Guardian `json:"activeGuardian,omitempty"`
+	PendingGuardian *Guardian `json:"pendingGuardian,omitempty"`
+	Guarded         bool      `json:"guarded,omitempty"`
+}
+
+// Guardian holds the address of a guardian, the epoch it becomes active in and the co-signing service it belongs to
+type Guardian struct {
+	Address         string `json:"address"`
+	ActivationEpoch uint32 `json:"activationEpoch"`
+	ServiceUID      string `json:"serviceUID"`
+}
+
 // Account defines the data structure for an account
 type Account struct {
 	Address         string            `json:"address"`
@@ -75,3 +143,61 @@ type IterateKeysRequest struct {
 	NumKeys       uint     `json:"numKeys"`
 	IteratorState [][]byte `json:"iteratorState"`
 }
+
+// IterateKeysApiResponse defines the response of an observer for a request iterating the keys of an account
+type IterateKeysApiResponse struct {
+	Data  IterateKeysResponseData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+// IterateKeysResponseData follows the format of the data field of an iterate keys response
+type IterateKeysResponseData struct {
+	Pairs            map[string]string `json:"pairs"`
+	NewIteratorState [][]byte          `json:"newIteratorState"`
+	BlockInfo        BlockInfo         `json:"blockInfo"`
+}
+
+// AccountDataExport holds a chunk of the key-value pairs of the data trie of an account, along with the cursor from
+// which the export continues. An empty cursor means that the export is complete
+type AccountDataExport struct {
+	Pairs      map[string]string `json:"pairs"`
+	BlockInfo  BlockInfo         `json:"blockInfo"`
+	NextCursor string            `json:"nextCursor,omitempty"`
+}
+
+// AccountExportCursor holds the state of an account data trie export: the iterator state of the data trie and the
+// nonce of the block the export is pinned to, so all its chunks are read from the same state
+type AccountExportCursor struct {
+	IteratorState [][]byte `json:"iteratorState"`
+	BlockNonce    uint64   `json:"blockNonce"`
+}
+
+// EncodeAccountExportCursor returns the opaque, URL safe, representation of the provided cursor
+func EncodeAccountExportCursor(cursor *AccountExportCursor) (string, error) {
+	cursorBytes, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cursorBytes), nil
+}
+
+// DecodeAccountExportCursor returns the cursor out of its opaque representation
+func DecodeAccountExportCursor(encodedCursor string) (*AccountExportCursor, error) {
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(encodedCursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidAccountExportCursor, err.Error())
+	}
+
+	cursor := &AccountExportCursor{}
+	err = json.Unmarshal(cursorBytes, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidAccountExportCursor, err.Error())
+	}
+	if len(cursor.IteratorState) == 0 {
+		return nil, fmt.Errorf("%w, empty iterator state", ErrInvalidAccountExportCursor)
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,15 @@
+package data
+
+// AddressConversion holds an address in both the bech32 and the hex formats. The error is set instead if the provided
+// input is neither a valid bech32 address nor a valid hex public key
+type AddressConversion struct {
+	Input  string `json:"input"`
+	Bech32 string `json:"bech32,omitempty"`
+	Hex    string `json:"hex,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// AddressConversionRequest holds the addresses to be converted, in either the bech32 or the hex format
+type AddressConversionRequest struct {
+	Addresses []string `json:"addresses"`
+}
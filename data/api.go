@@ -20,6 +20,10 @@ type NetworkConfig struct {
 		MinGasLimit           uint64 `json:"erd_min_gas_limit"`
 		MinGasPrice           uint64 `json:"erd_min_gas_price"`
 		MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
+		GasPerDataByte        uint64 `json:"erd_gas_per_data_byte"`
+		GasPriceModifier      string `json:"erd_gas_price_modifier"`
+		ExtraGasLimitGuarded  uint64 `json:"erd_extra_gas_limit_guarded_tx"`
+		MaxGasPerTransaction  uint64 `json:"erd_max_gas_per_transaction"`
 	} `json:"config"`
 }
 
@@ -35,6 +39,10 @@ const (
 
 	// ReturnCodeRequestError defines a request which hasn't been executed successfully due to a bad request received
 	ReturnCodeRequestError ReturnCode = "bad_request"
+
+	// ReturnCodeNonceAlreadyUsed defines a transaction rejected because its nonce was already used by another
+	// transaction of the sender, either executed or waiting in the pool
+	ReturnCodeNonceAlreadyUsed ReturnCode = "nonce_already_used"
 )
 
 // VersionData holds the components specific for each version
@@ -46,9 +54,11 @@ type VersionData struct {
 
 // EndpointHandlerData holds the items needed for creating a new HTTP endpoint
 type EndpointHandlerData struct {
-	Path    string
-	Handler gin.HandlerFunc
-	Method  string
+	Path         string
+	Handler      gin.HandlerFunc
+	Method       string
+	Cacheability CacheabilityClass
+	WithETag     bool
 }
 
 // GroupHandler defines the actions that an api group handler should be able to do
@@ -86,6 +96,8 @@ type StatusMetricsProvider interface {
 	GetAll() map[string]*EndpointMetrics
 	GetMetricsForPrometheus() string
 	AddRequestData(path string, withError bool, duration time.Duration)
+	RegisterCache(name string, metricsHandler func() CacheMetrics)
+	GetCachesMetrics() map[string]*CacheMetrics
 	IsInterfaceNil() bool
 }
 
@@ -94,9 +106,12 @@ type ApiRoutesConfig struct {
 	APIPackages map[string]APIPackageConfig
 }
 
-// APIPackageConfig holds the configuration for the routes of each package
+// APIPackageConfig holds the configuration for the routes of each package, along with the client IP ranges allowed or
+// denied to reach them
 type APIPackageConfig struct {
-	Routes []RouteConfig
+	AllowedCIDRs []string
+	DeniedCIDRs  []string
+	Routes       []RouteConfig
 }
 
 // RouteConfig holds the configuration for a single route
@@ -112,3 +127,95 @@ type Credential struct {
 	Username string
 	Password string
 }
+
+// DrainStatus holds the maintenance (drain) status of the proxy
+type DrainStatus struct {
+	IsDraining              bool  `json:"isDraining"`
+	DrainStartTimestamp     int64 `json:"drainStartTimestamp,omitempty"`
+	ReadsWindowEndTimestamp int64 `json:"readsWindowEndTimestamp,omitempty"`
+	AcceptsReads            bool  `json:"acceptsReads"`
+	AcceptsWrites           bool  `json:"acceptsWrites"`
+}
+
+// ConfigReloadStatus holds the outcome of reloading the main config file at runtime
+type ConfigReloadStatus struct {
+	Observers            NodesReloadStatus `json:"observers"`
+	FullHistoryObservers NodesReloadStatus `json:"fullHistoryObservers"`
+}
+
+// NodesReloadStatus holds the outcome of reloading a list of nodes out of the main config file
+type NodesReloadStatus struct {
+	Reloaded    bool   `json:"reloaded"`
+	Description string `json:"description"`
+	Error       string `json:"error,omitempty"`
+}
+
+// FaultInjectionScenario holds the faults injected in the calls towards the observers. Each percentage is applied
+// independently, in the order: delay, error, truncated body
+type FaultInjectionScenario struct {
+	PathPrefixes       []string `json:"pathPrefixes,omitempty"`
+	DelayInMs          uint64   `json:"delayInMs,omitempty"`
+	DelayPercentage    int      `json:"delayPercentage,omitempty"`
+	ErrorStatusCode    int      `json:"errorStatusCode,omitempty"`
+	ErrorPercentage    int      `json:"errorPercentage,omitempty"`
+	TruncatePercentage int      `json:"truncatePercentage,omitempty"`
+	Seed               int64    `json:"seed,omitempty"`
+}
+
+// FaultInjectionStatus holds the fault injection state of the proxy
+type FaultInjectionStatus struct {
+	Enabled  bool                    `json:"enabled"`
+	Scenario *FaultInjectionScenario `json:"scenario"`
+}
+
+// WarmUpStatus holds the status of the warm-up phase run at startup, which pre-populates the caches of the proxy
+type WarmUpStatus struct {
+	IsFinished bool                `json:"isFinished"`
+	IsTimedOut bool                `json:"isTimedOut"`
+	Tasks      []*WarmUpTaskStatus `json:"tasks"`
+}
+
+// WarmUpTaskStatus holds the status of a single warm-up task
+type WarmUpTaskStatus struct {
+	Name        string `json:"name"`
+	IsWarm      bool   `json:"isWarm"`
+	NumAttempts int    `json:"numAttempts"`
+	LastError   string `json:"lastError,omitempty"`
+}
+
+// ObserverHealth holds the health details of an observer
+type ObserverHealth struct {
+	Address                 string   `json:"address"`
+	ShardID                 uint32   `json:"shardID"`
+	IsSynced                bool     `json:"isSynced"`
+	Tier                    uint32   `json:"tier"`
+	LastResponseTimestamp   int64    `json:"lastResponseTimestamp"`
+	IsHealthy               bool     `json:"isHealthy"`
+	Version                 string   `json:"version,omitempty"`
+	UnsupportedCapabilities []string `json:"unsupportedCapabilities,omitempty"`
+	EvictedUntilTimestamp   int64    `json:"evictedUntilTimestamp,omitempty"`
+	NumEvictions            uint64   `json:"numEvictions,omitempty"`
+}
+
+// ShardReadiness holds the readiness details of a shard. A shard is ready if at least one of its observers is healthy
+type ShardReadiness struct {
+	ShardID             uint32            `json:"shardID"`
+	IsReady             bool              `json:"isReady"`
+	NumObservers        int               `json:"numObservers"`
+	NumHealthyObservers int               `json:"numHealthyObservers"`
+	Observers           []*ObserverHealth `json:"observers"`
+}
+
+// ReadinessStatus holds the readiness status of the proxy
+type ReadinessStatus struct {
+	IsReady bool              `json:"isReady"`
+	WarmUp  *WarmUpStatus     `json:"warmUp"`
+	Shards  []*ShardReadiness `json:"shards"`
+}
+
+// LivenessStatus holds the liveness status of the proxy process
+type LivenessStatus struct {
+	IsAlive       bool  `json:"isAlive"`
+	UptimeInSec   int64 `json:"uptimeInSec"`
+	NumGoroutines int   `json:"numGoroutines"`
+}
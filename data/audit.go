@@ -0,0 +1,24 @@
+package data
+
+const (
+	// AuditOutcomeSuccess is the outcome of the audited requests served successfully
+	AuditOutcomeSuccess = "success"
+
+	// AuditOutcomeFailure is the outcome of the audited requests rejected or failed
+	AuditOutcomeFailure = "failure"
+)
+
+// AuditEntry holds the record of a mutating request, as written in the audit log
+type AuditEntry struct {
+	Timestamp  string   `json:"timestamp"`
+	Method     string   `json:"method"`
+	Path       string   `json:"path"`
+	ClientIP   string   `json:"clientIP"`
+	User       string   `json:"user,omitempty"`
+	TxHashes   []string `json:"txHashes,omitempty"`
+	Observers  []string `json:"observers,omitempty"`
+	StatusCode int      `json:"statusCode"`
+	Outcome    string   `json:"outcome"`
+	Error      string   `json:"error,omitempty"`
+	DurationMs int64    `json:"durationMs"`
+}
@@ -14,7 +14,38 @@ type BlockApiResponse struct {
 
 // BlockApiResponsePayload wraps a block
 type BlockApiResponsePayload struct {
-	Block api.Block `json:"block"`
+	Block          api.Block                   `json:"block"`
+	Shard          *uint32                     `json:"shard,omitempty"`
+	TokenTransfers map[string][]*TokenTransfer `json:"tokenTransfers,omitempty"`
+}
+
+// TokenTransfer holds an ESDT, SFT or NFT transfer decoded from the data field of a transaction
+type TokenTransfer struct {
+	Token      string `json:"token"`
+	Identifier string `json:"identifier"`
+	Nonce      uint64 `json:"nonce,omitempty"`
+	Amount     string `json:"amount"`
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+}
+
+// BlockFeesResponseData holds the fees collected in a block
+type BlockFeesResponseData struct {
+	Fees *BlockFees `json:"fees"`
+}
+
+// BlockFees holds the fees collected in a block and the part of them rewarded to the developers of the called smart
+// contracts. The epoch totals are only set for the metachain blocks
+type BlockFees struct {
+	Shard                  uint32 `json:"shard"`
+	Nonce                  uint64 `json:"nonce"`
+	Round                  uint64 `json:"round"`
+	Epoch                  uint32 `json:"epoch"`
+	Hash                   string `json:"hash"`
+	AccumulatedFees        string `json:"accumulatedFees"`
+	DeveloperFees          string `json:"developerFees"`
+	AccumulatedFeesInEpoch string `json:"accumulatedFeesInEpoch,omitempty"`
+	DeveloperFeesInEpoch   string `json:"developerFeesInEpoch,omitempty"`
 }
 
 // HyperblockApiResponse is a response holding a hyperblock
@@ -36,7 +67,8 @@ func NewHyperblockApiResponse(hyperblock api.Hyperblock) *HyperblockApiResponse
 
 // HyperblockApiResponsePayload wraps a hyperblock
 type HyperblockApiResponsePayload struct {
-	Hyperblock api.Hyperblock `json:"hyperblock"`
+	Hyperblock api.Hyperblock  `json:"hyperblock"`
+	Pagination *PaginationInfo `json:"pagination,omitempty"`
 }
 
 // InternalBlockApiResponse is a response holding an internal block
@@ -11,5 +11,6 @@ type BlocksApiResponse struct {
 
 // BlocksApiResponsePayload wraps a block
 type BlocksApiResponsePayload struct {
-	Blocks []*api.Block `json:"blocks"`
+	Blocks         []*api.Block                `json:"blocks"`
+	TokenTransfers map[string][]*TokenTransfer `json:"tokenTransfers,omitempty"`
 }
@@ -0,0 +1,34 @@
+package data
+
+const (
+	// BridgeDepositStatusPending is the status of the deposits whose batch was not yet executed on the destination chain
+	BridgeDepositStatusPending = "pending"
+
+	// BridgeDepositStatusExecuted is the status of the deposits whose batch was executed on the destination chain
+	BridgeDepositStatusExecuted = "executed"
+)
+
+// BridgeDepositsResponseData holds the bridge deposits of an address
+type BridgeDepositsResponseData struct {
+	Deposits *BridgeDeposits `json:"deposits"`
+}
+
+// BridgeDeposits holds the deposits of an address found in the recent batches of the bridge safe contracts
+type BridgeDeposits struct {
+	Address  string           `json:"address"`
+	Deposits []*BridgeDeposit `json:"deposits"`
+}
+
+// BridgeDeposit holds a deposit towards another chain, as stored in a batch of a bridge safe contract
+type BridgeDeposit struct {
+	Chain        string `json:"chain"`
+	SafeContract string `json:"safeContract"`
+	BatchID      uint64 `json:"batchId"`
+	DepositNonce uint64 `json:"depositNonce"`
+	BlockNonce   uint64 `json:"blockNonce"`
+	Sender       string `json:"sender"`
+	Recipient    string `json:"recipient"`
+	Token        string `json:"token"`
+	Amount       string `json:"amount"`
+	Status       string `json:"status"`
+}
@@ -0,0 +1,31 @@
+package data
+
+// CacheabilityClass defines for how long the response of an endpoint can be cached by the clients and by the
+// intermediate caches (CDNs, reverse proxies) placed in front of the proxy
+type CacheabilityClass string
+
+const (
+	// CacheabilityNoStore is the class of the responses that should never be cached, such as the transactions pool
+	// or the nonce of an account
+	CacheabilityNoStore CacheabilityClass = "no-store"
+
+	// CacheabilityShortLived is the class of the responses that change with every round, such as the account state
+	// or the latest blocks
+	CacheabilityShortLived CacheabilityClass = "short-lived"
+
+	// CacheabilityLongLived is the class of the responses that change at most once per epoch, such as the network
+	// configuration
+	CacheabilityLongLived CacheabilityClass = "long-lived"
+
+	// CacheabilityImmutable is the class of the responses that never change once produced, such as the blocks
+	// fetched by hash
+	CacheabilityImmutable CacheabilityClass = "immutable"
+)
+
+// CacheabilityContextKey is the key under which the cacheability class of the current endpoint is stored in the
+// context of the request
+const CacheabilityContextKey = "cacheability"
+
+// ETagContextKey is the key under which the request context holds the flag telling that the responses of the current
+// endpoint should carry an ETag
+const ETagContextKey = "withETag"
@@ -0,0 +1,14 @@
+package data
+
+// ClientStats holds the statistics of the transactions relayed on behalf of a client key. The untracked transactions
+// are the relayed ones whose final status is unknown, as they could not be watched or their watch was lost on a restart
+type ClientStats struct {
+	Relayed                  uint64 `json:"relayed"`
+	Executed                 uint64 `json:"executed"`
+	Failed                   uint64 `json:"failed"`
+	Expired                  uint64 `json:"expired"`
+	Untracked                uint64 `json:"untracked"`
+	Pending                  uint64 `json:"pending"`
+	AverageTimeToExecutionMs uint64 `json:"averageTimeToExecutionMs"`
+	LastRelayedTimestamp     int64  `json:"lastRelayedTimestamp"`
+}
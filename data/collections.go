@@ -0,0 +1,25 @@
+package data
+
+// CollectionNFT holds an NFT (or SFT, or meta ESDT) of a collection, as found in the indexer, along with its current
+// owner. The owner is only set for the non-fungible tokens, which are held by a single address
+type CollectionNFT struct {
+	Identifier string   `json:"identifier"`
+	Collection string   `json:"collection"`
+	Nonce      uint64   `json:"nonce"`
+	Type       string   `json:"type"`
+	Name       string   `json:"name,omitempty"`
+	Creator    string   `json:"creator,omitempty"`
+	Royalties  uint32   `json:"royalties"`
+	Hash       []byte   `json:"hash,omitempty"`
+	URIs       []string `json:"uris"`
+	Tags       []string `json:"tags,omitempty"`
+	Attributes []byte   `json:"attributes,omitempty"`
+	Owner      string   `json:"owner,omitempty"`
+	Timestamp  uint64   `json:"timestamp"`
+}
+
+// CollectionNFTs holds a page of the NFTs of a collection, sorted by their nonce
+type CollectionNFTs struct {
+	NFTs       []CollectionNFT `json:"nfts"`
+	Pagination *PaginationInfo `json:"pagination"`
+}
@@ -0,0 +1,43 @@
+package data
+
+// ConsensusGroupResponseData holds the consensus group of a round
+type ConsensusGroupResponseData struct {
+	Consensus *ConsensusGroup `json:"consensus"`
+}
+
+// ConsensusGroup holds the consensus group and the leader of a past round, as computed from the eligible validators of
+// the epoch and the randomness of the previous block
+type ConsensusGroup struct {
+	ShardID            uint32             `json:"shardId"`
+	Round              uint64             `json:"round"`
+	Epoch              uint32             `json:"epoch"`
+	Randomness         string             `json:"randomness"`
+	PrevBlockNonce     uint64             `json:"prevBlockNonce"`
+	PrevBlockRound     uint64             `json:"prevBlockRound"`
+	NumEligible        int                `json:"numEligible"`
+	ConsensusGroupSize uint32             `json:"consensusGroupSize"`
+	Leader             string             `json:"leader"`
+	Members            []*ConsensusMember `json:"members"`
+	BlockProduced      bool               `json:"blockProduced"`
+	BlockHash          string             `json:"blockHash,omitempty"`
+	BlockNonce         uint64             `json:"blockNonce,omitempty"`
+	NumSigners         int                `json:"numSigners"`
+}
+
+// ConsensusMember holds a validator selected in the consensus group of a round
+type ConsensusMember struct {
+	PublicKey string `json:"publicKey"`
+	Index     uint32 `json:"index"`
+	Rating    uint32 `json:"rating"`
+	Chance    uint32 `json:"chance"`
+	Signed    bool   `json:"signed"`
+}
+
+// ConsensusValidatorInfo holds the start of epoch info of a validator, as returned by the observers
+type ConsensusValidatorInfo struct {
+	PublicKey  []byte `json:"publicKey"`
+	ShardId    uint32 `json:"shardId"`
+	List       string `json:"list"`
+	Index      uint32 `json:"index"`
+	TempRating uint32 `json:"tempRating"`
+}
@@ -13,6 +13,50 @@ type DatabaseTransaction struct {
 	data.Transaction
 }
 
+// TransactionsHistory holds a page of the historical transactions of an address, sorted from the newest to the oldest
+type TransactionsHistory struct {
+	Transactions []DatabaseTransaction `json:"transactions"`
+	Pagination   *PaginationInfo       `json:"pagination"`
+}
+
+// Transfer types, as recorded in the operations index by the elastic indexer
+const (
+	TransferTypeTransaction         = "normal"
+	TransferTypeSmartContractResult = "unsigned"
+)
+
+// Transfer directions, relative to the address whose history is requested
+const (
+	TransferDirectionIncoming = "in"
+	TransferDirectionOutgoing = "out"
+	TransferDirectionSelf     = "self"
+)
+
+// Transfer holds a transaction or a smart contract result moving value to or from an address. The smart contract results
+// are linked to the transaction that generated them by the original transaction hash
+type Transfer struct {
+	Hash           string   `json:"hash"`
+	Type           string   `json:"type"`
+	Direction      string   `json:"direction"`
+	Nonce          uint64   `json:"nonce"`
+	Sender         string   `json:"sender"`
+	Receiver       string   `json:"receiver"`
+	Value          string   `json:"value"`
+	Tokens         []string `json:"tokens,omitempty"`
+	ESDTValues     []string `json:"esdtValues,omitempty"`
+	Data           []byte   `json:"data,omitempty"`
+	Function       string   `json:"function,omitempty"`
+	Status         string   `json:"status,omitempty"`
+	OriginalTxHash string   `json:"originalTxHash,omitempty"`
+	Timestamp      uint64   `json:"timestamp"`
+}
+
+// TransfersHistory holds a page of the historical transfers of an address, sorted from the newest to the oldest
+type TransfersHistory struct {
+	Transfers  []Transfer      `json:"transfers"`
+	Pagination *PaginationInfo `json:"pagination"`
+}
+
 // CalculateFee calculates transaction fee using gasPrice and gasUsed
 func (dt *DatabaseTransaction) CalculateFee() string {
 	gasPrice := big.NewInt(0).SetUint64(dt.GasPrice)
@@ -7,3 +7,13 @@ var ErrNilTransaction = errors.New("nil transaction")
 
 // ErrNilPubKeyConverter signals that a nil pub key converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrSCQueryLimitReached signals that the smart contract query was rejected, as its contract reached the configured
+// queries or execution time limits
+var ErrSCQueryLimitReached = errors.New("smart contract query limit reached")
+
+// ErrInvalidAccountExportCursor signals that an invalid account data export cursor has been provided
+var ErrInvalidAccountExportCursor = errors.New("invalid account export cursor")
+
+// ErrInvalidBlocksRange signals that an invalid blocks range has been provided
+var ErrInvalidBlocksRange = errors.New("invalid blocks range")
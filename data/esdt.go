@@ -1,11 +1,27 @@
 package data
 
+import "encoding/json"
+
 const (
 	FungibleTokens     = "fungible-tokens"
 	SemiFungibleTokens = "semi-fungible-tokens"
 	NonFungibleTokens  = "non-fungible-tokens"
 )
 
+const (
+	// ESDTTypeFungible is the type filter value matching the fungible tokens of an account
+	ESDTTypeFungible = "fungible"
+	// ESDTTypeNFT is the type filter value matching the non-fungible tokens of an account
+	ESDTTypeNFT = "nft"
+	// ESDTTypeSFT is the type filter value matching the semi-fungible tokens of an account
+	ESDTTypeSFT = "sft"
+	// ESDTTypeMeta is the type filter value matching the meta tokens of an account
+	ESDTTypeMeta = "meta"
+)
+
+// ValidESDTTypeFilters holds the valid values of the type filter used when listing the tokens of an account
+var ValidESDTTypeFilters = []string{ESDTTypeFungible, ESDTTypeNFT, ESDTTypeSFT, ESDTTypeMeta}
+
 // ValidTokenTypes holds a slice containing the valid esdt token types
 var ValidTokenTypes = []string{FungibleTokens, SemiFungibleTokens, NonFungibleTokens}
 
@@ -25,6 +41,67 @@ type ESDTSupply struct {
 	RecomputedSupply bool   `json:"recomputedSupply"`
 }
 
+// ESDTSupplyEpoch holds the supply of a token at the end of an epoch (or at its last sample, for the current epoch). The
+// Minted and Burned amounts are the ones of the epoch, computed against the previous recorded epoch, while the total
+// amounts are the ones reported by the observers. The first recorded epoch has no Minted and Burned amounts
+type ESDTSupplyEpoch struct {
+	Epoch       uint32 `json:"epoch"`
+	Supply      string `json:"supply"`
+	Minted      string `json:"minted,omitempty"`
+	Burned      string `json:"burned,omitempty"`
+	TotalMinted string `json:"totalMinted"`
+	TotalBurned string `json:"totalBurned"`
+}
+
+// ESDTSupplyHistory holds the per-epoch supplies of a token, from the oldest to the newest epoch
+type ESDTSupplyHistory struct {
+	Token  string             `json:"token"`
+	Epochs []*ESDTSupplyEpoch `json:"epochs"`
+}
+
+// ESDTSupplyHistoryResponse is a response holding the per-epoch supplies of a token
+type ESDTSupplyHistoryResponse struct {
+	Data  ESDTSupplyHistory `json:"data"`
+	Error string            `json:"error"`
+	Code  ReturnCode        `json:"code"`
+}
+
+// ESDTSuppliesRequest holds the tokens whose supplies are requested
+type ESDTSuppliesRequest struct {
+	Tokens []string `json:"tokens"`
+}
+
+// ESDTSuppliesResponse is a response holding the supplies of multiple esdt tokens
+type ESDTSuppliesResponse struct {
+	Data  ESDTSupplies `json:"data"`
+	Error string       `json:"error"`
+	Code  ReturnCode   `json:"code"`
+}
+
+// ESDTSupplies holds the supplies of multiple esdt tokens, mapped by their identifier
+type ESDTSupplies struct {
+	Supplies map[string]ESDTSupply `json:"supplies"`
+}
+
+// ESDTRolesResponse is a response holding the addresses with special roles of an esdt token
+type ESDTRolesResponse struct {
+	Data  ESDTRoles  `json:"data"`
+	Error string     `json:"error"`
+	Code  ReturnCode `json:"code"`
+}
+
+// ESDTRoles holds the special roles of an esdt token, both per address and per role
+type ESDTRoles struct {
+	Addresses []*ESDTAddressRoles `json:"addresses"`
+	Roles     map[string][]string `json:"roles"`
+}
+
+// ESDTAddressRoles holds the special roles of an esdt token set for an address
+type ESDTAddressRoles struct {
+	Address string   `json:"address"`
+	Roles   []string `json:"roles"`
+}
+
 // IsValidEsdtPath returns true if the provided path is a valid esdt token type
 func IsValidEsdtPath(path string) bool {
 	for _, tokenType := range ValidTokenTypes {
@@ -35,3 +112,86 @@ func IsValidEsdtPath(path string) bool {
 
 	return false
 }
+
+// AccountESDTTokensApiResponse follows the format of the observers' response holding all the ESDT tokens of an account
+type AccountESDTTokensApiResponse struct {
+	Data  AccountESDTTokensApiResponsePayload `json:"data"`
+	Error string                              `json:"error"`
+	Code  string                              `json:"code"`
+}
+
+// AccountESDTTokensApiResponsePayload holds the ESDT tokens of an account, mapped by their identifier
+type AccountESDTTokensApiResponsePayload struct {
+	ESDTs     map[string]json.RawMessage `json:"esdts"`
+	BlockInfo BlockInfo                  `json:"blockInfo"`
+}
+
+// AccountESDTTokensListResponse is a response holding a (filtered) list of ESDT tokens of an account
+type AccountESDTTokensListResponse struct {
+	Data  AccountESDTTokensList `json:"data"`
+	Error string                `json:"error"`
+	Code  ReturnCode            `json:"code"`
+}
+
+// AccountESDTTokensList holds the ESDT tokens of an account, sorted by their identifier
+type AccountESDTTokensList struct {
+	ESDTs      []json.RawMessage `json:"esdts"`
+	BlockInfo  BlockInfo         `json:"blockInfo"`
+	Pagination *PaginationInfo   `json:"pagination,omitempty"`
+}
+
+// IsValidESDTTypeFilter returns true if the provided value is a valid type filter for the tokens of an account
+func IsValidESDTTypeFilter(tokenType string) bool {
+	for _, validType := range ValidESDTTypeFilters {
+		if validType == tokenType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ESDTPendingIssuancesResponse is a response holding the token issuances not yet executed on the metachain
+type ESDTPendingIssuancesResponse struct {
+	Data  ESDTPendingIssuances `json:"data"`
+	Error string               `json:"error"`
+	Code  ReturnCode           `json:"code"`
+}
+
+// ESDTPendingIssuances holds the token issuances not yet executed on the metachain
+type ESDTPendingIssuances struct {
+	Issuances []*ESDTPendingIssuance `json:"issuances"`
+}
+
+// ESDTPendingIssuance holds an issuance transaction found in the pool of a metachain observer
+type ESDTPendingIssuance struct {
+	TxHash      string `json:"txHash"`
+	Sender      string `json:"sender"`
+	Nonce       uint64 `json:"nonce"`
+	Function    string `json:"function"`
+	TokenName   string `json:"tokenName"`
+	TokenTicker string `json:"tokenTicker"`
+	Value       string `json:"value"`
+}
+
+// ESDTOwnershipResponse is a response holding the owner of an esdt token
+type ESDTOwnershipResponse struct {
+	Data  ESDTOwnership `json:"data"`
+	Error string        `json:"error"`
+	Code  ReturnCode    `json:"code"`
+}
+
+// ESDTOwnership holds the current owner of an esdt token and the ownership transfers not yet executed
+type ESDTOwnership struct {
+	Token            string                          `json:"token"`
+	Owner            string                          `json:"owner"`
+	PendingTransfers []*ESDTPendingOwnershipTransfer `json:"pendingTransfers"`
+}
+
+// ESDTPendingOwnershipTransfer holds an ownership transfer transaction found in the pool of a metachain observer
+type ESDTPendingOwnershipTransfer struct {
+	TxHash   string `json:"txHash"`
+	Sender   string `json:"sender"`
+	Nonce    uint64 `json:"nonce"`
+	NewOwner string `json:"newOwner"`
+}
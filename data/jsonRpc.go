@@ -0,0 +1,43 @@
+package data
+
+import "encoding/json"
+
+// JsonRpcVersion is the only JSON-RPC protocol version accepted by the proxy
+const JsonRpcVersion = "2.0"
+
+// The standard JSON-RPC 2.0 error codes, along with the server error code used for the errors returned by the proxy
+const (
+	JsonRpcParseErrorCode     = -32700
+	JsonRpcInvalidRequestCode = -32600
+	JsonRpcMethodNotFoundCode = -32601
+	JsonRpcInvalidParamsCode  = -32602
+	JsonRpcServerErrorCode    = -32000
+)
+
+// JsonRpcRequest represents a JSON-RPC 2.0 request. The params can be either positional (array) or named (object).
+// A request without an id is a notification and does not receive a response
+type JsonRpcRequest struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// IsNotification returns true if the request does not hold an id
+func (request *JsonRpcRequest) IsNotification() bool {
+	return len(request.ID) == 0
+}
+
+// JsonRpcResponse represents a JSON-RPC 2.0 response. Exactly one of the result and the error fields is set
+type JsonRpcResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *JsonRpcError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// JsonRpcError represents the error object of a JSON-RPC 2.0 response
+type JsonRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
@@ -10,3 +10,17 @@ type EndpointMetrics struct {
 	LowestResponseTime  time.Duration `json:"lowest_response_time"`
 	HighestResponseTime time.Duration `json:"highest_response_time"`
 }
+
+// EconomicMetricsSample holds the economics metrics fetched from the observers at a given moment
+type EconomicMetricsSample struct {
+	Timestamp int64       `json:"timestamp"`
+	Metrics   interface{} `json:"metrics"`
+}
+
+// CacheMetrics holds the lookup statistics of a cache
+type CacheMetrics struct {
+	NumHits      uint64  `json:"num_hits"`
+	NumMisses    uint64  `json:"num_misses"`
+	NumEvictions uint64  `json:"num_evictions"`
+	HitRatio     float64 `json:"hit_ratio"`
+}
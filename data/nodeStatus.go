@@ -7,6 +7,15 @@ type HeartbeatResponse struct {
 	Heartbeats []PubKeyHeartbeat `json:"heartbeats"`
 }
 
+// HeartbeatChangesResponse holds the heartbeats that changed and the public keys that were removed since a given moment.
+// The timestamp (unix milliseconds) of the last heartbeats update should be provided as the starting moment of the next poll
+type HeartbeatChangesResponse struct {
+	Heartbeats        []PubKeyHeartbeat `json:"heartbeats"`
+	RemovedPublicKeys []string          `json:"removedPublicKeys"`
+	Timestamp         int64             `json:"timestamp"`
+	IsFullSnapshot    bool              `json:"isFullSnapshot"`
+}
+
 // HeartbeatApiResponse matches the output of an observer's heartbeat endpoint
 type HeartbeatApiResponse struct {
 	Data  HeartbeatResponse `json:"data"`
@@ -44,6 +53,11 @@ type NodeStatusResponse struct {
 	Nonce                uint64 `json:"erd_nonce"`
 	ProbableHighestNonce uint64 `json:"erd_probable_highest_nonce"`
 	AreVmQueriesReady    string `json:"erd_are_vm_queries_ready"`
+	AppVersion           string `json:"erd_app_version"`
+	EpochNumber          uint32 `json:"erd_epoch_number"`
+	// ShardID is nil when the node does not report its shard
+	ShardID            *uint32 `json:"erd_shard_id"`
+	PublicKeyBlockSign string  `json:"erd_public_key_block_sign"`
 }
 
 // NodeStatusAPIResponseData holds the mapping of the data field when returning the status of a node
@@ -63,6 +77,39 @@ type TrieStatisticsResponse struct {
 	AccountsSnapshotNumNodes uint64 `json:"accounts-snapshot-num-nodes"`
 }
 
+// SyncProgress holds the synchronization progress of the observers, grouped by shard
+type SyncProgress struct {
+	IsSynced bool                 `json:"isSynced"`
+	Shards   []*ShardSyncProgress `json:"shards"`
+}
+
+// ShardSyncProgress holds the synchronization progress of the observers of a shard
+type ShardSyncProgress struct {
+	ShardID              uint32                  `json:"shardID"`
+	IsSynced             bool                    `json:"isSynced"`
+	NumSyncedObservers   int                     `json:"numSyncedObservers"`
+	HighestNonce         uint64                  `json:"highestNonce"`
+	ProbableHighestNonce uint64                  `json:"probableHighestNonce"`
+	Observers            []*ObserverSyncProgress `json:"observers"`
+}
+
+// ObserverSyncProgress holds the synchronization, trie sync and processing metrics of an observer
+type ObserverSyncProgress struct {
+	Address                   string `json:"address"`
+	IsSynced                  bool   `json:"isSynced"`
+	IsSyncing                 bool   `json:"isSyncing"`
+	Nonce                     uint64 `json:"nonce"`
+	ProbableHighestNonce      uint64 `json:"probableHighestNonce"`
+	NoncesBehind              uint64 `json:"noncesBehind"`
+	CurrentRound              uint64 `json:"currentRound"`
+	SynchronizedRound         uint64 `json:"synchronizedRound"`
+	Epoch                     uint64 `json:"epoch"`
+	TrieSyncNumProcessedNodes uint64 `json:"trieSyncNumProcessedNodes"`
+	TrieSyncNumReceivedBytes  uint64 `json:"trieSyncNumReceivedBytes"`
+	AccountsSnapshotNumNodes  uint64 `json:"accountsSnapshotNumNodes"`
+	Error                     string `json:"error,omitempty"`
+}
+
 // TrieStatisticsAPIResponse represents the mapping of the response of a node's trie statistics
 type TrieStatisticsAPIResponse struct {
 	Data  TrieStatisticsResponse `json:"data"`
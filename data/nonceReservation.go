@@ -0,0 +1,26 @@
+package data
+
+// NonceReservationRequest holds the request for reserving a range of nonces of a hosted sender. A zero TTL stands for
+// the maximum TTL allowed by the proxy
+type NonceReservationRequest struct {
+	Sender    string `json:"sender"`
+	NumNonces uint64 `json:"numNonces"`
+	TTLInSec  int    `json:"ttlInSec,omitempty"`
+}
+
+// NonceReservation holds a range of nonces of a hosted sender, reserved until the ExpiresAt unix timestamp
+type NonceReservation struct {
+	ReservationID string `json:"reservationId"`
+	Sender        string `json:"sender"`
+	FromNonce     uint64 `json:"fromNonce"`
+	ToNonce       uint64 `json:"toNonce"`
+	ExpiresAt     int64  `json:"expiresAt"`
+}
+
+// NonceReleaseRequest holds the request for releasing a nonces reservation. The first NumUsed nonces of the reservation
+// were used by the workers, the rest of them are given back
+type NonceReleaseRequest struct {
+	Sender        string `json:"sender"`
+	ReservationID string `json:"reservationId"`
+	NumUsed       uint64 `json:"numUsed"`
+}
@@ -1,5 +1,7 @@
 package data
 
+import "net/http"
+
 // NodeData holds an observer data
 type NodeData struct {
 	ShardId        uint32
@@ -7,6 +9,45 @@ type NodeData struct {
 	IsSynced       bool
 	IsFallback     bool
 	IsSnapshotless bool
+	// Tier holds the priority tier of the node, 0 being the preferred one (usually the local datacenter). The nodes of
+	// a tier are only used when all the nodes of the better tiers are out of sync or fail to respond
+	Tier uint32
+	// MinEpoch and MaxEpoch hold the range of epochs a full history node holds the data for, so the requests referencing
+	// an epoch are routed towards the nodes holding it. A MaxEpoch of 0 means no upper bound, so a node without a
+	// range holds all the epochs
+	MinEpoch uint32
+	MaxEpoch uint32
+	// Version holds the application version reported by the node on the last status check
+	Version string
+	// UnsupportedCapabilities holds the optional API capabilities the node is known not to support
+	UnsupportedCapabilities []string
+}
+
+const (
+	// CapabilityTxPoolNonceGaps identifies the support for fetching the nonce gaps of a sender from the transactions pool
+	CapabilityTxPoolNonceGaps = "tx-pool-nonce-gaps"
+	// CapabilityBlockWithLogs identifies the support for fetching the blocks along with the logs of their transactions
+	CapabilityBlockWithLogs = "block-with-logs"
+)
+
+// SupportsCapability returns false only if the node is known not to support the provided API capability
+func (nd *NodeData) SupportsCapability(capability string) bool {
+	for _, unsupported := range nd.UnsupportedCapabilities {
+		if unsupported == capability {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HoldsEpoch returns true if the node holds the data of the provided epoch
+func (nd *NodeData) HoldsEpoch(epoch uint32) bool {
+	if epoch < nd.MinEpoch {
+		return false
+	}
+
+	return nd.MaxEpoch == 0 || epoch <= nd.MaxEpoch
 }
 
 // NodesReloadResponse is a DTO that holds details about nodes reloading
@@ -37,3 +78,38 @@ const (
 	// AvailabilityRecent means that the observer can be used only for recent data
 	AvailabilityRecent ObserverDataAvailabilityType = "recent"
 )
+
+// RawObserverRequest holds a request forwarded, as it is, to an observer
+type RawObserverRequest struct {
+	Method   string
+	Path     string
+	RawQuery string
+	Header   http.Header
+	Body     []byte
+}
+
+// TopologySnapshot holds the knowledge the proxy gathered about its observers, as persisted on disk
+type TopologySnapshot struct {
+	Timestamp        int64           `json:"timestamp"`
+	NumShards        uint32          `json:"numShards"`
+	Observers        []*NodeSnapshot `json:"observers"`
+	FullHistoryNodes []*NodeSnapshot `json:"fullHistoryNodes"`
+}
+
+// NodeSnapshot holds the persisted state of an observer
+type NodeSnapshot struct {
+	Address               string `json:"address"`
+	ShardID               uint32 `json:"shardID"`
+	IsSynced              bool   `json:"isSynced"`
+	IsFallback            bool   `json:"isFallback"`
+	IsSnapshotless        bool   `json:"isSnapshotless"`
+	LastResponseTimestamp int64  `json:"lastResponseTimestamp,omitempty"`
+}
+
+// ResponseTruncation holds the details of an observer response truncated because of its size. The items are the
+// elements of the top level lists of the response
+type ResponseTruncation struct {
+	Truncated   bool
+	NumSkipped  uint64
+	NumReturned uint64
+}
@@ -0,0 +1,58 @@
+package data
+
+// FeedMessageType defines the type of the messages exchanged with the observers feed
+type FeedMessageType int32
+
+const (
+	// FeedPayloadMessage is the type of the messages carrying an outport payload
+	FeedPayloadMessage FeedMessageType = 1
+	// FeedAckMessage is the type of the messages acknowledging a received payload
+	FeedAckMessage FeedMessageType = 2
+)
+
+// FeedMessage represents the envelope of the messages sent by the WebSocket host driver of an observer
+type FeedMessage struct {
+	WithAcknowledge bool            `json:"WithAcknowledge,omitempty"`
+	Counter         uint64          `json:"Counter,omitempty"`
+	Type            FeedMessageType `json:"Type,omitempty"`
+	Payload         []byte          `json:"Payload,omitempty"`
+	Topic           string          `json:"Topic,omitempty"`
+	Version         uint32          `json:"Version,omitempty"`
+}
+
+// FeedBlock holds the details of a block received through the observers feed
+type FeedBlock struct {
+	ShardID         uint32   `json:"shardID"`
+	Nonce           uint64   `json:"nonce"`
+	Round           uint64   `json:"round"`
+	Epoch           uint32   `json:"epoch"`
+	Hash            string   `json:"hash"`
+	Timestamp       uint64   `json:"timestamp"`
+	NumTxs          int      `json:"numTxs"`
+	IsFinalized     bool     `json:"isFinalized"`
+	ReceivedAt      int64    `json:"receivedAt"`
+	TxsHashes       []string `json:"-"`
+	InvalidTxHashes []string `json:"-"`
+}
+
+// BlockNotification holds the compact details of a new block received through the observers feed, so the indexers
+// can fetch the full block only when notified
+type BlockNotification struct {
+	ShardID uint32 `json:"shard"`
+	Nonce   uint64 `json:"nonce"`
+	Hash    string `json:"hash"`
+	Round   uint64 `json:"round"`
+	TxCount int    `json:"txCount"`
+}
+
+// LatestBlocksResponse represents the response of the latest blocks received through the observers feed
+type LatestBlocksResponse struct {
+	Data  LatestBlocksResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  ReturnCode               `json:"code"`
+}
+
+// LatestBlocksResponseData holds the latest block of each shard, as received through the observers feed
+type LatestBlocksResponseData struct {
+	Blocks []*FeedBlock `json:"blocks"`
+}
@@ -0,0 +1,27 @@
+package data
+
+// PaginationInfo holds the details of a paginated response
+type PaginationInfo struct {
+	Page       uint32 `json:"page"`
+	Size       uint32 `json:"size"`
+	TotalItems int    `json:"totalItems"`
+	TotalPages int    `json:"totalPages"`
+}
+
+// NewPaginationInfo returns the pagination details of the requested page, for a list with the provided number of items
+func NewPaginationInfo(page uint32, size uint32, numItems int) *PaginationInfo {
+	totalPages := 0
+	if size > 0 {
+		totalPages = numItems / int(size)
+		if numItems%int(size) != 0 {
+			totalPages++
+		}
+	}
+
+	return &PaginationInfo{
+		Page:       page,
+		Size:       size,
+		TotalItems: numItems,
+		TotalPages: totalPages,
+	}
+}
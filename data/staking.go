@@ -0,0 +1,91 @@
+package data
+
+// StakingPortfolioResponseData holds the staking portfolio of an address
+type StakingPortfolioResponseData struct {
+	Portfolio *StakingPortfolio `json:"stakingPortfolio"`
+}
+
+// StakingPortfolio holds the consolidated staking positions of an address
+type StakingPortfolio struct {
+	Address          string                  `json:"address"`
+	ValidatorStake   *ValidatorStakePosition `json:"validatorStake"`
+	LegacyDelegation *DelegationPosition     `json:"legacyDelegation,omitempty"`
+	Delegations      []*DelegationPosition   `json:"delegations"`
+	TotalActiveStake string                  `json:"totalActiveStake"`
+}
+
+// ValidatorStakePosition holds the stake of an address in the validator system smart contract
+type ValidatorStakePosition struct {
+	TotalStaked string `json:"totalStaked"`
+	TopUp       string `json:"topUp"`
+	NumNodes    uint64 `json:"numNodes"`
+}
+
+// DelegationPosition holds the position of an address in a delegation contract
+type DelegationPosition struct {
+	Contract         string `json:"contract"`
+	ActiveStake      string `json:"activeStake"`
+	UnStakedValue    string `json:"unStakedValue"`
+	ClaimableRewards string `json:"claimableRewards"`
+}
+
+// AccountDelegationsResponseData holds the delegations of an address
+type AccountDelegationsResponseData struct {
+	Delegations *AccountDelegations `json:"delegations"`
+}
+
+// AccountDelegations holds the aggregated positions of an address in all the staking providers it delegated to
+type AccountDelegations struct {
+	Address               string               `json:"address"`
+	Delegations           []*AccountDelegation `json:"delegations"`
+	TotalActiveStake      string               `json:"totalActiveStake"`
+	TotalUnDelegated      string               `json:"totalUnDelegated"`
+	TotalClaimableRewards string               `json:"totalClaimableRewards"`
+}
+
+// AccountDelegation holds the position of an address in a staking provider, along with its undelegation queue
+type AccountDelegation struct {
+	Contract         string              `json:"contract"`
+	ActiveStake      string              `json:"activeStake"`
+	ClaimableRewards string              `json:"claimableRewards"`
+	TotalUnDelegated string              `json:"totalUnDelegated"`
+	UnBondable       string              `json:"unBondable"`
+	UnDelegatedList  []*UnDelegatedValue `json:"unDelegatedList"`
+}
+
+// UnDelegatedValue holds an undelegated amount and the number of epochs left until it can be withdrawn
+type UnDelegatedValue struct {
+	Value           string `json:"value"`
+	RemainingEpochs uint64 `json:"remainingEpochs"`
+}
+
+// DelegationProvidersResponse is a response holding all the staking providers along with their metadata
+type DelegationProvidersResponse struct {
+	Data  DelegationProviders `json:"data"`
+	Error string              `json:"error"`
+	Code  ReturnCode          `json:"code"`
+}
+
+// DelegationProviders holds all the staking providers and the moment they were last fetched, in unix seconds
+type DelegationProviders struct {
+	Providers []*DelegationProvider `json:"providers"`
+	Timestamp int64                 `json:"timestamp"`
+}
+
+// DelegationProvider holds the metadata of a staking provider and the inputs needed to compute its APR. The service
+// fee is expressed in hundredths of a percent (1000 meaning 10%), while an empty maximum delegation cap means no cap
+type DelegationProvider struct {
+	Contract            string `json:"contract"`
+	Owner               string `json:"owner"`
+	Name                string `json:"name"`
+	Website             string `json:"website"`
+	Identity            string `json:"identity"`
+	ServiceFee          uint64 `json:"serviceFee"`
+	MaxDelegationCap    string `json:"maxDelegationCap"`
+	AutomaticActivation bool   `json:"automaticActivation"`
+	TotalActiveStake    string `json:"totalActiveStake"`
+	NumDelegators       uint64 `json:"numDelegators"`
+	TotalStaked         string `json:"totalStaked"`
+	TopUp               string `json:"topUp"`
+	NumNodes            uint64 `json:"numNodes"`
+}
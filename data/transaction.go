@@ -157,6 +157,25 @@ type ResponseTransactionSimulationCrossShard struct {
 type MultipleTransactionsResponseData struct {
 	NumOfTxs  uint64         `json:"txsSent"`
 	TxsHashes map[int]string `json:"txsHashes"`
+	FailedTxs map[int]string `json:"failedTxs,omitempty"`
+}
+
+// MultipleTransactionsDryRunResponseData holds the verdicts of a dry-run of a send-multiple request
+type MultipleTransactionsDryRunResponseData struct {
+	NumValid   int                         `json:"numValid"`
+	NumInvalid int                         `json:"numInvalid"`
+	Verdicts   []*TransactionDryRunVerdict `json:"verdicts"`
+}
+
+// TransactionDryRunVerdict holds the verdict of a transaction of a dry-run send-multiple request, in the order of the
+// request
+type TransactionDryRunVerdict struct {
+	Index       int         `json:"index"`
+	Valid       bool        `json:"valid"`
+	Hash        string      `json:"hash,omitempty"`
+	SenderShard *uint32     `json:"senderShard,omitempty"`
+	Error       string      `json:"error,omitempty"`
+	Simulation  interface{} `json:"simulation,omitempty"`
 }
 
 // ResponseMultipleTransactions defines a response from the node holding the number of transactions sent to the chain
@@ -170,16 +189,52 @@ type ResponseMultipleTransactions struct {
 type TxCostResponseData struct {
 	TxCost     uint64                                     `json:"txGasUnits"`
 	RetMessage string                                     `json:"returnMessage"`
+	ReturnData [][]byte                                   `json:"returnData,omitempty"`
 	ScResults  map[string]*ExtendedApiSmartContractResult `json:"smartContractResults"`
 	Logs       *transaction.ApiLogs                       `json:"logs,omitempty"`
 }
 
+// TxCostDetailedResponse holds the full breakdown of a transaction cost simulation
+type TxCostDetailedResponse struct {
+	TxCost       uint64                                     `json:"txGasUnits"`
+	RetMessage   string                                     `json:"returnMessage"`
+	ReturnData   [][]byte                                   `json:"returnData"`
+	GasBreakdown []*SCRGasBreakdown                         `json:"gasBreakdown"`
+	ScResults    map[string]*ExtendedApiSmartContractResult `json:"smartContractResults"`
+	Logs         *transaction.ApiLogs                       `json:"logs,omitempty"`
+}
+
+// SCRGasBreakdown holds the gas details of a smart contract result generated during a transaction cost simulation
+type SCRGasBreakdown struct {
+	Hash          string `json:"hash"`
+	Sender        string `json:"sender"`
+	Receiver      string `json:"receiver"`
+	Function      string `json:"function,omitempty"`
+	GasLimit      uint64 `json:"gasLimit"`
+	GasPrice      uint64 `json:"gasPrice"`
+	IsRefund      bool   `json:"isRefund"`
+	ReturnMessage string `json:"returnMessage,omitempty"`
+}
+
 // ExtendedApiSmartContractResult extends the structure transaction.ApiSmartContractResult with an extra field
 type ExtendedApiSmartContractResult struct {
 	*transaction.ApiSmartContractResult
 	Used bool `json:"-"`
 }
 
+// TransactionFeeResponseData holds the fee of a transaction, computed by the proxy from the economics parameters of the
+// network config. The move balance gas is paid at the full gas price, while the rest of the gas limit is paid at the
+// gas price reduced by the gas price modifier
+type TransactionFeeResponseData struct {
+	Fee                string  `json:"fee"`
+	GasLimit           uint64  `json:"gasLimit"`
+	GasPrice           uint64  `json:"gasPrice"`
+	MoveBalanceGas     uint64  `json:"moveBalanceGas"`
+	ProcessingGas      uint64  `json:"processingGas"`
+	ProcessingGasPrice uint64  `json:"processingGasPrice"`
+	GasPriceModifier   float64 `json:"gasPriceModifier"`
+}
+
 // ResponseTxCost defines a response from the node holding the transaction cost
 type ResponseTxCost struct {
 	Data  TxCostResponseData `json:"data"`
@@ -214,6 +269,8 @@ type TransactionsPool struct {
 	RegularTransactions  []WrappedTransaction `json:"regularTransactions"`
 	SmartContractResults []WrappedTransaction `json:"smartContractResults"`
 	Rewards              []WrappedTransaction `json:"rewards"`
+	Truncated            bool                 `json:"truncated,omitempty"`
+	NextCursor           uint64               `json:"nextCursor,omitempty"`
 }
 
 // TransactionsPoolResponseData matches the data field of get tx pool response
@@ -287,3 +344,30 @@ type ProcessStatusResponse struct {
 	Status string `json:"status"`
 	Reason string `json:"reason"`
 }
+
+// TransactionExecutionResult holds the outcome of a sent transaction which was awaited until executed. If the
+// transaction did not reach a final status in time, the last known status is returned and TimedOut is set
+type TransactionExecutionResult struct {
+	Status               string                                `json:"status"`
+	Reason               string                                `json:"reason,omitempty"`
+	TimedOut             bool                                  `json:"timedOut"`
+	SmartContractResults []*transaction.ApiSmartContractResult `json:"smartContractResults,omitempty"`
+	Logs                 *transaction.ApiLogs                  `json:"logs,omitempty"`
+}
+
+// TransactionStatusEvent represents the event emitted by the transactions status watcher once a watched transaction
+// reaches a final status, or once its watch timed out, in which case the last known status is provided
+type TransactionStatusEvent struct {
+	TxHash    string `json:"txHash"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason,omitempty"`
+	TimedOut  bool   `json:"timedOut"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// ApiTransactionResultV2 represents the shape of a transaction returned by the v2 API: the v1 fields, extended with the
+// status of the transaction after the processing of its results
+type ApiTransactionResultV2 struct {
+	*transaction.ApiTransactionResult
+	ProcessStatus *ProcessStatusResponse `json:"processStatus,omitempty"`
+}
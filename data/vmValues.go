@@ -41,3 +41,19 @@ type SCQuery struct {
 	BlockNonce     core.OptionalUint64
 	BlockHash      []byte
 }
+
+// SCQueryContractCost holds the cost of the smart contract queries of a contract, as accounted by the proxy. The
+// execution time is the one measured while waiting for the observers
+type SCQueryContractCost struct {
+	ScAddress                string `json:"scAddress"`
+	NumQueries               uint64 `json:"numQueries"`
+	NumRejected              uint64 `json:"numRejected"`
+	TotalExecutionTimeMs     uint64 `json:"totalExecutionTimeMs"`
+	AverageExecutionTimeMs   uint64 `json:"averageExecutionTimeMs"`
+	TotalResponseSizeInBytes uint64 `json:"totalResponseSizeInBytes"`
+}
+
+// SCQueryCosts holds the costs of the smart contract queries of the tracked contracts, the most expensive first
+type SCQueryCosts struct {
+	Contracts []SCQueryContractCost `json:"contracts"`
+}
@@ -0,0 +1,36 @@
+package data
+
+// WebhookWatchRequest represents the request used for registering a transaction, by hash, for a webhook
+type WebhookWatchRequest struct {
+	Webhook string `json:"webhook"`
+	TxHash  string `json:"txHash"`
+}
+
+// WebhookNotification represents the payload POSTed to a webhook once a watched transaction reaches a final status
+type WebhookNotification struct {
+	Webhook   string `json:"webhook"`
+	TxHash    string `json:"txHash"`
+	Sender    string `json:"sender,omitempty"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// AddressWatchlistRequest represents the request used for registering addresses on the watchlist of a webhook
+type AddressWatchlistRequest struct {
+	Webhook   string   `json:"webhook"`
+	Addresses []string `json:"addresses"`
+}
+
+// AddressChangeNotification represents the payload POSTed to a webhook once the balance or the nonce of a watched
+// address changes
+type AddressChangeNotification struct {
+	Webhook         string `json:"webhook"`
+	Address         string `json:"address"`
+	HyperblockNonce uint64 `json:"hyperblockNonce"`
+	PreviousBalance string `json:"previousBalance"`
+	Balance         string `json:"balance"`
+	PreviousNonce   uint64 `json:"previousNonce"`
+	Nonce           uint64 `json:"nonce"`
+	Timestamp       int64  `json:"timestamp"`
+}
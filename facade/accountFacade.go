@@ -0,0 +1,181 @@
+package facade
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var _ groups.AccountsFacadeHandler = (*AccountFacade)(nil)
+var _ groups.UtilsFacadeHandler = (*AccountFacade)(nil)
+
+// AccountFacade implements the accounts domain of the facade: the accounts state, their tokens, their staking
+// positions, their transactions history and the conversions of their addresses
+type AccountFacade struct {
+	accountProc          AccountProcessor
+	stakingPortfolioProc StakingPortfolioProcessor
+	delegationProc       DelegationProcessor
+	txsHistoryProc       TransactionsHistoryProcessor
+	addressConverter     AddressConverterProcessor
+}
+
+// ArgsAccountFacade holds the arguments needed for creating a AccountFacade
+type ArgsAccountFacade struct {
+	AccountProcessor             AccountProcessor
+	StakingPortfolioProcessor    StakingPortfolioProcessor
+	DelegationProcessor          DelegationProcessor
+	TransactionsHistoryProcessor TransactionsHistoryProcessor
+	AddressConverterProcessor    AddressConverterProcessor
+}
+
+// NewAccountFacade creates a new AccountFacade instance
+func NewAccountFacade(args ArgsAccountFacade) (*AccountFacade, error) {
+	if args.AccountProcessor == nil {
+		return nil, ErrNilAccountProcessor
+	}
+	if args.StakingPortfolioProcessor == nil {
+		return nil, ErrNilStakingPortfolioProcessor
+	}
+	if args.DelegationProcessor == nil {
+		return nil, ErrNilDelegationProcessor
+	}
+	if args.TransactionsHistoryProcessor == nil {
+		return nil, ErrNilTransactionsHistoryProcessor
+	}
+	if args.AddressConverterProcessor == nil {
+		return nil, ErrNilAddressConverterProcessor
+	}
+
+	return &AccountFacade{
+		accountProc:          args.AccountProcessor,
+		stakingPortfolioProc: args.StakingPortfolioProcessor,
+		delegationProc:       args.DelegationProcessor,
+		txsHistoryProc:       args.TransactionsHistoryProcessor,
+		addressConverter:     args.AddressConverterProcessor,
+	}, nil
+}
+
+// GetAccount returns an account based on the input address
+func (af *AccountFacade) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	return af.accountProc.GetAccount(ctx, address, options)
+}
+
+// GetAccountWithQuorum returns an account based on the input address, only if enough observers agree on its nonce and balance
+func (af *AccountFacade) GetAccountWithQuorum(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error) {
+	return af.accountProc.GetAccountWithQuorum(address, options)
+}
+
+// GetCodeHash returns the code hash for the given address
+func (af *AccountFacade) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetCodeHash(address, options)
+}
+
+// GetKeyValuePairs returns the key-value pairs for the given address
+func (af *AccountFacade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetKeyValuePairs(address, options)
+}
+
+// GetAccounts returns data about the provided addresses
+func (af *AccountFacade) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+	return af.accountProc.GetAccounts(addresses, options)
+}
+
+// GetValueForKey returns the value for the given address and key
+func (af *AccountFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+	return af.accountProc.GetValueForKey(address, key, options)
+}
+
+// GetGuardianData returns the guardian data for the given address
+func (af *AccountFacade) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetGuardianData(address, options)
+}
+
+// GetStakingPortfolio returns the consolidated staking positions of the given address
+func (af *AccountFacade) GetStakingPortfolio(address string, stakingProviders []string) (*data.GenericAPIResponse, error) {
+	return af.stakingPortfolioProc.GetStakingPortfolio(address, stakingProviders)
+}
+
+// GetAccountDelegations returns the active delegations, the undelegation queues and the claimable rewards of the given address
+func (af *AccountFacade) GetAccountDelegations(address string) (*data.GenericAPIResponse, error) {
+	return af.delegationProc.GetAccountDelegations(address)
+}
+
+// GetShardIDForAddress returns the computed shard ID for the given address based on the current proxy's configuration
+func (af *AccountFacade) GetShardIDForAddress(address string) (uint32, error) {
+	return af.accountProc.GetShardIDForAddress(address)
+}
+
+// GetESDTTokenData returns the token data for a given token name
+func (af *AccountFacade) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetESDTTokenData(address, key, options)
+}
+
+// GetESDTNftTokenData returns the token data for a given token name
+func (af *AccountFacade) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetESDTNftTokenData(address, key, nonce, options)
+}
+
+// GetESDTsWithRole returns the tokens where the given address has the assigned role
+func (af *AccountFacade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetESDTsWithRole(address, role, options)
+}
+
+// GetESDTsRoles returns the tokens and roles for the given address
+func (af *AccountFacade) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetESDTsRoles(address, options)
+}
+
+// GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
+func (af *AccountFacade) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetNFTTokenIDsRegisteredByAddress(address, options)
+}
+
+// GetAllESDTTokens returns all the ESDT tokens for a given address
+func (af *AccountFacade) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.GetAllESDTTokens(address, options)
+}
+
+// GetESDTTokensList returns the ESDT tokens of a given address matching the provided filter
+func (af *AccountFacade) GetESDTTokensList(
+	address string,
+	options common.AccountQueryOptions,
+	filter common.ESDTTokensFilterOptions,
+) (*data.AccountESDTTokensListResponse, error) {
+	return af.accountProc.GetESDTTokensList(address, options, filter)
+}
+
+// IsDataTrieMigrated returns true if the data trie for the given address is migrated
+func (af *AccountFacade) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.IsDataTrieMigrated(address, options)
+}
+
+// IterateKeys returns keys for the given address
+func (af *AccountFacade) IterateKeys(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return af.accountProc.IterateKeys(address, numKeys, iteratorState, options)
+}
+
+// ExportAccountData returns a chunk of the key-value pairs of the data trie of the given address
+func (af *AccountFacade) ExportAccountData(address string, exportOptions common.AccountExportOptions, options common.AccountQueryOptions) (*data.AccountDataExport, error) {
+	return af.accountProc.ExportAccountData(address, exportOptions, options)
+}
+
+// GetTransactionsHistory returns the historical transactions sent or received by the provided address
+func (af *AccountFacade) GetTransactionsHistory(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error) {
+	return af.txsHistoryProc.GetTransactionsHistory(address, options)
+}
+
+// GetTransfersHistory returns the historical transactions and smart contract results moving value to or from the provided address
+func (af *AccountFacade) GetTransfersHistory(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error) {
+	return af.txsHistoryProc.GetTransfersHistory(address, options)
+}
+
+// ConvertAddresses converts the provided addresses between the bech32 and the hex formats
+func (af *AccountFacade) ConvertAddresses(addresses []string) ([]data.AddressConversion, error) {
+	return af.addressConverter.ConvertAddresses(addresses)
+}
+
+// EncodeAddressAsHex returns the hex public key of the provided bech32 address
+func (af *AccountFacade) EncodeAddressAsHex(address string) (string, error) {
+	return af.addressConverter.EncodeAddressAsHex(address)
+}
@@ -1,11 +1,10 @@
 package facade
 
 import (
-	"encoding/json"
-	"math/big"
+	"context"
+	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/core"
-	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -24,280 +23,274 @@ var _ groups.TransactionFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.ValidatorFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.VmValuesFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.ProofFacadeHandler = (*ProxyFacade)(nil)
+var _ groups.ProxyFacadeHandler = (*ProxyFacade)(nil)
+var _ groups.CollectionsFacadeHandler = (*ProxyFacade)(nil)
+var _ groups.UtilsFacadeHandler = (*ProxyFacade)(nil)
+var _ groups.AdminFacadeHandler = (*ProxyFacade)(nil)
 
-// ProxyFacade implements the facade used in api calls
+// ProxyFacade implements the facade used in api calls. The transactions, the accounts and the network domains are
+// implemented by their own facades, embedded here
 type ProxyFacade struct {
-	actionsProc      ActionsProcessor
-	accountProc      AccountProcessor
-	txProc           TransactionProcessor
-	scQueryService   SCQueryService
-	nodeGroupProc    NodeGroupProcessor
-	valStatsProc     ValidatorStatisticsProcessor
-	faucetProc       FaucetProcessor
-	nodeStatusProc   NodeStatusProcessor
-	blockProc        BlockProcessor
-	blocksProc       BlocksProcessor
-	proofProc        ProofProcessor
-	esdtSuppliesProc ESDTSupplyProcessor
-	statusProc       StatusProcessor
+	*TxFacade
+	*AccountFacade
+	*NetworkFacade
+
+	actionsProc        ActionsProcessor
+	txProc             TransactionProcessor
+	scQueryService     SCQueryService
+	nodeGroupProc      NodeGroupProcessor
+	valStatsProc       ValidatorStatisticsProcessor
+	nodeStatusProc     NodeStatusProcessor
+	blockProc          BlockProcessor
+	blocksProc         BlocksProcessor
+	proofProc          ProofProcessor
+	statusProc         StatusProcessor
+	proxyPublicKeyProc ProxyPublicKeyProcessor
+	drainProc          DrainProcessor
+	faultInjectionProc FaultInjectionProcessor
+	rawPassThroughProc RawPassThroughProcessor
+	configReloadProc   ConfigReloadProcessor
+	bridgeProc         BridgeProcessor
+	collectionsProc    CollectionsProcessor
+	clientStatsProc    ClientStatsProcessor
 
 	pubKeyConverter core.PubkeyConverter
 	aboutInfoProc   AboutInfoProcessor
 }
 
-// NewProxyFacade creates a new ProxyFacade instance
-func NewProxyFacade(
-	actionsProc ActionsProcessor,
-	accountProc AccountProcessor,
-	txProc TransactionProcessor,
-	scQueryService SCQueryService,
-	nodeGroupProc NodeGroupProcessor,
-	valStatsProc ValidatorStatisticsProcessor,
-	faucetProc FaucetProcessor,
-	nodeStatusProc NodeStatusProcessor,
-	blockProc BlockProcessor,
-	blocksProc BlocksProcessor,
-	proofProc ProofProcessor,
-	pubKeyConverter core.PubkeyConverter,
-	esdtSuppliesProc ESDTSupplyProcessor,
-	statusProc StatusProcessor,
-	aboutInfoProc AboutInfoProcessor,
-) (*ProxyFacade, error) {
-	if actionsProc == nil {
+// ArgsProxyFacade holds the arguments needed for creating a ProxyFacade
+type ArgsProxyFacade struct {
+	ActionsProcessor             ActionsProcessor
+	AccountProcessor             AccountProcessor
+	TransactionProcessor         TransactionProcessor
+	SCQueryService               SCQueryService
+	NodeGroupProcessor           NodeGroupProcessor
+	ValidatorStatisticsProcessor ValidatorStatisticsProcessor
+	FaucetProcessor              FaucetProcessor
+	NodeStatusProcessor          NodeStatusProcessor
+	BlockProcessor               BlockProcessor
+	BlocksProcessor              BlocksProcessor
+	ProofProcessor               ProofProcessor
+	PubKeyConverter              core.PubkeyConverter
+	ESDTSuppliesProcessor        ESDTSupplyProcessor
+	StatusProcessor              StatusProcessor
+	AboutInfoProcessor           AboutInfoProcessor
+	ProxyPublicKeyProcessor      ProxyPublicKeyProcessor
+	StakingPortfolioProcessor    StakingPortfolioProcessor
+	DrainProcessor               DrainProcessor
+	TransactionsHistoryProcessor TransactionsHistoryProcessor
+	NonceManagerProcessor        NonceManagerProcessor
+	FaultInjectionProcessor      FaultInjectionProcessor
+	RawPassThroughProcessor      RawPassThroughProcessor
+	WebhooksProcessor            WebhooksProcessor
+	ObserversFeedProcessor       ObserversFeedProcessor
+	ConfigReloadProcessor        ConfigReloadProcessor
+	DelegationProcessor          DelegationProcessor
+	TransactionWaitProcessor     TransactionWaitProcessor
+	ESDTIssuanceProcessor        ESDTIssuanceProcessor
+	TransactionStatusWatcher     TransactionStatusWatcher
+	BridgeProcessor              BridgeProcessor
+	ConsensusProcessor           ConsensusProcessor
+	CollectionsProcessor         CollectionsProcessor
+	AddressConverterProcessor    AddressConverterProcessor
+	ClientStatsProcessor         ClientStatsProcessor
+}
+
+// NewProxyFacade creates a new ProxyFacade instance. The processors of the transactions, the accounts and the network
+// domains are checked by the constructors of their own facades
+func NewProxyFacade(args ArgsProxyFacade) (*ProxyFacade, error) {
+	if args.ActionsProcessor == nil {
 		return nil, ErrNilActionsProcessor
 	}
-	if accountProc == nil {
-		return nil, ErrNilAccountProcessor
-	}
-	if txProc == nil {
+	if args.TransactionProcessor == nil {
 		return nil, ErrNilTransactionProcessor
 	}
-	if scQueryService == nil {
+	if args.SCQueryService == nil {
 		return nil, ErrNilSCQueryService
 	}
-	if nodeGroupProc == nil {
+	if args.NodeGroupProcessor == nil {
 		return nil, ErrNilNodeGroupProcessor
 	}
-	if valStatsProc == nil {
+	if args.ValidatorStatisticsProcessor == nil {
 		return nil, ErrNilValidatorStatisticsProcessor
 	}
-	if faucetProc == nil {
-		return nil, ErrNilFaucetProcessor
-	}
-	if nodeStatusProc == nil {
+	if args.NodeStatusProcessor == nil {
 		return nil, ErrNilNodeStatusProcessor
 	}
-	if blockProc == nil {
+	if args.BlockProcessor == nil {
 		return nil, ErrNilBlockProcessor
 	}
-	if blocksProc == nil {
+	if args.BlocksProcessor == nil {
 		return nil, ErrNilBlocksProcessor
 	}
-	if proofProc == nil {
+	if args.ProofProcessor == nil {
 		return nil, ErrNilProofProcessor
 	}
-	if esdtSuppliesProc == nil {
-		return nil, ErrNilESDTSuppliesProcessor
-	}
-	if statusProc == nil {
+	if args.StatusProcessor == nil {
 		return nil, ErrNilStatusProcessor
 	}
-	if aboutInfoProc == nil {
+	if args.AboutInfoProcessor == nil {
 		return nil, ErrNilAboutInfoProcessor
 	}
+	if args.ProxyPublicKeyProcessor == nil {
+		return nil, ErrNilProxyPublicKeyProcessor
+	}
+	if args.DrainProcessor == nil {
+		return nil, ErrNilDrainProcessor
+	}
+	if args.FaultInjectionProcessor == nil {
+		return nil, ErrNilFaultInjectionProcessor
+	}
+	if args.RawPassThroughProcessor == nil {
+		return nil, ErrNilRawPassThroughProcessor
+	}
+	if args.ConfigReloadProcessor == nil {
+		return nil, ErrNilConfigReloadProcessor
+	}
+	if args.BridgeProcessor == nil {
+		return nil, ErrNilBridgeProcessor
+	}
+	if args.CollectionsProcessor == nil {
+		return nil, ErrNilCollectionsProcessor
+	}
+	if args.ClientStatsProcessor == nil {
+		return nil, ErrNilClientStatsProcessor
+	}
 
-	return &ProxyFacade{
-		actionsProc:      actionsProc,
-		accountProc:      accountProc,
-		txProc:           txProc,
-		scQueryService:   scQueryService,
-		nodeGroupProc:    nodeGroupProc,
-		valStatsProc:     valStatsProc,
-		faucetProc:       faucetProc,
-		nodeStatusProc:   nodeStatusProc,
-		blockProc:        blockProc,
-		blocksProc:       blocksProc,
-		proofProc:        proofProc,
-		pubKeyConverter:  pubKeyConverter,
-		esdtSuppliesProc: esdtSuppliesProc,
-		statusProc:       statusProc,
-		aboutInfoProc:    aboutInfoProc,
-	}, nil
-}
-
-// GetAccount returns an account based on the input address
-func (pf *ProxyFacade) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	return pf.accountProc.GetAccount(address, options)
-}
-
-// GetCodeHash returns the code hash for the given address
-func (pf *ProxyFacade) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetCodeHash(address, options)
-}
-
-// GetKeyValuePairs returns the key-value pairs for the given address
-func (pf *ProxyFacade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetKeyValuePairs(address, options)
-}
-
-// GetAccounts returns data about the provided addresses
-func (pf *ProxyFacade) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
-	return pf.accountProc.GetAccounts(addresses, options)
-}
-
-// GetValueForKey returns the value for the given address and key
-func (pf *ProxyFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
-	return pf.accountProc.GetValueForKey(address, key, options)
-}
-
-// GetGuardianData returns the guardian data for the given address
-func (pf *ProxyFacade) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetGuardianData(address, options)
-}
-
-// GetShardIDForAddress returns the computed shard ID for the given address based on the current proxy's configuration
-func (pf *ProxyFacade) GetShardIDForAddress(address string) (uint32, error) {
-	return pf.accountProc.GetShardIDForAddress(address)
-}
-
-// GetESDTTokenData returns the token data for a given token name
-func (pf *ProxyFacade) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTTokenData(address, key, options)
-}
-
-// GetESDTNftTokenData returns the token data for a given token name
-func (pf *ProxyFacade) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTNftTokenData(address, key, nonce, options)
-}
-
-// GetESDTsWithRole returns the tokens where the given address has the assigned role
-func (pf *ProxyFacade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTsWithRole(address, role, options)
-}
+	txFacade, err := NewTxFacade(ArgsTxFacade{
+		TransactionProcessor:     args.TransactionProcessor,
+		AccountProcessor:         args.AccountProcessor,
+		FaucetProcessor:          args.FaucetProcessor,
+		NodeStatusProcessor:      args.NodeStatusProcessor,
+		NonceManagerProcessor:    args.NonceManagerProcessor,
+		WebhooksProcessor:        args.WebhooksProcessor,
+		TransactionWaitProcessor: args.TransactionWaitProcessor,
+		TransactionStatusWatcher: args.TransactionStatusWatcher,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-// GetESDTsRoles returns the tokens and roles for the given address
-func (pf *ProxyFacade) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetESDTsRoles(address, options)
-}
+	accountFacade, err := NewAccountFacade(ArgsAccountFacade{
+		AccountProcessor:             args.AccountProcessor,
+		StakingPortfolioProcessor:    args.StakingPortfolioProcessor,
+		DelegationProcessor:          args.DelegationProcessor,
+		TransactionsHistoryProcessor: args.TransactionsHistoryProcessor,
+		AddressConverterProcessor:    args.AddressConverterProcessor,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-// GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
-func (pf *ProxyFacade) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetNFTTokenIDsRegisteredByAddress(address, options)
-}
+	networkFacade, err := NewNetworkFacade(ArgsNetworkFacade{
+		NodeStatusProcessor:    args.NodeStatusProcessor,
+		AccountProcessor:       args.AccountProcessor,
+		ESDTSuppliesProcessor:  args.ESDTSuppliesProcessor,
+		DelegationProcessor:    args.DelegationProcessor,
+		ObserversFeedProcessor: args.ObserversFeedProcessor,
+		ESDTIssuanceProcessor:  args.ESDTIssuanceProcessor,
+		ConsensusProcessor:     args.ConsensusProcessor,
+		BlockProcessor:         args.BlockProcessor,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-// GetAllESDTTokens returns all the ESDT tokens for a given address
-func (pf *ProxyFacade) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.GetAllESDTTokens(address, options)
+	return &ProxyFacade{
+		TxFacade:           txFacade,
+		AccountFacade:      accountFacade,
+		NetworkFacade:      networkFacade,
+		actionsProc:        args.ActionsProcessor,
+		txProc:             args.TransactionProcessor,
+		scQueryService:     args.SCQueryService,
+		nodeGroupProc:      args.NodeGroupProcessor,
+		valStatsProc:       args.ValidatorStatisticsProcessor,
+		nodeStatusProc:     args.NodeStatusProcessor,
+		blockProc:          args.BlockProcessor,
+		blocksProc:         args.BlocksProcessor,
+		proofProc:          args.ProofProcessor,
+		pubKeyConverter:    args.PubKeyConverter,
+		statusProc:         args.StatusProcessor,
+		aboutInfoProc:      args.AboutInfoProcessor,
+		proxyPublicKeyProc: args.ProxyPublicKeyProcessor,
+		drainProc:          args.DrainProcessor,
+		faultInjectionProc: args.FaultInjectionProcessor,
+		rawPassThroughProc: args.RawPassThroughProcessor,
+		configReloadProc:   args.ConfigReloadProcessor,
+		bridgeProc:         args.BridgeProcessor,
+		collectionsProc:    args.CollectionsProcessor,
+		clientStatsProc:    args.ClientStatsProcessor,
+	}, nil
 }
 
-// SendTransaction should send the transaction to the correct observer
-func (pf *ProxyFacade) SendTransaction(tx *data.Transaction) (int, string, error) {
-	return pf.txProc.SendTransaction(tx)
+// ReloadObservers will try to reload the observers
+func (pf *ProxyFacade) ReloadObservers() data.NodesReloadResponse {
+	return pf.actionsProc.ReloadObservers()
 }
 
-// SendMultipleTransactions should send the transactions to the correct observers
-func (pf *ProxyFacade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
-	return pf.txProc.SendMultipleTransactions(txs)
+// ReloadFullHistoryObservers will try to reload the full history observers
+func (pf *ProxyFacade) ReloadFullHistoryObservers() data.NodesReloadResponse {
+	return pf.actionsProc.ReloadFullHistoryObservers()
 }
 
-// SimulateTransaction should send the transaction to the correct observer for simulation
-func (pf *ProxyFacade) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
-	return pf.txProc.SimulateTransaction(tx, checkSignature)
+// StartDrain will switch the proxy in drain mode, rejecting the write requests
+func (pf *ProxyFacade) StartDrain() *data.DrainStatus {
+	return pf.drainProc.StartDrain()
 }
 
-// TransactionCostRequest should return how many gas units a transaction will cost
-func (pf *ProxyFacade) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
-	return pf.txProc.TransactionCostRequest(tx)
+// GetDrainStatus returns the current drain status of the proxy
+func (pf *ProxyFacade) GetDrainStatus() *data.DrainStatus {
+	return pf.drainProc.GetDrainStatus()
 }
 
-// GetTransactionStatus should return transaction status
-func (pf *ProxyFacade) GetTransactionStatus(txHash string, sender string) (string, error) {
-	return pf.txProc.GetTransactionStatus(txHash, sender)
+// SetFaultInjectionScenario sets the scenario of the faults injected in the observers calls
+func (pf *ProxyFacade) SetFaultInjectionScenario(scenario *data.FaultInjectionScenario) error {
+	return pf.faultInjectionProc.SetScenario(scenario)
 }
 
-// GetProcessedTransactionStatus should return transaction status after internal processing of the transaction results
-func (pf *ProxyFacade) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
-	return pf.txProc.GetProcessedTransactionStatus(txHash)
+// ReloadConfig reloads the main config file, applying the observers lists and the settings that can change at runtime
+func (pf *ProxyFacade) ReloadConfig() (*data.ConfigReloadStatus, error) {
+	return pf.configReloadProc.ReloadConfig()
 }
 
-// GetTransaction should return a transaction by hash
-func (pf *ProxyFacade) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	return pf.txProc.GetTransaction(txHash, withResults)
+// GetFaultInjectionStatus returns the fault injection state of the proxy
+func (pf *ProxyFacade) GetFaultInjectionStatus() *data.FaultInjectionStatus {
+	return pf.faultInjectionProc.GetStatus()
 }
 
-// ReloadObservers will try to reload the observers
-func (pf *ProxyFacade) ReloadObservers() data.NodesReloadResponse {
-	return pf.actionsProc.ReloadObservers()
+// ForwardRawObserverRequest forwards the raw request to an observer of the provided shard
+func (pf *ProxyFacade) ForwardRawObserverRequest(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error) {
+	return pf.rawPassThroughProc.ForwardRequest(ctx, shardID, request)
 }
 
-// ReloadFullHistoryObservers will try to reload the full history observers
-func (pf *ProxyFacade) ReloadFullHistoryObservers() data.NodesReloadResponse {
-	return pf.actionsProc.ReloadFullHistoryObservers()
+// GetBridgeDeposits returns the deposits of the provided address found in the bridge safe contracts
+func (pf *ProxyFacade) GetBridgeDeposits(address string) (*data.GenericAPIResponse, error) {
+	return pf.bridgeProc.GetBridgeDeposits(address)
 }
 
-// GetTransactionByHashAndSenderAddress should return a transaction by hash and sender address
-func (pf *ProxyFacade) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
-	return pf.txProc.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+// GetCollectionNFTs returns the requested page of the NFTs of the collection, along with their current owners
+func (pf *ProxyFacade) GetCollectionNFTs(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error) {
+	return pf.collectionsProc.GetCollectionNFTs(collection, options)
 }
 
-// IsFaucetEnabled returns true if the faucet mechanism is enabled or false otherwise
-func (pf *ProxyFacade) IsFaucetEnabled() bool {
-	return pf.faucetProc.IsEnabled()
+// IsClientStatsEnabled returns true if the statistics of the transactions relayed on behalf of the client keys are enabled
+func (pf *ProxyFacade) IsClientStatsEnabled() bool {
+	return pf.clientStatsProc.IsEnabled()
 }
 
-// SendUserFunds should send a transaction to load one user's account with extra funds from an account in the pem file
-func (pf *ProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
-	senderSk, senderPk, err := pf.faucetProc.SenderDetailsFromPem(receiver)
-	if err != nil {
-		return err
-	}
-
-	senderAccount, err := pf.accountProc.GetAccount(senderPk, common.AccountQueryOptions{})
-	if err != nil {
-		return err
-	}
-
-	networkCfg, err := pf.getNetworkConfig()
-	if err != nil {
-		return err
-	}
-
-	tx, err := pf.faucetProc.GenerateTxForSendUserFunds(
-		senderSk,
-		senderPk,
-		senderAccount.Account.Nonce,
-		receiver,
-		value,
-		networkCfg,
-	)
-	if err != nil {
-		return err
-	}
-
-	_, _, err = pf.txProc.SendTransaction(tx)
-	return err
+// GetClientStats returns the statistics of the transactions relayed on behalf of the client key
+func (pf *ProxyFacade) GetClientStats(clientKey string) (*data.ClientStats, error) {
+	return pf.clientStatsProc.GetClientStats(clientKey)
 }
 
-func (pf *ProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
-	genericResponse, err := pf.nodeStatusProc.GetNetworkConfigMetrics()
-	if err != nil {
-		return nil, err
-	}
-
-	networkConfigBytes, err := json.Marshal(&genericResponse.Data)
-	if err != nil {
-		return nil, err
-	}
-
-	networkCfg := &data.NetworkConfig{}
-	err = json.Unmarshal(networkConfigBytes, networkCfg)
-
-	return networkCfg, err
+// GetSCQueryCosts returns the cost of the smart contract queries of each tracked contract
+func (pf *ProxyFacade) GetSCQueryCosts() *data.SCQueryCosts {
+	return pf.scQueryService.GetQueryCosts()
 }
 
 // ExecuteSCQuery retrieves data from existing SC trie through the use of a VM
-func (pf *ProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
-	return pf.scQueryService.ExecuteQuery(query)
+func (pf *ProxyFacade) ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	return pf.scQueryService.ExecuteQuery(ctx, query)
 }
 
 // GetHeartbeatData retrieves the heartbeat status from one observer
@@ -305,64 +298,49 @@ func (pf *ProxyFacade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
 	return pf.nodeGroupProc.GetHeartbeatData()
 }
 
-// GetNetworkConfigMetrics retrieves the node's configuration's metrics
-func (pf *ProxyFacade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetNetworkConfigMetrics()
-}
-
-// GetNetworkStatusMetrics retrieves the node's network metrics for a given shard
-func (pf *ProxyFacade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetNetworkStatusMetrics(shardID)
-}
-
-// GetESDTSupply retrieves the supply for the provided token
-func (pf *ProxyFacade) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
-	return pf.esdtSuppliesProc.GetESDTSupply(token)
+// GetHeartbeatChanges retrieves the heartbeats that changed since the provided moment
+func (pf *ProxyFacade) GetHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+	return pf.nodeGroupProc.GetHeartbeatChanges(sinceMillis)
 }
 
-// GetEconomicsDataMetrics retrieves the node's network metrics for a given shard
-func (pf *ProxyFacade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetEconomicsDataMetrics()
+// GetBlockByHash retrieves the block by hash for a given shard
+func (pf *ProxyFacade) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetBlockByHash(ctx, shardID, hash, options)
 }
 
-// GetDelegatedInfo retrieves the node's network delegated info
-func (pf *ProxyFacade) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetDelegatedInfo()
+// GetBlockByHashFromAnyShard retrieves the block by hash, searching it in all the shards
+func (pf *ProxyFacade) GetBlockByHashFromAnyShard(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetBlockByHashFromAnyShard(hash, options)
 }
 
-// GetDirectStakedInfo retrieves the node's direct staked values
-func (pf *ProxyFacade) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetDirectStakedInfo()
+// GetBlockByNonce retrieves the block by nonce for a given shard
+func (pf *ProxyFacade) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return pf.blockProc.GetBlockByNonce(ctx, shardID, nonce, options)
 }
 
-// GetAllIssuedESDTs retrieves all the issued ESDTs from the node
-func (pf *ProxyFacade) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetAllIssuedESDTs(tokenType)
+// GetBlockProtobufByNonce returns the protocol representation of the block with the given nonce
+func (pf *ProxyFacade) GetBlockProtobufByNonce(shardID uint32, nonce uint64) ([]byte, error) {
+	return pf.blockProc.GetBlockProtobufByNonce(shardID, nonce)
 }
 
-// GetEnableEpochsMetrics retrieves the activation epochs
-func (pf *ProxyFacade) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetEnableEpochsMetrics()
+// GetBlockProtobufByHash returns the protocol representation of the block with the given hash
+func (pf *ProxyFacade) GetBlockProtobufByHash(shardID uint32, hash string) ([]byte, error) {
+	return pf.blockProc.GetBlockProtobufByHash(shardID, hash)
 }
 
-// GetRatingsConfig retrieves the node's configuration's metrics
-func (pf *ProxyFacade) GetRatingsConfig() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetRatingsConfig()
+// GetBlocksByRound retrieves the blocks for a given round
+func (pf *ProxyFacade) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	return pf.blocksProc.GetBlocksByRound(round, options)
 }
 
-// GetBlockByHash retrieves the block by hash for a given shard
-func (pf *ProxyFacade) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return pf.blockProc.GetBlockByHash(shardID, hash, options)
+// GetBlocksByRoundRange retrieves the blocks for all the rounds in the given interval
+func (pf *ProxyFacade) GetBlocksByRoundRange(startRound uint64, endRound uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	return pf.blocksProc.GetBlocksByRoundRange(startRound, endRound, options)
 }
 
-// GetBlockByNonce retrieves the block by nonce for a given shard
-func (pf *ProxyFacade) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return pf.blockProc.GetBlockByNonce(shardID, nonce, options)
-}
-
-// GetBlocksByRound retrieves the blocks for a given round
-func (pf *ProxyFacade) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
-	return pf.blocksProc.GetBlocksByRound(round, options)
+// GetBlocksByNonceRange retrieves the blocks of a given shard for all the nonces in the given interval
+func (pf *ProxyFacade) GetBlocksByNonceRange(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	return pf.blockProc.GetBlocksByNonceRange(shardID, startNonce, endNonce, options)
 }
 
 // GetInternalBlockByHash retrieves the internal block by hash for a given shard
@@ -395,6 +373,11 @@ func (pf *ProxyFacade) GetHyperBlockByNonce(nonce uint64, options common.Hyperbl
 	return pf.blockProc.GetHyperBlockByNonce(nonce, options)
 }
 
+// GetHyperBlockByTimestamp retrieves the hyperblock whose round holds the provided unix timestamp
+func (pf *ProxyFacade) GetHyperBlockByTimestamp(timestamp int64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	return pf.blockProc.GetHyperBlockByTimestamp(timestamp, options)
+}
+
 // ValidatorStatistics will return the statistics from an observer
 func (pf *ProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
 	valStats, err := pf.valStatsProc.GetValidatorStatistics()
@@ -405,6 +388,11 @@ func (pf *ProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiRespo
 	return valStats.Statistics, nil
 }
 
+// ValidatorStatisticsForKey will return the statistics of the validator with the provided BLS key
+func (pf *ProxyFacade) ValidatorStatisticsForKey(blsKey string) (*data.ValidatorApiResponse, error) {
+	return pf.valStatsProc.GetValidatorStatisticsForKey(blsKey)
+}
+
 // AuctionList will return the auction list
 func (epf *ProxyFacade) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
 	auctionList, err := epf.valStatsProc.GetAuctionList()
@@ -430,36 +418,11 @@ func (pf *ProxyFacade) ComputeTransactionHash(tx *data.Transaction) (string, err
 	return pf.txProc.ComputeTransactionHash(tx)
 }
 
-// GetTransactionsPool returns all txs from pool
-func (pf *ProxyFacade) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
-	return pf.txProc.GetTransactionsPool(fields)
-}
-
-// GetTransactionsPoolForShard returns all txs from shard's pool
-func (pf *ProxyFacade) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
-	return pf.txProc.GetTransactionsPoolForShard(shardID, fields)
-}
-
-// GetTransactionsPoolForSender returns tx pool for sender
-func (pf *ProxyFacade) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	return pf.txProc.GetTransactionsPoolForSender(sender, fields)
-}
-
-// GetLastPoolNonceForSender returns last nonce from tx pool for sender
-func (pf *ProxyFacade) GetLastPoolNonceForSender(sender string) (uint64, error) {
-	return pf.txProc.GetLastPoolNonceForSender(sender)
-}
-
 // IsOldStorageForToken returns true is the storage for a given token is old
 func (pf *ProxyFacade) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
 	return pf.nodeGroupProc.IsOldStorageForToken(tokenID, nonce)
 }
 
-// GetTransactionsPoolNonceGapsForSender returns all nonce gaps from tx pool for sender
-func (pf *ProxyFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	return pf.txProc.GetTransactionsPoolNonceGapsForSender(sender)
-}
-
 // GetProof returns the Merkle proof for the given address
 func (pf *ProxyFacade) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
 	return pf.proofProc.GetProof(rootHash, address)
@@ -475,6 +438,17 @@ func (pf *ProxyFacade) GetProofCurrentRootHash(address string) (*data.GenericAPI
 	return pf.proofProc.GetProofCurrentRootHash(address)
 }
 
+// GetVerifiedProof returns the Merkle proof for the given address, after verifying it against the root hash
+func (pf *ProxyFacade) GetVerifiedProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.GetVerifiedProof(rootHash, address)
+}
+
+// GetVerifiedProofCurrentRootHash returns the Merkle proof for the given address, after verifying it against the
+// current root hash
+func (pf *ProxyFacade) GetVerifiedProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+	return pf.proofProc.GetVerifiedProofCurrentRootHash(address)
+}
+
 // VerifyProof verifies the given Merkle proof
 func (pf *ProxyFacade) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	return pf.proofProc.VerifyProof(rootHash, address, proof)
@@ -485,21 +459,16 @@ func (pf *ProxyFacade) GetMetrics() map[string]*data.EndpointMetrics {
 	return pf.statusProc.GetMetrics()
 }
 
+// GetCachesMetrics will return the hits, the misses and the evictions of each cache
+func (pf *ProxyFacade) GetCachesMetrics() map[string]*data.CacheMetrics {
+	return pf.statusProc.GetCachesMetrics()
+}
+
 // GetMetricsForPrometheus will return the status metrics in a prometheus format
 func (pf *ProxyFacade) GetMetricsForPrometheus() string {
 	return pf.statusProc.GetMetricsForPrometheus()
 }
 
-// GetGenesisNodesPubKeys retrieves the node's configuration public keys
-func (pf *ProxyFacade) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetGenesisNodesPubKeys()
-}
-
-// GetGasConfigs retrieves the current gas schedule configs
-func (pf *ProxyFacade) GetGasConfigs() (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetGasConfigs()
-}
-
 // GetAboutInfo will return the app info
 func (pf *ProxyFacade) GetAboutInfo() (*data.GenericAPIResponse, error) {
 	return pf.aboutInfoProc.GetAboutInfo(), nil
@@ -520,16 +489,6 @@ func (pf *ProxyFacade) GetAlteredAccountsByHash(shardID uint32, hash string, opt
 	return pf.blockProc.GetAlteredAccountsByHash(shardID, hash, options)
 }
 
-// GetTriesStatistics will return trie statistics
-func (pf *ProxyFacade) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
-	return pf.nodeStatusProc.GetTriesStatistics(shardID)
-}
-
-// GetEpochStartData retrieves epoch start data for the provides epoch and shard ID
-func (pf *ProxyFacade) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
-	return pf.nodeStatusProc.GetEpochStartData(epoch, shardID)
-}
-
 // GetInternalStartOfEpochValidatorsInfo retrieves the validators info by epoch
 func (pf *ProxyFacade) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	return pf.blockProc.GetInternalStartOfEpochValidatorsInfo(epoch)
@@ -540,12 +499,7 @@ func (epf *ProxyFacade) GetWaitingEpochsLeftForPublicKey(publicKey string) (*dat
 	return epf.nodeGroupProc.GetWaitingEpochsLeftForPublicKey(publicKey)
 }
 
-// IsDataTrieMigrated returns true if the data trie for the given address is migrated
-func (pf *ProxyFacade) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.IsDataTrieMigrated(address, options)
-}
-
-// IterateKeys returns keys for the given address
-func (pf *ProxyFacade) IterateKeys(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	return pf.accountProc.IterateKeys(address, numKeys, iteratorState, options)
+// GetProxyPublicKey returns the public key used for signing the proxy responses
+func (pf *ProxyFacade) GetProxyPublicKey() (*data.GenericAPIResponse, error) {
+	return pf.proxyPublicKeyProc.GetProxyPublicKey()
 }
@@ -1,8 +1,10 @@
 package facade_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
+	"net/http"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
@@ -26,326 +28,205 @@ type testStruct struct {
 
 var publicKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
 
-func TestNewProxyFacade_NilActionsProcShouldErr(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		nil,
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilActionsProcessor, err)
-}
-
-func TestNewProxyFacade_NilAccountProcShouldErr(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		nil,
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilAccountProcessor, err)
-}
-
-func TestNewProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		nil,
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilTransactionProcessor, err)
-}
-
-func TestNewProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		nil,
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilSCQueryService, err)
-}
-
-func TestNewProxyFacade_NilNodeGroupProcShouldErr(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		nil,
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilNodeGroupProcessor, err)
-}
-
-func TestNewProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		nil,
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilValidatorStatisticsProcessor, err)
-}
-
-func TestNewProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		nil,
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilFaucetProcessor, err)
-}
-
-func TestNewProxyFacade_NilNodeProcessor(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		nil,
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilNodeStatusProcessor, err)
-}
-
-func TestNewProxyFacade_NilBlocksProcessor(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		nil,
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilBlocksProcessor, err)
-}
-
-func TestNewProxyFacade_NilProofProcessor(t *testing.T) {
-	t.Parallel()
-
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		nil,
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilProofProcessor, err)
+func createMockArgsProxyFacade() facade.ArgsProxyFacade {
+	return facade.ArgsProxyFacade{
+		ActionsProcessor:             &mock.ActionsProcessorStub{},
+		AccountProcessor:             &mock.AccountProcessorStub{},
+		TransactionProcessor:         &mock.TransactionProcessorStub{},
+		SCQueryService:               &mock.SCQueryServiceStub{},
+		NodeGroupProcessor:           &mock.NodeGroupProcessorStub{},
+		ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
+		FaucetProcessor:              &mock.FaucetProcessorStub{},
+		NodeStatusProcessor:          &mock.NodeStatusProcessorStub{},
+		BlockProcessor:               &mock.BlockProcessorStub{},
+		BlocksProcessor:              &mock.BlocksProcessorStub{},
+		ProofProcessor:               &mock.ProofProcessorStub{},
+		PubKeyConverter:              publicKeyConverter,
+		ESDTSuppliesProcessor:        &mock.ESDTSuppliesProcessorStub{},
+		StatusProcessor:              &mock.StatusProcessorStub{},
+		AboutInfoProcessor:           &mock.AboutInfoProcessorStub{},
+		ProxyPublicKeyProcessor:      &mock.ProxyPublicKeyProcessorStub{},
+		StakingPortfolioProcessor:    &mock.StakingPortfolioProcessorStub{},
+		DrainProcessor:               &mock.DrainProcessorStub{},
+		TransactionsHistoryProcessor: &mock.TransactionsHistoryProcessorStub{},
+		NonceManagerProcessor:        &mock.NonceManagerProcessorStub{},
+		FaultInjectionProcessor:      &mock.FaultInjectionProcessorStub{},
+		RawPassThroughProcessor:      &mock.RawPassThroughProcessorStub{},
+		WebhooksProcessor:            &mock.WebhooksProcessorStub{},
+		ObserversFeedProcessor:       &mock.ObserversFeedProcessorStub{},
+		ConfigReloadProcessor:        &mock.ConfigReloadProcessorStub{},
+		DelegationProcessor:          &mock.DelegationProcessorStub{},
+		TransactionWaitProcessor:     &mock.TransactionWaitProcessorStub{},
+		ESDTIssuanceProcessor:        &mock.ESDTIssuanceProcessorStub{},
+		TransactionStatusWatcher:     &mock.TransactionStatusWatcherStub{},
+		BridgeProcessor:              &mock.BridgeProcessorStub{},
+		ConsensusProcessor:           &mock.ConsensusProcessorStub{},
+		CollectionsProcessor:         &mock.CollectionsProcessorStub{},
+		AddressConverterProcessor:    &mock.AddressConverterProcessorStub{},
+		ClientStatsProcessor:         &mock.ClientStatsProcessorStub{},
+	}
 }
 
-func TestNewProxyFacade_NilStatusProcessorShouldErr(t *testing.T) {
+func TestNewProxyFacade_NilArgumentShouldErr(t *testing.T) {
 	t.Parallel()
 
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		nil,
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilStatusProcessor, err)
-}
-
-func TestNewProxyFacade_NilAboutInfoProcessorShouldErr(t *testing.T) {
-	t.Parallel()
+	testCases := map[string]struct {
+		setNilArgument func(args *facade.ArgsProxyFacade)
+		expectedErr    error
+	}{
+		"nil actions processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ActionsProcessor = nil },
+			expectedErr:    facade.ErrNilActionsProcessor,
+		},
+		"nil account processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.AccountProcessor = nil },
+			expectedErr:    facade.ErrNilAccountProcessor,
+		},
+		"nil transaction processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.TransactionProcessor = nil },
+			expectedErr:    facade.ErrNilTransactionProcessor,
+		},
+		"nil sc query service": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.SCQueryService = nil },
+			expectedErr:    facade.ErrNilSCQueryService,
+		},
+		"nil node group processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.NodeGroupProcessor = nil },
+			expectedErr:    facade.ErrNilNodeGroupProcessor,
+		},
+		"nil validator statistics processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ValidatorStatisticsProcessor = nil },
+			expectedErr:    facade.ErrNilValidatorStatisticsProcessor,
+		},
+		"nil faucet processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.FaucetProcessor = nil },
+			expectedErr:    facade.ErrNilFaucetProcessor,
+		},
+		"nil node status processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.NodeStatusProcessor = nil },
+			expectedErr:    facade.ErrNilNodeStatusProcessor,
+		},
+		"nil block processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.BlockProcessor = nil },
+			expectedErr:    facade.ErrNilBlockProcessor,
+		},
+		"nil blocks processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.BlocksProcessor = nil },
+			expectedErr:    facade.ErrNilBlocksProcessor,
+		},
+		"nil proof processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ProofProcessor = nil },
+			expectedErr:    facade.ErrNilProofProcessor,
+		},
+		"nil esdt supplies processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ESDTSuppliesProcessor = nil },
+			expectedErr:    facade.ErrNilESDTSuppliesProcessor,
+		},
+		"nil status processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.StatusProcessor = nil },
+			expectedErr:    facade.ErrNilStatusProcessor,
+		},
+		"nil about info processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.AboutInfoProcessor = nil },
+			expectedErr:    facade.ErrNilAboutInfoProcessor,
+		},
+		"nil proxy public key processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ProxyPublicKeyProcessor = nil },
+			expectedErr:    facade.ErrNilProxyPublicKeyProcessor,
+		},
+		"nil staking portfolio processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.StakingPortfolioProcessor = nil },
+			expectedErr:    facade.ErrNilStakingPortfolioProcessor,
+		},
+		"nil drain processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.DrainProcessor = nil },
+			expectedErr:    facade.ErrNilDrainProcessor,
+		},
+		"nil transactions history processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.TransactionsHistoryProcessor = nil },
+			expectedErr:    facade.ErrNilTransactionsHistoryProcessor,
+		},
+		"nil nonce manager processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.NonceManagerProcessor = nil },
+			expectedErr:    facade.ErrNilNonceManagerProcessor,
+		},
+		"nil fault injection processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.FaultInjectionProcessor = nil },
+			expectedErr:    facade.ErrNilFaultInjectionProcessor,
+		},
+		"nil raw pass through processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.RawPassThroughProcessor = nil },
+			expectedErr:    facade.ErrNilRawPassThroughProcessor,
+		},
+		"nil webhooks processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.WebhooksProcessor = nil },
+			expectedErr:    facade.ErrNilWebhooksProcessor,
+		},
+		"nil observers feed processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ObserversFeedProcessor = nil },
+			expectedErr:    facade.ErrNilObserversFeedProcessor,
+		},
+		"nil config reload processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ConfigReloadProcessor = nil },
+			expectedErr:    facade.ErrNilConfigReloadProcessor,
+		},
+		"nil delegation processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.DelegationProcessor = nil },
+			expectedErr:    facade.ErrNilDelegationProcessor,
+		},
+		"nil transaction wait processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.TransactionWaitProcessor = nil },
+			expectedErr:    facade.ErrNilTransactionWaitProcessor,
+		},
+		"nil esdt issuance processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ESDTIssuanceProcessor = nil },
+			expectedErr:    facade.ErrNilESDTIssuanceProcessor,
+		},
+		"nil transaction status watcher": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.TransactionStatusWatcher = nil },
+			expectedErr:    facade.ErrNilTransactionStatusWatcher,
+		},
+		"nil bridge processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.BridgeProcessor = nil },
+			expectedErr:    facade.ErrNilBridgeProcessor,
+		},
+		"nil consensus processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ConsensusProcessor = nil },
+			expectedErr:    facade.ErrNilConsensusProcessor,
+		},
+		"nil collections processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.CollectionsProcessor = nil },
+			expectedErr:    facade.ErrNilCollectionsProcessor,
+		},
+		"nil address converter processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.AddressConverterProcessor = nil },
+			expectedErr:    facade.ErrNilAddressConverterProcessor,
+		},
+		"nil client stats processor": {
+			setNilArgument: func(args *facade.ArgsProxyFacade) { args.ClientStatsProcessor = nil },
+			expectedErr:    facade.ErrNilClientStatsProcessor,
+		},
+	}
 
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		nil,
-	)
-
-	assert.Nil(t, epf)
-	assert.Equal(t, facade.ErrNilAboutInfoProcessor, err)
+	for name, testCase := range testCases {
+		tc := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			args := createMockArgsProxyFacade()
+			tc.setNilArgument(&args)
+			epf, err := facade.NewProxyFacade(args)
+			assert.Nil(t, epf)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
 }
 
 func TestNewProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	args := createMockArgsProxyFacade()
+	epf, err := facade.NewProxyFacade(args)
 
 	assert.NotNil(t, epf)
 	assert.Nil(t, err)
@@ -370,30 +251,16 @@ func TestNewProxyFacade_GetBlocksByRound(t *testing.T) {
 	}
 
 	errGetBlockByRound := errors.New("could not get block by round")
-	epf, err := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{
-			GetBlocksByRoundCalled: func(round uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
-				if round == 4 {
-					return expectedResponse, nil
-				}
-				return nil, errGetBlockByRound
-			},
+	args := createMockArgsProxyFacade()
+	args.BlocksProcessor = &mock.BlocksProcessorStub{
+		GetBlocksByRoundCalled: func(round uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+			if round == 4 {
+				return expectedResponse, nil
+			}
+			return nil, errGetBlockByRound
 		},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, err := facade.NewProxyFacade(args)
 	require.NoError(t, err)
 
 	ret, err := epf.GetBlocksByRound(3, common.BlockQueryOptions{WithTransactions: true})
@@ -409,30 +276,16 @@ func TestProxyFacade_GetAccount(t *testing.T) {
 	t.Parallel()
 
 	wasCalled := false
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{
-			GetAccountCalled: func(address string, options common.AccountQueryOptions) (account *data.AccountModel, e error) {
-				wasCalled = true
-				return &data.AccountModel{}, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.AccountProcessor = &mock.AccountProcessorStub{
+		GetAccountCalled: func(_ context.Context, address string, options common.AccountQueryOptions) (account *data.AccountModel, e error) {
+			wasCalled = true
+			return &data.AccountModel{}, nil
 		},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	_, _ = epf.GetAccount("", common.AccountQueryOptions{})
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	_, _ = epf.GetAccount(context.Background(), "", common.AccountQueryOptions{})
 
 	assert.True(t, wasCalled)
 }
@@ -441,119 +294,282 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 	t.Parallel()
 
 	wasCalled := false
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{
-			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
-				wasCalled = true
-
-				return 0, "", nil
-			},
+	args := createMockArgsProxyFacade()
+	args.TransactionProcessor = &mock.TransactionProcessorStub{
+		SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			wasCalled = true
+
+			return 0, "", nil
 		},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	_, _, _ = epf.SendTransaction(&data.Transaction{})
 
 	assert.True(t, wasCalled)
 }
 
+func TestProxyFacade_SendMultipleTransactionsShouldRegisterTheSentTransactions(t *testing.T) {
+	t.Parallel()
+
+	registeredTransactions := make(map[string]string)
+	args := createMockArgsProxyFacade()
+	args.TransactionProcessor = &mock.TransactionProcessorStub{
+		SendMultipleTransactionsCalled: func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+			// the second transaction is not sent, so the third one is reported at index 1
+			return data.MultipleTransactionsResponseData{
+				NumOfTxs:  2,
+				TxsHashes: map[int]string{0: "hash-erd1first", 1: "hash-erd1third"},
+			}, nil
+		},
+		ComputeTransactionHashCalled: func(tx *data.Transaction) (string, error) {
+			return "hash-" + tx.Sender, nil
+		},
+	}
+	args.WebhooksProcessor = &mock.WebhooksProcessorStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		RegisterSentTransactionCalled: func(sender string, txHash string) {
+			registeredTransactions[txHash] = sender
+		},
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	txs := []*data.Transaction{{Sender: "erd1first"}, {Sender: "erd1second"}, {Sender: "erd1third"}}
+	_, err := epf.SendMultipleTransactions(txs)
+	require.NoError(t, err)
+
+	expectedTransactions := map[string]string{
+		"hash-erd1first": "erd1first",
+		"hash-erd1third": "erd1third",
+	}
+	assert.Equal(t, expectedTransactions, registeredTransactions)
+}
+
 func TestProxyFacade_SimulateTransaction(t *testing.T) {
 	t.Parallel()
 
 	wasCalled := false
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{
-			SimulateTransactionCalled: func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
-				wasCalled = true
-				return nil, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.TransactionProcessor = &mock.TransactionProcessorStub{
+		SimulateTransactionCalled: func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+			wasCalled = true
+			return nil, nil
 		},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	_, _ = epf.SimulateTransaction(&data.Transaction{}, false)
 
 	assert.True(t, wasCalled)
 }
 
-func TestProxyFacade_SendUserFunds(t *testing.T) {
+func TestProxyFacade_DryRunMultipleTransactions(t *testing.T) {
 	t.Parallel()
 
 	wasCalled := false
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{
-			GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-				return &data.AccountModel{
-					Account: data.Account{
-						Nonce: uint64(0),
-					},
-				}, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.TransactionProcessor = &mock.TransactionProcessorStub{
+		DryRunMultipleTransactionsCalled: func(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData {
+			wasCalled = true
+			assert.True(t, options.Simulate)
+			return nil
 		},
-		&mock.TransactionProcessorStub{
-			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
-				wasCalled = true
-				return 0, "", nil
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	_ = epf.DryRunMultipleTransactions([]*data.Transaction{{}}, common.TransactionsDryRunOptions{Simulate: true})
+
+	assert.True(t, wasCalled)
+}
+
+func TestProxyFacade_SendRawTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	createFacade := func(txProc *mock.TransactionProcessorStub, webhooksProc *mock.WebhooksProcessorStub) *facade.ProxyFacade {
+		args := createMockArgsProxyFacade()
+		args.TransactionProcessor = txProc
+		args.WebhooksProcessor = webhooksProc
+		epf, _ := facade.NewProxyFacade(args)
+
+		return epf
+	}
+
+	t.Run("decode error should be a bad request", func(t *testing.T) {
+		t.Parallel()
+
+		epf := createFacade(
+			&mock.TransactionProcessorStub{
+				DecodeRawTransactionCalled: func(txBytes []byte) (*data.Transaction, error) {
+					return nil, expectedErr
+				},
+				SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+					assert.Fail(t, "should have not been called")
+					return 0, "", nil
+				},
 			},
-		},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{
-			SenderDetailsFromPemCalled: func(receiver string) (crypto.PrivateKey, string, error) {
-				return getPrivKey(), "rcvr", nil
+			&mock.WebhooksProcessorStub{},
+		)
+
+		statusCode, txHash, err := epf.SendRawTransaction([]byte("raw"))
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+		assert.Empty(t, txHash)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should send the decoded transaction", func(t *testing.T) {
+		t.Parallel()
+
+		decodedTx := &data.Transaction{Sender: "sender", Nonce: 7}
+		registeredSender := ""
+		epf := createFacade(
+			&mock.TransactionProcessorStub{
+				DecodeRawTransactionCalled: func(txBytes []byte) (*data.Transaction, error) {
+					assert.Equal(t, []byte("raw"), txBytes)
+					return decodedTx, nil
+				},
+				SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+					assert.Equal(t, decodedTx, tx)
+					return http.StatusOK, "hash", nil
+				},
 			},
-			GenerateTxForSendUserFundsCalled: func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, value *big.Int, config *data.NetworkConfig) (*data.Transaction, error) {
-				return &data.Transaction{}, nil
+			&mock.WebhooksProcessorStub{
+				RegisterSentTransactionCalled: func(sender string, txHash string) {
+					registeredSender = sender
+				},
 			},
-		},
-		&mock.NodeStatusProcessorStub{
-			GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
-				return &data.GenericAPIResponse{
-					Data: map[string]interface{}{
-						"config": map[string]interface{}{
-							"erd_chain_id":                "chainID",
-							"erd_min_transaction_version": 1.0,
+		)
+
+		statusCode, txHash, err := epf.SendRawTransaction([]byte("raw"))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, "hash", txHash)
+		assert.Equal(t, "sender", registeredSender)
+	})
+}
+
+func TestProxyFacade_ComputeTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	createFacade := func(nodeStatusProc *mock.NodeStatusProcessorStub, txProc *mock.TransactionProcessorStub) *facade.ProxyFacade {
+		args := createMockArgsProxyFacade()
+		args.TransactionProcessor = txProc
+		args.NodeStatusProcessor = nodeStatusProc
+		epf, _ := facade.NewProxyFacade(args)
+
+		return epf
+	}
+
+	t.Run("network config error should error", func(t *testing.T) {
+		t.Parallel()
+
+		epf := createFacade(
+			&mock.NodeStatusProcessorStub{
+				GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+					return nil, expectedErr
+				},
+			},
+			&mock.TransactionProcessorStub{},
+		)
+
+		fee, statusCode, err := epf.ComputeTransactionFee(&data.Transaction{})
+		assert.Nil(t, fee)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("fee computation error should be a bad request", func(t *testing.T) {
+		t.Parallel()
+
+		epf := createFacade(
+			&mock.NodeStatusProcessorStub{},
+			&mock.TransactionProcessorStub{
+				ComputeTransactionFeeCalled: func(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeResponseData, error) {
+					return nil, expectedErr
+				},
+			},
+		)
+
+		fee, statusCode, err := epf.ComputeTransactionFee(&data.Transaction{})
+		assert.Nil(t, fee)
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should compute the fee from the network config", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFee := &data.TransactionFeeResponseData{Fee: "50000000000000"}
+		epf := createFacade(
+			&mock.NodeStatusProcessorStub{
+				GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+					return &data.GenericAPIResponse{
+						Data: map[string]interface{}{
+							"config": map[string]interface{}{
+								"erd_min_gas_limit":      50000,
+								"erd_gas_price_modifier": "0.01",
+							},
 						},
-					},
-				}, nil
+					}, nil
+				},
 			},
+			&mock.TransactionProcessorStub{
+				ComputeTransactionFeeCalled: func(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeResponseData, error) {
+					assert.Equal(t, uint64(50000), networkConfig.Config.MinGasLimit)
+					assert.Equal(t, "0.01", networkConfig.Config.GasPriceModifier)
+					return expectedFee, nil
+				},
+			},
+		)
+
+		fee, statusCode, err := epf.ComputeTransactionFee(&data.Transaction{})
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, expectedFee, fee)
+	})
+}
+
+func TestProxyFacade_SendUserFunds(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	args := createMockArgsProxyFacade()
+	args.AccountProcessor = &mock.AccountProcessorStub{
+		GetAccountCalled: func(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{
+				Account: data.Account{
+					Nonce: uint64(0),
+				},
+			}, nil
+		},
+	}
+	args.TransactionProcessor = &mock.TransactionProcessorStub{
+		SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			wasCalled = true
+			return 0, "", nil
+		},
+	}
+	args.FaucetProcessor = &mock.FaucetProcessorStub{
+		SenderDetailsFromPemCalled: func(receiver string) (crypto.PrivateKey, string, error) {
+			return getPrivKey(), "rcvr", nil
+		},
+		GenerateTxForSendUserFundsCalled: func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, value *big.Int, config *data.NetworkConfig) (*data.Transaction, error) {
+			return &data.Transaction{}, nil
 		},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	args.NodeStatusProcessor = &mock.NodeStatusProcessorStub{
+		GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id":                "chainID",
+						"erd_min_transaction_version": 1.0,
+					},
+				},
+			}, nil
+		},
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	_ = epf.SendUserFunds("", big.NewInt(0))
 
@@ -564,30 +580,16 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 	t.Parallel()
 
 	wasCalled := false
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{
-			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
-				wasCalled = true
-				return &vm.VMOutputApi{}, data.BlockInfo{}, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			wasCalled = true
+			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	_, _, _ = epf.ExecuteSCQuery(nil)
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	_, _, _ = epf.ExecuteSCQuery(context.Background(), nil)
 
 	assert.True(t, wasCalled)
 }
@@ -603,27 +605,13 @@ func TestProxyFacade_GetHeartbeatData(t *testing.T) {
 			},
 		},
 	}
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{
-			GetHeartbeatDataCalled: func() (*data.HeartbeatResponse, error) {
-				return expectedResults, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.NodeGroupProcessor = &mock.NodeGroupProcessorStub{
+		GetHeartbeatDataCalled: func() (*data.HeartbeatResponse, error) {
+			return expectedResults, nil
 		},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult, _ := epf.GetHeartbeatData()
 
@@ -638,27 +626,13 @@ func TestProxyFacade_ReloadObservers(t *testing.T) {
 		Error:       "bca",
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{
-			ReloadObserversCalled: func() data.NodesReloadResponse {
-				return expectedResult
-			},
+	args := createMockArgsProxyFacade()
+	args.ActionsProcessor = &mock.ActionsProcessorStub{
+		ReloadObserversCalled: func() data.NodesReloadResponse {
+			return expectedResult
 		},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult := epf.ReloadObservers()
 
@@ -673,27 +647,13 @@ func TestProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		Error:       "bca",
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{
-			ReloadFullHistoryObserversCalled: func() data.NodesReloadResponse {
-				return expectedResult
-			},
+	args := createMockArgsProxyFacade()
+	args.ActionsProcessor = &mock.ActionsProcessorStub{
+		ReloadFullHistoryObserversCalled: func() data.NodesReloadResponse {
+			return expectedResult
 		},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult := epf.ReloadFullHistoryObservers()
 
@@ -712,29 +672,15 @@ func TestProxyFacade_GetBlockByHash(t *testing.T) {
 		},
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{
-			GetBlockByHashCalled: func(_ uint32, _ string, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-				return expectedResult, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.BlockProcessor = &mock.BlockProcessorStub{
+		GetBlockByHashCalled: func(_ context.Context, _ uint32, _ string, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			return expectedResult, nil
 		},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
-	actualResult, err := epf.GetBlockByHash(0, "aaaa", common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByHash(context.Background(), 0, "aaaa", common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -752,29 +698,15 @@ func TestProxyFacade_GetBlockByNonce(t *testing.T) {
 		},
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{
-			GetBlockByNonceCalled: func(_ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-				return expectedResult, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.BlockProcessor = &mock.BlockProcessorStub{
+		GetBlockByNonceCalled: func(_ context.Context, _ uint32, _ uint64, _ common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			return expectedResult, nil
 		},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
-	actualResult, err := epf.GetBlockByNonce(0, 10, common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByNonce(context.Background(), 0, 10, common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -794,27 +726,13 @@ func TestProxyFacade_GetInternalBlockByHash(t *testing.T) {
 		},
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{
-			GetInternalBlockByHashCalled: func(_ uint32, _ string, _ common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-				return expectedResult, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.BlockProcessor = &mock.BlockProcessorStub{
+		GetInternalBlockByHashCalled: func(_ uint32, _ string, _ common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+			return expectedResult, nil
 		},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult, err := epf.GetInternalBlockByHash(0, "aaaa", common.Internal)
 	require.Nil(t, err)
@@ -834,27 +752,13 @@ func TestProxyFacade_GetInternalBlockByNonce(t *testing.T) {
 		},
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{
-			GetInternalBlockByNonceCalled: func(_ uint32, _ uint64, _ common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-				return expectedResult, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.BlockProcessor = &mock.BlockProcessorStub{
+		GetInternalBlockByNonceCalled: func(_ uint32, _ uint64, _ common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+			return expectedResult, nil
 		},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult, err := epf.GetInternalBlockByNonce(0, 10, common.Internal)
 	require.Nil(t, err)
@@ -874,27 +778,13 @@ func TestProxyFacade_GetInternalMiniBlockByHash(t *testing.T) {
 		},
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{
-			GetInternalMiniBlockByHashCalled: func(_ uint32, _ string, epoch uint32, _ common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
-				return expectedResult, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.BlockProcessor = &mock.BlockProcessorStub{
+		GetInternalMiniBlockByHashCalled: func(_ uint32, _ string, epoch uint32, _ common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+			return expectedResult, nil
 		},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult, err := epf.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
 	require.Nil(t, err)
@@ -912,27 +802,13 @@ func TestProxyFacade_GetRatingsConfig(t *testing.T) {
 		},
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{
-			GetRatingsConfigCalled: func() (*data.GenericAPIResponse, error) {
-				return expectedResult, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.NodeStatusProcessor = &mock.NodeStatusProcessorStub{
+		GetRatingsConfigCalled: func() (*data.GenericAPIResponse, error) {
+			return expectedResult, nil
 		},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult, err := epf.GetRatingsConfig()
 	require.Nil(t, err)
@@ -965,45 +841,31 @@ func TestProxyFacade_GetTransactionsPool(t *testing.T) {
 		Gaps: []data.NonceGap{providedNonceGap},
 	}
 
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{
-			GetTransactionsPoolCalled: func(fields string) (*data.TransactionsPool, error) {
-				return expectedTxPool, nil
-			},
-			GetTransactionsPoolForShardCalled: func(shardID uint32, fields string) (*data.TransactionsPool, error) {
-				return expectedTxPool, nil
-			},
-			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
-				return expectedTxPoolForSender, nil
-			},
-			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
-				return providedNonce, nil
-			},
-			GetTransactionsPoolNonceGapsForSenderCalled: func(sender string) (*data.TransactionsPoolNonceGaps, error) {
-				return expectedNonceGaps, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.TransactionProcessor = &mock.TransactionProcessorStub{
+		GetTransactionsPoolCalled: func(_ context.Context, fields string, cursor uint64) (*data.TransactionsPool, error) {
+			return expectedTxPool, nil
+		},
+		GetTransactionsPoolForShardCalled: func(_ context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error) {
+			return expectedTxPool, nil
+		},
+		GetTransactionsPoolForSenderCalled: func(sender, fields string) (*data.TransactionsPoolForSender, error) {
+			return expectedTxPoolForSender, nil
 		},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
-
-	actualTxPool, err := epf.GetTransactionsPool("")
+		GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
+			return providedNonce, nil
+		},
+		GetTransactionsPoolNonceGapsForSenderCalled: func(sender string) (*data.TransactionsPoolNonceGaps, error) {
+			return expectedNonceGaps, nil
+		},
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	actualTxPool, err := epf.GetTransactionsPool(context.Background(), "", 0)
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPool, err = epf.GetTransactionsPoolForShard(0, "")
+	actualTxPool, err = epf.GetTransactionsPoolForShard(context.Background(), 0, "", 0)
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
@@ -1030,28 +892,14 @@ func TestProxyFacade_GetGasConfigs(t *testing.T) {
 	}
 
 	wasCalled := false
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{
-			GetGasConfigsCalled: func() (*data.GenericAPIResponse, error) {
-				wasCalled = true
-				return expectedResult, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.NodeStatusProcessor = &mock.NodeStatusProcessorStub{
+		GetGasConfigsCalled: func() (*data.GenericAPIResponse, error) {
+			wasCalled = true
+			return expectedResult, nil
 		},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult, err := epf.GetGasConfigs()
 	require.Nil(t, err)
@@ -1068,33 +916,104 @@ func TestProxyFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 			EpochsLeft: 10,
 		},
 	}
-	epf, _ := facade.NewProxyFacade(
-		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{},
-		&mock.TransactionProcessorStub{},
-		&mock.SCQueryServiceStub{},
-		&mock.NodeGroupProcessorStub{
-			GetWaitingEpochsLeftForPublicKeyCalled: func(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
-				return expectedResults, nil
-			},
+	args := createMockArgsProxyFacade()
+	args.NodeGroupProcessor = &mock.NodeGroupProcessorStub{
+		GetWaitingEpochsLeftForPublicKeyCalled: func(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+			return expectedResults, nil
 		},
-		&mock.ValidatorStatisticsProcessorStub{},
-		&mock.FaucetProcessorStub{},
-		&mock.NodeStatusProcessorStub{},
-		&mock.BlockProcessorStub{},
-		&mock.BlocksProcessorStub{},
-		&mock.ProofProcessorStub{},
-		publicKeyConverter,
-		&mock.ESDTSuppliesProcessorStub{},
-		&mock.StatusProcessorStub{},
-		&mock.AboutInfoProcessorStub{},
-	)
+	}
+	epf, _ := facade.NewProxyFacade(args)
 
 	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey("key")
 
 	assert.Equal(t, expectedResults, actualResult)
 }
 
+func TestProxyFacade_WatchTransactionsStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedChan := make(chan *data.TransactionStatusEvent)
+	providedHashes := []string{"aa", "bb"}
+	args := createMockArgsProxyFacade()
+	args.TransactionStatusWatcher = &mock.TransactionStatusWatcherStub{
+		WatchTransactionsCalled: func(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error) {
+			assert.Equal(t, providedHashes, txHashes)
+			return expectedChan, nil
+		},
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	actualChan, err := epf.WatchTransactionsStatus(context.Background(), providedHashes)
+	require.NoError(t, err)
+	assert.Equal(t, (<-chan *data.TransactionStatusEvent)(expectedChan), actualChan)
+}
+
+func TestProxyFacade_GetBridgeDeposits(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.GenericAPIResponse{
+		Data: data.BridgeDepositsResponseData{
+			Deposits: &data.BridgeDeposits{Address: "erd1address"},
+		},
+	}
+	args := createMockArgsProxyFacade()
+	args.BridgeProcessor = &mock.BridgeProcessorStub{
+		GetBridgeDepositsCalled: func(address string) (*data.GenericAPIResponse, error) {
+			assert.Equal(t, "erd1address", address)
+			return expectedResponse, nil
+		},
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	actualResponse, err := epf.GetBridgeDeposits("erd1address")
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, actualResponse)
+}
+
+func TestProxyFacade_GetCollectionNFTs(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.CollectionNFTs{
+		NFTs: []data.CollectionNFT{{Identifier: "NFT-abcdef-01", Owner: "erd1owner"}},
+	}
+	args := createMockArgsProxyFacade()
+	args.CollectionsProcessor = &mock.CollectionsProcessorStub{
+		GetCollectionNFTsCalled: func(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error) {
+			assert.Equal(t, "NFT-abcdef", collection)
+			assert.Equal(t, common.PaginationOptions{Page: 1, Size: 10}, options)
+			return expectedResponse, nil
+		},
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	actualResponse, err := epf.GetCollectionNFTs("NFT-abcdef", common.PaginationOptions{Page: 1, Size: 10})
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, actualResponse)
+}
+
+func TestProxyFacade_GetConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.GenericAPIResponse{
+		Data: data.ConsensusGroupResponseData{
+			Consensus: &data.ConsensusGroup{ShardID: 1, Round: 37},
+		},
+	}
+	args := createMockArgsProxyFacade()
+	args.ConsensusProcessor = &mock.ConsensusProcessorStub{
+		GetConsensusGroupCalled: func(shardID uint32, round uint64) (*data.GenericAPIResponse, error) {
+			assert.Equal(t, uint32(1), shardID)
+			assert.Equal(t, uint64(37), round)
+			return expectedResponse, nil
+		},
+	}
+	epf, _ := facade.NewProxyFacade(args)
+
+	actualResponse, err := epf.GetConsensusGroup(1, 37)
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, actualResponse)
+}
+
 func getPrivKey() crypto.PrivateKey {
 	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
 	sk, _ := keyGen.GeneratePair()
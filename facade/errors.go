@@ -43,3 +43,60 @@ var ErrNilStatusProcessor = errors.New("nil status processor")
 
 // ErrNilAboutInfoProcessor signals that a nil about info processor has been provided
 var ErrNilAboutInfoProcessor = errors.New("nil about info processor")
+
+// ErrNilProxyPublicKeyProcessor signals that a nil proxy public key processor has been provided
+var ErrNilProxyPublicKeyProcessor = errors.New("nil proxy public key processor")
+
+// ErrNilStakingPortfolioProcessor signals that a nil staking portfolio processor has been provided
+var ErrNilStakingPortfolioProcessor = errors.New("nil staking portfolio processor")
+
+// ErrNilDrainProcessor signals that a nil drain processor has been provided
+var ErrNilDrainProcessor = errors.New("nil drain processor")
+
+// ErrNilTransactionsHistoryProcessor signals that a nil transactions history processor has been provided
+var ErrNilTransactionsHistoryProcessor = errors.New("nil transactions history processor")
+
+// ErrNilNonceManagerProcessor signals that a nil nonce manager processor has been provided
+var ErrNilNonceManagerProcessor = errors.New("nil nonce manager processor")
+
+// ErrNilFaultInjectionProcessor signals that a nil fault injection processor has been provided
+var ErrNilFaultInjectionProcessor = errors.New("nil fault injection processor")
+
+// ErrNilRawPassThroughProcessor signals that a nil raw pass-through processor has been provided
+var ErrNilRawPassThroughProcessor = errors.New("nil raw pass-through processor")
+
+// ErrNilWebhooksProcessor signals that a nil webhooks processor has been provided
+var ErrNilWebhooksProcessor = errors.New("nil webhooks processor")
+
+// ErrNilObserversFeedProcessor signals that a nil observers feed processor has been provided
+var ErrNilObserversFeedProcessor = errors.New("nil observers feed processor")
+
+// ErrNilConfigReloadProcessor signals that a nil config reload processor has been provided
+var ErrNilConfigReloadProcessor = errors.New("nil config reload processor")
+
+// ErrNilDelegationProcessor signals that a nil delegation processor has been provided
+var ErrNilDelegationProcessor = errors.New("nil delegation processor")
+
+// ErrNilTransactionWaitProcessor signals that a nil transaction wait processor has been provided
+var ErrNilTransactionWaitProcessor = errors.New("nil transaction wait processor")
+
+// ErrNilESDTIssuanceProcessor signals that a nil esdt issuance processor has been provided
+var ErrNilESDTIssuanceProcessor = errors.New("nil esdt issuance processor")
+
+// ErrNilTransactionStatusWatcher signals that a nil transaction status watcher has been provided
+var ErrNilTransactionStatusWatcher = errors.New("nil transaction status watcher")
+
+// ErrNilBridgeProcessor signals that a nil bridge processor has been provided
+var ErrNilBridgeProcessor = errors.New("nil bridge processor")
+
+// ErrNilConsensusProcessor signals that a nil consensus processor has been provided
+var ErrNilConsensusProcessor = errors.New("nil consensus processor")
+
+// ErrNilCollectionsProcessor signals that a nil collections processor has been provided
+var ErrNilCollectionsProcessor = errors.New("nil collections processor")
+
+// ErrNilAddressConverterProcessor signals that a nil address converter processor has been provided
+var ErrNilAddressConverterProcessor = errors.New("nil address converter processor")
+
+// ErrNilClientStatsProcessor signals that a nil client statistics processor has been provided
+var ErrNilClientStatsProcessor = errors.New("nil client statistics processor")
@@ -1,7 +1,11 @@
 package facade
 
 import (
+	"context"
+	"io"
 	"math/big"
+	"net/http"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
@@ -18,11 +22,14 @@ type ActionsProcessor interface {
 
 // AccountProcessor defines what an account request processor should do
 type AccountProcessor interface {
-	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccountWithQuorum(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error)
 	GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
 	GetShardIDForAddress(address string) (uint32, error)
+	GetShardsOfAddresses(addresses []string) (*data.AddressesShards, error)
 	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokensList(address string, options common.AccountQueryOptions, filter common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error)
 	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -33,21 +40,29 @@ type AccountProcessor interface {
 	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IterateKeys(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	ExportAccountData(address string, exportOptions common.AccountExportOptions, options common.AccountQueryOptions) (*data.AccountDataExport, error)
 }
 
 // TransactionProcessor defines what a transaction request processor should do
 type TransactionProcessor interface {
 	SendTransaction(tx *data.Transaction) (int, string, error)
 	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	DryRunMultipleTransactions(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData
 	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	TransactionCostDetailedRequest(tx *data.Transaction) (*data.TxCostDetailedResponse, error)
+	ComputeTransactionFee(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeResponseData, error)
+	DecodeRawTransaction(txBytes []byte) (*data.Transaction, error)
 	GetTransactionStatus(txHash string, sender string) (string, error)
 	GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetRelayedTransactionOfInner(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
 	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	GetTransactionProtobuf(txHash string, sndAddr string) ([]byte, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
-	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPool(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShardStream(ctx context.Context, shardID uint32, fields string) (io.ReadCloser, error)
 	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
@@ -58,17 +73,21 @@ type ProofProcessor interface {
 	GetProof(rootHash string, address string) (*data.GenericAPIResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error)
 	GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
+	GetVerifiedProof(rootHash string, address string) (*data.GenericAPIResponse, error)
+	GetVerifiedProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
 	VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
 }
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	GetQueryCosts() *data.SCQueryCosts
 }
 
 // NodeGroupProcessor defines what a node group processor should do
 type NodeGroupProcessor interface {
 	GetHeartbeatData() (*data.HeartbeatResponse, error)
+	GetHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error)
 	IsOldStorageForToken(tokenID string, nonce uint64) (bool, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
 }
@@ -76,12 +95,16 @@ type NodeGroupProcessor interface {
 // ValidatorStatisticsProcessor defines what a validator statistics processor should do
 type ValidatorStatisticsProcessor interface {
 	GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error)
+	GetValidatorStatisticsForKey(blsKey string) (*data.ValidatorApiResponse, error)
 	GetAuctionList() (*data.AuctionListResponse, error)
 }
 
 // ESDTSupplyProcessor defines what an esdt supply processor should do
 type ESDTSupplyProcessor interface {
 	GetESDTSupply(token string) (*data.ESDTSupplyResponse, error)
+	GetESDTSupplies(tokens []string) (*data.ESDTSuppliesResponse, error)
+	GetESDTRoles(token string) (*data.ESDTRolesResponse, error)
+	GetESDTSupplyHistory(token string) (int, *data.ESDTSupplyHistoryResponse, error)
 }
 
 // NodeStatusProcessor defines what a node status processor should do
@@ -89,6 +112,7 @@ type NodeStatusProcessor interface {
 	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
 	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
 	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
+	GetEconomicsDataMetricsHistory() []*data.EconomicMetricsSample
 	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
 	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
 	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
@@ -98,20 +122,28 @@ type NodeStatusProcessor interface {
 	GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error)
 	GetGasConfigs() (*data.GenericAPIResponse, error)
 	GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
+	GetSyncProgress() *data.SyncProgress
 	GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
 }
 
 // BlocksProcessor defines what a blocks processor should do
 type BlocksProcessor interface {
 	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRoundRange(startRound uint64, endRound uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // BlockProcessor defines what a block processor should do
 type BlockProcessor interface {
-	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHashFromAnyShard(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockProtobufByNonce(shardID uint32, nonce uint64) ([]byte, error)
+	GetBlockProtobufByHash(shardID uint32, hash string) ([]byte, error)
+	GetBlocksByNonceRange(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlockFeesByNonce(shardID uint32, nonce uint64) (*data.BlockFees, error)
 	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByTimestamp(timestamp int64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 
 	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
@@ -141,6 +173,7 @@ type FaucetProcessor interface {
 type StatusProcessor interface {
 	GetMetrics() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheus() string
+	GetCachesMetrics() map[string]*data.CacheMetrics
 }
 
 // AboutInfoProcessor defines the behaviour of about info processor
@@ -148,3 +181,117 @@ type AboutInfoProcessor interface {
 	GetAboutInfo() *data.GenericAPIResponse
 	GetNodesVersions() (*data.GenericAPIResponse, error)
 }
+
+// ProxyPublicKeyProcessor defines the behaviour of the component exposing the key used for signing the responses
+type ProxyPublicKeyProcessor interface {
+	GetProxyPublicKey() (*data.GenericAPIResponse, error)
+}
+
+// StakingPortfolioProcessor defines what a staking portfolio processor should do
+type StakingPortfolioProcessor interface {
+	GetStakingPortfolio(address string, stakingProviders []string) (*data.GenericAPIResponse, error)
+}
+
+// DelegationProcessor defines what a component aggregating the delegations of an address should do
+type DelegationProcessor interface {
+	GetAccountDelegations(address string) (*data.GenericAPIResponse, error)
+	GetDelegationProviders() (*data.DelegationProvidersResponse, error)
+}
+
+// ESDTIssuanceProcessor defines what a component tracking the issuance flows of the esdt tokens should do
+type ESDTIssuanceProcessor interface {
+	GetPendingIssuances() (*data.ESDTPendingIssuancesResponse, error)
+	GetTokenOwnership(token string) (*data.ESDTOwnershipResponse, error)
+}
+
+// BridgeProcessor defines what a component aggregating the bridge deposits should do
+type BridgeProcessor interface {
+	GetBridgeDeposits(address string) (*data.GenericAPIResponse, error)
+}
+
+// CollectionsProcessor defines what a component listing the NFTs of the collections should do
+type CollectionsProcessor interface {
+	GetCollectionNFTs(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error)
+}
+
+// AddressConverterProcessor defines what a component converting the addresses between the bech32 and the hex formats should do
+type AddressConverterProcessor interface {
+	ConvertAddresses(addresses []string) ([]data.AddressConversion, error)
+	EncodeAddressAsHex(address string) (string, error)
+}
+
+// ClientStatsProcessor defines what a component counting the transactions relayed on behalf of each client key should do
+type ClientStatsProcessor interface {
+	IsEnabled() bool
+	GetClientStats(clientKey string) (*data.ClientStats, error)
+}
+
+// ConsensusProcessor defines what a component resolving the consensus groups of the past rounds should do
+type ConsensusProcessor interface {
+	GetConsensusGroup(shardID uint32, round uint64) (*data.GenericAPIResponse, error)
+}
+
+// DrainProcessor defines what a component handling the drain mode should do
+type DrainProcessor interface {
+	StartDrain() *data.DrainStatus
+	GetDrainStatus() *data.DrainStatus
+}
+
+// TransactionsHistoryProcessor defines what a transactions history processor should do
+type TransactionsHistoryProcessor interface {
+	GetTransactionsHistory(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error)
+	GetTransfersHistory(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error)
+}
+
+// NonceManagerProcessor defines what a component assigning the nonces of the hosted senders should do
+type NonceManagerProcessor interface {
+	IsEnabled() bool
+	SendManagedTransaction(tx *data.Transaction) (int, string, error)
+	ReserveNonces(request *data.NonceReservationRequest) (int, *data.NonceReservation, error)
+	ReleaseNonces(request *data.NonceReleaseRequest) (int, error)
+}
+
+// WebhooksProcessor defines what a component notifying the webhooks about the watched transactions and addresses
+// should do
+type WebhooksProcessor interface {
+	IsEnabled() bool
+	WatchTransaction(webhook string, txHash string) error
+	RegisterSentTransaction(sender string, txHash string)
+	WatchAddresses(webhook string, addresses []string) error
+	UnwatchAddress(webhook string, address string) error
+	GetWatchedAddresses(webhook string) ([]string, error)
+}
+
+// TransactionStatusWatcher defines what a component tracking the process status of the watched transactions should do
+type TransactionStatusWatcher interface {
+	WatchTransactions(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error)
+}
+
+// TransactionWaitProcessor defines what a component waiting for the execution of the sent transactions should do
+type TransactionWaitProcessor interface {
+	IsEnabled() bool
+	WaitForExecution(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error)
+}
+
+// ObserversFeedProcessor defines what a component consuming the outport feed of the observers should do
+type ObserversFeedProcessor interface {
+	IsEnabled() bool
+	GetLatestBlocks() []*data.FeedBlock
+	WatchBlocks(ctx context.Context, shardID uint32) <-chan *data.BlockNotification
+}
+
+// FaultInjectionProcessor defines what a component injecting faults in the observers calls should do
+type FaultInjectionProcessor interface {
+	SetScenario(scenario *data.FaultInjectionScenario) error
+	GetStatus() *data.FaultInjectionStatus
+}
+
+// ConfigReloadProcessor defines what a component reloading the main config file at runtime should do
+type ConfigReloadProcessor interface {
+	ReloadConfig() (*data.ConfigReloadStatus, error)
+}
+
+// RawPassThroughProcessor defines what a component forwarding raw requests to the observers should do
+type RawPassThroughProcessor interface {
+	ForwardRequest(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error)
+}
@@ -1,19 +1,23 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // AccountProcessorStub -
 type AccountProcessorStub struct {
-	GetAccountCalled                        func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccountCalled                        func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccountWithQuorumCalled              func(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error)
 	GetAccountsCalled                       func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
 	GetValueForKeyCalled                    func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetShardIDForAddressCalled              func(address string) (uint32, error)
+	GetShardsOfAddressesCalled              func(addresses []string) (*data.AddressesShards, error)
 	GetTransactionsCalled                   func(address string) ([]data.DatabaseTransaction, error)
 	ValidatorStatisticsCalled               func() (map[string]*data.ValidatorApiResponse, error)
 	GetAllESDTTokensCalled                  func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokensListCalled                 func(address string, options common.AccountQueryOptions, filter common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error)
 	GetESDTTokenDataCalled                  func(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTNftTokenDataCalled               func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTsWithRoleCalled                  func(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -24,6 +28,7 @@ type AccountProcessorStub struct {
 	GetGuardianDataCalled                   func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IsDataTrieMigratedCalled                func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	IterateKeysCalled                       func(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	ExportAccountDataCalled                 func(address string, exportOptions common.AccountExportOptions, options common.AccountQueryOptions) (*data.AccountDataExport, error)
 }
 
 // GetKeyValuePairs -
@@ -36,6 +41,15 @@ func (aps *AccountProcessorStub) GetAllESDTTokens(address string, options common
 	return aps.GetAllESDTTokensCalled(address, options)
 }
 
+// GetESDTTokensList -
+func (aps *AccountProcessorStub) GetESDTTokensList(address string, options common.AccountQueryOptions, filter common.ESDTTokensFilterOptions) (*data.AccountESDTTokensListResponse, error) {
+	if aps.GetESDTTokensListCalled != nil {
+		return aps.GetESDTTokensListCalled(address, options, filter)
+	}
+
+	return &data.AccountESDTTokensListResponse{}, nil
+}
+
 // GetESDTTokenData -
 func (aps *AccountProcessorStub) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTTokenDataCalled(address, key, options)
@@ -66,8 +80,17 @@ func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(address strin
 }
 
 // GetAccount -
-func (aps *AccountProcessorStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	return aps.GetAccountCalled(address, options)
+func (aps *AccountProcessorStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	return aps.GetAccountCalled(ctx, address, options)
+}
+
+// GetAccountWithQuorum -
+func (aps *AccountProcessorStub) GetAccountWithQuorum(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error) {
+	if aps.GetAccountWithQuorumCalled != nil {
+		return aps.GetAccountWithQuorumCalled(address, options)
+	}
+
+	return &data.AccountQuorumModel{}, nil
 }
 
 // GetAccounts -
@@ -90,6 +113,15 @@ func (aps *AccountProcessorStub) GetShardIDForAddress(address string) (uint32, e
 	return aps.GetShardIDForAddressCalled(address)
 }
 
+// GetShardsOfAddresses -
+func (aps *AccountProcessorStub) GetShardsOfAddresses(addresses []string) (*data.AddressesShards, error) {
+	if aps.GetShardsOfAddressesCalled != nil {
+		return aps.GetShardsOfAddressesCalled(addresses)
+	}
+
+	return &data.AddressesShards{}, nil
+}
+
 // GetCodeHash -
 func (aps *AccountProcessorStub) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetCodeHashCalled(address, options)
@@ -118,6 +150,15 @@ func (aps *AccountProcessorStub) IterateKeys(address string, numKeys uint, itera
 	return &data.GenericAPIResponse{}, nil
 }
 
+// ExportAccountData -
+func (aps *AccountProcessorStub) ExportAccountData(address string, exportOptions common.AccountExportOptions, options common.AccountQueryOptions) (*data.AccountDataExport, error) {
+	if aps.ExportAccountDataCalled != nil {
+		return aps.ExportAccountDataCalled(address, exportOptions, options)
+	}
+
+	return &data.AccountDataExport{}, nil
+}
+
 // AuctionList -
 func (aps *AccountProcessorStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
 	return nil, nil
@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// AddressConverterProcessorStub -
+type AddressConverterProcessorStub struct {
+	ConvertAddressesCalled   func(addresses []string) ([]data.AddressConversion, error)
+	EncodeAddressAsHexCalled func(address string) (string, error)
+}
+
+// ConvertAddresses -
+func (stub *AddressConverterProcessorStub) ConvertAddresses(addresses []string) ([]data.AddressConversion, error) {
+	if stub.ConvertAddressesCalled != nil {
+		return stub.ConvertAddressesCalled(addresses)
+	}
+
+	return make([]data.AddressConversion, 0), nil
+}
+
+// EncodeAddressAsHex -
+func (stub *AddressConverterProcessorStub) EncodeAddressAsHex(address string) (string, error) {
+	if stub.EncodeAddressAsHexCalled != nil {
+		return stub.EncodeAddressAsHexCalled(address)
+	}
+
+	return "", nil
+}
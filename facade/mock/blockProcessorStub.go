@@ -1,29 +1,63 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // BlockProcessorStub -
 type BlockProcessorStub struct {
-	GetBlockByHashCalled                        func(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByNonceCalled                       func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHashCalled                        func(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHashFromAnyShardCalled            func(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonceCalled                       func(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetHyperBlockByHashCalled                   func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonceCalled                  func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByTimestampCalled              func(timestamp int64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetInternalBlockByHashCalled                func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonceCalled               func(shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalMiniBlockByHashCalled            func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
 	GetInternalStartOfEpochMetaBlockCalled      func(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	GetBlocksByNonceRangeCalled                 func(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlockProtobufByNonceCalled               func(shardID uint32, nonce uint64) ([]byte, error)
+	GetBlockProtobufByHashCalled                func(shardID uint32, hash string) ([]byte, error)
+	GetBlockFeesByNonceCalled                   func(shardID uint32, nonce uint64) (*data.BlockFees, error)
 }
 
-func (bps *BlockProcessorStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return bps.GetBlockByHashCalled(shardID, hash, options)
+func (bps *BlockProcessorStub) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return bps.GetBlockByHashCalled(ctx, shardID, hash, options)
 }
 
-func (bps *BlockProcessorStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	return bps.GetBlockByNonceCalled(shardID, nonce, options)
+// GetBlockByHashFromAnyShard -
+func (bps *BlockProcessorStub) GetBlockByHashFromAnyShard(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if bps.GetBlockByHashFromAnyShardCalled != nil {
+		return bps.GetBlockByHashFromAnyShardCalled(hash, options)
+	}
+
+	return &data.BlockApiResponse{}, nil
+}
+
+func (bps *BlockProcessorStub) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	return bps.GetBlockByNonceCalled(ctx, shardID, nonce, options)
+}
+
+// GetBlockProtobufByNonce -
+func (bps *BlockProcessorStub) GetBlockProtobufByNonce(shardID uint32, nonce uint64) ([]byte, error) {
+	if bps.GetBlockProtobufByNonceCalled != nil {
+		return bps.GetBlockProtobufByNonceCalled(shardID, nonce)
+	}
+
+	return nil, nil
+}
+
+// GetBlockProtobufByHash -
+func (bps *BlockProcessorStub) GetBlockProtobufByHash(shardID uint32, hash string) ([]byte, error) {
+	if bps.GetBlockProtobufByHashCalled != nil {
+		return bps.GetBlockProtobufByHashCalled(shardID, hash)
+	}
+
+	return nil, nil
 }
 
 // GetHyperBlockByHash -
@@ -44,6 +78,15 @@ func (bps *BlockProcessorStub) GetHyperBlockByNonce(nonce uint64, options common
 	panic("not implemented: GetHyperBlockByNonce")
 }
 
+// GetHyperBlockByTimestamp -
+func (bps *BlockProcessorStub) GetHyperBlockByTimestamp(timestamp int64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if bps.GetHyperBlockByTimestampCalled != nil {
+		return bps.GetHyperBlockByTimestampCalled(timestamp, options)
+	}
+
+	panic("not implemented: GetHyperBlockByTimestamp")
+}
+
 // GetInternalBlockByHash -
 func (bps *BlockProcessorStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return bps.GetInternalBlockByHashCalled(shardID, hash, format)
@@ -78,3 +121,20 @@ func (bps *BlockProcessorStub) GetAlteredAccountsByHash(shardID uint32, hash str
 func (bps *BlockProcessorStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	return bps.GetInternalStartOfEpochValidatorsInfoCalled(epoch)
 }
+
+// GetBlocksByNonceRange -
+func (bps *BlockProcessorStub) GetBlocksByNonceRange(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	if bps.GetBlocksByNonceRangeCalled != nil {
+		return bps.GetBlocksByNonceRangeCalled(shardID, startNonce, endNonce, options)
+	}
+	return nil, nil
+}
+
+// GetBlockFeesByNonce -
+func (bps *BlockProcessorStub) GetBlockFeesByNonce(shardID uint32, nonce uint64) (*data.BlockFees, error) {
+	if bps.GetBlockFeesByNonceCalled != nil {
+		return bps.GetBlockFeesByNonceCalled(shardID, nonce)
+	}
+
+	return &data.BlockFees{}, nil
+}
@@ -7,7 +7,8 @@ import (
 
 // BlocksProcessorStub -
 type BlocksProcessorStub struct {
-	GetBlocksByRoundCalled func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRoundCalled      func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRoundRangeCalled func(startRound uint64, endRound uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // GetBlocksByRound -
@@ -17,3 +18,11 @@ func (bps *BlocksProcessorStub) GetBlocksByRound(round uint64, options common.Bl
 	}
 	return nil, nil
 }
+
+// GetBlocksByRoundRange -
+func (bps *BlocksProcessorStub) GetBlocksByRoundRange(startRound uint64, endRound uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	if bps.GetBlocksByRoundRangeCalled != nil {
+		return bps.GetBlocksByRoundRangeCalled(startRound, endRound, options)
+	}
+	return nil, nil
+}
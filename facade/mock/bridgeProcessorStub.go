@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// BridgeProcessorStub -
+type BridgeProcessorStub struct {
+	GetBridgeDepositsCalled func(address string) (*data.GenericAPIResponse, error)
+}
+
+// GetBridgeDeposits -
+func (stub *BridgeProcessorStub) GetBridgeDeposits(address string) (*data.GenericAPIResponse, error) {
+	if stub.GetBridgeDepositsCalled != nil {
+		return stub.GetBridgeDepositsCalled(address)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
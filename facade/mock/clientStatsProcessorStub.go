@@ -0,0 +1,27 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ClientStatsProcessorStub -
+type ClientStatsProcessorStub struct {
+	IsEnabledCalled      func() bool
+	GetClientStatsCalled func(clientKey string) (*data.ClientStats, error)
+}
+
+// IsEnabled -
+func (stub *ClientStatsProcessorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// GetClientStats -
+func (stub *ClientStatsProcessorStub) GetClientStats(clientKey string) (*data.ClientStats, error) {
+	if stub.GetClientStatsCalled != nil {
+		return stub.GetClientStatsCalled(clientKey)
+	}
+
+	return &data.ClientStats{}, nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// CollectionsProcessorStub -
+type CollectionsProcessorStub struct {
+	GetCollectionNFTsCalled func(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error)
+}
+
+// GetCollectionNFTs -
+func (stub *CollectionsProcessorStub) GetCollectionNFTs(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error) {
+	if stub.GetCollectionNFTsCalled != nil {
+		return stub.GetCollectionNFTsCalled(collection, options)
+	}
+
+	return &data.CollectionNFTs{}, nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ConfigReloadProcessorStub -
+type ConfigReloadProcessorStub struct {
+	ReloadConfigCalled func() (*data.ConfigReloadStatus, error)
+}
+
+// ReloadConfig -
+func (stub *ConfigReloadProcessorStub) ReloadConfig() (*data.ConfigReloadStatus, error) {
+	if stub.ReloadConfigCalled != nil {
+		return stub.ReloadConfigCalled()
+	}
+
+	return &data.ConfigReloadStatus{}, nil
+}
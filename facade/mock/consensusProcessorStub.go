@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ConsensusProcessorStub -
+type ConsensusProcessorStub struct {
+	GetConsensusGroupCalled func(shardID uint32, round uint64) (*data.GenericAPIResponse, error)
+}
+
+// GetConsensusGroup -
+func (stub *ConsensusProcessorStub) GetConsensusGroup(shardID uint32, round uint64) (*data.GenericAPIResponse, error) {
+	if stub.GetConsensusGroupCalled != nil {
+		return stub.GetConsensusGroupCalled(shardID, round)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
@@ -0,0 +1,27 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// DelegationProcessorStub -
+type DelegationProcessorStub struct {
+	GetAccountDelegationsCalled  func(address string) (*data.GenericAPIResponse, error)
+	GetDelegationProvidersCalled func() (*data.DelegationProvidersResponse, error)
+}
+
+// GetAccountDelegations -
+func (stub *DelegationProcessorStub) GetAccountDelegations(address string) (*data.GenericAPIResponse, error) {
+	if stub.GetAccountDelegationsCalled != nil {
+		return stub.GetAccountDelegationsCalled(address)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// GetDelegationProviders -
+func (stub *DelegationProcessorStub) GetDelegationProviders() (*data.DelegationProvidersResponse, error) {
+	if stub.GetDelegationProvidersCalled != nil {
+		return stub.GetDelegationProvidersCalled()
+	}
+
+	return &data.DelegationProvidersResponse{}, nil
+}
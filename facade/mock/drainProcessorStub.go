@@ -0,0 +1,27 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// DrainProcessorStub -
+type DrainProcessorStub struct {
+	StartDrainCalled     func() *data.DrainStatus
+	GetDrainStatusCalled func() *data.DrainStatus
+}
+
+// StartDrain -
+func (stub *DrainProcessorStub) StartDrain() *data.DrainStatus {
+	if stub.StartDrainCalled != nil {
+		return stub.StartDrainCalled()
+	}
+
+	return &data.DrainStatus{}
+}
+
+// GetDrainStatus -
+func (stub *DrainProcessorStub) GetDrainStatus() *data.DrainStatus {
+	if stub.GetDrainStatusCalled != nil {
+		return stub.GetDrainStatusCalled()
+	}
+
+	return &data.DrainStatus{}
+}
@@ -0,0 +1,27 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ESDTIssuanceProcessorStub -
+type ESDTIssuanceProcessorStub struct {
+	GetPendingIssuancesCalled func() (*data.ESDTPendingIssuancesResponse, error)
+	GetTokenOwnershipCalled   func(token string) (*data.ESDTOwnershipResponse, error)
+}
+
+// GetPendingIssuances -
+func (stub *ESDTIssuanceProcessorStub) GetPendingIssuances() (*data.ESDTPendingIssuancesResponse, error) {
+	if stub.GetPendingIssuancesCalled != nil {
+		return stub.GetPendingIssuancesCalled()
+	}
+
+	return &data.ESDTPendingIssuancesResponse{}, nil
+}
+
+// GetTokenOwnership -
+func (stub *ESDTIssuanceProcessorStub) GetTokenOwnership(token string) (*data.ESDTOwnershipResponse, error) {
+	if stub.GetTokenOwnershipCalled != nil {
+		return stub.GetTokenOwnershipCalled(token)
+	}
+
+	return &data.ESDTOwnershipResponse{}, nil
+}
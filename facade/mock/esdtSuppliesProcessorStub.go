@@ -1,10 +1,17 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // ESDTSuppliesProcessorStub -
 type ESDTSuppliesProcessorStub struct {
-	GetESDTSupplyCalled func(token string) (*data.ESDTSupplyResponse, error)
+	GetESDTSupplyCalled        func(token string) (*data.ESDTSupplyResponse, error)
+	GetESDTSuppliesCalled      func(tokens []string) (*data.ESDTSuppliesResponse, error)
+	GetESDTRolesCalled         func(token string) (*data.ESDTRolesResponse, error)
+	GetESDTSupplyHistoryCalled func(token string) (int, *data.ESDTSupplyHistoryResponse, error)
 }
 
 // GetESDTSupply -
@@ -15,3 +22,30 @@ func (e *ESDTSuppliesProcessorStub) GetESDTSupply(token string) (*data.ESDTSuppl
 
 	return nil, nil
 }
+
+// GetESDTSupplies -
+func (e *ESDTSuppliesProcessorStub) GetESDTSupplies(tokens []string) (*data.ESDTSuppliesResponse, error) {
+	if e.GetESDTSuppliesCalled != nil {
+		return e.GetESDTSuppliesCalled(tokens)
+	}
+
+	return nil, nil
+}
+
+// GetESDTRoles -
+func (e *ESDTSuppliesProcessorStub) GetESDTRoles(token string) (*data.ESDTRolesResponse, error) {
+	if e.GetESDTRolesCalled != nil {
+		return e.GetESDTRolesCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetESDTSupplyHistory -
+func (e *ESDTSuppliesProcessorStub) GetESDTSupplyHistory(token string) (int, *data.ESDTSupplyHistoryResponse, error) {
+	if e.GetESDTSupplyHistoryCalled != nil {
+		return e.GetESDTSupplyHistoryCalled(token)
+	}
+
+	return http.StatusOK, nil, nil
+}
@@ -0,0 +1,27 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// FaultInjectionProcessorStub -
+type FaultInjectionProcessorStub struct {
+	SetScenarioCalled func(scenario *data.FaultInjectionScenario) error
+	GetStatusCalled   func() *data.FaultInjectionStatus
+}
+
+// SetScenario -
+func (stub *FaultInjectionProcessorStub) SetScenario(scenario *data.FaultInjectionScenario) error {
+	if stub.SetScenarioCalled != nil {
+		return stub.SetScenarioCalled(scenario)
+	}
+
+	return nil
+}
+
+// GetStatus -
+func (stub *FaultInjectionProcessorStub) GetStatus() *data.FaultInjectionStatus {
+	if stub.GetStatusCalled != nil {
+		return stub.GetStatusCalled()
+	}
+
+	return &data.FaultInjectionStatus{}
+}
@@ -5,6 +5,7 @@ import "github.com/multiversx/mx-chain-proxy-go/data"
 // NodeGroupProcessorStub represents a stub implementation of a NodeGroupProcessor
 type NodeGroupProcessorStub struct {
 	GetHeartbeatDataCalled                 func() (*data.HeartbeatResponse, error)
+	GetHeartbeatChangesCalled              func(sinceMillis int64) (*data.HeartbeatChangesResponse, error)
 	IsOldStorageForTokenCalled             func(tokenID string, nonce uint64) (bool, error)
 	GetWaitingEpochsLeftForPublicKeyCalled func(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
 }
@@ -19,6 +20,14 @@ func (hbps *NodeGroupProcessorStub) GetHeartbeatData() (*data.HeartbeatResponse,
 	return hbps.GetHeartbeatDataCalled()
 }
 
+// GetHeartbeatChanges -
+func (hbps *NodeGroupProcessorStub) GetHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+	if hbps.GetHeartbeatChangesCalled != nil {
+		return hbps.GetHeartbeatChangesCalled(sinceMillis)
+	}
+	return &data.HeartbeatChangesResponse{}, nil
+}
+
 // GetWaitingEpochsLeftForPublicKey -
 func (hbps *NodeGroupProcessorStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if hbps.GetWaitingEpochsLeftForPublicKeyCalled != nil {
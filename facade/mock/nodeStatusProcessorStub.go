@@ -8,6 +8,7 @@ type NodeStatusProcessorStub struct {
 	GetNetworkMetricsCalled                         func(shardID uint32) (*data.GenericAPIResponse, error)
 	GetLatestFullySynchronizedHyperblockNonceCalled func() (uint64, error)
 	GetEconomicsDataMetricsCalled                   func() (*data.GenericAPIResponse, error)
+	GetEconomicsDataMetricsHistoryCalled            func() []*data.EconomicMetricsSample
 	GetAllIssuedESDTsCalled                         func(tokenType string) (*data.GenericAPIResponse, error)
 	GetDirectStakedInfoCalled                       func() (*data.GenericAPIResponse, error)
 	GetDelegatedInfoCalled                          func() (*data.GenericAPIResponse, error)
@@ -17,6 +18,7 @@ type NodeStatusProcessorStub struct {
 	GetGasConfigsCalled                             func() (*data.GenericAPIResponse, error)
 	GetTriesStatisticsCalled                        func(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
 	GetEpochStartDataCalled                         func(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetSyncProgressCalled                           func() *data.SyncProgress
 }
 
 // GetNetworkConfigMetrics --
@@ -46,6 +48,15 @@ func (stub *NodeStatusProcessorStub) GetEconomicsDataMetrics() (*data.GenericAPI
 	return &data.GenericAPIResponse{}, nil
 }
 
+// GetEconomicsDataMetricsHistory -
+func (stub *NodeStatusProcessorStub) GetEconomicsDataMetricsHistory() []*data.EconomicMetricsSample {
+	if stub.GetEconomicsDataMetricsHistoryCalled != nil {
+		return stub.GetEconomicsDataMetricsHistoryCalled()
+	}
+
+	return make([]*data.EconomicMetricsSample, 0)
+}
+
 // GetLatestFullySynchronizedHyperblockNonce -
 func (stub *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
 	if stub.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
@@ -134,3 +145,11 @@ func (stub *NodeStatusProcessorStub) GetTriesStatistics(shardID uint32) (*data.T
 	}
 	return &data.TrieStatisticsAPIResponse{}, nil
 }
+
+// GetSyncProgress -
+func (stub *NodeStatusProcessorStub) GetSyncProgress() *data.SyncProgress {
+	if stub.GetSyncProgressCalled != nil {
+		return stub.GetSyncProgressCalled()
+	}
+	return &data.SyncProgress{}
+}
@@ -0,0 +1,51 @@
+package mock
+
+import (
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NonceManagerProcessorStub -
+type NonceManagerProcessorStub struct {
+	IsEnabledCalled              func() bool
+	SendManagedTransactionCalled func(tx *data.Transaction) (int, string, error)
+	ReserveNoncesCalled          func(request *data.NonceReservationRequest) (int, *data.NonceReservation, error)
+	ReleaseNoncesCalled          func(request *data.NonceReleaseRequest) (int, error)
+}
+
+// IsEnabled -
+func (stub *NonceManagerProcessorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// SendManagedTransaction -
+func (stub *NonceManagerProcessorStub) SendManagedTransaction(tx *data.Transaction) (int, string, error) {
+	if stub.SendManagedTransactionCalled != nil {
+		return stub.SendManagedTransactionCalled(tx)
+	}
+
+	return http.StatusOK, "", nil
+}
+
+// ReserveNonces -
+func (stub *NonceManagerProcessorStub) ReserveNonces(request *data.NonceReservationRequest) (int, *data.NonceReservation, error) {
+	if stub.ReserveNoncesCalled != nil {
+		return stub.ReserveNoncesCalled(request)
+	}
+
+	return http.StatusOK, &data.NonceReservation{}, nil
+}
+
+// ReleaseNonces -
+func (stub *NonceManagerProcessorStub) ReleaseNonces(request *data.NonceReleaseRequest) (int, error) {
+	if stub.ReleaseNoncesCalled != nil {
+		return stub.ReleaseNoncesCalled(request)
+	}
+
+	return http.StatusOK, nil
+}
@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ObserversFeedProcessorStub -
+type ObserversFeedProcessorStub struct {
+	IsEnabledCalled       func() bool
+	GetLatestBlocksCalled func() []*data.FeedBlock
+	WatchBlocksCalled     func(ctx context.Context, shardID uint32) <-chan *data.BlockNotification
+}
+
+// IsEnabled -
+func (stub *ObserversFeedProcessorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// GetLatestBlocks -
+func (stub *ObserversFeedProcessorStub) GetLatestBlocks() []*data.FeedBlock {
+	if stub.GetLatestBlocksCalled != nil {
+		return stub.GetLatestBlocksCalled()
+	}
+
+	return make([]*data.FeedBlock, 0)
+}
+
+// WatchBlocks -
+func (stub *ObserversFeedProcessorStub) WatchBlocks(ctx context.Context, shardID uint32) <-chan *data.BlockNotification {
+	if stub.WatchBlocksCalled != nil {
+		return stub.WatchBlocksCalled(ctx, shardID)
+	}
+
+	return make(chan *data.BlockNotification)
+}
@@ -4,10 +4,12 @@ import "github.com/multiversx/mx-chain-proxy-go/data"
 
 // ProofProcessorStub -
 type ProofProcessorStub struct {
-	GetProofCalled                func(string, string) (*data.GenericAPIResponse, error)
-	GetProofDataTrieCalled        func(string, string, string) (*data.GenericAPIResponse, error)
-	GetProofCurrentRootHashCalled func(string) (*data.GenericAPIResponse, error)
-	VerifyProofCalled             func(string, string, []string) (*data.GenericAPIResponse, error)
+	GetProofCalled                        func(string, string) (*data.GenericAPIResponse, error)
+	GetProofDataTrieCalled                func(string, string, string) (*data.GenericAPIResponse, error)
+	GetProofCurrentRootHashCalled         func(string) (*data.GenericAPIResponse, error)
+	VerifyProofCalled                     func(string, string, []string) (*data.GenericAPIResponse, error)
+	GetVerifiedProofCalled                func(string, string) (*data.GenericAPIResponse, error)
+	GetVerifiedProofCurrentRootHashCalled func(string) (*data.GenericAPIResponse, error)
 }
 
 // GetProof -
@@ -45,3 +47,21 @@ func (pp *ProofProcessorStub) VerifyProof(rootHash string, address string, proof
 
 	return nil, nil
 }
+
+// GetVerifiedProof -
+func (pp *ProofProcessorStub) GetVerifiedProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+	if pp.GetVerifiedProofCalled != nil {
+		return pp.GetVerifiedProofCalled(rootHash, address)
+	}
+
+	return nil, nil
+}
+
+// GetVerifiedProofCurrentRootHash -
+func (pp *ProofProcessorStub) GetVerifiedProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+	if pp.GetVerifiedProofCurrentRootHashCalled != nil {
+		return pp.GetVerifiedProofCurrentRootHashCalled(address)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ProxyPublicKeyProcessorStub -
+type ProxyPublicKeyProcessorStub struct {
+	GetProxyPublicKeyCalled func() (*data.GenericAPIResponse, error)
+}
+
+// GetProxyPublicKey -
+func (stub *ProxyPublicKeyProcessorStub) GetProxyPublicKey() (*data.GenericAPIResponse, error) {
+	if stub.GetProxyPublicKeyCalled != nil {
+		return stub.GetProxyPublicKeyCalled()
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// RawPassThroughProcessorStub -
+type RawPassThroughProcessorStub struct {
+	ForwardRequestCalled func(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error)
+}
+
+// ForwardRequest -
+func (stub *RawPassThroughProcessorStub) ForwardRequest(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error) {
+	if stub.ForwardRequestCalled != nil {
+		return stub.ForwardRequestCalled(ctx, shardID, request)
+	}
+
+	return http.StatusOK, &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
@@ -1,16 +1,27 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
-	ExecuteQueryCalled func(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQueryCalled  func(context.Context, *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	GetQueryCostsCalled func() *data.SCQueryCosts
 }
 
 // ExecuteQuery -
-func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
-	return serviceStub.ExecuteQueryCalled(query)
+func (serviceStub *SCQueryServiceStub) ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	return serviceStub.ExecuteQueryCalled(ctx, query)
+}
+
+// GetQueryCosts -
+func (serviceStub *SCQueryServiceStub) GetQueryCosts() *data.SCQueryCosts {
+	if serviceStub.GetQueryCostsCalled != nil {
+		return serviceStub.GetQueryCostsCalled()
+	}
+
+	return &data.SCQueryCosts{}
 }
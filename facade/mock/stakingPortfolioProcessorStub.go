@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// StakingPortfolioProcessorStub -
+type StakingPortfolioProcessorStub struct {
+	GetStakingPortfolioCalled func(address string, stakingProviders []string) (*data.GenericAPIResponse, error)
+}
+
+// GetStakingPortfolio -
+func (stub *StakingPortfolioProcessorStub) GetStakingPortfolio(address string, stakingProviders []string) (*data.GenericAPIResponse, error) {
+	if stub.GetStakingPortfolioCalled != nil {
+		return stub.GetStakingPortfolioCalled(address, stakingProviders)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
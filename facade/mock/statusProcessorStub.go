@@ -8,6 +8,7 @@ import (
 type StatusProcessorStub struct {
 	GetMetricsCalled              func() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheusCalled func() string
+	GetCachesMetricsCalled        func() map[string]*data.CacheMetrics
 }
 
 // GetMetricsForPrometheus -
@@ -27,3 +28,12 @@ func (s *StatusProcessorStub) GetMetrics() map[string]*data.EndpointMetrics {
 
 	return nil
 }
+
+// GetCachesMetrics -
+func (s *StatusProcessorStub) GetCachesMetrics() map[string]*data.CacheMetrics {
+	if s.GetCachesMetricsCalled != nil {
+		return s.GetCachesMetricsCalled()
+	}
+
+	return nil
+}
@@ -1,10 +1,13 @@
 package mock
 
 import (
+	"context"
 	"errors"
+	"io"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -14,21 +17,37 @@ var errNotImplemented = errors.New("not implemented")
 type TransactionProcessorStub struct {
 	SendTransactionCalled                       func(tx *data.Transaction) (int, string, error)
 	SendMultipleTransactionsCalled              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	DryRunMultipleTransactionsCalled            func(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData
+	ComputeTransactionFeeCalled                 func(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeResponseData, error)
+	DecodeRawTransactionCalled                  func(txBytes []byte) (*data.Transaction, error)
 	SimulateTransactionCalled                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	SendUserFundsCalled                         func(receiver string, value *big.Int) error
 	TransactionCostRequestCalled                func(tx *data.Transaction) (*data.TxCostResponseData, error)
+	TransactionCostDetailedRequestCalled        func(tx *data.Transaction) (*data.TxCostDetailedResponse, error)
 	GetTransactionStatusCalled                  func(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatusCalled         func(txHash string) (*data.ProcessStatusResponse, error)
 	GetTransactionCalled                        func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetRelayedTransactionOfInnerCalled          func(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionByHashAndSenderAddressCalled  func(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	GetTransactionProtobufCalled                func(txHash string, sndAddr string) ([]byte, error)
 	ComputeTransactionHashCalled                func(tx *data.Transaction) (string, error)
-	GetTransactionsPoolCalled                   func(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShardCalled           func(shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolCalled                   func(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShardCalled           func(ctx context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShardStreamCalled     func(ctx context.Context, shardID uint32, fields string) (io.ReadCloser, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*data.TransactionsPoolNonceGaps, error)
 }
 
+// DryRunMultipleTransactions -
+func (tps *TransactionProcessorStub) DryRunMultipleTransactions(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData {
+	if tps.DryRunMultipleTransactionsCalled != nil {
+		return tps.DryRunMultipleTransactionsCalled(txs, options)
+	}
+
+	return &data.MultipleTransactionsDryRunResponseData{}
+}
+
 // SimulateTransaction -
 func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	if tps.SimulateTransactionCalled != nil {
@@ -101,6 +120,15 @@ func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bo
 	return nil, errNotImplemented
 }
 
+// GetRelayedTransactionOfInner -
+func (tps *TransactionProcessorStub) GetRelayedTransactionOfInner(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error) {
+	if tps.GetRelayedTransactionOfInnerCalled != nil {
+		return tps.GetRelayedTransactionOfInnerCalled(innerTx, withResults)
+	}
+
+	return nil, nil
+}
+
 // GetTransactionByHashAndSenderAddress -
 func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
 	if tps.GetTransactionByHashAndSenderAddressCalled != nil {
@@ -110,6 +138,15 @@ func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash
 	return nil, 0, errNotImplemented
 }
 
+// GetTransactionProtobuf -
+func (tps *TransactionProcessorStub) GetTransactionProtobuf(txHash string, sndAddr string) ([]byte, error) {
+	if tps.GetTransactionProtobufCalled != nil {
+		return tps.GetTransactionProtobufCalled(txHash, sndAddr)
+	}
+
+	return nil, errNotImplemented
+}
+
 // TransactionCostRequest -
 func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
 	if tps.TransactionCostRequestCalled != nil {
@@ -119,19 +156,55 @@ func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction
 	return nil, errNotImplemented
 }
 
+// TransactionCostDetailedRequest -
+func (tps *TransactionProcessorStub) TransactionCostDetailedRequest(tx *data.Transaction) (*data.TxCostDetailedResponse, error) {
+	if tps.TransactionCostDetailedRequestCalled != nil {
+		return tps.TransactionCostDetailedRequestCalled(tx)
+	}
+
+	return nil, errNotImplemented
+}
+
+// ComputeTransactionFee -
+func (tps *TransactionProcessorStub) ComputeTransactionFee(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeResponseData, error) {
+	if tps.ComputeTransactionFeeCalled != nil {
+		return tps.ComputeTransactionFeeCalled(tx, networkConfig)
+	}
+
+	return nil, errNotImplemented
+}
+
+// DecodeRawTransaction -
+func (tps *TransactionProcessorStub) DecodeRawTransaction(txBytes []byte) (*data.Transaction, error) {
+	if tps.DecodeRawTransactionCalled != nil {
+		return tps.DecodeRawTransactionCalled(txBytes)
+	}
+
+	return nil, errNotImplemented
+}
+
 // GetTransactionsPool -
-func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPool(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolCalled != nil {
-		return tps.GetTransactionsPoolCalled(fields)
+		return tps.GetTransactionsPoolCalled(ctx, fields, cursor)
 	}
 
 	return nil, errNotImplemented
 }
 
+// GetTransactionsPoolForShardStream -
+func (tps *TransactionProcessorStub) GetTransactionsPoolForShardStream(ctx context.Context, shardID uint32, fields string) (io.ReadCloser, error) {
+	if tps.GetTransactionsPoolForShardStreamCalled != nil {
+		return tps.GetTransactionsPoolForShardStreamCalled(ctx, shardID, fields)
+	}
+
+	return nil, nil
+}
+
 // GetTransactionsPoolForShard -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolForShardCalled != nil {
-		return tps.GetTransactionsPoolForShardCalled(shardID, fields)
+		return tps.GetTransactionsPoolForShardCalled(ctx, shardID, fields, cursor)
 	}
 
 	return nil, errNotImplemented
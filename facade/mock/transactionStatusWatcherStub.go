@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionStatusWatcherStub -
+type TransactionStatusWatcherStub struct {
+	WatchTransactionsCalled func(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error)
+}
+
+// WatchTransactions -
+func (stub *TransactionStatusWatcherStub) WatchTransactions(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error) {
+	if stub.WatchTransactionsCalled != nil {
+		return stub.WatchTransactionsCalled(ctx, txHashes)
+	}
+
+	chanEvents := make(chan *data.TransactionStatusEvent)
+	close(chanEvents)
+
+	return chanEvents, nil
+}
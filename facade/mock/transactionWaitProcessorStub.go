@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionWaitProcessorStub -
+type TransactionWaitProcessorStub struct {
+	IsEnabledCalled        func() bool
+	WaitForExecutionCalled func(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error)
+}
+
+// IsEnabled -
+func (stub *TransactionWaitProcessorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// WaitForExecution -
+func (stub *TransactionWaitProcessorStub) WaitForExecution(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error) {
+	if stub.WaitForExecutionCalled != nil {
+		return stub.WaitForExecutionCalled(ctx, txHash, timeout)
+	}
+
+	return &data.TransactionExecutionResult{}, nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionsHistoryProcessorStub -
+type TransactionsHistoryProcessorStub struct {
+	GetTransactionsHistoryCalled func(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error)
+	GetTransfersHistoryCalled    func(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error)
+}
+
+// GetTransactionsHistory -
+func (stub *TransactionsHistoryProcessorStub) GetTransactionsHistory(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error) {
+	if stub.GetTransactionsHistoryCalled != nil {
+		return stub.GetTransactionsHistoryCalled(address, options)
+	}
+
+	return &data.TransactionsHistory{}, nil
+}
+
+// GetTransfersHistory -
+func (stub *TransactionsHistoryProcessorStub) GetTransfersHistory(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error) {
+	if stub.GetTransfersHistoryCalled != nil {
+		return stub.GetTransfersHistoryCalled(address, options)
+	}
+
+	return &data.TransfersHistory{}, nil
+}
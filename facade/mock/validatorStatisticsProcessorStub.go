@@ -4,7 +4,8 @@ import "github.com/multiversx/mx-chain-proxy-go/data"
 
 // ValidatorStatisticsProcessorStub -
 type ValidatorStatisticsProcessorStub struct {
-	GetValidatorStatisticsCalled func() (*data.ValidatorStatisticsResponse, error)
+	GetValidatorStatisticsCalled       func() (*data.ValidatorStatisticsResponse, error)
+	GetValidatorStatisticsForKeyCalled func(blsKey string) (*data.ValidatorApiResponse, error)
 }
 
 // GetValidatorStatistics -
@@ -12,6 +13,15 @@ func (v *ValidatorStatisticsProcessorStub) GetValidatorStatistics() (*data.Valid
 	return v.GetValidatorStatisticsCalled()
 }
 
+// GetValidatorStatisticsForKey -
+func (v *ValidatorStatisticsProcessorStub) GetValidatorStatisticsForKey(blsKey string) (*data.ValidatorApiResponse, error) {
+	if v.GetValidatorStatisticsForKeyCalled != nil {
+		return v.GetValidatorStatisticsForKeyCalled(blsKey)
+	}
+
+	return nil, nil
+}
+
 // GetAuctionList -
 func (v *ValidatorStatisticsProcessorStub) GetAuctionList() (*data.AuctionListResponse, error) {
 	return nil, nil
@@ -0,0 +1,63 @@
+package mock
+
+// WebhooksProcessorStub -
+type WebhooksProcessorStub struct {
+	IsEnabledCalled               func() bool
+	WatchTransactionCalled        func(webhook string, txHash string) error
+	RegisterSentTransactionCalled func(sender string, txHash string)
+	WatchAddressesCalled          func(webhook string, addresses []string) error
+	UnwatchAddressCalled          func(webhook string, address string) error
+	GetWatchedAddressesCalled     func(webhook string) ([]string, error)
+}
+
+// IsEnabled -
+func (stub *WebhooksProcessorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// WatchTransaction -
+func (stub *WebhooksProcessorStub) WatchTransaction(webhook string, txHash string) error {
+	if stub.WatchTransactionCalled != nil {
+		return stub.WatchTransactionCalled(webhook, txHash)
+	}
+
+	return nil
+}
+
+// RegisterSentTransaction -
+func (stub *WebhooksProcessorStub) RegisterSentTransaction(sender string, txHash string) {
+	if stub.RegisterSentTransactionCalled != nil {
+		stub.RegisterSentTransactionCalled(sender, txHash)
+	}
+}
+
+// WatchAddresses -
+func (stub *WebhooksProcessorStub) WatchAddresses(webhook string, addresses []string) error {
+	if stub.WatchAddressesCalled != nil {
+		return stub.WatchAddressesCalled(webhook, addresses)
+	}
+
+	return nil
+}
+
+// UnwatchAddress -
+func (stub *WebhooksProcessorStub) UnwatchAddress(webhook string, address string) error {
+	if stub.UnwatchAddressCalled != nil {
+		return stub.UnwatchAddressCalled(webhook, address)
+	}
+
+	return nil
+}
+
+// GetWatchedAddresses -
+func (stub *WebhooksProcessorStub) GetWatchedAddresses(webhook string) ([]string, error) {
+	if stub.GetWatchedAddressesCalled != nil {
+		return stub.GetWatchedAddressesCalled(webhook)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,212 @@
+package facade
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var _ groups.NetworkFacadeHandler = (*NetworkFacade)(nil)
+
+// NetworkFacade implements the network domain of the facade: the network config and status metrics, the economics,
+// the tokens supplies and the shards of the addresses
+type NetworkFacade struct {
+	nodeStatusProc    NodeStatusProcessor
+	accountProc       AccountProcessor
+	esdtSuppliesProc  ESDTSupplyProcessor
+	delegationProc    DelegationProcessor
+	observersFeedProc ObserversFeedProcessor
+	esdtIssuanceProc  ESDTIssuanceProcessor
+	consensusProc     ConsensusProcessor
+	blockProc         BlockProcessor
+}
+
+// ArgsNetworkFacade holds the arguments needed for creating a NetworkFacade
+type ArgsNetworkFacade struct {
+	NodeStatusProcessor    NodeStatusProcessor
+	AccountProcessor       AccountProcessor
+	ESDTSuppliesProcessor  ESDTSupplyProcessor
+	DelegationProcessor    DelegationProcessor
+	ObserversFeedProcessor ObserversFeedProcessor
+	ESDTIssuanceProcessor  ESDTIssuanceProcessor
+	ConsensusProcessor     ConsensusProcessor
+	BlockProcessor         BlockProcessor
+}
+
+// NewNetworkFacade creates a new NetworkFacade instance
+func NewNetworkFacade(args ArgsNetworkFacade) (*NetworkFacade, error) {
+	if args.NodeStatusProcessor == nil {
+		return nil, ErrNilNodeStatusProcessor
+	}
+	if args.AccountProcessor == nil {
+		return nil, ErrNilAccountProcessor
+	}
+	if args.ESDTSuppliesProcessor == nil {
+		return nil, ErrNilESDTSuppliesProcessor
+	}
+	if args.DelegationProcessor == nil {
+		return nil, ErrNilDelegationProcessor
+	}
+	if args.ObserversFeedProcessor == nil {
+		return nil, ErrNilObserversFeedProcessor
+	}
+	if args.ESDTIssuanceProcessor == nil {
+		return nil, ErrNilESDTIssuanceProcessor
+	}
+	if args.ConsensusProcessor == nil {
+		return nil, ErrNilConsensusProcessor
+	}
+	if args.BlockProcessor == nil {
+		return nil, ErrNilBlockProcessor
+	}
+
+	return &NetworkFacade{
+		nodeStatusProc:    args.NodeStatusProcessor,
+		accountProc:       args.AccountProcessor,
+		esdtSuppliesProc:  args.ESDTSuppliesProcessor,
+		delegationProc:    args.DelegationProcessor,
+		observersFeedProc: args.ObserversFeedProcessor,
+		esdtIssuanceProc:  args.ESDTIssuanceProcessor,
+		consensusProc:     args.ConsensusProcessor,
+		blockProc:         args.BlockProcessor,
+	}, nil
+}
+
+// GetDelegationProviders returns all the staking providers along with their metadata
+func (nf *NetworkFacade) GetDelegationProviders() (*data.DelegationProvidersResponse, error) {
+	return nf.delegationProc.GetDelegationProviders()
+}
+
+// IsObserversFeedEnabled returns true if the observers feed is enabled or false otherwise
+func (nf *NetworkFacade) IsObserversFeedEnabled() bool {
+	return nf.observersFeedProc.IsEnabled()
+}
+
+// GetLatestBlocks returns the latest block of each shard, as received through the observers feed
+func (nf *NetworkFacade) GetLatestBlocks() *data.LatestBlocksResponseData {
+	return &data.LatestBlocksResponseData{
+		Blocks: nf.observersFeedProc.GetLatestBlocks(),
+	}
+}
+
+// WatchBlocks returns the channel on which the notifications of the new blocks of the provided shard are sent, as
+// received through the observers feed
+func (nf *NetworkFacade) WatchBlocks(ctx context.Context, shardID uint32) <-chan *data.BlockNotification {
+	return nf.observersFeedProc.WatchBlocks(ctx, shardID)
+}
+
+// GetShardsOfAddresses returns the shard of each of the provided addresses and whether each pair of them is intra-shard
+func (nf *NetworkFacade) GetShardsOfAddresses(addresses []string) (*data.AddressesShards, error) {
+	return nf.accountProc.GetShardsOfAddresses(addresses)
+}
+
+// GetConsensusGroup returns the consensus group and the leader of a past round of the provided shard
+func (nf *NetworkFacade) GetConsensusGroup(shardID uint32, round uint64) (*data.GenericAPIResponse, error) {
+	return nf.consensusProc.GetConsensusGroup(shardID, round)
+}
+
+// GetNetworkConfigMetrics retrieves the node's configuration's metrics
+func (nf *NetworkFacade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetNetworkConfigMetrics()
+}
+
+// GetNetworkStatusMetrics retrieves the node's network metrics for a given shard
+func (nf *NetworkFacade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetNetworkStatusMetrics(shardID)
+}
+
+// GetESDTRoles retrieves the addresses with special roles for the provided token
+func (nf *NetworkFacade) GetESDTRoles(token string) (*data.ESDTRolesResponse, error) {
+	return nf.esdtSuppliesProc.GetESDTRoles(token)
+}
+
+// GetESDTSupplyHistory retrieves the per-epoch supplies of the provided token
+func (nf *NetworkFacade) GetESDTSupplyHistory(token string) (int, *data.ESDTSupplyHistoryResponse, error) {
+	return nf.esdtSuppliesProc.GetESDTSupplyHistory(token)
+}
+
+// GetESDTPendingIssuances retrieves the token issuances not yet executed on the metachain
+func (nf *NetworkFacade) GetESDTPendingIssuances() (*data.ESDTPendingIssuancesResponse, error) {
+	return nf.esdtIssuanceProc.GetPendingIssuances()
+}
+
+// GetESDTOwnership retrieves the owner and the pending ownership transfers of the provided token
+func (nf *NetworkFacade) GetESDTOwnership(token string) (*data.ESDTOwnershipResponse, error) {
+	return nf.esdtIssuanceProc.GetTokenOwnership(token)
+}
+
+// GetESDTSupply retrieves the supply for the provided token
+func (nf *NetworkFacade) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
+	return nf.esdtSuppliesProc.GetESDTSupply(token)
+}
+
+// GetESDTSupplies retrieves the supplies for the provided tokens
+func (nf *NetworkFacade) GetESDTSupplies(tokens []string) (*data.ESDTSuppliesResponse, error) {
+	return nf.esdtSuppliesProc.GetESDTSupplies(tokens)
+}
+
+// GetEconomicsDataMetrics retrieves the node's network metrics for a given shard
+func (nf *NetworkFacade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetEconomicsDataMetrics()
+}
+
+// GetEconomicsDataMetricsHistory retrieves the last economics metrics samples
+func (nf *NetworkFacade) GetEconomicsDataMetricsHistory() []*data.EconomicMetricsSample {
+	return nf.nodeStatusProc.GetEconomicsDataMetricsHistory()
+}
+
+// GetDelegatedInfo retrieves the node's network delegated info
+func (nf *NetworkFacade) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetDelegatedInfo()
+}
+
+// GetDirectStakedInfo retrieves the node's direct staked values
+func (nf *NetworkFacade) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetDirectStakedInfo()
+}
+
+// GetAllIssuedESDTs retrieves all the issued ESDTs from the node
+func (nf *NetworkFacade) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetAllIssuedESDTs(tokenType)
+}
+
+// GetEnableEpochsMetrics retrieves the activation epochs
+func (nf *NetworkFacade) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetEnableEpochsMetrics()
+}
+
+// GetRatingsConfig retrieves the node's configuration's metrics
+func (nf *NetworkFacade) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetRatingsConfig()
+}
+
+// GetGenesisNodesPubKeys retrieves the node's configuration public keys
+func (nf *NetworkFacade) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetGenesisNodesPubKeys()
+}
+
+// GetGasConfigs retrieves the current gas schedule configs
+func (nf *NetworkFacade) GetGasConfigs() (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetGasConfigs()
+}
+
+// GetTriesStatistics will return trie statistics
+func (nf *NetworkFacade) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+	return nf.nodeStatusProc.GetTriesStatistics(shardID)
+}
+
+// GetSyncProgress will return the synchronization progress of the observers, grouped by shard
+func (nf *NetworkFacade) GetSyncProgress() *data.SyncProgress {
+	return nf.nodeStatusProc.GetSyncProgress()
+}
+
+// GetEpochStartData retrieves epoch start data for the provides epoch and shard ID
+func (nf *NetworkFacade) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+	return nf.nodeStatusProc.GetEpochStartData(epoch, shardID)
+}
+
+// GetBlockFeesByNonce returns the fees collected in the block with the provided nonce and the developer rewards
+func (nf *NetworkFacade) GetBlockFeesByNonce(shardID uint32, nonce uint64) (*data.BlockFees, error) {
+	return nf.blockProc.GetBlockFeesByNonce(shardID, nonce)
+}
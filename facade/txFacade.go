@@ -0,0 +1,349 @@
+package facade
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var _ groups.TransactionFacadeHandler = (*TxFacade)(nil)
+
+// TxFacade implements the transactions domain of the facade: the sends, the costs, the statuses and the pool of the
+// transactions, along with the faucet, the nonce manager and the transactions webhooks
+type TxFacade struct {
+	txProc           TransactionProcessor
+	accountProc      AccountProcessor
+	faucetProc       FaucetProcessor
+	nodeStatusProc   NodeStatusProcessor
+	nonceManagerProc NonceManagerProcessor
+	webhooksProc     WebhooksProcessor
+	txWaitProc       TransactionWaitProcessor
+	txStatusWatcher  TransactionStatusWatcher
+}
+
+// ArgsTxFacade holds the arguments needed for creating a TxFacade
+type ArgsTxFacade struct {
+	TransactionProcessor     TransactionProcessor
+	AccountProcessor         AccountProcessor
+	FaucetProcessor          FaucetProcessor
+	NodeStatusProcessor      NodeStatusProcessor
+	NonceManagerProcessor    NonceManagerProcessor
+	WebhooksProcessor        WebhooksProcessor
+	TransactionWaitProcessor TransactionWaitProcessor
+	TransactionStatusWatcher TransactionStatusWatcher
+}
+
+// NewTxFacade creates a new TxFacade instance
+func NewTxFacade(args ArgsTxFacade) (*TxFacade, error) {
+	if args.TransactionProcessor == nil {
+		return nil, ErrNilTransactionProcessor
+	}
+	if args.AccountProcessor == nil {
+		return nil, ErrNilAccountProcessor
+	}
+	if args.FaucetProcessor == nil {
+		return nil, ErrNilFaucetProcessor
+	}
+	if args.NodeStatusProcessor == nil {
+		return nil, ErrNilNodeStatusProcessor
+	}
+	if args.NonceManagerProcessor == nil {
+		return nil, ErrNilNonceManagerProcessor
+	}
+	if args.WebhooksProcessor == nil {
+		return nil, ErrNilWebhooksProcessor
+	}
+	if args.TransactionWaitProcessor == nil {
+		return nil, ErrNilTransactionWaitProcessor
+	}
+	if args.TransactionStatusWatcher == nil {
+		return nil, ErrNilTransactionStatusWatcher
+	}
+
+	return &TxFacade{
+		txProc:           args.TransactionProcessor,
+		accountProc:      args.AccountProcessor,
+		faucetProc:       args.FaucetProcessor,
+		nodeStatusProc:   args.NodeStatusProcessor,
+		nonceManagerProc: args.NonceManagerProcessor,
+		webhooksProc:     args.WebhooksProcessor,
+		txWaitProc:       args.TransactionWaitProcessor,
+		txStatusWatcher:  args.TransactionStatusWatcher,
+	}, nil
+}
+
+// SendTransaction should send the transaction to the correct observer
+func (tf *TxFacade) SendTransaction(tx *data.Transaction) (int, string, error) {
+	statusCode, txHash, err := tf.txProc.SendTransaction(tx)
+	if err == nil {
+		tf.webhooksProc.RegisterSentTransaction(tx.Sender, txHash)
+	}
+
+	return statusCode, txHash, err
+}
+
+// SendRawTransaction decodes the signed transaction bytes and relays the transaction to the correct observers
+func (tf *TxFacade) SendRawTransaction(txBytes []byte) (int, string, error) {
+	tx, err := tf.txProc.DecodeRawTransaction(txBytes)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+
+	return tf.SendTransaction(tx)
+}
+
+// SendMultipleTransactions should send the transactions to the correct observers
+func (tf *TxFacade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+	response, err := tf.txProc.SendMultipleTransactions(txs)
+	if err == nil && tf.webhooksProc.IsEnabled() {
+		tf.registerSentTransactions(txs, response.TxsHashes)
+	}
+
+	return response, err
+}
+
+// registerSentTransactions registers the sent transactions for the webhooks watching their senders. The hashes are
+// recomputed, as the invalid transactions of the batch are not sent, so the returned indexes cannot be trusted
+func (tf *TxFacade) registerSentTransactions(txs []*data.Transaction, sentHashes map[int]string) {
+	isHashSent := make(map[string]struct{}, len(sentHashes))
+	for _, txHash := range sentHashes {
+		isHashSent[txHash] = struct{}{}
+	}
+
+	for _, tx := range txs {
+		txHash, err := tf.txProc.ComputeTransactionHash(tx)
+		if err != nil {
+			continue
+		}
+
+		_, found := isHashSent[txHash]
+		if found {
+			tf.webhooksProc.RegisterSentTransaction(tx.Sender, txHash)
+		}
+	}
+}
+
+// DryRunMultipleTransactions runs the checks of a send-multiple request on the provided transactions, without sending them
+func (tf *TxFacade) DryRunMultipleTransactions(txs []*data.Transaction, options common.TransactionsDryRunOptions) *data.MultipleTransactionsDryRunResponseData {
+	return tf.txProc.DryRunMultipleTransactions(txs, options)
+}
+
+// SimulateTransaction should send the transaction to the correct observer for simulation
+func (tf *TxFacade) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+	return tf.txProc.SimulateTransaction(tx, checkSignature)
+}
+
+// TransactionCostRequest should return how many gas units a transaction will cost
+func (tf *TxFacade) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+	return tf.txProc.TransactionCostRequest(tx)
+}
+
+// ComputeTransactionFee computes the fee of the provided transaction from the cached network config, without calling
+// the observers for a cost simulation
+func (tf *TxFacade) ComputeTransactionFee(tx *data.Transaction) (*data.TransactionFeeResponseData, int, error) {
+	networkCfg, err := tf.getNetworkConfig()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	fee, err := tf.txProc.ComputeTransactionFee(tx, networkCfg)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	return fee, http.StatusOK, nil
+}
+
+// TransactionCostDetailedRequest should return the gas units a transaction will cost, along with the full breakdown of the simulation
+func (tf *TxFacade) TransactionCostDetailedRequest(tx *data.Transaction) (*data.TxCostDetailedResponse, error) {
+	return tf.txProc.TransactionCostDetailedRequest(tx)
+}
+
+// GetTransactionStatus should return transaction status
+func (tf *TxFacade) GetTransactionStatus(txHash string, sender string) (string, error) {
+	return tf.txProc.GetTransactionStatus(txHash, sender)
+}
+
+// GetProcessedTransactionStatus should return transaction status after internal processing of the transaction results
+func (tf *TxFacade) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+	return tf.txProc.GetProcessedTransactionStatus(txHash)
+}
+
+// GetTransaction should return a transaction by hash
+func (tf *TxFacade) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	return tf.txProc.GetTransaction(txHash, withResults)
+}
+
+// GetRelayedTransactionOfInner returns the relayed transaction wrapping the provided inner transaction, if any
+func (tf *TxFacade) GetRelayedTransactionOfInner(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error) {
+	return tf.txProc.GetRelayedTransactionOfInner(innerTx, withResults)
+}
+
+// GetTransactionByHashAndSenderAddress should return a transaction by hash and sender address
+func (tf *TxFacade) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+	return tf.txProc.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+}
+
+// GetTransactionProtobuf returns the protocol representation of a transaction, marshaled with the protocol marshalizer
+func (tf *TxFacade) GetTransactionProtobuf(txHash string, sndAddr string) ([]byte, error) {
+	return tf.txProc.GetTransactionProtobuf(txHash, sndAddr)
+}
+
+// IsFaucetEnabled returns true if the faucet mechanism is enabled or false otherwise
+func (tf *TxFacade) IsFaucetEnabled() bool {
+	return tf.faucetProc.IsEnabled()
+}
+
+// SendUserFunds should send a transaction to load one user's account with extra funds from an account in the pem file
+func (tf *TxFacade) SendUserFunds(receiver string, value *big.Int) error {
+	senderSk, senderPk, err := tf.faucetProc.SenderDetailsFromPem(receiver)
+	if err != nil {
+		return err
+	}
+
+	senderAccount, err := tf.accountProc.GetAccount(context.Background(), senderPk, common.AccountQueryOptions{})
+	if err != nil {
+		return err
+	}
+
+	networkCfg, err := tf.getNetworkConfig()
+	if err != nil {
+		return err
+	}
+
+	tx, err := tf.faucetProc.GenerateTxForSendUserFunds(
+		senderSk,
+		senderPk,
+		senderAccount.Account.Nonce,
+		receiver,
+		value,
+		networkCfg,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = tf.txProc.SendTransaction(tx)
+	return err
+}
+
+// IsNonceManagerEnabled returns true if the nonce manager is enabled or false otherwise
+func (tf *TxFacade) IsNonceManagerEnabled() bool {
+	return tf.nonceManagerProc.IsEnabled()
+}
+
+// SendManagedTransaction assigns the next nonce of the hosted sender to the transaction, signs it and relays it
+func (tf *TxFacade) SendManagedTransaction(tx *data.Transaction) (int, string, error) {
+	statusCode, txHash, err := tf.nonceManagerProc.SendManagedTransaction(tx)
+	if err == nil {
+		tf.webhooksProc.RegisterSentTransaction(tx.Sender, txHash)
+	}
+
+	return statusCode, txHash, err
+}
+
+// ReserveNonces reserves a range of nonces of the hosted sender, for the workers signing its transactions themselves
+func (tf *TxFacade) ReserveNonces(request *data.NonceReservationRequest) (int, *data.NonceReservation, error) {
+	return tf.nonceManagerProc.ReserveNonces(request)
+}
+
+// ReleaseNonces releases a nonces reservation of the hosted sender, giving back its unused nonces
+func (tf *TxFacade) ReleaseNonces(request *data.NonceReleaseRequest) (int, error) {
+	return tf.nonceManagerProc.ReleaseNonces(request)
+}
+
+// IsWebhooksEnabled returns true if the transactions webhooks are enabled or false otherwise
+func (tf *TxFacade) IsWebhooksEnabled() bool {
+	return tf.webhooksProc.IsEnabled()
+}
+
+// WatchTransaction registers the transaction with the provided hash for the provided webhook
+func (tf *TxFacade) WatchTransaction(webhook string, txHash string) error {
+	return tf.webhooksProc.WatchTransaction(webhook, txHash)
+}
+
+// WatchAddresses registers the addresses on the watchlist of the provided webhook
+func (tf *TxFacade) WatchAddresses(webhook string, addresses []string) error {
+	return tf.webhooksProc.WatchAddresses(webhook, addresses)
+}
+
+// UnwatchAddress removes the address from the watchlist of the provided webhook
+func (tf *TxFacade) UnwatchAddress(webhook string, address string) error {
+	return tf.webhooksProc.UnwatchAddress(webhook, address)
+}
+
+// GetWatchedAddresses returns the addresses on the watchlist of the provided webhook
+func (tf *TxFacade) GetWatchedAddresses(webhook string) ([]string, error) {
+	return tf.webhooksProc.GetWatchedAddresses(webhook)
+}
+
+// IsTransactionWaitEnabled returns true if waiting for the execution of the sent transactions is enabled or false otherwise
+func (tf *TxFacade) IsTransactionWaitEnabled() bool {
+	return tf.txWaitProc.IsEnabled()
+}
+
+// WaitForTransactionExecution waits for the transaction with the provided hash to reach a final status
+func (tf *TxFacade) WaitForTransactionExecution(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error) {
+	return tf.txWaitProc.WaitForExecution(ctx, txHash, timeout)
+}
+
+// WatchTransactionsStatus returns the channel on which the final (or timed out) status of each provided transaction
+// is written
+func (tf *TxFacade) WatchTransactionsStatus(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error) {
+	return tf.txStatusWatcher.WatchTransactions(ctx, txHashes)
+}
+
+func (tf *TxFacade) getNetworkConfig() (*data.NetworkConfig, error) {
+	genericResponse, err := tf.nodeStatusProc.GetNetworkConfigMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfigBytes, err := json.Marshal(&genericResponse.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	networkCfg := &data.NetworkConfig{}
+	err = json.Unmarshal(networkConfigBytes, networkCfg)
+
+	return networkCfg, err
+}
+
+// GetTransactionsPool returns all txs from pool
+func (tf *TxFacade) GetTransactionsPool(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error) {
+	return tf.txProc.GetTransactionsPool(ctx, fields, cursor)
+}
+
+// GetTransactionsPoolForShardStream returns the undecoded response holding all txs from shard's pool
+func (tf *TxFacade) GetTransactionsPoolForShardStream(ctx context.Context, shardID uint32, fields string) (io.ReadCloser, error) {
+	return tf.txProc.GetTransactionsPoolForShardStream(ctx, shardID, fields)
+}
+
+// GetTransactionsPoolForShard returns all txs from shard's pool
+func (tf *TxFacade) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error) {
+	return tf.txProc.GetTransactionsPoolForShard(ctx, shardID, fields, cursor)
+}
+
+// GetTransactionsPoolForSender returns tx pool for sender
+func (tf *TxFacade) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+	return tf.txProc.GetTransactionsPoolForSender(sender, fields)
+}
+
+// GetLastPoolNonceForSender returns last nonce from tx pool for sender
+func (tf *TxFacade) GetLastPoolNonceForSender(sender string) (uint64, error) {
+	return tf.txProc.GetLastPoolNonceForSender(sender)
+}
+
+// GetTransactionsPoolNonceGapsForSender returns all nonce gaps from tx pool for sender
+func (tf *TxFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+	return tf.txProc.GetTransactionsPoolNonceGapsForSender(sender)
+}
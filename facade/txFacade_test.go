@@ -0,0 +1,68 @@
+package facade_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/facade/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsTxFacade() facade.ArgsTxFacade {
+	return facade.ArgsTxFacade{
+		TransactionProcessor:     &mock.TransactionProcessorStub{},
+		AccountProcessor:         &mock.AccountProcessorStub{},
+		FaucetProcessor:          &mock.FaucetProcessorStub{},
+		NodeStatusProcessor:      &mock.NodeStatusProcessorStub{},
+		NonceManagerProcessor:    &mock.NonceManagerProcessorStub{},
+		WebhooksProcessor:        &mock.WebhooksProcessorStub{},
+		TransactionWaitProcessor: &mock.TransactionWaitProcessorStub{},
+		TransactionStatusWatcher: &mock.TransactionStatusWatcherStub{},
+	}
+}
+
+func TestNewTxFacade(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsTxFacade()
+	args.TransactionProcessor = nil
+	txFacade, err := facade.NewTxFacade(args)
+	require.Nil(t, txFacade)
+	require.Equal(t, facade.ErrNilTransactionProcessor, err)
+
+	args = createArgsTxFacade()
+	args.WebhooksProcessor = nil
+	txFacade, err = facade.NewTxFacade(args)
+	require.Nil(t, txFacade)
+	require.Equal(t, facade.ErrNilWebhooksProcessor, err)
+
+	txFacade, err = facade.NewTxFacade(createArgsTxFacade())
+	require.NoError(t, err)
+	require.NotNil(t, txFacade)
+}
+
+func TestTxFacade_SendTransactionShouldRegisterItForTheWebhooks(t *testing.T) {
+	t.Parallel()
+
+	registeredHashes := make(map[string]string)
+	args := createArgsTxFacade()
+	args.TransactionProcessor = &mock.TransactionProcessorStub{
+		SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusOK, "txHash", nil
+		},
+	}
+	args.WebhooksProcessor = &mock.WebhooksProcessorStub{
+		RegisterSentTransactionCalled: func(sender string, txHash string) {
+			registeredHashes[txHash] = sender
+		},
+	}
+	txFacade, _ := facade.NewTxFacade(args)
+
+	statusCode, txHash, err := txFacade.SendTransaction(&data.Transaction{Sender: "sender"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "txHash", txHash)
+	require.Equal(t, map[string]string{"txHash": "sender"}, registeredHashes)
+}
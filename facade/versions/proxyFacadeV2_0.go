@@ -0,0 +1,46 @@
+package versions
+
+import (
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+)
+
+// ProxyFacadeV2_0 is the facade that corresponds to the version v2.0. It adapts the responses of the common facade to
+// the v2 response shapes, leaving the v1.0 ones untouched
+type ProxyFacadeV2_0 struct {
+	*facade.ProxyFacade
+}
+
+// GetTransactionV2 returns the transaction in the v2 shape, which also holds the processed status of the transaction
+func (epf *ProxyFacadeV2_0) GetTransactionV2(txHash string, sender string, withResults bool) (*data.ApiTransactionResultV2, int, error) {
+	tx, statusCode, err := epf.getTransaction(txHash, sender, withResults)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	processStatus, err := epf.GetProcessedTransactionStatus(txHash)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	return &data.ApiTransactionResultV2{
+		ApiTransactionResult: tx,
+		ProcessStatus:        processStatus,
+	}, statusCode, nil
+}
+
+func (epf *ProxyFacadeV2_0) getTransaction(txHash string, sender string, withResults bool) (*transaction.ApiTransactionResult, int, error) {
+	if len(sender) > 0 {
+		return epf.GetTransactionByHashAndSenderAddress(txHash, sender, withResults)
+	}
+
+	tx, err := epf.GetTransaction(txHash, withResults)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return tx, http.StatusOK, nil
+}
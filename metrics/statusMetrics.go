@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -9,16 +10,20 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
-// statusMetrics will handle displaying at /status/metrics all collected metrics
+// statusMetrics will handle displaying at /status/metrics all collected metrics, and at /status/caches the metrics of
+// the registered caches
 type statusMetrics struct {
 	endpointMetrics        map[string]*data.EndpointMetrics
 	mutEndpointsOperations sync.RWMutex
+	cachesMetricsHandlers  map[string]func() data.CacheMetrics
+	mutCaches              sync.RWMutex
 }
 
 // NewStatusMetrics will return an instance of the struct
 func NewStatusMetrics() *statusMetrics {
 	return &statusMetrics{
-		endpointMetrics: make(map[string]*data.EndpointMetrics),
+		endpointMetrics:       make(map[string]*data.EndpointMetrics),
+		cachesMetricsHandlers: make(map[string]func() data.CacheMetrics),
 	}
 }
 
@@ -71,6 +76,32 @@ func (sm *statusMetrics) GetAll() map[string]*data.EndpointMetrics {
 	return newMap
 }
 
+// RegisterCache adds the cache to the reported ones, under the provided name. The handler is called each time the
+// metrics are requested
+func (sm *statusMetrics) RegisterCache(name string, metricsHandler func() data.CacheMetrics) {
+	if metricsHandler == nil {
+		return
+	}
+
+	sm.mutCaches.Lock()
+	sm.cachesMetricsHandlers[name] = metricsHandler
+	sm.mutCaches.Unlock()
+}
+
+// GetCachesMetrics returns the hits, the misses and the evictions of each registered cache
+func (sm *statusMetrics) GetCachesMetrics() map[string]*data.CacheMetrics {
+	sm.mutCaches.RLock()
+	defer sm.mutCaches.RUnlock()
+
+	cachesMetrics := make(map[string]*data.CacheMetrics, len(sm.cachesMetricsHandlers))
+	for name, metricsHandler := range sm.cachesMetricsHandlers {
+		cacheMetrics := metricsHandler()
+		cachesMetrics[name] = &cacheMetrics
+	}
+
+	return cachesMetrics
+}
+
 // GetMetricsForPrometheus returns the metrics in a prometheus format
 func (sm *statusMetrics) GetMetricsForPrometheus() string {
 	metricsMap := sm.GetAll()
@@ -85,6 +116,20 @@ func (sm *statusMetrics) GetMetricsForPrometheus() string {
 		stringBuilder.WriteString(fmt.Sprintf("lowest_response_time_ns{endpoint=\"%s\"} %d\n", endpointPath, endpointData.LowestResponseTime))
 	}
 
+	cachesMetrics := sm.GetCachesMetrics()
+	cacheNames := make([]string, 0, len(cachesMetrics))
+	for name := range cachesMetrics {
+		cacheNames = append(cacheNames, name)
+	}
+	sort.Strings(cacheNames)
+
+	for _, name := range cacheNames {
+		cacheMetrics := cachesMetrics[name]
+		stringBuilder.WriteString(fmt.Sprintf("cache_hits{cache=\"%s\"} %d\n", name, cacheMetrics.NumHits))
+		stringBuilder.WriteString(fmt.Sprintf("cache_misses{cache=\"%s\"} %d\n", name, cacheMetrics.NumMisses))
+		stringBuilder.WriteString(fmt.Sprintf("cache_evictions{cache=\"%s\"} %d\n", name, cacheMetrics.NumEvictions))
+	}
+
 	return stringBuilder.String()
 }
 
@@ -129,6 +129,39 @@ lowest_response_time_ns{endpoint="/network/config"} 2000000
 	require.Equal(t, expectedString, res)
 }
 
+func TestStatusMetrics_CachesMetrics(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+	require.Empty(t, sm.GetCachesMetrics())
+
+	numCalls := 0
+	sm.RegisterCache("sc-query", func() data.CacheMetrics {
+		numCalls++
+		return data.CacheMetrics{NumHits: 3, NumMisses: 1, NumEvictions: 2, HitRatio: 0.75}
+	})
+	sm.RegisterCache("economics", func() data.CacheMetrics {
+		return data.CacheMetrics{NumMisses: 4}
+	})
+	sm.RegisterCache("nil handler", nil)
+
+	res := sm.GetCachesMetrics()
+	require.Equal(t, map[string]*data.CacheMetrics{
+		"sc-query":  {NumHits: 3, NumMisses: 1, NumEvictions: 2, HitRatio: 0.75},
+		"economics": {NumMisses: 4},
+	}, res)
+	require.Equal(t, 1, numCalls)
+
+	expectedString := `cache_hits{cache="economics"} 0
+cache_misses{cache="economics"} 4
+cache_evictions{cache="economics"} 0
+cache_hits{cache="sc-query"} 3
+cache_misses{cache="sc-query"} 1
+cache_evictions{cache="sc-query"} 2
+`
+	require.Equal(t, expectedString, sm.GetMetricsForPrometheus())
+}
+
 func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 	t.Parallel()
 
@@ -152,6 +185,11 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 				delete(res, "endpoint_0")
 			case 2:
 				_ = sm.GetMetricsForPrometheus()
+			case 3:
+				sm.RegisterCache(fmt.Sprintf("cache_%d", index%3), func() data.CacheMetrics {
+					return data.CacheMetrics{}
+				})
+				_ = sm.GetCachesMetrics()
 			}
 
 			wg.Done()
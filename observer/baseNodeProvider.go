@@ -27,6 +27,15 @@ func (bnp *baseNodeProvider) initNodes(nodes []*data.NodeData) error {
 
 	newNodes := make(map[uint32][]*data.NodeData)
 	for _, observer := range nodes {
+		if observer.MaxEpoch > 0 && observer.MaxEpoch < observer.MinEpoch {
+			return fmt.Errorf("%w for observer %s, min epoch %d, max epoch %d",
+				ErrInvalidEpochsRange,
+				observer.Address,
+				observer.MinEpoch,
+				observer.MaxEpoch,
+			)
+		}
+
 		shardId := observer.ShardId
 		newNodes[shardId] = append(newNodes[shardId], observer)
 		isMeta := shardId == core.MetachainShardId
@@ -104,11 +113,16 @@ func (bnp *baseNodeProvider) GetAllNodesWithSyncState() []*data.NodeData {
 	return nodesSlice
 }
 
-// UpdateNodesBasedOnSyncState will simply call the corresponding function for both regular and snapshotless observers
+// UpdateNodesBasedOnSyncState will simply call the corresponding function for both regular and snapshotless observers.
+// The shards are recomputed from the provided nodes, as these might have been moved to other shards
 func (bnp *baseNodeProvider) UpdateNodesBasedOnSyncState(nodesWithSyncStatus []*data.NodeData) {
 	bnp.mutNodes.Lock()
 	defer bnp.mutNodes.Unlock()
 
+	if len(nodesWithSyncStatus) > 0 {
+		bnp.shardIds = getSortedShardIDsSlice(nodesSliceToShardedMap(nodesWithSyncStatus))
+	}
+
 	regularNodes, snapshotlessNodes := splitNodesByDataAvailability(nodesWithSyncStatus)
 	bnp.regularNodes.UpdateNodes(regularNodes)
 	bnp.snapshotlessNodes.UpdateNodes(snapshotlessNodes)
@@ -186,27 +200,28 @@ func (bnp *baseNodeProvider) ReloadNodes(nodesType data.NodeType) data.NodesRelo
 	}
 }
 
+// getSyncedNodesForShardUnprotected returns the best available nodes of the shard, ordered by their priority tier
 func (bnp *baseNodeProvider) getSyncedNodesForShardUnprotected(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 	var syncedNodes []*data.NodeData
 
 	syncedNodes = bnp.getSyncedNodes(dataAvailability, shardID)
 	if len(syncedNodes) != 0 {
-		return syncedNodes, nil
+		return sortNodesByTier(syncedNodes), nil
 	}
 
 	fallbackNodesSource := bnp.getFallbackNodes(dataAvailability, shardID)
 	if len(fallbackNodesSource) != 0 {
-		return fallbackNodesSource, nil
+		return sortNodesByTier(fallbackNodesSource), nil
 	}
 
 	outOfSyncNodes := bnp.getOutOfSyncNodes(dataAvailability, shardID)
 	if len(outOfSyncNodes) > 0 {
-		return outOfSyncNodes, nil
+		return sortNodesByTier(outOfSyncNodes), nil
 	}
 
 	outOfSyncFallbackNodesSource := bnp.getOutOfSyncFallbackNodes(dataAvailability, shardID)
 	if len(outOfSyncFallbackNodesSource) != 0 {
-		return outOfSyncFallbackNodesSource, nil
+		return sortNodesByTier(outOfSyncFallbackNodesSource), nil
 	}
 
 	return nil, ErrShardNotAvailable
@@ -258,7 +273,7 @@ func (bnp *baseNodeProvider) getSyncedNodesUnprotected(dataAvailability data.Obs
 		return nil, ErrEmptyObserversList
 	}
 
-	return syncedNodes, nil
+	return sortNodesByTier(syncedNodes), nil
 }
 
 func loadMainConfig(filepath string) (*config.Config, error) {
@@ -73,6 +73,26 @@ func TestBaseNodeProvider_InvalidShardForObserver(t *testing.T) {
 	require.True(t, strings.Contains(err.Error(), "addr1"))
 }
 
+func TestBaseNodeProvider_InvalidEpochsRangeForObserver(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{
+			Address:  "addr0",
+			ShardId:  0,
+			MinEpoch: 100,
+			MaxEpoch: 10,
+		},
+	}
+
+	bnp := baseNodeProvider{
+		numOfShards: 1,
+	}
+	err := bnp.initNodes(nodes)
+	require.True(t, errors.Is(err, ErrInvalidEpochsRange))
+	require.True(t, strings.Contains(err.Error(), "addr0"))
+}
+
 func TestBaseNodeProvider_ReloadNodesConfigurationFileNotFound(t *testing.T) {
 	bnp := &baseNodeProvider{
 		configurationFilePath: "wrong config path",
@@ -413,3 +433,36 @@ func TestBaseNodeProvider_getSyncedNodesForShardUnprotected(t *testing.T) {
 	require.Equal(t, "addr0-snapshotless", nodes[0].Address)
 	require.False(t, nodes[0].IsSynced)
 }
+
+func TestBaseNodeProvider_UpdateNodesBasedOnSyncStateShouldRehomeNodes(t *testing.T) {
+	t.Parallel()
+
+	getNodes := func() []*data.NodeData {
+		return []*data.NodeData{
+			{Address: "addr0", ShardId: 0, IsSynced: true},
+			{Address: "addr1", ShardId: 1, IsSynced: true},
+			{Address: "addr2", ShardId: 2, IsSynced: true},
+		}
+	}
+	initialNodes := getNodes()
+	syncedNodes, _, syncedSnapshotless, _ := initAllNodesSlice(nodesSliceToShardedMap(initialNodes))
+	snapshotlessNodes, _ := holder.NewNodesHolder(syncedSnapshotless, nil, data.AvailabilityRecent)
+	bnp := &baseNodeProvider{
+		regularNodes:      createNodesHolder(syncedNodes),
+		snapshotlessNodes: snapshotlessNodes,
+		shardIds:          []uint32{0, 1, 2},
+	}
+
+	// the shards 1 and 2 merged
+	updatedNodes := getNodes()
+	updatedNodes[2].ShardId = 1
+	bnp.UpdateNodesBasedOnSyncState(updatedNodes)
+
+	nodes, err := bnp.getSyncedNodesForShardUnprotected(1, data.AvailabilityAll)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	_, err = bnp.getSyncedNodesForShardUnprotected(2, data.AvailabilityAll)
+	require.Error(t, err)
+	require.Len(t, bnp.GetAllNodesWithSyncState(), 3)
+	require.Equal(t, []uint32{0, 1}, bnp.shardIds)
+}
@@ -9,7 +9,9 @@ import (
 // balancing of them
 type circularQueueNodesProvider struct {
 	*baseNodeProvider
-	positionsHolder CounterMapsHolder
+	// tiersPositionsHolders holds the positions of each priority tier, so the nodes of a tier are balanced with counters
+	// sized by the number of nodes of that tier
+	tiersPositionsHolders map[uint32]CounterMapsHolder
 }
 
 // NewCircularQueueNodesProvider returns a new instance of circularQueueNodesProvider
@@ -29,8 +31,8 @@ func NewCircularQueueNodesProvider(
 	}
 
 	return &circularQueueNodesProvider{
-		baseNodeProvider: bop,
-		positionsHolder:  mapCounters.NewMapCountersHolder(),
+		baseNodeProvider:      bop,
+		tiersPositionsHolders: make(map[uint32]CounterMapsHolder),
 	}, nil
 }
 
@@ -44,14 +46,9 @@ func (cqnp *circularQueueNodesProvider) GetNodesByShardId(shardId uint32, dataAv
 		return nil, err
 	}
 
-	position, err := cqnp.positionsHolder.ComputeShardPosition(dataAvailability, shardId, uint32(len(syncedNodesForShard)))
-	if err != nil {
-		return nil, err
-	}
-
-	sliceToRet := append(syncedNodesForShard[position:], syncedNodesForShard[:position]...)
-
-	return sliceToRet, nil
+	return rotateWithinTiers(syncedNodesForShard, func(tier uint32, numTierNodes uint32) (uint32, error) {
+		return cqnp.getTierPositionsHolderUnprotected(tier).ComputeShardPosition(dataAvailability, shardId, numTierNodes)
+	})
 }
 
 // GetAllNodes will return a slice containing all observers
@@ -64,14 +61,19 @@ func (cqnp *circularQueueNodesProvider) GetAllNodes(dataAvailability data.Observ
 		return nil, err
 	}
 
-	position, err := cqnp.positionsHolder.ComputeAllNodesPosition(dataAvailability, uint32(len(allNodes)))
-	if err != nil {
-		return nil, err
-	}
+	return rotateWithinTiers(allNodes, func(tier uint32, numTierNodes uint32) (uint32, error) {
+		return cqnp.getTierPositionsHolderUnprotected(tier).ComputeAllNodesPosition(dataAvailability, numTierNodes)
+	})
+}
 
-	sliceToRet := append(allNodes[position:], allNodes[:position]...)
+func (cqnp *circularQueueNodesProvider) getTierPositionsHolderUnprotected(tier uint32) CounterMapsHolder {
+	positionsHolder, found := cqnp.tiersPositionsHolders[tier]
+	if !found {
+		positionsHolder = mapCounters.NewMapCountersHolder()
+		cqnp.tiersPositionsHolders[tier] = positionsHolder
+	}
 
-	return sliceToRet, nil
+	return positionsHolder
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
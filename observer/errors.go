@@ -10,3 +10,6 @@ var ErrShardNotAvailable = errors.New("the specified shard ID does not exist in
 
 // ErrInvalidShard signals that an invalid shard has been provided
 var ErrInvalidShard = errors.New("invalid shard")
+
+// ErrInvalidEpochsRange signals that an invalid range of epochs has been provided
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
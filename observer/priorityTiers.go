@@ -0,0 +1,47 @@
+package observer
+
+import (
+	"sort"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// sortNodesByTier returns a copy of the provided nodes ordered by their priority tier, keeping the configured order
+// of the nodes from the same tier. This way, the nodes of a remote tier are only tried after all the nodes of the
+// better tiers failed
+func sortNodesByTier(nodes []*data.NodeData) []*data.NodeData {
+	sortedNodes := make([]*data.NodeData, len(nodes))
+	copy(sortedNodes, nodes)
+	sort.SliceStable(sortedNodes, func(i, j int) bool {
+		return sortedNodes[i].Tier < sortedNodes[j].Tier
+	})
+
+	return sortedNodes
+}
+
+// rotateWithinTiers balances the nodes of each tier by rotating them with the position computed for that tier, without
+// moving any node in front of the nodes of a better tier. The provided nodes should already be sorted by tier
+func rotateWithinTiers(
+	nodes []*data.NodeData,
+	computeTierPosition func(tier uint32, numTierNodes uint32) (uint32, error),
+) ([]*data.NodeData, error) {
+	rotatedNodes := make([]*data.NodeData, 0, len(nodes))
+	for tierStart := 0; tierStart < len(nodes); {
+		tierEnd := tierStart + 1
+		for tierEnd < len(nodes) && nodes[tierEnd].Tier == nodes[tierStart].Tier {
+			tierEnd++
+		}
+
+		tierNodes := nodes[tierStart:tierEnd]
+		tierPosition, err := computeTierPosition(tierNodes[0].Tier, uint32(len(tierNodes)))
+		if err != nil {
+			return nil, err
+		}
+		rotatedNodes = append(rotatedNodes, tierNodes[tierPosition:]...)
+		rotatedNodes = append(rotatedNodes, tierNodes[:tierPosition]...)
+
+		tierStart = tierEnd
+	}
+
+	return rotatedNodes, nil
+}
@@ -0,0 +1,155 @@
+package observer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func getNodesAddresses(nodes []*data.NodeData) []string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return addresses
+}
+
+func TestSortNodesByTier(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "remote0", Tier: 1},
+		{Address: "local0", Tier: 0},
+		{Address: "far0", Tier: 2},
+		{Address: "remote1", Tier: 1},
+		{Address: "local1", Tier: 0},
+	}
+
+	sortedNodes := sortNodesByTier(nodes)
+	require.Equal(t, []string{"local0", "local1", "remote0", "remote1", "far0"}, getNodesAddresses(sortedNodes))
+	require.Equal(t, []string{"remote0", "local0", "far0", "remote1", "local1"}, getNodesAddresses(nodes))
+}
+
+func TestRotateWithinTiers(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "local0", Tier: 0},
+		{Address: "local1", Tier: 0},
+		{Address: "remote0", Tier: 1},
+		{Address: "remote1", Tier: 1},
+		{Address: "remote2", Tier: 1},
+	}
+
+	rotateWithPosition := func(nodes []*data.NodeData, position uint32) []string {
+		rotatedNodes, err := rotateWithinTiers(nodes, func(_ uint32, numTierNodes uint32) (uint32, error) {
+			return position % numTierNodes, nil
+		})
+		require.NoError(t, err)
+
+		return getNodesAddresses(rotatedNodes)
+	}
+
+	require.Equal(t, []string{"local0", "local1", "remote0", "remote1", "remote2"}, rotateWithPosition(nodes, 0))
+	require.Equal(t, []string{"local1", "local0", "remote1", "remote2", "remote0"}, rotateWithPosition(nodes, 1))
+	require.Equal(t, []string{"local0", "local1", "remote2", "remote0", "remote1"}, rotateWithPosition(nodes, 2))
+	require.Empty(t, rotateWithPosition(nil, 3))
+
+	expectedErr := errors.New("expected error")
+	rotatedNodes, err := rotateWithinTiers(nodes, func(_ uint32, _ uint32) (uint32, error) {
+		return 0, expectedErr
+	})
+	require.Equal(t, expectedErr, err)
+	require.Nil(t, rotatedNodes)
+}
+
+func TestCircularQueueNodesProvider_PriorityTiersShouldBeEvenlyBalanced(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "local0", ShardId: 0},
+		{Address: "local1", ShardId: 0},
+		{Address: "remote0", ShardId: 0, Tier: 1},
+		{Address: "remote1", ShardId: 0, Tier: 1},
+		{Address: "remote2", ShardId: 0, Tier: 1},
+	}
+	cqnp, err := NewCircularQueueNodesProvider(observers, "path", 1)
+	require.NoError(t, err)
+
+	// over a full cycle of both tiers, each node should lead its tier the same number of times
+	const numRequests = 2 * 3 * 4
+	getFirstNodesCounts := func(getNodes func() ([]*data.NodeData, error)) map[string]int {
+		firstNodesCounts := make(map[string]int)
+		for i := 0; i < numRequests; i++ {
+			nodes, errGet := getNodes()
+			require.NoError(t, errGet)
+			require.Len(t, nodes, 5)
+			firstNodesCounts[nodes[0].Address]++
+			firstNodesCounts[nodes[2].Address]++
+		}
+
+		return firstNodesCounts
+	}
+	expectedCounts := map[string]int{
+		"local0":  numRequests / 2,
+		"local1":  numRequests / 2,
+		"remote0": numRequests / 3,
+		"remote1": numRequests / 3,
+		"remote2": numRequests / 3,
+	}
+
+	require.Equal(t, expectedCounts, getFirstNodesCounts(func() ([]*data.NodeData, error) {
+		return cqnp.GetNodesByShardId(0, data.AvailabilityAll)
+	}))
+	require.Equal(t, expectedCounts, getFirstNodesCounts(func() ([]*data.NodeData, error) {
+		return cqnp.GetAllNodes(data.AvailabilityAll)
+	}))
+}
+
+func TestCircularQueueNodesProvider_PriorityTiers(t *testing.T) {
+	t.Parallel()
+
+	getObservers := func() []*data.NodeData {
+		return []*data.NodeData{
+			{Address: "remote0", ShardId: 0, Tier: 1},
+			{Address: "local0", ShardId: 0},
+			{Address: "remote1", ShardId: 0, Tier: 1},
+			{Address: "local1", ShardId: 0},
+		}
+	}
+	cqnp, err := NewCircularQueueNodesProvider(getObservers(), "path", 1)
+	require.NoError(t, err)
+
+	// the local observers are balanced, while the remote ones are only used after them
+	for i := 0; i < 4; i++ {
+		nodes, errGet := cqnp.GetNodesByShardId(0, data.AvailabilityAll)
+		require.NoError(t, errGet)
+		require.Len(t, nodes, 4)
+		require.Equal(t, uint32(0), nodes[0].Tier)
+		require.Equal(t, uint32(0), nodes[1].Tier)
+		require.NotEqual(t, nodes[0].Address, nodes[1].Address)
+	}
+
+	// the local tier is unhealthy, so the remote observers are used
+	updatedObservers := getObservers()
+	updatedObservers[0].IsSynced = true
+	updatedObservers[2].IsSynced = true
+	cqnp.UpdateNodesBasedOnSyncState(updatedObservers)
+
+	nodes, err := cqnp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"remote0", "remote1"}, getNodesAddresses(nodes))
+
+	// a single local observer is enough for the local tier to be used again
+	updatedObservers = getObservers()
+	updatedObservers[0].IsSynced = true
+	updatedObservers[1].IsSynced = true
+	cqnp.UpdateNodesBasedOnSyncState(updatedObservers)
+
+	nodes, err = cqnp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.NoError(t, err)
+	require.Equal(t, []string{"local0", "remote0"}, getNodesAddresses(nodes))
+}
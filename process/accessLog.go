@@ -0,0 +1,190 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	accessLogSinkStdout = "stdout"
+	accessLogSinkFile   = "file"
+)
+
+// accessLogStreamSink writes the entries as JSON lines into a stream, such as the standard output
+type accessLogStreamSink struct {
+	mutWriter sync.Mutex
+	writer    io.Writer
+}
+
+func (sink *accessLogStreamSink) write(entry []byte) error {
+	sink.mutWriter.Lock()
+	defer sink.mutWriter.Unlock()
+
+	_, err := sink.writer.Write(append(entry, '\n'))
+	return err
+}
+
+func (sink *accessLogStreamSink) close() error {
+	return nil
+}
+
+// AccessLog writes a structured entry for the sampled requests into the configured sink. Each route is sampled with
+// its own rate, the requests failed with a 5xx status code being optionally always logged
+type AccessLog struct {
+	sink              auditSink
+	defaultSampleRate float64
+	alwaysLogErrors   bool
+	routesSampleRates map[string]float64
+	mutRand           sync.Mutex
+	randHandler       func() float64
+}
+
+// NewAccessLog creates a new instance of AccessLog. If the access log is not enabled, the returned instance does nothing
+func NewAccessLog(cfg config.AccessLogConfig) (*AccessLog, error) {
+	if !cfg.Enabled {
+		return &AccessLog{}, nil
+	}
+
+	err := checkSampleRate(cfg.DefaultSampleRate)
+	if err != nil {
+		return nil, fmt.Errorf("%w for DefaultSampleRate", err)
+	}
+	routesSampleRates := make(map[string]float64, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		if len(route.Route) == 0 {
+			return nil, fmt.Errorf("%w, empty route", ErrInvalidAccessLogConfig)
+		}
+		err = checkSampleRate(route.SampleRate)
+		if err != nil {
+			return nil, fmt.Errorf("%w for route %s", err, route.Route)
+		}
+
+		routesSampleRates[route.Route] = route.SampleRate
+	}
+
+	sink, err := createAccessLogSink(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("structured access log enabled", "sink", cfg.Sink, "default sample rate", cfg.DefaultSampleRate)
+
+	return &AccessLog{
+		sink:              sink,
+		defaultSampleRate: cfg.DefaultSampleRate,
+		alwaysLogErrors:   cfg.AlwaysLogErrors,
+		routesSampleRates: routesSampleRates,
+		randHandler:       rand.New(rand.NewSource(time.Now().UnixNano())).Float64,
+	}, nil
+}
+
+func checkSampleRate(sampleRate float64) error {
+	if sampleRate < 0 || sampleRate > 1 {
+		return fmt.Errorf("%w, sample rate %v not within 0 - 1", ErrInvalidAccessLogConfig, sampleRate)
+	}
+
+	return nil
+}
+
+func createAccessLogSink(cfg config.AccessLogConfig) (auditSink, error) {
+	switch cfg.Sink {
+	case accessLogSinkStdout:
+		return &accessLogStreamSink{writer: os.Stdout}, nil
+	case accessLogSinkFile:
+		if len(cfg.FilePath) == 0 {
+			return nil, fmt.Errorf("%w, empty FilePath", ErrInvalidAccessLogConfig)
+		}
+		if cfg.MaxFileSizeInMB <= 0 {
+			return nil, fmt.Errorf("%w, MaxFileSizeInMB should be positive", ErrInvalidAccessLogConfig)
+		}
+
+		return newAuditFileSink(cfg.FilePath, int64(cfg.MaxFileSizeInMB)*1024*1024, cfg.MaxBackupFiles)
+	default:
+		return nil, fmt.Errorf("%w, unknown sink %q", ErrInvalidAccessLogConfig, cfg.Sink)
+	}
+}
+
+// IsEnabled returns true if the access log was enabled from config
+func (al *AccessLog) IsEnabled() bool {
+	return al.sink != nil
+}
+
+// LogEntry writes the entry into the sink, if sampled. A failure of the sink is logged, without affecting the request
+func (al *AccessLog) LogEntry(entry *data.AccessLogEntry) {
+	if !al.IsEnabled() || entry == nil {
+		return
+	}
+
+	sampleRate := al.getSampleRate(entry.Route)
+	isForced := al.alwaysLogErrors && entry.StatusCode >= http.StatusInternalServerError
+	if isForced {
+		sampleRate = 1
+	}
+	if !al.isSampled(sampleRate) {
+		return
+	}
+
+	entry.SampleRate = sampleRate
+	buff, err := json.Marshal(entry)
+	if err != nil {
+		log.Warn("cannot marshal the access log entry", "route", entry.Route, "error", err)
+		return
+	}
+
+	err = al.sink.write(buff)
+	if err != nil {
+		log.Debug("cannot write the access log entry", "route", entry.Route, "error", err)
+	}
+}
+
+// getSampleRate returns the sample rate of the longest configured route matching the provided route template
+func (al *AccessLog) getSampleRate(route string) float64 {
+	sampleRate := al.defaultSampleRate
+	longestMatch := 0
+	for configuredRoute, routeSampleRate := range al.routesSampleRates {
+		if len(configuredRoute) > longestMatch && strings.HasSuffix(route, configuredRoute) {
+			sampleRate = routeSampleRate
+			longestMatch = len(configuredRoute)
+		}
+	}
+
+	return sampleRate
+}
+
+func (al *AccessLog) isSampled(sampleRate float64) bool {
+	if sampleRate >= 1 {
+		return true
+	}
+	if sampleRate <= 0 {
+		return false
+	}
+
+	al.mutRand.Lock()
+	defer al.mutRand.Unlock()
+
+	return al.randHandler() < sampleRate
+}
+
+// Close closes the sink of the access log
+func (al *AccessLog) Close() error {
+	if !al.IsEnabled() {
+		return nil
+	}
+
+	return al.sink.close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (al *AccessLog) IsInterfaceNil() bool {
+	return al == nil
+}
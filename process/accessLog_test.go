@@ -0,0 +1,137 @@
+package process_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccessLogConfig(filePath string) config.AccessLogConfig {
+	return config.AccessLogConfig{
+		Enabled:           true,
+		Sink:              "file",
+		FilePath:          filePath,
+		MaxFileSizeInMB:   1,
+		DefaultSampleRate: 1,
+		AlwaysLogErrors:   true,
+		Routes: []config.AccessLogRouteConfig{
+			{Route: "/node/heartbeatstatus", SampleRate: 0.1},
+			{Route: "/network/status/:shard", SampleRate: 0},
+		},
+	}
+}
+
+func readAccessLogEntries(t *testing.T, filePath string) []*data.AccessLogEntry {
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	entries := make([]*data.AccessLogEntry, 0)
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+
+		entry := &data.AccessLogEntry{}
+		require.NoError(t, json.Unmarshal([]byte(line), entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestNewAccessLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should do nothing", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := process.NewAccessLog(config.AccessLogConfig{Enabled: false, Sink: "unknown"})
+		require.False(t, check.IfNil(al))
+		require.NoError(t, err)
+		require.False(t, al.IsEnabled())
+
+		al.LogEntry(&data.AccessLogEntry{Route: "/v1.0/network/config"})
+		require.NoError(t, al.Close())
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "access.log")
+		cfg := createAccessLogConfig(filePath)
+		cfg.Sink = "unknown"
+		al, err := process.NewAccessLog(cfg)
+		require.True(t, check.IfNil(al))
+		require.True(t, errors.Is(err, process.ErrInvalidAccessLogConfig))
+
+		cfg = createAccessLogConfig(filePath)
+		cfg.DefaultSampleRate = 1.5
+		al, err = process.NewAccessLog(cfg)
+		require.True(t, check.IfNil(al))
+		require.True(t, errors.Is(err, process.ErrInvalidAccessLogConfig))
+
+		cfg = createAccessLogConfig(filePath)
+		cfg.Routes[0].SampleRate = -0.1
+		al, err = process.NewAccessLog(cfg)
+		require.True(t, check.IfNil(al))
+		require.True(t, errors.Is(err, process.ErrInvalidAccessLogConfig))
+
+		cfg = createAccessLogConfig(filePath)
+		cfg.Routes[0].Route = ""
+		al, err = process.NewAccessLog(cfg)
+		require.True(t, check.IfNil(al))
+		require.True(t, errors.Is(err, process.ErrInvalidAccessLogConfig))
+
+		cfg = createAccessLogConfig("")
+		al, err = process.NewAccessLog(cfg)
+		require.True(t, check.IfNil(al))
+		require.True(t, errors.Is(err, process.ErrInvalidAccessLogConfig))
+	})
+	t.Run("stdout sink should work", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := process.NewAccessLog(config.AccessLogConfig{Enabled: true, Sink: "stdout", DefaultSampleRate: 1})
+		require.NoError(t, err)
+		require.True(t, al.IsEnabled())
+		require.NoError(t, al.Close())
+	})
+}
+
+func TestAccessLog_LogEntry(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "access.log")
+	al, err := process.NewAccessLog(createAccessLogConfig(filePath))
+	require.NoError(t, err)
+	randValue := 0.5
+	al.SetRandHandler(func() float64 {
+		return randValue
+	})
+
+	al.LogEntry(&data.AccessLogEntry{RequestID: "id0", Route: "/v1.0/network/config", StatusCode: 200})
+	// not sampled, as the random value is above the sample rate of the route
+	al.LogEntry(&data.AccessLogEntry{RequestID: "id1", Route: "/v1.0/node/heartbeatstatus", StatusCode: 200})
+	randValue = 0.05
+	al.LogEntry(&data.AccessLogEntry{RequestID: "id2", Route: "/v1.0/node/heartbeatstatus", StatusCode: 200})
+	// never sampled, unless failed
+	al.LogEntry(&data.AccessLogEntry{RequestID: "id3", Route: "/v1.0/network/status/:shard", StatusCode: 200})
+	al.LogEntry(&data.AccessLogEntry{RequestID: "id4", Route: "/v1.0/network/status/:shard", StatusCode: 502})
+	require.NoError(t, al.Close())
+
+	entries := readAccessLogEntries(t, filePath)
+	require.Len(t, entries, 3)
+	require.Equal(t, "id0", entries[0].RequestID)
+	require.Equal(t, float64(1), entries[0].SampleRate)
+	require.Equal(t, "id2", entries[1].RequestID)
+	require.Equal(t, 0.1, entries[1].SampleRate)
+	require.Equal(t, "id4", entries[2].RequestID)
+	require.Equal(t, float64(1), entries[2].SampleRate)
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/availabilityCommon"
 )
@@ -21,21 +23,27 @@ type AccountProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
 	availabilityProvider availabilityCommon.AvailabilityProvider
+	quorumReads          config.QuorumReadsConfig
 }
 
 // NewAccountProcessor creates a new instance of AccountProcessor
-func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*AccountProcessor, error) {
+func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter, quorumReads config.QuorumReadsConfig) (*AccountProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	err := checkQuorumReadsConfig(quorumReads)
+	if err != nil {
+		return nil, err
+	}
 
 	return &AccountProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
+		quorumReads:          quorumReads,
 	}, nil
 }
 
@@ -49,28 +57,60 @@ func (ap *AccountProcessor) GetShardIDForAddress(address string) (uint32, error)
 	return ap.proc.ComputeShardId(addressBytes)
 }
 
+// GetShardsOfAddresses returns the shard of each of the provided addresses and whether each pair of addresses is
+// intra-shard. Everything is computed locally, based on the current proxy's configuration
+func (ap *AccountProcessor) GetShardsOfAddresses(addresses []string) (*data.AddressesShards, error) {
+	addressesShards := &data.AddressesShards{
+		Addresses:         make([]*data.AddressShard, 0, len(addresses)),
+		Pairs:             make([]*data.AddressesPair, 0),
+		AreAllInSameShard: true,
+	}
+	for _, address := range addresses {
+		shardID, err := ap.GetShardIDForAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w, address: %s, error: %s", ErrInvalidAddress, address, err.Error())
+		}
+
+		addressesShards.Addresses = append(addressesShards.Addresses, &data.AddressShard{
+			Address: address,
+			ShardID: shardID,
+		})
+	}
+
+	for i := 0; i < len(addressesShards.Addresses); i++ {
+		for j := i + 1; j < len(addressesShards.Addresses); j++ {
+			first, second := addressesShards.Addresses[i], addressesShards.Addresses[j]
+			isIntraShard := first.ShardID == second.ShardID
+			addressesShards.Pairs = append(addressesShards.Pairs, &data.AddressesPair{
+				First:        first.Address,
+				Second:       second.Address,
+				IsIntraShard: isIntraShard,
+			})
+			addressesShards.AreAllInSameShard = addressesShards.AreAllInSameShard && isIntraShard
+		}
+	}
+
+	return addressesShards, nil
+}
+
 // GetAccount resolves the request by sending the request to the right observer and returns the response
-func (ap *AccountProcessor) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+func (ap *AccountProcessor) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, availability, options)
 	if err != nil {
 		return nil, err
 	}
 
 	responseAccount := data.AccountApiResponse{}
-	for _, observer := range observers {
-
-		url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
-		_, err = ap.proc.CallGetRestEndPoint(observer.Address, url, &responseAccount)
-		if err == nil {
-			log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
-			return &responseAccount.Data, nil
-		}
-
-		log.Error("account request", "observer", observer.Address, "address", address, "error", err.Error())
+	url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
+	observer, _, err := ap.proc.CallGetRestEndPointHedged(ctx, observers, url, &responseAccount)
+	if err != nil {
+		log.Error("account request", "address", address, "error", err.Error())
+		return nil, WrapObserversError(responseAccount.Error)
 	}
 
-	return nil, WrapObserversError(responseAccount.Error)
+	log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
+	return &responseAccount.Data, nil
 }
 
 // GetAccounts will return data about the provided accounts
@@ -156,7 +196,7 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 // GetValueForKey returns the value for the given address and key
 func (ap *AccountProcessor) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, availability, options)
 	if err != nil {
 		return "", err
 	}
@@ -188,7 +228,7 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 // GetESDTTokenData returns the token data for a token with the given name
 func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, availability, options)
 	if err != nil {
 		return nil, err
 	}
@@ -320,7 +360,7 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 // GetESDTNftTokenData returns the nft token data for a token with the given identifier and nonce
 func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, availability, options)
 	if err != nil {
 		return nil, err
 	}
@@ -354,7 +394,7 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 // GetAllESDTTokens returns all the tokens for a given address
 func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, availability, options)
 	if err != nil {
 		return nil, err
 	}
@@ -383,10 +423,61 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
+// GetESDTTokensList returns the ESDT tokens of the given address matching the provided filter, sorted by their identifier
+func (ap *AccountProcessor) GetESDTTokensList(
+	address string,
+	options common.AccountQueryOptions,
+	filter common.ESDTTokensFilterOptions,
+) (*data.AccountESDTTokensListResponse, error) {
+	if len(filter.TokenType) > 0 && !data.IsValidESDTTypeFilter(filter.TokenType) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidESDTTypeFilter, filter.TokenType)
+	}
+
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse := data.AccountESDTTokensApiResponse{}
+	for _, observer := range observers {
+		apiPath := addressPath + address + "/esdt"
+		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
+		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			log.Info("account ESDT tokens list",
+				"address", address,
+				"shard ID", observer.ShardId,
+				"observer", observer.Address,
+				"http code", respCode)
+			if apiResponse.Error != "" {
+				return nil, errors.New(apiResponse.Error)
+			}
+
+			tokens, err := filterESDTTokens(apiResponse.Data.ESDTs, filter)
+			if err != nil {
+				return nil, err
+			}
+
+			return &data.AccountESDTTokensListResponse{
+				Data: data.AccountESDTTokensList{
+					ESDTs:     tokens,
+					BlockInfo: apiResponse.Data.BlockInfo,
+				},
+				Code: data.ReturnCodeSuccess,
+			}, nil
+		}
+
+		log.Error("account get ESDT tokens list", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return nil, WrapObserversError(apiResponse.Error)
+}
+
 // GetKeyValuePairs returns all the key-value pairs for a given address
 func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, availability, options)
 	if err != nil {
 		return nil, err
 	}
@@ -418,7 +509,7 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 // GetGuardianData returns the guardian data for the given address
 func (ap *AccountProcessor) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, availability, options)
 	if err != nil {
 		return nil, err
 	}
@@ -450,7 +541,7 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 // GetCodeHash returns the code hash for a given address
 func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
-	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, availability, options)
 	if err != nil {
 		return nil, err
 	}
@@ -488,24 +579,37 @@ func (ap *AccountProcessor) getShardIfOdAddress(address string) (uint32, error)
 	return ap.proc.ComputeShardId(addressBytes)
 }
 
-func (ap *AccountProcessor) getObserversForAddress(address string, availability data.ObserverDataAvailabilityType, forcedShardID core.OptionalUint32) ([]*data.NodeData, error) {
-	if forcedShardID.HasValue {
-		return ap.proc.GetObservers(forcedShardID.Value, availability)
-	}
-
-	addressBytes, err := ap.pubKeyConverter.Decode(address)
+// getObserversForAddress returns the observers of the shard of the provided address. The queries naming an epoch are
+// routed to the full history nodes holding that epoch
+func (ap *AccountProcessor) getObserversForAddress(address string, availability data.ObserverDataAvailabilityType, options common.AccountQueryOptions) ([]*data.NodeData, error) {
+	shardID, err := ap.getShardForAddress(address, options.ForcedShardID)
 	if err != nil {
 		return nil, err
 	}
 
-	shardID, err := ap.proc.ComputeShardId(addressBytes)
-	if err != nil {
-		return nil, err
+	if options.OnStartOfEpoch.HasValue {
+		return getNodesForEpoch(ap.proc, shardID, options.OnStartOfEpoch.Value)
+	}
+	if options.HintEpoch.HasValue {
+		return getNodesForEpoch(ap.proc, shardID, options.HintEpoch.Value)
 	}
 
 	return ap.proc.GetObservers(shardID, availability)
 }
 
+func (ap *AccountProcessor) getShardForAddress(address string, forcedShardID core.OptionalUint32) (uint32, error) {
+	if forcedShardID.HasValue {
+		return forcedShardID.Value, nil
+	}
+
+	addressBytes, err := ap.pubKeyConverter.Decode(address)
+	if err != nil {
+		return 0, err
+	}
+
+	return ap.proc.ComputeShardId(addressBytes)
+}
+
 // GetBaseProcessor returns the base processor
 func (ap *AccountProcessor) GetBaseProcessor() Processor {
 	return ap.proc
@@ -513,7 +617,7 @@ func (ap *AccountProcessor) GetBaseProcessor() Processor {
 
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address, data.AvailabilityRecent, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, data.AvailabilityRecent, options)
 	if err != nil {
 		return nil, err
 	}
@@ -544,7 +648,7 @@ func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.Ac
 
 // IterateKeys returns keys from the given address, along with the iterator state from which to continue
 func (ap *AccountProcessor) IterateKeys(address string, numKeys uint, iteratorState [][]byte, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address, data.AvailabilityRecent, options.ForcedShardID)
+	observers, err := ap.getObserversForAddress(address, data.AvailabilityRecent, options)
 	if err != nil {
 		return nil, err
 	}
@@ -578,6 +682,93 @@ func (ap *AccountProcessor) IterateKeys(address string, numKeys uint, iteratorSt
 	return nil, WrapObserversError(apiResponse.Error)
 }
 
+// ExportAccountData returns a chunk of the key-value pairs of the data trie of the given address, read from a full
+// history observer, along with the cursor from which the export continues. The first chunk pins the export to the
+// block it was read from, so the next chunks are read from the same state
+func (ap *AccountProcessor) ExportAccountData(address string, exportOptions common.AccountExportOptions, options common.AccountQueryOptions) (*data.AccountDataExport, error) {
+	var iteratorState [][]byte
+	if exportOptions.Cursor != nil {
+		iteratorState = exportOptions.Cursor.IteratorState
+		options = pinAccountQueryOptionsToBlock(options, exportOptions.Cursor.BlockNonce)
+	}
+
+	observers, err := ap.getObserversForAddress(address, data.AvailabilityAll, options)
+	if err != nil {
+		return nil, err
+	}
+
+	iterateKeysReq := data.IterateKeysRequest{
+		Address:       address,
+		NumKeys:       uint(exportOptions.NumKeys),
+		IteratorState: iteratorState,
+	}
+
+	apiResponse := data.IterateKeysApiResponse{}
+	apiPath := addressPath + "iterate-keys"
+	apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
+	for _, observer := range observers {
+		respCode, err := ap.proc.CallPostRestEndPoint(observer.Address, apiPath, iterateKeysReq, &apiResponse)
+		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			log.Info("account data export request",
+				"address", address,
+				"shard ID", observer.ShardId,
+				"observer", observer.Address,
+				"http code", respCode)
+			if apiResponse.Error != "" {
+				return nil, errors.New(apiResponse.Error)
+			}
+
+			return createAccountDataExport(apiResponse.Data, exportOptions.Cursor)
+		}
+
+		log.Error("account data export request", "observer", observer.Address, "address", address, "error", err.Error())
+	}
+
+	return nil, WrapObserversError(apiResponse.Error)
+}
+
+func createAccountDataExport(responseData data.IterateKeysResponseData, cursor *data.AccountExportCursor) (*data.AccountDataExport, error) {
+	export := &data.AccountDataExport{
+		Pairs:     responseData.Pairs,
+		BlockInfo: responseData.BlockInfo,
+	}
+	if export.Pairs == nil {
+		export.Pairs = make(map[string]string)
+	}
+	if len(responseData.NewIteratorState) == 0 {
+		return export, nil
+	}
+
+	blockNonce := responseData.BlockInfo.Nonce
+	if cursor != nil && cursor.BlockNonce > 0 {
+		blockNonce = cursor.BlockNonce
+	}
+
+	nextCursor, err := data.EncodeAccountExportCursor(&data.AccountExportCursor{
+		IteratorState: responseData.NewIteratorState,
+		BlockNonce:    blockNonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+	export.NextCursor = nextCursor
+
+	return export, nil
+}
+
+// pinAccountQueryOptionsToBlock replaces the block coordinates of the provided options with the given block nonce, if
+// known
+func pinAccountQueryOptionsToBlock(options common.AccountQueryOptions, blockNonce uint64) common.AccountQueryOptions {
+	if blockNonce == 0 {
+		return options
+	}
+
+	return common.AccountQueryOptions{
+		ForcedShardID: options.ForcedShardID,
+		BlockNonce:    core.OptionalUint64{Value: blockNonce, HasValue: true},
+	}
+}
+
 // WrapObserversError wraps the observers error
 func WrapObserversError(responseError string) error {
 	if len(responseError) == 0 {
@@ -590,3 +781,8 @@ func WrapObserversError(responseError string) error {
 func (ap *AccountProcessor) getAvailabilityBasedOnAccountQueryOptions(options common.AccountQueryOptions) data.ObserverDataAvailabilityType {
 	return ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ap *AccountProcessor) IsInterfaceNil() bool {
+	return ap == nil
+}
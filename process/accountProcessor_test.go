@@ -1,15 +1,20 @@
 package process_test
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
@@ -20,7 +25,7 @@ import (
 func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{}, config.QuorumReadsConfig{})
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -29,7 +34,7 @@ func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewAccountProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil)
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil, config.QuorumReadsConfig{})
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -38,7 +43,7 @@ func TestNewAccountProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, config.QuorumReadsConfig{})
 
 	assert.NotNil(t, ap)
 	assert.Nil(t, err)
@@ -49,8 +54,8 @@ func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 func TestAccountProcessor_GetAccountInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	accnt, err := ap.GetAccount("invalid hex number", common.AccountQueryOptions{})
+	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, config.QuorumReadsConfig{})
+	accnt, err := ap.GetAccount(context.Background(), "invalid hex number", common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.NotNil(t, err)
@@ -68,9 +73,10 @@ func TestAccountProcessor_GetAccountComputeShardIdFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -90,9 +96,10 @@ func TestAccountProcessor_GetAccountGetObserversFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -118,9 +125,10 @@ func TestAccountProcessor_GetAccountSendingFailsOnAllObserversShouldErr(t *testi
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.True(t, errors.Is(err, process.ErrSendingRequest))
@@ -158,14 +166,218 @@ func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 	address := "DEADBEEF"
-	accountModel, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accountModel, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Equal(t, respondedAccount.Account, accountModel.Account)
 	assert.Nil(t, err)
 }
 
+func TestAccountProcessor_GetAccountWithEpochShouldUseNodesHoldingTheEpoch(t *testing.T) {
+	t.Parallel()
+
+	fullHistoryNodes := []*data.NodeData{
+		{Address: "archive0", ShardId: 1, MinEpoch: 0, MaxEpoch: 99},
+		{Address: "archive1", ShardId: 1, MinEpoch: 100},
+	}
+	createAccountProcessor := func(calledAddresses *[]string) *process.AccountProcessor {
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 1, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+				},
+				GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					require.Equal(t, uint32(1), shardId)
+					return fullHistoryNodes, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					*calledAddresses = append(*calledAddresses, address)
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
+		)
+
+		return ap
+	}
+
+	testCases := map[string]struct {
+		options         common.AccountQueryOptions
+		expectedAddress string
+	}{
+		"no epoch should use the observers": {
+			options:         common.AccountQueryOptions{},
+			expectedAddress: "observer",
+		},
+		"on start of old epoch should use the node holding it": {
+			options:         common.AccountQueryOptions{OnStartOfEpoch: core.OptionalUint32{Value: 42, HasValue: true}},
+			expectedAddress: "archive0",
+		},
+		"hint epoch should use the node holding it": {
+			options:         common.AccountQueryOptions{HintEpoch: core.OptionalUint32{Value: 120, HasValue: true}},
+			expectedAddress: "archive1",
+		},
+		"on start of epoch should take precedence over the hint epoch": {
+			options: common.AccountQueryOptions{
+				OnStartOfEpoch: core.OptionalUint32{Value: 150, HasValue: true},
+				HintEpoch:      core.OptionalUint32{Value: 10, HasValue: true},
+			},
+			expectedAddress: "archive1",
+		},
+	}
+	for name, testCase := range testCases {
+		tc := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calledAddresses := make([]string, 0)
+			ap := createAccountProcessor(&calledAddresses)
+			_, err := ap.GetAccount(context.Background(), "DEADBEEF", tc.options)
+			require.Nil(t, err)
+			require.Equal(t, []string{tc.expectedAddress}, calledAddresses)
+		})
+	}
+}
+
+func TestNewAccountProcessor_InvalidQuorumReadsConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, config.QuorumReadsConfig{NumObservers: 2, MinAgreements: 3})
+	require.Nil(t, ap)
+	require.True(t, errors.Is(err, process.ErrInvalidQuorumReadsConfig))
+
+	ap, err = process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, config.QuorumReadsConfig{NumObservers: -1})
+	require.Nil(t, ap)
+	require.True(t, errors.Is(err, process.ErrInvalidQuorumReadsConfig))
+}
+
+func TestAccountProcessor_GetAccountWithQuorum(t *testing.T) {
+	t.Parallel()
+
+	shardObservers := []*data.NodeData{
+		{Address: "address1", ShardId: 0},
+		{Address: "address2", ShardId: 0},
+		{Address: "address3", ShardId: 0},
+		{Address: "address4", ShardId: 0},
+	}
+	createAccountProcessor := func(quorumReads config.QuorumReadsConfig, accounts map[string]data.Account, statusCodes map[string]int, numCalls *uint32) *process.AccountProcessor {
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+					return shardObservers, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					atomic.AddUint32(numCalls, 1)
+					statusCode, found := statusCodes[address]
+					if found {
+						return statusCode, errors.New("observer error")
+					}
+
+					valRespond := value.(*data.AccountApiResponse)
+					valRespond.Data.Account = accounts[address]
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			quorumReads,
+		)
+
+		return ap
+	}
+	sameAccounts := map[string]data.Account{
+		"address1": {Nonce: 5, Balance: "100"},
+		"address2": {Nonce: 5, Balance: "100"},
+		"address3": {Nonce: 5, Balance: "100"},
+		"address4": {Nonce: 5, Balance: "100"},
+	}
+
+	t.Run("quorum reads not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		ap := createAccountProcessor(config.QuorumReadsConfig{}, sameAccounts, nil, &numCalls)
+		model, err := ap.GetAccountWithQuorum("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, model)
+		require.Equal(t, process.ErrQuorumReadsNotEnabled, err)
+		require.Zero(t, atomic.LoadUint32(&numCalls))
+	})
+	t.Run("all observers agree", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		ap := createAccountProcessor(config.QuorumReadsConfig{NumObservers: 3, MinAgreements: 2}, sameAccounts, nil, &numCalls)
+		model, err := ap.GetAccountWithQuorum("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, uint64(5), model.Account.Nonce)
+		require.Equal(t, "100", model.Account.Balance)
+		require.Equal(t, data.QuorumReadInfo{NumResponses: 3, NumAgreements: 3, MinAgreements: 2, Confidence: 1}, model.Quorum)
+		require.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("majority agrees", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := map[string]data.Account{
+			"address1": {Nonce: 4, Balance: "90"},
+			"address2": {Nonce: 5, Balance: "100"},
+			"address3": {Nonce: 5, Balance: "100"},
+		}
+		numCalls := uint32(0)
+		ap := createAccountProcessor(config.QuorumReadsConfig{NumObservers: 3, MinAgreements: 2}, accounts, nil, &numCalls)
+		model, err := ap.GetAccountWithQuorum("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, uint64(5), model.Account.Nonce)
+		require.Equal(t, "100", model.Account.Balance)
+		require.Equal(t, 2, model.Quorum.NumAgreements)
+		require.Equal(t, 3, model.Quorum.NumResponses)
+		require.InDelta(t, 2.0/3.0, model.Quorum.Confidence, 0.0001)
+	})
+	t.Run("observers disagree should err", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := map[string]data.Account{
+			"address1": {Nonce: 4, Balance: "90"},
+			"address2": {Nonce: 5, Balance: "90"},
+			"address3": {Nonce: 5, Balance: "100"},
+		}
+		numCalls := uint32(0)
+		ap := createAccountProcessor(config.QuorumReadsConfig{NumObservers: 3, MinAgreements: 2}, accounts, nil, &numCalls)
+		model, err := ap.GetAccountWithQuorum("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, model)
+		require.True(t, errors.Is(err, process.ErrQuorumReadNotReached))
+	})
+	t.Run("unavailable observer should be replaced", func(t *testing.T) {
+		t.Parallel()
+
+		statusCodes := map[string]int{"address2": http.StatusNotFound}
+		numCalls := uint32(0)
+		ap := createAccountProcessor(config.QuorumReadsConfig{NumObservers: 3, MinAgreements: 3}, sameAccounts, statusCodes, &numCalls)
+		model, err := ap.GetAccountWithQuorum("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, 3, model.Quorum.NumAgreements)
+		require.Equal(t, uint32(4), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("observer error should not be replaced", func(t *testing.T) {
+		t.Parallel()
+
+		statusCodes := map[string]int{"address2": http.StatusInternalServerError}
+		numCalls := uint32(0)
+		ap := createAccountProcessor(config.QuorumReadsConfig{NumObservers: 3, MinAgreements: 3}, sameAccounts, statusCodes, &numCalls)
+		model, err := ap.GetAccountWithQuorum("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, model)
+		require.True(t, errors.Is(err, process.ErrQuorumReadNotReached))
+		require.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+	})
+}
+
 func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -187,6 +399,7 @@ func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 
 	key := "key"
@@ -215,6 +428,7 @@ func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 
 	key := "key"
@@ -248,6 +462,7 @@ func TestAccountProcessor_GetShardIForAddressShouldWork(t *testing.T) {
 			},
 		},
 		bech32C,
+		config.QuorumReadsConfig{},
 	)
 
 	shardID, err := ap.GetShardIDForAddress(addressShard1)
@@ -270,6 +485,7 @@ func TestAccountProcessor_GetShardIDForAddressShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 
 	shardID, err := ap.GetShardIDForAddress("aaaa")
@@ -277,6 +493,58 @@ func TestAccountProcessor_GetShardIDForAddressShouldError(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 }
 
+func TestAccountProcessor_GetShardsOfAddresses(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return uint32(addressBuff[0]), nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
+	)
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		addressesShards, err := ap.GetShardsOfAddresses([]string{"00aa", "not hex"})
+		assert.Nil(t, addressesShards)
+		assert.ErrorIs(t, err, process.ErrInvalidAddress)
+		assert.Contains(t, err.Error(), "not hex")
+	})
+	t.Run("addresses in different shards", func(t *testing.T) {
+		t.Parallel()
+
+		addressesShards, err := ap.GetShardsOfAddresses([]string{"00aa", "01bb", "00cc"})
+		require.NoError(t, err)
+
+		expectedAddresssShards := &data.AddressesShards{
+			Addresses: []*data.AddressShard{
+				{Address: "00aa", ShardID: 0},
+				{Address: "01bb", ShardID: 1},
+				{Address: "00cc", ShardID: 0},
+			},
+			Pairs: []*data.AddressesPair{
+				{First: "00aa", Second: "01bb", IsIntraShard: false},
+				{First: "00aa", Second: "00cc", IsIntraShard: true},
+				{First: "01bb", Second: "00cc", IsIntraShard: false},
+			},
+			AreAllInSameShard: false,
+		}
+		assert.Equal(t, expectedAddresssShards, addressesShards)
+	})
+	t.Run("addresses in the same shard", func(t *testing.T) {
+		t.Parallel()
+
+		addressesShards, err := ap.GetShardsOfAddresses([]string{"02aa", "02bb"})
+		require.NoError(t, err)
+		assert.True(t, addressesShards.AreAllInSameShard)
+		assert.Equal(t, []*data.AddressesPair{{First: "02aa", Second: "02bb", IsIntraShard: true}}, addressesShards.Pairs)
+	})
+}
+
 func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 	t.Parallel()
 
@@ -288,6 +556,7 @@ func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 
 	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
@@ -315,6 +584,7 @@ func TestAccountProcessor_GetESDTsWithRoleApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 
 	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
@@ -343,6 +613,7 @@ func TestAccountProcessor_GetESDTsWithRoleShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 	address := "DEADBEEF"
 	response, err := ap.GetESDTsWithRole(address, "role", common.AccountQueryOptions{})
@@ -350,6 +621,100 @@ func TestAccountProcessor_GetESDTsWithRoleShouldWork(t *testing.T) {
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
 
+func TestAccountProcessor_GetESDTTokensList(t *testing.T) {
+	t.Parallel()
+
+	observerTokens := map[string]json.RawMessage{
+		"WEGLD-bd4d79":     json.RawMessage(`{"tokenIdentifier":"WEGLD-bd4d79","balance":"100"}`),
+		"MEX-455c57":       json.RawMessage(`{"tokenIdentifier":"MEX-455c57","balance":"5"}`),
+		"PUNK-a1b2c3-01":   json.RawMessage(`{"tokenIdentifier":"PUNK-a1b2c3-01","balance":"1","nonce":1,"name":"Punk #1","type":"NonFungibleESDT"}`),
+		"TICKET-d4e5f6-0a": json.RawMessage(`{"tokenIdentifier":"TICKET-d4e5f6-0a","balance":"7","nonce":10,"name":"Ticket"}`),
+		"LKMEX-aab910-2c":  json.RawMessage(`{"tokenIdentifier":"LKMEX-aab910-2c","balance":"70","nonce":44,"type":"DynamicMetaESDT"}`),
+		"OLDNFT-f7e8d9-03": json.RawMessage(`{"tokenIdentifier":"OLDNFT-f7e8d9-03","balance":"1","nonce":3,"name":"Old punk"}`),
+	}
+	createAccountProcessor := func() *process.AccountProcessor {
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(_ []byte) (u uint32, e error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+					return []*data.NodeData{{Address: "address", ShardId: 0}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					require.Equal(t, "/address/DEADBEEF/esdt", path)
+					tokensResponse := value.(*data.AccountESDTTokensApiResponse)
+					tokensResponse.Data.ESDTs = observerTokens
+					tokensResponse.Data.BlockInfo = data.BlockInfo{Nonce: 37}
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
+		)
+
+		return ap
+	}
+	getIdentifiers := func(response *data.AccountESDTTokensListResponse) []string {
+		identifiers := make([]string, 0, len(response.Data.ESDTs))
+		for _, token := range response.Data.ESDTs {
+			summary := struct {
+				TokenIdentifier string `json:"tokenIdentifier"`
+			}{}
+			_ = json.Unmarshal(token, &summary)
+			identifiers = append(identifiers, summary.TokenIdentifier)
+		}
+
+		return identifiers
+	}
+
+	t.Run("invalid type filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := createAccountProcessor().GetESDTTokensList("DEADBEEF", common.AccountQueryOptions{}, common.ESDTTokensFilterOptions{TokenType: "coins"})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInvalidESDTTypeFilter))
+	})
+
+	t.Run("no filter should return all tokens sorted by identifier", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := createAccountProcessor().GetESDTTokensList("DEADBEEF", common.AccountQueryOptions{}, common.ESDTTokensFilterOptions{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(37), response.Data.BlockInfo.Nonce)
+		require.Equal(t, []string{"LKMEX-aab910-2c", "MEX-455c57", "OLDNFT-f7e8d9-03", "PUNK-a1b2c3-01", "TICKET-d4e5f6-0a", "WEGLD-bd4d79"}, getIdentifiers(response))
+		require.JSONEq(t, string(observerTokens["MEX-455c57"]), string(response.Data.ESDTs[1]))
+	})
+
+	t.Run("type filters should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedIdentifiers := map[string][]string{
+			data.ESDTTypeFungible: {"MEX-455c57", "WEGLD-bd4d79"},
+			data.ESDTTypeNFT:      {"OLDNFT-f7e8d9-03", "PUNK-a1b2c3-01"},
+			data.ESDTTypeSFT:      {"TICKET-d4e5f6-0a"},
+			data.ESDTTypeMeta:     {"LKMEX-aab910-2c"},
+		}
+		for tokenType, identifiers := range expectedIdentifiers {
+			response, err := createAccountProcessor().GetESDTTokensList("DEADBEEF", common.AccountQueryOptions{}, common.ESDTTokensFilterOptions{TokenType: tokenType})
+			require.NoError(t, err)
+			require.Equal(t, identifiers, getIdentifiers(response), tokenType)
+		}
+	})
+
+	t.Run("search should match identifier or name", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := createAccountProcessor().GetESDTTokensList("DEADBEEF", common.AccountQueryOptions{}, common.ESDTTokensFilterOptions{TokenType: data.ESDTTypeNFT, Search: "PUNK"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"OLDNFT-f7e8d9-03", "PUNK-a1b2c3-01"}, getIdentifiers(response))
+
+		response, err = createAccountProcessor().GetESDTTokensList("DEADBEEF", common.AccountQueryOptions{}, common.ESDTTokensFilterOptions{Search: "mex"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"LKMEX-aab910-2c", "MEX-455c57"}, getIdentifiers(response))
+	})
+}
+
 func TestAccountProcessor_GetESDTsRolesGetObserversFails(t *testing.T) {
 	t.Parallel()
 
@@ -361,6 +726,7 @@ func TestAccountProcessor_GetESDTsRolesGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 
 	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
@@ -388,6 +754,7 @@ func TestAccountProcessor_GetESDTsRolesApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 
 	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
@@ -416,6 +783,7 @@ func TestAccountProcessor_GetESDTsRolesShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 	address := "DEADBEEF"
 	response, err := ap.GetESDTsRoles(address, common.AccountQueryOptions{})
@@ -443,6 +811,7 @@ func TestAccountProcessor_GetCodeHash(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		config.QuorumReadsConfig{},
 	)
 	address := "DEADBEEF"
 	response, err := ap.GetCodeHash(address, common.AccountQueryOptions{})
@@ -450,6 +819,69 @@ func TestAccountProcessor_GetCodeHash(t *testing.T) {
 	require.Equal(t, "code-hash", response.Data.([]string)[0])
 }
 
+func TestAccountProcessor_GetGuardianData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("observer error should err", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+				},
+				CallGetRestEndPointCalled: func(_ string, _ string, value interface{}) (int, error) {
+					guardianDataResponse := value.(*data.GenericAPIResponse)
+					guardianDataResponse.Error = "account not found"
+					return http.StatusBadRequest, errors.New("bad request")
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
+		)
+
+		response, err := ap.GetGuardianData("DEADBEEF", common.AccountQueryOptions{})
+		require.Nil(t, response)
+		require.Equal(t, "account not found", err.Error())
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedGuardianData := data.GuardianData{
+			ActiveGuardian: &data.Guardian{Address: "guardian", ActivationEpoch: 10, ServiceUID: "service"},
+			Guarded:        true,
+		}
+		calledPath := ""
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+				},
+				CallGetRestEndPointCalled: func(_ string, path string, value interface{}) (int, error) {
+					calledPath = path
+					guardianDataResponse := value.(*data.GenericAPIResponse)
+					guardianDataResponse.Data = data.GuardianDataModel{GuardianData: expectedGuardianData}
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
+		)
+
+		response, err := ap.GetGuardianData("DEADBEEF", common.AccountQueryOptions{OnFinalBlock: true})
+		require.NoError(t, err)
+		require.Equal(t, expectedGuardianData, response.Data.(data.GuardianDataModel).GuardianData)
+		require.Equal(t, "/address/DEADBEEF/guardian-data?onFinalBlock=true", calledPath)
+	})
+}
+
 func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 	t.Parallel()
 
@@ -461,6 +893,7 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
 		)
 
 		result, err := ap.IsDataTrieMigrated("address", common.AccountQueryOptions{})
@@ -488,6 +921,7 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
 		)
 
 		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
@@ -517,6 +951,7 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
 		)
 
 		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
@@ -563,6 +998,7 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
 		)
 
 		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
@@ -608,6 +1044,7 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
 		)
 
 		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
@@ -626,6 +1063,92 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 	})
 }
 
+func TestAccountProcessor_ExportAccountData(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(handler func(path string, request data.IterateKeysRequest, response *data.IterateKeysApiResponse)) *process.AccountProcessor {
+		ap, _ := process.NewAccountProcessor(
+			&mock.ProcessorStub{
+				GetObserversCalled: func(_ uint32, availability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					require.Equal(t, data.AvailabilityAll, availability)
+
+					return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+				},
+				CallPostRestEndPointCalled: func(_ string, path string, request interface{}, response interface{}) (int, error) {
+					handler(path, request.(data.IterateKeysRequest), response.(*data.IterateKeysApiResponse))
+					return http.StatusOK, nil
+				},
+				ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+					return 0, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
+		)
+
+		return ap
+	}
+
+	t.Run("observer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createProcessor(func(_ string, _ data.IterateKeysRequest, response *data.IterateKeysApiResponse) {
+			response.Error = "trie error"
+		})
+
+		result, err := ap.ExportAccountData("DEADBEEF", common.AccountExportOptions{NumKeys: 10}, common.AccountQueryOptions{})
+		require.Nil(t, result)
+		require.Equal(t, "trie error", err.Error())
+	})
+	t.Run("first chunk should pin the export to its block", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createProcessor(func(path string, request data.IterateKeysRequest, response *data.IterateKeysApiResponse) {
+			require.Equal(t, "/address/iterate-keys", path)
+			require.Equal(t, uint(10), request.NumKeys)
+			require.Empty(t, request.IteratorState)
+
+			response.Data = data.IterateKeysResponseData{
+				Pairs:            map[string]string{"key1": "value1"},
+				NewIteratorState: [][]byte{[]byte("state")},
+				BlockInfo:        data.BlockInfo{Nonce: 37, Hash: "hash"},
+			}
+		})
+
+		result, err := ap.ExportAccountData("DEADBEEF", common.AccountExportOptions{NumKeys: 10}, common.AccountQueryOptions{})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"key1": "value1"}, result.Pairs)
+		require.Equal(t, uint64(37), result.BlockInfo.Nonce)
+
+		cursor, err := data.DecodeAccountExportCursor(result.NextCursor)
+		require.NoError(t, err)
+		require.Equal(t, &data.AccountExportCursor{IteratorState: [][]byte{[]byte("state")}, BlockNonce: 37}, cursor)
+	})
+	t.Run("next chunks should be read from the pinned block", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createProcessor(func(path string, request data.IterateKeysRequest, response *data.IterateKeysApiResponse) {
+			require.Equal(t, "/address/iterate-keys?blockNonce=37", path)
+			require.Equal(t, [][]byte{[]byte("state")}, request.IteratorState)
+
+			response.Data = data.IterateKeysResponseData{
+				Pairs:     map[string]string{"key2": "value2"},
+				BlockInfo: data.BlockInfo{Nonce: 37, Hash: "hash"},
+			}
+		})
+
+		exportOptions := common.AccountExportOptions{
+			NumKeys: 10,
+			Cursor:  &data.AccountExportCursor{IteratorState: [][]byte{[]byte("state")}, BlockNonce: 37},
+		}
+		queryOptions := common.AccountQueryOptions{OnFinalBlock: true}
+		result, err := ap.ExportAccountData("DEADBEEF", exportOptions, queryOptions)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"key2": "value2"}, result.Pairs)
+		require.Empty(t, result.NextCursor)
+	})
+}
+
 func TestAccountProcessor_IterateKeys(t *testing.T) {
 	t.Parallel()
 
@@ -639,6 +1162,7 @@ func TestAccountProcessor_IterateKeys(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
 		)
 
 		result, err := ap.IterateKeys("address", 0, nil, common.AccountQueryOptions{})
@@ -666,6 +1190,7 @@ func TestAccountProcessor_IterateKeys(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
 		)
 
 		result, err := ap.IterateKeys("DEADBEEF", 10, [][]byte{[]byte("iterator state")}, common.AccountQueryOptions{})
@@ -705,6 +1230,7 @@ func TestAccountProcessor_IterateKeys(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			config.QuorumReadsConfig{},
 		)
 
 		result, err := ap.IterateKeys("DEADBEEF", 10, [][]byte{[]byte("original iterator state")}, common.AccountQueryOptions{})
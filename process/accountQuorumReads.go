@@ -0,0 +1,152 @@
+package process
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type accountReadResult struct {
+	observer   string
+	statusCode int
+	account    *data.AccountModel
+	err        error
+}
+
+// isObserverUnavailable returns true if the observer was down or didn't respond in time, so the next observer can be tried
+func (result *accountReadResult) isObserverUnavailable() bool {
+	return result.statusCode == http.StatusNotFound || result.statusCode == http.StatusRequestTimeout
+}
+
+// accountReadKey holds the fields the observers should agree on
+type accountReadKey struct {
+	nonce   uint64
+	balance string
+}
+
+func checkQuorumReadsConfig(cfg config.QuorumReadsConfig) error {
+	if cfg.NumObservers < 0 {
+		return fmt.Errorf("%w, NumObservers: %d", ErrInvalidQuorumReadsConfig, cfg.NumObservers)
+	}
+	if cfg.NumObservers <= 1 {
+		return nil
+	}
+	if cfg.MinAgreements < 1 || cfg.MinAgreements > cfg.NumObservers {
+		return fmt.Errorf("%w, MinAgreements: %d, NumObservers: %d",
+			ErrInvalidQuorumReadsConfig, cfg.MinAgreements, cfg.NumObservers)
+	}
+
+	return nil
+}
+
+// GetAccountWithQuorum reads the account, in parallel, from the configured number of observers of its shard and returns
+// it only if at least the configured minimum number of observers agree on its nonce and balance. The unavailable
+// observers are replaced by the next ones from the shard
+func (ap *AccountProcessor) GetAccountWithQuorum(address string, options common.AccountQueryOptions) (*data.AccountQuorumModel, error) {
+	if ap.quorumReads.NumObservers <= 1 {
+		return nil, ErrQuorumReadsNotEnabled
+	}
+
+	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
+	observers, err := ap.getObserversForAddress(address, availability, options)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*accountReadResult, 0, ap.quorumReads.NumObservers)
+	numFailedObservers := 0
+	var lastErr error
+	remainingObservers := observers
+	for len(responses)+numFailedObservers < ap.quorumReads.NumObservers && len(remainingObservers) > 0 {
+		numObserversToCall := ap.quorumReads.NumObservers - len(responses) - numFailedObservers
+		if numObserversToCall > len(remainingObservers) {
+			numObserversToCall = len(remainingObservers)
+		}
+
+		results := ap.getAccountFromObservers(address, options, remainingObservers[:numObserversToCall])
+		remainingObservers = remainingObservers[numObserversToCall:]
+		for _, result := range results {
+			switch {
+			case result.err == nil:
+				responses = append(responses, result)
+			case result.isObserverUnavailable():
+				log.LogIfError(result.err)
+			default:
+				log.Debug("account quorum read: observer error", "observer", result.observer, "status code", result.statusCode, "error", result.err)
+				lastErr = result.err
+				numFailedObservers++
+			}
+		}
+	}
+
+	if len(responses) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	return ap.computeAccountQuorum(address, responses)
+}
+
+func (ap *AccountProcessor) computeAccountQuorum(address string, responses []*accountReadResult) (*data.AccountQuorumModel, error) {
+	agreements := make(map[accountReadKey][]*accountReadResult)
+	var bestKey accountReadKey
+	for _, response := range responses {
+		key := accountReadKey{
+			nonce:   response.account.Account.Nonce,
+			balance: response.account.Account.Balance,
+		}
+		agreements[key] = append(agreements[key], response)
+		if len(agreements[key]) > len(agreements[bestKey]) {
+			bestKey = key
+		}
+	}
+
+	numAgreements := len(agreements[bestKey])
+	if len(agreements) > 1 {
+		log.Warn("account quorum read: observers returned different values",
+			"address", address, "num responses", len(responses), "num distinct values", len(agreements))
+	}
+	if numAgreements < ap.quorumReads.MinAgreements {
+		return nil, fmt.Errorf("%w, %d observers agreed out of the required %d, %d observers responded",
+			ErrQuorumReadNotReached, numAgreements, ap.quorumReads.MinAgreements, len(responses))
+	}
+
+	log.Info("account quorum read", "address", address, "num responses", len(responses), "num agreements", numAgreements)
+
+	return &data.AccountQuorumModel{
+		AccountModel: *agreements[bestKey][0].account,
+		Quorum: data.QuorumReadInfo{
+			NumResponses:  len(responses),
+			NumAgreements: numAgreements,
+			MinAgreements: ap.quorumReads.MinAgreements,
+			Confidence:    float64(numAgreements) / float64(len(responses)),
+		},
+	}, nil
+}
+
+func (ap *AccountProcessor) getAccountFromObservers(address string, options common.AccountQueryOptions, observers []*data.NodeData) []*accountReadResult {
+	results := make([]*accountReadResult, len(observers))
+	url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
+	wg := sync.WaitGroup{}
+	wg.Add(len(observers))
+	for idx, observer := range observers {
+		go func(idx int, observer *data.NodeData) {
+			defer wg.Done()
+
+			responseAccount := data.AccountApiResponse{}
+			respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, url, &responseAccount)
+			results[idx] = &accountReadResult{
+				observer:   observer.Address,
+				statusCode: respCode,
+				account:    &responseAccount.Data,
+				err:        err,
+			}
+		}(idx, observer)
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,102 @@
+package process
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// maxAddressesToConvert is the maximum number of addresses converted in a single request
+	maxAddressesToConvert = 1000
+	hexPrefix             = "0x"
+)
+
+type addressConverterProcessor struct {
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewAddressConverterProcessor will create a new instance of the processor converting the addresses between the
+// bech32 and the hex formats
+func NewAddressConverterProcessor(pubKeyConverter core.PubkeyConverter) (*addressConverterProcessor, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &addressConverterProcessor{
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// ConvertAddresses converts each of the provided addresses to the other format: the bech32 addresses to hex public keys
+// and the hex public keys (optionally 0x prefixed) to bech32 addresses. The invalid addresses do not fail the entire
+// batch, their conversions holding the error instead
+func (acp *addressConverterProcessor) ConvertAddresses(addresses []string) ([]data.AddressConversion, error) {
+	if len(addresses) == 0 {
+		return nil, ErrNoAddressesToConvert
+	}
+	if len(addresses) > maxAddressesToConvert {
+		return nil, ErrTooManyAddressesToConvert
+	}
+
+	conversions := make([]data.AddressConversion, 0, len(addresses))
+	for _, address := range addresses {
+		conversions = append(conversions, acp.convertAddress(address))
+	}
+
+	return conversions, nil
+}
+
+func (acp *addressConverterProcessor) convertAddress(address string) data.AddressConversion {
+	conversion := data.AddressConversion{
+		Input: address,
+	}
+
+	pubKey, err := acp.decodeAddress(address)
+	if err != nil {
+		conversion.Error = err.Error()
+		return conversion
+	}
+
+	conversion.Bech32, err = acp.pubKeyConverter.Encode(pubKey)
+	if err != nil {
+		conversion.Error = err.Error()
+		return conversion
+	}
+	conversion.Hex = hex.EncodeToString(pubKey)
+
+	return conversion
+}
+
+// decodeAddress returns the public key of the address, provided either in the bech32 or in the hex format
+func (acp *addressConverterProcessor) decodeAddress(address string) ([]byte, error) {
+	pubKey, err := acp.pubKeyConverter.Decode(address)
+	if err == nil {
+		return pubKey, nil
+	}
+
+	pubKey, err = hex.DecodeString(strings.TrimPrefix(address, hexPrefix))
+	if err != nil || len(pubKey) != acp.pubKeyConverter.Len() {
+		return nil, ErrInvalidAddress
+	}
+
+	return pubKey, nil
+}
+
+// EncodeAddressAsHex returns the hex public key of the provided bech32 address
+func (acp *addressConverterProcessor) EncodeAddressAsHex(address string) (string, error) {
+	pubKey, err := acp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return "", ErrInvalidAddress
+	}
+
+	return hex.EncodeToString(pubKey), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (acp *addressConverterProcessor) IsInterfaceNil() bool {
+	return acp == nil
+}
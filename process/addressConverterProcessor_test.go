@@ -0,0 +1,69 @@
+package process_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+const testHexPubKey = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+
+func TestNewAddressConverterProcessor(t *testing.T) {
+	t.Parallel()
+
+	acp, err := process.NewAddressConverterProcessor(nil)
+	require.Nil(t, acp)
+	require.Equal(t, process.ErrNilPubKeyConverter, err)
+
+	acp, err = process.NewAddressConverterProcessor(testPubkeyConverter)
+	require.NoError(t, err)
+	require.False(t, acp.IsInterfaceNil())
+}
+
+func TestAddressConverterProcessor_ConvertAddresses(t *testing.T) {
+	t.Parallel()
+
+	acp, _ := process.NewAddressConverterProcessor(testPubkeyConverter)
+
+	conversions, err := acp.ConvertAddresses(nil)
+	require.Nil(t, conversions)
+	require.Equal(t, process.ErrNoAddressesToConvert, err)
+
+	conversions, err = acp.ConvertAddresses(make([]string, 1001))
+	require.Nil(t, conversions)
+	require.Equal(t, process.ErrTooManyAddressesToConvert, err)
+
+	conversions, err = acp.ConvertAddresses([]string{
+		testHistoryAddress,
+		testHexPubKey,
+		"0x" + strings.ToUpper(testHexPubKey),
+		testHexPubKey[2:],
+		"invalid",
+	})
+	require.NoError(t, err)
+	require.Len(t, conversions, 5)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, testHistoryAddress, conversions[i].Bech32)
+		require.Equal(t, testHexPubKey, conversions[i].Hex)
+		require.Empty(t, conversions[i].Error)
+	}
+	require.Equal(t, "0x"+strings.ToUpper(testHexPubKey), conversions[2].Input)
+	require.Equal(t, process.ErrInvalidAddress.Error(), conversions[3].Error)
+	require.Empty(t, conversions[3].Bech32)
+	require.Equal(t, process.ErrInvalidAddress.Error(), conversions[4].Error)
+}
+
+func TestAddressConverterProcessor_EncodeAddressAsHex(t *testing.T) {
+	t.Parallel()
+
+	acp, _ := process.NewAddressConverterProcessor(testPubkeyConverter)
+
+	hexAddress, err := acp.EncodeAddressAsHex(testHistoryAddress)
+	require.NoError(t, err)
+	require.Equal(t, testHexPubKey, hexAddress)
+
+	_, err = acp.EncodeAddressAsHex(testHexPubKey)
+	require.Equal(t, process.ErrInvalidAddress, err)
+}
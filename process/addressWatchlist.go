@@ -0,0 +1,199 @@
+package process
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type watchedAccountState struct {
+	isKnown bool
+	balance string
+	nonce   uint64
+}
+
+// addressWatchlist holds the addresses watched by each webhook, along with the balance and the nonce of each address,
+// as seen on the last checked hyperblock
+type addressWatchlist struct {
+	mut                 sync.RWMutex
+	maxWatched          int
+	watchlists          map[string]map[string]struct{}
+	states              map[string]*watchedAccountState
+	lastHyperblockNonce uint64
+}
+
+func newAddressWatchlist(maxWatched int) *addressWatchlist {
+	return &addressWatchlist{
+		maxWatched: maxWatched,
+		watchlists: make(map[string]map[string]struct{}),
+		states:     make(map[string]*watchedAccountState),
+	}
+}
+
+// add registers the addresses for the webhook. Either all the addresses are registered, or none, if the maximum number
+// of watched addresses would be exceeded
+func (aw *addressWatchlist) add(webhook string, addresses []string) error {
+	aw.mut.Lock()
+	defer aw.mut.Unlock()
+
+	numNew := 0
+	for _, address := range addresses {
+		_, isWatched := aw.watchlists[webhook][address]
+		if !isWatched {
+			numNew++
+		}
+	}
+	if aw.numWatched()+numNew > aw.maxWatched {
+		return ErrTooManyWatchedAddresses
+	}
+
+	watchlist, found := aw.watchlists[webhook]
+	if !found {
+		watchlist = make(map[string]struct{})
+		aw.watchlists[webhook] = watchlist
+	}
+	for _, address := range addresses {
+		watchlist[address] = struct{}{}
+		_, found = aw.states[address]
+		if !found {
+			aw.states[address] = &watchedAccountState{}
+		}
+	}
+
+	return nil
+}
+
+func (aw *addressWatchlist) numWatched() int {
+	numWatched := 0
+	for _, watchlist := range aw.watchlists {
+		numWatched += len(watchlist)
+	}
+
+	return numWatched
+}
+
+// remove drops the address from the watchlist of the webhook. It returns false if the address was not watched
+func (aw *addressWatchlist) remove(webhook string, address string) bool {
+	aw.mut.Lock()
+	defer aw.mut.Unlock()
+
+	_, isWatched := aw.watchlists[webhook][address]
+	if !isWatched {
+		return false
+	}
+
+	delete(aw.watchlists[webhook], address)
+	if len(aw.watchlists[webhook]) == 0 {
+		delete(aw.watchlists, webhook)
+	}
+	if !aw.isWatchedByAnyWebhook(address) {
+		delete(aw.states, address)
+	}
+
+	return true
+}
+
+func (aw *addressWatchlist) isWatchedByAnyWebhook(address string) bool {
+	for _, watchlist := range aw.watchlists {
+		_, isWatched := watchlist[address]
+		if isWatched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getAddresses returns the addresses watched by the webhook, sorted
+func (aw *addressWatchlist) getAddresses(webhook string) []string {
+	aw.mut.RLock()
+	defer aw.mut.RUnlock()
+
+	addresses := make([]string, 0, len(aw.watchlists[webhook]))
+	for address := range aw.watchlists[webhook] {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
+
+// getAddressesToCheck returns all the watched addresses, sorted, if the provided hyperblock was not checked already
+func (aw *addressWatchlist) getAddressesToCheck(hyperblockNonce uint64) ([]string, bool) {
+	aw.mut.RLock()
+	defer aw.mut.RUnlock()
+
+	if hyperblockNonce <= aw.lastHyperblockNonce {
+		return nil, false
+	}
+
+	addresses := make([]string, 0, len(aw.states))
+	for address := range aw.states {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses, true
+}
+
+// update stores the balances and the nonces of the accounts, as seen on the provided hyperblock, and returns a
+// notification for each webhook watching an account which changed since the previous check. The first state seen for
+// an address is only stored, as there is nothing to compare it with
+func (aw *addressWatchlist) update(hyperblockNonce uint64, accounts map[string]*data.Account, timestamp int64) []*data.AddressChangeNotification {
+	aw.mut.Lock()
+	defer aw.mut.Unlock()
+
+	if hyperblockNonce <= aw.lastHyperblockNonce {
+		return nil
+	}
+	aw.lastHyperblockNonce = hyperblockNonce
+
+	notifications := make([]*data.AddressChangeNotification, 0)
+	for address, account := range accounts {
+		state, isWatched := aw.states[address]
+		if !isWatched || account == nil {
+			continue
+		}
+
+		isChanged := state.isKnown && (state.balance != account.Balance || state.nonce != account.Nonce)
+		if isChanged {
+			notifications = append(notifications, aw.createNotifications(address, state, account, hyperblockNonce, timestamp)...)
+		}
+
+		state.isKnown = true
+		state.balance = account.Balance
+		state.nonce = account.Nonce
+	}
+
+	return notifications
+}
+
+func (aw *addressWatchlist) createNotifications(
+	address string,
+	state *watchedAccountState,
+	account *data.Account,
+	hyperblockNonce uint64,
+	timestamp int64,
+) []*data.AddressChangeNotification {
+	notifications := make([]*data.AddressChangeNotification, 0)
+	for webhook, watchlist := range aw.watchlists {
+		_, isWatched := watchlist[address]
+		if !isWatched {
+			continue
+		}
+
+		notifications = append(notifications, &data.AddressChangeNotification{
+			Webhook:         webhook,
+			Address:         address,
+			HyperblockNonce: hyperblockNonce,
+			PreviousBalance: state.balance,
+			Balance:         account.Balance,
+			PreviousNonce:   state.nonce,
+			Nonce:           account.Nonce,
+			Timestamp:       timestamp,
+		})
+	}
+
+	return notifications
+}
@@ -0,0 +1,86 @@
+package process
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressWatchlist_AddRemove(t *testing.T) {
+	t.Parallel()
+
+	aw := newAddressWatchlist(3)
+	require.NoError(t, aw.add("hook1", []string{"addr2", "addr1"}))
+	require.NoError(t, aw.add("hook2", []string{"addr1"}))
+	// already watched addresses do not count
+	require.NoError(t, aw.add("hook1", []string{"addr1", "addr2"}))
+	require.Equal(t, ErrTooManyWatchedAddresses, aw.add("hook2", []string{"addr2", "addr3"}))
+	require.Equal(t, []string{"addr1", "addr2"}, aw.getAddresses("hook1"))
+	require.Equal(t, []string{"addr1"}, aw.getAddresses("hook2"))
+	require.Empty(t, aw.getAddresses("hook3"))
+
+	require.False(t, aw.remove("hook2", "addr2"))
+	require.True(t, aw.remove("hook1", "addr1"))
+	require.Equal(t, []string{"addr2"}, aw.getAddresses("hook1"))
+
+	// addr1 is still watched by hook2
+	addresses, shouldCheck := aw.getAddressesToCheck(1)
+	require.True(t, shouldCheck)
+	require.Equal(t, []string{"addr1", "addr2"}, addresses)
+
+	require.True(t, aw.remove("hook2", "addr1"))
+	addresses, _ = aw.getAddressesToCheck(1)
+	require.Equal(t, []string{"addr2"}, addresses)
+	require.NoError(t, aw.add("hook2", []string{"addr3", "addr4"}))
+}
+
+func TestAddressWatchlist_Update(t *testing.T) {
+	t.Parallel()
+
+	aw := newAddressWatchlist(10)
+	_ = aw.add("hook1", []string{"addr1", "addr2"})
+	_ = aw.add("hook2", []string{"addr1"})
+
+	// the first state seen is the reference
+	notifications := aw.update(10, map[string]*data.Account{
+		"addr1": {Address: "addr1", Balance: "100", Nonce: 1},
+		"addr2": {Address: "addr2", Balance: "5", Nonce: 0},
+	}, 1000)
+	require.Empty(t, notifications)
+
+	_, shouldCheck := aw.getAddressesToCheck(10)
+	require.False(t, shouldCheck)
+	require.Nil(t, aw.update(9, map[string]*data.Account{"addr1": {Balance: "0"}}, 1001))
+
+	notifications = aw.update(11, map[string]*data.Account{
+		"addr1": {Address: "addr1", Balance: "150", Nonce: 1},
+		"addr2": {Address: "addr2", Balance: "5", Nonce: 0},
+		"addr3": {Address: "addr3", Balance: "7", Nonce: 2},
+	}, 1002)
+	require.Len(t, notifications, 2)
+	webhooks := map[string]bool{}
+	for _, notification := range notifications {
+		webhooks[notification.Webhook] = true
+		require.Equal(t, &data.AddressChangeNotification{
+			Webhook:         notification.Webhook,
+			Address:         "addr1",
+			HyperblockNonce: 11,
+			PreviousBalance: "100",
+			Balance:         "150",
+			PreviousNonce:   1,
+			Nonce:           1,
+			Timestamp:       1002,
+		}, notification)
+	}
+	require.Equal(t, map[string]bool{"hook1": true, "hook2": true}, webhooks)
+
+	notifications = aw.update(12, map[string]*data.Account{
+		"addr2": {Address: "addr2", Balance: "4", Nonce: 1},
+	}, 1003)
+	require.Len(t, notifications, 1)
+	require.Equal(t, "hook1", notifications[0].Webhook)
+	require.Equal(t, "addr2", notifications[0].Address)
+	require.Equal(t, uint64(0), notifications[0].PreviousNonce)
+	require.Equal(t, uint64(1), notifications[0].Nonce)
+}
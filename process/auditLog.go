@@ -0,0 +1,181 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	auditSinkFile   = "file"
+	auditSinkSyslog = "syslog"
+
+	// sentTransactionsRetention is the duration the observers of a sent transaction are kept, waiting for the audit
+	// entry of the request. The transactions sent through routes which are not audited are never claimed
+	sentTransactionsRetention = time.Minute
+)
+
+type auditSink interface {
+	write(entry []byte) error
+	close() error
+}
+
+type sentTransaction struct {
+	observers []string
+	timestamp time.Time
+}
+
+// AuditLog writes a structured entry for each audited request into the configured sink. It also keeps the observers
+// which accepted the recently sent transactions, so the entries of the send requests can report them
+type AuditLog struct {
+	sink           auditSink
+	mutSentTxs     sync.Mutex
+	sentTxs        map[string]*sentTransaction
+	lastCleanup    time.Time
+	getTimeHandler func() time.Time
+}
+
+// NewAuditLog creates a new instance of AuditLog. If the audit log is not enabled, the returned instance does nothing
+func NewAuditLog(cfg config.AuditLogConfig) (*AuditLog, error) {
+	al := newAuditLog(nil)
+	if !cfg.Enabled {
+		return al, nil
+	}
+
+	sink, err := createAuditSink(cfg)
+	if err != nil {
+		return nil, err
+	}
+	al.sink = sink
+
+	log.Info("audit log enabled", "sink", cfg.Sink, "routes", cfg.Routes)
+
+	return al, nil
+}
+
+func newAuditLog(sink auditSink) *AuditLog {
+	return &AuditLog{
+		sink:           sink,
+		sentTxs:        make(map[string]*sentTransaction),
+		getTimeHandler: time.Now,
+	}
+}
+
+func createAuditSink(cfg config.AuditLogConfig) (auditSink, error) {
+	switch cfg.Sink {
+	case auditSinkFile:
+		return newAuditFileSink(cfg.FilePath, int64(cfg.MaxFileSizeInMB)*1024*1024, cfg.MaxBackupFiles)
+	case auditSinkSyslog:
+		return newAuditSyslogSink(cfg.SyslogNetwork, cfg.SyslogAddress, cfg.SyslogTag)
+	default:
+		return nil, fmt.Errorf("%w, unknown sink %q", ErrInvalidAuditLogConfig, cfg.Sink)
+	}
+}
+
+// IsEnabled returns true if the audit log was enabled from config
+func (al *AuditLog) IsEnabled() bool {
+	return al.sink != nil
+}
+
+// RecordSentTransaction records the observer which accepted the transaction, to be reported by the audit entry of
+// the request which sent it
+func (al *AuditLog) RecordSentTransaction(txHash string, observer string) {
+	if !al.IsEnabled() || len(txHash) == 0 {
+		return
+	}
+
+	al.mutSentTxs.Lock()
+	defer al.mutSentTxs.Unlock()
+
+	now := al.getTimeHandler()
+	al.removeStaleSentTransactions(now)
+
+	sentTx, found := al.sentTxs[txHash]
+	if !found {
+		sentTx = &sentTransaction{}
+		al.sentTxs[txHash] = sentTx
+	}
+	sentTx.observers = append(sentTx.observers, observer)
+	sentTx.timestamp = now
+}
+
+// LogEntry completes the entry with the observers of its transactions and writes it into the sink. A failure of the
+// sink is logged, without affecting the request
+func (al *AuditLog) LogEntry(entry *data.AuditEntry) {
+	if !al.IsEnabled() || entry == nil {
+		return
+	}
+
+	entry.Observers = al.claimObservers(entry.TxHashes)
+	buff, err := json.Marshal(entry)
+	if err != nil {
+		log.Warn("cannot marshal the audit entry", "path", entry.Path, "error", err)
+		return
+	}
+
+	err = al.sink.write(buff)
+	if err != nil {
+		log.Error("cannot write the audit entry", "path", entry.Path, "error", err)
+	}
+}
+
+// claimObservers returns the distinct observers which accepted the provided transactions, forgetting them afterwards
+func (al *AuditLog) claimObservers(txHashes []string) []string {
+	al.mutSentTxs.Lock()
+	defer al.mutSentTxs.Unlock()
+
+	al.removeStaleSentTransactions(al.getTimeHandler())
+
+	observers := make([]string, 0)
+	seenObservers := make(map[string]struct{})
+	for _, txHash := range txHashes {
+		sentTx, found := al.sentTxs[txHash]
+		if !found {
+			continue
+		}
+		delete(al.sentTxs, txHash)
+
+		for _, observer := range sentTx.observers {
+			_, seen := seenObservers[observer]
+			if seen {
+				continue
+			}
+			seenObservers[observer] = struct{}{}
+			observers = append(observers, observer)
+		}
+	}
+
+	return observers
+}
+
+// removeStaleSentTransactions should be called under mutex protection. The map is scanned at most once per retention
+func (al *AuditLog) removeStaleSentTransactions(now time.Time) {
+	if now.Sub(al.lastCleanup) < sentTransactionsRetention {
+		return
+	}
+	al.lastCleanup = now
+
+	for txHash, sentTx := range al.sentTxs {
+		if now.Sub(sentTx.timestamp) > sentTransactionsRetention {
+			delete(al.sentTxs, txHash)
+		}
+	}
+}
+
+// Close closes the sink of the audit log
+func (al *AuditLog) Close() error {
+	if !al.IsEnabled() {
+		return nil
+	}
+
+	return al.sink.close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (al *AuditLog) IsInterfaceNil() bool {
+	return al == nil
+}
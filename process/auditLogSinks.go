@@ -0,0 +1,195 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"log/syslog"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	auditFilePermissions  = 0600
+	auditDirPermissions   = 0750
+	defaultAuditSyslogTag = "mx-chain-proxy-go"
+)
+
+// auditFileSink appends the entries as JSON lines into a file. Once the file reaches the maximum size, it is rotated
+// into <path>.1, the older backups being shifted until the maximum number of backups is reached
+type auditFileSink struct {
+	mutFile        sync.Mutex
+	filePath       string
+	maxFileSize    int64
+	maxBackupFiles int
+	file           *os.File
+	fileSize       int64
+}
+
+func newAuditFileSink(filePath string, maxFileSize int64, maxBackupFiles int) (*auditFileSink, error) {
+	if len(filePath) == 0 {
+		return nil, fmt.Errorf("%w, empty FilePath", ErrInvalidAuditLogConfig)
+	}
+	if maxFileSize < 1 {
+		return nil, fmt.Errorf("%w, the maximum file size should be positive", ErrInvalidAuditLogConfig)
+	}
+	if maxBackupFiles < 0 {
+		return nil, fmt.Errorf("%w, MaxBackupFiles: %d", ErrInvalidAuditLogConfig, maxBackupFiles)
+	}
+
+	err := os.MkdirAll(filepath.Dir(filePath), auditDirPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	sink := &auditFileSink{
+		filePath:       filePath,
+		maxFileSize:    maxFileSize,
+		maxBackupFiles: maxBackupFiles,
+	}
+	err = sink.openFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+func (sink *auditFileSink) openFile() error {
+	file, err := os.OpenFile(sink.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, auditFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	sink.file = file
+	sink.fileSize = info.Size()
+
+	return nil
+}
+
+func (sink *auditFileSink) write(entry []byte) error {
+	sink.mutFile.Lock()
+	defer sink.mutFile.Unlock()
+
+	if sink.file == nil {
+		return os.ErrClosed
+	}
+
+	line := append(entry, '\n')
+	if sink.fileSize > 0 && sink.fileSize+int64(len(line)) > sink.maxFileSize {
+		err := sink.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	numWritten, err := sink.file.Write(line)
+	sink.fileSize += int64(numWritten)
+
+	return err
+}
+
+func (sink *auditFileSink) rotate() error {
+	err := sink.file.Close()
+	sink.file = nil
+	if err != nil {
+		return err
+	}
+
+	if sink.maxBackupFiles == 0 {
+		err = os.Remove(sink.filePath)
+		if err != nil {
+			return err
+		}
+
+		return sink.openFile()
+	}
+
+	err = removeIfExists(sink.backupFilePath(sink.maxBackupFiles))
+	if err != nil {
+		return err
+	}
+	for index := sink.maxBackupFiles - 1; index > 0; index-- {
+		err = renameIfExists(sink.backupFilePath(index), sink.backupFilePath(index+1))
+		if err != nil {
+			return err
+		}
+	}
+	err = os.Rename(sink.filePath, sink.backupFilePath(1))
+	if err != nil {
+		return err
+	}
+
+	return sink.openFile()
+}
+
+func (sink *auditFileSink) backupFilePath(index int) string {
+	return fmt.Sprintf("%s.%d", sink.filePath, index)
+}
+
+func (sink *auditFileSink) close() error {
+	sink.mutFile.Lock()
+	defer sink.mutFile.Unlock()
+
+	if sink.file == nil {
+		return nil
+	}
+
+	err := sink.file.Close()
+	sink.file = nil
+
+	return err
+}
+
+func removeIfExists(path string) error {
+	err := os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func renameIfExists(oldPath string, newPath string) error {
+	err := os.Rename(oldPath, newPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// auditSyslogSink sends each entry as an info message of the security facility. An empty network and address
+// select the local syslog daemon
+type auditSyslogSink struct {
+	writer *syslog.Writer
+}
+
+func newAuditSyslogSink(network string, address string, tag string) (*auditSyslogSink, error) {
+	if len(tag) == 0 {
+		tag = defaultAuditSyslogTag
+	}
+
+	writer, err := syslog.Dial(network, address, syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, fmt.Errorf("%w, cannot connect to syslog: %s", ErrInvalidAuditLogConfig, err.Error())
+	}
+
+	return &auditSyslogSink{
+		writer: writer,
+	}, nil
+}
+
+func (sink *auditSyslogSink) write(entry []byte) error {
+	return sink.writer.Info(string(entry))
+}
+
+func (sink *auditSyslogSink) close() error {
+	return sink.writer.Close()
+}
@@ -0,0 +1,193 @@
+package process_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditEntries(t *testing.T, filePath string) []*data.AuditEntry {
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	entries := make([]*data.AuditEntry, 0)
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+
+		entry := &data.AuditEntry{}
+		require.NoError(t, json.Unmarshal([]byte(line), entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestNewAuditLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should do nothing", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := process.NewAuditLog(config.AuditLogConfig{Enabled: false, Sink: "unknown"})
+		require.False(t, check.IfNil(al))
+		require.NoError(t, err)
+		require.False(t, al.IsEnabled())
+
+		al.RecordSentTransaction("hash", "observer")
+		al.LogEntry(&data.AuditEntry{TxHashes: []string{"hash"}})
+		require.Zero(t, al.NumSentTransactions())
+		require.NoError(t, al.Close())
+	})
+	t.Run("unknown sink should error", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := process.NewAuditLog(config.AuditLogConfig{Enabled: true, Sink: "unknown"})
+		require.True(t, check.IfNil(al))
+		require.True(t, errors.Is(err, process.ErrInvalidAuditLogConfig))
+	})
+	t.Run("invalid file sink config should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "audit.jsonl")
+		testInvalidConfig := func(cfg config.AuditLogConfig) {
+			al, err := process.NewAuditLog(cfg)
+			require.True(t, check.IfNil(al))
+			require.True(t, errors.Is(err, process.ErrInvalidAuditLogConfig))
+		}
+
+		testInvalidConfig(config.AuditLogConfig{Enabled: true, Sink: "file", MaxFileSizeInMB: 1})
+		testInvalidConfig(config.AuditLogConfig{Enabled: true, Sink: "file", FilePath: filePath})
+		testInvalidConfig(config.AuditLogConfig{Enabled: true, Sink: "file", FilePath: filePath, MaxFileSizeInMB: 1, MaxBackupFiles: -1})
+	})
+	t.Run("file sink should work", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "logs", "audit.jsonl")
+		al, err := process.NewAuditLog(config.AuditLogConfig{Enabled: true, Sink: "file", FilePath: filePath, MaxFileSizeInMB: 1})
+		require.False(t, check.IfNil(al))
+		require.NoError(t, err)
+		require.True(t, al.IsEnabled())
+		require.NoError(t, al.Close())
+
+		_, err = os.Stat(filePath)
+		require.NoError(t, err)
+	})
+}
+
+func TestAuditLog_LogEntry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report the observers of the sent transactions", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "audit.jsonl")
+		al, err := process.NewAuditLogWithFileSink(filePath, 1024*1024, 1)
+		require.NoError(t, err)
+
+		al.RecordSentTransaction("hash0", "observer0")
+		al.RecordSentTransaction("hash1", "observer1")
+		al.RecordSentTransaction("hash1", "observer2")
+		al.RecordSentTransaction("hash2", "observer0")
+		al.RecordSentTransaction("other hash", "observer3")
+
+		al.LogEntry(&data.AuditEntry{
+			Method:     "POST",
+			Path:       "/transaction/send-multiple",
+			ClientIP:   "10.0.0.1",
+			TxHashes:   []string{"hash0", "hash1", "hash2", "unknown hash"},
+			StatusCode: 200,
+			Outcome:    data.AuditOutcomeSuccess,
+		})
+		al.LogEntry(&data.AuditEntry{
+			Method:     "POST",
+			Path:       "/actions/reload-config",
+			User:       "admin",
+			StatusCode: 500,
+			Outcome:    data.AuditOutcomeFailure,
+			Error:      "expected error",
+		})
+		require.Equal(t, 1, al.NumSentTransactions())
+		require.NoError(t, al.Close())
+
+		entries := readAuditEntries(t, filePath)
+		require.Len(t, entries, 2)
+		require.Equal(t, []string{"hash0", "hash1", "hash2", "unknown hash"}, entries[0].TxHashes)
+		require.Equal(t, []string{"observer0", "observer1", "observer2"}, entries[0].Observers)
+		require.Equal(t, "10.0.0.1", entries[0].ClientIP)
+		require.Equal(t, "admin", entries[1].User)
+		require.Empty(t, entries[1].Observers)
+		require.Equal(t, "expected error", entries[1].Error)
+		require.Equal(t, data.AuditOutcomeFailure, entries[1].Outcome)
+	})
+	t.Run("should forget the stale sent transactions", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := process.NewAuditLogWithFileSink(filepath.Join(t.TempDir(), "audit.jsonl"), 1024*1024, 1)
+		require.NoError(t, err)
+		defer func() {
+			_ = al.Close()
+		}()
+
+		currentTime := time.Now()
+		al.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+		al.RecordSentTransaction("hash0", "observer0")
+		al.RecordSentTransaction("hash1", "observer0")
+
+		currentTime = currentTime.Add(2 * time.Minute)
+		al.RecordSentTransaction("hash2", "observer1")
+		require.Equal(t, 1, al.NumSentTransactions())
+	})
+	t.Run("should rotate the file", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "audit.jsonl")
+		entry := &data.AuditEntry{Method: "POST", Path: "/transaction/send", TxHashes: []string{"hash"}}
+		buff, _ := json.Marshal(entry)
+		entrySize := int64(len(buff) + 1)
+
+		al, err := process.NewAuditLogWithFileSink(filePath, 2*entrySize, 2)
+		require.NoError(t, err)
+		for i := 0; i < 7; i++ {
+			al.LogEntry(entry)
+		}
+		require.NoError(t, al.Close())
+
+		require.Len(t, readAuditEntries(t, filePath), 1)
+		require.Len(t, readAuditEntries(t, filePath+".1"), 2)
+		require.Len(t, readAuditEntries(t, filePath+".2"), 2)
+		_, err = os.Stat(filePath + ".3")
+		require.True(t, errors.Is(err, os.ErrNotExist))
+	})
+	t.Run("no backup file should truncate the file", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "audit.jsonl")
+		entry := &data.AuditEntry{Method: "POST", Path: "/transaction/send"}
+		buff, _ := json.Marshal(entry)
+
+		al, err := process.NewAuditLogWithFileSink(filePath, int64(len(buff)+1), 0)
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			al.LogEntry(entry)
+		}
+		require.NoError(t, al.Close())
+
+		require.Len(t, readAuditEntries(t, filePath), 1)
+		_, err = os.Stat(filePath + ".1")
+		require.True(t, errors.Is(err, os.ErrNotExist))
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"net/http"
 	"strconv"
@@ -15,14 +16,15 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/sharding"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 )
 
 var log = logger.GetOrCreate("process")
-var mutHttpClient sync.RWMutex
 
 const (
 	nodeSyncedNonceDifferenceThreshold = 10
@@ -43,60 +45,198 @@ type BaseProcessor struct {
 	delayForCheckingNodesSyncState time.Duration
 	cancelFunc                     func()
 	noStatusCheck                  bool
+	upstreamProxies                []string
+
+	httpClients      *observersHttpClients
+	shadowTraffic    *shadowTrafficHandler
+	topologySnapshot *topologySnapshotHandler
+	capabilities     *observersCapabilitiesHandler
+	hedgedRequests   *hedgedRequestsHandler
+	responseLimits   *responseSizeLimits
+	consistency      *observersConsistencyMonitor
+	shardsTopology   *shardsTopologyTracker
+
+	heartbeatsProvider HeartbeatsProvider
+	epochChange        *EpochChangeProcessor
+}
 
-	httpClient *http.Client
+// ArgsBaseProcessor holds the arguments needed for creating a new base processor. The optional features are enabled
+// through their configs
+type ArgsBaseProcessor struct {
+	RequestTimeoutSec        int
+	ShardCoordinator         common.Coordinator
+	ObserversProvider        observer.NodesProviderHandler
+	FullHistoryNodesProvider observer.NodesProviderHandler
+	PubKeyConverter          core.PubkeyConverter
+	NoStatusCheck            bool
+	HttpClientConfig         config.ObserversHttpClientConfig
+	UpstreamProxies          []string
+	ShadowTrafficConfig      config.ShadowTrafficConfig
+	TopologySnapshotConfig   config.TopologySnapshotConfig
+	CapabilitiesConfig       config.ObserversCapabilitiesConfig
+	HedgedRequestsConfig     config.HedgedRequestsConfig
+	ResponseSizeLimitsConfig config.ResponseSizeLimitsConfig
+	ConsistencyConfig        config.ObserversConsistencyConfig
+	ShardsTopologyConfig     config.ShardsTopologyConfig
 }
 
 // NewBaseProcessor creates a new instance of BaseProcessor struct
-func NewBaseProcessor(
-	requestTimeoutSec int,
-	shardCoord common.Coordinator,
-	observersProvider observer.NodesProviderHandler,
-	fullHistoryNodesProvider observer.NodesProviderHandler,
-	pubKeyConverter core.PubkeyConverter,
-	noStatusCheck bool,
-) (*BaseProcessor, error) {
-	if check.IfNil(shardCoord) {
+func NewBaseProcessor(args ArgsBaseProcessor) (*BaseProcessor, error) {
+	if check.IfNil(args.ShardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
-	if requestTimeoutSec <= 0 {
+	if args.RequestTimeoutSec <= 0 {
 		return nil, ErrInvalidRequestTimeout
 	}
-	if check.IfNil(observersProvider) {
+	if check.IfNil(args.ObserversProvider) {
 		return nil, fmt.Errorf("%w for observers", ErrNilNodesProvider)
 	}
-	if check.IfNil(fullHistoryNodesProvider) {
+	if check.IfNil(args.FullHistoryNodesProvider) {
 		return nil, fmt.Errorf("%w for full history nodes", ErrNilNodesProvider)
 	}
-	if check.IfNil(pubKeyConverter) {
+	if check.IfNil(args.PubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	for _, upstreamProxy := range args.UpstreamProxies {
+		if len(upstreamProxy) == 0 {
+			return nil, ErrEmptyUpstreamProxyAddress
+		}
+	}
 
-	httpClient := http.DefaultClient
-	mutHttpClient.Lock()
-	httpClient.Timeout = time.Duration(requestTimeoutSec) * time.Second
-	mutHttpClient.Unlock()
+	httpClients, err := newObserversHttpClients(time.Duration(args.RequestTimeoutSec)*time.Second, args.HttpClientConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	bp := &BaseProcessor{
-		shardCoordinator:               shardCoord,
-		observersProvider:              observersProvider,
-		fullHistoryNodesProvider:       fullHistoryNodesProvider,
-		httpClient:                     httpClient,
-		pubKeyConverter:                pubKeyConverter,
-		shardIDs:                       computeShardIDs(shardCoord),
+		shardCoordinator:               args.ShardCoordinator,
+		observersProvider:              args.ObserversProvider,
+		fullHistoryNodesProvider:       args.FullHistoryNodesProvider,
+		httpClients:                    httpClients,
+		pubKeyConverter:                args.PubKeyConverter,
+		shardIDs:                       computeShardIDs(args.ShardCoordinator),
 		delayForCheckingNodesSyncState: stepDelayForCheckingNodesSyncState,
 		chanTriggerNodesState:          make(chan struct{}),
-		noStatusCheck:                  noStatusCheck,
+		noStatusCheck:                  args.NoStatusCheck,
+		upstreamProxies:                args.UpstreamProxies,
 	}
 	bp.nodeStatusFetcher = bp.getNodeStatusResponseFromAPI
+	httpClients.shardOfObserver = bp.getShardOfNode
+
+	if args.ShadowTrafficConfig.Enabled {
+		bp.shadowTraffic, err = newShadowTrafficHandler(args.ShadowTrafficConfig, httpClients, bp.getShardOfNode)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("Proxy started with shadow traffic towards canary observers",
+			"percentage", args.ShadowTrafficConfig.Percentage,
+			"num canary observers", len(args.ShadowTrafficConfig.CanaryObservers))
+	}
+
+	if args.TopologySnapshotConfig.Enabled {
+		bp.topologySnapshot, err = newTopologySnapshotHandler(args.TopologySnapshotConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if args.CapabilitiesConfig.Enabled {
+		bp.capabilities, err = newObserversCapabilitiesHandler(args.CapabilitiesConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if args.HedgedRequestsConfig.Enabled {
+		bp.hedgedRequests, err = newHedgedRequestsHandler(args.HedgedRequestsConfig, httpClients.responses)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("Proxy started with hedged requests",
+			"delay percentile", args.HedgedRequestsConfig.DelayPercentile,
+			"max delay in ms", args.HedgedRequestsConfig.MaxDelayInMs)
+	}
+
+	if args.ResponseSizeLimitsConfig.Enabled {
+		bp.responseLimits, err = newResponseSizeLimits(args.ResponseSizeLimitsConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if args.ConsistencyConfig.Enabled {
+		bp.consistency, err = newObserversConsistencyMonitor(args.ConsistencyConfig, bp.getBlockHashFromAPI)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("Proxy started with observers consistency checks",
+			"nonce offset", args.ConsistencyConfig.NonceOffset,
+			"min quorum", args.ConsistencyConfig.MinQuorum,
+			"eviction duration in sec", args.ConsistencyConfig.EvictionDurationInSec)
+	}
+
+	if args.ShardsTopologyConfig.Enabled {
+		bp.shardsTopology = newShardsTopologyTracker()
+	}
 
-	if noStatusCheck {
+	if args.NoStatusCheck {
 		log.Info("Proxy started with no status check! The provided observers will always be considered synced!")
 	}
+	if len(args.UpstreamProxies) > 0 {
+		log.Info("Proxy started with upstream proxies", "addresses", args.UpstreamProxies)
+	}
 
 	return bp, nil
 }
 
+// SetFaultInjectionProcessor sets the component injecting faults in the calls towards the observers
+func (bp *BaseProcessor) SetFaultInjectionProcessor(faultInjection *FaultInjectionProcessor) error {
+	if faultInjection == nil {
+		return ErrNilFaultInjectionProcessor
+	}
+
+	bp.httpClients.setFaultInjectionProcessor(faultInjection)
+
+	return nil
+}
+
+// SetEpochChangeProcessor sets the component retrying the calls towards the observers failing during the epoch change
+func (bp *BaseProcessor) SetEpochChangeProcessor(epochChange *EpochChangeProcessor) error {
+	if epochChange == nil {
+		return ErrNilEpochChangeProcessor
+	}
+
+	bp.mutState.Lock()
+	bp.epochChange = epochChange
+	bp.mutState.Unlock()
+
+	return nil
+}
+
+func (bp *BaseProcessor) getEpochChangeProcessor() *EpochChangeProcessor {
+	bp.mutState.RLock()
+	defer bp.mutState.RUnlock()
+
+	return bp.epochChange
+}
+
+// SetHeartbeatsProvider sets the component providing the heartbeats used to detect the observers which moved to
+// another shard. The heartbeats are only used when the shards topology tracking is enabled
+func (bp *BaseProcessor) SetHeartbeatsProvider(heartbeatsProvider HeartbeatsProvider) error {
+	if check.IfNil(heartbeatsProvider) {
+		return ErrNilHeartbeatsProvider
+	}
+
+	bp.mutState.Lock()
+	bp.heartbeatsProvider = heartbeatsProvider
+	bp.mutState.Unlock()
+
+	return nil
+}
+
 // StartNodesSyncStateChecks will simply start the goroutine that handles the nodes sync state
 func (bp *BaseProcessor) StartNodesSyncStateChecks() {
 	if bp.cancelFunc != nil {
@@ -107,27 +247,151 @@ func (bp *BaseProcessor) StartNodesSyncStateChecks() {
 	var ctx context.Context
 	ctx, bp.cancelFunc = context.WithCancel(context.Background())
 
-	go bp.handleOutOfSyncNodes(ctx)
+	isTopologyRestored := bp.restoreTopologySnapshot()
+	go bp.handleOutOfSyncNodes(ctx, !isTopologyRestored)
+
+	if bp.topologySnapshot != nil {
+		go bp.saveTopologySnapshots(ctx)
+	}
+}
+
+// restoreTopologySnapshot restores the state of the observers from the snapshot saved on disk and returns true if the
+// state of all the observers was restored
+func (bp *BaseProcessor) restoreTopologySnapshot() bool {
+	if bp.topologySnapshot == nil || bp.noStatusCheck {
+		return false
+	}
+
+	snapshot, err := bp.topologySnapshot.load()
+	if err == nil && snapshot.NumShards != bp.shardCoordinator.NumberOfShards() {
+		err = fmt.Errorf("%w, snapshot number of shards: %d, current number of shards: %d",
+			ErrTopologySnapshotMismatch, snapshot.NumShards, bp.shardCoordinator.NumberOfShards())
+	}
+	if err != nil {
+		log.Info("topology snapshot not restored, all the observers will be checked", "reason", err.Error())
+		return false
+	}
+
+	bp.restoreLastResponseTimes(snapshot.Observers)
+	bp.restoreLastResponseTimes(snapshot.FullHistoryNodes)
+
+	observers := bp.observersProvider.GetAllNodesWithSyncState()
+	areObserversRestored := restoreNodesSyncState(observers, snapshot.Observers)
+	bp.observersProvider.UpdateNodesBasedOnSyncState(observers)
+
+	fullHistoryNodes := bp.fullHistoryNodesProvider.GetAllNodesWithSyncState()
+	areFullHistoryNodesRestored := restoreNodesSyncState(fullHistoryNodes, snapshot.FullHistoryNodes)
+	bp.fullHistoryNodesProvider.UpdateNodesBasedOnSyncState(fullHistoryNodes)
+
+	isTopologyRestored := areObserversRestored && areFullHistoryNodesRestored
+	log.Info("topology snapshot restored",
+		"snapshot age", time.Since(time.Unix(snapshot.Timestamp, 0)).Truncate(time.Second),
+		"all observers found in snapshot", isTopologyRestored)
+
+	return isTopologyRestored
+}
+
+func (bp *BaseProcessor) restoreLastResponseTimes(nodesSnapshot []*proxyData.NodeSnapshot) {
+	for _, nodeSnapshot := range nodesSnapshot {
+		if nodeSnapshot.LastResponseTimestamp > 0 {
+			bp.httpClients.responses.restoreLastResponseTime(nodeSnapshot.Address, time.Unix(nodeSnapshot.LastResponseTimestamp, 0))
+		}
+	}
+}
+
+func (bp *BaseProcessor) saveTopologySnapshots(ctx context.Context) {
+	timer := time.NewTimer(bp.topologySnapshot.saveInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(bp.topologySnapshot.saveInterval)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return
+		}
+
+		bp.saveTopologySnapshot()
+	}
+}
+
+func (bp *BaseProcessor) saveTopologySnapshot() {
+	snapshot := &proxyData.TopologySnapshot{
+		NumShards:        bp.GetShardCoordinator().NumberOfShards(),
+		Observers:        createNodesSnapshot(bp.observersProvider.GetAllNodesWithSyncState(), bp.httpClients.responses),
+		FullHistoryNodes: createNodesSnapshot(bp.fullHistoryNodesProvider.GetAllNodesWithSyncState(), bp.httpClients.responses),
+	}
+
+	err := bp.topologySnapshot.save(snapshot)
+	if err != nil {
+		log.Warn("cannot save the topology snapshot", "file", bp.topologySnapshot.filePath, "error", err.Error())
+	}
 }
 
 // GetShardIDs will return the shard IDs slice
 func (bp *BaseProcessor) GetShardIDs() []uint32 {
+	bp.mutState.RLock()
+	defer bp.mutState.RUnlock()
+
 	return bp.shardIDs
 }
 
+// GetObserversHealth returns the health details of all the observers, both synced and out of sync
+func (bp *BaseProcessor) GetObserversHealth() []*proxyData.ObserverHealth {
+	observers := bp.observersProvider.GetAllNodesWithSyncState()
+	observersHealth := make([]*proxyData.ObserverHealth, 0, len(observers))
+	for _, observer := range observers {
+		observerHealth := &proxyData.ObserverHealth{
+			Address:                 observer.Address,
+			ShardID:                 observer.ShardId,
+			IsSynced:                observer.IsSynced,
+			Tier:                    observer.Tier,
+			Version:                 observer.Version,
+			UnsupportedCapabilities: observer.UnsupportedCapabilities,
+		}
+		lastResponse, found := bp.httpClients.responses.getLastResponseTime(observer.Address)
+		if found {
+			observerHealth.LastResponseTimestamp = lastResponse.Unix()
+		}
+		if bp.consistency != nil {
+			evictedUntil, numEvictions := bp.consistency.getEviction(observer.Address)
+			if !evictedUntil.IsZero() {
+				observerHealth.EvictedUntilTimestamp = evictedUntil.Unix()
+			}
+			observerHealth.NumEvictions = numEvictions
+		}
+
+		observersHealth = append(observersHealth, observerHealth)
+	}
+
+	return observersHealth
+}
+
 // ReloadObservers will call the nodes reloading from the observers provider
 func (bp *BaseProcessor) ReloadObservers() proxyData.NodesReloadResponse {
-	return bp.observersProvider.ReloadNodes(proxyData.Observer)
+	response := bp.observersProvider.ReloadNodes(proxyData.Observer)
+	if response.OkRequest {
+		bp.httpClients.onObserversChanged()
+	}
+
+	return response
 }
 
 // ReloadFullHistoryObservers will call the nodes reloading from the full history observers provider
 func (bp *BaseProcessor) ReloadFullHistoryObservers() proxyData.NodesReloadResponse {
-	return bp.fullHistoryNodesProvider.ReloadNodes(proxyData.FullHistoryNode)
+	response := bp.fullHistoryNodesProvider.ReloadNodes(proxyData.FullHistoryNode)
+	if response.OkRequest {
+		bp.httpClients.onObserversChanged()
+	}
+
+	return response
 }
 
 // GetObservers returns the registered observers on a shard
 func (bp *BaseProcessor) GetObservers(shardID uint32, dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error) {
-	return bp.observersProvider.GetNodesByShardId(shardID, dataAvailability)
+	observers, err := bp.observersProvider.GetNodesByShardId(shardID, dataAvailability)
+	return bp.addUpstreamProxies(shardID, observers, err)
 }
 
 // GetAllObservers will return all the observers, regardless of shard ID
@@ -142,7 +406,8 @@ func (bp *BaseProcessor) GetObserversOnePerShard(dataAvailability proxyData.Obse
 
 // GetFullHistoryNodes returns the registered full history nodes on a shard
 func (bp *BaseProcessor) GetFullHistoryNodes(shardID uint32, dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error) {
-	return bp.fullHistoryNodesProvider.GetNodesByShardId(shardID, dataAvailability)
+	fullHistoryNodes, err := bp.fullHistoryNodesProvider.GetNodesByShardId(shardID, dataAvailability)
+	return bp.addUpstreamProxies(shardID, fullHistoryNodes, err)
 }
 
 // GetAllFullHistoryNodes will return all the full history nodes, regardless of shard ID
@@ -159,7 +424,7 @@ func (bp *BaseProcessor) getNodesOnePerShard(
 	observersInShardGetter func(shardID uint32, dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error),
 	dataAvailability proxyData.ObserverDataAvailabilityType,
 ) ([]*proxyData.NodeData, error) {
-	numShards := bp.shardCoordinator.NumberOfShards()
+	numShards := bp.GetShardCoordinator().NumberOfShards()
 	sliceToReturn := make([]*proxyData.NodeData, 0)
 
 	for shardID := uint32(0); shardID < numShards; shardID++ {
@@ -183,6 +448,47 @@ func (bp *BaseProcessor) getNodesOnePerShard(
 	return sliceToReturn, nil
 }
 
+// addUpstreamProxies will place the upstream proxies (if any) in the list of nodes to be tried for a shard. The upstream
+// proxies come after the synced local nodes, but before the out of sync ones, so they will be used when the local
+// nodes are lagging or unavailable
+func (bp *BaseProcessor) addUpstreamProxies(shardID uint32, nodes []*proxyData.NodeData, err error) ([]*proxyData.NodeData, error) {
+	if len(bp.upstreamProxies) == 0 {
+		return nodes, err
+	}
+
+	upstreamNodes := make([]*proxyData.NodeData, 0, len(bp.upstreamProxies))
+	for _, upstreamProxy := range bp.upstreamProxies {
+		upstreamNodes = append(upstreamNodes, &proxyData.NodeData{
+			ShardId:    shardID,
+			Address:    upstreamProxy,
+			IsSynced:   true,
+			IsFallback: true,
+		})
+	}
+	if err != nil {
+		log.Trace("no local nodes available, using the upstream proxies", "shard", shardID, "error", err)
+		return upstreamNodes, nil
+	}
+
+	syncedNodes := make([]*proxyData.NodeData, 0, len(nodes))
+	outOfSyncNodes := make([]*proxyData.NodeData, 0)
+	for _, node := range nodes {
+		if node.IsSynced {
+			syncedNodes = append(syncedNodes, node)
+			continue
+		}
+
+		outOfSyncNodes = append(outOfSyncNodes, node)
+	}
+
+	nodesWithUpstreams := make([]*proxyData.NodeData, 0, len(nodes)+len(upstreamNodes))
+	nodesWithUpstreams = append(nodesWithUpstreams, syncedNodes...)
+	nodesWithUpstreams = append(nodesWithUpstreams, upstreamNodes...)
+	nodesWithUpstreams = append(nodesWithUpstreams, outOfSyncNodes...)
+
+	return nodesWithUpstreams, nil
+}
+
 // ComputeShardId computes the shard id in which the account resides
 func (bp *BaseProcessor) ComputeShardId(addressBuff []byte) (uint32, error) {
 	bp.mutState.RLock()
@@ -197,24 +503,31 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	path string,
 	value interface{},
 ) (int, error) {
+	return bp.CallGetRestEndPointWithContext(context.Background(), address, path, value)
+}
 
-	req, err := http.NewRequest("GET", address+path, nil)
-	if err != nil {
-		return http.StatusInternalServerError, err
-	}
-
-	userAgent := "Multiversx Proxy / 1.0.0 <Requesting data from nodes>"
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", userAgent)
+// CallGetRestEndPointWithContext calls an external end point (sends a request on a node). The request is canceled
+// when the provided context is done
+func (bp *BaseProcessor) CallGetRestEndPointWithContext(
+	ctx context.Context,
+	address string,
+	path string,
+	value interface{},
+) (int, error) {
+	return bp.getEpochChangeProcessor().callWithRetries(ctx, func() (int, error) {
+		return bp.callGetRestEndPoint(ctx, address, path, value)
+	})
+}
 
-	resp, err := bp.httpClient.Do(req)
+func (bp *BaseProcessor) callGetRestEndPoint(
+	ctx context.Context,
+	address string,
+	path string,
+	value interface{},
+) (int, error) {
+	resp, statusCode, err := bp.getRestEndPointResponse(ctx, address, path)
 	if err != nil {
-		bp.triggerNodesSyncCheck(address)
-		if isTimeoutError(err) {
-			return http.StatusRequestTimeout, err
-		}
-
-		return http.StatusNotFound, err
+		return statusCode, err
 	}
 
 	defer func() {
@@ -224,25 +537,221 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 		}
 	}()
 
-	responseBodyBytes, err := io.ReadAll(resp.Body)
+	body, err := bp.limitResponseBody(resp, path)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
-	err = json.Unmarshal(responseBodyBytes, value)
+	// the large bodies are decoded directly from the connection, so their raw bytes are not available
+	responseBodyBytes, err := decodeResponseBody(body, bp.httpClients.config.StreamingThresholdInBytes, value)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
 	responseStatusCode := resp.StatusCode
+	if bp.shadowTraffic != nil && responseBodyBytes != nil {
+		bp.shadowTraffic.shadowGetRequest(address, path, responseStatusCode, responseBodyBytes)
+	}
+
 	if responseStatusCode == http.StatusOK { // everything ok, return status ok and the expected response
 		return responseStatusCode, nil
 	}
 
 	// status response not ok, return the error
+	if responseBodyBytes == nil {
+		return responseStatusCode, fmt.Errorf("%w, status code: %d", ErrObserverResponseNotOk, responseStatusCode)
+	}
+
 	return responseStatusCode, errors.New(string(responseBodyBytes))
 }
 
+// CallGetRestEndPointWithTruncation calls an external end point, skipping the first items of the top level lists of
+// the response. If the endpoint belongs to a response size class allowing truncation, the items exceeding the maximum
+// size of the class are dropped as well. The returned truncation details hold the number of skipped and returned items.
+// The request is canceled when the provided context is done
+func (bp *BaseProcessor) CallGetRestEndPointWithTruncation(
+	ctx context.Context,
+	address string,
+	path string,
+	value interface{},
+	numItemsToSkip uint64,
+) (int, proxyData.ResponseTruncation, error) {
+	class := bp.getResponseSizeLimitClass(path)
+	isTruncationClass := class != nil && class.truncate
+	if !isTruncationClass && numItemsToSkip == 0 {
+		statusCode, err := bp.CallGetRestEndPointWithContext(ctx, address, path, value)
+		return statusCode, proxyData.ResponseTruncation{}, err
+	}
+
+	truncation := proxyData.ResponseTruncation{}
+	statusCode, err := bp.getEpochChangeProcessor().callWithRetries(ctx, func() (int, error) {
+		var statusCode int
+		var err error
+		statusCode, truncation, err = bp.callGetRestEndPointWithTruncation(ctx, address, path, value, numItemsToSkip, class)
+		return statusCode, err
+	})
+
+	return statusCode, truncation, err
+}
+
+func (bp *BaseProcessor) callGetRestEndPointWithTruncation(
+	ctx context.Context,
+	address string,
+	path string,
+	value interface{},
+	numItemsToSkip uint64,
+	class *responseSizeLimitClass,
+) (int, proxyData.ResponseTruncation, error) {
+	isTruncationClass := class != nil && class.truncate
+	resp, statusCode, err := bp.getRestEndPointResponse(ctx, address, path)
+	if err != nil {
+		return statusCode, proxyData.ResponseTruncation{}, err
+	}
+
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("base process GET with truncation: close body", "error", errNotCritical.Error())
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		responseBodyBytes, errRead := io.ReadAll(io.LimitReader(resp.Body, int64(bp.httpClients.config.StreamingThresholdInBytes)))
+		if errRead != nil {
+			return http.StatusInternalServerError, proxyData.ResponseTruncation{}, errRead
+		}
+
+		return resp.StatusCode, proxyData.ResponseTruncation{}, errors.New(string(responseBodyBytes))
+	}
+
+	maxSize := math.MaxInt
+	var body io.Reader = resp.Body
+	if isTruncationClass {
+		maxSize = class.maxSizeInBytes
+	} else {
+		body, err = bp.limitResponseBody(resp, path)
+		if err != nil {
+			return http.StatusInternalServerError, proxyData.ResponseTruncation{}, err
+		}
+	}
+
+	truncatedBody, truncation, err := truncateJSONItems(body, maxSize, numItemsToSkip)
+	if err != nil {
+		return http.StatusInternalServerError, proxyData.ResponseTruncation{}, err
+	}
+	if truncation.Truncated {
+		log.Debug("observer response truncated", "address", address, "path", path,
+			"num skipped", truncation.NumSkipped, "num returned", truncation.NumReturned)
+	}
+
+	return resp.StatusCode, truncation, json.Unmarshal(truncatedBody, value)
+}
+
+// getRestEndPointResponse sends a GET request towards the provided address. The caller is responsible for closing the
+// body of the returned response
+func (bp *BaseProcessor) getRestEndPointResponse(ctx context.Context, address string, path string) (*http.Response, int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", address+path, nil)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	userAgent := "Multiversx Proxy / 1.0.0 <Requesting data from nodes>"
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	return bp.doRequest(ctx, address, req)
+}
+
+// doRequest sends the request towards the observer and records the call on the observer calls recorder of the
+// context, if any, so the access log entry of the client request can report it
+func (bp *BaseProcessor) doRequest(ctx context.Context, address string, req *http.Request) (*http.Response, int, error) {
+	resp, err := bp.httpClients.getClient(address).Do(req)
+	if err != nil {
+		statusCode, errRequest := bp.handleRequestError(ctx, address, err)
+		common.RecordObserverCall(ctx, address, statusCode)
+		return nil, statusCode, errRequest
+	}
+
+	common.RecordObserverCall(ctx, address, resp.StatusCode)
+
+	return resp, resp.StatusCode, nil
+}
+
+// limitResponseBody returns the body of the response, failing the reads above the maximum size of the class the path
+// belongs to. The responses announcing a larger size are rejected before being read
+func (bp *BaseProcessor) limitResponseBody(resp *http.Response, path string) (io.Reader, error) {
+	class := bp.getResponseSizeLimitClass(path)
+	if class == nil {
+		return resp.Body, nil
+	}
+	if resp.ContentLength > int64(class.maxSizeInBytes) {
+		return nil, newResponseTooLargeError(class)
+	}
+
+	return newSizeLimitedReader(resp.Body, class), nil
+}
+
+func (bp *BaseProcessor) getResponseSizeLimitClass(path string) *responseSizeLimitClass {
+	if bp.responseLimits == nil {
+		return nil
+	}
+
+	return bp.responseLimits.getClass(path)
+}
+
+// CallGetRestEndPointStream calls an external end point and returns the body of the response, without decoding it, so
+// it can be relayed as it is received. The caller is responsible for closing the returned body. If the response status
+// is not ok, the body is read, closed and returned as error. The request is canceled when the provided context is done
+func (bp *BaseProcessor) CallGetRestEndPointStream(ctx context.Context, address string, path string) (int, io.ReadCloser, error) {
+	var body io.ReadCloser
+	statusCode, err := bp.getEpochChangeProcessor().callWithRetries(ctx, func() (int, error) {
+		var statusCode int
+		var err error
+		statusCode, body, err = bp.callGetRestEndPointStream(ctx, address, path)
+		return statusCode, err
+	})
+
+	return statusCode, body, err
+}
+
+func (bp *BaseProcessor) callGetRestEndPointStream(ctx context.Context, address string, path string) (int, io.ReadCloser, error) {
+	resp, statusCode, err := bp.getRestEndPointResponse(ctx, address, path)
+	if err != nil {
+		return statusCode, nil, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return resp.StatusCode, resp.Body, nil
+	}
+
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("base process GET stream: close body", "error", errNotCritical.Error())
+		}
+	}()
+
+	responseBodyBytes, err := io.ReadAll(io.LimitReader(resp.Body, int64(bp.httpClients.config.StreamingThresholdInBytes)))
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	return resp.StatusCode, nil, errors.New(string(responseBodyBytes))
+}
+
+// getShardOfNode returns the shard of the provided observer or full history node address
+func (bp *BaseProcessor) getShardOfNode(address string) (uint32, bool) {
+	for _, provider := range []observer.NodesProviderHandler{bp.observersProvider, bp.fullHistoryNodesProvider} {
+		for _, node := range provider.GetAllNodesWithSyncState() {
+			if node.Address == address {
+				return node.ShardId, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
 // CallPostRestEndPoint calls an external end point (sends a request on a node)
 func (bp *BaseProcessor) CallPostRestEndPoint(
 	address string,
@@ -250,13 +759,37 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	data interface{},
 	response interface{},
 ) (int, error) {
+	return bp.CallPostRestEndPointWithContext(context.Background(), address, path, data, response)
+}
+
+// CallPostRestEndPointWithContext calls an external end point (sends a request on a node). The request is canceled
+// when the provided context is done
+func (bp *BaseProcessor) CallPostRestEndPointWithContext(
+	ctx context.Context,
+	address string,
+	path string,
+	data interface{},
+	response interface{},
+) (int, error) {
 
 	buff, err := json.Marshal(data)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
-	req, err := http.NewRequest("POST", address+path, bytes.NewReader(buff))
+	return bp.getEpochChangeProcessor().callWithRetries(ctx, func() (int, error) {
+		return bp.callPostRestEndPoint(ctx, address, path, buff, response)
+	})
+}
+
+func (bp *BaseProcessor) callPostRestEndPoint(
+	ctx context.Context,
+	address string,
+	path string,
+	buff []byte,
+	response interface{},
+) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", address+path, bytes.NewReader(buff))
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -266,14 +799,9 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", userAgent)
 
-	resp, err := bp.httpClient.Do(req)
+	resp, statusCode, err := bp.doRequest(ctx, address, req)
 	if err != nil {
-		bp.triggerNodesSyncCheck(address)
-		if isTimeoutError(err) {
-			return http.StatusRequestTimeout, err
-		}
-
-		return http.StatusNotFound, err
+		return statusCode, err
 	}
 
 	defer func() {
@@ -303,6 +831,47 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	return responseStatusCode, errors.New(genericApiResponse.Error)
 }
 
+// CallRawRestEndPoint forwards the raw request to the provided address and returns the response as it is received,
+// regardless of its status. The caller is responsible for closing the body of the returned response
+func (bp *BaseProcessor) CallRawRestEndPoint(ctx context.Context, address string, request *proxyData.RawObserverRequest) (int, *http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, request.Method, address+request.Path, bytes.NewReader(request.Body))
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	req.URL.RawQuery = request.RawQuery
+	for name, values := range request.Header {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+
+	resp, statusCode, err := bp.doRequest(ctx, address, req)
+	if err != nil {
+		return statusCode, nil, err
+	}
+
+	return statusCode, resp, nil
+}
+
+// handleRequestError returns the status code matching the error of a failed request. If the context of the request is
+// done or the observer is saturated, the observer is not offline, so its sync state is not checked
+func (bp *BaseProcessor) handleRequestError(ctx context.Context, address string, err error) (int, error) {
+	if ctx.Err() != nil {
+		return http.StatusRequestTimeout, ctx.Err()
+	}
+	if errors.Is(err, ErrObserverSaturated) {
+		return http.StatusServiceUnavailable, err
+	}
+
+	bp.triggerNodesSyncCheck(address)
+	if isTimeoutError(err) {
+		return http.StatusRequestTimeout, err
+	}
+
+	return http.StatusNotFound, err
+}
+
 func (bp *BaseProcessor) triggerNodesSyncCheck(address string) {
 	log.Info("triggering nodes state checks because of an offline node", "address of offline node", address)
 	select {
@@ -321,6 +890,9 @@ func isTimeoutError(err error) bool {
 
 // GetShardCoordinator returns the shard coordinator
 func (bp *BaseProcessor) GetShardCoordinator() common.Coordinator {
+	bp.mutState.RLock()
+	defer bp.mutState.RUnlock()
+
 	return bp.shardCoordinator
 }
 
@@ -350,11 +922,13 @@ func computeShardIDs(shardCoordinator common.Coordinator) []uint32 {
 	return shardIDs
 }
 
-func (bp *BaseProcessor) handleOutOfSyncNodes(ctx context.Context) {
+func (bp *BaseProcessor) handleOutOfSyncNodes(ctx context.Context, shouldCheckNodesRightAway bool) {
 	timer := time.NewTimer(bp.delayForCheckingNodesSyncState)
 	defer timer.Stop()
 
-	bp.handleNodes()
+	if shouldCheckNodesRightAway {
+		bp.handleNodes()
+	}
 	for {
 		timer.Reset(bp.delayForCheckingNodesSyncState)
 
@@ -385,17 +959,129 @@ func (bp *BaseProcessor) handleNodes() {
 func (bp *BaseProcessor) updateNodesWithSync() {
 	observers := bp.observersProvider.GetAllNodesWithSyncState()
 	observersWithSyncStatus := bp.getNodesWithSyncStatus(observers)
-	bp.observersProvider.UpdateNodesBasedOnSyncState(observersWithSyncStatus)
 
 	fullHistoryNodes := bp.fullHistoryNodesProvider.GetAllNodesWithSyncState()
 	fullHistoryNodesWithSyncStatus := bp.getNodesWithSyncStatus(fullHistoryNodes)
+
+	if bp.shardsTopology != nil {
+		bp.recordHeartbeatsShards()
+		bp.refreshShardsTopology()
+		bp.rehomeNodes(observersWithSyncStatus)
+		bp.rehomeNodes(fullHistoryNodesWithSyncStatus)
+	}
+
+	bp.observersProvider.UpdateNodesBasedOnSyncState(observersWithSyncStatus)
 	bp.fullHistoryNodesProvider.UpdateNodesBasedOnSyncState(fullHistoryNodesWithSyncStatus)
 }
 
+// refreshShardsTopology reads the number of shards from the network once a new epoch starts and replaces the shard
+// coordinator if the number of shards changed
+func (bp *BaseProcessor) refreshShardsTopology() {
+	epoch, shouldCheck := bp.shardsTopology.getEpochToCheck()
+	if !shouldCheck {
+		return
+	}
+
+	numShards, err := bp.getNetworkNumShards()
+	if err != nil {
+		log.Warn("cannot get the number of shards at epoch change, will retry", "epoch", epoch, "error", err.Error())
+		return
+	}
+	bp.shardsTopology.setEpochChecked(epoch)
+
+	currentNumShards := bp.GetShardCoordinator().NumberOfShards()
+	if numShards == currentNumShards {
+		return
+	}
+
+	shardCoord, err := sharding.NewMultiShardCoordinator(numShards, 0)
+	if err != nil {
+		log.Error("cannot create the shard coordinator for the new number of shards", "num shards", numShards, "error", err.Error())
+		return
+	}
+
+	bp.mutState.Lock()
+	bp.shardCoordinator = shardCoord
+	bp.shardIDs = computeShardIDs(shardCoord)
+	bp.mutState.Unlock()
+
+	log.Warn("the number of shards changed, the shard coordinator was refreshed",
+		"epoch", epoch,
+		"old num shards", currentNumShards,
+		"new num shards", numShards)
+}
+
+func (bp *BaseProcessor) getNetworkNumShards() (uint32, error) {
+	observers, err := bp.observersProvider.GetNodesByShardId(core.MetachainShardId, proxyData.AvailabilityRecent)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, observer := range observers {
+		var response networkConfigResponse
+		_, err = bp.CallGetRestEndPoint(observer.Address, NetworkConfigPath, &response)
+		if err != nil {
+			continue
+		}
+
+		numShards := response.Data.Config.NumShards
+		if numShards == 0 {
+			return 0, fmt.Errorf("%w from observer %s", ErrInvalidNumShardsReceived, observer.Address)
+		}
+
+		return numShards, nil
+	}
+
+	return 0, ErrSendingRequest
+}
+
+// recordHeartbeatsShards feeds the shards topology with the latest heartbeats, if a heartbeats provider was set
+func (bp *BaseProcessor) recordHeartbeatsShards() {
+	bp.mutState.RLock()
+	heartbeatsProvider := bp.heartbeatsProvider
+	bp.mutState.RUnlock()
+	if check.IfNil(heartbeatsProvider) {
+		return
+	}
+
+	heartbeats, err := heartbeatsProvider.GetHeartbeatData()
+	if err != nil {
+		log.Debug("cannot get the heartbeats for the shards topology", "error", err.Error())
+		return
+	}
+
+	bp.shardsTopology.recordHeartbeats(heartbeats.Heartbeats)
+}
+
+// rehomeNodes moves the nodes to the shards they reported on their last status check
+func (bp *BaseProcessor) rehomeNodes(nodes []*proxyData.NodeData) {
+	numMovedNodes := 0
+	for _, node := range nodes {
+		shardID, found := bp.shardsTopology.getNodeShard(node.Address)
+		if !found || shardID == node.ShardId {
+			continue
+		}
+
+		log.Warn("observer moved to the shard it reports", "address", node.Address, "old shard", node.ShardId, "new shard", shardID)
+		node.ShardId = shardID
+		numMovedNodes++
+	}
+
+	if numMovedNodes > 0 {
+		bp.httpClients.onObserversChanged()
+	}
+}
+
 func (bp *BaseProcessor) getNodesWithSyncStatus(nodes []*proxyData.NodeData) []*proxyData.NodeData {
 	nodesToReturn := make([]*proxyData.NodeData, 0)
 	for _, node := range nodes {
 		isSynced, err := bp.isNodeSynced(node)
+		if errors.Is(err, ErrObserverSaturated) {
+			// a saturated observer is busy, not offline, so it keeps its previous sync state
+			log.Debug("cannot get node status. observer saturated", "address", node.Address)
+			nodesToReturn = append(nodesToReturn, node)
+			continue
+		}
 		if err != nil {
 			log.Warn("cannot get node status. will mark as inactive", "address", node.Address, "error", err)
 			isSynced = false
@@ -405,6 +1091,10 @@ func (bp *BaseProcessor) getNodesWithSyncStatus(nodes []*proxyData.NodeData) []*
 		nodesToReturn = append(nodesToReturn, node)
 	}
 
+	if bp.consistency != nil {
+		bp.consistency.checkNodes(nodesToReturn)
+	}
+
 	return nodesToReturn
 }
 
@@ -417,8 +1107,19 @@ func (bp *BaseProcessor) isNodeSynced(node *proxyData.NodeData) (bool, error) {
 		return false, fmt.Errorf("observer %s responded with code %d", node.Address, httpCode)
 	}
 
+	if bp.capabilities != nil {
+		bp.capabilities.updateNodeCapabilities(node, nodeStatusResponse.Data.Metrics.AppVersion)
+	}
+
 	nonce := nodeStatusResponse.Data.Metrics.Nonce
 	probableHighestNonce := nodeStatusResponse.Data.Metrics.ProbableHighestNonce
+	if bp.consistency != nil {
+		bp.consistency.recordNodeNonce(node.Address, nonce)
+	}
+	if bp.shardsTopology != nil {
+		metrics := nodeStatusResponse.Data.Metrics
+		bp.shardsTopology.recordNodeStatus(node.Address, metrics.EpochNumber, metrics.ShardID, metrics.PublicKeyBlockSign)
+	}
 	isReadyForVMQueries := parseBool(nodeStatusResponse.Data.Metrics.AreVmQueriesReady)
 
 	// In some cases, the probableHighestNonce can be lower than the nonce. In this case we consider the node as synced
@@ -439,7 +1140,8 @@ func (bp *BaseProcessor) isNodeSynced(node *proxyData.NodeData) (bool, error) {
 		"is synced", isNodeSynced,
 		"is ready for VM Queries", isReadyForVMQueries,
 		"is snapshotless", node.IsSnapshotless,
-		"is fallback", node.IsFallback)
+		"is fallback", node.IsFallback,
+		"version", node.Version)
 
 	if !isReadyForVMQueries {
 		isNodeSynced = false
@@ -457,7 +1159,7 @@ func (bp *BaseProcessor) getNodeStatusResponseFromAPI(url string) (*proxyData.No
 		return nil, http.StatusNotFound, err
 	}
 
-	resp, err := bp.httpClient.Do(req)
+	resp, err := bp.httpClients.getClient(url).Do(req)
 	if err != nil {
 		return nil, http.StatusNotFound, err
 	}
@@ -469,6 +1171,8 @@ func (bp *BaseProcessor) getNodeStatusResponseFromAPI(url string) (*proxyData.No
 	}()
 
 	if resp.StatusCode != http.StatusOK {
+		// drain the body so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
 		return nil, resp.StatusCode, nil
 	}
 
@@ -487,6 +1191,22 @@ func (bp *BaseProcessor) getNodeStatusResponseFromAPI(url string) (*proxyData.No
 	return &nodeStatusResponse, resp.StatusCode, nil
 }
 
+func (bp *BaseProcessor) getBlockHashFromAPI(address string, nonce uint64) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutDurationForNodeStatus)
+	defer cancel()
+
+	var response proxyData.BlockApiResponse
+	_, err := bp.CallGetRestEndPointWithContext(ctx, address, fmt.Sprintf("%s/%d", blockByNoncePath, nonce), &response)
+	if err != nil {
+		return "", err
+	}
+	if len(response.Data.Block.Hash) == 0 {
+		return "", fmt.Errorf("empty block hash received from observer %s", address)
+	}
+
+	return response.Data.Block.Hash, nil
+}
+
 func parseBool(metricValue string) bool {
 	return strconv.FormatBool(true) == metricValue
 }
@@ -501,6 +1221,10 @@ func (bp *BaseProcessor) Close() error {
 	if bp.cancelFunc != nil {
 		bp.cancelFunc()
 	}
+	if bp.topologySnapshot != nil {
+		bp.saveTopologySnapshot()
+	}
+	bp.httpClients.closeIdleConnections()
 
 	return nil
 }
@@ -2,17 +2,23 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
@@ -47,14 +53,13 @@ func createTestHttpServer(
 func TestNewBaseProcessor_WithInvalidRequestTimeoutShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		-5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        -5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	assert.Nil(t, bp)
 	assert.Equal(t, process.ErrInvalidRequestTimeout, err)
@@ -63,14 +68,12 @@ func TestNewBaseProcessor_WithInvalidRequestTimeoutShouldErr(t *testing.T) {
 func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		5,
-		nil,
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	assert.Nil(t, bp)
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -79,14 +82,12 @@ func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		nil,
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:   &mock.PubKeyConverterMock{},
+	})
 
 	assert.Nil(t, bp)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
@@ -95,14 +96,12 @@ func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		nil,
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	assert.Nil(t, bp)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
@@ -111,43 +110,132 @@ func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T)
 func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	assert.NotNil(t, bp)
 	assert.Nil(t, err)
 }
 
+func TestNewBaseProcessor_WithInvalidHttpClientConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		HttpClientConfig: config.ObserversHttpClientConfig{
+			MaxConnsPerHost: -1,
+		},
+	})
+
+	assert.Nil(t, bp)
+	assert.True(t, errors.Is(err, process.ErrInvalidObserversHttpClientConfig))
+}
+
 //------- GetObservers
 
 func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 	t.Parallel()
 
 	observersSlice := []*data.NodeData{{Address: "addr1"}}
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 				return observersSlice, nil
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 	observers, err := bp.GetObservers(0, data.AvailabilityAll)
 
 	assert.Nil(t, err)
 	assert.Equal(t, observersSlice, observers)
 }
 
+func TestNewBaseProcessor_EmptyUpstreamProxyAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		UpstreamProxies:          []string{"http://upstream1", ""},
+	})
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrEmptyUpstreamProxyAddress, err)
+}
+
+func TestBaseProcessor_GetObserversWithUpstreamProxies(t *testing.T) {
+	t.Parallel()
+
+	upstreamProxies := []string{"http://upstream1", "http://upstream2"}
+	expectedUpstreamNodes := []*data.NodeData{
+		{ShardId: 1, Address: "http://upstream1", IsSynced: true, IsFallback: true},
+		{ShardId: 1, Address: "http://upstream2", IsSynced: true, IsFallback: true},
+	}
+
+	t.Run("upstream proxies should be placed between synced and out of sync nodes", func(t *testing.T) {
+		t.Parallel()
+
+		syncedNode := &data.NodeData{ShardId: 1, Address: "synced", IsSynced: true}
+		outOfSyncNode := &data.NodeData{ShardId: 1, Address: "out of sync", IsSynced: false}
+		bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+			RequestTimeoutSec: 5,
+			ShardCoordinator:  &mock.ShardCoordinatorMock{},
+			ObserversProvider: &mock.ObserversProviderStub{
+				GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{outOfSyncNode, syncedNode}, nil
+				},
+			},
+			FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+			PubKeyConverter:          &mock.PubKeyConverterMock{},
+			UpstreamProxies:          upstreamProxies,
+		})
+
+		observers, err := bp.GetObservers(1, data.AvailabilityAll)
+		require.Nil(t, err)
+
+		expectedObservers := append([]*data.NodeData{syncedNode}, expectedUpstreamNodes...)
+		expectedObservers = append(expectedObservers, outOfSyncNode)
+		assert.Equal(t, expectedObservers, observers)
+	})
+
+	t.Run("no local full history nodes should return the upstream proxies", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+			RequestTimeoutSec: 5,
+			ShardCoordinator:  &mock.ShardCoordinatorMock{},
+			ObserversProvider: &mock.ObserversProviderStub{},
+			FullHistoryNodesProvider: &mock.ObserversProviderStub{
+				GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return nil, errors.New("no nodes")
+				},
+			},
+			PubKeyConverter: &mock.PubKeyConverterMock{},
+			UpstreamProxies: upstreamProxies,
+		})
+
+		nodes, err := bp.GetFullHistoryNodes(1, data.AvailabilityAll)
+		require.Nil(t, err)
+		assert.Equal(t, expectedUpstreamNodes, nodes)
+	})
+}
+
 //------- ComputeShardId
 
 func TestBaseProcessor_ComputeShardId(t *testing.T) {
@@ -165,18 +253,17 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 	}
 
 	msc, _ := sharding.NewMultiShardCoordinator(3, 0)
-	bp, _ := process.NewBaseProcessor(
-		5,
-		msc,
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  msc,
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 				return observersList, nil
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	//there are 2 shards, compute ID should correctly process
 	addressInShard0 := []byte{0}
@@ -192,6 +279,40 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 
 //------- Calls
 
+func TestBaseProcessor_CallGetRestEndPointShouldReuseConnections(t *testing.T) {
+	t.Parallel()
+
+	response, _ := json.Marshal(&testStruct{Nonce: 1})
+	numNewConnections := uint32(0)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write(response)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddUint32(&numNewConnections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
+
+	numRequests := 10
+	for i := 0; i < numRequests; i++ {
+		_, err := bp.CallGetRestEndPoint(server.URL, "/some/path", &testStruct{})
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numNewConnections))
+	assert.Nil(t, bp.Close())
+}
+
 func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
@@ -204,20 +325,199 @@ func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
 	defer server.Close()
 
 	tsRecovered := &testStruct{}
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 	_, err := bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
 
 	assert.Nil(t, err)
 	assert.Equal(t, ts, tsRecovered)
 }
 
+func TestBaseProcessor_CallGetRestEndPointLargeResponseShouldStream(t *testing.T) {
+	t.Parallel()
+
+	ts := &testStruct{
+		Nonce: 10000,
+		Name:  strings.Repeat("a", 1000),
+	}
+	response, _ := json.Marshal(ts)
+
+	server := createTestHttpServer("/some/path", response)
+	defer server.Close()
+
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		HttpClientConfig:         config.ObserversHttpClientConfig{StreamingThresholdInBytes: 100},
+	})
+
+	tsRecovered := &testStruct{}
+	statusCode, err := bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, ts, tsRecovered)
+}
+
+func TestBaseProcessor_CallGetRestEndPointObserverSaturated(t *testing.T) {
+	t.Parallel()
+
+	chanSlowArrived := make(chan struct{})
+	chanRelease := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/slow" {
+			close(chanSlowArrived)
+			<-chanRelease
+		}
+		_, _ = rw.Write([]byte(`{"data":{},"error":"","code":"successful"}`))
+	}))
+	defer server.Close()
+
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		HttpClientConfig:         config.ObserversHttpClientConfig{MaxInFlightRequestsPerHost: 1},
+	})
+
+	chanSlowDone := make(chan error, 1)
+	go func() {
+		_, errGet := bp.CallGetRestEndPoint(server.URL, "/slow", &data.GenericAPIResponse{})
+		chanSlowDone <- errGet
+	}()
+	<-chanSlowArrived
+
+	statusCode, err := bp.CallGetRestEndPoint(server.URL, "/fast", &data.GenericAPIResponse{})
+	require.True(t, errors.Is(err, process.ErrObserverSaturated))
+	require.Equal(t, http.StatusServiceUnavailable, statusCode)
+
+	close(chanRelease)
+	require.NoError(t, <-chanSlowDone)
+
+	statusCode, err = bp.CallGetRestEndPoint(server.URL, "/fast", &data.GenericAPIResponse{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+}
+
+func TestBaseProcessor_CallGetRestEndPointResponseSizeLimits(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"data":{"txPool":{"regularTransactions":[{"txFields":{"hash":"aa"}},{"txFields":{"hash":"bb"}},{"txFields":{"hash":"cc"}}]}},"code":"successful"}`))
+	}))
+	defer server.Close()
+
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		ResponseSizeLimitsConfig: config.ResponseSizeLimitsConfig{
+			Enabled: true,
+			Classes: []config.ResponseSizeLimitClassConfig{
+				{Name: "blocks", Paths: []string{"/block/"}, MaxSizeInBytes: 100},
+				{Name: "transactions-pool", Paths: []string{"/transaction/pool"}, MaxSizeInBytes: 100, Truncate: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("response above the limit should be rejected", func(t *testing.T) {
+
+		_, errGet := bp.CallGetRestEndPoint(server.URL, "/block/by-nonce/1", &data.GenericAPIResponse{})
+		require.True(t, errors.Is(errGet, process.ErrObserverResponseTooLarge))
+	})
+
+	t.Run("response above the limit should be truncated", func(t *testing.T) {
+
+		response := &data.TransactionsPoolApiResponse{}
+		statusCode, truncation, errGet := bp.CallGetRestEndPointWithTruncation(context.Background(), server.URL, "/transaction/pool", response, 0)
+		require.NoError(t, errGet)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.True(t, truncation.Truncated)
+		require.Equal(t, uint64(2), truncation.NumReturned)
+		require.Len(t, response.Data.Transactions.RegularTransactions, 2)
+
+		response = &data.TransactionsPoolApiResponse{}
+		_, truncation, errGet = bp.CallGetRestEndPointWithTruncation(context.Background(), server.URL, "/transaction/pool", response, 2)
+		require.NoError(t, errGet)
+		require.False(t, truncation.Truncated)
+		require.Equal(t, uint64(2), truncation.NumSkipped)
+		require.Len(t, response.Data.Transactions.RegularTransactions, 1)
+		require.Equal(t, "cc", response.Data.Transactions.RegularTransactions[0].TxFields["hash"])
+	})
+
+	t.Run("paths without limits should not be affected", func(t *testing.T) {
+
+		response := &data.TransactionsPoolApiResponse{}
+		_, truncation, errGet := bp.CallGetRestEndPointWithTruncation(context.Background(), server.URL, "/network/config", response, 0)
+		require.NoError(t, errGet)
+		require.False(t, truncation.Truncated)
+		require.Len(t, response.Data.Transactions.RegularTransactions, 3)
+	})
+
+	t.Run("canceled context should cancel the request", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		response := &data.TransactionsPoolApiResponse{}
+		_, _, errGet := bp.CallGetRestEndPointWithTruncation(ctx, server.URL, "/transaction/pool", response, 0)
+		require.True(t, errors.Is(errGet, context.Canceled))
+	})
+}
+
+func TestBaseProcessor_CallGetRestEndPointStream(t *testing.T) {
+	t.Parallel()
+
+	response := []byte(`{"data":{"txPool":{}},"error":"","code":"successful"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/some/path" {
+			rw.WriteHeader(http.StatusBadRequest)
+			_, _ = rw.Write([]byte("bad request"))
+			return
+		}
+
+		_, _ = rw.Write(response)
+	}))
+	defer server.Close()
+
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
+
+	statusCode, body, err := bp.CallGetRestEndPointStream(context.Background(), server.URL, "/some/path")
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	receivedResponse, _ := io.ReadAll(body)
+	require.Nil(t, body.Close())
+	require.Equal(t, response, receivedResponse)
+
+	statusCode, body, err = bp.CallGetRestEndPointStream(context.Background(), server.URL, "/other/path")
+	require.Equal(t, "bad request", err.Error())
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Nil(t, body)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, body, err = bp.CallGetRestEndPointStream(ctx, server.URL, "/some/path")
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Nil(t, body)
+}
+
 func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
@@ -233,14 +533,13 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	defer testServer.Close()
 
 	tsRecovered := &testStruct{}
-	bp, _ := process.NewBaseProcessor(
-		1,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        1,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 	_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", tsRecovered)
 
 	assert.NotEqual(t, ts.Name, tsRecovered.Name)
@@ -258,14 +557,13 @@ func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 	fmt.Printf("Server: %s\n", server.URL)
 	defer server.Close()
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 	rc, err := bp.CallPostRestEndPoint(server.URL, "/some/path", ts, tsRecv)
 
 	assert.Nil(t, err)
@@ -289,14 +587,13 @@ func TestBaseProcessor_CallPostRestEndPointShouldTimeout(t *testing.T) {
 	fmt.Printf("Server: %s\n", testServer.URL)
 	defer testServer.Close()
 
-	bp, _ := process.NewBaseProcessor(
-		1,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        1,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 	rc, err := bp.CallPostRestEndPoint(testServer.URL, "/some/path", ts, tsRecv)
 
 	assert.NotEqual(t, tsRecv.Name, ts.Name)
@@ -326,18 +623,17 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 		Address: server.URL,
 	})
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetAllNodesCalled: func(_ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 				return observersList, nil
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	assert.Nil(t, err)
 
@@ -368,10 +664,10 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 		{Address: "shard meta - id 1"},
 	}
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 2},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{NumShards: 2},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 				switch shardId {
 				case 0:
@@ -385,10 +681,9 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 				return nil, nil
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
 	assert.NoError(t, err)
@@ -417,10 +712,10 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 		{Address: "shard meta - id 1"},
 	}
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 2},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{NumShards: 2},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 				switch shardId {
 				case 0:
@@ -434,10 +729,9 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 				return nil, nil
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
 	assert.NoError(t, err)
@@ -466,10 +760,10 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 	}
 	var observersListShardMeta []*data.NodeData
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 2},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{NumShards: 2},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 				switch shardId {
 				case 0:
@@ -483,10 +777,9 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 				return nil, nil
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	observers, err := bp.GetObserversOnePerShard(data.AvailabilityAll)
 	assert.NoError(t, err)
@@ -519,11 +812,11 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 		{Address: "shard meta - id 1"},
 	}
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 2},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{NumShards: 2},
+		ObserversProvider: &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 				switch shardId {
 				case 0:
@@ -537,9 +830,8 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 				return nil, nil
 			},
 		},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		PubKeyConverter: &mock.PubKeyConverterMock{},
+	})
 
 	observers, err := bp.GetFullHistoryNodesOnePerShard(data.AvailabilityAll)
 	assert.NoError(t, err)
@@ -553,14 +845,13 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 func TestBaseProcessor_GetShardIDs(t *testing.T) {
 	t.Parallel()
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 3},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{NumShards: 3},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	expected := []uint32{0, 1, 2, core.MetachainShardId}
 	require.Equal(t, expected, bp.GetShardIDs())
@@ -569,10 +860,10 @@ func TestBaseProcessor_GetShardIDs(t *testing.T) {
 func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotReady(t *testing.T) {
 	numTimesUpdateNodesWasCalled := uint32(0)
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
 				return []*data.NodeData{
 					{Address: "address0", ShardId: 0, IsSynced: true},
@@ -585,10 +876,9 @@ func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotR
 				atomic.AddUint32(&numTimesUpdateNodesWasCalled, 1)
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
 		if url == "address0" {
@@ -615,10 +905,10 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 	numTimesUpdateNodesWasCalled := uint32(0)
 	numTimesGetStatusWasCalled := uint32(0)
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
 				numTimesCalled := atomic.LoadUint32(&numTimesGetStatusWasCalled)
 				isSynced := numTimesCalled%2 == 0
@@ -646,10 +936,9 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 				require.True(t, nodesWithSyncStatus[0].IsSynced)
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
 		defer func() {
@@ -689,10 +978,10 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 	numTimesUpdateNodesWasCalled := uint32(0)
 	numTimesGetStatusWasCalled := uint32(0)
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
 				numTimesCalled := atomic.LoadUint32(&numTimesGetStatusWasCalled)
 				isSynced := numTimesCalled%2 == 0
@@ -708,10 +997,9 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 				require.True(t, nodesWithSyncStatus[0].IsSynced)
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
 		defer func() {
@@ -743,10 +1031,10 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 
 	numTimesUpdateNodesWasCalled := uint32(0)
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
 				return []*data.NodeData{
 					{Address: "address0", ShardId: 0, IsSynced: true},
@@ -757,10 +1045,9 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 				atomic.AddUint32(&numTimesUpdateNodesWasCalled, 1)
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
 		return &data.NodeStatusAPIResponse{
@@ -789,10 +1076,10 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 
 	numTimesUpdateNodesWasCalled := uint32(0)
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
 				return []*data.NodeData{
 					{Address: "address0", ShardId: 0, IsSynced: true},
@@ -805,7 +1092,7 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 				atomic.AddUint32(&numTimesUpdateNodesWasCalled, 1)
 			},
 		},
-		&mock.ObserversProviderStub{
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
 				return []*data.NodeData{
 					{Address: "fhaddress0", ShardId: 0, IsSynced: true},
@@ -818,9 +1105,8 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 				atomic.AddUint32(&numTimesUpdateNodesWasCalled, 1)
 			},
 		},
-		&mock.PubKeyConverterMock{},
-		false,
-	)
+		PubKeyConverter: &mock.PubKeyConverterMock{},
+	})
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
 		if url == "address0" {
@@ -852,10 +1138,10 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 func TestBaseProcessor_NoStatusCheck(t *testing.T) {
 
 	numPrintNodesInShardsCalled := uint32(0)
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
 				require.Fail(t, "should have not been called")
 				return nil
@@ -867,10 +1153,10 @@ func TestBaseProcessor_NoStatusCheck(t *testing.T) {
 				atomic.AddUint32(&numPrintNodesInShardsCalled, 1)
 			},
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-		true,
-	)
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		NoStatusCheck:            true,
+	})
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
 		require.Fail(t, "should have not been called")
@@ -888,6 +1174,265 @@ func TestBaseProcessor_NoStatusCheck(t *testing.T) {
 	time.Sleep(50 * time.Millisecond)
 }
 
+func TestNewBaseProcessor_InvalidTopologySnapshotConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		TopologySnapshotConfig:   config.TopologySnapshotConfig{Enabled: true},
+	})
+
+	assert.Nil(t, bp)
+	assert.ErrorIs(t, err, process.ErrInvalidTopologySnapshotConfig)
+}
+
+func TestBaseProcessor_TopologySnapshotShouldBeRestoredAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	topologySnapshotConfig := config.TopologySnapshotConfig{
+		Enabled:           true,
+		FilePath:          filepath.Join(t.TempDir(), "topology.json"),
+		SaveIntervalInSec: 60,
+		MaxAgeInSec:       300,
+	}
+	createBaseProcessor := func(observersProvider *mock.ObserversProviderStub) *process.BaseProcessor {
+		bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+			RequestTimeoutSec:        5,
+			ShardCoordinator:         &mock.ShardCoordinatorMock{NumShards: 2},
+			ObserversProvider:        observersProvider,
+			FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+			PubKeyConverter:          &mock.PubKeyConverterMock{},
+			TopologySnapshotConfig:   topologySnapshotConfig,
+		})
+		require.NoError(t, err)
+
+		return bp
+	}
+
+	bp := createBaseProcessor(&mock.ObserversProviderStub{
+		GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+			return []*data.NodeData{
+				{Address: "observer0", ShardId: 0, IsSynced: true},
+				{Address: "observer1", ShardId: 1, IsSynced: false},
+			}
+		},
+	})
+	_ = bp.Close()
+
+	var restoredNodes []*data.NodeData
+	bp = createBaseProcessor(&mock.ObserversProviderStub{
+		GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+			return []*data.NodeData{
+				{Address: "observer0", ShardId: 0, IsSynced: true},
+				{Address: "observer1", ShardId: 1, IsSynced: true},
+			}
+		},
+		UpdateNodesBasedOnSyncStateCalled: func(nodesWithSyncStatus []*data.NodeData) {
+			restoredNodes = nodesWithSyncStatus
+		},
+	})
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		assert.Fail(t, "should have not probed the observers")
+		return nil, http.StatusBadRequest, nil
+	})
+	bp.SetDelayForCheckingNodesSyncState(time.Hour)
+	bp.StartNodesSyncStateChecks()
+	time.Sleep(50 * time.Millisecond)
+	_ = bp.Close()
+
+	expectedNodes := []*data.NodeData{
+		{Address: "observer0", ShardId: 0, IsSynced: true},
+		{Address: "observer1", ShardId: 1, IsSynced: false},
+	}
+	assert.Equal(t, expectedNodes, restoredNodes)
+}
+
+func TestBaseProcessor_HandleNodesSyncStateShouldStoreVersionAndCapabilities(t *testing.T) {
+	t.Parallel()
+
+	chanUpdatedNodes := make(chan []*data.NodeData, 10)
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{
+					{Address: "address0", ShardId: 0},
+					{Address: "address1", ShardId: 0},
+				}
+			},
+			UpdateNodesBasedOnSyncStateCalled: func(nodesWithSyncStatus []*data.NodeData) {
+				chanUpdatedNodes <- nodesWithSyncStatus
+			},
+		},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		CapabilitiesConfig: config.ObserversCapabilitiesConfig{
+			Enabled: true,
+			Capabilities: []config.ObserverCapabilityConfig{
+				{Name: data.CapabilityTxPoolNonceGaps, MinVersion: "v1.6.0"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		response := getResponseForNodeStatus(true, "true")
+		response.Data.Metrics.AppVersion = "v1.5.9-0-gabcdef/go1.20.7"
+		if url == "address1" {
+			response.Data.Metrics.AppVersion = "v1.6.18-0-gabcdef/go1.20.7"
+		}
+		return response, 200, nil
+	})
+	bp.SetDelayForCheckingNodesSyncState(time.Hour)
+	bp.StartNodesSyncStateChecks()
+	defer func() {
+		_ = bp.Close()
+	}()
+
+	select {
+	case updatedNodes := <-chanUpdatedNodes:
+		require.Equal(t, "v1.5.9-0-gabcdef/go1.20.7", updatedNodes[0].Version)
+		require.False(t, updatedNodes[0].SupportsCapability(data.CapabilityTxPoolNonceGaps))
+		require.Equal(t, "v1.6.18-0-gabcdef/go1.20.7", updatedNodes[1].Version)
+		require.True(t, updatedNodes[1].SupportsCapability(data.CapabilityTxPoolNonceGaps))
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout waiting for the nodes sync state update")
+	}
+}
+
+func TestBaseProcessor_HandleNodesSyncStateShouldRefreshShardsTopologyAtEpochChange(t *testing.T) {
+	t.Parallel()
+
+	numShards := uint32(3)
+	metaObserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, process.NetworkConfigPath, r.URL.Path)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"config":{"erd_num_shards_without_meta":%d}}}`, atomic.LoadUint32(&numShards))))
+	}))
+	defer metaObserver.Close()
+
+	chanUpdatedNodes := make(chan []*data.NodeData, 100)
+	observersProvider := &mock.ObserversProviderStub{
+		GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+			return []*data.NodeData{
+				{Address: "address0", ShardId: 0},
+				{Address: "address2", ShardId: 2},
+				{Address: metaObserver.URL, ShardId: core.MetachainShardId},
+			}
+		},
+		GetNodesByShardIdCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			require.Equal(t, core.MetachainShardId, shardId)
+			return []*data.NodeData{{Address: metaObserver.URL, ShardId: core.MetachainShardId}}, nil
+		},
+		UpdateNodesBasedOnSyncStateCalled: func(nodesWithSyncStatus []*data.NodeData) {
+			chanUpdatedNodes <- nodesWithSyncStatus
+		},
+	}
+	shardCoord, _ := sharding.NewMultiShardCoordinator(3, 0)
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         shardCoord,
+		ObserversProvider:        observersProvider,
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		ShardsTopologyConfig:     config.ShardsTopologyConfig{Enabled: true},
+	})
+	require.Nil(t, err)
+
+	epoch := uint32(5)
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		response := getResponseForNodeStatus(true, "true")
+		response.Data.Metrics.EpochNumber = atomic.LoadUint32(&epoch)
+		if url == "address2" && atomic.LoadUint32(&numShards) == 2 {
+			// the shards 1 and 2 merged, the observer reports its new shard
+			shardID := uint32(1)
+			response.Data.Metrics.ShardID = &shardID
+		}
+		return response, http.StatusOK, nil
+	})
+	bp.SetDelayForCheckingNodesSyncState(10 * time.Millisecond)
+	bp.StartNodesSyncStateChecks()
+	defer func() {
+		_ = bp.Close()
+	}()
+
+	updatedNodes := <-chanUpdatedNodes
+	require.Equal(t, uint32(2), updatedNodes[1].ShardId)
+
+	atomic.StoreUint32(&numShards, 2)
+	atomic.StoreUint32(&epoch, 6)
+	require.Eventually(t, func() bool {
+		return len(bp.GetShardIDs()) == 3
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint32{0, 1, core.MetachainShardId}, bp.GetShardIDs())
+	require.Equal(t, uint32(2), bp.GetShardCoordinator().NumberOfShards())
+
+	require.Eventually(t, func() bool {
+		updatedNodes = <-chanUpdatedNodes
+		return updatedNodes[1].ShardId == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBaseProcessor_HandleNodesSyncStateShouldRehomeNodesBasedOnHeartbeats(t *testing.T) {
+	t.Parallel()
+
+	chanUpdatedNodes := make(chan []*data.NodeData, 100)
+	observersProvider := &mock.ObserversProviderStub{
+		GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+			return []*data.NodeData{
+				{Address: "address0", ShardId: 0},
+				{Address: "address1", ShardId: 1},
+			}
+		},
+		UpdateNodesBasedOnSyncStateCalled: func(nodesWithSyncStatus []*data.NodeData) {
+			chanUpdatedNodes <- nodesWithSyncStatus
+		},
+	}
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        observersProvider,
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		ShardsTopologyConfig:     config.ShardsTopologyConfig{Enabled: true, UseHeartbeats: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, process.ErrNilHeartbeatsProvider, bp.SetHeartbeatsProvider(nil))
+
+	err = bp.SetHeartbeatsProvider(&mock.HeartbeatsProviderStub{
+		GetHeartbeatDataCalled: func() (*data.HeartbeatResponse, error) {
+			return &data.HeartbeatResponse{
+				Heartbeats: []data.PubKeyHeartbeat{
+					{PublicKey: "pk0", ReceivedShardID: 0, IsActive: true},
+					// the observer was repointed to shard 2
+					{PublicKey: "pk1", ReceivedShardID: 2, IsActive: true},
+				},
+			}, nil
+		},
+	})
+	require.Nil(t, err)
+
+	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
+		response := getResponseForNodeStatus(true, "true")
+		response.Data.Metrics.PublicKeyBlockSign = strings.Replace(url, "address", "pk", 1)
+		return response, http.StatusOK, nil
+	})
+	bp.SetDelayForCheckingNodesSyncState(10 * time.Millisecond)
+	bp.StartNodesSyncStateChecks()
+	defer func() {
+		_ = bp.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		updatedNodes := <-chanUpdatedNodes
+		return updatedNodes[0].ShardId == 0 && updatedNodes[1].ShardId == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
 func getResponseForNodeStatus(synced bool, vmQueriesReadyStr string) *data.NodeStatusAPIResponse {
 	nonce, probableHighestNonce := uint64(10), uint64(11)
 	if !synced {
@@ -906,3 +1451,158 @@ func getResponseForNodeStatus(synced bool, vmQueriesReadyStr string) *data.NodeS
 
 	return &obj
 }
+
+func createBaseProcessorWithHedgedRequests(t *testing.T, hedgedRequestsConfig config.HedgedRequestsConfig) *process.BaseProcessor {
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		HedgedRequestsConfig:     hedgedRequestsConfig,
+	})
+	require.Nil(t, err)
+
+	return bp
+}
+
+func startObserverRespondingAfter(t *testing.T, delay time.Duration, statusCode int, name string, numCanceled *int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			atomic.AddInt32(numCanceled, 1)
+			return
+		}
+
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"Name": "%s"}`, name)))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNewBaseProcessor_InvalidHedgedRequestsConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		HedgedRequestsConfig:     config.HedgedRequestsConfig{Enabled: true},
+	})
+	require.Nil(t, bp)
+	require.True(t, errors.Is(err, process.ErrInvalidHedgedRequestsConfig))
+}
+
+func TestBaseProcessor_CallGetRestEndPointHedged(t *testing.T) {
+	t.Parallel()
+
+	hedgedRequestsConfig := config.HedgedRequestsConfig{
+		Enabled:         true,
+		DelayPercentile: 95,
+		MinDelayInMs:    10,
+		MaxDelayInMs:    50,
+	}
+
+	t.Run("no observers should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp := createBaseProcessorWithHedgedRequests(t, hedgedRequestsConfig)
+		observer, _, err := bp.CallGetRestEndPointHedged(context.Background(), nil, "/path", &testStruct{})
+		require.Nil(t, observer)
+		require.Equal(t, process.ErrMissingObserver, err)
+	})
+	t.Run("slow observer should be hedged and canceled", func(t *testing.T) {
+		t.Parallel()
+
+		numCanceled := int32(0)
+		slowObserver := startObserverRespondingAfter(t, 2*time.Second, http.StatusOK, "slow", &numCanceled)
+		fastObserver := startObserverRespondingAfter(t, 0, http.StatusOK, "fast", &numCanceled)
+		bp := createBaseProcessorWithHedgedRequests(t, hedgedRequestsConfig)
+
+		start := time.Now()
+		response := &testStruct{}
+		observers := []*data.NodeData{{Address: slowObserver.URL}, {Address: fastObserver.URL}}
+		observer, statusCode, err := bp.CallGetRestEndPointHedged(context.Background(), observers, "/path", response)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, fastObserver.URL, observer.Address)
+		require.Equal(t, "fast", response.Name)
+		require.Less(t, time.Since(start), time.Second)
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&numCanceled) == 1
+		}, time.Second, 10*time.Millisecond)
+	})
+	t.Run("failed observer should be followed by the next one", func(t *testing.T) {
+		t.Parallel()
+
+		numCanceled := int32(0)
+		failingObserver := startObserverRespondingAfter(t, 0, http.StatusInternalServerError, "failing", &numCanceled)
+		workingObserver := startObserverRespondingAfter(t, 0, http.StatusOK, "working", &numCanceled)
+		bp := createBaseProcessorWithHedgedRequests(t, hedgedRequestsConfig)
+
+		response := &testStruct{}
+		observers := []*data.NodeData{{Address: failingObserver.URL}, {Address: workingObserver.URL}}
+		observer, _, err := bp.CallGetRestEndPointHedged(context.Background(), observers, "/path", response)
+		require.NoError(t, err)
+		require.Equal(t, workingObserver.URL, observer.Address)
+		require.Equal(t, "working", response.Name)
+	})
+	t.Run("all observers failing should return the last error", func(t *testing.T) {
+		t.Parallel()
+
+		numCanceled := int32(0)
+		failingObserver := startObserverRespondingAfter(t, 0, http.StatusInternalServerError, "failing", &numCanceled)
+		bp := createBaseProcessorWithHedgedRequests(t, hedgedRequestsConfig)
+
+		response := &testStruct{}
+		observer, statusCode, err := bp.CallGetRestEndPointHedged(context.Background(), []*data.NodeData{{Address: failingObserver.URL}}, "/path", response)
+		require.Nil(t, observer)
+		require.Equal(t, http.StatusInternalServerError, statusCode)
+		require.NotNil(t, err)
+		require.Equal(t, "failing", response.Name)
+	})
+	t.Run("canceled client request should cancel the pending requests", func(t *testing.T) {
+		t.Parallel()
+
+		numCanceled := int32(0)
+		firstObserver := startObserverRespondingAfter(t, 2*time.Second, http.StatusOK, "first", &numCanceled)
+		secondObserver := startObserverRespondingAfter(t, 2*time.Second, http.StatusOK, "second", &numCanceled)
+		bp := createBaseProcessorWithHedgedRequests(t, hedgedRequestsConfig)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		observers := []*data.NodeData{{Address: firstObserver.URL}, {Address: secondObserver.URL}}
+		observer, _, err := bp.CallGetRestEndPointHedged(ctx, observers, "/path", &testStruct{})
+		require.Nil(t, observer)
+		require.NotNil(t, err)
+		require.Less(t, time.Since(start), time.Second)
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&numCanceled) == 2
+		}, time.Second, 10*time.Millisecond)
+	})
+	t.Run("disabled hedging should call the observers in order", func(t *testing.T) {
+		t.Parallel()
+
+		numCanceled := int32(0)
+		slowObserver := startObserverRespondingAfter(t, 100*time.Millisecond, http.StatusOK, "slow", &numCanceled)
+		fastObserver := startObserverRespondingAfter(t, 0, http.StatusOK, "fast", &numCanceled)
+		bp := createBaseProcessorWithHedgedRequests(t, config.HedgedRequestsConfig{})
+
+		response := &testStruct{}
+		observers := []*data.NodeData{{Address: slowObserver.URL}, {Address: fastObserver.URL}}
+		observer, _, err := bp.CallGetRestEndPointHedged(context.Background(), observers, "/path", response)
+		require.NoError(t, err)
+		require.Equal(t, slowObserver.URL, observer.Address)
+		require.Equal(t, "slow", response.Name)
+		require.Zero(t, atomic.LoadInt32(&numCanceled))
+	})
+}
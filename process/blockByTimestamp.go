@@ -0,0 +1,148 @@
+package process
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	metricRoundDuration = "erd_round_duration"
+	metricStartTime     = "erd_start_time"
+)
+
+// SetBlocksNetworkProvider sets the network metrics source used to map the timestamps to the metachain blocks
+func (bp *BlockProcessor) SetBlocksNetworkProvider(networkProvider BlocksNetworkProvider) error {
+	if networkProvider == nil {
+		return ErrNilBlocksNetworkProvider
+	}
+
+	bp.networkProvider = networkProvider
+	return nil
+}
+
+// GetHyperBlockByTimestamp returns the hyperblock whose round interval contains the provided unix timestamp. If no
+// metachain block was proposed in that round, the last hyperblock proposed before it is returned
+func (bp *BlockProcessor) GetHyperBlockByTimestamp(timestamp int64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	nonce, err := bp.getMetaNonceByTimestamp(timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return bp.GetHyperBlockByNonce(nonce, options)
+}
+
+// getMetaNonceByTimestamp returns the nonce of the last metachain block proposed up to the round holding the provided
+// timestamp. The round is computed out of the start time and the round duration from the network config, while the
+// nonce is estimated out of the rounds missed up to the current round. As each round produces at most one block, the
+// estimated block was proposed up to the round, so a single verification query is enough when no round was missed
+// since the timestamp. Otherwise, the nonce is found with a binary search between the estimated and the latest nonce
+func (bp *BlockProcessor) getMetaNonceByTimestamp(timestamp int64) (uint64, error) {
+	if bp.networkProvider == nil {
+		return 0, ErrNilBlocksNetworkProvider
+	}
+
+	round, err := bp.computeRoundOfTimestamp(timestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	latestNonce, currentRound, err := bp.getMetaNonceAndRound()
+	if err != nil {
+		return 0, err
+	}
+	if round > currentRound {
+		return 0, fmt.Errorf("%w, timestamp %d is after the current round %d", ErrInvalidBlockTimestamp, timestamp, currentRound)
+	}
+
+	fetchedRounds := make(map[uint64]uint64)
+	getBlockRound := func(nonce uint64) (uint64, error) {
+		blockRound, found := fetchedRounds[nonce]
+		if found {
+			return blockRound, nil
+		}
+
+		response, errGet := bp.GetBlockByNonce(context.Background(), core.MetachainShardId, nonce, common.BlockQueryOptions{})
+		if errGet != nil {
+			return 0, errGet
+		}
+
+		fetchedRounds[nonce] = response.Data.Block.Round
+		return response.Data.Block.Round, nil
+	}
+
+	low, high := uint64(0), core.MinUint64(latestNonce, round)
+	numMissedRounds := uint64(0)
+	if currentRound > latestNonce {
+		numMissedRounds = currentRound - latestNonce
+	}
+	if round > numMissedRounds {
+		estimatedNonce := core.MinUint64(round-numMissedRounds, high)
+		estimatedRound, errGet := getBlockRound(estimatedNonce)
+		if errGet != nil {
+			return 0, errGet
+		}
+		if estimatedRound == round {
+			return estimatedNonce, nil
+		}
+		// the estimation does not hold if the round duration changed, in which case all the nonces are searched
+		if estimatedRound < round {
+			low = estimatedNonce
+		}
+	}
+
+	for low < high {
+		middle := low + (high-low+1)/2
+		blockRound, errGet := getBlockRound(middle)
+		if errGet != nil {
+			return 0, errGet
+		}
+
+		if blockRound <= round {
+			low = middle
+		} else {
+			high = middle - 1
+		}
+	}
+
+	return low, nil
+}
+
+func (bp *BlockProcessor) computeRoundOfTimestamp(timestamp int64) (uint64, error) {
+	response, err := bp.networkProvider.GetNetworkConfigMetrics()
+	if err != nil {
+		return 0, err
+	}
+
+	roundDuration, okDuration := getNetworkMetric(response.Data, networkConfigKey, metricRoundDuration)
+	startTime, okStartTime := getNetworkMetric(response.Data, networkConfigKey, metricStartTime)
+	roundDurationMs := getUint(roundDuration)
+	if !okDuration || !okStartTime || roundDurationMs == 0 {
+		return 0, fmt.Errorf("%w, missing %s or %s network config metric", ErrCannotParseNodeStatusMetrics, metricRoundDuration, metricStartTime)
+	}
+
+	startTimestamp := int64(getUint(startTime))
+	if timestamp < startTimestamp {
+		return 0, fmt.Errorf("%w, timestamp %d is before the genesis time %d", ErrInvalidBlockTimestamp, timestamp, startTimestamp)
+	}
+
+	return uint64(timestamp-startTimestamp) * 1000 / roundDurationMs, nil
+}
+
+func (bp *BlockProcessor) getMetaNonceAndRound() (uint64, uint64, error) {
+	response, err := bp.networkProvider.GetNetworkStatusMetrics(core.MetachainShardId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	nonce, okNonce := getNetworkMetric(response.Data, networkStatusKey, MetricNonce)
+	round, okRound := getNetworkMetric(response.Data, networkStatusKey, MetricCurrentRound)
+	if !okNonce || !okRound {
+		return 0, 0, ErrCannotParseNodeStatusMetrics
+	}
+
+	return getUint(nonce), getUint(round), nil
+}
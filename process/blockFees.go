@@ -0,0 +1,106 @@
+package process
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// internalHeaderFees holds the fees fields of an internal block header. The big integers are decoded from JSON numbers
+// without going through float64, which would lose their precision
+type internalHeaderFees struct {
+	AccumulatedFees *big.Int
+	DeveloperFees   *big.Int
+	// Header is set for the shard headers of version 2, which wrap the first version one
+	Header *internalHeaderFees
+}
+
+type internalHeaderFeesApiResponse struct {
+	Data struct {
+		Block internalHeaderFees `json:"block"`
+	} `json:"data"`
+	Error string          `json:"error"`
+	Code  data.ReturnCode `json:"code"`
+}
+
+// GetBlockFeesByNonce returns the fees collected in the block with the provided nonce and the developer rewards
+func (bp *BlockProcessor) GetBlockFeesByNonce(shardID uint32, nonce uint64) (*data.BlockFees, error) {
+	response, err := bp.GetBlockByNonce(context.Background(), shardID, nonce, common.BlockQueryOptions{WithFees: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlockFees(&response.Data.Block), nil
+}
+
+// addFeesIfNeeded completes the fees of the block, if requested
+func (bp *BlockProcessor) addFeesIfNeeded(response *data.BlockApiResponse, options common.BlockQueryOptions) error {
+	if !options.WithFees {
+		return nil
+	}
+
+	observers, err := bp.getObserversOrFullHistoryNodes(response.Data.Block.Shard)
+	if err != nil {
+		return err
+	}
+
+	return addBlockFees(bp.proc, observers, &response.Data.Block)
+}
+
+// addBlockFees completes the fees of the provided block out of its internal header, fetched from the provided nodes.
+// The metachain blocks returned by the observers already hold them, unlike the shard ones
+func addBlockFees(proc Processor, observers []*data.NodeData, block *api.Block) error {
+	if len(block.AccumulatedFees) > 0 {
+		return nil
+	}
+
+	path, err := getInternalBlockByHashPath(block.Shard, common.Internal, block.Hash)
+	if err != nil {
+		return err
+	}
+
+	response := internalHeaderFeesApiResponse{}
+	for _, observer := range observers {
+		_, err = proc.CallGetRestEndPoint(observer.Address, path, &response)
+		if err != nil {
+			log.Error("internal block fees request", "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		header := &response.Data.Block
+		if header.Header != nil {
+			header = header.Header
+		}
+		block.AccumulatedFees = bigIntToString(header.AccumulatedFees)
+		block.DeveloperFees = bigIntToString(header.DeveloperFees)
+
+		return nil
+	}
+
+	return WrapObserversError(response.Error)
+}
+
+func newBlockFees(block *api.Block) *data.BlockFees {
+	return &data.BlockFees{
+		Shard:                  block.Shard,
+		Nonce:                  block.Nonce,
+		Round:                  block.Round,
+		Epoch:                  block.Epoch,
+		Hash:                   block.Hash,
+		AccumulatedFees:        block.AccumulatedFees,
+		DeveloperFees:          block.DeveloperFees,
+		AccumulatedFeesInEpoch: block.AccumulatedFeesInEpoch,
+		DeveloperFeesInEpoch:   block.DeveloperFeesInEpoch,
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,160 @@
+package process_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockProcessor_GetBlockFeesByNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shard block should read the fees from the internal header", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				switch path {
+				case "/block/by-nonce/37":
+					valResp := value.(*data.BlockApiResponse)
+					valResp.Data.Block = api.Block{Nonce: 37, Round: 40, Epoch: 2, Shard: 1, Hash: "aabb"}
+					return 200, nil
+				case "/internal/json/shardblock/by-hash/aabb":
+					internalResponse := `{"data":{"block":{"Nonce":37,"AccumulatedFees":123456789012345678901234,"DeveloperFees":37037036703703703670370}},"code":"successful"}`
+					return 200, json.Unmarshal([]byte(internalResponse), value)
+				default:
+					require.Fail(t, "unexpected path "+path)
+					return 0, nil
+				}
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+		fees, err := bp.GetBlockFeesByNonce(1, 37)
+		require.NoError(t, err)
+		require.Equal(t, &data.BlockFees{
+			Shard:           1,
+			Nonce:           37,
+			Round:           40,
+			Epoch:           2,
+			Hash:            "aabb",
+			AccumulatedFees: "123456789012345678901234",
+			DeveloperFees:   "37037036703703703670370",
+		}, fees)
+	})
+
+	t.Run("shard block with header of version 2 should read the fees from the wrapped header", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				valResp, ok := value.(*data.BlockApiResponse)
+				if ok {
+					valResp.Data.Block = api.Block{Nonce: 37, Shard: 0, Hash: "aabb"}
+					return 200, nil
+				}
+
+				internalResponse := `{"data":{"block":{"Header":{"AccumulatedFees":1000,"DeveloperFees":300},"ScheduledAccumulatedFees":5}},"code":"successful"}`
+				return 200, json.Unmarshal([]byte(internalResponse), value)
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+		fees, err := bp.GetBlockFeesByNonce(0, 37)
+		require.NoError(t, err)
+		require.Equal(t, "1000", fees.AccumulatedFees)
+		require.Equal(t, "300", fees.DeveloperFees)
+	})
+
+	t.Run("metachain block should keep the fees returned by the observers", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				require.Equal(t, "/block/by-nonce/37", path)
+				valResp := value.(*data.BlockApiResponse)
+				valResp.Data.Block = api.Block{
+					Nonce:                  37,
+					Shard:                  core.MetachainShardId,
+					AccumulatedFees:        "100",
+					DeveloperFees:          "30",
+					AccumulatedFeesInEpoch: "1000",
+					DeveloperFeesInEpoch:   "300",
+				}
+				return 200, nil
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+		fees, err := bp.GetBlockFeesByNonce(core.MetachainShardId, 37)
+		require.NoError(t, err)
+		require.Equal(t, "100", fees.AccumulatedFees)
+		require.Equal(t, "30", fees.DeveloperFees)
+		require.Equal(t, "1000", fees.AccumulatedFeesInEpoch)
+		require.Equal(t, "300", fees.DeveloperFeesInEpoch)
+	})
+
+	t.Run("internal header request failing should error", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				valResp, ok := value.(*data.BlockApiResponse)
+				if ok {
+					valResp.Data.Block = api.Block{Nonce: 37, Hash: "aabb"}
+					return 200, nil
+				}
+
+				return 500, errors.New("internal error")
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+		fees, err := bp.GetBlockFeesByNonce(0, 37)
+		require.Nil(t, fees)
+		require.Error(t, err)
+	})
+
+	t.Run("blocks without the fees option should not request the internal header", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				require.Equal(t, "/block/by-nonce/37", path)
+				valResp := value.(*data.BlockApiResponse)
+				valResp.Data.Block = api.Block{Nonce: 37, Hash: "aabb"}
+				return 200, nil
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+		res, err := bp.GetBlockByNonce(context.Background(), 0, 37, common.BlockQueryOptions{})
+		require.NoError(t, err)
+		require.Empty(t, res.Data.Block.AccumulatedFees)
+	})
+}
@@ -1,6 +1,8 @@
 package process
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -8,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -37,23 +40,43 @@ const (
 
 // BlockProcessor handles blocks retrieving
 type BlockProcessor struct {
-	proc Processor
+	proc            Processor
+	networkProvider BlocksNetworkProvider
+	hyperblockCache *hyperblockCache
 }
 
-// NewBlockProcessor will create a new block processor
-func NewBlockProcessor(proc Processor) (*BlockProcessor, error) {
+// NewBlockProcessor will create a new block processor. If enabled, the assembled hyperblocks are cached
+func NewBlockProcessor(proc Processor, hyperblockCacheConfig config.HyperblockCacheConfig) (*BlockProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 
-	return &BlockProcessor{
+	bp := &BlockProcessor{
 		proc: proc,
-	}, nil
+	}
+	if hyperblockCacheConfig.Enabled {
+		var err error
+		bp.hyperblockCache, err = newHyperblockCache(hyperblockCacheConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bp, nil
+}
+
+// GetHyperblockCacheMetrics returns the hits, the misses and the evictions of the hyperblocks cache, if enabled
+func (bp *BlockProcessor) GetHyperblockCacheMetrics() data.CacheMetrics {
+	if bp.hyperblockCache == nil {
+		return data.CacheMetrics{}
+	}
+
+	return bp.hyperblockCache.metrics.GetCacheMetrics()
 }
 
 // GetBlockByHash will return the block based on its hash
-func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+func (bp *BlockProcessor) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	observers, err := bp.getNodesForBlockRequest(shardID, options)
 	if err != nil {
 		return nil, err
 	}
@@ -61,25 +84,90 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%s", blockByHashPath, hash), options)
 
 	response := data.BlockApiResponse{}
-	for _, observer := range observers {
+	observer, _, err := bp.proc.CallGetRestEndPointHedged(ctx, observers, path, &response)
+	if err != nil {
+		log.Error("block request", "shard id", shardID, "hash", hash, "error", err.Error())
+		return nil, WrapObserversError(response.Error)
+	}
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
-		if err != nil {
-			log.Error("block request", "observer", observer.Address, "error", err.Error())
+	log.Info("block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
+	bp.addTokenTransfersIfNeeded(&response, options)
+	err = bp.addFeesIfNeeded(&response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetBlockByHashFromAnyShard will return the block based on its hash, without knowing the shard it belongs to. All the
+// shards are probed in parallel and the first block found is returned, along with its shard
+func (bp *BlockProcessor) GetBlockByHashFromAnyShard(hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	shardIDs := bp.proc.GetShardIDs()
+	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%s", blockByHashPath, hash), options)
+
+	type shardBlockResult struct {
+		response *data.BlockApiResponse
+		shardID  uint32
+	}
+
+	chanResults := make(chan *shardBlockResult, len(shardIDs))
+	for _, shardID := range shardIDs {
+		go func(shardID uint32) {
+			chanResults <- &shardBlockResult{
+				response: bp.probeShardForBlock(shardID, path, options),
+				shardID:  shardID,
+			}
+		}(shardID)
+	}
+
+	for range shardIDs {
+		result := <-chanResults
+		if result.response == nil {
 			continue
 		}
 
-		log.Info("block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
-		return &response, nil
+		log.Info("block request", "shard id", result.shardID, "hash", hash)
+		shardID := result.shardID
+		result.response.Data.Shard = &shardID
+		bp.addTokenTransfersIfNeeded(result.response, options)
+		err := bp.addFeesIfNeeded(result.response, options)
+		if err != nil {
+			return nil, err
+		}
 
+		return result.response, nil
 	}
 
-	return nil, WrapObserversError(response.Error)
+	return nil, fmt.Errorf("%w, hash: %s", ErrBlockNotFoundInAnyShard, hash)
+}
+
+// probeShardForBlock returns the response of the first node from the provided shard that knows the block, or nil if
+// none does. Failures are expected for the shards the block does not belong to, so they are not logged as errors
+func (bp *BlockProcessor) probeShardForBlock(shardID uint32, path string, options common.BlockQueryOptions) *data.BlockApiResponse {
+	observers, err := bp.getNodesForBlockRequest(shardID, options)
+	if err != nil {
+		log.Debug("block probe", "shard id", shardID, "error", err.Error())
+		return nil
+	}
+
+	for _, observer := range observers {
+		response := &data.BlockApiResponse{}
+		_, err = bp.proc.CallGetRestEndPoint(observer.Address, path, response)
+		if err != nil {
+			log.Debug("block probe", "shard id", shardID, "observer", observer.Address, "error", err.Error())
+			continue
+		}
+
+		return response
+	}
+
+	return nil
 }
 
 // GetBlockByNonce will return the block based on the nonce
-func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
-	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+func (bp *BlockProcessor) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	observers, err := bp.getNodesForBlockRequest(shardID, options)
 	if err != nil {
 		return nil, err
 	}
@@ -87,20 +175,88 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%d", blockByNoncePath, nonce), options)
 
 	response := data.BlockApiResponse{}
-	for _, observer := range observers {
+	observer, _, err := bp.proc.CallGetRestEndPointHedged(ctx, observers, path, &response)
+	if err != nil {
+		log.Error("block request", "shard id", shardID, "nonce", nonce, "error", err.Error())
+		return nil, WrapObserversError(response.Error)
+	}
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
-		if err != nil {
-			log.Error("block request", "observer", observer.Address, "error", err.Error())
-			continue
+	log.Info("block request", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
+	bp.addTokenTransfersIfNeeded(&response, options)
+	err = bp.addFeesIfNeeded(&response, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetBlocksByNonceRange will return the blocks of a shard with the nonces in the [startNonce, endNonce] interval.
+// The blocks are fetched by a bounded number of workers and the call fails if any of them cannot be fetched
+func (bp *BlockProcessor) GetBlocksByNonceRange(shardID uint32, startNonce uint64, endNonce uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	err := checkBlocksRange(startNonce, endNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	numBlocks := endNonce - startNonce + 1
+	responses := make([]*data.BlockApiResponse, numBlocks)
+	errs := make([]error, numBlocks)
+
+	runWithWorkers(numBlocks, maxConcurrentBlocksRequests, func(idx uint64) {
+		responses[idx], errs[idx] = bp.GetBlockByNonce(context.Background(), shardID, startNonce+idx, options)
+	})
+
+	ret := &data.BlocksApiResponse{
+		Data: data.BlocksApiResponsePayload{
+			Blocks: make([]*api.Block, 0, numBlocks),
+		},
+	}
+	for idx, response := range responses {
+		if errs[idx] != nil {
+			return nil, fmt.Errorf("%w for nonce %d", errs[idx], startNonce+uint64(idx))
 		}
 
-		log.Info("block request", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
-		return &response, nil
+		ret.Data.Blocks = append(ret.Data.Blocks, &response.Data.Block)
+		if options.WithTokenTransfers {
+			ret.Data.TokenTransfers = mergeTokenTransfers(ret.Data.TokenTransfers, response.Data.TokenTransfers)
+		}
+	}
 
+	return ret, nil
+}
+
+// addTokenTransfersIfNeeded decodes the token transfers of the block transactions, if requested
+func (bp *BlockProcessor) addTokenTransfersIfNeeded(response *data.BlockApiResponse, options common.BlockQueryOptions) {
+	if !options.WithTokenTransfers {
+		return
 	}
 
-	return nil, WrapObserversError(response.Error)
+	response.Data.TokenTransfers = decodeBlockTokenTransfers(&response.Data.Block, bp.proc.GetPubKeyConverter())
+}
+
+func mergeTokenTransfers(dst map[string][]*data.TokenTransfer, src map[string][]*data.TokenTransfer) map[string][]*data.TokenTransfer {
+	if dst == nil {
+		dst = make(map[string][]*data.TokenTransfer, len(src))
+	}
+	for txHash, transfers := range src {
+		dst[txHash] = transfers
+	}
+
+	return dst
+}
+
+// getNodesForBlockRequest returns the nodes able to serve a block request with the provided options
+func (bp *BlockProcessor) getNodesForBlockRequest(shardID uint32, options common.BlockQueryOptions) ([]*data.NodeData, error) {
+	nodes, err := bp.getObserversOrFullHistoryNodes(shardID)
+	if err != nil {
+		return nil, err
+	}
+	if options.WithLogs {
+		return filterNodesByCapability(nodes, data.CapabilityBlockWithLogs), nil
+	}
+
+	return nodes, nil
 }
 
 func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*data.NodeData, error) {
@@ -114,6 +270,13 @@ func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*dat
 
 // GetHyperBlockByHash returns the hyperblock by hash
 func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if bp.hyperblockCache != nil {
+		cachedResponse, found := bp.hyperblockCache.getByHash(hash, options)
+		if found {
+			return cachedResponse, nil
+		}
+	}
+
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -122,7 +285,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 		ForHyperblock:    true,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByHash(core.MetachainShardId, hash, blockQueryOptions)
+	metaBlockResponse, err := bp.GetBlockByHash(context.Background(), core.MetachainShardId, hash, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +299,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 	}
 
 	hyperblock := builder.build(options.NotarizedAtSource)
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	return bp.cacheHyperblock(data.NewHyperblockApiResponse(hyperblock), options), nil
 }
 
 func (bp *BlockProcessor) addShardBlocks(
@@ -146,7 +309,7 @@ func (bp *BlockProcessor) addShardBlocks(
 	blockQueryOptions common.BlockQueryOptions,
 ) error {
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+		shardBlockResponse, err := bp.GetBlockByHash(context.Background(), notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
 		if err != nil {
 			return err
 		}
@@ -181,6 +344,13 @@ func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQu
 
 // GetHyperBlockByNonce returns the hyperblock by nonce
 func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if bp.hyperblockCache != nil {
+		cachedResponse, found := bp.hyperblockCache.getByNonce(nonce, options)
+		if found {
+			return cachedResponse, nil
+		}
+	}
+
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -189,7 +359,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 		ForHyperblock:    true,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByNonce(core.MetachainShardId, nonce, blockQueryOptions)
+	metaBlockResponse, err := bp.GetBlockByNonce(context.Background(), core.MetachainShardId, nonce, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +373,15 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 	}
 
 	hyperblock := builder.build(options.NotarizedAtSource)
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	return bp.cacheHyperblock(data.NewHyperblockApiResponse(hyperblock), options), nil
+}
+
+func (bp *BlockProcessor) cacheHyperblock(response *data.HyperblockApiResponse, options common.HyperblockQueryOptions) *data.HyperblockApiResponse {
+	if bp.hyperblockCache != nil {
+		bp.hyperblockCache.put(response, options)
+	}
+
+	return response
 }
 
 // GetInternalBlockByHash will return the internal block based on its hash
@@ -281,6 +459,43 @@ func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64,
 	return nil, WrapObserversError(response.Error)
 }
 
+// GetBlockProtobufByNonce returns the protocol representation of the block with the given nonce, as marshaled by the
+// observers with the protocol marshalizer
+func (bp *BlockProcessor) GetBlockProtobufByNonce(shardID uint32, nonce uint64) ([]byte, error) {
+	response, err := bp.GetInternalBlockByNonce(shardID, nonce, common.Proto)
+	if err != nil {
+		return nil, err
+	}
+
+	return getRawBlockBytes(response)
+}
+
+// GetBlockProtobufByHash returns the protocol representation of the block with the given hash, as marshaled by the
+// observers with the protocol marshalizer
+func (bp *BlockProcessor) GetBlockProtobufByHash(shardID uint32, hash string) ([]byte, error) {
+	response, err := bp.GetInternalBlockByHash(shardID, hash, common.Proto)
+	if err != nil {
+		return nil, err
+	}
+
+	return getRawBlockBytes(response)
+}
+
+// getRawBlockBytes returns the bytes of a raw block, which are received base64 encoded in the JSON response
+func getRawBlockBytes(response *data.InternalBlockApiResponse) ([]byte, error) {
+	encodedBlock, ok := response.Data.Block.(string)
+	if !ok {
+		return nil, ErrInvalidRawBlockResponse
+	}
+
+	blockBytes, err := base64.StdEncoding.DecodeString(encodedBlock)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRawBlockResponse, err.Error())
+	}
+
+	return blockBytes, nil
+}
+
 func getInternalBlockByNoncePath(shardID uint32, format common.OutputFormat, nonce uint64) (string, error) {
 	var path string
 
@@ -300,7 +515,7 @@ func getInternalBlockByNoncePath(shardID uint32, format common.OutputFormat, non
 
 // GetInternalMiniBlockByHash will return the miniblock based on its hash
 func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
-	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+	observers, err := getNodesForEpoch(bp.proc, shardID, epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -345,7 +560,7 @@ func getOutputFormat(format common.OutputFormat) (string, error) {
 
 // GetInternalStartOfEpochMetaBlock will return the internal start of epoch meta block based on epoch
 func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
-	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
+	observers, err := getNodesForEpoch(bp.proc, core.MetachainShardId, epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -376,7 +591,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 
 // GetInternalStartOfEpochValidatorsInfo will return the internal start of epoch validators info based on epoch
 func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
-	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
+	observers, err := getNodesForEpoch(bp.proc, core.MetachainShardId, epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -1,16 +1,22 @@
 package process_test
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
@@ -21,7 +27,7 @@ import (
 func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(nil)
+	bp, err := process.NewBlockProcessor(nil, config.HyperblockCacheConfig{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
 }
@@ -29,7 +35,7 @@ func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 	require.NoError(t, err)
 }
@@ -51,10 +57,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -77,10 +83,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -99,10 +105,10 @@ func TestBlockProcessor_GetBlockByHashNoFullNodesOrObserversShouldErr(t *testing
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -120,10 +126,10 @@ func TestBlockProcessor_GetBlockByHashCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -143,10 +149,10 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -171,10 +177,10 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -183,6 +189,65 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 	require.True(t, isAddressCorrect)
 }
 
+func TestBlockProcessor_GetBlockByHashFromAnyShard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("block not found in any shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, core.MetachainShardId}
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: fmt.Sprintf("addr%d", shardId)}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return 404, errors.New("block not found")
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+		res, err := bp.GetBlockByHashFromAnyShard("hash", common.BlockQueryOptions{})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, process.ErrBlockNotFoundInAnyShard))
+	})
+
+	t.Run("should probe all shards and return the block along with its shard", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := uint64(37)
+		proc := &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, core.MetachainShardId}
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{ShardId: shardId, Address: fmt.Sprintf("addr%d-a", shardId)},
+					{ShardId: shardId, Address: fmt.Sprintf("addr%d-b", shardId)},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				require.Equal(t, "/block/by-hash/hash?withTxs=true", path)
+				if address != "addr1-b" {
+					return 404, errors.New("block not found")
+				}
+
+				valResp := value.(*data.BlockApiResponse)
+				valResp.Data.Block = api.Block{Nonce: nonce, Shard: 1}
+				return 200, nil
+			},
+		}
+
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+		res, err := bp.GetBlockByHashFromAnyShard("hash", common.BlockQueryOptions{WithTransactions: true})
+		require.NoError(t, err)
+		require.Equal(t, nonce, res.Data.Block.Nonce)
+		require.NotNil(t, res.Data.Shard)
+		require.Equal(t, uint32(1), *res.Data.Shard)
+	})
+}
+
 func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
 	t.Parallel()
 
@@ -200,10 +265,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -226,15 +291,47 @@ func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
 }
 
+func TestBlockProcessor_GetBlockByNonceWithLogsShouldSkipObserversWithoutCapability(t *testing.T) {
+	t.Parallel()
+
+	queriedObservers := make([]string, 0)
+	proc := &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return nil, errors.New("local err")
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{
+				{Address: "old", UnsupportedCapabilities: []string{data.CapabilityBlockWithLogs}},
+				{Address: "new"},
+			}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			queriedObservers = append(queriedObservers, address)
+			return 200, nil
+		},
+	}
+
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+
+	_, err := bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{WithLogs: true})
+	require.Nil(t, err)
+	require.Equal(t, []string{"new"}, queriedObservers)
+
+	queriedObservers = queriedObservers[:0]
+	_, err = bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"old"}, queriedObservers)
+}
+
 func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -248,10 +345,10 @@ func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testin
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -269,10 +366,10 @@ func TestBlockProcessor_GetBlockByNonceCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -292,10 +389,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, nonce, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, nonce, common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -320,10 +417,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 3, common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 3, common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -332,6 +429,66 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 	require.True(t, isAddressCorrect)
 }
 
+func TestBlockProcessor_GetBlockByNonceWithTokenTransfers(t *testing.T) {
+	t.Parallel()
+
+	receiverBytes, _ := testPubkeyConverter.Decode(testStakingProvider)
+	receiverHex := hex.EncodeToString(receiverBytes)
+	tokenHex := hex.EncodeToString([]byte("TKN-abcdef"))
+	nftHex := hex.EncodeToString([]byte("NFT-abcdef"))
+	block := api.Block{
+		Nonce: 37,
+		MiniBlocks: []*api.MiniBlock{
+			{
+				Transactions: []*transaction.ApiTransactionResult{
+					{Hash: "esdt", Sender: testDelegatorAddress, Receiver: testStakingProvider, Data: []byte("ESDTTransfer@" + tokenHex + "@0a")},
+					{Hash: "nft", Sender: testDelegatorAddress, Receiver: testDelegatorAddress, Data: []byte("ESDTNFTTransfer@" + nftHex + "@01@01@" + receiverHex)},
+					{Hash: "multi", Sender: testDelegatorAddress, Receiver: testDelegatorAddress, Data: []byte("MultiESDTNFTTransfer@" + receiverHex + "@02@" + tokenHex + "@@64@" + nftHex + "@0f@01@" + hex.EncodeToString([]byte("buy")))},
+					{Hash: "failed", Sender: testDelegatorAddress, Receiver: testStakingProvider, Data: []byte("ESDTTransfer@" + tokenHex + "@0a"), Status: transaction.TxStatusFail},
+					{Hash: "malformed", Sender: testDelegatorAddress, Receiver: testStakingProvider, Data: []byte("ESDTTransfer@" + tokenHex)},
+					{Hash: "move-balance", Sender: testDelegatorAddress, Receiver: testStakingProvider, Value: "1"},
+				},
+			},
+		},
+	}
+	proc := &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			require.False(t, strings.Contains(path, "withTokenTransfers"))
+			valResp := value.(*data.BlockApiResponse)
+			valResp.Data = data.BlockApiResponsePayload{Block: block}
+			return 200, nil
+		},
+		GetPubKeyConverterCalled: func() core.PubkeyConverter {
+			return testPubkeyConverter
+		},
+	}
+
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 37, common.BlockQueryOptions{WithTransactions: true})
+	require.NoError(t, err)
+	require.Nil(t, res.Data.TokenTransfers)
+
+	res, err = bp.GetBlockByNonce(context.Background(), 0, 37, common.BlockQueryOptions{WithTransactions: true, WithTokenTransfers: true})
+	require.NoError(t, err)
+	expectedTokenTransfers := map[string][]*data.TokenTransfer{
+		"esdt": {
+			{Token: "TKN-abcdef", Identifier: "TKN-abcdef", Amount: "10", Sender: testDelegatorAddress, Receiver: testStakingProvider},
+		},
+		"nft": {
+			{Token: "NFT-abcdef", Identifier: "NFT-abcdef-01", Nonce: 1, Amount: "1", Sender: testDelegatorAddress, Receiver: testStakingProvider},
+		},
+		"multi": {
+			{Token: "TKN-abcdef", Identifier: "TKN-abcdef", Amount: "100", Sender: testDelegatorAddress, Receiver: testStakingProvider},
+			{Token: "NFT-abcdef", Identifier: "NFT-abcdef-0f", Nonce: 15, Amount: "1", Sender: testDelegatorAddress, Receiver: testStakingProvider},
+		},
+	}
+	require.Equal(t, expectedTokenTransfers, res.Data.TokenTransfers)
+}
+
 func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 	t.Parallel()
 
@@ -359,7 +516,7 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 		},
 	}
 
-	processor, err := process.NewBlockProcessor(proc)
+	processor, err := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.Nil(t, err)
 	require.NotNil(t, processor)
 
@@ -391,7 +548,7 @@ func TestBlockProcessor_GetInternalBlockByNonceInvalidOutputFormat_ShouldFail(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalBlockByNonce(0, 0, 2)
@@ -416,7 +573,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetFullHistoryNodes(t *test
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByNonce(0, 0, common.Internal)
@@ -442,7 +599,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetObservers(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByNonce(0, 1, common.Internal)
@@ -464,7 +621,7 @@ func TestBlockProcessor_GetInternalBlockByNonceNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, 1, common.Internal)
@@ -485,7 +642,7 @@ func TestBlockProcessor_GetInternalBlockByNonceCallGetFailsShouldErr(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, 0, common.Internal)
@@ -514,7 +671,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, nonce, common.Internal)
@@ -528,6 +685,58 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldWork(t *testing.T) {
 	require.Equal(t, expectedData, res.Data)
 }
 
+func TestBlockProcessor_GetBlockProtobuf(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(block interface{}, calledPath *string) *process.BlockProcessor {
+		bp, _ := process.NewBlockProcessor(&mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(_ string, path string, value interface{}) (int, error) {
+				*calledPath = path
+				valResp := value.(*data.InternalBlockApiResponse)
+				valResp.Data = data.InternalBlockApiResponsePayload{Block: block}
+				return 200, nil
+			},
+		}, config.HyperblockCacheConfig{})
+
+		return bp
+	}
+
+	t.Run("malformed raw block should err", func(t *testing.T) {
+		t.Parallel()
+
+		calledPath := ""
+		bp := createProcessor(map[string]interface{}{"nonce": 1}, &calledPath)
+		blockBytes, err := bp.GetBlockProtobufByNonce(0, 1)
+		require.Nil(t, blockBytes)
+		require.Equal(t, process.ErrInvalidRawBlockResponse, err)
+
+		bp = createProcessor("not base64!", &calledPath)
+		blockBytes, err = bp.GetBlockProtobufByHash(0, "hash")
+		require.Nil(t, blockBytes)
+		require.True(t, errors.Is(err, process.ErrInvalidRawBlockResponse))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rawBlock := []byte("raw block bytes")
+		calledPath := ""
+		bp := createProcessor(base64.StdEncoding.EncodeToString(rawBlock), &calledPath)
+
+		blockBytes, err := bp.GetBlockProtobufByNonce(0, 37)
+		require.NoError(t, err)
+		require.Equal(t, rawBlock, blockBytes)
+		require.Equal(t, "/internal/raw/shardblock/by-nonce/37", calledPath)
+
+		blockBytes, err = bp.GetBlockProtobufByHash(core.MetachainShardId, "hash")
+		require.NoError(t, err)
+		require.Equal(t, rawBlock, blockBytes)
+		require.Equal(t, "/internal/raw/metablock/by-hash/hash", calledPath)
+	})
+}
+
 // GetInternalBlockByHash
 
 func TestBlockProcessor_GetInternalBlockByHashInvalidOutputFormat_ShouldFail(t *testing.T) {
@@ -539,7 +748,7 @@ func TestBlockProcessor_GetInternalBlockByHashInvalidOutputFormat_ShouldFail(t *
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalBlockByHash(0, "aaaa", 2)
@@ -564,7 +773,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetFullHistoryNodes(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -590,7 +799,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -612,7 +821,7 @@ func TestBlockProcessor_GetInternalBlockByHashNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -633,7 +842,7 @@ func TestBlockProcessor_GetInternalBlockByHashCallGetFailsShouldErr(t *testing.T
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -661,7 +870,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -686,7 +895,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashInvalidOutputFormat_ShouldFail
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, 2)
@@ -711,7 +920,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetFullHistoryNodes(t *t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -737,7 +946,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetObservers(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -759,7 +968,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashNoFullNodesOrObserversShouldEr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -780,7 +989,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashCallGetFailsShouldErr(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -808,7 +1017,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -833,7 +1042,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockInvalidOutputFormat_Shou
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalStartOfEpochMetaBlock(0, 2)
@@ -858,7 +1067,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetFullHistoryNode
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -884,7 +1093,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetObservers(t *te
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -893,6 +1102,40 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetObservers(t *te
 	require.True(t, getObserversCalled)
 }
 
+func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldRouteByEpoch(t *testing.T) {
+	t.Parallel()
+
+	fullHistoryNodes := []*data.NodeData{
+		{Address: "old-epochs", MinEpoch: 0, MaxEpoch: 99},
+		{Address: "recent-epochs", MinEpoch: 100},
+	}
+	calledAddresses := make([]string, 0)
+	proc := &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return fullHistoryNodes, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			calledAddresses = append(calledAddresses, address)
+			return 200, nil
+		},
+	}
+
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+	_, err := bp.GetInternalStartOfEpochMetaBlock(10, common.Internal)
+	require.NoError(t, err)
+	_, err = bp.GetInternalStartOfEpochMetaBlock(150, common.Internal)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"old-epochs", "recent-epochs"}, calledAddresses)
+
+	// none of the nodes holds the epoch, so all of them are tried
+	fullHistoryNodes[1].MaxEpoch = 199
+	calledAddresses = calledAddresses[:0]
+	_, err = bp.GetInternalStartOfEpochValidatorsInfo(300)
+	require.NoError(t, err)
+	require.Equal(t, []string{"old-epochs"}, calledAddresses)
+}
+
 func TestBlockProcessor_GetInternalStartOfEpochMetaBlockNoFullNodesOrObserversShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -906,7 +1149,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockNoFullNodesOrObserversSh
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -928,7 +1171,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockCallGetFailsShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -956,7 +1199,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldWork(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(1, common.Internal)
@@ -986,7 +1229,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
@@ -1015,7 +1258,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
@@ -1044,7 +1287,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
@@ -1073,7 +1316,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
@@ -1102,7 +1345,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
@@ -1131,7 +1374,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
@@ -1226,7 +1469,7 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 
 	res, err := bp.GetHyperBlockByNonce(4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
@@ -1343,7 +1586,7 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 
 	res, err := bp.GetHyperBlockByHash("abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
@@ -1400,7 +1643,7 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochValidatorsInfo(1)
@@ -1408,3 +1651,269 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 }
+
+func TestBlockProcessor_GetBlocksByNonceRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("start greater than end should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(&mock.ProcessorStub{}, config.HyperblockCacheConfig{})
+
+		res, err := bp.GetBlocksByNonceRange(0, 10, 9, common.BlockQueryOptions{})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, data.ErrInvalidBlocksRange))
+	})
+	t.Run("range too large should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(&mock.ProcessorStub{}, config.HyperblockCacheConfig{})
+
+		res, err := bp.GetBlocksByNonceRange(0, 0, 100, common.BlockQueryOptions{})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, data.ErrInvalidBlocksRange))
+	})
+	t.Run("one block fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				if strings.HasSuffix(path, "/12") {
+					return 0, errors.New("local error")
+				}
+
+				return 200, nil
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+
+		res, err := bp.GetBlocksByNonceRange(0, 10, 14, common.BlockQueryOptions{})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.Contains(t, err.Error(), "for nonce 12")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				require.True(t, strings.Contains(path, "withTxs=true"))
+
+				nonce := uint64(0)
+				_, _ = fmt.Sscanf(path, "/block/by-nonce/%d", &nonce)
+				valResp := value.(*data.BlockApiResponse)
+				valResp.Data = data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce}}
+				return 200, nil
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+
+		res, err := bp.GetBlocksByNonceRange(0, 10, 14, common.BlockQueryOptions{WithTransactions: true})
+		require.NoError(t, err)
+		require.Len(t, res.Data.Blocks, 5)
+		for idx, block := range res.Data.Blocks {
+			require.Equal(t, uint64(10+idx), block.Nonce)
+		}
+	})
+	t.Run("should fetch the blocks out of a bounded number of workers", func(t *testing.T) {
+		t.Parallel()
+
+		numInFlight := int32(0)
+		maxInFlight := int32(0)
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				inFlight := atomic.AddInt32(&numInFlight, 1)
+				defer atomic.AddInt32(&numInFlight, -1)
+				for {
+					currentMax := atomic.LoadInt32(&maxInFlight)
+					if inFlight <= currentMax || atomic.CompareAndSwapInt32(&maxInFlight, currentMax, inFlight) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+
+				nonce := uint64(0)
+				_, _ = fmt.Sscanf(path, "/block/by-nonce/%d", &nonce)
+				valResp := value.(*data.BlockApiResponse)
+				valResp.Data = data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce}}
+				return 200, nil
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+
+		res, err := bp.GetBlocksByNonceRange(0, 0, 99, common.BlockQueryOptions{})
+		require.NoError(t, err)
+		require.Len(t, res.Data.Blocks, 100)
+		for idx, block := range res.Data.Blocks {
+			require.Equal(t, uint64(idx), block.Nonce)
+		}
+		require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(10))
+	})
+}
+
+func TestBlockProcessor_GetHyperBlockByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	// rounds 3, 6 and 7 were missed
+	metaBlocksRounds := []uint64{0, 1, 2, 4, 5, 8, 9, 10}
+	createBlockProcessor := func(numBlockRequests *int, currentRound uint64) *process.BlockProcessor {
+		proc := &mock.ProcessorStub{
+			GetFullHistoryNodesCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				*numBlockRequests++
+				nonce := uint64(0)
+				_, _ = fmt.Sscanf(path, "/block/by-nonce/%d", &nonce)
+				if nonce >= uint64(len(metaBlocksRounds)) {
+					return 0, errors.New("block not found")
+				}
+
+				valResp := value.(*data.BlockApiResponse)
+				valResp.Data = data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce, Round: metaBlocksRounds[nonce]}}
+				return 200, nil
+			},
+		}
+		bp, _ := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{})
+		_ = bp.SetBlocksNetworkProvider(&mock.ConsensusNetworkProviderStub{
+			GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return &data.GenericAPIResponse{Data: map[string]interface{}{
+					"config": map[string]interface{}{"erd_start_time": float64(1000), "erd_round_duration": float64(6000)},
+				}}, nil
+			},
+			GetNetworkStatusMetricsCalled: func(shardID uint32) (*data.GenericAPIResponse, error) {
+				require.Equal(t, core.MetachainShardId, shardID)
+				return &data.GenericAPIResponse{Data: map[string]interface{}{
+					"status": map[string]interface{}{"erd_nonce": float64(7), "erd_current_round": float64(currentRound)},
+				}}, nil
+			},
+		})
+
+		return bp
+	}
+
+	t.Run("nil network provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(&mock.ProcessorStub{}, config.HyperblockCacheConfig{})
+		require.Equal(t, process.ErrNilBlocksNetworkProvider, bp.SetBlocksNetworkProvider(nil))
+
+		res, err := bp.GetHyperBlockByTimestamp(1000, common.HyperblockQueryOptions{})
+		require.Nil(t, res)
+		require.Equal(t, process.ErrNilBlocksNetworkProvider, err)
+	})
+	t.Run("timestamp out of the chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := 0
+		bp := createBlockProcessor(&numBlockRequests, 11)
+
+		res, err := bp.GetHyperBlockByTimestamp(999, common.HyperblockQueryOptions{})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, process.ErrInvalidBlockTimestamp))
+
+		res, err = bp.GetHyperBlockByTimestamp(1000+12*6, common.HyperblockQueryOptions{})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, process.ErrInvalidBlockTimestamp))
+		require.Zero(t, numBlockRequests)
+	})
+	t.Run("should return the block of the round or the one before it", func(t *testing.T) {
+		t.Parallel()
+
+		expectedNonces := map[int64]uint64{
+			1000:            0,
+			1000 + 2*6 + 5:  2,
+			1000 + 3*6:      2,
+			1000 + 5*6:      4,
+			1000 + 7*6 + 3:  4,
+			1000 + 8*6:      5,
+			1000 + 10*6:     7,
+			1000 + 11*6 + 1: 7,
+		}
+		for timestamp, expectedNonce := range expectedNonces {
+			numBlockRequests := 0
+			bp := createBlockProcessor(&numBlockRequests, 11)
+
+			res, err := bp.GetHyperBlockByTimestamp(timestamp, common.HyperblockQueryOptions{})
+			require.NoError(t, err)
+			require.Equal(t, expectedNonce, res.Data.Hyperblock.Nonce, "timestamp %d", timestamp)
+		}
+	})
+	t.Run("no round missed since the timestamp should verify the estimated block only", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := 0
+		bp := createBlockProcessor(&numBlockRequests, 10)
+
+		res, err := bp.GetHyperBlockByTimestamp(1000+10*6, common.HyperblockQueryOptions{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), res.Data.Hyperblock.Nonce)
+		// the verification query, followed by the hyperblock query
+		require.Equal(t, 2, numBlockRequests)
+	})
+}
+
+func TestBlockProcessor_GetHyperBlockShouldUseTheCache(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			numCalls++
+			ret := value.(*data.BlockApiResponse)
+			ret.Code = data.ReturnCodeSuccess
+			if strings.Contains(path, "by-nonce/4") {
+				ret.Data.Block = api.Block{
+					Nonce:           4,
+					Hash:            "metaHash",
+					Shard:           core.MetachainShardId,
+					NotarizedBlocks: []*api.NotarizedBlock{{Shard: 1, Hash: "hash1"}},
+				}
+				return 200, nil
+			}
+
+			ret.Data.Block = api.Block{Hash: "hash1", Shard: 1}
+			return 200, nil
+		},
+	}
+
+	bp, err := process.NewBlockProcessor(proc, config.HyperblockCacheConfig{Enabled: true, MaxEntries: 10})
+	require.NoError(t, err)
+
+	options := common.HyperblockQueryOptions{}
+	response, err := bp.GetHyperBlockByNonce(4, options)
+	require.NoError(t, err)
+	require.Equal(t, 2, numCalls)
+
+	cachedResponse, err := bp.GetHyperBlockByNonce(4, options)
+	require.NoError(t, err)
+	require.Equal(t, response, cachedResponse)
+	cachedResponse, err = bp.GetHyperBlockByHash("metaHash", options)
+	require.NoError(t, err)
+	require.Equal(t, response, cachedResponse)
+	require.Equal(t, 2, numCalls)
+
+	metrics := bp.GetHyperblockCacheMetrics()
+	require.Equal(t, uint64(2), metrics.NumHits)
+	require.Equal(t, uint64(1), metrics.NumMisses)
+}
+
+func TestNewBlockProcessor_InvalidHyperblockCacheConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, config.HyperblockCacheConfig{Enabled: true})
+	require.Nil(t, bp)
+	require.True(t, errors.Is(err, process.ErrInvalidHyperblockCacheConfig))
+}
@@ -2,6 +2,7 @@ package process
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -11,6 +12,11 @@ import (
 
 const (
 	blockByRoundPath = "/block/by-round"
+
+	// maxBlocksInRange is the maximum number of rounds or nonces that can be requested in a single range call
+	maxBlocksInRange = 100
+	// maxConcurrentBlocksRequests is the number of workers fetching the blocks of a range call
+	maxConcurrentBlocksRequests = 10
 )
 
 // BlocksProcessor handles blocks retrieving from all shards
@@ -55,9 +61,20 @@ func (bp *BlocksProcessor) GetBlocksByRound(round uint64, options common.BlockQu
 				log.Error("block request failed", "shard id", observer.ShardId, "observer", observer.Address, "error", err.Error())
 				continue
 			}
+			if options.WithFees {
+				err = addBlockFees(bp.proc, observers, block)
+				if err != nil {
+					log.Error("block fees request failed", "shard id", observer.ShardId, "round", round, "error", err.Error())
+					continue
+				}
+			}
 
 			log.Info("block requested successfully", "shard id", observer.ShardId, "observer", observer.Address, "round", round)
 			ret.Data.Blocks = append(ret.Data.Blocks, block)
+			if options.WithTokenTransfers {
+				blockTokenTransfers := decodeBlockTokenTransfers(block, bp.proc.GetPubKeyConverter())
+				ret.Data.TokenTransfers = mergeTokenTransfers(ret.Data.TokenTransfers, blockTokenTransfers)
+			}
 			break
 		}
 	}
@@ -75,3 +92,77 @@ func (bp *BlocksProcessor) getBlockFromObserver(observer *data.NodeData, path st
 
 	return &response.Data.Block, nil
 }
+
+// GetBlocksByRoundRange returns all blocks (from all shards) for each round in the [startRound, endRound] interval.
+// The rounds are fetched by a bounded number of workers and the blocks are returned in ascending order of their round
+func (bp *BlocksProcessor) GetBlocksByRoundRange(startRound uint64, endRound uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+	err := checkBlocksRange(startRound, endRound)
+	if err != nil {
+		return nil, err
+	}
+
+	numRounds := endRound - startRound + 1
+	responses := make([]*data.BlocksApiResponse, numRounds)
+	errs := make([]error, numRounds)
+
+	runWithWorkers(numRounds, maxConcurrentBlocksRequests, func(idx uint64) {
+		responses[idx], errs[idx] = bp.GetBlocksByRound(startRound+idx, options)
+	})
+
+	ret := &data.BlocksApiResponse{
+		Data: data.BlocksApiResponsePayload{
+			Blocks: make([]*api.Block, 0, numRounds),
+		},
+	}
+	for idx, response := range responses {
+		if errs[idx] != nil {
+			return nil, errs[idx]
+		}
+
+		ret.Data.Blocks = append(ret.Data.Blocks, response.Data.Blocks...)
+		if options.WithTokenTransfers {
+			ret.Data.TokenTransfers = mergeTokenTransfers(ret.Data.TokenTransfers, response.Data.TokenTransfers)
+		}
+	}
+
+	return ret, nil
+}
+
+func checkBlocksRange(start uint64, end uint64) error {
+	if start > end {
+		return fmt.Errorf("%w: start %d is greater than end %d", data.ErrInvalidBlocksRange, start, end)
+	}
+	if end-start >= maxBlocksInRange {
+		return fmt.Errorf("%w: at most %d blocks can be requested at once", data.ErrInvalidBlocksRange, maxBlocksInRange)
+	}
+
+	return nil
+}
+
+// runWithWorkers calls the handler for each index in the [0, numItems) interval, out of at most maxWorkers goroutines,
+// and returns after all the calls completed
+func runWithWorkers(numItems uint64, maxWorkers int, handler func(idx uint64)) {
+	indexes := make(chan uint64, numItems)
+	for idx := uint64(0); idx < numItems; idx++ {
+		indexes <- idx
+	}
+	close(indexes)
+
+	numWorkers := maxWorkers
+	if numItems < uint64(numWorkers) {
+		numWorkers = int(numItems)
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for idx := range indexes {
+				handler(idx)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -2,6 +2,7 @@ package process_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -155,3 +156,66 @@ func TestBlocksProcessor_GetBlocksByRound_TwoBlocks_ThreeObservers_OneObserverGe
 	require.Nil(t, err)
 	require.Equal(t, expectedApiResp, ret)
 }
+
+func TestBlocksProcessor_GetBlocksByRoundRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlocksProcessor(&mock.ProcessorStub{})
+
+		ret, err := bp.GetBlocksByRoundRange(5, 4, common.BlockQueryOptions{})
+		require.Nil(t, ret)
+		require.True(t, errors.Is(err, data.ErrInvalidBlocksRange))
+
+		ret, err = bp.GetBlocksByRoundRange(0, 200, common.BlockQueryOptions{})
+		require.Nil(t, ret)
+		require.True(t, errors.Is(err, data.ErrInvalidBlocksRange))
+	})
+	t.Run("get observers fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("err observers")
+		proc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, expectedErr
+			},
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+		}
+		bp, _ := process.NewBlocksProcessor(proc)
+
+		ret, err := bp.GetBlocksByRoundRange(4, 6, common.BlockQueryOptions{})
+		require.Nil(t, ret)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+			},
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				round := uint64(0)
+				_, _ = fmt.Sscanf(path, "/block/by-round/%d", &round)
+				valResp := value.(*data.BlockApiResponse)
+				valResp.Data = data.BlockApiResponsePayload{Block: api.Block{Round: round}}
+				return 200, nil
+			},
+		}
+		bp, _ := process.NewBlocksProcessor(proc)
+
+		ret, err := bp.GetBlocksByRoundRange(4, 6, common.BlockQueryOptions{})
+		require.NoError(t, err)
+		require.Len(t, ret.Data.Blocks, 6)
+		for idx, block := range ret.Data.Blocks {
+			require.Equal(t, uint64(4+idx/2), block.Round)
+		}
+	})
+}
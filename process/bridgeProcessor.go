@@ -0,0 +1,252 @@
+package process
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	bridgeLastBatchIDFunc = "getLastBatchId"
+	bridgeBatchFunc       = "getBatch"
+	bridgeBatchStatusFunc = "getBatchStatus"
+
+	// bridgeDepositNumFields is the number of return data items of each deposit of a batch: the block nonce, the
+	// deposit nonce, the sender, the recipient, the token and the amount
+	bridgeDepositNumFields = 6
+
+	// bridgeBatchStatusProcessed is the discriminant of the AlreadyProcessed variant of the batch status enum
+	bridgeBatchStatusProcessed = 0
+
+	evmAddressPrefix = "0x"
+)
+
+type bridgeSafeContract struct {
+	chain   string
+	address string
+}
+
+type bridgeBatchRef struct {
+	contract *bridgeSafeContract
+	batchID  uint64
+}
+
+type bridgeProcessor struct {
+	scQueryProc           SCQueryService
+	pubKeyConverter       core.PubkeyConverter
+	maxBatchesPerContract uint64
+	safeContracts         []*bridgeSafeContract
+}
+
+// NewBridgeProcessor will create a new instance of the bridge processor
+func NewBridgeProcessor(scQueryProc SCQueryService, pubKeyConverter core.PubkeyConverter, cfg config.BridgeConfig) (*bridgeProcessor, error) {
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	safeContracts, err := createBridgeSafeContracts(cfg, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bridgeProcessor{
+		scQueryProc:           scQueryProc,
+		pubKeyConverter:       pubKeyConverter,
+		maxBatchesPerContract: uint64(cfg.MaxBatchesPerContract),
+		safeContracts:         safeContracts,
+	}, nil
+}
+
+func createBridgeSafeContracts(cfg config.BridgeConfig, pubKeyConverter core.PubkeyConverter) ([]*bridgeSafeContract, error) {
+	if len(cfg.SafeContracts) == 0 {
+		return nil, nil
+	}
+	if cfg.MaxBatchesPerContract < 1 {
+		return nil, fmt.Errorf("%w, MaxBatchesPerContract: %d", ErrInvalidBridgeConfig, cfg.MaxBatchesPerContract)
+	}
+
+	safeContracts := make([]*bridgeSafeContract, 0, len(cfg.SafeContracts))
+	for _, safeContract := range cfg.SafeContracts {
+		if len(safeContract.Chain) == 0 {
+			return nil, fmt.Errorf("%w, empty chain for safe contract %s", ErrInvalidBridgeConfig, safeContract.Address)
+		}
+
+		_, err := pubKeyConverter.Decode(safeContract.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w, invalid address of the %s safe contract: %s", ErrInvalidBridgeConfig, safeContract.Chain, err.Error())
+		}
+
+		safeContracts = append(safeContracts, &bridgeSafeContract{
+			chain:   safeContract.Chain,
+			address: safeContract.Address,
+		})
+	}
+
+	return safeContracts, nil
+}
+
+// GetBridgeDeposits returns the deposits of the provided address found in the most recent batches of the configured
+// safe contracts. The deposits of the batches already processed by the relayers are reported as executed, while the
+// ones of the batches still being filled or waiting for the relayers signatures are reported as pending
+func (bp *bridgeProcessor) GetBridgeDeposits(address string) (*data.GenericAPIResponse, error) {
+	if len(bp.safeContracts) == 0 {
+		return nil, ErrBridgeNotConfigured
+	}
+
+	addressBytes, err := bp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+
+	batchRefs, err := bp.getRecentBatches()
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]*data.SCQuery, 0, 2*len(batchRefs))
+	for _, batchRef := range batchRefs {
+		batchIDBytes := big.NewInt(0).SetUint64(batchRef.batchID).Bytes()
+		queries = append(queries,
+			&data.SCQuery{
+				ScAddress: batchRef.contract.address,
+				FuncName:  bridgeBatchFunc,
+				Arguments: [][]byte{batchIDBytes},
+			},
+			&data.SCQuery{
+				ScAddress: batchRef.contract.address,
+				FuncName:  bridgeBatchStatusFunc,
+				Arguments: [][]byte{batchIDBytes},
+			},
+		)
+	}
+
+	outputs, err := executeSCQueriesInParallel(bp.scQueryProc, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := &data.BridgeDeposits{
+		Address:  address,
+		Deposits: make([]*data.BridgeDeposit, 0),
+	}
+	for i, batchRef := range batchRefs {
+		status := getBridgeBatchStatus(outputs[2*i+1])
+		batchDeposits := bp.parseBatchDeposits(batchRef, outputs[2*i], addressBytes, status)
+		deposits.Deposits = append(deposits.Deposits, batchDeposits...)
+	}
+
+	return &data.GenericAPIResponse{
+		Data:  data.BridgeDepositsResponseData{Deposits: deposits},
+		Error: "",
+		Code:  data.ReturnCodeSuccess,
+	}, nil
+}
+
+// getRecentBatches returns the most recent maxBatchesPerContract batches of each safe contract, the oldest first
+func (bp *bridgeProcessor) getRecentBatches() ([]*bridgeBatchRef, error) {
+	queries := make([]*data.SCQuery, 0, len(bp.safeContracts))
+	for _, safeContract := range bp.safeContracts {
+		queries = append(queries, &data.SCQuery{
+			ScAddress: safeContract.address,
+			FuncName:  bridgeLastBatchIDFunc,
+		})
+	}
+
+	outputs, err := executeSCQueriesInParallel(bp.scQueryProc, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	batchRefs := make([]*bridgeBatchRef, 0)
+	for i, safeContract := range bp.safeContracts {
+		if !isSuccessfulVMOutput(outputs[i]) {
+			return nil, fmt.Errorf("%w: %s on the %s safe contract", ErrSendingRequest, outputs[i].ReturnMessage, safeContract.chain)
+		}
+
+		lastBatchID := getFirstReturnDataAsBigInt(outputs[i]).Uint64()
+		firstBatchID := uint64(1)
+		if lastBatchID > bp.maxBatchesPerContract {
+			firstBatchID = lastBatchID - bp.maxBatchesPerContract + 1
+		}
+		for batchID := firstBatchID; batchID <= lastBatchID; batchID++ {
+			batchRefs = append(batchRefs, &bridgeBatchRef{
+				contract: safeContract,
+				batchID:  batchID,
+			})
+		}
+	}
+
+	return batchRefs, nil
+}
+
+// parseBatchDeposits decodes the deposits of the provided sender from the output of the getBatch function, which returns
+// the batch ID followed by the fields of each of its deposits. The batches no longer stored by the contract return nothing
+func (bp *bridgeProcessor) parseBatchDeposits(
+	batchRef *bridgeBatchRef,
+	output *vm.VMOutputApi,
+	senderBytes []byte,
+	status string,
+) []*data.BridgeDeposit {
+	if !isSuccessfulVMOutput(output) || len(output.ReturnData) == 0 {
+		return nil
+	}
+
+	deposits := make([]*data.BridgeDeposit, 0)
+	returnData := output.ReturnData[1:]
+	for i := 0; i+bridgeDepositNumFields <= len(returnData); i += bridgeDepositNumFields {
+		if !bytes.Equal(returnData[i+2], senderBytes) {
+			continue
+		}
+
+		sender, err := bp.pubKeyConverter.Encode(returnData[i+2])
+		if err != nil {
+			log.Debug("bridge deposits: invalid sender", "chain", batchRef.contract.chain, "batch", batchRef.batchID, "error", err)
+			continue
+		}
+
+		deposits = append(deposits, &data.BridgeDeposit{
+			Chain:        batchRef.contract.chain,
+			SafeContract: batchRef.contract.address,
+			BatchID:      batchRef.batchID,
+			BlockNonce:   big.NewInt(0).SetBytes(returnData[i]).Uint64(),
+			DepositNonce: big.NewInt(0).SetBytes(returnData[i+1]).Uint64(),
+			Sender:       sender,
+			Recipient:    evmAddressPrefix + hex.EncodeToString(returnData[i+3]),
+			Token:        string(returnData[i+4]),
+			Amount:       big.NewInt(0).SetBytes(returnData[i+5]).String(),
+			Status:       status,
+		})
+	}
+
+	return deposits
+}
+
+// getBridgeBatchStatus decodes the output of the getBatchStatus function. Its first return data item starts with the
+// discriminant of the batch status enum, the top encoding of the AlreadyProcessed variant being empty
+func getBridgeBatchStatus(output *vm.VMOutputApi) string {
+	if !isSuccessfulVMOutput(output) || len(output.ReturnData) == 0 {
+		return data.BridgeDepositStatusPending
+	}
+
+	encodedStatus := output.ReturnData[0]
+	if len(encodedStatus) == 0 || encodedStatus[0] == bridgeBatchStatusProcessed {
+		return data.BridgeDepositStatusExecuted
+	}
+
+	return data.BridgeDepositStatusPending
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bp *bridgeProcessor) IsInterfaceNil() bool {
+	return bp == nil
+}
@@ -0,0 +1,221 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createBridgeConfig() config.BridgeConfig {
+	return config.BridgeConfig{
+		MaxBatchesPerContract: 2,
+		SafeContracts: []config.BridgeSafeContractConfig{
+			{Chain: "Ethereum", Address: testLegacyDelegation},
+		},
+	}
+}
+
+func createBridgeDepositFields(blockNonce int64, depositNonce int64, sender []byte, token string, amount int64) [][]byte {
+	return [][]byte{
+		big.NewInt(blockNonce).Bytes(),
+		big.NewInt(depositNonce).Bytes(),
+		sender,
+		{0xab, 0xcd},
+		[]byte(token),
+		big.NewInt(amount).Bytes(),
+	}
+}
+
+func TestNewBridgeProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, err := process.NewBridgeProcessor(nil, testPubkeyConverter, createBridgeConfig())
+		require.True(t, check.IfNil(bp))
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, err := process.NewBridgeProcessor(&mock.SCQueryServiceStub{}, nil, createBridgeConfig())
+		require.True(t, check.IfNil(bp))
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidConfig := func(modifier func(cfg *config.BridgeConfig), expectedMessage string) {
+			cfg := createBridgeConfig()
+			modifier(&cfg)
+
+			bp, err := process.NewBridgeProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, cfg)
+			require.True(t, check.IfNil(bp))
+			require.True(t, errors.Is(err, process.ErrInvalidBridgeConfig))
+			require.True(t, strings.Contains(err.Error(), expectedMessage))
+		}
+
+		testInvalidConfig(func(cfg *config.BridgeConfig) { cfg.MaxBatchesPerContract = 0 }, "MaxBatchesPerContract")
+		testInvalidConfig(func(cfg *config.BridgeConfig) { cfg.SafeContracts[0].Chain = "" }, "empty chain")
+		testInvalidConfig(func(cfg *config.BridgeConfig) { cfg.SafeContracts[0].Address = "invalid" }, "invalid address")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bp, err := process.NewBridgeProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, createBridgeConfig())
+		require.False(t, check.IfNil(bp))
+		require.NoError(t, err)
+	})
+}
+
+func TestBridgeProcessor_GetBridgeDeposits(t *testing.T) {
+	t.Parallel()
+
+	depositorBytes, _ := testPubkeyConverter.Decode(testDelegatorAddress)
+	otherSenderBytes, _ := testPubkeyConverter.Decode(testStakingProvider)
+
+	t.Run("no safe contract configured should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBridgeProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, config.BridgeConfig{})
+		response, err := bp.GetBridgeDeposits(testDelegatorAddress)
+		require.Nil(t, response)
+		require.Equal(t, process.ErrBridgeNotConfigured, err)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBridgeProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, createBridgeConfig())
+		response, err := bp.GetBridgeDeposits("invalid")
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("last batch id failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{ReturnCode: "user error", ReturnMessage: "expected message"}, data.BlockInfo{}, nil
+			},
+		}
+		bp, _ := process.NewBridgeProcessor(scQueryStub, testPubkeyConverter, createBridgeConfig())
+		response, err := bp.GetBridgeDeposits(testDelegatorAddress)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.Contains(t, err.Error(), "expected message")
+	})
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		bp, _ := process.NewBridgeProcessor(scQueryStub, testPubkeyConverter, createBridgeConfig())
+		response, err := bp.GetBridgeDeposits(testDelegatorAddress)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should return the deposits of the address from the recent batches", func(t *testing.T) {
+		t.Parallel()
+
+		mutQueries := sync.Mutex{}
+		queriedBatches := make([]uint64, 0)
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, testLegacyDelegation, query.ScAddress)
+
+				switch query.FuncName {
+				case "getLastBatchId":
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{big.NewInt(5).Bytes()}}, data.BlockInfo{}, nil
+				case "getBatchStatus":
+					batchID := big.NewInt(0).SetBytes(query.Arguments[0]).Uint64()
+					if batchID == 4 {
+						// AlreadyProcessed
+						return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{{}}}, data.BlockInfo{}, nil
+					}
+
+					// PartiallyFull
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{{2, 0, 0, 0, 10}}}, data.BlockInfo{}, nil
+				case "getBatch":
+					batchID := big.NewInt(0).SetBytes(query.Arguments[0]).Uint64()
+					mutQueries.Lock()
+					queriedBatches = append(queriedBatches, batchID)
+					mutQueries.Unlock()
+
+					returnData := [][]byte{query.Arguments[0]}
+					returnData = append(returnData, createBridgeDepositFields(100, int64(batchID*10), depositorBytes, "WEGLD-bd4d79", 1000)...)
+					returnData = append(returnData, createBridgeDepositFields(101, int64(batchID*10+1), otherSenderBytes, "USDC-c76f1f", 2000)...)
+
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: returnData}, data.BlockInfo{}, nil
+				}
+
+				require.Fail(t, "unexpected function "+query.FuncName)
+				return nil, data.BlockInfo{}, nil
+			},
+		}
+		bp, _ := process.NewBridgeProcessor(scQueryStub, testPubkeyConverter, createBridgeConfig())
+
+		response, err := bp.GetBridgeDeposits(testDelegatorAddress)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uint64{4, 5}, queriedBatches)
+
+		deposits := response.Data.(data.BridgeDepositsResponseData).Deposits
+		require.Equal(t, testDelegatorAddress, deposits.Address)
+		require.Equal(t, []*data.BridgeDeposit{
+			{
+				Chain:        "Ethereum",
+				SafeContract: testLegacyDelegation,
+				BatchID:      4,
+				DepositNonce: 40,
+				BlockNonce:   100,
+				Sender:       testDelegatorAddress,
+				Recipient:    "0xabcd",
+				Token:        "WEGLD-bd4d79",
+				Amount:       "1000",
+				Status:       data.BridgeDepositStatusExecuted,
+			},
+			{
+				Chain:        "Ethereum",
+				SafeContract: testLegacyDelegation,
+				BatchID:      5,
+				DepositNonce: 50,
+				BlockNonce:   100,
+				Sender:       testDelegatorAddress,
+				Recipient:    "0xabcd",
+				Token:        "WEGLD-bd4d79",
+				Amount:       "1000",
+				Status:       data.BridgeDepositStatusPending,
+			},
+		}, deposits.Deposits)
+	})
+	t.Run("no batch yet should return no deposit", func(t *testing.T) {
+		t.Parallel()
+
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, "getLastBatchId", query.FuncName)
+				return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{{}}}, data.BlockInfo{}, nil
+			},
+		}
+		bp, _ := process.NewBridgeProcessor(scQueryStub, testPubkeyConverter, createBridgeConfig())
+
+		response, err := bp.GetBridgeDeposits(testDelegatorAddress)
+		require.NoError(t, err)
+		require.Empty(t, response.Data.(data.BridgeDepositsResponseData).Deposits.Deposits)
+	})
+}
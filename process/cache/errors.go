@@ -19,3 +19,28 @@ var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in ca
 
 // ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
 var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+
+// ErrInvalidRedisConfig signals that an invalid Redis configuration has been provided
+var ErrInvalidRedisConfig = errors.New("invalid Redis config")
+
+// ErrRedisErrorReply signals that the Redis server replied with an error
+var ErrRedisErrorReply = errors.New("redis error reply")
+
+// ErrRedisUnavailable signals that the Redis server could not be reached recently, so it is not dialed again until the
+// back off delay expires
+var ErrRedisUnavailable = errors.New("redis server unavailable")
+
+// ErrUnexpectedRedisReply signals that the Redis server replied with an unexpected or malformed reply
+var ErrUnexpectedRedisReply = errors.New("unexpected redis reply")
+
+// ErrKeyNotFoundInSharedStore signals that the requested key does not exist in the shared store
+var ErrKeyNotFoundInSharedStore = errors.New("key not found in shared store")
+
+// ErrNilSharedStore signals that a nil shared store has been provided
+var ErrNilSharedStore = errors.New("nil shared store")
+
+// ErrInvalidCacheRefreshInterval signals that an invalid cache refresh interval has been provided
+var ErrInvalidCacheRefreshInterval = errors.New("invalid cache refresh interval")
+
+// ErrInvalidCacheBackend signals that an unknown cache backend has been configured
+var ErrInvalidCacheBackend = errors.New("invalid cache backend")
@@ -10,6 +10,7 @@ import (
 type genericApiResponseMemoryCacher struct {
 	storedResponse        *data.GenericAPIResponse
 	mutGenericApiResponse sync.RWMutex
+	metrics               MetricsCounters
 }
 
 // NewGenericApiResponseMemoryCacher will return a new instance of genericApiResponseMemoryCacher
@@ -26,19 +27,34 @@ func (garmc *genericApiResponseMemoryCacher) Load() (*data.GenericAPIResponse, e
 	defer garmc.mutGenericApiResponse.RUnlock()
 
 	if garmc.storedResponse == nil {
+		garmc.metrics.AddMiss()
 		return nil, ErrNilGenericApiResponseInCache
 	}
 
+	garmc.metrics.AddHit()
 	return garmc.storedResponse, nil
 }
 
-// Store will update the generic api response response in cache
+// Store will update the generic api response response in cache. Storing a nil response evicts the stored one
 func (garmc *genericApiResponseMemoryCacher) Store(genericApiResponse *data.GenericAPIResponse) {
 	garmc.mutGenericApiResponse.Lock()
+	if genericApiResponse == nil && garmc.storedResponse != nil {
+		garmc.metrics.AddEvictions(1)
+	}
 	garmc.storedResponse = genericApiResponse
 	garmc.mutGenericApiResponse.Unlock()
 }
 
+// ShouldRefresh returns true, as the cache is only used by this proxy instance
+func (garmc *genericApiResponseMemoryCacher) ShouldRefresh() bool {
+	return true
+}
+
+// GetCacheMetrics returns the hits, the misses and the evictions of the cache
+func (garmc *genericApiResponseMemoryCacher) GetCacheMetrics() data.CacheMetrics {
+	return garmc.metrics.GetCacheMetrics()
+}
+
 // IsInterfaceNil will return true if there is no value under the interface
 func (garmc *genericApiResponseMemoryCacher) IsInterfaceNil() bool {
 	return garmc == nil
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// genericApiResponseRedisCacher will handle caching a generic api response shared by several proxy instances through
+// a Redis server. The response is served from a local copy, synchronized with the shared one
+type genericApiResponseRedisCacher struct {
+	localCacher *genericApiResponseMemoryCacher
+	shared      *sharedValue
+}
+
+// NewGenericApiResponseRedisCacher will return a new instance of genericApiResponseRedisCacher. The response is stored
+// under the provided key and is expected to be refreshed at the provided interval
+func NewGenericApiResponseRedisCacher(store SharedStore, key string, refreshInterval time.Duration) (*genericApiResponseRedisCacher, error) {
+	shared, err := newSharedValue(store, key, refreshInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genericApiResponseRedisCacher{
+		localCacher: NewGenericApiResponseMemoryCacher(),
+		shared:      shared,
+	}, nil
+}
+
+// Load will return the generic api response stored in cache (if found)
+func (garrc *genericApiResponseRedisCacher) Load() (*data.GenericAPIResponse, error) {
+	garrc.syncFromSharedStore()
+
+	return garrc.localCacher.Load()
+}
+
+func (garrc *genericApiResponseRedisCacher) syncFromSharedStore() {
+	buff, isChanged := garrc.shared.fetchIfChanged()
+	if !isChanged {
+		return
+	}
+	if buff == nil {
+		garrc.localCacher.Store(nil)
+		return
+	}
+
+	response := &data.GenericAPIResponse{}
+	err := json.Unmarshal(buff, response)
+	if err != nil {
+		log.Warn("generic api response: unmarshal from shared cache", "key", garrc.shared.key, "error", err.Error())
+		return
+	}
+
+	garrc.localCacher.Store(response)
+}
+
+// Store will update the generic api response in cache. Storing a nil response evicts the stored one
+func (garrc *genericApiResponseRedisCacher) Store(genericApiResponse *data.GenericAPIResponse) {
+	garrc.localCacher.Store(genericApiResponse)
+
+	var err error
+	if genericApiResponse == nil {
+		err = garrc.shared.remove()
+	} else {
+		err = garrc.storeInSharedStore(genericApiResponse)
+	}
+	if err != nil {
+		log.Warn("generic api response: store in shared cache", "key", garrc.shared.key, "error", err.Error())
+	}
+}
+
+func (garrc *genericApiResponseRedisCacher) storeInSharedStore(genericApiResponse *data.GenericAPIResponse) error {
+	buff, err := json.Marshal(genericApiResponse)
+	if err != nil {
+		return err
+	}
+
+	return garrc.shared.store(buff)
+}
+
+// ShouldRefresh returns true if this proxy instance holds the lease for refreshing the response from the observers
+func (garrc *genericApiResponseRedisCacher) ShouldRefresh() bool {
+	return garrc.shared.tryAcquireRefreshLease()
+}
+
+// GetCacheMetrics returns the hits, the misses and the evictions of the local copy of the cache
+func (garrc *genericApiResponseRedisCacher) GetCacheMetrics() data.CacheMetrics {
+	return garrc.localCacher.GetCacheMetrics()
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (garrc *genericApiResponseRedisCacher) IsInterfaceNil() bool {
+	return garrc == nil
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGenericApiResponseRedisCacher(t *testing.T) {
+	t.Parallel()
+
+	garrc, err := NewGenericApiResponseRedisCacher(nil, "economics", time.Second)
+	require.Nil(t, garrc)
+	require.Equal(t, ErrNilSharedStore, err)
+
+	garrc, err = NewGenericApiResponseRedisCacher(createRedisClient(t), "economics", time.Second)
+	require.Nil(t, err)
+	require.False(t, garrc.IsInterfaceNil())
+}
+
+func TestGenericApiResponseRedisCacher_SharedBetweenInstances(t *testing.T) {
+	t.Parallel()
+
+	rc := createRedisClient(t)
+	refresher, _ := NewGenericApiResponseRedisCacher(rc, "economics", time.Second)
+	follower, _ := NewGenericApiResponseRedisCacher(rc, "economics", time.Second)
+	currentTime := time.Unix(1000, 0)
+	setSharedValueTime(refresher.shared, &currentTime)
+	setSharedValueTime(follower.shared, &currentTime)
+
+	require.True(t, refresher.ShouldRefresh())
+	require.False(t, follower.ShouldRefresh())
+
+	response := &data.GenericAPIResponse{
+		Data: map[string]interface{}{"metrics": "supply"},
+		Code: data.ReturnCodeSuccess,
+	}
+	refresher.Store(response)
+
+	loadedResponse, err := follower.Load()
+	require.Nil(t, err)
+	require.Equal(t, response, loadedResponse)
+
+	// storing a nil response evicts the shared one
+	refresher.Store(nil)
+	currentTime = currentTime.Add(time.Second)
+	_, err = follower.Load()
+	require.Equal(t, ErrNilGenericApiResponseInCache, err)
+	require.Equal(t, uint64(1), follower.GetCacheMetrics().NumEvictions)
+}
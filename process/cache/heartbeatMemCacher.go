@@ -1,22 +1,34 @@
 package cache
 
 import (
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
-// HeartbeatMemoryCacher will handle caching the heartbeats response
+// HeartbeatMemoryCacher will handle caching the heartbeats response. Besides the latest heartbeats, it also keeps track
+// of the moment each public key last changed, so the changes since a given moment can be served
 type HeartbeatMemoryCacher struct {
-	storedHeartbeats []data.PubKeyHeartbeat
-	mutHeartbeats    sync.RWMutex
+	storedHeartbeats   []data.PubKeyHeartbeat
+	lastChanges        map[string]int64
+	removedPublicKeys  map[string]int64
+	firstUpdateMillis  int64
+	lastUpdateMillis   int64
+	currentTimeHandler func() time.Time
+	mutHeartbeats      sync.RWMutex
+	metrics            MetricsCounters
 }
 
 // NewHeartbeatMemoryCacher will return a new instance of HeartbeatMemoryCacher
 func NewHeartbeatMemoryCacher() *HeartbeatMemoryCacher {
 	return &HeartbeatMemoryCacher{
-		storedHeartbeats: nil,
-		mutHeartbeats:    sync.RWMutex{},
+		storedHeartbeats:   nil,
+		lastChanges:        make(map[string]int64),
+		removedPublicKeys:  make(map[string]int64),
+		currentTimeHandler: time.Now,
+		mutHeartbeats:      sync.RWMutex{},
 	}
 }
 
@@ -26,12 +38,53 @@ func (hmc *HeartbeatMemoryCacher) LoadHeartbeats() (*data.HeartbeatResponse, err
 	defer hmc.mutHeartbeats.RUnlock()
 
 	if hmc.storedHeartbeats == nil {
+		hmc.metrics.AddMiss()
 		return nil, ErrNilHeartbeatsInCache
 	}
 
+	hmc.metrics.AddHit()
 	return &data.HeartbeatResponse{Heartbeats: hmc.storedHeartbeats}, nil
 }
 
+// LoadHeartbeatChanges will return the heartbeats that changed and the public keys that were removed after the provided
+// moment, expressed in unix milliseconds. If the provided moment is before the first cache update, all the stored
+// heartbeats are returned and the response is marked as a full snapshot
+func (hmc *HeartbeatMemoryCacher) LoadHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+	hmc.mutHeartbeats.RLock()
+	defer hmc.mutHeartbeats.RUnlock()
+
+	if hmc.storedHeartbeats == nil {
+		hmc.metrics.AddMiss()
+		return nil, ErrNilHeartbeatsInCache
+	}
+
+	hmc.metrics.AddHit()
+	response := &data.HeartbeatChangesResponse{
+		Heartbeats:        make([]data.PubKeyHeartbeat, 0),
+		RemovedPublicKeys: make([]string, 0),
+		Timestamp:         hmc.lastUpdateMillis,
+		IsFullSnapshot:    sinceMillis < hmc.firstUpdateMillis,
+	}
+	if response.IsFullSnapshot {
+		response.Heartbeats = append(response.Heartbeats, hmc.storedHeartbeats...)
+		return response, nil
+	}
+
+	for _, heartbeat := range hmc.storedHeartbeats {
+		if hmc.lastChanges[heartbeat.PublicKey] > sinceMillis {
+			response.Heartbeats = append(response.Heartbeats, heartbeat)
+		}
+	}
+	for publicKey, removedMillis := range hmc.removedPublicKeys {
+		if removedMillis > sinceMillis {
+			response.RemovedPublicKeys = append(response.RemovedPublicKeys, publicKey)
+		}
+	}
+	sort.Strings(response.RemovedPublicKeys)
+
+	return response, nil
+}
+
 // StoreHeartbeats will update the stored heartbeats response in cache
 func (hmc *HeartbeatMemoryCacher) StoreHeartbeats(hbts *data.HeartbeatResponse) error {
 	if hbts == nil {
@@ -39,12 +92,67 @@ func (hmc *HeartbeatMemoryCacher) StoreHeartbeats(hbts *data.HeartbeatResponse)
 	}
 
 	hmc.mutHeartbeats.Lock()
+	hmc.trackChanges(hbts.Heartbeats)
 	hmc.storedHeartbeats = hbts.Heartbeats
 	hmc.mutHeartbeats.Unlock()
 
 	return nil
 }
 
+// trackChanges will record the moment of the current update for the new, changed and removed public keys.
+// The update moments are strictly increasing, so they can be safely used as cursors by the pollers
+func (hmc *HeartbeatMemoryCacher) trackChanges(newHeartbeats []data.PubKeyHeartbeat) {
+	updateMillis := hmc.currentTimeHandler().UnixMilli()
+	if updateMillis <= hmc.lastUpdateMillis {
+		updateMillis = hmc.lastUpdateMillis + 1
+	}
+	if hmc.firstUpdateMillis == 0 {
+		hmc.firstUpdateMillis = updateMillis
+	}
+	hmc.lastUpdateMillis = updateMillis
+
+	oldHeartbeats := make(map[string]data.PubKeyHeartbeat, len(hmc.storedHeartbeats))
+	for _, heartbeat := range hmc.storedHeartbeats {
+		oldHeartbeats[heartbeat.PublicKey] = heartbeat
+	}
+
+	for _, heartbeat := range newHeartbeats {
+		oldHeartbeat, found := oldHeartbeats[heartbeat.PublicKey]
+		delete(oldHeartbeats, heartbeat.PublicKey)
+		if found && !isRelevantHeartbeatChange(oldHeartbeat, heartbeat) {
+			continue
+		}
+
+		hmc.lastChanges[heartbeat.PublicKey] = updateMillis
+		delete(hmc.removedPublicKeys, heartbeat.PublicKey)
+	}
+
+	for publicKey := range oldHeartbeats {
+		delete(hmc.lastChanges, publicKey)
+		hmc.removedPublicKeys[publicKey] = updateMillis
+	}
+}
+
+// isRelevantHeartbeatChange returns true if the status of the node changed. The fields that change on every heartbeat
+// message (timestamp, nonce and the number of received trie nodes) are ignored
+func isRelevantHeartbeatChange(oldHeartbeat data.PubKeyHeartbeat, newHeartbeat data.PubKeyHeartbeat) bool {
+	oldHeartbeat.TimeStamp = newHeartbeat.TimeStamp
+	oldHeartbeat.Nonce = newHeartbeat.Nonce
+	oldHeartbeat.NumTrieNodesReceived = newHeartbeat.NumTrieNodesReceived
+
+	return oldHeartbeat != newHeartbeat
+}
+
+// ShouldRefresh returns true, as the cache is only used by this proxy instance
+func (hmc *HeartbeatMemoryCacher) ShouldRefresh() bool {
+	return true
+}
+
+// GetCacheMetrics returns the hits and the misses of the cache. The heartbeats are only replaced, never evicted
+func (hmc *HeartbeatMemoryCacher) GetCacheMetrics() data.CacheMetrics {
+	return hmc.metrics.GetCacheMetrics()
+}
+
 // IsInterfaceNil will return true if there is no value under the interface
 func (hmc *HeartbeatMemoryCacher) IsInterfaceNil() bool {
 	return hmc == nil
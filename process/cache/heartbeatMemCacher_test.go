@@ -118,3 +118,66 @@ func TestHeartbeatMemoryCacher_ConcurrencySafe(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestHeartbeatMemoryCacher_LoadHeartbeatChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		mc := cache.NewHeartbeatMemoryCacher()
+
+		changes, err := mc.LoadHeartbeatChanges(0)
+		assert.Nil(t, changes)
+		assert.Equal(t, cache.ErrNilHeartbeatsInCache, err)
+	})
+
+	t.Run("should track the relevant changes", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.UnixMilli(1000)
+		mc := cache.NewHeartbeatMemoryCacher()
+		mc.SetCurrentTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		hbt1 := data.PubKeyHeartbeat{PublicKey: "pk1", IsActive: true, Nonce: 10, TimeStamp: time.Unix(1, 0)}
+		hbt2 := data.PubKeyHeartbeat{PublicKey: "pk2", IsActive: true, Nonce: 10}
+		hbt3 := data.PubKeyHeartbeat{PublicKey: "pk3", IsActive: true, Nonce: 10}
+		_ = mc.StoreHeartbeats(&data.HeartbeatResponse{Heartbeats: []data.PubKeyHeartbeat{hbt1, hbt2, hbt3}})
+
+		changes, err := mc.LoadHeartbeatChanges(0)
+		assert.Nil(t, err)
+		assert.True(t, changes.IsFullSnapshot)
+		assert.Equal(t, []data.PubKeyHeartbeat{hbt1, hbt2, hbt3}, changes.Heartbeats)
+		assert.Equal(t, int64(1000), changes.Timestamp)
+
+		// pk1 only received a new heartbeat message, pk2 became inactive and pk3 was removed
+		currentTime = time.UnixMilli(2000)
+		hbt1.Nonce, hbt1.TimeStamp = 11, time.Unix(2, 0)
+		hbt2.IsActive = false
+		_ = mc.StoreHeartbeats(&data.HeartbeatResponse{Heartbeats: []data.PubKeyHeartbeat{hbt1, hbt2}})
+
+		changes, err = mc.LoadHeartbeatChanges(1000)
+		assert.Nil(t, err)
+		assert.False(t, changes.IsFullSnapshot)
+		assert.Equal(t, []data.PubKeyHeartbeat{hbt2}, changes.Heartbeats)
+		assert.Equal(t, []string{"pk3"}, changes.RemovedPublicKeys)
+		assert.Equal(t, int64(2000), changes.Timestamp)
+
+		// the clock did not advance, but the timestamp of the update should still increase. pk3 is back
+		hbt3.NodeDisplayName = "node3"
+		_ = mc.StoreHeartbeats(&data.HeartbeatResponse{Heartbeats: []data.PubKeyHeartbeat{hbt1, hbt2, hbt3}})
+
+		changes, err = mc.LoadHeartbeatChanges(2000)
+		assert.Nil(t, err)
+		assert.Equal(t, []data.PubKeyHeartbeat{hbt3}, changes.Heartbeats)
+		assert.Empty(t, changes.RemovedPublicKeys)
+		assert.Equal(t, int64(2001), changes.Timestamp)
+
+		changes, err = mc.LoadHeartbeatChanges(2001)
+		assert.Nil(t, err)
+		assert.Empty(t, changes.Heartbeats)
+		assert.Empty(t, changes.RemovedPublicKeys)
+	})
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const heartbeatsSharedKey = "heartbeats"
+
+// heartbeatRedisCacher will handle caching the heartbeats shared by several proxy instances through a Redis server.
+// The heartbeats are served from a local HeartbeatMemoryCacher, synchronized with the shared ones, which also keeps
+// track of the changes between the synchronized versions
+type heartbeatRedisCacher struct {
+	localCacher *HeartbeatMemoryCacher
+	shared      *sharedValue
+}
+
+// NewHeartbeatRedisCacher will return a new instance of heartbeatRedisCacher. The heartbeats are expected to be
+// refreshed at the provided interval
+func NewHeartbeatRedisCacher(store SharedStore, refreshInterval time.Duration) (*heartbeatRedisCacher, error) {
+	shared, err := newSharedValue(store, heartbeatsSharedKey, refreshInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &heartbeatRedisCacher{
+		localCacher: NewHeartbeatMemoryCacher(),
+		shared:      shared,
+	}, nil
+}
+
+// LoadHeartbeats will return the heartbeats response stored in cache (if found)
+func (hrc *heartbeatRedisCacher) LoadHeartbeats() (*data.HeartbeatResponse, error) {
+	hrc.syncFromSharedStore()
+
+	return hrc.localCacher.LoadHeartbeats()
+}
+
+// LoadHeartbeatChanges will return the heartbeats that changed and the public keys that were removed after the provided
+// moment, expressed in unix milliseconds
+func (hrc *heartbeatRedisCacher) LoadHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+	hrc.syncFromSharedStore()
+
+	return hrc.localCacher.LoadHeartbeatChanges(sinceMillis)
+}
+
+// syncFromSharedStore replaces the local copy with the shared heartbeats, if changed. The heartbeats are never evicted,
+// so the local copy is kept if the shared one expired
+func (hrc *heartbeatRedisCacher) syncFromSharedStore() {
+	buff, isChanged := hrc.shared.fetchIfChanged()
+	if !isChanged || buff == nil {
+		return
+	}
+
+	hbts := &data.HeartbeatResponse{}
+	err := json.Unmarshal(buff, hbts)
+	if err != nil {
+		log.Warn("heartbeat: unmarshal from shared cache", "error", err.Error())
+		return
+	}
+
+	_ = hrc.localCacher.StoreHeartbeats(hbts)
+}
+
+// StoreHeartbeats will update the stored heartbeats response in cache
+func (hrc *heartbeatRedisCacher) StoreHeartbeats(hbts *data.HeartbeatResponse) error {
+	err := hrc.localCacher.StoreHeartbeats(hbts)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.Marshal(hbts)
+	if err != nil {
+		return err
+	}
+
+	return hrc.shared.store(buff)
+}
+
+// ShouldRefresh returns true if this proxy instance holds the lease for refreshing the heartbeats from the observers
+func (hrc *heartbeatRedisCacher) ShouldRefresh() bool {
+	return hrc.shared.tryAcquireRefreshLease()
+}
+
+// GetCacheMetrics returns the hits and the misses of the local copy of the cache
+func (hrc *heartbeatRedisCacher) GetCacheMetrics() data.CacheMetrics {
+	return hrc.localCacher.GetCacheMetrics()
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (hrc *heartbeatRedisCacher) IsInterfaceNil() bool {
+	return hrc == nil
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createRedisClient(t *testing.T) *RedisClient {
+	server := startRedisServerStub(t, "")
+	rc, err := NewRedisClient(createRedisConfig(server.listener.Addr().String()))
+	require.Nil(t, err)
+
+	return rc
+}
+
+func setSharedValueTime(sv *sharedValue, currentTime *time.Time) {
+	sv.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
+}
+
+func TestNewHeartbeatRedisCacher(t *testing.T) {
+	t.Parallel()
+
+	hrc, err := NewHeartbeatRedisCacher(nil, time.Second)
+	require.Nil(t, hrc)
+	require.Equal(t, ErrNilSharedStore, err)
+
+	hrc, err = NewHeartbeatRedisCacher(createRedisClient(t), 0)
+	require.Nil(t, hrc)
+	require.Equal(t, ErrInvalidCacheRefreshInterval, err)
+
+	hrc, err = NewHeartbeatRedisCacher(createRedisClient(t), time.Second)
+	require.Nil(t, err)
+	require.False(t, hrc.IsInterfaceNil())
+}
+
+func TestHeartbeatRedisCacher_SharedBetweenInstances(t *testing.T) {
+	t.Parallel()
+
+	rc := createRedisClient(t)
+	refresher, _ := NewHeartbeatRedisCacher(rc, time.Second)
+	follower, _ := NewHeartbeatRedisCacher(rc, time.Second)
+	currentTime := time.Unix(1000, 0)
+	setSharedValueTime(refresher.shared, &currentTime)
+	setSharedValueTime(follower.shared, &currentTime)
+
+	require.True(t, refresher.ShouldRefresh())
+	require.False(t, follower.ShouldRefresh())
+
+	_, err := follower.LoadHeartbeats()
+	require.Equal(t, ErrNilHeartbeatsInCache, err)
+
+	currentTime = currentTime.Add(time.Second)
+	err = refresher.StoreHeartbeats(&data.HeartbeatResponse{Heartbeats: []data.PubKeyHeartbeat{
+		{PublicKey: "pk1", PeerType: "eligible"},
+		{PublicKey: "pk2", PeerType: "waiting"},
+	}})
+	require.Nil(t, err)
+
+	hbts, err := follower.LoadHeartbeats()
+	require.Nil(t, err)
+	require.Len(t, hbts.Heartbeats, 2)
+
+	err = refresher.StoreHeartbeats(&data.HeartbeatResponse{Heartbeats: []data.PubKeyHeartbeat{
+		{PublicKey: "pk1", PeerType: "eligible"},
+		{PublicKey: "pk2", PeerType: "eligible"},
+	}})
+	require.Nil(t, err)
+
+	// the shared version is only checked once per sync interval
+	hbts, _ = follower.LoadHeartbeats()
+	require.Equal(t, "waiting", hbts.Heartbeats[1].PeerType)
+
+	currentTime = currentTime.Add(time.Second)
+	hbts, _ = follower.LoadHeartbeats()
+	require.Equal(t, "eligible", hbts.Heartbeats[1].PeerType)
+
+	// the changes are tracked between the versions synchronized by the follower
+	changes, err := follower.LoadHeartbeatChanges(follower.localCacher.firstUpdateMillis)
+	require.Nil(t, err)
+	require.False(t, changes.IsFullSnapshot)
+	require.Len(t, changes.Heartbeats, 1)
+	require.Equal(t, "pk2", changes.Heartbeats[0].PublicKey)
+}
@@ -0,0 +1,12 @@
+package cache
+
+import "time"
+
+// SharedStore defines what a key-value store shared by several proxy instances, such as a Redis server, should do
+type SharedStore interface {
+	Get(key string) ([]byte, error)
+	Set(key string, value []byte, ttl time.Duration) error
+	SetIfNotExists(key string, value []byte, ttl time.Duration) (bool, error)
+	Delete(key string) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// MetricsCounters counts the hits, the misses and the evictions of a cache. The zero value is ready to use
+type MetricsCounters struct {
+	numHits      atomic.Uint64
+	numMisses    atomic.Uint64
+	numEvictions atomic.Uint64
+}
+
+// AddHit counts a lookup which found a valid entry
+func (mc *MetricsCounters) AddHit() {
+	mc.numHits.Add(1)
+}
+
+// AddMiss counts a lookup which did not find a valid entry
+func (mc *MetricsCounters) AddMiss() {
+	mc.numMisses.Add(1)
+}
+
+// AddEvictions counts the entries dropped before being replaced, either expired, invalidated or evicted for space
+func (mc *MetricsCounters) AddEvictions(numEvictions uint64) {
+	mc.numEvictions.Add(numEvictions)
+}
+
+// GetCacheMetrics returns the counters, along with the ratio of the lookups which were hits
+func (mc *MetricsCounters) GetCacheMetrics() data.CacheMetrics {
+	metrics := data.CacheMetrics{
+		NumHits:      mc.numHits.Load(),
+		NumMisses:    mc.numMisses.Load(),
+		NumEvictions: mc.numEvictions.Load(),
+	}
+	numLookups := metrics.NumHits + metrics.NumMisses
+	if numLookups > 0 {
+		metrics.HitRatio = float64(metrics.NumHits) / float64(numLookups)
+	}
+
+	return metrics
+}
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsCounters_GetCacheMetrics(t *testing.T) {
+	t.Parallel()
+
+	mc := MetricsCounters{}
+	require.Equal(t, data.CacheMetrics{}, mc.GetCacheMetrics())
+
+	mc.AddHit()
+	mc.AddHit()
+	mc.AddHit()
+	mc.AddMiss()
+	mc.AddEvictions(2)
+	require.Equal(t, data.CacheMetrics{
+		NumHits:      3,
+		NumMisses:    1,
+		NumEvictions: 2,
+		HitRatio:     0.75,
+	}, mc.GetCacheMetrics())
+}
+
+func TestMemoryCachers_ShouldCountHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	garmc := NewGenericApiResponseMemoryCacher()
+	_, _ = garmc.Load()
+	garmc.Store(&data.GenericAPIResponse{})
+	_, _ = garmc.Load()
+	garmc.Store(nil)
+	require.Equal(t, data.CacheMetrics{NumHits: 1, NumMisses: 1, NumEvictions: 1, HitRatio: 0.5}, garmc.GetCacheMetrics())
+
+	hmc := NewHeartbeatMemoryCacher()
+	_, _ = hmc.LoadHeartbeats()
+	_ = hmc.StoreHeartbeats(&data.HeartbeatResponse{Heartbeats: []data.PubKeyHeartbeat{}})
+	_, _ = hmc.LoadHeartbeats()
+	_, _ = hmc.LoadHeartbeatChanges(0)
+	require.Equal(t, uint64(2), hmc.GetCacheMetrics().NumHits)
+	require.Equal(t, uint64(1), hmc.GetCacheMetrics().NumMisses)
+
+	vsmc := NewValidatorsStatsMemoryCacher()
+	_, _ = vsmc.LoadValStats()
+	require.Equal(t, data.CacheMetrics{NumMisses: 1}, vsmc.GetCacheMetrics())
+}
@@ -0,0 +1,395 @@
+package cache
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const (
+	redisOkReply    = "OK"
+	redisNilLength  = -1
+	maxRedisBulkLen = 512 * 1024 * 1024
+
+	minRedisDialBackoff = 100 * time.Millisecond
+	maxRedisDialBackoff = 10 * time.Second
+)
+
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// RedisClient is a minimal client of the Redis serialization protocol (RESP), issuing the few commands needed by the
+// shared caches over a small pool of connections. All the keys are prefixed with the configured key prefix. Once a
+// connection cannot be opened, the server is not dialed again until a back off delay expires, so the lookups done
+// during an outage fail fast instead of waiting for the dial timeout
+type RedisClient struct {
+	address          string
+	password         string
+	database         int
+	keyPrefix        string
+	dialTimeout      time.Duration
+	operationTimeout time.Duration
+	tlsConfig        *tls.Config
+	idleConns        chan *redisConn
+	getTimeHandler   func() time.Time
+
+	mutDial      sync.Mutex
+	dialBackoff  time.Duration
+	nextDialTime time.Time
+}
+
+// NewRedisClient creates a new instance of RedisClient. The connections are opened on demand
+func NewRedisClient(cfg config.RedisConfig) (*RedisClient, error) {
+	if len(cfg.Address) == 0 {
+		return nil, fmt.Errorf("%w, empty Address", ErrInvalidRedisConfig)
+	}
+	if cfg.Database < 0 {
+		return nil, fmt.Errorf("%w, Database should not be negative", ErrInvalidRedisConfig)
+	}
+	if cfg.DialTimeoutInMs <= 0 || cfg.OperationTimeoutInMs <= 0 {
+		return nil, fmt.Errorf("%w, DialTimeoutInMs and OperationTimeoutInMs should be positive", ErrInvalidRedisConfig)
+	}
+	if cfg.MaxIdleConnections <= 0 {
+		return nil, fmt.Errorf("%w, MaxIdleConnections should be positive", ErrInvalidRedisConfig)
+	}
+
+	tlsConfig, err := createRedisTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisClient{
+		address:          cfg.Address,
+		password:         cfg.Password,
+		database:         cfg.Database,
+		keyPrefix:        cfg.KeyPrefix,
+		dialTimeout:      time.Duration(cfg.DialTimeoutInMs) * time.Millisecond,
+		operationTimeout: time.Duration(cfg.OperationTimeoutInMs) * time.Millisecond,
+		tlsConfig:        tlsConfig,
+		idleConns:        make(chan *redisConn, cfg.MaxIdleConnections),
+		getTimeHandler:   time.Now,
+	}, nil
+}
+
+func createRedisTLSConfig(cfg config.RedisConfig) (*tls.Config, error) {
+	if !cfg.UseTLS {
+		return nil, nil
+	}
+
+	serverName := cfg.ServerName
+	if len(serverName) == 0 {
+		host, _, err := net.SplitHostPort(cfg.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w, invalid Address: %s", ErrInvalidRedisConfig, err.Error())
+		}
+		serverName = host
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+	if len(cfg.CACertificateFile) == 0 {
+		return tlsConfig, nil
+	}
+
+	caBundle, err := os.ReadFile(cfg.CACertificateFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w, cannot read CACertificateFile: %s", ErrInvalidRedisConfig, err.Error())
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("%w, no PEM certificate found in %s", ErrInvalidRedisConfig, cfg.CACertificateFile)
+	}
+	tlsConfig.RootCAs = rootCAs
+
+	return tlsConfig, nil
+}
+
+// Get returns the value stored under the key, or ErrKeyNotFoundInSharedStore if the key does not exist
+func (rc *RedisClient) Get(key string) ([]byte, error) {
+	reply, err := rc.do("GET", rc.keyPrefix+key)
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, ErrKeyNotFoundInSharedStore
+	}
+
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("%w for GET: %v", ErrUnexpectedRedisReply, reply)
+	}
+
+	return value, nil
+}
+
+// Set stores the value under the key, expiring after the provided duration
+func (rc *RedisClient) Set(key string, value []byte, ttl time.Duration) error {
+	reply, err := rc.do("SET", rc.keyPrefix+key, string(value), "PX", formatMilliseconds(ttl))
+	if err != nil {
+		return err
+	}
+	if reply != redisOkReply {
+		return fmt.Errorf("%w for SET: %v", ErrUnexpectedRedisReply, reply)
+	}
+
+	return nil
+}
+
+// SetIfNotExists stores the value under the key, expiring after the provided duration, only if the key does not exist.
+// Returns true if the value was stored
+func (rc *RedisClient) SetIfNotExists(key string, value []byte, ttl time.Duration) (bool, error) {
+	reply, err := rc.do("SET", rc.keyPrefix+key, string(value), "NX", "PX", formatMilliseconds(ttl))
+	if err != nil {
+		return false, err
+	}
+
+	switch reply {
+	case redisOkReply:
+		return true, nil
+	case nil:
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w for SET NX: %v", ErrUnexpectedRedisReply, reply)
+	}
+}
+
+// Delete removes the key, if it exists
+func (rc *RedisClient) Delete(key string) error {
+	_, err := rc.do("DEL", rc.keyPrefix+key)
+	return err
+}
+
+func formatMilliseconds(duration time.Duration) string {
+	milliseconds := duration.Milliseconds()
+	if milliseconds < 1 {
+		milliseconds = 1
+	}
+
+	return strconv.FormatInt(milliseconds, 10)
+}
+
+// do sends the command over an idle connection, opening a new one if none is available. The connection is only put
+// back in the pool after a complete exchange, including the error replies, the ones failing being closed
+func (rc *RedisClient) do(args ...string) (interface{}, error) {
+	rConn, err := rc.getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := rc.exchange(rConn, args)
+	if errors.Is(err, ErrRedisErrorReply) {
+		rc.putConn(rConn)
+		return nil, err
+	}
+	if err != nil {
+		_ = rConn.conn.Close()
+		return nil, err
+	}
+
+	rc.putConn(rConn)
+	return reply, nil
+}
+
+func (rc *RedisClient) getConn() (*redisConn, error) {
+	select {
+	case rConn := <-rc.idleConns:
+		return rConn, nil
+	default:
+	}
+
+	err := rc.checkDialBackoff()
+	if err != nil {
+		return nil, err
+	}
+
+	rConn, err := rc.openConn()
+	rc.updateDialBackoff(err)
+
+	return rConn, err
+}
+
+func (rc *RedisClient) openConn() (*redisConn, error) {
+	dialer := &net.Dialer{
+		Timeout: rc.dialTimeout,
+	}
+
+	var conn net.Conn
+	var err error
+	if rc.tlsConfig != nil {
+		conn, err = tls.DialWithDialer(dialer, "tcp", rc.address, rc.tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", rc.address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rConn := &redisConn{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+	}
+	err = rc.initConn(rConn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return rConn, nil
+}
+
+func (rc *RedisClient) checkDialBackoff() error {
+	rc.mutDial.Lock()
+	defer rc.mutDial.Unlock()
+
+	if rc.getTimeHandler().Before(rc.nextDialTime) {
+		return fmt.Errorf("%w, next dial after %s", ErrRedisUnavailable, rc.nextDialTime.Format(time.RFC3339Nano))
+	}
+
+	return nil
+}
+
+// updateDialBackoff doubles the back off delay after each failed attempt of opening a connection, up to the maximum
+// delay, and resets it once a connection is opened
+func (rc *RedisClient) updateDialBackoff(dialErr error) {
+	rc.mutDial.Lock()
+	defer rc.mutDial.Unlock()
+
+	if dialErr == nil {
+		rc.dialBackoff = 0
+		rc.nextDialTime = time.Time{}
+		return
+	}
+
+	rc.dialBackoff *= 2
+	if rc.dialBackoff < minRedisDialBackoff {
+		rc.dialBackoff = minRedisDialBackoff
+	}
+	if rc.dialBackoff > maxRedisDialBackoff {
+		rc.dialBackoff = maxRedisDialBackoff
+	}
+	rc.nextDialTime = rc.getTimeHandler().Add(rc.dialBackoff)
+}
+
+func (rc *RedisClient) initConn(rConn *redisConn) error {
+	if len(rc.password) > 0 {
+		_, err := rc.exchange(rConn, []string{"AUTH", rc.password})
+		if err != nil {
+			return err
+		}
+	}
+	if rc.database > 0 {
+		_, err := rc.exchange(rConn, []string{"SELECT", strconv.Itoa(rc.database)})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rc *RedisClient) putConn(rConn *redisConn) {
+	select {
+	case rc.idleConns <- rConn:
+	default:
+		_ = rConn.conn.Close()
+	}
+}
+
+func (rc *RedisClient) exchange(rConn *redisConn, args []string) (interface{}, error) {
+	err := rConn.conn.SetDeadline(time.Now().Add(rc.operationTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = rConn.conn.Write(encodeRedisCommand(args))
+	if err != nil {
+		return nil, err
+	}
+
+	return readRedisReply(rConn.reader)
+}
+
+// encodeRedisCommand encodes the command as an array of bulk strings
+func encodeRedisCommand(args []string) []byte {
+	builder := strings.Builder{}
+	builder.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		builder.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n")
+		builder.WriteString(arg)
+		builder.WriteString("\r\n")
+	}
+
+	return []byte(builder.String())
+}
+
+// readRedisReply reads a reply, returned as a string for the simple strings, an int64 for the integers, a byte slice
+// for the bulk strings and nil for the null bulk string. The error replies are returned as errors
+func readRedisReply(reader *bufio.Reader) (interface{}, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || !strings.HasSuffix(line, "\r\n") {
+		return nil, fmt.Errorf("%w: malformed line %q", ErrUnexpectedRedisReply, line)
+	}
+	payload := line[1 : len(line)-2]
+
+	switch line[0] {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, fmt.Errorf("%w: %s", ErrRedisErrorReply, payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		length, errParse := strconv.Atoi(payload)
+		if errParse != nil || length < redisNilLength || length > maxRedisBulkLen {
+			return nil, fmt.Errorf("%w: invalid bulk length %q", ErrUnexpectedRedisReply, payload)
+		}
+		if length == redisNilLength {
+			return nil, nil
+		}
+
+		buff := make([]byte, length+2)
+		_, err = io.ReadFull(reader, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		return buff[:length], nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported reply type %q", ErrUnexpectedRedisReply, line[0])
+	}
+}
+
+// Close closes the idle connections
+func (rc *RedisClient) Close() error {
+	for {
+		select {
+		case rConn := <-rc.idleConns:
+			_ = rConn.conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *RedisClient) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,419 @@
+package cache
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+// redisServerStub is an in-memory server of the few commands issued by the RedisClient. The expiry is not handled
+type redisServerStub struct {
+	listener       net.Listener
+	password       string
+	mut            sync.Mutex
+	values         map[string]string
+	commands       []string
+	numConnections int32
+}
+
+func startRedisServerStub(t *testing.T, password string) *redisServerStub {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	return startRedisServerStubOnListener(t, listener, password)
+}
+
+func startRedisServerStubOnListener(t *testing.T, listener net.Listener, password string) *redisServerStub {
+	server := &redisServerStub{
+		listener: listener,
+		password: password,
+		values:   make(map[string]string),
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, errAccept := listener.Accept()
+			if errAccept != nil {
+				return
+			}
+			atomic.AddInt32(&server.numConnections, 1)
+			go server.serve(conn)
+		}
+	}()
+
+	return server
+}
+
+func (server *redisServerStub) serve(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	isAuthenticated := len(server.password) == 0
+	for {
+		args, err := readStubCommand(reader)
+		if err != nil {
+			return
+		}
+
+		reply := server.handle(args, &isAuthenticated)
+		_, err = conn.Write([]byte(reply))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func readStubCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	numArgs, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+
+	args := make([]string, 0, numArgs)
+	for i := 0; i < numArgs; i++ {
+		line, err = reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		length, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+		buff := make([]byte, length+2)
+		_, err = io.ReadFull(reader, buff)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(buff[:length]))
+	}
+
+	return args, nil
+}
+
+func (server *redisServerStub) handle(args []string, isAuthenticated *bool) string {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	server.commands = append(server.commands, strings.Join(args, " "))
+	command := strings.ToUpper(args[0])
+	if command == "AUTH" {
+		if args[1] != server.password {
+			return "-WRONGPASS invalid password\r\n"
+		}
+		*isAuthenticated = true
+		return "+OK\r\n"
+	}
+	if !*isAuthenticated {
+		return "-NOAUTH Authentication required\r\n"
+	}
+
+	switch command {
+	case "SELECT":
+		return "+OK\r\n"
+	case "GET":
+		value, found := server.values[args[1]]
+		if !found {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+	case "SET":
+		_, found := server.values[args[1]]
+		isNX := len(args) > 3 && strings.ToUpper(args[3]) == "NX"
+		if isNX && found {
+			return "$-1\r\n"
+		}
+		server.values[args[1]] = args[2]
+		return "+OK\r\n"
+	case "DEL":
+		_, found := server.values[args[1]]
+		delete(server.values, args[1])
+		if found {
+			return ":1\r\n"
+		}
+		return ":0\r\n"
+	default:
+		return "-ERR unknown command\r\n"
+	}
+}
+
+func (server *redisServerStub) getCommands() []string {
+	server.mut.Lock()
+	defer server.mut.Unlock()
+
+	return append([]string{}, server.commands...)
+}
+
+func createRedisConfig(address string) config.RedisConfig {
+	return config.RedisConfig{
+		Address:              address,
+		KeyPrefix:            "proxy:",
+		DialTimeoutInMs:      1000,
+		OperationTimeoutInMs: 1000,
+		MaxIdleConnections:   2,
+	}
+}
+
+func TestNewRedisClient(t *testing.T) {
+	t.Parallel()
+
+	cfg := createRedisConfig("")
+	rc, err := NewRedisClient(cfg)
+	require.Nil(t, rc)
+	require.True(t, errors.Is(err, ErrInvalidRedisConfig))
+
+	cfg = createRedisConfig("127.0.0.1:6379")
+	cfg.Database = -1
+	rc, err = NewRedisClient(cfg)
+	require.Nil(t, rc)
+	require.True(t, errors.Is(err, ErrInvalidRedisConfig))
+
+	cfg = createRedisConfig("127.0.0.1:6379")
+	cfg.OperationTimeoutInMs = 0
+	rc, err = NewRedisClient(cfg)
+	require.Nil(t, rc)
+	require.True(t, errors.Is(err, ErrInvalidRedisConfig))
+
+	cfg = createRedisConfig("127.0.0.1:6379")
+	cfg.MaxIdleConnections = 0
+	rc, err = NewRedisClient(cfg)
+	require.Nil(t, rc)
+	require.True(t, errors.Is(err, ErrInvalidRedisConfig))
+
+	rc, err = NewRedisClient(createRedisConfig("127.0.0.1:6379"))
+	require.Nil(t, err)
+	require.False(t, rc.IsInterfaceNil())
+}
+
+func TestRedisClient_Commands(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStub(t, "secret")
+	cfg := createRedisConfig(server.listener.Addr().String())
+	cfg.Password = "secret"
+	cfg.Database = 2
+	rc, _ := NewRedisClient(cfg)
+	defer func() {
+		_ = rc.Close()
+	}()
+
+	_, err := rc.Get("key")
+	require.Equal(t, ErrKeyNotFoundInSharedStore, err)
+
+	err = rc.Set("key", []byte("value\r\nwith separators"), 1500*time.Microsecond)
+	require.Nil(t, err)
+	value, err := rc.Get("key")
+	require.Nil(t, err)
+	require.Equal(t, "value\r\nwith separators", string(value))
+
+	isSet, err := rc.SetIfNotExists("key", []byte("other"), time.Second)
+	require.Nil(t, err)
+	require.False(t, isSet)
+	isSet, err = rc.SetIfNotExists("lease", []byte("instance"), time.Second)
+	require.Nil(t, err)
+	require.True(t, isSet)
+
+	err = rc.Delete("key")
+	require.Nil(t, err)
+	_, err = rc.Get("key")
+	require.Equal(t, ErrKeyNotFoundInSharedStore, err)
+
+	// a single connection was opened, authenticated and switched to the configured database
+	require.Equal(t, []string{
+		"AUTH secret",
+		"SELECT 2",
+		"GET proxy:key",
+		"SET proxy:key value\r\nwith separators PX 1",
+		"GET proxy:key",
+		"SET proxy:key other NX PX 1000",
+		"SET proxy:lease instance NX PX 1000",
+		"DEL proxy:key",
+		"GET proxy:key",
+	}, server.getCommands())
+}
+
+func TestRedisClient_ErrorReplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStub(t, "secret")
+	rc, _ := NewRedisClient(createRedisConfig(server.listener.Addr().String()))
+
+	_, err := rc.Get("key")
+	require.True(t, errors.Is(err, ErrRedisErrorReply))
+	require.Contains(t, err.Error(), "NOAUTH")
+
+	cfg := createRedisConfig(server.listener.Addr().String())
+	cfg.Password = "wrong"
+	rc, _ = NewRedisClient(cfg)
+	_, err = rc.Get("key")
+	require.True(t, errors.Is(err, ErrRedisErrorReply))
+	require.Contains(t, err.Error(), "WRONGPASS")
+}
+
+func TestRedisClient_ErrorReplyShouldKeepTheConnection(t *testing.T) {
+	t.Parallel()
+
+	server := startRedisServerStub(t, "")
+	rc, _ := NewRedisClient(createRedisConfig(server.listener.Addr().String()))
+
+	for i := 0; i < 3; i++ {
+		_, err := rc.do("UNKNOWN")
+		require.True(t, errors.Is(err, ErrRedisErrorReply))
+	}
+	require.Nil(t, rc.Set("key", []byte("value"), time.Second))
+	require.Equal(t, int32(1), atomic.LoadInt32(&server.numConnections))
+}
+
+func TestRedisClient_UnreachableServerShouldBackOff(t *testing.T) {
+	t.Parallel()
+
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	address := listener.Addr().String()
+	_ = listener.Close()
+
+	currentTime := time.Unix(1000, 0)
+	rc, _ := NewRedisClient(createRedisConfig(address))
+	rc.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	_, err := rc.Get("key")
+	require.NotNil(t, err)
+	require.False(t, errors.Is(err, ErrRedisUnavailable))
+
+	// the server is not dialed again until the back off delay expires
+	_, err = rc.Get("key")
+	require.True(t, errors.Is(err, ErrRedisUnavailable))
+
+	currentTime = currentTime.Add(minRedisDialBackoff)
+	_, err = rc.Get("key")
+	require.NotNil(t, err)
+	require.False(t, errors.Is(err, ErrRedisUnavailable))
+	require.Equal(t, 2*minRedisDialBackoff, rc.dialBackoff)
+
+	// the delay is capped
+	for i := 0; i < 20; i++ {
+		currentTime = currentTime.Add(maxRedisDialBackoff)
+		_, _ = rc.Get("key")
+	}
+	require.Equal(t, maxRedisDialBackoff, rc.dialBackoff)
+
+	// once the server is reachable again, the delay is reset
+	listener, err = net.Listen("tcp", address)
+	require.Nil(t, err)
+	startRedisServerStubOnListener(t, listener, "")
+	currentTime = currentTime.Add(maxRedisDialBackoff)
+	require.Nil(t, rc.Set("key", []byte("value"), time.Second))
+	require.Zero(t, rc.dialBackoff)
+}
+
+func TestRedisClient_TLS(t *testing.T) {
+	t.Parallel()
+
+	// the test server of the httptest package provides a certificate valid for 127.0.0.1
+	tlsServer := httptest.NewTLSServer(http.NotFoundHandler())
+	defer tlsServer.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+	require.Nil(t, os.WriteFile(caFile, caPem, 0600))
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: tlsServer.TLS.Certificates})
+	require.Nil(t, err)
+	server := startRedisServerStubOnListener(t, listener, "secret")
+
+	cfg := createRedisConfig(listener.Addr().String())
+	cfg.Password = "secret"
+	cfg.UseTLS = true
+	cfg.CACertificateFile = caFile
+	rc, err := NewRedisClient(cfg)
+	require.Nil(t, err)
+
+	require.Nil(t, rc.Set("key", []byte("value"), time.Second))
+	value, err := rc.Get("key")
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Equal(t, "AUTH secret", server.getCommands()[0])
+
+	t.Run("untrusted server certificate should err", func(t *testing.T) {
+		cfgWithoutCA := cfg
+		cfgWithoutCA.CACertificateFile = ""
+		rcWithoutCA, _ := NewRedisClient(cfgWithoutCA)
+		_, errGet := rcWithoutCA.Get("key")
+		require.NotNil(t, errGet)
+	})
+	t.Run("invalid CA certificate file should err", func(t *testing.T) {
+		cfgInvalidCA := cfg
+		cfgInvalidCA.CACertificateFile = filepath.Join(t.TempDir(), "missing.pem")
+		rcInvalidCA, errCreate := NewRedisClient(cfgInvalidCA)
+		require.True(t, errors.Is(errCreate, ErrInvalidRedisConfig))
+		require.Nil(t, rcInvalidCA)
+	})
+}
+
+func TestRedisClient_UnreachableServerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	address := listener.Addr().String()
+	_ = listener.Close()
+
+	rc, _ := NewRedisClient(createRedisConfig(address))
+	_, err := rc.Get("key")
+	require.NotNil(t, err)
+}
+
+func TestReadRedisReply(t *testing.T) {
+	t.Parallel()
+
+	readReply := func(reply string) (interface{}, error) {
+		return readRedisReply(bufio.NewReader(strings.NewReader(reply)))
+	}
+
+	reply, err := readReply("+OK\r\n")
+	require.Nil(t, err)
+	require.Equal(t, "OK", reply)
+
+	reply, err = readReply(":42\r\n")
+	require.Nil(t, err)
+	require.Equal(t, int64(42), reply)
+
+	reply, err = readReply("$3\r\nabc\r\n")
+	require.Nil(t, err)
+	require.Equal(t, []byte("abc"), reply)
+
+	reply, err = readReply("$-1\r\n")
+	require.Nil(t, err)
+	require.Nil(t, reply)
+
+	_, err = readReply("-ERR failure\r\n")
+	require.True(t, errors.Is(err, ErrRedisErrorReply))
+
+	_, err = readReply("*1\r\n")
+	require.True(t, errors.Is(err, ErrUnexpectedRedisReply))
+
+	_, err = readReply("$-5\r\n")
+	require.True(t, errors.Is(err, ErrUnexpectedRedisReply))
+
+	_, err = readReply("$10\r\nabc\r\n")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/cache")
+
+const (
+	// sharedValueTTLMultiplier is the number of refresh intervals a shared value outlives its last refresh, so the
+	// value survives the failure of the instance holding the refresh lease until another instance takes it over
+	sharedValueTTLMultiplier = 3
+	sharedValueSyncInterval  = time.Second
+	instanceIDNumBytes       = 8
+	versionKeySuffix         = ":version"
+	refreshLeaseKeySuffix    = ":refresh-lease"
+	versionSeparator         = '\n'
+)
+
+// sharedValue synchronizes a value kept in a shared store by several proxy instances. The value is stored along with
+// a version held under a separate small key, so the instances only fetch the value again once its version changed.
+// Only the instance holding the refresh lease should refresh the value from the observers
+type sharedValue struct {
+	sharedStore    SharedStore
+	key            string
+	ttl            time.Duration
+	leaseTTL       time.Duration
+	instanceID     string
+	getTimeHandler func() time.Time
+
+	mut       sync.Mutex
+	version   string
+	lastSync  time.Time
+	numStores uint64
+}
+
+func newSharedValue(store SharedStore, key string, refreshInterval time.Duration) (*sharedValue, error) {
+	if check.IfNil(store) {
+		return nil, ErrNilSharedStore
+	}
+	if refreshInterval <= 0 {
+		return nil, ErrInvalidCacheRefreshInterval
+	}
+
+	instanceID := make([]byte, instanceIDNumBytes)
+	_, _ = rand.Read(instanceID)
+
+	return &sharedValue{
+		sharedStore: store,
+		key:         key,
+		ttl:         refreshInterval * sharedValueTTLMultiplier,
+		// the lease expires slightly before the next refresh of its holder, which usually takes it again
+		leaseTTL:       refreshInterval * 9 / 10,
+		instanceID:     hex.EncodeToString(instanceID),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// fetchIfChanged returns the value from the shared store if its version changed since the last fetch or store, the
+// version being checked at most once per sync interval. A nil value along with true means the value was removed or
+// expired. The failures of the shared store are logged and reported as no change
+func (sv *sharedValue) fetchIfChanged() ([]byte, bool) {
+	sv.mut.Lock()
+	defer sv.mut.Unlock()
+
+	now := sv.getTimeHandler()
+	if now.Sub(sv.lastSync) < sharedValueSyncInterval {
+		return nil, false
+	}
+	sv.lastSync = now
+
+	version, err := sv.sharedStore.Get(sv.key + versionKeySuffix)
+	if errors.Is(err, ErrKeyNotFoundInSharedStore) {
+		isRemoved := len(sv.version) > 0
+		sv.version = ""
+		return nil, isRemoved
+	}
+	if err != nil {
+		log.Debug("shared cache: get version", "key", sv.key, "error", err.Error())
+		return nil, false
+	}
+	if string(version) == sv.version {
+		return nil, false
+	}
+
+	buff, err := sv.sharedStore.Get(sv.key)
+	if err != nil {
+		log.Debug("shared cache: get value", "key", sv.key, "error", err.Error())
+		return nil, false
+	}
+	separatorIndex := bytes.IndexByte(buff, versionSeparator)
+	if separatorIndex < 0 {
+		log.Debug("shared cache: malformed value", "key", sv.key)
+		return nil, false
+	}
+
+	// the version stored along with the value is used, as the version key might already be newer
+	sv.version = string(buff[:separatorIndex])
+	return buff[separatorIndex+1:], true
+}
+
+// store writes the value in the shared store, under a new version
+func (sv *sharedValue) store(value []byte) error {
+	sv.mut.Lock()
+	defer sv.mut.Unlock()
+
+	sv.numStores++
+	version := fmt.Sprintf("%s-%d", sv.instanceID, sv.numStores)
+	buff := make([]byte, 0, len(version)+1+len(value))
+	buff = append(buff, version...)
+	buff = append(buff, versionSeparator)
+	buff = append(buff, value...)
+
+	err := sv.sharedStore.Set(sv.key, buff, sv.ttl)
+	if err != nil {
+		return err
+	}
+	err = sv.sharedStore.Set(sv.key+versionKeySuffix, []byte(version), sv.ttl)
+	if err != nil {
+		return err
+	}
+
+	sv.version = version
+	sv.lastSync = sv.getTimeHandler()
+	return nil
+}
+
+// remove deletes the value from the shared store
+func (sv *sharedValue) remove() error {
+	sv.mut.Lock()
+	defer sv.mut.Unlock()
+
+	err := sv.sharedStore.Delete(sv.key + versionKeySuffix)
+	if err != nil {
+		return err
+	}
+
+	sv.version = ""
+	return sv.sharedStore.Delete(sv.key)
+}
+
+// tryAcquireRefreshLease returns true if this instance holds the refresh lease for the current refresh interval. If
+// the shared store cannot be reached, each instance refreshes its own copy
+func (sv *sharedValue) tryAcquireRefreshLease() bool {
+	isAcquired, err := sv.sharedStore.SetIfNotExists(sv.key+refreshLeaseKeySuffix, []byte(sv.instanceID), sv.leaseTTL)
+	if err != nil {
+		log.Debug("shared cache: acquire refresh lease", "key", sv.key, "error", err.Error())
+		return true
+	}
+
+	return isAcquired
+}
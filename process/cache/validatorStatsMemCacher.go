@@ -10,6 +10,7 @@ import (
 type validatorsStatsMemoryCacher struct {
 	storedValidatorsStats map[string]*data.ValidatorApiResponse
 	mutValidatorsStatss   sync.RWMutex
+	metrics               MetricsCounters
 }
 
 // NewValidatorsStatsMemoryCacher will return a new instance of validatorsStatsMemoryCacher
@@ -26,9 +27,11 @@ func (vsmc *validatorsStatsMemoryCacher) LoadValStats() (map[string]*data.Valida
 	defer vsmc.mutValidatorsStatss.RUnlock()
 
 	if vsmc.storedValidatorsStats == nil {
+		vsmc.metrics.AddMiss()
 		return nil, ErrNilValidatorStatsInCache
 	}
 
+	vsmc.metrics.AddHit()
 	return vsmc.storedValidatorsStats, nil
 }
 
@@ -45,6 +48,16 @@ func (vsmc *validatorsStatsMemoryCacher) StoreValStats(valStats map[string]*data
 	return nil
 }
 
+// ShouldRefresh returns true, as the cache is only used by this proxy instance
+func (vsmc *validatorsStatsMemoryCacher) ShouldRefresh() bool {
+	return true
+}
+
+// GetCacheMetrics returns the hits and the misses of the cache. The statistics are only replaced, never evicted
+func (vsmc *validatorsStatsMemoryCacher) GetCacheMetrics() data.CacheMetrics {
+	return vsmc.metrics.GetCacheMetrics()
+}
+
 // IsInterfaceNil will return true if there is no value under the interface
 func (vsmc *validatorsStatsMemoryCacher) IsInterfaceNil() bool {
 	return vsmc == nil
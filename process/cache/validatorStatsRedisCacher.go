@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const validatorStatsSharedKey = "validator-statistics"
+
+// validatorsStatsRedisCacher will handle caching the validator statistics shared by several proxy instances through a
+// Redis server. The statistics are served from a local copy, synchronized with the shared one
+type validatorsStatsRedisCacher struct {
+	localCacher *validatorsStatsMemoryCacher
+	shared      *sharedValue
+}
+
+// NewValidatorsStatsRedisCacher will return a new instance of validatorsStatsRedisCacher. The statistics are expected
+// to be refreshed at the provided interval
+func NewValidatorsStatsRedisCacher(store SharedStore, refreshInterval time.Duration) (*validatorsStatsRedisCacher, error) {
+	shared, err := newSharedValue(store, validatorStatsSharedKey, refreshInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &validatorsStatsRedisCacher{
+		localCacher: NewValidatorsStatsMemoryCacher(),
+		shared:      shared,
+	}, nil
+}
+
+// LoadValStats will return the ValidatorsStats response stored in cache (if found)
+func (vsrc *validatorsStatsRedisCacher) LoadValStats() (map[string]*data.ValidatorApiResponse, error) {
+	vsrc.syncFromSharedStore()
+
+	return vsrc.localCacher.LoadValStats()
+}
+
+// syncFromSharedStore replaces the local copy with the shared statistics, if changed. The statistics are never
+// evicted, so the local copy is kept if the shared one expired
+func (vsrc *validatorsStatsRedisCacher) syncFromSharedStore() {
+	buff, isChanged := vsrc.shared.fetchIfChanged()
+	if !isChanged || buff == nil {
+		return
+	}
+
+	valStats := make(map[string]*data.ValidatorApiResponse)
+	err := json.Unmarshal(buff, &valStats)
+	if err != nil {
+		log.Warn("validator statistics: unmarshal from shared cache", "error", err.Error())
+		return
+	}
+
+	_ = vsrc.localCacher.StoreValStats(valStats)
+}
+
+// StoreValStats will update the stored ValidatorsStats response in cache
+func (vsrc *validatorsStatsRedisCacher) StoreValStats(valStats map[string]*data.ValidatorApiResponse) error {
+	err := vsrc.localCacher.StoreValStats(valStats)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.Marshal(valStats)
+	if err != nil {
+		return err
+	}
+
+	return vsrc.shared.store(buff)
+}
+
+// ShouldRefresh returns true if this proxy instance holds the lease for refreshing the statistics from the observers
+func (vsrc *validatorsStatsRedisCacher) ShouldRefresh() bool {
+	return vsrc.shared.tryAcquireRefreshLease()
+}
+
+// GetCacheMetrics returns the hits and the misses of the local copy of the cache
+func (vsrc *validatorsStatsRedisCacher) GetCacheMetrics() data.CacheMetrics {
+	return vsrc.localCacher.GetCacheMetrics()
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (vsrc *validatorsStatsRedisCacher) IsInterfaceNil() bool {
+	return vsrc == nil
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValidatorsStatsRedisCacher(t *testing.T) {
+	t.Parallel()
+
+	vsrc, err := NewValidatorsStatsRedisCacher(nil, time.Second)
+	require.Nil(t, vsrc)
+	require.Equal(t, ErrNilSharedStore, err)
+
+	vsrc, err = NewValidatorsStatsRedisCacher(createRedisClient(t), time.Second)
+	require.Nil(t, err)
+	require.False(t, vsrc.IsInterfaceNil())
+}
+
+func TestValidatorsStatsRedisCacher_SharedBetweenInstances(t *testing.T) {
+	t.Parallel()
+
+	rc := createRedisClient(t)
+	refresher, _ := NewValidatorsStatsRedisCacher(rc, time.Second)
+	follower, _ := NewValidatorsStatsRedisCacher(rc, time.Second)
+
+	require.True(t, refresher.ShouldRefresh())
+	require.False(t, follower.ShouldRefresh())
+
+	err := refresher.StoreValStats(nil)
+	require.Equal(t, ErrNilValidatorStatsToStoreInCache, err)
+
+	valStats := map[string]*data.ValidatorApiResponse{
+		"pk1": {TempRating: 50, NumLeaderSuccess: 3},
+	}
+	err = refresher.StoreValStats(valStats)
+	require.Nil(t, err)
+
+	loadedValStats, err := follower.LoadValStats()
+	require.Nil(t, err)
+	require.Equal(t, valStats, loadedValStats)
+	require.Equal(t, uint64(1), follower.GetCacheMetrics().NumHits)
+}
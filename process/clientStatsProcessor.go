@@ -0,0 +1,379 @@
+package process
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	minClientStatsPollingIntervalInMs = 100
+	clientStatsFilePermissions        = 0644
+)
+
+// clientStatsRecord holds the counters of a client key, as persisted. The transactions relayed while no watch could
+// be registered, or whose watches were lost on a restart, are counted as untracked
+type clientStatsRecord struct {
+	Relayed                uint64 `json:"relayed"`
+	Executed               uint64 `json:"executed"`
+	Failed                 uint64 `json:"failed"`
+	Expired                uint64 `json:"expired"`
+	Untracked              uint64 `json:"untracked"`
+	TotalTimeToExecutionMs uint64 `json:"totalTimeToExecutionMs"`
+	LastRelayedTimestamp   int64  `json:"lastRelayedTimestamp"`
+}
+
+func (record *clientStatsRecord) numPending() uint64 {
+	numResolved := record.Executed + record.Failed + record.Expired + record.Untracked
+	if numResolved > record.Relayed {
+		return 0
+	}
+
+	return record.Relayed - numResolved
+}
+
+// ClientStatsProcessor counts the transactions relayed on behalf of each client key and follows them, through the
+// transaction status watcher, until they reach a final status. The client keys are only kept as hashes, so neither
+// the memory nor the persistence file hold the keys themselves
+type ClientStatsProcessor struct {
+	isEnabled           bool
+	pollingInterval     time.Duration
+	watchTimeout        time.Duration
+	maxWatched          int
+	maxClients          int
+	persistenceFilePath string
+	persistInterval     time.Duration
+	getTimeHandler      func() time.Time
+
+	mutStats      sync.Mutex
+	statusWatcher TransactionStatusWatcherHandler
+	clients       map[string]*clientStatsRecord
+	numWatched    int
+
+	ctx        context.Context
+	cancelFunc func()
+}
+
+// NewClientStatsProcessor creates a new instance of ClientStatsProcessor. If the client statistics are not enabled,
+// the returned instance does nothing. The statistics saved in the persistence file, if any, are loaded
+func NewClientStatsProcessor(cfg config.ClientStatsConfig) (*ClientStatsProcessor, error) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	csp := &ClientStatsProcessor{
+		isEnabled:           cfg.Enabled,
+		pollingInterval:     time.Duration(cfg.PollingIntervalInMs) * time.Millisecond,
+		watchTimeout:        time.Duration(cfg.WatchTimeoutInSec) * time.Second,
+		maxWatched:          cfg.MaxWatchedTransactions,
+		maxClients:          cfg.MaxClients,
+		persistenceFilePath: cfg.PersistenceFilePath,
+		persistInterval:     time.Duration(cfg.PersistIntervalInSec) * time.Second,
+		getTimeHandler:      time.Now,
+		clients:             make(map[string]*clientStatsRecord),
+		ctx:                 ctx,
+		cancelFunc:          cancelFunc,
+	}
+	if !cfg.Enabled {
+		return csp, nil
+	}
+
+	err := checkClientStatsConfig(cfg)
+	if err != nil {
+		cancelFunc()
+		return nil, err
+	}
+
+	err = csp.loadFromFile()
+	if err != nil {
+		cancelFunc()
+		return nil, err
+	}
+
+	log.Info("client statistics enabled", "key header", cfg.KeyHeader, "routes", cfg.Routes, "clients", len(csp.clients))
+
+	return csp, nil
+}
+
+func checkClientStatsConfig(cfg config.ClientStatsConfig) error {
+	if len(cfg.KeyHeader) == 0 {
+		return fmt.Errorf("%w, empty KeyHeader", ErrInvalidClientStatsConfig)
+	}
+	if len(cfg.Routes) == 0 {
+		return fmt.Errorf("%w, no route provided", ErrInvalidClientStatsConfig)
+	}
+	if cfg.PollingIntervalInMs < minClientStatsPollingIntervalInMs {
+		return fmt.Errorf("%w, PollingIntervalInMs: %d", ErrInvalidClientStatsConfig, cfg.PollingIntervalInMs)
+	}
+	if cfg.WatchTimeoutInSec < 1 {
+		return fmt.Errorf("%w, WatchTimeoutInSec: %d", ErrInvalidClientStatsConfig, cfg.WatchTimeoutInSec)
+	}
+	if cfg.MaxWatchedTransactions < 1 {
+		return fmt.Errorf("%w, MaxWatchedTransactions: %d", ErrInvalidClientStatsConfig, cfg.MaxWatchedTransactions)
+	}
+	if cfg.MaxClients < 1 {
+		return fmt.Errorf("%w, MaxClients: %d", ErrInvalidClientStatsConfig, cfg.MaxClients)
+	}
+	if len(cfg.PersistenceFilePath) > 0 && cfg.PersistIntervalInSec < 1 {
+		return fmt.Errorf("%w, PersistIntervalInSec: %d", ErrInvalidClientStatsConfig, cfg.PersistIntervalInSec)
+	}
+
+	return nil
+}
+
+// loadFromFile loads the persisted statistics. The watches do not survive a restart, so the transactions that were
+// still pending are counted as untracked
+func (csp *ClientStatsProcessor) loadFromFile() error {
+	if len(csp.persistenceFilePath) == 0 {
+		return nil
+	}
+
+	buff, err := os.ReadFile(csp.persistenceFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	clients := make(map[string]*clientStatsRecord)
+	err = json.Unmarshal(buff, &clients)
+	if err != nil {
+		return fmt.Errorf("%w while loading the client statistics from %s", err, csp.persistenceFilePath)
+	}
+
+	for _, record := range clients {
+		record.Untracked += record.numPending()
+	}
+	csp.clients = clients
+
+	return nil
+}
+
+// SetStatusWatcher sets the component tracking the process status of the relayed transactions. Until it is set, the
+// relayed transactions are counted as untracked
+func (csp *ClientStatsProcessor) SetStatusWatcher(statusWatcher TransactionStatusWatcherHandler) error {
+	if statusWatcher == nil {
+		return ErrNilTransactionStatusWatcher
+	}
+
+	csp.mutStats.Lock()
+	csp.statusWatcher = statusWatcher
+	csp.mutStats.Unlock()
+
+	return nil
+}
+
+// IsEnabled returns true if the client statistics were enabled from config
+func (csp *ClientStatsProcessor) IsEnabled() bool {
+	return csp.isEnabled
+}
+
+// RegisterSentTransactions counts the transactions relayed on behalf of the client key and watches them. It never
+// fails the sending, the keys above the maximum number of clients not being tracked
+func (csp *ClientStatsProcessor) RegisterSentTransactions(clientKey string, txHashes []string) {
+	if !csp.isEnabled || len(clientKey) == 0 || len(txHashes) == 0 {
+		return
+	}
+
+	keyHash := hashClientKey(clientKey)
+	now := csp.getTimeHandler()
+
+	csp.mutStats.Lock()
+	record, found := csp.clients[keyHash]
+	if !found {
+		if len(csp.clients) >= csp.maxClients {
+			csp.mutStats.Unlock()
+			log.Debug("client statistics: too many clients, key not tracked", "num clients", len(csp.clients))
+			return
+		}
+		record = &clientStatsRecord{}
+		csp.clients[keyHash] = record
+	}
+	record.Relayed += uint64(len(txHashes))
+	record.LastRelayedTimestamp = now.Unix()
+	statusWatcher := csp.statusWatcher
+	csp.mutStats.Unlock()
+
+	for _, txHash := range txHashes {
+		csp.watchTransaction(statusWatcher, keyHash, txHash, now)
+	}
+}
+
+// watchTransaction registers the watch with the status watcher. The statistics lock is not held while calling the
+// status watcher, as the events are emitted while the status watcher holds its own lock
+func (csp *ClientStatsProcessor) watchTransaction(statusWatcher TransactionStatusWatcherHandler, keyHash string, txHash string, sentAt time.Time) {
+	csp.mutStats.Lock()
+	if statusWatcher == nil || csp.numWatched >= csp.maxWatched {
+		csp.clients[keyHash].Untracked++
+		csp.mutStats.Unlock()
+		return
+	}
+	csp.numWatched++
+	csp.mutStats.Unlock()
+
+	sink := &clientStatsSink{
+		processor: csp,
+		keyHash:   keyHash,
+		sentAt:    sentAt,
+	}
+	_, err := statusWatcher.Watch(txHash, sink, TransactionWatchOptions{
+		PollingInterval: csp.pollingInterval,
+		Timeout:         csp.watchTimeout,
+	})
+	if err != nil {
+		log.Debug("client statistics: cannot watch the relayed transaction", "hash", txHash, "error", err.Error())
+
+		csp.mutStats.Lock()
+		csp.numWatched--
+		csp.clients[keyHash].Untracked++
+		csp.mutStats.Unlock()
+	}
+}
+
+func (csp *ClientStatsProcessor) onTransactionStatus(keyHash string, sentAt time.Time, event *data.TransactionStatusEvent) {
+	csp.mutStats.Lock()
+	defer csp.mutStats.Unlock()
+
+	csp.numWatched--
+	record := csp.clients[keyHash]
+	switch {
+	case event.TimedOut:
+		record.Expired++
+	case event.Status == string(transaction.TxStatusSuccess):
+		record.Executed++
+		timeToExecution := csp.getTimeHandler().Sub(sentAt)
+		if timeToExecution > 0 {
+			record.TotalTimeToExecutionMs += uint64(timeToExecution.Milliseconds())
+		}
+	default:
+		record.Failed++
+	}
+}
+
+// GetClientStats returns the statistics of the transactions relayed on behalf of the client key. The keys without any
+// relayed transaction have empty statistics
+func (csp *ClientStatsProcessor) GetClientStats(clientKey string) (*data.ClientStats, error) {
+	if !csp.isEnabled {
+		return nil, ErrClientStatsNotEnabled
+	}
+
+	csp.mutStats.Lock()
+	defer csp.mutStats.Unlock()
+
+	record, found := csp.clients[hashClientKey(clientKey)]
+	if !found {
+		return &data.ClientStats{}, nil
+	}
+
+	stats := &data.ClientStats{
+		Relayed:              record.Relayed,
+		Executed:             record.Executed,
+		Failed:               record.Failed,
+		Expired:              record.Expired,
+		Untracked:            record.Untracked,
+		Pending:              record.numPending(),
+		LastRelayedTimestamp: record.LastRelayedTimestamp,
+	}
+	if record.Executed > 0 {
+		stats.AverageTimeToExecutionMs = record.TotalTimeToExecutionMs / record.Executed
+	}
+
+	return stats, nil
+}
+
+func hashClientKey(clientKey string) string {
+	keyHash := sha256.Sum256([]byte(clientKey))
+	return hex.EncodeToString(keyHash[:])
+}
+
+// StartPersisting starts the go routine saving the statistics into the persistence file, if one is configured
+func (csp *ClientStatsProcessor) StartPersisting() {
+	if !csp.isEnabled || len(csp.persistenceFilePath) == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(csp.persistInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				err := csp.persist()
+				if err != nil {
+					log.Warn("cannot persist the client statistics", "file", csp.persistenceFilePath, "error", err.Error())
+				}
+			case <-csp.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// persist writes the statistics into a temporary file, renamed over the persistence file, so a crash while writing
+// does not corrupt the saved statistics
+func (csp *ClientStatsProcessor) persist() error {
+	csp.mutStats.Lock()
+	buff, err := json.Marshal(csp.clients)
+	csp.mutStats.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(csp.persistenceFilePath), filepath.Base(csp.persistenceFilePath)+".tmp")
+	if err != nil {
+		return err
+	}
+	tempFilePath := tempFile.Name()
+
+	_, err = tempFile.Write(buff)
+	errClose := tempFile.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err == nil {
+		err = os.Chmod(tempFilePath, clientStatsFilePermissions)
+	}
+	if err != nil {
+		_ = os.Remove(tempFilePath)
+		return err
+	}
+
+	return os.Rename(tempFilePath, csp.persistenceFilePath)
+}
+
+// Close stops the persisting go routine and saves the statistics one last time
+func (csp *ClientStatsProcessor) Close() error {
+	csp.cancelFunc()
+	if !csp.isEnabled || len(csp.persistenceFilePath) == 0 {
+		return nil
+	}
+
+	return csp.persist()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (csp *ClientStatsProcessor) IsInterfaceNil() bool {
+	return csp == nil
+}
+
+// clientStatsSink forwards the events of a relayed transaction to the client statistics processor
+type clientStatsSink struct {
+	processor *ClientStatsProcessor
+	keyHash   string
+	sentAt    time.Time
+}
+
+// OnTransactionStatus forwards the event to the client statistics processor
+func (css *clientStatsSink) OnTransactionStatus(event *data.TransactionStatusEvent) {
+	css.processor.onTransactionStatus(css.keyHash, css.sentAt, event)
+}
@@ -0,0 +1,218 @@
+package process_test
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+type statusWatcherSinksStub struct {
+	mutSinks sync.Mutex
+	sinks    map[string]process.TransactionStatusSink
+	watchErr error
+}
+
+func (stub *statusWatcherSinksStub) Watch(txHash string, sink process.TransactionStatusSink, _ process.TransactionWatchOptions) (func(), error) {
+	if stub.watchErr != nil {
+		return nil, stub.watchErr
+	}
+
+	stub.mutSinks.Lock()
+	if stub.sinks == nil {
+		stub.sinks = make(map[string]process.TransactionStatusSink)
+	}
+	stub.sinks[txHash] = sink
+	stub.mutSinks.Unlock()
+
+	return func() {}, nil
+}
+
+func (stub *statusWatcherSinksStub) emit(txHash string, status transaction.TxStatus, timedOut bool) {
+	stub.mutSinks.Lock()
+	sink := stub.sinks[txHash]
+	delete(stub.sinks, txHash)
+	stub.mutSinks.Unlock()
+
+	sink.OnTransactionStatus(&data.TransactionStatusEvent{
+		TxHash:   txHash,
+		Status:   string(status),
+		TimedOut: timedOut,
+	})
+}
+
+func createClientStatsConfig() config.ClientStatsConfig {
+	return config.ClientStatsConfig{
+		Enabled:                true,
+		KeyHeader:              "X-Api-Key",
+		Routes:                 []string{"/transaction/send"},
+		PollingIntervalInMs:    1000,
+		WatchTimeoutInSec:      60,
+		MaxWatchedTransactions: 10,
+		MaxClients:             10,
+		PersistIntervalInSec:   60,
+	}
+}
+
+func TestNewClientStatsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should do nothing", func(t *testing.T) {
+		t.Parallel()
+
+		csp, err := process.NewClientStatsProcessor(config.ClientStatsConfig{})
+		require.False(t, check.IfNil(csp))
+		require.NoError(t, err)
+		require.False(t, csp.IsEnabled())
+
+		csp.RegisterSentTransactions("client", []string{"hash"})
+		stats, err := csp.GetClientStats("client")
+		require.Nil(t, stats)
+		require.Equal(t, process.ErrClientStatsNotEnabled, err)
+		require.NoError(t, csp.Close())
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidConfig := func(modifier func(cfg *config.ClientStatsConfig), expectedField string) {
+			cfg := createClientStatsConfig()
+			modifier(&cfg)
+			csp, err := process.NewClientStatsProcessor(cfg)
+			require.True(t, check.IfNil(csp))
+			require.True(t, errors.Is(err, process.ErrInvalidClientStatsConfig))
+			require.True(t, strings.Contains(err.Error(), expectedField))
+		}
+
+		testInvalidConfig(func(cfg *config.ClientStatsConfig) { cfg.KeyHeader = "" }, "KeyHeader")
+		testInvalidConfig(func(cfg *config.ClientStatsConfig) { cfg.Routes = nil }, "route")
+		testInvalidConfig(func(cfg *config.ClientStatsConfig) { cfg.PollingIntervalInMs = 10 }, "PollingIntervalInMs")
+		testInvalidConfig(func(cfg *config.ClientStatsConfig) { cfg.WatchTimeoutInSec = 0 }, "WatchTimeoutInSec")
+		testInvalidConfig(func(cfg *config.ClientStatsConfig) { cfg.MaxWatchedTransactions = 0 }, "MaxWatchedTransactions")
+		testInvalidConfig(func(cfg *config.ClientStatsConfig) { cfg.MaxClients = 0 }, "MaxClients")
+		testInvalidConfig(func(cfg *config.ClientStatsConfig) {
+			cfg.PersistenceFilePath = "stats.json"
+			cfg.PersistIntervalInSec = 0
+		}, "PersistIntervalInSec")
+	})
+	t.Run("nil status watcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		csp, _ := process.NewClientStatsProcessor(createClientStatsConfig())
+		require.Equal(t, process.ErrNilTransactionStatusWatcher, csp.SetStatusWatcher(nil))
+	})
+}
+
+func TestClientStatsProcessor_RegisterSentTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("outcomes should be counted per client", func(t *testing.T) {
+		t.Parallel()
+
+		statusWatcher := &statusWatcherSinksStub{}
+		csp, _ := process.NewClientStatsProcessor(createClientStatsConfig())
+		require.NoError(t, csp.SetStatusWatcher(statusWatcher))
+
+		csp.RegisterSentTransactions("client", []string{"hash0", "hash1", "hash2", "hash3"})
+		csp.RegisterSentTransactions("other", []string{"hash4"})
+		statusWatcher.emit("hash0", transaction.TxStatusSuccess, false)
+		statusWatcher.emit("hash1", transaction.TxStatusFail, false)
+		statusWatcher.emit("hash2", transaction.TxStatusPending, true)
+
+		stats, err := csp.GetClientStats("client")
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), stats.Relayed)
+		require.Equal(t, uint64(1), stats.Executed)
+		require.Equal(t, uint64(1), stats.Failed)
+		require.Equal(t, uint64(1), stats.Expired)
+		require.Equal(t, uint64(1), stats.Pending)
+		require.Zero(t, stats.Untracked)
+		require.NotZero(t, stats.LastRelayedTimestamp)
+
+		stats, _ = csp.GetClientStats("other")
+		require.Equal(t, uint64(1), stats.Relayed)
+		require.Equal(t, uint64(1), stats.Pending)
+
+		stats, err = csp.GetClientStats("unknown")
+		require.NoError(t, err)
+		require.Equal(t, &data.ClientStats{}, stats)
+	})
+	t.Run("transactions that cannot be watched should be untracked", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createClientStatsConfig()
+		cfg.MaxWatchedTransactions = 1
+		csp, _ := process.NewClientStatsProcessor(cfg)
+
+		// no status watcher set yet
+		csp.RegisterSentTransactions("client", []string{"hash0"})
+
+		statusWatcher := &statusWatcherSinksStub{}
+		require.NoError(t, csp.SetStatusWatcher(statusWatcher))
+		csp.RegisterSentTransactions("client", []string{"hash1", "hash2"})
+
+		stats, _ := csp.GetClientStats("client")
+		require.Equal(t, uint64(3), stats.Relayed)
+		require.Equal(t, uint64(2), stats.Untracked)
+		require.Equal(t, uint64(1), stats.Pending)
+
+		// the watch slot is released once the transaction reached a final status
+		statusWatcher.emit("hash1", transaction.TxStatusSuccess, false)
+		csp.RegisterSentTransactions("client", []string{"hash3"})
+		stats, _ = csp.GetClientStats("client")
+		require.Equal(t, uint64(1), stats.Executed)
+		require.Equal(t, uint64(1), stats.Pending)
+
+		statusWatcher.watchErr = errors.New("expected error")
+		csp.RegisterSentTransactions("client", []string{"hash4"})
+		stats, _ = csp.GetClientStats("client")
+		require.Equal(t, uint64(3), stats.Untracked)
+	})
+	t.Run("clients above the maximum should not be tracked", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createClientStatsConfig()
+		cfg.MaxClients = 1
+		csp, _ := process.NewClientStatsProcessor(cfg)
+		require.NoError(t, csp.SetStatusWatcher(&statusWatcherSinksStub{}))
+
+		csp.RegisterSentTransactions("client", []string{"hash0"})
+		csp.RegisterSentTransactions("other", []string{"hash1"})
+
+		stats, _ := csp.GetClientStats("client")
+		require.Equal(t, uint64(1), stats.Relayed)
+		stats, _ = csp.GetClientStats("other")
+		require.Zero(t, stats.Relayed)
+	})
+}
+
+func TestClientStatsProcessor_Persistence(t *testing.T) {
+	t.Parallel()
+
+	cfg := createClientStatsConfig()
+	cfg.PersistenceFilePath = filepath.Join(t.TempDir(), "clientStats.json")
+
+	statusWatcher := &statusWatcherSinksStub{}
+	csp, err := process.NewClientStatsProcessor(cfg)
+	require.NoError(t, err)
+	require.NoError(t, csp.SetStatusWatcher(statusWatcher))
+	csp.RegisterSentTransactions("client", []string{"hash0", "hash1"})
+	statusWatcher.emit("hash0", transaction.TxStatusSuccess, false)
+	require.NoError(t, csp.Close())
+
+	// the pending transaction lost its watch on restart
+	csp, err = process.NewClientStatsProcessor(cfg)
+	require.NoError(t, err)
+	stats, _ := csp.GetClientStats("client")
+	require.Equal(t, uint64(2), stats.Relayed)
+	require.Equal(t, uint64(1), stats.Executed)
+	require.Equal(t, uint64(1), stats.Untracked)
+	require.Zero(t, stats.Pending)
+}
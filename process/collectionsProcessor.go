@@ -0,0 +1,56 @@
+package process
+
+import (
+	"regexp"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// maxCollectionNFTsWindow mirrors the default max_result_window of an Elasticsearch index
+const maxCollectionNFTsWindow = 10000
+
+// collectionIdentifierRegex matches the identifiers of the ESDT collections: a ticker of 3 to 10 uppercase
+// alphanumeric characters, followed by a dash and 6 random hex characters
+var collectionIdentifierRegex = regexp.MustCompile(`^[A-Z0-9]{3,10}-[0-9a-f]{6}$`)
+
+type collectionsProcessor struct {
+	connector CollectionsConnector
+}
+
+// NewCollectionsProcessor will create a new instance of the collections processor
+func NewCollectionsProcessor(connector CollectionsConnector) (*collectionsProcessor, error) {
+	if check.IfNil(connector) {
+		return nil, ErrNilCollectionsConnector
+	}
+
+	return &collectionsProcessor{
+		connector: connector,
+	}, nil
+}
+
+// GetCollectionNFTs returns the requested page of the NFTs of the collection, sorted by their nonce
+func (cp *collectionsProcessor) GetCollectionNFTs(collection string, options common.PaginationOptions) (*data.CollectionNFTs, error) {
+	if !collectionIdentifierRegex.MatchString(collection) {
+		return nil, ErrInvalidCollectionIdentifier
+	}
+	if uint64(options.Page)*uint64(options.Size) > maxCollectionNFTsWindow {
+		return nil, ErrCollectionNFTsWindowTooLarge
+	}
+
+	nfts, numNFTs, err := cp.connector.GetCollectionNFTs(collection, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.CollectionNFTs{
+		NFTs:       nfts,
+		Pagination: data.NewPaginationInfo(options.Page, options.Size, numNFTs),
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *collectionsProcessor) IsInterfaceNil() bool {
+	return cp == nil
+}
@@ -0,0 +1,79 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCollectionsProcessor(t *testing.T) {
+	t.Parallel()
+
+	cp, err := process.NewCollectionsProcessor(nil)
+	require.Nil(t, cp)
+	require.Equal(t, process.ErrNilCollectionsConnector, err)
+
+	cp, err = process.NewCollectionsProcessor(&mock.CollectionsConnectorStub{})
+	require.NoError(t, err)
+	require.False(t, cp.IsInterfaceNil())
+}
+
+func TestCollectionsProcessor_GetCollectionNFTs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid collection should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(&mock.CollectionsConnectorStub{})
+		for _, collection := range []string{"", "NFT", "NFT-abcdef-01", "nft-abcdef", "NFT-ABCDEF", "TOOLONGTICKER-abcdef"} {
+			res, err := cp.GetCollectionNFTs(collection, common.PaginationOptions{Page: 1, Size: 10})
+			require.Nil(t, res)
+			require.Equal(t, process.ErrInvalidCollectionIdentifier, err, collection)
+		}
+	})
+	t.Run("page beyond the window should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewCollectionsProcessor(&mock.CollectionsConnectorStub{})
+		res, err := cp.GetCollectionNFTs("NFT-abcdef", common.PaginationOptions{Page: 11, Size: 1000})
+		require.Nil(t, res)
+		require.Equal(t, process.ErrCollectionNFTsWindowTooLarge, err)
+	})
+	t.Run("connector error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		cp, _ := process.NewCollectionsProcessor(&mock.CollectionsConnectorStub{
+			GetCollectionNFTsCalled: func(collection string, options common.PaginationOptions) ([]data.CollectionNFT, int, error) {
+				return nil, 0, expectedErr
+			},
+		})
+		res, err := cp.GetCollectionNFTs("NFT-abcdef", common.PaginationOptions{Page: 1, Size: 10})
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nfts := []data.CollectionNFT{
+			{Identifier: "NFT-abcdef-0b", Nonce: 11, Owner: "erd1owner"},
+			{Identifier: "NFT-abcdef-0c", Nonce: 12},
+		}
+		cp, _ := process.NewCollectionsProcessor(&mock.CollectionsConnectorStub{
+			GetCollectionNFTsCalled: func(collection string, options common.PaginationOptions) ([]data.CollectionNFT, int, error) {
+				require.Equal(t, "NFT-abcdef", collection)
+				require.Equal(t, common.PaginationOptions{Page: 2, Size: 10}, options)
+				return nfts, 12, nil
+			},
+		})
+		res, err := cp.GetCollectionNFTs("NFT-abcdef", common.PaginationOptions{Page: 2, Size: 10})
+		require.NoError(t, err)
+		require.Equal(t, nfts, res.NFTs)
+		require.Equal(t, data.NewPaginationInfo(2, 10, 12), res.Pagination)
+	})
+}
@@ -0,0 +1,182 @@
+package process
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const noFullHistoryObserversDescription = "no full history observers in config, not reloaded"
+
+// ConfigReloadProcessor reloads the main config file at runtime: the observers lists, along with the settings handled
+// by the registered runtime config handlers (such as the rate limits, the cache max ages and the route toggles). The
+// new config is checked by all the handlers before being applied, so an invalid file leaves the running config
+// untouched, while a handler failing to apply it rolls the already updated handlers back to the previous config
+type ConfigReloadProcessor struct {
+	mutReload             sync.Mutex
+	configurationFilePath string
+	appliedConfig         *config.Config
+	handlers              []config.RuntimeConfigHandler
+	observersReloader     ObserversReloader
+}
+
+// NewConfigReloadProcessor creates a new instance of ConfigReloadProcessor, starting from the config the proxy was
+// started with
+func NewConfigReloadProcessor(configurationFilePath string, startupConfig config.Config) *ConfigReloadProcessor {
+	return &ConfigReloadProcessor{
+		configurationFilePath: configurationFilePath,
+		appliedConfig:         &startupConfig,
+		handlers:              make([]config.RuntimeConfigHandler, 0),
+	}
+}
+
+func loadConfigFile(filePath string) (*config.Config, error) {
+	cfg := &config.Config{}
+	err := core.LoadTomlFile(cfg, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// SetObserversReloader sets the component reloading the observers lists out of the config file
+func (crp *ConfigReloadProcessor) SetObserversReloader(observersReloader ObserversReloader) error {
+	if check.IfNil(observersReloader) {
+		return ErrNilObserversReloader
+	}
+
+	crp.mutReload.Lock()
+	crp.observersReloader = observersReloader
+	crp.mutReload.Unlock()
+
+	return nil
+}
+
+// RegisterRuntimeConfigHandler adds a handler to be called on each reload. The handlers are applied in the order they
+// were registered
+func (crp *ConfigReloadProcessor) RegisterRuntimeConfigHandler(handler config.RuntimeConfigHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilRuntimeConfigHandler
+	}
+
+	crp.mutReload.Lock()
+	crp.handlers = append(crp.handlers, handler)
+	crp.mutReload.Unlock()
+
+	return nil
+}
+
+// ReloadConfig loads the main config file again and applies its reloadable settings. The requests in progress are
+// not interrupted, the new settings being used by the next ones
+func (crp *ConfigReloadProcessor) ReloadConfig() (*data.ConfigReloadStatus, error) {
+	crp.mutReload.Lock()
+	defer crp.mutReload.Unlock()
+
+	newConfig, err := loadConfigFile(crp.configurationFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w, cannot load %s: %s", ErrInvalidReloadedConfig, crp.configurationFilePath, err.Error())
+	}
+
+	err = crp.checkConfig(newConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = crp.applyConfig(newConfig)
+	if err != nil {
+		return nil, err
+	}
+	crp.appliedConfig = newConfig
+
+	status := &data.ConfigReloadStatus{
+		Observers:            crp.reloadObservers(),
+		FullHistoryObservers: crp.reloadFullHistoryObservers(newConfig),
+	}
+	log.Info("main config reloaded", "file", crp.configurationFilePath,
+		"observers", status.Observers.Description, "full history observers", status.FullHistoryObservers.Description)
+
+	return status, nil
+}
+
+func (crp *ConfigReloadProcessor) checkConfig(newConfig *config.Config) error {
+	if len(newConfig.Observers) == 0 {
+		return fmt.Errorf("%w, empty observers list", ErrInvalidReloadedConfig)
+	}
+	for _, nodes := range [][]*data.NodeData{newConfig.Observers, newConfig.FullHistoryNodes} {
+		for _, node := range nodes {
+			if node == nil || len(node.Address) == 0 {
+				return fmt.Errorf("%w, observer with empty address", ErrInvalidReloadedConfig)
+			}
+		}
+	}
+
+	for _, handler := range crp.handlers {
+		err := handler.CheckConfig(newConfig)
+		if err != nil {
+			return fmt.Errorf("%w, %s", ErrInvalidReloadedConfig, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (crp *ConfigReloadProcessor) applyConfig(newConfig *config.Config) error {
+	for idx, handler := range crp.handlers {
+		err := handler.ApplyConfig(newConfig)
+		if err == nil {
+			continue
+		}
+
+		log.Error("cannot apply the reloaded config, rolling back", "error", err)
+		crp.rollback(idx)
+
+		return fmt.Errorf("%w, %s", ErrInvalidReloadedConfig, err.Error())
+	}
+
+	return nil
+}
+
+// rollback applies the previous config on the handlers updated before the one that failed
+func (crp *ConfigReloadProcessor) rollback(failedHandlerIndex int) {
+	for _, handler := range crp.handlers[:failedHandlerIndex] {
+		err := handler.ApplyConfig(crp.appliedConfig)
+		log.LogIfError(err)
+	}
+}
+
+func (crp *ConfigReloadProcessor) reloadObservers() data.NodesReloadStatus {
+	if check.IfNil(crp.observersReloader) {
+		return data.NodesReloadStatus{Description: "observers reloading not available"}
+	}
+
+	return toNodesReloadStatus(crp.observersReloader.ReloadObservers())
+}
+
+func (crp *ConfigReloadProcessor) reloadFullHistoryObservers(newConfig *config.Config) data.NodesReloadStatus {
+	if len(newConfig.FullHistoryNodes) == 0 {
+		return data.NodesReloadStatus{Description: noFullHistoryObserversDescription}
+	}
+	if check.IfNil(crp.observersReloader) {
+		return data.NodesReloadStatus{Description: "full history observers reloading not available"}
+	}
+
+	return toNodesReloadStatus(crp.observersReloader.ReloadFullHistoryObservers())
+}
+
+func toNodesReloadStatus(response data.NodesReloadResponse) data.NodesReloadStatus {
+	return data.NodesReloadStatus{
+		Reloaded:    len(response.Error) == 0,
+		Description: response.Description,
+		Error:       response.Error,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (crp *ConfigReloadProcessor) IsInterfaceNil() bool {
+	return crp == nil
+}
@@ -0,0 +1,158 @@
+package process_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const reloadedConfigContent = `
+[RateLimiter]
+    Enabled = true
+    RequestsPerSecond = 20.0
+
+[[Observers]]
+    ShardId = 0
+    Address = "observer-shard-0"
+`
+
+func writeConfigFile(t *testing.T, content string) string {
+	filePath := filepath.Join(t.TempDir(), "config.toml")
+	err := os.WriteFile(filePath, []byte(content), 0644)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func TestConfigReloadProcessor_RegisterRuntimeConfigHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	crp := process.NewConfigReloadProcessor("config.toml", config.Config{})
+	require.Equal(t, process.ErrNilRuntimeConfigHandler, crp.RegisterRuntimeConfigHandler(nil))
+	require.Equal(t, process.ErrNilObserversReloader, crp.SetObserversReloader(nil))
+}
+
+func TestConfigReloadProcessor_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("file not found should not apply", func(t *testing.T) {
+		t.Parallel()
+
+		crp := process.NewConfigReloadProcessor("missing-config.toml", config.Config{})
+		_ = crp.RegisterRuntimeConfigHandler(&mock.RuntimeConfigHandlerStub{
+			ApplyConfigCalled: func(cfg *config.Config) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		})
+
+		status, err := crp.ReloadConfig()
+		require.Nil(t, status)
+		require.True(t, errors.Is(err, process.ErrInvalidReloadedConfig))
+	})
+	t.Run("parse error should not apply", func(t *testing.T) {
+		t.Parallel()
+
+		crp := process.NewConfigReloadProcessor(writeConfigFile(t, "[RateLimiter\nEnabled = "), config.Config{})
+		_ = crp.RegisterRuntimeConfigHandler(&mock.RuntimeConfigHandlerStub{
+			ApplyConfigCalled: func(cfg *config.Config) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		})
+
+		status, err := crp.ReloadConfig()
+		require.Nil(t, status)
+		require.True(t, errors.Is(err, process.ErrInvalidReloadedConfig))
+	})
+	t.Run("empty observers list should not apply", func(t *testing.T) {
+		t.Parallel()
+
+		crp := process.NewConfigReloadProcessor(writeConfigFile(t, "[RateLimiter]\nEnabled = true\n"), config.Config{})
+		_ = crp.SetObserversReloader(&mock.ObserversReloaderStub{
+			ReloadObserversCalled: func() data.NodesReloadResponse {
+				require.Fail(t, "should have not been called")
+				return data.NodesReloadResponse{}
+			},
+		})
+
+		_, err := crp.ReloadConfig()
+		require.True(t, errors.Is(err, process.ErrInvalidReloadedConfig))
+	})
+	t.Run("check error should not apply", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		crp := process.NewConfigReloadProcessor(writeConfigFile(t, reloadedConfigContent), config.Config{})
+		numApplied := 0
+		_ = crp.RegisterRuntimeConfigHandler(&mock.RuntimeConfigHandlerStub{
+			ApplyConfigCalled: func(cfg *config.Config) error {
+				numApplied++
+				return nil
+			},
+		})
+		_ = crp.RegisterRuntimeConfigHandler(&mock.RuntimeConfigHandlerStub{
+			CheckConfigCalled: func(cfg *config.Config) error {
+				return expectedErr
+			},
+		})
+
+		_, err := crp.ReloadConfig()
+		require.True(t, errors.Is(err, process.ErrInvalidReloadedConfig))
+		require.Contains(t, err.Error(), expectedErr.Error())
+		require.Zero(t, numApplied)
+	})
+	t.Run("apply error should roll back", func(t *testing.T) {
+		t.Parallel()
+
+		startupConfig := config.Config{RateLimiter: config.RateLimiterConfig{RequestsPerSecond: 10}}
+		crp := process.NewConfigReloadProcessor(writeConfigFile(t, reloadedConfigContent), startupConfig)
+		appliedRates := make([]float64, 0)
+		_ = crp.RegisterRuntimeConfigHandler(&mock.RuntimeConfigHandlerStub{
+			ApplyConfigCalled: func(cfg *config.Config) error {
+				appliedRates = append(appliedRates, cfg.RateLimiter.RequestsPerSecond)
+				return nil
+			},
+		})
+		_ = crp.RegisterRuntimeConfigHandler(&mock.RuntimeConfigHandlerStub{
+			ApplyConfigCalled: func(cfg *config.Config) error {
+				return errors.New("cannot apply")
+			},
+		})
+
+		_, err := crp.ReloadConfig()
+		require.True(t, errors.Is(err, process.ErrInvalidReloadedConfig))
+		require.Equal(t, []float64{20, 10}, appliedRates)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		crp := process.NewConfigReloadProcessor(writeConfigFile(t, reloadedConfigContent), config.Config{})
+		var appliedConfig *config.Config
+		_ = crp.RegisterRuntimeConfigHandler(&mock.RuntimeConfigHandlerStub{
+			ApplyConfigCalled: func(cfg *config.Config) error {
+				appliedConfig = cfg
+				return nil
+			},
+		})
+		_ = crp.SetObserversReloader(&mock.ObserversReloaderStub{
+			ReloadFullHistoryObserversCalled: func() data.NodesReloadResponse {
+				require.Fail(t, "should have not been called")
+				return data.NodesReloadResponse{}
+			},
+		})
+
+		status, err := crp.ReloadConfig()
+		require.Nil(t, err)
+		require.Equal(t, 20.0, appliedConfig.RateLimiter.RequestsPerSecond)
+		require.True(t, status.Observers.Reloaded)
+		require.False(t, status.FullHistoryObservers.Reloaded)
+	})
+}
@@ -0,0 +1,413 @@
+package process
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	networkStatusKey = "status"
+	networkConfigKey = "config"
+
+	metricShardConsensusGroupSize      = "erd_shard_consensus_group_size"
+	metricMetaConsensusGroupSize       = "erd_meta_consensus_group_size"
+	metricRatingsSelectionChances      = "erd_ratings_general_selection_chances"
+	metricSelectionChanceMaxThreshold  = "erd_max_threshold"
+	metricSelectionChanceChancePercent = "erd_chance_percent"
+
+	eligibleValidatorsList = "eligible"
+)
+
+type selectionChance struct {
+	maxThreshold  uint32
+	chancePercent uint32
+}
+
+type consensusProcessor struct {
+	blocksProvider  ConsensusBlocksProvider
+	networkProvider ConsensusNetworkProvider
+	hasher          hashing.Hasher
+}
+
+// NewConsensusProcessor will create a new instance of the consensus processor
+func NewConsensusProcessor(
+	blocksProvider ConsensusBlocksProvider,
+	networkProvider ConsensusNetworkProvider,
+	hasher hashing.Hasher,
+) (*consensusProcessor, error) {
+	if blocksProvider == nil {
+		return nil, ErrNilConsensusBlocksProvider
+	}
+	if networkProvider == nil {
+		return nil, ErrNilConsensusNetworkProvider
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &consensusProcessor{
+		blocksProvider:  blocksProvider,
+		networkProvider: networkProvider,
+		hasher:          hasher,
+	}, nil
+}
+
+// GetConsensusGroup returns the consensus group and the leader of a past round of a shard. The group is computed the
+// same way the nodes select it: the eligible validators of the epoch are weighted by the selection chance of their
+// rating and sampled using the randomness of the last block proposed before the round
+func (cp *consensusProcessor) GetConsensusGroup(shardID uint32, round uint64) (*data.GenericAPIResponse, error) {
+	if round == 0 {
+		return nil, fmt.Errorf("%w, the genesis round has no consensus group", ErrInvalidConsensusRound)
+	}
+
+	latestNonce, err := cp.getLatestNonce(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	prevBlock, nextBlock, err := cp.getBlocksAroundRound(shardID, round, latestNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusGroup := &data.ConsensusGroup{
+		ShardID:        shardID,
+		Round:          round,
+		Epoch:          prevBlock.Epoch,
+		PrevBlockNonce: prevBlock.Nonce,
+		PrevBlockRound: prevBlock.Round,
+		BlockProduced:  nextBlock.Round == round,
+	}
+	if consensusGroup.BlockProduced {
+		consensusGroup.Epoch = nextBlock.Epoch
+		consensusGroup.BlockHash = nextBlock.Hash
+		consensusGroup.BlockNonce = nextBlock.Nonce
+	}
+
+	randSeed, err := hex.DecodeString(prevBlock.RandSeed)
+	if err != nil {
+		return nil, fmt.Errorf("%w, invalid rand seed of block %d: %s", ErrCannotComputeConsensusGroup, prevBlock.Nonce, err.Error())
+	}
+	randomness := computeConsensusRandomness(round, randSeed)
+	consensusGroup.Randomness = hex.EncodeToString(randomness)
+
+	eligible, err := cp.getEligibleValidators(shardID, consensusGroup.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	consensusGroup.NumEligible = len(eligible)
+
+	consensusGroup.ConsensusGroupSize, err = cp.getConsensusGroupSize(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	chances, err := cp.getSelectionChances()
+	if err != nil {
+		return nil, err
+	}
+
+	consensusGroup.Members, err = cp.selectConsensusGroup(eligible, chances, randomness, consensusGroup.ConsensusGroupSize)
+	if err != nil {
+		return nil, err
+	}
+	consensusGroup.Leader = consensusGroup.Members[0].PublicKey
+
+	if consensusGroup.BlockProduced {
+		consensusGroup.NumSigners, err = markConsensusSigners(consensusGroup.Members, nextBlock.PubKeyBitmap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data.GenericAPIResponse{
+		Data: data.ConsensusGroupResponseData{Consensus: consensusGroup},
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+func (cp *consensusProcessor) getLatestNonce(shardID uint32) (uint64, error) {
+	response, err := cp.networkProvider.GetNetworkStatusMetrics(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, ok := getNetworkMetric(response.Data, networkStatusKey, MetricNonce)
+	if !ok {
+		return 0, ErrCannotParseNodeStatusMetrics
+	}
+
+	return getUint(nonce), nil
+}
+
+// getBlocksAroundRound returns the last block proposed before the provided round, whose randomness seeded the
+// consensus group of the round, along with the block following it. The first one is found with a binary search over
+// the nonces, as the rounds of the blocks grow together with their nonces
+func (cp *consensusProcessor) getBlocksAroundRound(shardID uint32, round uint64, latestNonce uint64) (*api.Block, *api.Block, error) {
+	fetchedBlocks := make(map[uint64]*api.Block)
+	getBlock := func(nonce uint64) (*api.Block, error) {
+		block, found := fetchedBlocks[nonce]
+		if found {
+			return block, nil
+		}
+
+		response, err := cp.blocksProvider.GetBlockByNonce(context.Background(), shardID, nonce, common.BlockQueryOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		fetchedBlocks[nonce] = &response.Data.Block
+		return fetchedBlocks[nonce], nil
+	}
+
+	// each round produces at most one block, so the block proposed before the round has a lower nonce
+	low, high := uint64(0), core.MinUint64(latestNonce, round-1)
+	for low < high {
+		middle := low + (high-low+1)/2
+		block, err := getBlock(middle)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if block.Round < round {
+			low = middle
+		} else {
+			high = middle - 1
+		}
+	}
+
+	if low == latestNonce {
+		return nil, nil, fmt.Errorf("%w, latest nonce of shard %d is %d", ErrConsensusRoundNotReached, shardID, latestNonce)
+	}
+
+	prevBlock, err := getBlock(low)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextBlock, err := getBlock(low + 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return prevBlock, nextBlock, nil
+}
+
+// getEligibleValidators returns the eligible validators of the shard in the provided epoch, ordered by their index
+func (cp *consensusProcessor) getEligibleValidators(shardID uint32, epoch uint32) ([]*data.ConsensusValidatorInfo, error) {
+	response, err := cp.blocksProvider.GetInternalStartOfEpochValidatorsInfo(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsInfoBytes, err := json.Marshal(response.Data.ValidatorsInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsInfo := make([]*data.ConsensusValidatorInfo, 0)
+	err = json.Unmarshal(validatorsInfoBytes, &validatorsInfo)
+	if err != nil {
+		return nil, fmt.Errorf("%w, invalid validators info of epoch %d: %s", ErrCannotComputeConsensusGroup, epoch, err.Error())
+	}
+
+	eligible := make([]*data.ConsensusValidatorInfo, 0, len(validatorsInfo))
+	for _, validatorInfo := range validatorsInfo {
+		if validatorInfo.ShardId == shardID && validatorInfo.List == eligibleValidatorsList {
+			eligible = append(eligible, validatorInfo)
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return eligible[i].Index < eligible[j].Index
+	})
+
+	return eligible, nil
+}
+
+func (cp *consensusProcessor) getConsensusGroupSize(shardID uint32) (uint32, error) {
+	response, err := cp.networkProvider.GetNetworkConfigMetrics()
+	if err != nil {
+		return 0, err
+	}
+
+	metric := metricShardConsensusGroupSize
+	if shardID == core.MetachainShardId {
+		metric = metricMetaConsensusGroupSize
+	}
+
+	value, ok := getNetworkMetric(response.Data, networkConfigKey, metric)
+	if !ok || getUint(value) == 0 {
+		return 0, fmt.Errorf("%w, missing %s network config metric", ErrCannotComputeConsensusGroup, metric)
+	}
+
+	return uint32(getUint(value)), nil
+}
+
+// getSelectionChances returns the selection chances of the ratings config, ordered by their max rating threshold
+func (cp *consensusProcessor) getSelectionChances() ([]*selectionChance, error) {
+	response, err := cp.networkProvider.GetRatingsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := getNetworkMetric(response.Data, networkConfigKey, metricRatingsSelectionChances)
+	if !ok {
+		return nil, fmt.Errorf("%w, missing %s ratings config metric", ErrCannotComputeConsensusGroup, metricRatingsSelectionChances)
+	}
+
+	chancesSlice, ok := value.([]interface{})
+	if !ok || len(chancesSlice) == 0 {
+		return nil, fmt.Errorf("%w, invalid %s ratings config metric", ErrCannotComputeConsensusGroup, metricRatingsSelectionChances)
+	}
+
+	chances := make([]*selectionChance, 0, len(chancesSlice))
+	for _, chanceI := range chancesSlice {
+		chanceMap, isMap := chanceI.(map[string]interface{})
+		if !isMap {
+			return nil, fmt.Errorf("%w, invalid %s ratings config metric", ErrCannotComputeConsensusGroup, metricRatingsSelectionChances)
+		}
+
+		chances = append(chances, &selectionChance{
+			maxThreshold:  uint32(getUint(chanceMap[metricSelectionChanceMaxThreshold])),
+			chancePercent: uint32(getUint(chanceMap[metricSelectionChanceChancePercent])),
+		})
+	}
+	sort.SliceStable(chances, func(i, j int) bool {
+		return chances[i].maxThreshold < chances[j].maxThreshold
+	})
+
+	return chances, nil
+}
+
+// selectConsensusGroup samples the consensus group out of the eligible list expanded by the selection chance of each
+// validator. Each member is picked by hashing its position in the group together with the randomness, skipping the
+// validators already selected
+func (cp *consensusProcessor) selectConsensusGroup(
+	eligible []*data.ConsensusValidatorInfo,
+	chances []*selectionChance,
+	randomness []byte,
+	consensusGroupSize uint32,
+) ([]*data.ConsensusMember, error) {
+	if uint32(len(eligible)) < consensusGroupSize {
+		return nil, fmt.Errorf("%w, %d eligible validators are not enough for a consensus group of %d",
+			ErrCannotComputeConsensusGroup, len(eligible), consensusGroupSize)
+	}
+
+	validatorsChances := make([]uint32, len(eligible))
+	expandedList := make([]int, 0)
+	for i, validator := range eligible {
+		validatorsChances[i] = getSelectionChance(chances, validator.TempRating)
+		for j := uint32(0); j < validatorsChances[i]; j++ {
+			expandedList = append(expandedList, i)
+		}
+	}
+
+	numSelectable := 0
+	for _, chance := range validatorsChances {
+		if chance > 0 {
+			numSelectable++
+		}
+	}
+	if numSelectable < int(consensusGroupSize) {
+		return nil, fmt.Errorf("%w, %d validators have selection chances for a consensus group of %d",
+			ErrCannotComputeConsensusGroup, numSelectable, consensusGroupSize)
+	}
+
+	selected := make(map[int]struct{}, consensusGroupSize)
+	members := make([]*data.ConsensusMember, 0, consensusGroupSize)
+	expandedListLen := big.NewInt(int64(len(expandedList)))
+	for i := uint32(0); i < consensusGroupSize; i++ {
+		positionBuff := make([]byte, 8)
+		binary.BigEndian.PutUint64(positionBuff, uint64(i))
+		indexHash := cp.hasher.Compute(string(positionBuff) + string(randomness))
+		index := big.NewInt(0).Mod(big.NewInt(0).SetBytes(indexHash), expandedListLen).Int64()
+
+		_, isSelected := selected[expandedList[index]]
+		for isSelected {
+			index = (index + 1) % int64(len(expandedList))
+			_, isSelected = selected[expandedList[index]]
+		}
+
+		validatorIndex := expandedList[index]
+		selected[validatorIndex] = struct{}{}
+		members = append(members, &data.ConsensusMember{
+			PublicKey: hex.EncodeToString(eligible[validatorIndex].PublicKey),
+			Index:     eligible[validatorIndex].Index,
+			Rating:    eligible[validatorIndex].TempRating,
+			Chance:    validatorsChances[validatorIndex],
+		})
+	}
+
+	return members, nil
+}
+
+func getSelectionChance(chances []*selectionChance, rating uint32) uint32 {
+	for _, chance := range chances {
+		if rating <= chance.maxThreshold {
+			return chance.chancePercent
+		}
+	}
+
+	return chances[len(chances)-1].chancePercent
+}
+
+// computeConsensusRandomness mixes the round into the rand seed of the previous block, so that a new group is selected
+// for each round even if no block was proposed in between
+func computeConsensusRandomness(round uint64, randSeed []byte) []byte {
+	return append([]byte(strconv.FormatUint(round, 10)+"-"), randSeed...)
+}
+
+// markConsensusSigners flags the members which signed the block, as reported by its public keys bitmap, and returns
+// their number. The bit i of the bitmap corresponds to the member i of the consensus group
+func markConsensusSigners(members []*data.ConsensusMember, pubKeyBitmap string) (int, error) {
+	bitmap, err := hex.DecodeString(pubKeyBitmap)
+	if err != nil {
+		return 0, fmt.Errorf("%w, invalid public keys bitmap: %s", ErrCannotComputeConsensusGroup, err.Error())
+	}
+
+	numSigners := 0
+	for i, member := range members {
+		if i/8 >= len(bitmap) {
+			break
+		}
+
+		member.Signed = bitmap[i/8]&(1<<uint(i%8)) != 0
+		if member.Signed {
+			numSigners++
+		}
+	}
+
+	return numSigners, nil
+}
+
+func getNetworkMetric(responseData interface{}, key string, metric string) (interface{}, bool) {
+	responseMap, ok := responseData.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	metrics, ok := responseMap[key].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := metrics[metric]
+	return value, ok
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *consensusProcessor) IsInterfaceNil() bool {
+	return cp == nil
+}
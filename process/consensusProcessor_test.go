@@ -0,0 +1,225 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// the rounds of the blocks of the test shard, indexed by nonce. The rounds 4 and 7 have no block
+var testConsensusBlocksRounds = []uint64{0, 1, 2, 3, 5, 6, 8, 9, 10, 11, 12}
+
+func createTestConsensusBlocksProvider(validatorsInfo []*data.ConsensusValidatorInfo) *mock.ConsensusBlocksProviderStub {
+	return &mock.ConsensusBlocksProviderStub{
+		GetBlockByNonceCalled: func(_ context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			if nonce >= uint64(len(testConsensusBlocksRounds)) {
+				return nil, errors.New("block not found")
+			}
+
+			block := api.Block{
+				Nonce:        nonce,
+				Round:        testConsensusBlocksRounds[nonce],
+				Epoch:        2,
+				Shard:        shardID,
+				Hash:         hex.EncodeToString([]byte{byte(nonce)}),
+				RandSeed:     hex.EncodeToString([]byte{byte(nonce), 0xaa}),
+				PubKeyBitmap: "05",
+			}
+			return &data.BlockApiResponse{Data: data.BlockApiResponsePayload{Block: block}}, nil
+		},
+		GetInternalStartOfEpochValidatorsInfoCalled: func(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+			return &data.ValidatorsInfoApiResponse{
+				Data: data.InternalStartOfEpochValidators{ValidatorsInfo: validatorsInfo},
+			}, nil
+		},
+	}
+}
+
+func createTestConsensusNetworkProvider(consensusGroupSize int) *mock.ConsensusNetworkProviderStub {
+	return &mock.ConsensusNetworkProviderStub{
+		GetNetworkStatusMetricsCalled: func(shardID uint32) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"status": map[string]interface{}{"erd_nonce": float64(len(testConsensusBlocksRounds) - 1)},
+				},
+			}, nil
+		},
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_shard_consensus_group_size": float64(consensusGroupSize),
+						"erd_meta_consensus_group_size":  float64(consensusGroupSize + 1),
+					},
+				},
+			}, nil
+		},
+		GetRatingsConfigCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_ratings_general_selection_chances": []interface{}{
+							map[string]interface{}{"erd_max_threshold": float64(10000000), "erd_chance_percent": float64(150)},
+							map[string]interface{}{"erd_max_threshold": float64(0), "erd_chance_percent": float64(0)},
+							map[string]interface{}{"erd_max_threshold": float64(5000000), "erd_chance_percent": float64(100)},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func createTestConsensusValidatorsInfo() []*data.ConsensusValidatorInfo {
+	return []*data.ConsensusValidatorInfo{
+		{PublicKey: []byte("pk3"), ShardId: 1, List: "eligible", Index: 3, TempRating: 5000001},
+		{PublicKey: []byte("pk1"), ShardId: 1, List: "eligible", Index: 1, TempRating: 0},
+		{PublicKey: []byte("pk0"), ShardId: 1, List: "eligible", Index: 0, TempRating: 4000000},
+		{PublicKey: []byte("pk2"), ShardId: 1, List: "eligible", Index: 2, TempRating: 7000000},
+		{PublicKey: []byte("pk4"), ShardId: 1, List: "waiting", Index: 4, TempRating: 7000000},
+		{PublicKey: []byte("other"), ShardId: 0, List: "eligible", Index: 0, TempRating: 7000000},
+	}
+}
+
+func getConsensusGroup(t *testing.T, response *data.GenericAPIResponse) *data.ConsensusGroup {
+	responseData, ok := response.Data.(data.ConsensusGroupResponseData)
+	require.True(t, ok)
+
+	return responseData.Consensus
+}
+
+func TestNewConsensusProcessor(t *testing.T) {
+	t.Parallel()
+
+	cp, err := process.NewConsensusProcessor(nil, &mock.ConsensusNetworkProviderStub{}, hasher)
+	require.True(t, check.IfNil(cp))
+	require.Equal(t, process.ErrNilConsensusBlocksProvider, err)
+
+	cp, err = process.NewConsensusProcessor(&mock.ConsensusBlocksProviderStub{}, nil, hasher)
+	require.True(t, check.IfNil(cp))
+	require.Equal(t, process.ErrNilConsensusNetworkProvider, err)
+
+	cp, err = process.NewConsensusProcessor(&mock.ConsensusBlocksProviderStub{}, &mock.ConsensusNetworkProviderStub{}, nil)
+	require.True(t, check.IfNil(cp))
+	require.Equal(t, process.ErrNilHasher, err)
+
+	cp, err = process.NewConsensusProcessor(&mock.ConsensusBlocksProviderStub{}, &mock.ConsensusNetworkProviderStub{}, hasher)
+	require.False(t, check.IfNil(cp))
+	require.NoError(t, err)
+}
+
+func TestConsensusProcessor_GetConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("genesis round should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewConsensusProcessor(createTestConsensusBlocksProvider(createTestConsensusValidatorsInfo()), createTestConsensusNetworkProvider(3), hasher)
+		response, err := cp.GetConsensusGroup(1, 0)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInvalidConsensusRound))
+	})
+	t.Run("round without a following block should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewConsensusProcessor(createTestConsensusBlocksProvider(createTestConsensusValidatorsInfo()), createTestConsensusNetworkProvider(3), hasher)
+		response, err := cp.GetConsensusGroup(1, 13)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrConsensusRoundNotReached))
+	})
+	t.Run("not enough eligible validators should error", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewConsensusProcessor(createTestConsensusBlocksProvider(createTestConsensusValidatorsInfo()), createTestConsensusNetworkProvider(5), hasher)
+		response, err := cp.GetConsensusGroup(1, 8)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrCannotComputeConsensusGroup))
+
+		// one of the eligible validators has no selection chance
+		cp, _ = process.NewConsensusProcessor(createTestConsensusBlocksProvider(createTestConsensusValidatorsInfo()), createTestConsensusNetworkProvider(4), hasher)
+		response, err = cp.GetConsensusGroup(1, 8)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrCannotComputeConsensusGroup))
+	})
+	t.Run("blocks provider error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		blocksProvider := createTestConsensusBlocksProvider(createTestConsensusValidatorsInfo())
+		blocksProvider.GetInternalStartOfEpochValidatorsInfoCalled = func(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+			return nil, expectedErr
+		}
+		cp, _ := process.NewConsensusProcessor(blocksProvider, createTestConsensusNetworkProvider(3), hasher)
+		response, err := cp.GetConsensusGroup(1, 8)
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("produced block should report the signers", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewConsensusProcessor(createTestConsensusBlocksProvider(createTestConsensusValidatorsInfo()), createTestConsensusNetworkProvider(3), hasher)
+		response, err := cp.GetConsensusGroup(1, 8)
+		require.NoError(t, err)
+		require.Equal(t, data.ReturnCodeSuccess, response.Code)
+
+		consensusGroup := getConsensusGroup(t, response)
+		require.Equal(t, uint32(1), consensusGroup.ShardID)
+		require.Equal(t, uint64(8), consensusGroup.Round)
+		require.Equal(t, uint32(2), consensusGroup.Epoch)
+		require.Equal(t, uint64(5), consensusGroup.PrevBlockNonce)
+		require.Equal(t, uint64(6), consensusGroup.PrevBlockRound)
+		require.Equal(t, hex.EncodeToString(append([]byte("8-"), 5, 0xaa)), consensusGroup.Randomness)
+		require.Equal(t, 4, consensusGroup.NumEligible)
+		require.Equal(t, uint32(3), consensusGroup.ConsensusGroupSize)
+		require.True(t, consensusGroup.BlockProduced)
+		require.Equal(t, "06", consensusGroup.BlockHash)
+		require.Equal(t, uint64(6), consensusGroup.BlockNonce)
+
+		// the validator without selection chance is never selected
+		require.Len(t, consensusGroup.Members, 3)
+		publicKeys := make([]string, 0, len(consensusGroup.Members))
+		for _, member := range consensusGroup.Members {
+			publicKeys = append(publicKeys, member.PublicKey)
+		}
+		require.ElementsMatch(t, []string{hex.EncodeToString([]byte("pk0")), hex.EncodeToString([]byte("pk2")), hex.EncodeToString([]byte("pk3"))}, publicKeys)
+		require.Equal(t, consensusGroup.Members[0].PublicKey, consensusGroup.Leader)
+
+		// the 05 bitmap marks the first and the third members as signers
+		require.Equal(t, 2, consensusGroup.NumSigners)
+		require.True(t, consensusGroup.Members[0].Signed)
+		require.False(t, consensusGroup.Members[1].Signed)
+		require.True(t, consensusGroup.Members[2].Signed)
+
+		sameResponse, err := cp.GetConsensusGroup(1, 8)
+		require.NoError(t, err)
+		require.Equal(t, consensusGroup, getConsensusGroup(t, sameResponse))
+	})
+	t.Run("missed block should report no signers", func(t *testing.T) {
+		t.Parallel()
+
+		cp, _ := process.NewConsensusProcessor(createTestConsensusBlocksProvider(createTestConsensusValidatorsInfo()), createTestConsensusNetworkProvider(2), hasher)
+		response, err := cp.GetConsensusGroup(1, 7)
+		require.NoError(t, err)
+
+		consensusGroup := getConsensusGroup(t, response)
+		require.Equal(t, uint64(5), consensusGroup.PrevBlockNonce)
+		require.False(t, consensusGroup.BlockProduced)
+		require.Empty(t, consensusGroup.BlockHash)
+		require.Len(t, consensusGroup.Members, 2)
+		require.Equal(t, consensusGroup.Members[0].PublicKey, consensusGroup.Leader)
+		require.Zero(t, consensusGroup.NumSigners)
+		for _, member := range consensusGroup.Members {
+			require.False(t, member.Signed)
+			require.NotEqual(t, hex.EncodeToString([]byte("pk1")), member.PublicKey)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	dataIndexer "github.com/multiversx/mx-chain-es-indexer-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -53,3 +54,127 @@ func convertObjectToTransactions(obj object) ([]data.DatabaseTransaction, error)
 	}
 	return txs, nil
 }
+
+func convertObjectToTransfers(obj object) ([]data.Transfer, error) {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return nil, errCannotGetTxsFromBody
+	}
+
+	transfers := make([]data.Transfer, 0)
+	for _, h1 := range hits["hits"].([]interface{}) {
+		h2 := h1.(object)["_source"]
+
+		var transfer data.Transfer
+		marshalizedTransfer, _ := json.Marshal(h2)
+		err := json.Unmarshal(marshalizedTransfer, &transfer)
+		if err != nil {
+			continue
+		}
+
+		transfer.Hash = fmt.Sprint(h1.(object)["_id"])
+		if len(transfer.Type) == 0 {
+			transfer.Type = data.TransferTypeTransaction
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
+
+func getTotalHits(obj object) int {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return 0
+	}
+
+	total, ok := hits["total"].(object)
+	if !ok {
+		return 0
+	}
+
+	value, ok := total["value"].(float64)
+	if !ok {
+		return 0
+	}
+
+	return int(value)
+}
+
+func convertObjectToCollectionNFTs(obj object) ([]data.CollectionNFT, error) {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return nil, errCannotGetTokensFromBody
+	}
+
+	nfts := make([]data.CollectionNFT, 0)
+	for _, h1 := range hits["hits"].([]interface{}) {
+		h2 := h1.(object)["_source"]
+
+		var token dataIndexer.TokenInfo
+		marshalizedToken, _ := json.Marshal(h2)
+		err := json.Unmarshal(marshalizedToken, &token)
+		if err != nil {
+			continue
+		}
+
+		nfts = append(nfts, convertTokenToCollectionNFT(&token))
+	}
+	return nfts, nil
+}
+
+func convertTokenToCollectionNFT(token *dataIndexer.TokenInfo) data.CollectionNFT {
+	nft := data.CollectionNFT{
+		Identifier: token.Identifier,
+		Collection: token.Token,
+		Nonce:      token.Nonce,
+		Type:       token.Type,
+		URIs:       make([]string, 0),
+		Timestamp:  uint64(token.Timestamp),
+	}
+	if isNonFungibleType(token.Type) {
+		nft.Owner = token.CurrentOwner
+	}
+	if token.Data == nil {
+		return nft
+	}
+
+	nft.Name = token.Data.Name
+	nft.Creator = token.Data.Creator
+	nft.Royalties = token.Data.Royalties
+	nft.Hash = token.Data.Hash
+	nft.Tags = token.Data.Tags
+	nft.Attributes = token.Data.Attributes
+	for _, uri := range token.Data.URIs {
+		nft.URIs = append(nft.URIs, string(uri))
+	}
+
+	return nft
+}
+
+func isNonFungibleType(tokenType string) bool {
+	return tokenType == core.NonFungibleESDT || tokenType == core.NonFungibleESDTv2 || tokenType == core.DynamicNFTESDT
+}
+
+// convertObjectToOwners returns the addresses holding the tokens, mapped by the token identifier
+func convertObjectToOwners(obj object) map[string]string {
+	owners := make(map[string]string)
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return owners
+	}
+
+	for _, h1 := range hits["hits"].([]interface{}) {
+		source, isObject := h1.(object)["_source"].(object)
+		if !isObject {
+			continue
+		}
+
+		identifier, _ := source["identifier"].(string)
+		address, _ := source["address"].(string)
+		if len(identifier) > 0 && len(address) > 0 {
+			owners[identifier] = address
+		}
+	}
+
+	return owners
+}
@@ -0,0 +1,33 @@
+package database
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type disabledElasticSearchConnector struct{}
+
+// NewDisabledElasticSearchConnector returns a connector to be used when no Elasticsearch backend is configured
+func NewDisabledElasticSearchConnector() *disabledElasticSearchConnector {
+	return &disabledElasticSearchConnector{}
+}
+
+// GetTransactionsByAddress returns ErrDatabaseNotEnabled
+func (desc *disabledElasticSearchConnector) GetTransactionsByAddress(_ string, _ common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, int, error) {
+	return nil, 0, ErrDatabaseNotEnabled
+}
+
+// GetTransfersByAddress returns ErrDatabaseNotEnabled
+func (desc *disabledElasticSearchConnector) GetTransfersByAddress(_ string, _ common.TransactionsHistoryOptions) ([]data.Transfer, int, error) {
+	return nil, 0, ErrDatabaseNotEnabled
+}
+
+// GetCollectionNFTs returns ErrDatabaseNotEnabled
+func (desc *disabledElasticSearchConnector) GetCollectionNFTs(_ string, _ common.PaginationOptions) ([]data.CollectionNFT, int, error) {
+	return nil, 0, ErrDatabaseNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (desc *disabledElasticSearchConnector) IsInterfaceNil() bool {
+	return desc == nil
+}
@@ -0,0 +1,185 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/database")
+
+const (
+	transactionsIndex        = "transactions"
+	operationsIndex          = "operations"
+	tokensIndex              = "tokens"
+	accountsESDTIndex        = "accountsesdt"
+	defaultRequestTimeoutSec = 10
+)
+
+type elasticSearchConnector struct {
+	url        string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewElasticSearchConnector creates a connector towards the Elasticsearch backend populated by the elastic indexer
+func NewElasticSearchConnector(cfg config.ElasticSearchConfig) (*elasticSearchConnector, error) {
+	if len(cfg.URL) == 0 {
+		return nil, ErrEmptyElasticSearchURL
+	}
+	if cfg.RequestTimeoutSec < 0 {
+		return nil, ErrInvalidElasticSearchRequestTimeout
+	}
+
+	requestTimeoutSec := cfg.RequestTimeoutSec
+	if requestTimeoutSec == 0 {
+		requestTimeoutSec = defaultRequestTimeoutSec
+	}
+
+	return &elasticSearchConnector{
+		url:      strings.TrimSuffix(cfg.URL, "/"),
+		username: cfg.Username,
+		password: cfg.Password,
+		httpClient: &http.Client{
+			Timeout: time.Duration(requestTimeoutSec) * time.Second,
+		},
+	}, nil
+}
+
+// GetTransactionsByAddress returns the requested page of the transactions sent or received by the address, sorted from
+// the newest to the oldest, along with the total number of transactions matching the filters
+func (esc *elasticSearchConnector) GetTransactionsByAddress(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, int, error) {
+	query := txsByAddressQuery(address, options)
+	decodedBody, err := esc.doSearch(transactionsIndex, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	txs, err := convertObjectToTransactions(decodedBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return txs, getTotalHits(decodedBody), nil
+}
+
+// GetTransfersByAddress returns the requested page of the transactions and smart contract results moving value to or
+// from the address, sorted from the newest to the oldest, along with the total number of transfers matching the filters
+func (esc *elasticSearchConnector) GetTransfersByAddress(address string, options common.TransactionsHistoryOptions) ([]data.Transfer, int, error) {
+	decodedBody, err := esc.doSearch(operationsIndex, transfersByAddressQuery(address, options))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	transfers, err := convertObjectToTransfers(decodedBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return transfers, getTotalHits(decodedBody), nil
+}
+
+// GetCollectionNFTs returns the requested page of the NFTs of the collection, sorted by their nonce, along with the
+// total number of NFTs of the collection. The owners of the non-fungible tokens are looked up in the accounts ESDT index
+func (esc *elasticSearchConnector) GetCollectionNFTs(collection string, options common.PaginationOptions) ([]data.CollectionNFT, int, error) {
+	decodedBody, err := esc.doSearch(tokensIndex, nftsByCollectionQuery(collection, options))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	nfts, err := convertObjectToCollectionNFTs(decodedBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = esc.setNFTsOwners(nfts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nfts, getTotalHits(decodedBody), nil
+}
+
+// setNFTsOwners sets the address holding each non-fungible token, as found in the accounts ESDT index. The current owner
+// recorded in the tokens index is kept for the tokens not found there
+func (esc *elasticSearchConnector) setNFTsOwners(nfts []data.CollectionNFT) error {
+	identifiers := make([]string, 0, len(nfts))
+	for _, nft := range nfts {
+		if isNonFungibleType(nft.Type) {
+			identifiers = append(identifiers, nft.Identifier)
+		}
+	}
+	if len(identifiers) == 0 {
+		return nil
+	}
+
+	decodedBody, err := esc.doSearch(accountsESDTIndex, accountsESDTByIdentifiersQuery(identifiers))
+	if err != nil {
+		return err
+	}
+
+	owners := convertObjectToOwners(decodedBody)
+	for i := range nfts {
+		owner, found := owners[nfts[i].Identifier]
+		if found {
+			nfts[i].Owner = owner
+		}
+	}
+
+	return nil
+}
+
+func (esc *elasticSearchConnector) doSearch(index string, query object) (object, error) {
+	buff, err := encodeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/_search", esc.url, index), &buff)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(esc.username) > 0 {
+		req.SetBasicAuth(esc.username, esc.password)
+	}
+
+	resp, err := esc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("elastic search connector: close body", "error", errNotCritical.Error())
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%w, status code: %d, response: %s", ErrElasticSearchRequestFailed, resp.StatusCode, string(responseBody))
+	}
+
+	decodedBody := make(object)
+	err = json.NewDecoder(resp.Body).Decode(&decodedBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedBody, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (esc *elasticSearchConnector) IsInterfaceNil() bool {
+	return esc == nil
+}
@@ -0,0 +1,221 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewElasticSearchConnector(t *testing.T) {
+	t.Parallel()
+
+	esc, err := NewElasticSearchConnector(config.ElasticSearchConfig{})
+	require.Nil(t, esc)
+	require.Equal(t, ErrEmptyElasticSearchURL, err)
+
+	esc, err = NewElasticSearchConnector(config.ElasticSearchConfig{URL: "http://localhost:9200", RequestTimeoutSec: -1})
+	require.Nil(t, esc)
+	require.Equal(t, ErrInvalidElasticSearchRequestTimeout, err)
+
+	esc, err = NewElasticSearchConnector(config.ElasticSearchConfig{URL: "http://localhost:9200/"})
+	require.NoError(t, err)
+	require.False(t, esc.IsInterfaceNil())
+	require.Equal(t, "http://localhost:9200", esc.url)
+}
+
+func TestElasticSearchConnector_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(config.ElasticSearchConfig{URL: server.URL})
+		txs, total, err := esc.GetTransactionsByAddress("erd1addr", common.TransactionsHistoryOptions{Page: 1, Size: 10})
+		require.Nil(t, txs)
+		require.Zero(t, total)
+		require.True(t, errors.Is(err, ErrElasticSearchRequestFailed))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/transactions/_search", r.URL.Path)
+			username, password, ok := r.BasicAuth()
+			require.True(t, ok)
+			require.Equal(t, "user", username)
+			require.Equal(t, "pass", password)
+
+			query := make(object)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+			require.Equal(t, float64(10), query["from"])
+			require.Equal(t, float64(10), query["size"])
+
+			_, _ = w.Write([]byte(`{"hits":{"total":{"value":12},"hits":[` +
+				`{"_id":"h1","_source":{"sender":"erd1addr","timestamp":15}},` +
+				`{"_id":"h2","_source":{"receiver":"erd1addr","timestamp":12}}]}}`))
+		}))
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(config.ElasticSearchConfig{
+			URL:      server.URL,
+			Username: "user",
+			Password: "pass",
+		})
+		txs, total, err := esc.GetTransactionsByAddress("erd1addr", common.TransactionsHistoryOptions{Page: 2, Size: 10, After: 10})
+		require.NoError(t, err)
+		require.Equal(t, 12, total)
+		require.Len(t, txs, 2)
+		require.Equal(t, "h1", txs[0].Hash)
+		require.Equal(t, "h2", txs[1].Hash)
+	})
+}
+
+func TestTxsByAddressQuery(t *testing.T) {
+	t.Parallel()
+
+	query := txsByAddressQuery("erd1addr", common.TransactionsHistoryOptions{Page: 1, Size: 5})
+	boolQuery := query["query"].(object)["bool"].(object)
+	_, hasFilter := boolQuery["filter"]
+	require.False(t, hasFilter)
+
+	query = txsByAddressQuery("erd1addr", common.TransactionsHistoryOptions{Page: 1, Size: 5, After: 1, Before: 2})
+	boolQuery = query["query"].(object)["bool"].(object)
+	timestampRange := boolQuery["filter"].([]interface{})[0].(object)["range"].(object)["timestamp"].(object)
+	require.Equal(t, uint64(1), timestampRange["gte"])
+	require.Equal(t, uint64(2), timestampRange["lte"])
+}
+
+func TestElasticSearchConnector_GetTransfersByAddress(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/operations/_search", r.URL.Path)
+
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":2},"hits":[` +
+			`{"_id":"scr","_source":{"type":"unsigned","sender":"erd1sc","receiver":"erd1addr","value":"5","originalTxHash":"tx","timestamp":15}},` +
+			`{"_id":"tx","_source":{"type":"normal","sender":"erd1addr","receiver":"erd1sc","value":"0","nonce":3,"timestamp":15}}]}}`))
+	}))
+	defer server.Close()
+
+	esc, _ := NewElasticSearchConnector(config.ElasticSearchConfig{URL: server.URL})
+	transfers, total, err := esc.GetTransfersByAddress("erd1addr", common.TransactionsHistoryOptions{Page: 1, Size: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, total)
+	require.Equal(t, []data.Transfer{
+		{
+			Hash:           "scr",
+			Type:           data.TransferTypeSmartContractResult,
+			Sender:         "erd1sc",
+			Receiver:       "erd1addr",
+			Value:          "5",
+			OriginalTxHash: "tx",
+			Timestamp:      15,
+		},
+		{
+			Hash:      "tx",
+			Type:      data.TransferTypeTransaction,
+			Nonce:     3,
+			Sender:    "erd1addr",
+			Receiver:  "erd1sc",
+			Value:     "0",
+			Timestamp: 15,
+		},
+	}, transfers)
+}
+
+func TestTransfersByAddressQuery(t *testing.T) {
+	t.Parallel()
+
+	query := transfersByAddressQuery("erd1addr", common.TransactionsHistoryOptions{Page: 1, Size: 5})
+	filters := query["query"].(object)["bool"].(object)["filter"].([]interface{})
+	require.Len(t, filters, 1)
+
+	query = transfersByAddressQuery("erd1addr", common.TransactionsHistoryOptions{Page: 1, Size: 5, Before: 2})
+	filters = query["query"].(object)["bool"].(object)["filter"].([]interface{})
+	require.Len(t, filters, 2)
+	timestampRange := filters[0].(object)["range"].(object)["timestamp"].(object)
+	require.Equal(t, uint64(2), timestampRange["lte"])
+}
+
+func TestElasticSearchConnector_GetCollectionNFTs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("owners request failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/accountsesdt/_search" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(`{"hits":{"total":{"value":1},"hits":[` +
+				`{"_id":"NFT-abcdef-01","_source":{"identifier":"NFT-abcdef-01","token":"NFT-abcdef","nonce":1,"type":"NonFungibleESDT"}}]}}`))
+		}))
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(config.ElasticSearchConfig{URL: server.URL})
+		nfts, total, err := esc.GetCollectionNFTs("NFT-abcdef", common.PaginationOptions{Page: 1, Size: 10})
+		require.Nil(t, nfts)
+		require.Zero(t, total)
+		require.True(t, errors.Is(err, ErrElasticSearchRequestFailed))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := make(object)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+
+			switch r.URL.Path {
+			case "/tokens/_search":
+				require.Equal(t, float64(10), query["from"])
+				require.Equal(t, float64(10), query["size"])
+				_, _ = w.Write([]byte(`{"hits":{"total":{"value":13},"hits":[` +
+					`{"_id":"NFT-abcdef-0b","_source":{"identifier":"NFT-abcdef-0b","token":"NFT-abcdef","nonce":11,"type":"NonFungibleESDT","currentOwner":"erd1old","data":{"name":"first","royalties":500,"uris":["aHR0cHM6Ly91cmkx"]}}},` +
+					`{"_id":"NFT-abcdef-0c","_source":{"identifier":"NFT-abcdef-0c","token":"NFT-abcdef","nonce":12,"type":"NonFungibleESDT","currentOwner":"erd1owner2"}},` +
+					`{"_id":"NFT-abcdef-0d","_source":{"identifier":"NFT-abcdef-0d","token":"NFT-abcdef","nonce":13,"type":"SemiFungibleESDT","currentOwner":"erd1creator"}}]}}`))
+			case "/accountsesdt/_search":
+				identifiers := query["query"].(object)["terms"].(object)["identifier"].([]interface{})
+				require.Equal(t, []interface{}{"NFT-abcdef-0b", "NFT-abcdef-0c"}, identifiers)
+				_, _ = w.Write([]byte(`{"hits":{"hits":[` +
+					`{"_id":"x","_source":{"identifier":"NFT-abcdef-0b","address":"erd1owner1"}}]}}`))
+			default:
+				require.Fail(t, "unexpected path "+r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(config.ElasticSearchConfig{URL: server.URL})
+		nfts, total, err := esc.GetCollectionNFTs("NFT-abcdef", common.PaginationOptions{Page: 2, Size: 10})
+		require.NoError(t, err)
+		require.Equal(t, 13, total)
+		require.Len(t, nfts, 3)
+
+		require.Equal(t, "NFT-abcdef-0b", nfts[0].Identifier)
+		require.Equal(t, "NFT-abcdef", nfts[0].Collection)
+		require.Equal(t, uint64(11), nfts[0].Nonce)
+		require.Equal(t, "first", nfts[0].Name)
+		require.Equal(t, uint32(500), nfts[0].Royalties)
+		require.Equal(t, []string{"https://uri1"}, nfts[0].URIs)
+		require.Equal(t, "erd1owner1", nfts[0].Owner)
+
+		// not found in the accounts ESDT index, the current owner of the tokens index is kept
+		require.Equal(t, "erd1owner2", nfts[1].Owner)
+		require.Empty(t, nfts[1].URIs)
+
+		// the semi-fungible tokens might be held by several addresses
+		require.Empty(t, nfts[2].Owner)
+	})
+}
@@ -5,3 +5,16 @@ import "errors"
 var errCannotFindBlockInDb = errors.New("cannot find blocks in database")
 var errCannotUnmarshalBlock = errors.New("cannot unmarshal block")
 var errCannotGetTxsFromBody = errors.New("cannot get transactions from decoded body")
+var errCannotGetTokensFromBody = errors.New("cannot get tokens from decoded body")
+
+// ErrDatabaseNotEnabled signals that the Elasticsearch backend is not enabled
+var ErrDatabaseNotEnabled = errors.New("the Elasticsearch backend is not enabled")
+
+// ErrEmptyElasticSearchURL signals that an empty Elasticsearch URL has been provided
+var ErrEmptyElasticSearchURL = errors.New("empty Elasticsearch URL")
+
+// ErrInvalidElasticSearchRequestTimeout signals that an invalid Elasticsearch request timeout has been provided
+var ErrInvalidElasticSearchRequestTimeout = errors.New("invalid Elasticsearch request timeout")
+
+// ErrElasticSearchRequestFailed signals that the request towards Elasticsearch failed
+var ErrElasticSearchRequestFailed = errors.New("Elasticsearch request failed")
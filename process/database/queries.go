@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 type object = map[string]interface{}
@@ -57,3 +60,158 @@ func txsByMiniblockHashQuery(hash string) object {
 		},
 	}
 }
+
+func txsByAddressQuery(address string, options common.TransactionsHistoryOptions) object {
+	return addressHistoryQuery(address, options, nil)
+}
+
+// transfersByAddressQuery matches the transactions of the address along with the smart contract results moving EGLD or
+// ESDT tokens to or from it. The smart contract results without value (e.g. the ones only carrying return data) are
+// left out
+func transfersByAddressQuery(address string, options common.TransactionsHistoryOptions) object {
+	transferFilter := object{
+		"bool": object{
+			"should": []interface{}{
+				object{
+					"term": object{
+						"type": data.TransferTypeTransaction,
+					},
+				},
+				object{
+					"bool": object{
+						"filter": []interface{}{
+							object{
+								"term": object{
+									"type": data.TransferTypeSmartContractResult,
+								},
+							},
+						},
+						"should": []interface{}{
+							object{
+								"bool": object{
+									"must_not": []interface{}{
+										object{
+											"term": object{
+												"value": "0",
+											},
+										},
+									},
+								},
+							},
+							object{
+								"exists": object{
+									"field": "tokens",
+								},
+							},
+						},
+						"minimum_should_match": 1,
+					},
+				},
+			},
+			"minimum_should_match": 1,
+		},
+	}
+
+	return addressHistoryQuery(address, options, []interface{}{transferFilter})
+}
+
+// addressHistoryQuery matches the documents sent or received by the address, within the optional time range, along
+// with the extra filters, and returns the requested page sorted from the newest to the oldest
+func addressHistoryQuery(address string, options common.TransactionsHistoryOptions, filters []interface{}) object {
+	boolQuery := object{
+		"should": []interface{}{
+			object{
+				"match": object{
+					"sender": address,
+				},
+			},
+			object{
+				"match": object{
+					"receiver": address,
+				},
+			},
+		},
+		"minimum_should_match": 1,
+	}
+
+	timestampRange := object{}
+	if options.After > 0 {
+		timestampRange["gte"] = options.After
+	}
+	if options.Before > 0 {
+		timestampRange["lte"] = options.Before
+	}
+	if len(timestampRange) > 0 {
+		filters = append([]interface{}{
+			object{
+				"range": object{
+					"timestamp": timestampRange,
+				},
+			},
+		}, filters...)
+	}
+	if len(filters) > 0 {
+		boolQuery["filter"] = filters
+	}
+
+	return object{
+		"query": object{
+			"bool": boolQuery,
+		},
+		"sort": []interface{}{
+			object{
+				"timestamp": object{
+					"order": "desc",
+				},
+			},
+		},
+		"from":             (options.Page - 1) * options.Size,
+		"size":             options.Size,
+		"track_total_hits": true,
+	}
+}
+
+func nftsByCollectionQuery(collection string, options common.PaginationOptions) object {
+	return object{
+		"query": object{
+			"bool": object{
+				"filter": []interface{}{
+					object{
+						"term": object{
+							"token": collection,
+						},
+					},
+					object{
+						"range": object{
+							"nonce": object{
+								"gt": 0,
+							},
+						},
+					},
+				},
+			},
+		},
+		"sort": []interface{}{
+			object{
+				"nonce": object{
+					"order": "asc",
+				},
+			},
+		},
+		"from":             (options.Page - 1) * options.Size,
+		"size":             options.Size,
+		"track_total_hits": true,
+	}
+}
+
+func accountsESDTByIdentifiersQuery(identifiers []string) object {
+	return object{
+		"query": object{
+			"terms": object{
+				"identifier": identifiers,
+			},
+		},
+		"_source": []string{"address", "identifier"},
+		"size":    len(identifiers),
+	}
+}
@@ -0,0 +1,402 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	delegationManagerContractAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqylllslmq6y6"
+	allContractAddressesFunc         = "getAllContractAddresses"
+	isDelegatorFunc                  = "isDelegator"
+	userUnDelegatedListFunc          = "getUserUnDelegatedList"
+	contractConfigFunc               = "getContractConfig"
+	contractMetaDataFunc             = "getMetaData"
+	totalActiveStakeFunc             = "getTotalActiveStake"
+	numUsersFunc                     = "getNumUsers"
+	trueValue                        = "true"
+)
+
+var accountDelegationFuncs = []string{userActiveStakeFunc, claimableRewardsFunc, userUnDelegatedListFunc}
+
+var providerMetadataFuncs = []string{contractConfigFunc, contractMetaDataFunc, totalActiveStakeFunc, numUsersFunc}
+
+type delegationProcessor struct {
+	scQueryProc            SCQueryService
+	pubKeyConverter        core.PubkeyConverter
+	providersCacheValidity time.Duration
+	mutProviders           sync.RWMutex
+	providers              *data.DelegationProviders
+	getTimeHandler         func() time.Time
+	cancelFunc             func()
+}
+
+// NewDelegationProcessor will create a new instance of the delegation processor. The staking providers metadata is
+// cached and refreshed each providersCacheValidity
+func NewDelegationProcessor(
+	scQueryProc SCQueryService,
+	pubKeyConverter core.PubkeyConverter,
+	providersCacheValidity time.Duration,
+) (*delegationProcessor, error) {
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if providersCacheValidity <= 0 {
+		return nil, ErrInvalidCacheValidityDuration
+	}
+
+	return &delegationProcessor{
+		scQueryProc:            scQueryProc,
+		pubKeyConverter:        pubKeyConverter,
+		providersCacheValidity: providersCacheValidity,
+		getTimeHandler:         time.Now,
+	}, nil
+}
+
+// GetAccountDelegations returns the positions of the provided address in all the staking providers it delegated to:
+// the active stake, the claimable rewards and the undelegation queue. The staking providers are fetched from the
+// delegation manager, then each of them is asked whether the address is one of its delegators
+func (dp *delegationProcessor) GetAccountDelegations(address string) (*data.GenericAPIResponse, error) {
+	addressBytes, err := dp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+
+	stakingProviders, err := dp.getStakingProviders()
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedProviders, err := dp.filterDelegatedProviders(stakingProviders, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]*data.SCQuery, 0, len(delegatedProviders)*len(accountDelegationFuncs))
+	for _, provider := range delegatedProviders {
+		for _, funcName := range accountDelegationFuncs {
+			queries = append(queries, &data.SCQuery{
+				ScAddress: provider,
+				FuncName:  funcName,
+				Arguments: [][]byte{addressBytes},
+			})
+		}
+	}
+
+	outputs, err := executeSCQueriesInParallel(dp.scQueryProc, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations := &data.AccountDelegations{
+		Address:     address,
+		Delegations: make([]*data.AccountDelegation, 0, len(delegatedProviders)),
+	}
+	totalActiveStake := big.NewInt(0)
+	totalUnDelegated := big.NewInt(0)
+	totalClaimableRewards := big.NewInt(0)
+	for i, provider := range delegatedProviders {
+		providerOutputs := outputs[i*len(accountDelegationFuncs) : (i+1)*len(accountDelegationFuncs)]
+		activeStake := getFirstReturnDataAsBigInt(providerOutputs[0])
+		claimableRewards := getFirstReturnDataAsBigInt(providerOutputs[1])
+		delegation, unDelegated := newAccountDelegation(provider, activeStake, claimableRewards, providerOutputs[2])
+
+		delegations.Delegations = append(delegations.Delegations, delegation)
+		totalActiveStake.Add(totalActiveStake, activeStake)
+		totalUnDelegated.Add(totalUnDelegated, unDelegated)
+		totalClaimableRewards.Add(totalClaimableRewards, claimableRewards)
+	}
+	delegations.TotalActiveStake = totalActiveStake.String()
+	delegations.TotalUnDelegated = totalUnDelegated.String()
+	delegations.TotalClaimableRewards = totalClaimableRewards.String()
+
+	return &data.GenericAPIResponse{
+		Data:  data.AccountDelegationsResponseData{Delegations: delegations},
+		Error: "",
+		Code:  data.ReturnCodeSuccess,
+	}, nil
+}
+
+func (dp *delegationProcessor) getStakingProviders() ([]string, error) {
+	outputs, err := executeSCQueriesInParallel(dp.scQueryProc, []*data.SCQuery{
+		{
+			ScAddress:  delegationManagerContractAddress,
+			FuncName:   allContractAddressesFunc,
+			CallerAddr: delegationManagerContractAddress,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !isSuccessfulVMOutput(outputs[0]) {
+		return nil, fmt.Errorf("%w: %s", ErrSendingRequest, outputs[0].ReturnMessage)
+	}
+
+	stakingProviders := make([]string, 0, len(outputs[0].ReturnData))
+	for _, providerBytes := range outputs[0].ReturnData {
+		provider, errEncode := dp.pubKeyConverter.Encode(providerBytes)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+
+		stakingProviders = append(stakingProviders, provider)
+	}
+
+	return stakingProviders, nil
+}
+
+// filterDelegatedProviders returns the staking providers having the provided address as delegator. The isDelegator
+// function fails for the addresses that never delegated to that staking provider
+func (dp *delegationProcessor) filterDelegatedProviders(stakingProviders []string, addressBytes []byte) ([]string, error) {
+	queries := make([]*data.SCQuery, 0, len(stakingProviders))
+	for _, provider := range stakingProviders {
+		queries = append(queries, &data.SCQuery{
+			ScAddress: provider,
+			FuncName:  isDelegatorFunc,
+			Arguments: [][]byte{addressBytes},
+		})
+	}
+
+	outputs, err := executeSCQueriesInParallel(dp.scQueryProc, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedProviders := make([]string, 0)
+	for i, output := range outputs {
+		if isSuccessfulVMOutput(output) {
+			delegatedProviders = append(delegatedProviders, stakingProviders[i])
+		}
+	}
+
+	return delegatedProviders, nil
+}
+
+// newAccountDelegation builds the position held in a staking provider. The output of the getUserUnDelegatedList
+// function holds pairs of undelegated value and remaining epochs until the value can be withdrawn
+func newAccountDelegation(
+	provider string,
+	activeStake *big.Int,
+	claimableRewards *big.Int,
+	unDelegatedListOutput *vm.VMOutputApi,
+) (*data.AccountDelegation, *big.Int) {
+	totalUnDelegated := big.NewInt(0)
+	unBondable := big.NewInt(0)
+	unDelegatedList := make([]*data.UnDelegatedValue, 0)
+	if isSuccessfulVMOutput(unDelegatedListOutput) {
+		returnData := unDelegatedListOutput.ReturnData
+		for i := 0; i+1 < len(returnData); i += 2 {
+			value := big.NewInt(0).SetBytes(returnData[i])
+			remainingEpochs := big.NewInt(0).SetBytes(returnData[i+1]).Uint64()
+
+			totalUnDelegated.Add(totalUnDelegated, value)
+			if remainingEpochs == 0 {
+				unBondable.Add(unBondable, value)
+			}
+			unDelegatedList = append(unDelegatedList, &data.UnDelegatedValue{
+				Value:           value.String(),
+				RemainingEpochs: remainingEpochs,
+			})
+		}
+	}
+
+	return &data.AccountDelegation{
+		Contract:         provider,
+		ActiveStake:      activeStake.String(),
+		ClaimableRewards: claimableRewards.String(),
+		TotalUnDelegated: totalUnDelegated.String(),
+		UnBondable:       unBondable.String(),
+		UnDelegatedList:  unDelegatedList,
+	}, totalUnDelegated
+}
+
+// GetDelegationProviders returns all the staking providers along with their metadata, served from cache. Until the
+// cache is populated, the staking providers are fetched on request
+func (dp *delegationProcessor) GetDelegationProviders() (*data.DelegationProvidersResponse, error) {
+	dp.mutProviders.RLock()
+	providers := dp.providers
+	dp.mutProviders.RUnlock()
+
+	if providers == nil {
+		var err error
+		providers, err = dp.fetchDelegationProviders()
+		if err != nil {
+			return nil, err
+		}
+
+		dp.storeDelegationProviders(providers)
+	}
+
+	return &data.DelegationProvidersResponse{
+		Data:  *providers,
+		Error: "",
+		Code:  data.ReturnCodeSuccess,
+	}, nil
+}
+
+// fetchDelegationProviders queries the delegation manager for the staking providers, then queries in parallel each
+// staking provider for its config, its metadata, its active stake and its number of delegators, and the validator
+// contract for the stake and the number of nodes of each staking provider
+func (dp *delegationProcessor) fetchDelegationProviders() (*data.DelegationProviders, error) {
+	stakingProviders, err := dp.getStakingProviders()
+	if err != nil {
+		return nil, err
+	}
+
+	numQueriesPerProvider := len(providerMetadataFuncs) + 1
+	queries := make([]*data.SCQuery, 0, len(stakingProviders)*numQueriesPerProvider)
+	for _, provider := range stakingProviders {
+		providerBytes, errDecode := dp.pubKeyConverter.Decode(provider)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		for _, funcName := range providerMetadataFuncs {
+			queries = append(queries, &data.SCQuery{
+				ScAddress: provider,
+				FuncName:  funcName,
+			})
+		}
+		queries = append(queries, &data.SCQuery{
+			ScAddress:  validatorContractAddress,
+			FuncName:   totalStakedTopUpFunc,
+			CallerAddr: validatorContractAddress,
+			Arguments:  [][]byte{providerBytes},
+		})
+	}
+
+	outputs, err := executeSCQueriesInParallel(dp.scQueryProc, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]*data.DelegationProvider, 0, len(stakingProviders))
+	for i, provider := range stakingProviders {
+		providerOutputs := outputs[i*numQueriesPerProvider : (i+1)*numQueriesPerProvider]
+		providers = append(providers, dp.newDelegationProvider(provider, providerOutputs))
+	}
+
+	return &data.DelegationProviders{
+		Providers: providers,
+		Timestamp: dp.getTimeHandler().Unix(),
+	}, nil
+}
+
+// newDelegationProvider builds the metadata of a staking provider from the outputs of the functions defined in
+// providerMetadataFuncs, followed by the output of the getTotalStakedTopUpStakedBlsKeys function. The getContractConfig
+// function returns the owner, the service fee, the maximum delegation cap, the initial owner funds, the automatic
+// activation flag and the delegation cap flag, while the getMetaData function returns the name, the website and the
+// identity of the staking provider
+func (dp *delegationProcessor) newDelegationProvider(contract string, outputs []*vm.VMOutputApi) *data.DelegationProvider {
+	validatorStake, _ := newValidatorStakePosition(outputs[len(providerMetadataFuncs)])
+	provider := &data.DelegationProvider{
+		Contract:         contract,
+		TotalActiveStake: getFirstReturnDataAsBigInt(outputs[2]).String(),
+		NumDelegators:    getFirstReturnDataAsBigInt(outputs[3]).Uint64(),
+		TotalStaked:      validatorStake.TotalStaked,
+		TopUp:            validatorStake.TopUp,
+		NumNodes:         validatorStake.NumNodes,
+	}
+
+	contractConfig := outputs[0]
+	if isSuccessfulVMOutput(contractConfig) && len(contractConfig.ReturnData) >= 6 {
+		owner, err := dp.pubKeyConverter.Encode(contractConfig.ReturnData[0])
+		if err == nil {
+			provider.Owner = owner
+		}
+		provider.ServiceFee = big.NewInt(0).SetBytes(contractConfig.ReturnData[1]).Uint64()
+		provider.AutomaticActivation = string(contractConfig.ReturnData[4]) == trueValue
+		if string(contractConfig.ReturnData[5]) == trueValue {
+			provider.MaxDelegationCap = big.NewInt(0).SetBytes(contractConfig.ReturnData[2]).String()
+		}
+	}
+
+	metaData := outputs[1]
+	if isSuccessfulVMOutput(metaData) && len(metaData.ReturnData) >= 3 {
+		provider.Name = string(metaData.ReturnData[0])
+		provider.Website = string(metaData.ReturnData[1])
+		provider.Identity = string(metaData.ReturnData[2])
+	}
+
+	return provider
+}
+
+func (dp *delegationProcessor) storeDelegationProviders(providers *data.DelegationProviders) {
+	dp.mutProviders.Lock()
+	dp.providers = providers
+	dp.mutProviders.Unlock()
+}
+
+// StartProvidersCacheUpdate will start the refreshing of the staking providers metadata cache
+func (dp *delegationProcessor) StartProvidersCacheUpdate() {
+	if dp.cancelFunc != nil {
+		log.Error("delegationProcessor - cache update already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, dp.cancelFunc = context.WithCancel(context.Background())
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(dp.providersCacheValidity)
+		defer timer.Stop()
+
+		dp.handleProvidersCacheUpdate()
+
+		for {
+			timer.Reset(dp.providersCacheValidity)
+
+			select {
+			case <-timer.C:
+				dp.handleProvidersCacheUpdate()
+			case <-ctx.Done():
+				log.Debug("finishing delegationProcessor cache update...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+// handleProvidersCacheUpdate refreshes the cache. On failure, the previous staking providers metadata is kept
+func (dp *delegationProcessor) handleProvidersCacheUpdate() {
+	providers, err := dp.fetchDelegationProviders()
+	if err != nil {
+		log.Warn("delegation providers: get from observers", "error", err.Error())
+		return
+	}
+
+	dp.storeDelegationProviders(providers)
+}
+
+// Close will handle the closing of the cache update go routine
+func (dp *delegationProcessor) Close() error {
+	if dp.cancelFunc != nil {
+		dp.cancelFunc()
+	}
+
+	return nil
+}
+
+func getFirstReturnDataAsBigInt(output *vm.VMOutputApi) *big.Int {
+	if !isSuccessfulVMOutput(output) || len(output.ReturnData) == 0 {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).SetBytes(output.ReturnData[0])
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dp *delegationProcessor) IsInterfaceNil() bool {
+	return dp == nil
+}
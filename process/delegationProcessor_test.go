@@ -0,0 +1,277 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testDelegationManager = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqylllslmq6y6"
+
+func TestNewDelegationProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, err := process.NewDelegationProcessor(nil, testPubkeyConverter, time.Minute)
+		require.True(t, check.IfNil(dp))
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, err := process.NewDelegationProcessor(&mock.SCQueryServiceStub{}, nil, time.Minute)
+		require.True(t, check.IfNil(dp))
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("invalid cache validity should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, err := process.NewDelegationProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, 0)
+		require.True(t, check.IfNil(dp))
+		require.Equal(t, process.ErrInvalidCacheValidityDuration, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dp, err := process.NewDelegationProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+		require.False(t, check.IfNil(dp))
+		require.NoError(t, err)
+	})
+}
+
+func TestDelegationProcessor_GetAccountDelegations(t *testing.T) {
+	t.Parallel()
+
+	stakingProviderBytes, _ := testPubkeyConverter.Decode(testStakingProvider)
+	otherStakingProviderBytes, _ := testPubkeyConverter.Decode(testLegacyDelegation)
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		dp, _ := process.NewDelegationProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, time.Minute)
+		response, err := dp.GetAccountDelegations("invalid")
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+
+	t.Run("delegation manager failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{ReturnCode: "user error", ReturnMessage: "expected message"}, data.BlockInfo{}, nil
+			},
+		}
+		dp, _ := process.NewDelegationProcessor(scQueryStub, testPubkeyConverter, time.Minute)
+		response, err := dp.GetAccountDelegations(testDelegatorAddress)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.Contains(t, err.Error(), "expected message")
+	})
+
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.FuncName == "getAllContractAddresses" {
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{stakingProviderBytes}}, data.BlockInfo{}, nil
+				}
+				if query.FuncName == "getUserUnDelegatedList" {
+					return nil, data.BlockInfo{}, expectedErr
+				}
+
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{}, nil
+			},
+		}
+		dp, _ := process.NewDelegationProcessor(scQueryStub, testPubkeyConverter, time.Minute)
+		response, err := dp.GetAccountDelegations(testDelegatorAddress)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+
+	t.Run("should aggregate the delegations", func(t *testing.T) {
+		t.Parallel()
+
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.FuncName == "getAllContractAddresses" {
+					require.Equal(t, testDelegationManager, query.ScAddress)
+					require.Equal(t, testDelegationManager, query.CallerAddr)
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{stakingProviderBytes, otherStakingProviderBytes},
+					}, data.BlockInfo{}, nil
+				}
+
+				delegatorBytes, _ := testPubkeyConverter.Decode(testDelegatorAddress)
+				require.Equal(t, [][]byte{delegatorBytes}, query.Arguments)
+				if query.ScAddress == testLegacyDelegation {
+					require.Equal(t, "isDelegator", query.FuncName)
+					return &vm.VMOutputApi{ReturnCode: "user error"}, data.BlockInfo{}, nil
+				}
+
+				switch query.FuncName {
+				case "getUserActiveStake":
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{big.NewInt(1000).Bytes()}}, data.BlockInfo{}, nil
+				case "getClaimableRewards":
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{big.NewInt(7).Bytes()}}, data.BlockInfo{}, nil
+				case "getUserUnDelegatedList":
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{big.NewInt(30).Bytes(), {}, big.NewInt(20).Bytes(), big.NewInt(4).Bytes()},
+					}, data.BlockInfo{}, nil
+				default:
+					return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{}, nil
+				}
+			},
+		}
+		dp, _ := process.NewDelegationProcessor(scQueryStub, testPubkeyConverter, time.Minute)
+		response, err := dp.GetAccountDelegations(testDelegatorAddress)
+		require.NoError(t, err)
+		require.Equal(t, data.ReturnCodeSuccess, response.Code)
+
+		expectedDelegations := &data.AccountDelegations{
+			Address: testDelegatorAddress,
+			Delegations: []*data.AccountDelegation{
+				{
+					Contract:         testStakingProvider,
+					ActiveStake:      "1000",
+					ClaimableRewards: "7",
+					TotalUnDelegated: "50",
+					UnBondable:       "30",
+					UnDelegatedList: []*data.UnDelegatedValue{
+						{Value: "30", RemainingEpochs: 0},
+						{Value: "20", RemainingEpochs: 4},
+					},
+				},
+			},
+			TotalActiveStake:      "1000",
+			TotalUnDelegated:      "50",
+			TotalClaimableRewards: "7",
+		}
+		require.Equal(t, expectedDelegations, response.Data.(data.AccountDelegationsResponseData).Delegations)
+	})
+}
+
+func TestDelegationProcessor_GetDelegationProviders(t *testing.T) {
+	t.Parallel()
+
+	stakingProviderBytes, _ := testPubkeyConverter.Decode(testStakingProvider)
+	otherStakingProviderBytes, _ := testPubkeyConverter.Decode(testLegacyDelegation)
+	ownerBytes, _ := testPubkeyConverter.Decode(testDelegatorAddress)
+
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.FuncName == "getAllContractAddresses" {
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{stakingProviderBytes}}, data.BlockInfo{}, nil
+				}
+
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		dp, _ := process.NewDelegationProcessor(scQueryStub, testPubkeyConverter, time.Minute)
+		response, err := dp.GetDelegationProviders()
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+
+	t.Run("should aggregate the metadata and serve it from cache", func(t *testing.T) {
+		t.Parallel()
+
+		numGetAllContractAddressesCalls := 0
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.FuncName == "getAllContractAddresses" {
+					numGetAllContractAddressesCalls++
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{stakingProviderBytes, otherStakingProviderBytes},
+					}, data.BlockInfo{}, nil
+				}
+				if query.ScAddress == testLegacyDelegation {
+					return &vm.VMOutputApi{ReturnCode: "user error"}, data.BlockInfo{}, nil
+				}
+
+				switch query.FuncName {
+				case "getContractConfig":
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{ownerBytes, big.NewInt(1200).Bytes(), big.NewInt(5000).Bytes(), {}, []byte("true"), []byte("true")},
+					}, data.BlockInfo{}, nil
+				case "getMetaData":
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{[]byte("provider"), []byte("provider.com"), []byte("provider-id")},
+					}, data.BlockInfo{}, nil
+				case "getTotalActiveStake":
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{big.NewInt(4000).Bytes()}}, data.BlockInfo{}, nil
+				case "getNumUsers":
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{big.NewInt(12).Bytes()}}, data.BlockInfo{}, nil
+				case "getTotalStakedTopUpStakedBlsKeys":
+					if string(query.Arguments[0]) != string(stakingProviderBytes) {
+						return &vm.VMOutputApi{ReturnCode: "user error"}, data.BlockInfo{}, nil
+					}
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{big.NewInt(1500).Bytes(), big.NewInt(7500).Bytes(), big.NewInt(3).Bytes()},
+					}, data.BlockInfo{}, nil
+				default:
+					return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{}, nil
+				}
+			},
+		}
+		dp, _ := process.NewDelegationProcessor(scQueryStub, testPubkeyConverter, time.Minute)
+		response, err := dp.GetDelegationProviders()
+		require.NoError(t, err)
+		require.Equal(t, data.ReturnCodeSuccess, response.Code)
+
+		expectedProviders := []*data.DelegationProvider{
+			{
+				Contract:            testStakingProvider,
+				Owner:               testDelegatorAddress,
+				Name:                "provider",
+				Website:             "provider.com",
+				Identity:            "provider-id",
+				ServiceFee:          1200,
+				MaxDelegationCap:    "5000",
+				AutomaticActivation: true,
+				TotalActiveStake:    "4000",
+				NumDelegators:       12,
+				TotalStaked:         "7500",
+				TopUp:               "1500",
+				NumNodes:            3,
+			},
+			{
+				Contract:         testLegacyDelegation,
+				TotalActiveStake: "0",
+				TotalStaked:      "0",
+				TopUp:            "0",
+			},
+		}
+		require.Equal(t, expectedProviders, response.Data.Providers)
+
+		_, err = dp.GetDelegationProviders()
+		require.NoError(t, err)
+		require.Equal(t, 1, numGetAllContractAddressesCalls)
+	})
+}
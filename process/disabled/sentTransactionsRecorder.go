@@ -0,0 +1,14 @@
+package disabled
+
+// SentTransactionsRecorder represents a disabled struct that implements the SentTransactionsRecorder interface
+type SentTransactionsRecorder struct {
+}
+
+// RecordSentTransaction won't do anything as this is a disabled component
+func (recorder *SentTransactionsRecorder) RecordSentTransaction(_ string, _ string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (recorder *SentTransactionsRecorder) IsInterfaceNil() bool {
+	return recorder == nil
+}
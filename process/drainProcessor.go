@@ -0,0 +1,93 @@
+package process
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// DrainProcessor holds the maintenance (drain) state of the proxy. Once the drain is started, the write requests are
+// rejected, while the read requests are still served until the configured reads window elapses
+type DrainProcessor struct {
+	mutState       sync.RWMutex
+	readsWindow    time.Duration
+	drainStartTime time.Time
+	isDraining     bool
+	getTimeHandler func() time.Time
+}
+
+// NewDrainProcessor creates a new instance of DrainProcessor
+func NewDrainProcessor(readsWindow time.Duration) (*DrainProcessor, error) {
+	if readsWindow < 0 {
+		return nil, ErrInvalidDrainReadsWindow
+	}
+
+	return &DrainProcessor{
+		readsWindow:    readsWindow,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// StartDrain will switch the proxy in drain mode. Calling it while already draining will not restart the reads window
+func (dp *DrainProcessor) StartDrain() *data.DrainStatus {
+	dp.mutState.Lock()
+	if !dp.isDraining {
+		dp.isDraining = true
+		dp.drainStartTime = dp.getTimeHandler()
+		log.Info("proxy switched in drain mode, write requests will be rejected", "reads window", dp.readsWindow)
+	}
+	dp.mutState.Unlock()
+
+	return dp.GetDrainStatus()
+}
+
+// GetDrainStatus returns the current drain status
+func (dp *DrainProcessor) GetDrainStatus() *data.DrainStatus {
+	dp.mutState.RLock()
+	defer dp.mutState.RUnlock()
+
+	if !dp.isDraining {
+		return &data.DrainStatus{
+			AcceptsReads:  true,
+			AcceptsWrites: true,
+		}
+	}
+
+	return &data.DrainStatus{
+		IsDraining:              true,
+		DrainStartTimestamp:     dp.drainStartTime.Unix(),
+		ReadsWindowEndTimestamp: dp.drainStartTime.Add(dp.readsWindow).Unix(),
+		AcceptsReads:            dp.acceptsReads(),
+		AcceptsWrites:           false,
+	}
+}
+
+// IsWriteAllowed returns false if the proxy is draining
+func (dp *DrainProcessor) IsWriteAllowed() bool {
+	dp.mutState.RLock()
+	defer dp.mutState.RUnlock()
+
+	return !dp.isDraining
+}
+
+// IsReadAllowed returns false if the proxy is draining and the reads window elapsed
+func (dp *DrainProcessor) IsReadAllowed() bool {
+	dp.mutState.RLock()
+	defer dp.mutState.RUnlock()
+
+	return dp.acceptsReads()
+}
+
+func (dp *DrainProcessor) acceptsReads() bool {
+	if !dp.isDraining {
+		return true
+	}
+
+	return dp.getTimeHandler().Sub(dp.drainStartTime) < dp.readsWindow
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dp *DrainProcessor) IsInterfaceNil() bool {
+	return dp == nil
+}
@@ -0,0 +1,58 @@
+package process_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDrainProcessor(t *testing.T) {
+	t.Parallel()
+
+	dp, err := process.NewDrainProcessor(-time.Second)
+	require.True(t, check.IfNil(dp))
+	require.Equal(t, process.ErrInvalidDrainReadsWindow, err)
+
+	dp, err = process.NewDrainProcessor(0)
+	require.False(t, check.IfNil(dp))
+	require.NoError(t, err)
+}
+
+func TestDrainProcessor_StartDrain(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	dp, _ := process.NewDrainProcessor(30 * time.Second)
+	dp.SetGetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	require.Equal(t, &data.DrainStatus{AcceptsReads: true, AcceptsWrites: true}, dp.GetDrainStatus())
+	require.True(t, dp.IsWriteAllowed())
+	require.True(t, dp.IsReadAllowed())
+
+	expectedStatus := &data.DrainStatus{
+		IsDraining:              true,
+		DrainStartTimestamp:     1000,
+		ReadsWindowEndTimestamp: 1030,
+		AcceptsReads:            true,
+		AcceptsWrites:           false,
+	}
+	require.Equal(t, expectedStatus, dp.StartDrain())
+	require.False(t, dp.IsWriteAllowed())
+	require.True(t, dp.IsReadAllowed())
+
+	// starting the drain again should not restart the reads window
+	currentTime = time.Unix(1020, 0)
+	require.Equal(t, expectedStatus, dp.StartDrain())
+
+	currentTime = time.Unix(1030, 0)
+	expectedStatus.AcceptsReads = false
+	require.Equal(t, expectedStatus, dp.GetDrainStatus())
+	require.False(t, dp.IsWriteAllowed())
+	require.False(t, dp.IsReadAllowed())
+}
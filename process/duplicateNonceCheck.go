@@ -0,0 +1,66 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const nonceField = "nonce"
+
+// SetSenderAccountHandler enables the duplicate nonce check of the sent transactions, the provided component being
+// used to fetch the nonce of the senders
+func (tp *TransactionProcessor) SetSenderAccountHandler(handler SenderAccountHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilSenderAccountHandler
+	}
+
+	tp.senderAccountHandler = handler
+
+	return nil
+}
+
+// checkNonceNotUsed returns an error if the nonce of the transaction was already consumed by an executed transaction
+// of the sender, or if a transaction of the sender with the same nonce is already waiting in the pool. The last nonce
+// in the pool does not tell whether the lower nonces are present, so the pool of the sender is only fetched when the
+// nonce is not above it, allowing the transactions filling the nonce gaps. When the sender state can not be fetched,
+// the transaction is sent anyway
+func (tp *TransactionProcessor) checkNonceNotUsed(tx *data.Transaction) (int, error) {
+	if check.IfNil(tp.senderAccountHandler) {
+		return http.StatusOK, nil
+	}
+
+	account, err := tp.senderAccountHandler.GetAccount(context.Background(), tx.Sender, common.AccountQueryOptions{})
+	if err != nil {
+		log.Debug("duplicate nonce check: cannot get the sender account", "sender", tx.Sender, "error", err)
+		return http.StatusOK, nil
+	}
+	if tx.Nonce < account.Account.Nonce {
+		return http.StatusConflict, fmt.Errorf("%w: nonce %d is lower than the account nonce %d",
+			errors.ErrNonceAlreadyUsed, tx.Nonce, account.Account.Nonce)
+	}
+
+	lastPoolNonce, err := tp.getLastTxPoolNonceForSender(tx.Sender)
+	if err != nil || tx.Nonce > lastPoolNonce {
+		return http.StatusOK, nil
+	}
+
+	txsInPool, err := tp.getTxPoolForSender(tx.Sender, nonceField)
+	if err != nil || txsInPool == nil {
+		return http.StatusOK, nil
+	}
+	for _, txInPool := range txsInPool.Transactions {
+		poolNonce, ok := txInPool.TxFields[nonceField].(float64)
+		if ok && uint64(poolNonce) == tx.Nonce {
+			return http.StatusConflict, fmt.Errorf("%w: a transaction with nonce %d is already in the pool",
+				errors.ErrNonceAlreadyUsed, tx.Nonce)
+		}
+	}
+
+	return http.StatusOK, nil
+}
@@ -18,6 +18,11 @@ func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics() (*data.GenericAPIRespo
 	return nsp.economicMetricsCacher.Load()
 }
 
+// GetEconomicsDataMetricsHistory will return the last economic metrics samples, from the oldest to the newest one
+func (nsp *NodeStatusProcessor) GetEconomicsDataMetricsHistory() []*data.EconomicMetricsSample {
+	return nsp.economicsHistory.getAll()
+}
+
 func (nsp *NodeStatusProcessor) getEconomicsDataMetricsFromApi() (*data.GenericAPIResponse, error) {
 	metaObservers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
@@ -77,6 +82,15 @@ func (nsp *NodeStatusProcessor) StartCacheUpdate() {
 }
 
 func (nsp *NodeStatusProcessor) handleCacheUpdate(countConsecutiveFails *int) {
+	if !nsp.economicMetricsCacher.ShouldRefresh() {
+		// another proxy instance refreshes the shared cache, the history is still sampled out of the shared metrics
+		economicMetrics, err := nsp.economicMetricsCacher.Load()
+		if err == nil {
+			nsp.addEconomicsSample(economicMetrics)
+		}
+		return
+	}
+
 	economicMetrics, err := nsp.getEconomicsDataMetricsFromApi()
 	if err != nil {
 		*countConsecutiveFails++
@@ -90,9 +104,17 @@ func (nsp *NodeStatusProcessor) handleCacheUpdate(countConsecutiveFails *int) {
 	if economicMetrics != nil {
 		*countConsecutiveFails = 0
 		nsp.economicMetricsCacher.Store(economicMetrics)
+		nsp.addEconomicsSample(economicMetrics)
 	}
 }
 
+func (nsp *NodeStatusProcessor) addEconomicsSample(economicMetrics *data.GenericAPIResponse) {
+	nsp.economicsHistory.add(&data.EconomicMetricsSample{
+		Timestamp: nsp.getTimeHandler().Unix(),
+		Metrics:   economicMetrics.Data,
+	})
+}
+
 // Close will handle the closing of the cache update go routine
 func (nsp *NodeStatusProcessor) Close() error {
 	if nsp.cancelFunc != nil {
@@ -0,0 +1,55 @@
+package process
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// economicMetricsHistory is a fixed size ring buffer holding the last economics metrics samples
+type economicMetricsHistory struct {
+	mutSamples sync.RWMutex
+	samples    []*data.EconomicMetricsSample
+	nextIndex  int
+	isFull     bool
+}
+
+func newEconomicMetricsHistory(size int) *economicMetricsHistory {
+	return &economicMetricsHistory{
+		samples: make([]*data.EconomicMetricsSample, size),
+	}
+}
+
+// add stores the sample, overwriting the oldest one if the history is full
+func (emh *economicMetricsHistory) add(sample *data.EconomicMetricsSample) {
+	emh.mutSamples.Lock()
+	defer emh.mutSamples.Unlock()
+
+	if len(emh.samples) == 0 {
+		return
+	}
+
+	emh.samples[emh.nextIndex] = sample
+	emh.nextIndex = (emh.nextIndex + 1) % len(emh.samples)
+	if emh.nextIndex == 0 {
+		emh.isFull = true
+	}
+}
+
+// getAll returns the stored samples, from the oldest to the newest one
+func (emh *economicMetricsHistory) getAll() []*data.EconomicMetricsSample {
+	emh.mutSamples.RLock()
+	defer emh.mutSamples.RUnlock()
+
+	if !emh.isFull {
+		result := make([]*data.EconomicMetricsSample, emh.nextIndex)
+		copy(result, emh.samples[:emh.nextIndex])
+		return result
+	}
+
+	result := make([]*data.EconomicMetricsSample, 0, len(emh.samples))
+	result = append(result, emh.samples[emh.nextIndex:]...)
+	result = append(result, emh.samples[:emh.nextIndex]...)
+
+	return result
+}
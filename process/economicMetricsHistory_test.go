@@ -0,0 +1,89 @@
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEconomicMetricsHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero size should not store", func(t *testing.T) {
+		t.Parallel()
+
+		history := newEconomicMetricsHistory(0)
+		history.add(&data.EconomicMetricsSample{Timestamp: 1})
+		assert.Empty(t, history.getAll())
+	})
+	t.Run("should keep the last samples in order", func(t *testing.T) {
+		t.Parallel()
+
+		history := newEconomicMetricsHistory(3)
+		assert.Empty(t, history.getAll())
+
+		history.add(&data.EconomicMetricsSample{Timestamp: 1})
+		history.add(&data.EconomicMetricsSample{Timestamp: 2})
+		assert.Equal(t, []int64{1, 2}, getSamplesTimestamps(history.getAll()))
+
+		history.add(&data.EconomicMetricsSample{Timestamp: 3})
+		assert.Equal(t, []int64{1, 2, 3}, getSamplesTimestamps(history.getAll()))
+
+		history.add(&data.EconomicMetricsSample{Timestamp: 4})
+		history.add(&data.EconomicMetricsSample{Timestamp: 5})
+		assert.Equal(t, []int64{3, 4, 5}, getSamplesTimestamps(history.getAll()))
+	})
+}
+
+func getSamplesTimestamps(samples []*data.EconomicMetricsSample) []int64 {
+	timestamps := make([]int64, 0, len(samples))
+	for _, sample := range samples {
+		timestamps = append(timestamps, sample.Timestamp)
+	}
+
+	return timestamps
+}
+
+func TestNodeStatusProcessor_GetEconomicsDataMetricsHistory(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "meta"}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ string, _ string, value interface{}) (int, error) {
+			numCalls++
+			if numCalls == 2 {
+				return 0, errors.New("observer down")
+			}
+
+			response := value.(*data.GenericAPIResponse)
+			response.Data = map[string]interface{}{"erd_total_supply": numCalls}
+			return 200, nil
+		},
+	}, &mock.GenericApiResponseCacherMock{}, time.Second, 2, 0)
+	require.Nil(t, err)
+
+	currentTime := int64(100)
+	nodeStatusProc.getTimeHandler = func() time.Time {
+		currentTime += 10
+		return time.Unix(currentTime, 0)
+	}
+
+	countConsecutiveFails := 0
+	for i := 0; i < 4; i++ {
+		nodeStatusProc.handleCacheUpdate(&countConsecutiveFails)
+	}
+
+	expectedHistory := []*data.EconomicMetricsSample{
+		{Timestamp: 120, Metrics: map[string]interface{}{"erd_total_supply": 3}},
+		{Timestamp: 130, Metrics: map[string]interface{}{"erd_total_supply": 4}},
+	}
+	assert.Equal(t, expectedHistory, nodeStatusProc.GetEconomicsDataMetricsHistory())
+}
@@ -0,0 +1,233 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const (
+	// MetricRoundsPassedInCurrentEpoch is the metric holding the number of rounds passed since the start of the epoch
+	MetricRoundsPassedInCurrentEpoch = "erd_rounds_passed_in_current_epoch"
+
+	// MetricRoundsPerEpoch is the metric holding the number of rounds of an epoch
+	MetricRoundsPerEpoch = "erd_rounds_per_epoch"
+)
+
+// EpochChangeProcessor detects, out of the status metrics of the metachain, the window around the epoch change, while
+// the observers might fail the requests referencing the state of the previous or the next epoch. Within this window,
+// the calls towards the observers failing with an epoch transition error are retried after a short delay
+type EpochChangeProcessor struct {
+	isEnabled       bool
+	windowInRounds  uint64
+	checkInterval   time.Duration
+	retryDelay      time.Duration
+	maxRetries      int
+	errorMessages   []string
+	networkProvider EpochChangeNetworkProvider
+	isPending       bool
+	cancelFunc      func()
+	mutState        sync.RWMutex
+}
+
+// NewEpochChangeProcessor creates a new instance of EpochChangeProcessor
+func NewEpochChangeProcessor(epochChangeConfig config.EpochChangeConfig) (*EpochChangeProcessor, error) {
+	if epochChangeConfig.Enabled {
+		if epochChangeConfig.WindowInRounds == 0 {
+			return nil, fmt.Errorf("%w, WindowInRounds should be positive", ErrInvalidEpochChangeConfig)
+		}
+		if epochChangeConfig.CheckIntervalInMs <= 0 {
+			return nil, fmt.Errorf("%w, CheckIntervalInMs should be positive", ErrInvalidEpochChangeConfig)
+		}
+		if epochChangeConfig.RetryDelayInMs <= 0 {
+			return nil, fmt.Errorf("%w, RetryDelayInMs should be positive", ErrInvalidEpochChangeConfig)
+		}
+		if epochChangeConfig.MaxRetries < 0 {
+			return nil, fmt.Errorf("%w, MaxRetries should not be negative", ErrInvalidEpochChangeConfig)
+		}
+		if epochChangeConfig.RetryAfterInSec <= 0 {
+			return nil, fmt.Errorf("%w, RetryAfterInSec should be positive", ErrInvalidEpochChangeConfig)
+		}
+		if len(epochChangeConfig.ErrorMessages) == 0 {
+			return nil, fmt.Errorf("%w, at least one error message should be provided", ErrInvalidEpochChangeConfig)
+		}
+	}
+
+	errorMessages := make([]string, 0, len(epochChangeConfig.ErrorMessages))
+	for _, message := range epochChangeConfig.ErrorMessages {
+		errorMessages = append(errorMessages, strings.ToLower(message))
+	}
+
+	return &EpochChangeProcessor{
+		isEnabled:      epochChangeConfig.Enabled,
+		windowInRounds: epochChangeConfig.WindowInRounds,
+		checkInterval:  time.Duration(epochChangeConfig.CheckIntervalInMs) * time.Millisecond,
+		retryDelay:     time.Duration(epochChangeConfig.RetryDelayInMs) * time.Millisecond,
+		maxRetries:     epochChangeConfig.MaxRetries,
+		errorMessages:  errorMessages,
+	}, nil
+}
+
+// SetNetworkProvider sets the source of the status metrics of the metachain. Until set, no epoch change is detected
+func (ecp *EpochChangeProcessor) SetNetworkProvider(networkProvider EpochChangeNetworkProvider) error {
+	if networkProvider == nil {
+		return ErrNilEpochChangeNetworkProvider
+	}
+
+	ecp.mutState.Lock()
+	ecp.networkProvider = networkProvider
+	ecp.mutState.Unlock()
+
+	return nil
+}
+
+// Start periodically checks in background whether the epoch change is pending. If disabled, no epoch change is ever
+// reported
+func (ecp *EpochChangeProcessor) Start() {
+	if !ecp.isEnabled {
+		return
+	}
+
+	var ctx context.Context
+	ecp.mutState.Lock()
+	if ecp.cancelFunc != nil {
+		ecp.mutState.Unlock()
+		log.Error("EpochChangeProcessor - already started")
+		return
+	}
+	ctx, ecp.cancelFunc = context.WithCancel(context.Background())
+	ecp.mutState.Unlock()
+
+	go ecp.checkEpochChangeContinuously(ctx)
+}
+
+func (ecp *EpochChangeProcessor) checkEpochChangeContinuously(ctx context.Context) {
+	timer := time.NewTimer(ecp.checkInterval)
+	defer timer.Stop()
+
+	for {
+		ecp.checkEpochChange()
+
+		timer.Reset(ecp.checkInterval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			log.Debug("finishing EpochChangeProcessor checks...")
+			return
+		}
+	}
+}
+
+// checkEpochChange marks the epoch change as pending if the metachain is within the configured number of rounds from
+// the end or from the start of its epoch
+func (ecp *EpochChangeProcessor) checkEpochChange() {
+	ecp.mutState.RLock()
+	networkProvider := ecp.networkProvider
+	ecp.mutState.RUnlock()
+	if networkProvider == nil {
+		return
+	}
+
+	response, err := networkProvider.GetNetworkStatusMetrics(core.MetachainShardId)
+	if err != nil {
+		log.Debug("EpochChangeProcessor: cannot get the status metrics of the metachain", "error", err.Error())
+		return
+	}
+
+	roundsPassed, okPassed := getNetworkMetric(response.Data, networkStatusKey, MetricRoundsPassedInCurrentEpoch)
+	roundsPerEpoch, okPerEpoch := getNetworkMetric(response.Data, networkStatusKey, MetricRoundsPerEpoch)
+	if !okPassed || !okPerEpoch {
+		log.Debug("EpochChangeProcessor: missing epoch metrics in the status of the metachain")
+		return
+	}
+
+	isPending := ecp.isWithinEpochChangeWindow(getUint(roundsPassed), getUint(roundsPerEpoch))
+
+	ecp.mutState.Lock()
+	defer ecp.mutState.Unlock()
+
+	if isPending != ecp.isPending {
+		log.Info("epoch change window", "pending", isPending, "rounds passed in epoch", getUint(roundsPassed))
+	}
+	ecp.isPending = isPending
+}
+
+func (ecp *EpochChangeProcessor) isWithinEpochChangeWindow(roundsPassed uint64, roundsPerEpoch uint64) bool {
+	if roundsPassed < ecp.windowInRounds {
+		return true
+	}
+
+	return roundsPerEpoch > 0 && roundsPassed+ecp.windowInRounds >= roundsPerEpoch
+}
+
+// IsEpochChangePending returns true if the network is within the window around the epoch change
+func (ecp *EpochChangeProcessor) IsEpochChangePending() bool {
+	ecp.mutState.RLock()
+	defer ecp.mutState.RUnlock()
+
+	return ecp.isPending
+}
+
+// isEpochTransitionError returns true if the error reported by an observer is caused by the epoch transition
+func (ecp *EpochChangeProcessor) isEpochTransitionError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, errorMessage := range ecp.errorMessages {
+		if strings.Contains(message, errorMessage) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// callWithRetries runs the call towards an observer. Within the epoch change window, the call failing with an epoch
+// transition error is retried after the configured delay, at most the configured number of times. The retries stop
+// as soon as the context of the request is done
+func (ecp *EpochChangeProcessor) callWithRetries(ctx context.Context, call func() (int, error)) (int, error) {
+	statusCode, err := call()
+	if ecp == nil || !ecp.isEnabled {
+		return statusCode, err
+	}
+
+	for attempt := 0; attempt < ecp.maxRetries; attempt++ {
+		if err == nil || !ecp.IsEpochChangePending() || !ecp.isEpochTransitionError(err) {
+			return statusCode, err
+		}
+
+		log.Debug("retrying the observer call failed during the epoch change", "attempt", attempt+1, "error", err.Error())
+		timer := time.NewTimer(ecp.retryDelay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return statusCode, err
+		}
+
+		statusCode, err = call()
+	}
+
+	return statusCode, err
+}
+
+// Close stops the epoch change checks
+func (ecp *EpochChangeProcessor) Close() error {
+	ecp.mutState.RLock()
+	cancelFunc := ecp.cancelFunc
+	ecp.mutState.RUnlock()
+
+	if cancelFunc != nil {
+		cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ecp *EpochChangeProcessor) IsInterfaceNil() bool {
+	return ecp == nil
+}
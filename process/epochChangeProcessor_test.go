@@ -0,0 +1,173 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+type epochChangeNetworkProviderStub struct {
+	roundsPassed   float64
+	roundsPerEpoch float64
+}
+
+func (stub *epochChangeNetworkProviderStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+	if shardID != core.MetachainShardId {
+		return nil, errors.New("unexpected shard")
+	}
+
+	return &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			networkStatusKey: map[string]interface{}{
+				MetricRoundsPassedInCurrentEpoch: stub.roundsPassed,
+				MetricRoundsPerEpoch:             stub.roundsPerEpoch,
+			},
+		},
+	}, nil
+}
+
+func createEpochChangeConfig() config.EpochChangeConfig {
+	return config.EpochChangeConfig{
+		Enabled:           true,
+		WindowInRounds:    5,
+		CheckIntervalInMs: 100,
+		RetryDelayInMs:    1,
+		MaxRetries:        2,
+		RetryAfterInSec:   6,
+		ErrorMessages:     []string{"Old Epoch"},
+	}
+}
+
+func TestNewEpochChangeProcessor(t *testing.T) {
+	t.Parallel()
+
+	testInvalidConfig := func(modifier func(cfg *config.EpochChangeConfig)) {
+		cfg := createEpochChangeConfig()
+		modifier(&cfg)
+		ecp, err := NewEpochChangeProcessor(cfg)
+		require.Nil(t, ecp)
+		require.True(t, errors.Is(err, ErrInvalidEpochChangeConfig))
+	}
+
+	testInvalidConfig(func(cfg *config.EpochChangeConfig) { cfg.WindowInRounds = 0 })
+	testInvalidConfig(func(cfg *config.EpochChangeConfig) { cfg.CheckIntervalInMs = 0 })
+	testInvalidConfig(func(cfg *config.EpochChangeConfig) { cfg.RetryDelayInMs = 0 })
+	testInvalidConfig(func(cfg *config.EpochChangeConfig) { cfg.MaxRetries = -1 })
+	testInvalidConfig(func(cfg *config.EpochChangeConfig) { cfg.RetryAfterInSec = 0 })
+	testInvalidConfig(func(cfg *config.EpochChangeConfig) { cfg.ErrorMessages = nil })
+
+	ecp, err := NewEpochChangeProcessor(config.EpochChangeConfig{})
+	require.NoError(t, err)
+	require.False(t, ecp.IsInterfaceNil())
+	require.Equal(t, ErrNilEpochChangeNetworkProvider, ecp.SetNetworkProvider(nil))
+}
+
+func TestEpochChangeProcessor_IsEpochChangePending(t *testing.T) {
+	t.Parallel()
+
+	ecp, _ := NewEpochChangeProcessor(createEpochChangeConfig())
+	networkProvider := &epochChangeNetworkProviderStub{roundsPerEpoch: 100}
+	_ = ecp.SetNetworkProvider(networkProvider)
+
+	checkPending := func(roundsPassed float64, expectedPending bool) {
+		networkProvider.roundsPassed = roundsPassed
+		ecp.checkEpochChange()
+		require.Equal(t, expectedPending, ecp.IsEpochChangePending())
+	}
+
+	checkPending(0, true)
+	checkPending(4, true)
+	checkPending(5, false)
+	checkPending(94, false)
+	checkPending(95, true)
+}
+
+func TestEpochChangeProcessor_CallWithRetries(t *testing.T) {
+	t.Parallel()
+
+	errOldEpoch := errors.New("getting account failed: OLD EPOCH requested")
+	errOther := errors.New("other error")
+
+	createCall := func(errs ...error) (func() (int, error), *int) {
+		numCalls := 0
+		return func() (int, error) {
+			numCalls++
+			if numCalls > len(errs) || errs[numCalls-1] == nil {
+				return http.StatusOK, nil
+			}
+
+			return http.StatusInternalServerError, errs[numCalls-1]
+		}, &numCalls
+	}
+
+	t.Run("nil processor should call once", func(t *testing.T) {
+		t.Parallel()
+
+		var ecp *EpochChangeProcessor
+		call, numCalls := createCall(errOldEpoch)
+		statusCode, err := ecp.callWithRetries(context.Background(), call)
+		require.Equal(t, errOldEpoch, err)
+		require.Equal(t, http.StatusInternalServerError, statusCode)
+		require.Equal(t, 1, *numCalls)
+	})
+	t.Run("outside the window should not retry", func(t *testing.T) {
+		t.Parallel()
+
+		ecp, _ := NewEpochChangeProcessor(createEpochChangeConfig())
+		call, numCalls := createCall(errOldEpoch)
+		_, err := ecp.callWithRetries(context.Background(), call)
+		require.Equal(t, errOldEpoch, err)
+		require.Equal(t, 1, *numCalls)
+	})
+	t.Run("other errors should not be retried", func(t *testing.T) {
+		t.Parallel()
+
+		ecp, _ := NewEpochChangeProcessor(createEpochChangeConfig())
+		ecp.isPending = true
+		call, numCalls := createCall(errOther)
+		_, err := ecp.callWithRetries(context.Background(), call)
+		require.Equal(t, errOther, err)
+		require.Equal(t, 1, *numCalls)
+	})
+	t.Run("epoch transition errors should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		ecp, _ := NewEpochChangeProcessor(createEpochChangeConfig())
+		ecp.isPending = true
+		call, numCalls := createCall(errOldEpoch, errOldEpoch)
+		statusCode, err := ecp.callWithRetries(context.Background(), call)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, 3, *numCalls)
+	})
+	t.Run("retries should be limited", func(t *testing.T) {
+		t.Parallel()
+
+		ecp, _ := NewEpochChangeProcessor(createEpochChangeConfig())
+		ecp.isPending = true
+		call, numCalls := createCall(errOldEpoch, errOldEpoch, errOldEpoch)
+		_, err := ecp.callWithRetries(context.Background(), call)
+		require.Equal(t, errOldEpoch, err)
+		require.Equal(t, 3, *numCalls)
+	})
+	t.Run("canceled context should stop the retries", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createEpochChangeConfig()
+		cfg.RetryDelayInMs = 10000
+		ecp, _ := NewEpochChangeProcessor(cfg)
+		ecp.isPending = true
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		call, numCalls := createCall(errOldEpoch)
+		_, err := ecp.callWithRetries(ctx, call)
+		require.Equal(t, errOldEpoch, err)
+		require.Equal(t, 1, *numCalls)
+	})
+}
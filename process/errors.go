@@ -11,6 +11,9 @@ var ErrSendingRequest = errors.New("sending request error")
 // ErrNilShardCoordinator signals that a nil shard coordinator has been provided
 var ErrNilShardCoordinator = errors.New("nil shard coordinator")
 
+// ErrInvalidObserversHttpClientConfig signals that an invalid observers http client configuration has been provided
+var ErrInvalidObserversHttpClientConfig = errors.New("invalid observers http client config")
+
 // ErrInvalidRequestTimeout signals that the provided number of seconds before timeout is invalid
 var ErrInvalidRequestTimeout = errors.New("invalid duration until timeout for requests")
 
@@ -38,6 +41,9 @@ var ErrNilEconomicMetricsCacher = errors.New("nil economic metrics cacher")
 // ErrValidatorStatisticsNotAvailable signals that the validator statistics data is not found
 var ErrValidatorStatisticsNotAvailable = errors.New("validator statistics data not found on any observer")
 
+// ErrValidatorNotFound signals that the validator statistics do not hold the requested BLS key
+var ErrValidatorNotFound = errors.New("validator not found in the validator statistics")
+
 // ErrAuctionListNotAvailable signals that the auction list data is not found
 var ErrAuctionListNotAvailable = errors.New("auction list data not found on any observer")
 
@@ -112,3 +118,370 @@ var ErrEmptyPubKey = errors.New("public key is empty")
 
 // ErrNilHttpClient signals that a nil http client has been provided
 var ErrNilHttpClient = errors.New("nil http client")
+
+// ErrBlockNotFoundInAnyShard signals that no shard was able to provide the requested block
+var ErrBlockNotFoundInAnyShard = errors.New("block not found in any shard")
+
+// ErrResponseSigningNotEnabled signals that the response signing is not enabled
+var ErrResponseSigningNotEnabled = errors.New("response signing is not enabled")
+
+// ErrEmptyUpstreamProxyAddress signals that an empty upstream proxy address has been provided
+var ErrEmptyUpstreamProxyAddress = errors.New("empty upstream proxy address")
+
+// ErrTooManyStakingProviders signals that too many staking providers have been provided
+var ErrTooManyStakingProviders = errors.New("too many staking providers")
+
+// ErrInvalidSendTransactionQuorum signals that an invalid send transaction quorum configuration has been provided
+var ErrInvalidSendTransactionQuorum = errors.New("invalid send transaction quorum config")
+
+// ErrInvalidQuorumReadsConfig signals that an invalid quorum reads configuration has been provided
+var ErrInvalidQuorumReadsConfig = errors.New("invalid quorum reads config")
+
+// ErrQuorumReadsNotEnabled signals that a quorum read has been requested while the quorum reads are not enabled
+var ErrQuorumReadsNotEnabled = errors.New("quorum reads are not enabled")
+
+// ErrQuorumReadNotReached signals that not enough observers agreed on the read value
+var ErrQuorumReadNotReached = errors.New("quorum read not reached")
+
+// ErrSendTransactionQuorumNotReached signals that not enough observers accepted the transaction
+var ErrSendTransactionQuorumNotReached = errors.New("send transaction quorum not reached")
+
+// ErrObserverResponseNotOk signals that the observer responded with a status code different than ok
+var ErrObserverResponseNotOk = errors.New("observer response not ok")
+
+// ErrObserverSaturated signals that the maximum number of requests in progress towards the observer has been reached
+var ErrObserverSaturated = errors.New("observer saturated")
+
+// ErrInvalidShadowTrafficConfig signals that an invalid shadow traffic configuration has been provided
+var ErrInvalidShadowTrafficConfig = errors.New("invalid shadow traffic config")
+
+// ErrInvalidESDTTypeFilter signals that an invalid ESDT type filter has been provided
+var ErrInvalidESDTTypeFilter = errors.New("invalid ESDT type filter, accepted values: fungible, nft, sft, meta")
+
+// ErrInvalidDrainReadsWindow signals that an invalid reads window has been provided for the drain mode
+var ErrInvalidDrainReadsWindow = errors.New("invalid drain reads window")
+
+// ErrNilTransactionsHistoryConnector signals that a nil transactions history connector has been provided
+var ErrNilTransactionsHistoryConnector = errors.New("nil transactions history connector")
+
+// ErrNilCollectionsConnector signals that a nil collections connector has been provided
+var ErrNilCollectionsConnector = errors.New("nil collections connector")
+
+// ErrInvalidCollectionIdentifier signals that an invalid collection identifier has been provided
+var ErrInvalidCollectionIdentifier = errors.New("invalid collection identifier")
+
+// ErrCollectionNFTsWindowTooLarge signals that the requested page exceeds the maximum window of the collection NFTs
+var ErrCollectionNFTsWindowTooLarge = errors.New("requested page exceeds the maximum window of the collection NFTs")
+
+// ErrTransactionsHistoryWindowTooLarge signals that the requested page exceeds the maximum transactions history window
+var ErrTransactionsHistoryWindowTooLarge = errors.New("requested page exceeds the maximum transactions history window")
+
+// ErrInvalidTransactionsHistoryTimeRange signals that the provided after timestamp is greater than the before timestamp
+var ErrInvalidTransactionsHistoryTimeRange = errors.New("the after timestamp must not be greater than the before timestamp")
+
+// ErrNilAccountProcessor signals that a nil account processor has been provided
+var ErrNilAccountProcessor = errors.New("nil account processor")
+
+// ErrNilTransactionProcessor signals that a nil transaction processor has been provided
+var ErrNilTransactionProcessor = errors.New("nil transaction processor")
+
+// ErrNoManagedSenders signals that no managed sender could be loaded
+var ErrNoManagedSenders = errors.New("no managed senders loaded")
+
+// ErrSenderNotManaged signals that the sender of the transaction is not one of the managed senders
+var ErrSenderNotManaged = errors.New("the sender is not managed by the proxy")
+
+// ErrInvalidNonceManagerConfig signals that an invalid nonce manager configuration has been provided
+var ErrInvalidNonceManagerConfig = errors.New("invalid nonce manager config")
+
+// ErrInvalidNonceReservation signals that an invalid nonce reservation request has been provided
+var ErrInvalidNonceReservation = errors.New("invalid nonce reservation")
+
+// ErrNonceReservationNotFound signals that the nonce reservation was not found, either released or expired
+var ErrNonceReservationNotFound = errors.New("nonce reservation not found")
+
+// ErrUnsupportedManagedTransaction signals that the managed transaction uses fields that the nonce manager cannot sign
+var ErrUnsupportedManagedTransaction = errors.New("managed transactions cannot be guarded, relayed or have options set")
+
+// ErrFaultInjectionNotEnabled signals that the fault injection mode is not enabled
+var ErrFaultInjectionNotEnabled = errors.New("fault injection not enabled")
+
+// ErrInvalidFaultInjectionScenario signals that an invalid fault injection scenario has been provided
+var ErrInvalidFaultInjectionScenario = errors.New("invalid fault injection scenario")
+
+// ErrNilFaultInjectionProcessor signals that a nil fault injection processor has been provided
+var ErrNilFaultInjectionProcessor = errors.New("nil fault injection processor")
+
+// ErrInvalidTransactionsPolicy signals that an invalid transactions policy has been provided
+var ErrInvalidTransactionsPolicy = errors.New("invalid transactions policy")
+
+// ErrTransactionDeniedByPolicy signals that the transaction is not allowed by the transactions policy of the proxy
+var ErrTransactionDeniedByPolicy = errors.New("transaction denied by the proxy policy")
+
+// ErrInvalidEconomicsHistorySize signals that an invalid economics metrics history size has been provided
+var ErrInvalidEconomicsHistorySize = errors.New("invalid economics metrics history size")
+
+// ErrInvalidShardForRawRequest signals that the raw request targets an unknown shard
+var ErrInvalidShardForRawRequest = errors.New("invalid shard for the raw request")
+
+// ErrInvalidWarmUpConfig signals that an invalid warm-up configuration has been provided
+var ErrInvalidWarmUpConfig = errors.New("invalid warm-up config")
+
+// ErrNilWarmUpTaskHandler signals that a nil warm-up task handler has been provided
+var ErrNilWarmUpTaskHandler = errors.New("nil warm-up task handler")
+
+// ErrWarmUpAlreadyStarted signals that a warm-up task has been added after the warm-up was started
+var ErrWarmUpAlreadyStarted = errors.New("warm-up already started")
+
+// ErrNilWarmUpStatusHandler signals that a nil warm-up status handler has been provided
+var ErrNilWarmUpStatusHandler = errors.New("nil warm-up status handler")
+
+// ErrNilObserversHealthHandler signals that a nil observers health handler has been provided
+var ErrNilObserversHealthHandler = errors.New("nil observers health handler")
+
+// ErrInvalidMaxObserverSilence signals that an invalid maximum observer silence has been provided
+var ErrInvalidMaxObserverSilence = errors.New("invalid maximum observer silence")
+
+// ErrInvalidTopologySnapshotConfig signals that an invalid topology snapshot configuration has been provided
+var ErrInvalidTopologySnapshotConfig = errors.New("invalid topology snapshot config")
+
+// ErrTopologySnapshotTooOld signals that the topology snapshot found on disk is too old to be restored
+var ErrTopologySnapshotTooOld = errors.New("topology snapshot too old")
+
+// ErrTopologySnapshotMismatch signals that the topology snapshot found on disk was taken for another network layout
+var ErrTopologySnapshotMismatch = errors.New("topology snapshot mismatch")
+
+// ErrInvalidWebhooksConfig signals that an invalid webhooks configuration has been provided
+var ErrInvalidWebhooksConfig = errors.New("invalid webhooks config")
+
+// ErrUnknownWebhook signals that the provided webhook is not defined in the configuration
+var ErrUnknownWebhook = errors.New("unknown webhook")
+
+// ErrTooManyWatchedTransactions signals that the maximum number of watched transactions has been reached
+var ErrTooManyWatchedTransactions = errors.New("too many watched transactions")
+
+// ErrInvalidWatchedTransactionHash signals that an invalid transaction hash has been provided for watching
+var ErrInvalidWatchedTransactionHash = errors.New("invalid transaction hash")
+
+// ErrAddressWatchlistsNotEnabled signals that the address watchlists are not enabled
+var ErrAddressWatchlistsNotEnabled = errors.New("address watchlists not enabled")
+
+// ErrTooManyWatchedAddresses signals that the maximum number of watched addresses has been reached
+var ErrTooManyWatchedAddresses = errors.New("too many watched addresses")
+
+// ErrNoWatchedAddressProvided signals that no address has been provided for watching
+var ErrNoWatchedAddressProvided = errors.New("no address provided")
+
+// ErrAddressNotWatched signals that the provided address is not on the watchlist of the webhook
+var ErrAddressNotWatched = errors.New("address not watched")
+
+// ErrNilWatchedAccountsHandler signals that a nil watched accounts handler has been provided
+var ErrNilWatchedAccountsHandler = errors.New("nil watched accounts handler")
+
+// ErrNilHyperblockNonceHandler signals that a nil hyperblock nonce handler has been provided
+var ErrNilHyperblockNonceHandler = errors.New("nil hyperblock nonce handler")
+
+// ErrInvalidObserversFeedConfig signals that an invalid observers feed configuration has been provided
+var ErrInvalidObserversFeedConfig = errors.New("invalid observers feed config")
+
+// ErrUnknownFeedHeaderType signals that a block of an unknown header type has been received through the observers feed
+var ErrUnknownFeedHeaderType = errors.New("unknown header type received through the observers feed")
+
+// ErrMissingFeedBlockData signals that a block without block data has been received through the observers feed
+var ErrMissingFeedBlockData = errors.New("missing block data in the observers feed payload")
+
+// ErrInvalidTransactionStatusWatcherConfig signals that an invalid transaction status watcher configuration has been provided
+var ErrInvalidTransactionStatusWatcherConfig = errors.New("invalid transaction status watcher config")
+
+// ErrNilTransactionStatusWatcher signals that a nil transaction status watcher has been provided
+var ErrNilTransactionStatusWatcher = errors.New("nil transaction status watcher")
+
+// ErrNilTransactionStatusSink signals that a nil transaction status sink has been provided
+var ErrNilTransactionStatusSink = errors.New("nil transaction status sink")
+
+// ErrTooManyStreamedTransactions signals that more transactions than allowed have been provided for a status stream
+var ErrTooManyStreamedTransactions = errors.New("too many streamed transactions")
+
+// ErrNilTransactionsFeedHandler signals that a nil transactions feed handler has been provided
+var ErrNilTransactionsFeedHandler = errors.New("nil transactions feed handler")
+
+// ErrInvalidProofResponse signals that the observer replied with a malformed Merkle proof response
+var ErrInvalidProofResponse = errors.New("invalid proof response")
+
+// ErrProofVerificationFailed signals that the Merkle proof returned by the observer does not match the root hash
+var ErrProofVerificationFailed = errors.New("proof verification failed")
+
+// ErrInvalidObserversCapabilitiesConfig signals that an invalid observers capabilities configuration has been provided
+var ErrInvalidObserversCapabilitiesConfig = errors.New("invalid observers capabilities config")
+
+// ErrInvalidRawTransaction signals that the provided transaction bytes cannot be unmarshaled
+var ErrInvalidRawTransaction = errors.New("invalid raw transaction")
+
+// ErrNonCanonicalRawTransaction signals that the provided transaction bytes differ from the canonical marshaling of the
+// transaction, so the relayed transaction would have a different hash
+var ErrNonCanonicalRawTransaction = errors.New("the raw transaction bytes are not canonical")
+
+// ErrProtobufNotSupportedForTransactionType signals that the protocol representation of a transaction was requested
+// for a transaction type that is not a regular transaction
+var ErrProtobufNotSupportedForTransactionType = errors.New("protobuf representation not supported")
+
+// ErrInvalidRawBlockResponse signals that the observer replied with a malformed raw block
+var ErrInvalidRawBlockResponse = errors.New("invalid raw block response")
+
+// ErrTransactionNotAccepted signals that a transaction was not accepted by any of the observers of its shard
+var ErrTransactionNotAccepted = errors.New("transaction not accepted by the observers")
+
+// ErrNilRuntimeConfigHandler signals that a nil runtime config handler has been provided
+var ErrNilRuntimeConfigHandler = errors.New("nil runtime config handler")
+
+// ErrNilObserversReloader signals that a nil observers reloader has been provided
+var ErrNilObserversReloader = errors.New("nil observers reloader")
+
+// ErrInvalidReloadedConfig signals that the reloaded main config file is not valid, so it was not applied
+var ErrInvalidReloadedConfig = errors.New("invalid reloaded config")
+
+// ErrInvalidObserverTLSConfig signals that an invalid observer TLS configuration has been provided
+var ErrInvalidObserverTLSConfig = errors.New("invalid observer TLS config")
+
+// ErrInvalidObserverAuthConfig signals that an invalid observer authentication configuration has been provided
+var ErrInvalidObserverAuthConfig = errors.New("invalid observer auth config")
+
+// ErrObserverTLSRequired signals that an observer configured with TLS was about to be called over plain HTTP
+var ErrObserverTLSRequired = errors.New("observer requires TLS, only https:// calls are allowed")
+
+// ErrInvalidTransactionWaitConfig signals that an invalid transaction wait configuration has been provided
+var ErrInvalidTransactionWaitConfig = errors.New("invalid transaction wait config")
+
+// ErrInvalidHedgedRequestsConfig signals that an invalid hedged requests configuration has been provided
+var ErrInvalidHedgedRequestsConfig = errors.New("invalid hedged requests config")
+
+// ErrInvalidResponseSizeLimitsConfig signals that an invalid response size limits configuration has been provided
+var ErrInvalidResponseSizeLimitsConfig = errors.New("invalid response size limits config")
+
+// ErrInvalidObserversConsistencyConfig signals that an invalid observers consistency configuration has been provided
+var ErrInvalidObserversConsistencyConfig = errors.New("invalid observers consistency config")
+
+// ErrInvalidSCQueryCacheConfig signals that an invalid smart contract query cache configuration has been provided
+var ErrInvalidSCQueryCacheConfig = errors.New("invalid SC query cache config")
+
+// ErrInvalidNumShardsReceived signals that an invalid number of shards has been received from the observers
+var ErrInvalidNumShardsReceived = errors.New("invalid number of shards received")
+
+// ErrInvalidESDTSupplyHistoryConfig signals that an invalid ESDT supply history configuration has been provided
+var ErrInvalidESDTSupplyHistoryConfig = errors.New("invalid ESDT supply history config")
+
+// ErrESDTSupplyHistoryDisabled signals that the ESDT supply history is disabled
+var ErrESDTSupplyHistoryDisabled = errors.New("the ESDT supply history is disabled")
+
+// ErrTooManyTrackedTokens signals that the maximum number of tokens whose supply history is tracked has been reached
+var ErrTooManyTrackedTokens = errors.New("the maximum number of tracked tokens has been reached")
+
+// ErrObserverResponseTooLarge signals that the observer response exceeds the maximum size allowed for its endpoint
+var ErrObserverResponseTooLarge = errors.New("observer response too large")
+
+// ErrInvalidBridgeConfig signals that an invalid bridge configuration has been provided
+var ErrInvalidBridgeConfig = errors.New("invalid bridge config")
+
+// ErrBridgeNotConfigured signals that no bridge safe contract was configured
+var ErrBridgeNotConfigured = errors.New("no bridge safe contract configured")
+
+// ErrInvalidAuditLogConfig signals that an invalid audit log config has been provided
+var ErrInvalidAuditLogConfig = errors.New("invalid audit log config")
+
+// ErrNilSentTransactionsRecorder signals that a nil sent transactions recorder has been provided
+var ErrNilSentTransactionsRecorder = errors.New("nil sent transactions recorder")
+
+// ErrNilConsensusBlocksProvider signals that a nil consensus blocks provider has been provided
+var ErrNilConsensusBlocksProvider = errors.New("nil consensus blocks provider")
+
+// ErrNilConsensusNetworkProvider signals that a nil consensus network provider has been provided
+var ErrNilConsensusNetworkProvider = errors.New("nil consensus network provider")
+
+// ErrInvalidConsensusRound signals that the consensus group of the provided round cannot be resolved
+var ErrInvalidConsensusRound = errors.New("invalid consensus round")
+
+// ErrConsensusRoundNotReached signals that the observers did not yet produce a block after the provided round
+var ErrConsensusRoundNotReached = errors.New("the round was not reached yet")
+
+// ErrInsufficientGasLimit signals that the gas limit of a transaction does not cover its move balance gas
+var ErrInsufficientGasLimit = errors.New("insufficient gas limit")
+
+// ErrHigherGasLimitThanAllowed signals that the gas limit of a transaction exceeds the maximum gas per transaction
+var ErrHigherGasLimitThanAllowed = errors.New("higher gas limit than allowed")
+
+// ErrInsufficientGasPrice signals that the gas price of a transaction is lower than the minimum gas price
+var ErrInsufficientGasPrice = errors.New("insufficient gas price")
+
+// ErrInvalidGasPriceModifier signals that the gas price modifier of the network config is not valid
+var ErrInvalidGasPriceModifier = errors.New("invalid gas price modifier")
+
+// ErrCannotComputeConsensusGroup signals that the consensus group of a round cannot be computed from the data of the
+// observers
+var ErrCannotComputeConsensusGroup = errors.New("cannot compute the consensus group")
+
+// ErrInvalidLoadSheddingConfig signals that an invalid load shedding configuration has been provided
+var ErrInvalidLoadSheddingConfig = errors.New("invalid load shedding config")
+
+// ErrNilReadinessStatusHandler signals that a nil readiness status handler has been provided
+var ErrNilReadinessStatusHandler = errors.New("nil readiness status handler")
+
+// ErrNilAddressShardComputer signals that a nil address shard computer has been provided
+var ErrNilAddressShardComputer = errors.New("nil address shard computer")
+
+// ErrInvalidAccessLogConfig signals that an invalid access log configuration has been provided
+var ErrInvalidAccessLogConfig = errors.New("invalid access log config")
+
+// ErrNilHeartbeatsProvider signals that a nil heartbeats provider has been provided
+var ErrNilHeartbeatsProvider = errors.New("nil heartbeats provider")
+
+// ErrNilSenderAccountHandler signals that a nil sender account handler has been provided
+var ErrNilSenderAccountHandler = errors.New("nil sender account handler")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilBlocksNetworkProvider signals that a nil blocks network provider has been provided
+var ErrNilBlocksNetworkProvider = errors.New("nil blocks network provider")
+
+// ErrInvalidBlockTimestamp signals that no block can be resolved for the provided timestamp
+var ErrInvalidBlockTimestamp = errors.New("invalid block timestamp")
+
+// ErrNilSharedCacheStore signals that a nil shared cache store has been provided
+var ErrNilSharedCacheStore = errors.New("nil shared cache store")
+
+// ErrNoAddressesToConvert signals that no address to be converted has been provided
+var ErrNoAddressesToConvert = errors.New("no addresses to convert")
+
+// ErrTooManyAddressesToConvert signals that too many addresses to be converted have been provided
+var ErrTooManyAddressesToConvert = errors.New("too many addresses to convert")
+
+// ErrInvalidClientStatsConfig signals that the client statistics config is invalid
+var ErrInvalidClientStatsConfig = errors.New("invalid client statistics config")
+
+// ErrClientStatsNotEnabled signals that the client statistics are not enabled
+var ErrClientStatsNotEnabled = errors.New("client statistics are not enabled")
+
+// ErrInvalidSCQueryGuardConfig signals that the smart contract queries guard config is invalid
+var ErrInvalidSCQueryGuardConfig = errors.New("invalid smart contract queries guard config")
+
+// ErrSCQueryResponseTooLarge signals that the result of the smart contract query exceeds the maximum allowed size
+var ErrSCQueryResponseTooLarge = errors.New("smart contract query response too large")
+
+// ErrInvalidHyperblockCacheConfig signals that an invalid hyperblocks cache configuration has been provided
+var ErrInvalidHyperblockCacheConfig = errors.New("invalid hyperblock cache config")
+
+// ErrInvalidEpochChangeConfig signals that an invalid epoch change configuration has been provided
+var ErrInvalidEpochChangeConfig = errors.New("invalid epoch change config")
+
+// ErrNilEpochChangeNetworkProvider signals that a nil epoch change network provider has been provided
+var ErrNilEpochChangeNetworkProvider = errors.New("nil epoch change network provider")
+
+// ErrNilEpochChangeProcessor signals that a nil epoch change processor has been provided
+var ErrNilEpochChangeProcessor = errors.New("nil epoch change processor")
+
+// ErrTooManySmartContractResults signals that a transaction generated more smart contract results than the ones aggregated
+// when computing its status
+var ErrTooManySmartContractResults = errors.New("too many smart contract results")
@@ -0,0 +1,244 @@
+package process
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	esdtTransferOwnershipFunc = "transferOwnership"
+	esdtPoolFields            = "hash,nonce,sender,receiver,value,data"
+	esdtArgumentsSeparator    = "@"
+	tokenPropertiesOwnerIndex = 2
+)
+
+// esdtIssuanceFuncs holds the functions of the ESDT system smart contract that issue a new token. All of them receive
+// the token name and the ticker as first arguments
+var esdtIssuanceFuncs = map[string]struct{}{
+	"issue":                  {},
+	"issueSemiFungible":      {},
+	"issueNonFungible":       {},
+	"registerMetaESDT":       {},
+	"registerAndSetAllRoles": {},
+}
+
+type esdtIssuanceProcessor struct {
+	baseProc        Processor
+	scQueryProc     SCQueryService
+	pubKeyConverter core.PubkeyConverter
+}
+
+type esdtPoolCall struct {
+	txHash    string
+	sender    string
+	nonce     uint64
+	value     string
+	function  string
+	arguments [][]byte
+}
+
+// NewESDTIssuanceProcessor will create a new instance of the ESDT issuance processor
+func NewESDTIssuanceProcessor(baseProc Processor, scQueryProc SCQueryService, pubKeyConverter core.PubkeyConverter) (*esdtIssuanceProcessor, error) {
+	if check.IfNil(baseProc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &esdtIssuanceProcessor{
+		baseProc:        baseProc,
+		scQueryProc:     scQueryProc,
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// GetPendingIssuances returns the token issuances not yet executed by the ESDT system smart contract. The contract does
+// not expose its in-flight operations, so they are read from the transactions pool of a metachain observer
+func (eip *esdtIssuanceProcessor) GetPendingIssuances() (*data.ESDTPendingIssuancesResponse, error) {
+	calls, err := eip.getPendingESDTCalls()
+	if err != nil {
+		return nil, err
+	}
+
+	issuances := make([]*data.ESDTPendingIssuance, 0)
+	for _, call := range calls {
+		_, isIssuance := esdtIssuanceFuncs[call.function]
+		if !isIssuance || len(call.arguments) < 2 {
+			continue
+		}
+
+		issuances = append(issuances, &data.ESDTPendingIssuance{
+			TxHash:      call.txHash,
+			Sender:      call.sender,
+			Nonce:       call.nonce,
+			Function:    call.function,
+			TokenName:   string(call.arguments[0]),
+			TokenTicker: string(call.arguments[1]),
+			Value:       call.value,
+		})
+	}
+
+	return &data.ESDTPendingIssuancesResponse{
+		Data: data.ESDTPendingIssuances{Issuances: issuances},
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+// GetTokenOwnership returns the current owner of the token, as known by the ESDT system smart contract, along with the
+// ownership transfers of the token still waiting in the transactions pool of a metachain observer
+func (eip *esdtIssuanceProcessor) GetTokenOwnership(tokenIdentifier string) (*data.ESDTOwnershipResponse, error) {
+	scQuery := &data.SCQuery{
+		ScAddress: esdtContractAddress,
+		FuncName:  initialESDTSupplyFunc,
+		Arguments: [][]byte{[]byte(tokenIdentifier)},
+	}
+
+	res, _, err := eip.scQueryProc.ExecuteQuery(context.Background(), scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if !isSuccessfulVMOutput(res) {
+		return nil, fmt.Errorf("%w: %s", ErrSendingRequest, res.ReturnMessage)
+	}
+	if len(res.ReturnData) <= tokenPropertiesOwnerIndex {
+		return nil, fmt.Errorf("%w: invalid token properties", ErrSendingRequest)
+	}
+
+	owner, err := eip.pubKeyConverter.Encode(res.ReturnData[tokenPropertiesOwnerIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	calls, err := eip.getPendingESDTCalls()
+	if err != nil {
+		return nil, err
+	}
+
+	pendingTransfers := make([]*data.ESDTPendingOwnershipTransfer, 0)
+	for _, call := range calls {
+		if call.function != esdtTransferOwnershipFunc || len(call.arguments) < 2 {
+			continue
+		}
+		if string(call.arguments[0]) != tokenIdentifier {
+			continue
+		}
+
+		newOwner, errEncode := eip.pubKeyConverter.Encode(call.arguments[1])
+		if errEncode != nil {
+			log.Debug("esdt ownership: invalid new owner", "txHash", call.txHash, "error", errEncode)
+			continue
+		}
+
+		pendingTransfers = append(pendingTransfers, &data.ESDTPendingOwnershipTransfer{
+			TxHash:   call.txHash,
+			Sender:   call.sender,
+			Nonce:    call.nonce,
+			NewOwner: newOwner,
+		})
+	}
+
+	return &data.ESDTOwnershipResponse{
+		Data: data.ESDTOwnership{
+			Token:            tokenIdentifier,
+			Owner:            owner,
+			PendingTransfers: pendingTransfers,
+		},
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+// getPendingESDTCalls returns the calls towards the ESDT system smart contract found in the transactions pool of the
+// first metachain observer that responds
+func (eip *esdtIssuanceProcessor) getPendingESDTCalls() ([]*esdtPoolCall, error) {
+	observers, err := eip.baseProc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
+	if err != nil {
+		return nil, err
+	}
+
+	apiPath := TransactionsPoolPath + fieldsParam + esdtPoolFields
+	for _, observer := range observers {
+		response := data.TransactionsPoolApiResponse{}
+		_, errCall := eip.baseProc.CallGetRestEndPoint(observer.Address, apiPath, &response)
+		if errCall != nil {
+			log.Trace("esdt pending calls: cannot get tx pool", "address", observer.Address, "error", errCall)
+			continue
+		}
+
+		return parseESDTPoolCalls(response.Data.Transactions.RegularTransactions), nil
+	}
+
+	return nil, ErrSendingRequest
+}
+
+func parseESDTPoolCalls(txs []data.WrappedTransaction) []*esdtPoolCall {
+	calls := make([]*esdtPoolCall, 0)
+	for _, tx := range txs {
+		receiver, _ := tx.TxFields["receiver"].(string)
+		if receiver != esdtContractAddress {
+			continue
+		}
+
+		function, arguments, ok := parseESDTCallData(tx.TxFields["data"])
+		if !ok {
+			continue
+		}
+
+		txHash, _ := tx.TxFields["hash"].(string)
+		sender, _ := tx.TxFields["sender"].(string)
+		value, _ := tx.TxFields["value"].(string)
+		nonce, _ := tx.TxFields["nonce"].(float64)
+		calls = append(calls, &esdtPoolCall{
+			txHash:    txHash,
+			sender:    sender,
+			nonce:     uint64(nonce),
+			value:     value,
+			function:  function,
+			arguments: arguments,
+		})
+	}
+
+	return calls
+}
+
+// parseESDTCallData decodes the function@arg1@arg2 data field of a transaction. The observers return the data field
+// base64 encoded, while the arguments are hex encoded
+func parseESDTCallData(dataField interface{}) (string, [][]byte, bool) {
+	dataStr, ok := dataField.(string)
+	if !ok || len(dataStr) == 0 {
+		return "", nil, false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(dataStr)
+	if err == nil {
+		dataStr = string(decoded)
+	}
+
+	tokens := strings.Split(dataStr, esdtArgumentsSeparator)
+	arguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		argument, errDecode := hex.DecodeString(token)
+		if errDecode != nil {
+			return "", nil, false
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	return tokens[0], arguments, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eip *esdtIssuanceProcessor) IsInterfaceNil() bool {
+	return eip == nil
+}
@@ -0,0 +1,211 @@
+package process_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testESDTContract = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
+
+func createESDTPoolProcessorStub(txs []data.WrappedTransaction) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			if shardId != core.MetachainShardId {
+				return nil, errors.New("unexpected shard")
+			}
+
+			return []*data.NodeData{{Address: "meta0", ShardId: core.MetachainShardId}, {Address: "meta1", ShardId: core.MetachainShardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			if address == "meta0" {
+				return 0, errors.New("observer down")
+			}
+
+			response := value.(*data.TransactionsPoolApiResponse)
+			response.Data.Transactions.RegularTransactions = txs
+			return 0, nil
+		},
+	}
+}
+
+func createESDTPoolTx(hash string, receiver string, txData string) data.WrappedTransaction {
+	return data.WrappedTransaction{
+		TxFields: map[string]interface{}{
+			"hash":     hash,
+			"nonce":    float64(7),
+			"sender":   testDelegatorAddress,
+			"receiver": receiver,
+			"value":    "50000000000000000",
+			"data":     base64.StdEncoding.EncodeToString([]byte(txData)),
+		},
+	}
+}
+
+func TestNewESDTIssuanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil base processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		eip, err := process.NewESDTIssuanceProcessor(nil, &mock.SCQueryServiceStub{}, testPubkeyConverter)
+		require.True(t, check.IfNil(eip))
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		eip, err := process.NewESDTIssuanceProcessor(&mock.ProcessorStub{}, nil, testPubkeyConverter)
+		require.True(t, check.IfNil(eip))
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		eip, err := process.NewESDTIssuanceProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, nil)
+		require.True(t, check.IfNil(eip))
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		eip, err := process.NewESDTIssuanceProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, testPubkeyConverter)
+		require.False(t, check.IfNil(eip))
+		require.NoError(t, err)
+	})
+}
+
+func TestESDTIssuanceProcessor_GetPendingIssuances(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no observer responding should error", func(t *testing.T) {
+		t.Parallel()
+
+		baseProc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "meta0"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return 0, errors.New("observer down")
+			},
+		}
+		eip, _ := process.NewESDTIssuanceProcessor(baseProc, &mock.SCQueryServiceStub{}, testPubkeyConverter)
+		response, err := eip.GetPendingIssuances()
+		require.Nil(t, response)
+		require.Equal(t, process.ErrSendingRequest, err)
+	})
+
+	t.Run("should return the issuances from the metachain pool", func(t *testing.T) {
+		t.Parallel()
+
+		name := hex.EncodeToString([]byte("MyToken"))
+		ticker := hex.EncodeToString([]byte("MTK"))
+		txs := []data.WrappedTransaction{
+			createESDTPoolTx("h1", testESDTContract, "issue@"+name+"@"+ticker+"@0a@12"),
+			createESDTPoolTx("h2", testDelegatorAddress, "issue@"+name+"@"+ticker),
+			createESDTPoolTx("h3", testESDTContract, "registerMetaESDT@"+name+"@"+ticker+"@12"),
+			createESDTPoolTx("h4", testESDTContract, "freeze@"+ticker),
+			createESDTPoolTx("h5", testESDTContract, "issue@not-hex@"+ticker),
+		}
+		eip, _ := process.NewESDTIssuanceProcessor(createESDTPoolProcessorStub(txs), &mock.SCQueryServiceStub{}, testPubkeyConverter)
+		response, err := eip.GetPendingIssuances()
+		require.NoError(t, err)
+		require.Equal(t, &data.ESDTPendingIssuancesResponse{
+			Data: data.ESDTPendingIssuances{
+				Issuances: []*data.ESDTPendingIssuance{
+					{TxHash: "h1", Sender: testDelegatorAddress, Nonce: 7, Function: "issue", TokenName: "MyToken", TokenTicker: "MTK", Value: "50000000000000000"},
+					{TxHash: "h3", Sender: testDelegatorAddress, Nonce: 7, Function: "registerMetaESDT", TokenName: "MyToken", TokenTicker: "MTK", Value: "50000000000000000"},
+				},
+			},
+			Code: data.ReturnCodeSuccess,
+		}, response)
+	})
+}
+
+func TestESDTIssuanceProcessor_GetTokenOwnership(t *testing.T) {
+	t.Parallel()
+
+	ownerBytes, _ := testPubkeyConverter.Decode(testDelegatorAddress)
+	newOwnerBytes, _ := testPubkeyConverter.Decode(testStakingProvider)
+
+	t.Run("sc query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("no ticker with given name")
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		eip, _ := process.NewESDTIssuanceProcessor(&mock.ProcessorStub{}, scQueryProc, testPubkeyConverter)
+		response, err := eip.GetTokenOwnership("TKN-abcdef")
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("unsuccessful query should error", func(t *testing.T) {
+		t.Parallel()
+
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{ReturnCode: "user error", ReturnMessage: "no ticker with given name"}, data.BlockInfo{}, nil
+			},
+		}
+		eip, _ := process.NewESDTIssuanceProcessor(&mock.ProcessorStub{}, scQueryProc, testPubkeyConverter)
+		response, err := eip.GetTokenOwnership("TKN-abcdef")
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrSendingRequest))
+		require.Contains(t, err.Error(), "no ticker with given name")
+	})
+
+	t.Run("should return the owner and the pending transfers", func(t *testing.T) {
+		t.Parallel()
+
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, testESDTContract, query.ScAddress)
+				require.Equal(t, "getTokenProperties", query.FuncName)
+				require.Equal(t, [][]byte{[]byte("TKN-abcdef")}, query.Arguments)
+
+				return &vm.VMOutputApi{
+					ReturnCode: "ok",
+					ReturnData: [][]byte{[]byte("Token"), []byte("FungibleESDT"), ownerBytes, []byte("1000")},
+				}, data.BlockInfo{}, nil
+			},
+		}
+		token := hex.EncodeToString([]byte("TKN-abcdef"))
+		otherToken := hex.EncodeToString([]byte("OTH-abcdef"))
+		newOwner := hex.EncodeToString(newOwnerBytes)
+		txs := []data.WrappedTransaction{
+			createESDTPoolTx("h1", testESDTContract, "transferOwnership@"+token+"@"+newOwner),
+			createESDTPoolTx("h2", testESDTContract, "transferOwnership@"+otherToken+"@"+newOwner),
+			createESDTPoolTx("h3", testESDTContract, "transferOwnership@"+token+"@0102"),
+		}
+		eip, _ := process.NewESDTIssuanceProcessor(createESDTPoolProcessorStub(txs), scQueryProc, testPubkeyConverter)
+		response, err := eip.GetTokenOwnership("TKN-abcdef")
+		require.NoError(t, err)
+		require.Equal(t, &data.ESDTOwnershipResponse{
+			Data: data.ESDTOwnership{
+				Token: "TKN-abcdef",
+				Owner: testDelegatorAddress,
+				PendingTransfers: []*data.ESDTPendingOwnershipTransfer{
+					{TxHash: "h1", Sender: testDelegatorAddress, Nonce: 7, NewOwner: testStakingProvider},
+				},
+			},
+			Code: data.ReturnCodeSuccess,
+		}, response)
+	})
+}
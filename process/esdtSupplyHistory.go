@@ -0,0 +1,159 @@
+package process
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// esdtSupplyHistory holds, for each tracked token, the last supply sample of each of the most recent epochs
+type esdtSupplyHistory struct {
+	mut              sync.RWMutex
+	maxTrackedTokens int
+	maxEpochs        int
+	epochs           map[string][]*data.ESDTSupplyEpoch
+}
+
+func newESDTSupplyHistory(cfg config.ESDTSupplyHistoryConfig) (*esdtSupplyHistory, error) {
+	if cfg.MaxTrackedTokens <= 0 {
+		return nil, fmt.Errorf("%w, MaxTrackedTokens should be positive", ErrInvalidESDTSupplyHistoryConfig)
+	}
+	if cfg.MaxEpochs <= 0 {
+		return nil, fmt.Errorf("%w, MaxEpochs should be positive", ErrInvalidESDTSupplyHistoryConfig)
+	}
+	if cfg.SampleIntervalInSec <= 0 {
+		return nil, fmt.Errorf("%w, SampleIntervalInSec should be positive", ErrInvalidESDTSupplyHistoryConfig)
+	}
+	if len(cfg.Tokens) > cfg.MaxTrackedTokens {
+		return nil, fmt.Errorf("%w, %d tokens provided, while MaxTrackedTokens is %d",
+			ErrInvalidESDTSupplyHistoryConfig, len(cfg.Tokens), cfg.MaxTrackedTokens)
+	}
+
+	esh := &esdtSupplyHistory{
+		maxTrackedTokens: cfg.MaxTrackedTokens,
+		maxEpochs:        cfg.MaxEpochs,
+		epochs:           make(map[string][]*data.ESDTSupplyEpoch),
+	}
+	for _, token := range cfg.Tokens {
+		if len(token) == 0 {
+			return nil, fmt.Errorf("%w, empty token provided", ErrInvalidESDTSupplyHistoryConfig)
+		}
+
+		esh.epochs[token] = make([]*data.ESDTSupplyEpoch, 0)
+	}
+
+	return esh, nil
+}
+
+// track starts tracking the supply of the token
+func (esh *esdtSupplyHistory) track(token string) error {
+	esh.mut.Lock()
+	defer esh.mut.Unlock()
+
+	_, isTracked := esh.epochs[token]
+	if isTracked {
+		return nil
+	}
+	if len(esh.epochs) >= esh.maxTrackedTokens {
+		return fmt.Errorf("%w, maximum %d", ErrTooManyTrackedTokens, esh.maxTrackedTokens)
+	}
+
+	esh.epochs[token] = make([]*data.ESDTSupplyEpoch, 0)
+	return nil
+}
+
+func (esh *esdtSupplyHistory) isTracked(token string) bool {
+	esh.mut.RLock()
+	defer esh.mut.RUnlock()
+
+	_, isTracked := esh.epochs[token]
+	return isTracked
+}
+
+// trackedTokens returns the tracked tokens, sorted
+func (esh *esdtSupplyHistory) trackedTokens() []string {
+	esh.mut.RLock()
+	defer esh.mut.RUnlock()
+
+	tokens := make([]string, 0, len(esh.epochs))
+	for token := range esh.epochs {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	return tokens
+}
+
+// record stores the supply of a tracked token as the last sample of the epoch. The samples of an epoch older than the
+// last recorded one (coming from a lagging observer) are ignored
+func (esh *esdtSupplyHistory) record(token string, epoch uint32, supply *data.ESDTSupply) {
+	esh.mut.Lock()
+	defer esh.mut.Unlock()
+
+	epochs, isTracked := esh.epochs[token]
+	if !isTracked {
+		return
+	}
+
+	sample := &data.ESDTSupplyEpoch{
+		Epoch:       epoch,
+		Supply:      supply.Supply,
+		TotalMinted: supply.Minted,
+		TotalBurned: supply.Burned,
+	}
+	numEpochs := len(epochs)
+	if numEpochs > 0 {
+		lastEpoch := epochs[numEpochs-1].Epoch
+		if epoch < lastEpoch {
+			return
+		}
+		if epoch == lastEpoch {
+			epochs[numEpochs-1] = sample
+			return
+		}
+	}
+
+	epochs = append(epochs, sample)
+	if len(epochs) > esh.maxEpochs {
+		epochs = epochs[len(epochs)-esh.maxEpochs:]
+	}
+	esh.epochs[token] = epochs
+}
+
+// getEpochs returns the recorded epochs of the token, from the oldest to the newest one, along with the amounts minted
+// and burned in each epoch
+func (esh *esdtSupplyHistory) getEpochs(token string) []*data.ESDTSupplyEpoch {
+	esh.mut.RLock()
+	defer esh.mut.RUnlock()
+
+	epochs := esh.epochs[token]
+	result := make([]*data.ESDTSupplyEpoch, 0, len(epochs))
+	for idx, sample := range epochs {
+		epoch := *sample
+		if idx > 0 {
+			epoch.Minted = subStr(sample.TotalMinted, epochs[idx-1].TotalMinted)
+			epoch.Burned = subStr(sample.TotalBurned, epochs[idx-1].TotalBurned)
+		}
+
+		result = append(result, &epoch)
+	}
+
+	return result
+}
+
+func subStr(s1, s2 string) string {
+	s1Big, ok := big.NewInt(0).SetString(s1, 10)
+	if !ok {
+		return ""
+	}
+	s2Big, ok := big.NewInt(0).SetString(s2, 10)
+	if !ok {
+		return ""
+	}
+
+	return big.NewInt(0).Sub(s1Big, s2Big).String()
+}
@@ -0,0 +1,92 @@
+package process
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	createConfig := func() config.ESDTSupplyHistoryConfig {
+		return config.ESDTSupplyHistoryConfig{
+			Enabled:             true,
+			Tokens:              []string{"TKN-abcd"},
+			MaxTrackedTokens:    1,
+			MaxEpochs:           3,
+			SampleIntervalInSec: 1,
+		}
+	}
+
+	cfg := createConfig()
+	cfg.MaxTrackedTokens = 0
+	esh, err := newESDTSupplyHistory(cfg)
+	require.Nil(t, esh)
+	require.True(t, errors.Is(err, ErrInvalidESDTSupplyHistoryConfig))
+
+	cfg = createConfig()
+	cfg.SampleIntervalInSec = 0
+	esh, err = newESDTSupplyHistory(cfg)
+	require.Nil(t, esh)
+	require.True(t, errors.Is(err, ErrInvalidESDTSupplyHistoryConfig))
+
+	cfg = createConfig()
+	cfg.Tokens = []string{"TKN-abcd", "TKN-0123"}
+	esh, err = newESDTSupplyHistory(cfg)
+	require.Nil(t, esh)
+	require.True(t, errors.Is(err, ErrInvalidESDTSupplyHistoryConfig))
+
+	cfg = createConfig()
+	cfg.Tokens = []string{""}
+	esh, err = newESDTSupplyHistory(cfg)
+	require.Nil(t, esh)
+	require.True(t, errors.Is(err, ErrInvalidESDTSupplyHistoryConfig))
+
+	esh, err = newESDTSupplyHistory(createConfig())
+	require.NoError(t, err)
+	require.Equal(t, []string{"TKN-abcd"}, esh.trackedTokens())
+}
+
+func TestESDTSupplyHistory_Record(t *testing.T) {
+	t.Parallel()
+
+	esh, _ := newESDTSupplyHistory(config.ESDTSupplyHistoryConfig{
+		MaxTrackedTokens:    2,
+		MaxEpochs:           2,
+		SampleIntervalInSec: 1,
+	})
+	createSupply := func(supply string, minted string, burned string) *data.ESDTSupply {
+		return &data.ESDTSupply{Supply: supply, Minted: minted, Burned: burned}
+	}
+
+	// untracked tokens are not recorded
+	esh.record("TKN-abcd", 1, createSupply("10", "10", "0"))
+	require.Empty(t, esh.getEpochs("TKN-abcd"))
+
+	require.Nil(t, esh.track("TKN-abcd"))
+	esh.record("TKN-abcd", 1, createSupply("10", "10", "0"))
+	esh.record("TKN-abcd", 2, createSupply("15", "20", "5"))
+	esh.record("TKN-abcd", 2, createSupply("17", "25", "8"))
+	// lagging observer
+	esh.record("TKN-abcd", 1, createSupply("1", "1", "1"))
+	require.Equal(t, []*data.ESDTSupplyEpoch{
+		{Epoch: 1, Supply: "10", TotalMinted: "10", TotalBurned: "0"},
+		{Epoch: 2, Supply: "17", Minted: "15", Burned: "8", TotalMinted: "25", TotalBurned: "8"},
+	}, esh.getEpochs("TKN-abcd"))
+
+	// the oldest epoch is dropped
+	esh.record("TKN-abcd", 4, createSupply("7", "25", "18"))
+	require.Equal(t, []*data.ESDTSupplyEpoch{
+		{Epoch: 2, Supply: "17", TotalMinted: "25", TotalBurned: "8"},
+		{Epoch: 4, Supply: "7", Minted: "0", Burned: "10", TotalMinted: "25", TotalBurned: "18"},
+	}, esh.getEpochs("TKN-abcd"))
+
+	require.Nil(t, esh.track("TKN-abcd"))
+	require.Nil(t, esh.track("TKN-0123"))
+	require.True(t, errors.Is(esh.track("TKN-4567"), ErrTooManyTrackedTokens))
+	require.Equal(t, []string{"TKN-0123", "TKN-abcd"}, esh.trackedTokens())
+}
@@ -1,29 +1,41 @@
 package process
 
 import (
+	"context"
 	"math/big"
+	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 const (
 	esdtContractAddress   = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
 	initialESDTSupplyFunc = "getTokenProperties"
+	esdtSpecialRolesFunc  = "getSpecialRoles"
+
+	specialRolesAddressSeparator = ":"
+	specialRolesSeparator        = ","
 
 	networkESDTSupplyPath = "/network/esdt/supply/"
 	zeroBigIntStr         = "0"
 )
 
 type esdtSupplyProcessor struct {
-	baseProc    Processor
-	scQueryProc SCQueryService
+	baseProc       Processor
+	scQueryProc    SCQueryService
+	history        *esdtSupplyHistory
+	sampleInterval time.Duration
+	cancelFunc     func()
 }
 
 // NewESDTSupplyProcessor will create a new instance of the ESDT supply processor
-func NewESDTSupplyProcessor(baseProc Processor, scQueryProc SCQueryService) (*esdtSupplyProcessor, error) {
+func NewESDTSupplyProcessor(baseProc Processor, scQueryProc SCQueryService, historyConfig config.ESDTSupplyHistoryConfig) (*esdtSupplyProcessor, error) {
 	if check.IfNil(baseProc) {
 		return nil, ErrNilCoreProcessor
 	}
@@ -31,24 +43,69 @@ func NewESDTSupplyProcessor(baseProc Processor, scQueryProc SCQueryService) (*es
 		return nil, ErrNilSCQueryService
 	}
 
-	return &esdtSupplyProcessor{
+	esp := &esdtSupplyProcessor{
 		baseProc:    baseProc,
 		scQueryProc: scQueryProc,
-	}, nil
+	}
+	if historyConfig.Enabled {
+		var err error
+		esp.history, err = newESDTSupplyHistory(historyConfig)
+		if err != nil {
+			return nil, err
+		}
+		esp.sampleInterval = time.Duration(historyConfig.SampleIntervalInSec) * time.Second
+	}
+
+	return esp, nil
 }
 
 // GetESDTSupply will return the total supply for the provided token
 func (esp *esdtSupplyProcessor) GetESDTSupply(tokenIdentifier string) (*data.ESDTSupplyResponse, error) {
-	totalSupply, err := esp.getSupplyFromShards(tokenIdentifier)
+	totalSupplies, err := esp.getSuppliesFromShards([]string{tokenIdentifier})
 	if err != nil {
 		return nil, err
 	}
 
-	res := &data.ESDTSupplyResponse{
+	supply, err := esp.computeTokenSupply(tokenIdentifier, totalSupplies[tokenIdentifier])
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.ESDTSupplyResponse{
+		Data: *supply,
 		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+// GetESDTSupplies will return the total supply for each of the provided tokens. Each shard is queried through a single
+// observer for all the tokens, the shards being queried in parallel
+func (esp *esdtSupplyProcessor) GetESDTSupplies(tokenIdentifiers []string) (*data.ESDTSuppliesResponse, error) {
+	tokens := removeDuplicatedTokens(tokenIdentifiers)
+	totalSupplies, err := esp.getSuppliesFromShards(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	supplies := make(map[string]data.ESDTSupply, len(tokens))
+	for _, token := range tokens {
+		supply, errCompute := esp.computeTokenSupply(token, totalSupplies[token])
+		if errCompute != nil {
+			return nil, errCompute
+		}
+
+		supplies[token] = *supply
 	}
+
+	return &data.ESDTSuppliesResponse{
+		Data: data.ESDTSupplies{Supplies: supplies},
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+func (esp *esdtSupplyProcessor) computeTokenSupply(tokenIdentifier string, totalSupply *data.ESDTSupply) (*data.ESDTSupply, error) {
+	res := &data.ESDTSupply{}
 	if !isFungibleESDT(tokenIdentifier) {
-		res.Data = *totalSupply
+		*res = *totalSupply
 		makeInitialMintedNotEmpty(res)
 		return res, nil
 	}
@@ -58,55 +115,90 @@ func (esp *esdtSupplyProcessor) GetESDTSupply(tokenIdentifier string) (*data.ESD
 		return nil, err
 	}
 
-	res.Data.InitialMinted = initialSupply.String()
+	res.InitialMinted = initialSupply.String()
 	if totalSupply.RecomputedSupply {
-		res.Data.Supply = totalSupply.Supply
-		res.Data.Burned = zeroBigIntStr
-		res.Data.Minted = zeroBigIntStr
-		res.Data.RecomputedSupply = true
+		res.Supply = totalSupply.Supply
+		res.Burned = zeroBigIntStr
+		res.Minted = zeroBigIntStr
+		res.RecomputedSupply = true
 	} else {
-		res.Data.Supply = sumStr(totalSupply.Supply, initialSupply.String())
-		res.Data.Burned = totalSupply.Burned
-		res.Data.Minted = totalSupply.Minted
+		res.Supply = sumStr(totalSupply.Supply, initialSupply.String())
+		res.Burned = totalSupply.Burned
+		res.Minted = totalSupply.Minted
 	}
 
 	makeInitialMintedNotEmpty(res)
 	return res, nil
 }
 
-func makeInitialMintedNotEmpty(resp *data.ESDTSupplyResponse) {
-	if resp.Data.InitialMinted == "" {
-		resp.Data.InitialMinted = zeroBigIntStr
+func makeInitialMintedNotEmpty(supply *data.ESDTSupply) {
+	if supply.InitialMinted == "" {
+		supply.InitialMinted = zeroBigIntStr
 	}
 }
 
-func (esp *esdtSupplyProcessor) getSupplyFromShards(tokenIdentifier string) (*data.ESDTSupply, error) {
-	totalSupply := &data.ESDTSupply{}
+func removeDuplicatedTokens(tokenIdentifiers []string) []string {
+	tokens := make([]string, 0, len(tokenIdentifiers))
+	seen := make(map[string]struct{}, len(tokenIdentifiers))
+	for _, token := range tokenIdentifiers {
+		_, found := seen[token]
+		if found {
+			continue
+		}
+
+		seen[token] = struct{}{}
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+type shardSuppliesResult struct {
+	supplies map[string]*data.ESDTSupply
+	err      error
+}
+
+// getSuppliesFromShards fetches the supplies of all the tokens from each shard and sums them per token
+func (esp *esdtSupplyProcessor) getSuppliesFromShards(tokens []string) (map[string]*data.ESDTSupply, error) {
 	shardIDs := esp.baseProc.GetShardIDs()
-	numNodesQueried := 0
-	numNodesWithRecomputedSupply := 0
-	for _, shardID := range shardIDs {
+	results := make([]shardSuppliesResult, len(shardIDs))
+	wg := sync.WaitGroup{}
+	for idx, shardID := range shardIDs {
 		if shardID == core.MetachainShardId {
 			continue
 		}
 
-		supply, err := esp.getShardSupply(tokenIdentifier, shardID)
-		if err != nil {
-			return nil, err
-		}
+		wg.Add(1)
+		go func(resultIdx int, shardID uint32) {
+			defer wg.Done()
 
-		addToSupply(totalSupply, supply)
-		if supply.RecomputedSupply {
-			numNodesWithRecomputedSupply++
-		}
-		numNodesQueried++
+			supplies, err := esp.getShardSupplies(tokens, shardID)
+			results[resultIdx] = shardSuppliesResult{supplies: supplies, err: err}
+		}(idx, shardID)
 	}
+	wg.Wait()
 
-	if numNodesWithRecomputedSupply > 0 {
-		totalSupply.RecomputedSupply = true
+	totalSupplies := make(map[string]*data.ESDTSupply, len(tokens))
+	for _, token := range tokens {
+		totalSupplies[token] = &data.ESDTSupply{}
+	}
+	for idx, shardID := range shardIDs {
+		if shardID == core.MetachainShardId {
+			continue
+		}
+		if results[idx].err != nil {
+			return nil, results[idx].err
+		}
+
+		for token, supply := range results[idx].supplies {
+			addToSupply(totalSupplies[token], supply)
+			if supply.RecomputedSupply {
+				totalSupplies[token].RecomputedSupply = true
+			}
+		}
 	}
 
-	return totalSupply, nil
+	return totalSupplies, nil
 }
 
 func addToSupply(dstSupply, sourceSupply *data.ESDTSupply) {
@@ -135,7 +227,7 @@ func (esp *esdtSupplyProcessor) getInitialSupplyFromMeta(token string) (*big.Int
 		Arguments: [][]byte{[]byte(token)},
 	}
 
-	res, _, err := esp.scQueryProc.ExecuteQuery(scQuery)
+	res, _, err := esp.scQueryProc.ExecuteQuery(context.Background(), scQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -153,29 +245,218 @@ func (esp *esdtSupplyProcessor) getInitialSupplyFromMeta(token string) (*big.Int
 	return supplyBig, nil
 }
 
-func (esp *esdtSupplyProcessor) getShardSupply(token string, shardID uint32) (*data.ESDTSupply, error) {
+// GetESDTRoles will return the addresses holding special roles (such as mint, burn or NFT create) for the provided token
+func (esp *esdtSupplyProcessor) GetESDTRoles(tokenIdentifier string) (*data.ESDTRolesResponse, error) {
+	scQuery := &data.SCQuery{
+		ScAddress: esdtContractAddress,
+		FuncName:  esdtSpecialRolesFunc,
+		Arguments: [][]byte{[]byte(tokenIdentifier)},
+	}
+
+	res, _, err := esp.scQueryProc.ExecuteQuery(context.Background(), scQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.ESDTRolesResponse{
+		Data: parseSpecialRoles(tokenIdentifier, res.ReturnData),
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+// parseSpecialRoles decodes the output of the getSpecialRoles function, where each entry has the
+// address:role1,role2 format. The malformed entries are skipped
+func parseSpecialRoles(tokenIdentifier string, returnData [][]byte) data.ESDTRoles {
+	roles := data.ESDTRoles{
+		Addresses: make([]*data.ESDTAddressRoles, 0, len(returnData)),
+		Roles:     make(map[string][]string),
+	}
+	for _, entry := range returnData {
+		address, rolesStr, found := strings.Cut(string(entry), specialRolesAddressSeparator)
+		if !found || len(address) == 0 || len(rolesStr) == 0 {
+			log.Warn("esdt roles: malformed special roles entry", "token", tokenIdentifier, "entry", string(entry))
+			continue
+		}
+
+		addressRoles := strings.Split(rolesStr, specialRolesSeparator)
+		roles.Addresses = append(roles.Addresses, &data.ESDTAddressRoles{
+			Address: address,
+			Roles:   addressRoles,
+		})
+		for _, role := range addressRoles {
+			roles.Roles[role] = append(roles.Roles[role], address)
+		}
+	}
+
+	return roles
+}
+
+// getShardSupplies fetches the supplies of all the tokens from the same observer of the shard, moving to the next
+// observer only for the tokens not yet fetched
+func (esp *esdtSupplyProcessor) getShardSupplies(tokens []string, shardID uint32) (map[string]*data.ESDTSupply, error) {
 	shardObservers, errObs := esp.baseProc.GetObservers(shardID, data.AvailabilityAll)
 	if errObs != nil {
 		return nil, errObs
 	}
 
-	responseEsdtSupply := data.ESDTSupplyResponse{}
-	apiPath := networkESDTSupplyPath + token
+	supplies := make(map[string]*data.ESDTSupply, len(tokens))
+	lastObserverError := ""
 	for _, observer := range shardObservers {
+		for _, token := range tokens {
+			_, alreadyFetched := supplies[token]
+			if alreadyFetched {
+				continue
+			}
+
+			responseEsdtSupply := data.ESDTSupplyResponse{}
+			_, errGet := esp.baseProc.CallGetRestEndPoint(observer.Address, networkESDTSupplyPath+token, &responseEsdtSupply)
+			if errGet != nil {
+				log.Error("esdt supply request", "shard ID", observer.ShardId, "observer", observer.Address, "token", token, "error", errGet.Error())
+				lastObserverError = responseEsdtSupply.Error
+				break
+			}
+
+			supplies[token] = &responseEsdtSupply.Data
+		}
+
+		if len(supplies) == len(tokens) {
+			log.Info("esdt supply request", "shard ID", observer.ShardId, "observer", observer.Address, "num tokens", len(tokens))
+			return supplies, nil
+		}
+	}
+
+	return nil, WrapObserversError(lastObserverError)
+}
+
+// GetESDTSupplyHistory will return the per-epoch supplies of the provided token, as sampled by the proxy, along with
+// the http status code. A token not yet tracked is sampled right away and tracked from then on
+func (esp *esdtSupplyProcessor) GetESDTSupplyHistory(tokenIdentifier string) (int, *data.ESDTSupplyHistoryResponse, error) {
+	if esp.history == nil {
+		return http.StatusBadRequest, nil, ErrESDTSupplyHistoryDisabled
+	}
+
+	if !esp.history.isTracked(tokenIdentifier) {
+		epoch, supplies, err := esp.getCurrentSupplies([]string{tokenIdentifier})
+		if err != nil {
+			return http.StatusInternalServerError, nil, err
+		}
+
+		err = esp.history.track(tokenIdentifier)
+		if err != nil {
+			return http.StatusTooManyRequests, nil, err
+		}
+		esp.history.record(tokenIdentifier, epoch, supplies[tokenIdentifier])
+	}
+
+	return http.StatusOK, &data.ESDTSupplyHistoryResponse{
+		Data: data.ESDTSupplyHistory{
+			Token:  tokenIdentifier,
+			Epochs: esp.history.getEpochs(tokenIdentifier),
+		},
+		Code: data.ReturnCodeSuccess,
+	}, nil
+}
+
+// StartSupplyHistoryTracking will periodically sample the supplies of the tracked tokens, if the history is enabled
+func (esp *esdtSupplyProcessor) StartSupplyHistoryTracking() {
+	if esp.history == nil {
+		return
+	}
+	if esp.cancelFunc != nil {
+		log.Error("esdtSupplyProcessor - supply history tracking already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, esp.cancelFunc = context.WithCancel(context.Background())
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(esp.sampleInterval)
+		defer timer.Stop()
 
-		_, errGet := esp.baseProc.CallGetRestEndPoint(observer.Address, apiPath, &responseEsdtSupply)
-		if errGet != nil {
-			log.Error("esdt supply request", "shard ID", observer.ShardId, "observer", observer.Address, "error", errGet.Error())
+		esp.sampleTrackedSupplies()
+		for {
+			timer.Reset(esp.sampleInterval)
+
+			select {
+			case <-timer.C:
+				esp.sampleTrackedSupplies()
+			case <-ctx.Done():
+				log.Debug("finishing esdtSupplyProcessor supply history tracking...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+func (esp *esdtSupplyProcessor) sampleTrackedSupplies() {
+	tokens := esp.history.trackedTokens()
+	if len(tokens) == 0 {
+		return
+	}
+
+	epoch, supplies, err := esp.getCurrentSupplies(tokens)
+	if err != nil {
+		log.Warn("esdt supply history: cannot sample the supplies", "num tokens", len(tokens), "error", err.Error())
+		return
+	}
+
+	for token, supply := range supplies {
+		esp.history.record(token, epoch, supply)
+	}
+	log.Debug("esdt supply history: sampled the supplies", "epoch", epoch, "num tokens", len(tokens))
+}
+
+// getCurrentSupplies returns the current epoch along with the supplies of the tokens
+func (esp *esdtSupplyProcessor) getCurrentSupplies(tokens []string) (uint32, map[string]*data.ESDTSupply, error) {
+	epoch, err := esp.getCurrentEpoch()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	totalSupplies, err := esp.getSuppliesFromShards(tokens)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	supplies := make(map[string]*data.ESDTSupply, len(tokens))
+	for _, token := range tokens {
+		supplies[token], err = esp.computeTokenSupply(token, totalSupplies[token])
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return epoch, supplies, nil
+}
+
+func (esp *esdtSupplyProcessor) getCurrentEpoch() (uint32, error) {
+	observers, err := esp.baseProc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
+	if err != nil {
+		return 0, err
+	}
+
+	response := data.GenericAPIResponse{}
+	for _, observer := range observers {
+		_, err = esp.baseProc.CallGetRestEndPoint(observer.Address, NetworkStatusPath, &response)
+		if err != nil {
+			log.Error("esdt supply history: network status request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
 
-		log.Info("esdt supply request", "shard ID", observer.ShardId, "observer", observer.Address)
+		return uint32(getUintMetric(response.Data, MetricEpochNumber)), nil
+	}
 
-		return &responseEsdtSupply.Data, nil
+	return 0, WrapObserversError(response.Error)
+}
 
+// Close will stop the supply history tracking
+func (esp *esdtSupplyProcessor) Close() error {
+	if esp.cancelFunc != nil {
+		esp.cancelFunc()
 	}
 
-	return nil, WrapObserversError(responseEsdtSupply.Error)
+	return nil
 }
 
 func isFungibleESDT(tokenIdentifier string) bool {
@@ -1,12 +1,17 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
@@ -15,10 +20,10 @@ import (
 func TestNewESDTSupplyProcessor(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewESDTSupplyProcessor(nil, &mock.SCQueryServiceStub{})
+	_, err := NewESDTSupplyProcessor(nil, &mock.SCQueryServiceStub{}, config.ESDTSupplyHistoryConfig{})
 	require.Equal(t, ErrNilCoreProcessor, err)
 
-	_, err = NewESDTSupplyProcessor(&mock.ProcessorStub{}, nil)
+	_, err = NewESDTSupplyProcessor(&mock.ProcessorStub{}, nil, config.ESDTSupplyHistoryConfig{})
 	require.Equal(t, ErrNilSCQueryService, err)
 }
 
@@ -56,13 +61,13 @@ func TestEsdtSupplyProcessor_GetESDTSupplyFungible(t *testing.T) {
 		},
 	}
 	scQueryProc := &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+		ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{nil, nil, nil, []byte("500")},
 			}, data.BlockInfo{}, nil
 		},
 	}
-	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc)
+	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc, config.ESDTSupplyHistoryConfig{})
 	require.Nil(t, err)
 
 	supplyRes, err := esdtProc.GetESDTSupply("TOKEN-ABCD")
@@ -111,7 +116,7 @@ func TestEsdtSupplyProcessor_GetESDTSupplyNonFungible(t *testing.T) {
 		},
 	}
 	scQueryProc := &mock.SCQueryServiceStub{}
-	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc)
+	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc, config.ESDTSupplyHistoryConfig{})
 	require.Nil(t, err)
 
 	supplyRes, err := esdtProc.GetESDTSupply("SEMI-ABCD-0A")
@@ -163,13 +168,13 @@ func TestEsdtSupplyProcessor_GetESDTSupplyShouldReturnRecomputed(t *testing.T) {
 		},
 	}
 	scQueryProc := &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+		ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{nil, nil, nil, []byte("500")},
 			}, data.BlockInfo{}, nil
 		},
 	}
-	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc)
+	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc, config.ESDTSupplyHistoryConfig{})
 	require.Nil(t, err)
 
 	supplyRes, err := esdtProc.GetESDTSupply("SEMI-ABCDEF")
@@ -179,3 +184,248 @@ func TestEsdtSupplyProcessor_GetESDTSupplyShouldReturnRecomputed(t *testing.T) {
 	require.Equal(t, "0", supplyRes.Data.Minted)
 	require.True(t, supplyRes.Data.RecomputedSupply)
 }
+
+func TestEsdtSupplyProcessor_GetESDTSupplies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should query each shard once for all the tokens", func(t *testing.T) {
+		t.Parallel()
+
+		numGetObserversCalls := uint32(0)
+		baseProc := &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, core.MetachainShardId}
+			},
+			GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				atomic.AddUint32(&numGetObserversCalls, 1)
+				return []*data.NodeData{
+					{ShardId: shardID, Address: fmt.Sprintf("shard-%d-failing", shardID)},
+					{ShardId: shardID, Address: fmt.Sprintf("shard-%d", shardID)},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				valResp := value.(*data.ESDTSupplyResponse)
+				switch {
+				case address == "shard-0-failing" && strings.HasSuffix(path, "NFT-abcd-01"):
+					return 500, errors.New("local err")
+				case address == "shard-1-failing":
+					return 500, errors.New("local err")
+				case strings.HasSuffix(path, "TKN-abcd"):
+					valResp.Data.Supply = "1000"
+					valResp.Data.Minted = "200"
+					valResp.Data.Burned = "100"
+				case strings.HasSuffix(path, "NFT-abcd-01"):
+					valResp.Data.Supply = "1"
+				}
+				return 200, nil
+			},
+		}
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, []byte("TKN-abcd"), query.Arguments[0])
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{nil, nil, nil, []byte("500")},
+				}, data.BlockInfo{}, nil
+			},
+		}
+		esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc, config.ESDTSupplyHistoryConfig{})
+		require.Nil(t, err)
+
+		suppliesRes, err := esdtProc.GetESDTSupplies([]string{"TKN-abcd", "NFT-abcd-01", "TKN-abcd"})
+		require.Nil(t, err)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numGetObserversCalls))
+		require.Len(t, suppliesRes.Data.Supplies, 2)
+		require.Equal(t, data.ESDTSupply{
+			Supply:        "2500",
+			Minted:        "400",
+			Burned:        "200",
+			InitialMinted: "500",
+		}, suppliesRes.Data.Supplies["TKN-abcd"])
+		require.Equal(t, data.ESDTSupply{
+			Supply:        "2",
+			InitialMinted: "0",
+		}, suppliesRes.Data.Supplies["NFT-abcd-01"])
+	})
+	t.Run("all observers of a shard failing should error", func(t *testing.T) {
+		t.Parallel()
+
+		baseProc := &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+			GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardID, Address: fmt.Sprintf("shard-%d", shardID)}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				if address == "shard-1" {
+					return 500, errors.New("local err")
+				}
+				return 200, nil
+			},
+		}
+		esdtProc, err := NewESDTSupplyProcessor(baseProc, &mock.SCQueryServiceStub{}, config.ESDTSupplyHistoryConfig{})
+		require.Nil(t, err)
+
+		suppliesRes, err := esdtProc.GetESDTSupplies([]string{"NFT-abcd-01"})
+		require.Nil(t, suppliesRes)
+		require.True(t, errors.Is(err, ErrSendingRequest))
+	})
+}
+
+func TestEsdtSupplyProcessor_GetESDTRoles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sc query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("no ticker with given name")
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		esdtProc, _ := NewESDTSupplyProcessor(&mock.ProcessorStub{}, scQueryProc, config.ESDTSupplyHistoryConfig{})
+
+		rolesRes, err := esdtProc.GetESDTRoles("TKN-abcdef")
+		require.Nil(t, rolesRes)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should decode the special roles", func(t *testing.T) {
+		t.Parallel()
+
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, esdtContractAddress, query.ScAddress)
+				require.Equal(t, esdtSpecialRolesFunc, query.FuncName)
+				require.Equal(t, [][]byte{[]byte("TKN-abcdef")}, query.Arguments)
+
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{
+						[]byte("erd1a:ESDTRoleLocalMint,ESDTRoleLocalBurn"),
+						[]byte("malformed"),
+						[]byte("erd1b:ESDTRoleLocalBurn"),
+					},
+				}, data.BlockInfo{}, nil
+			},
+		}
+		esdtProc, _ := NewESDTSupplyProcessor(&mock.ProcessorStub{}, scQueryProc, config.ESDTSupplyHistoryConfig{})
+
+		rolesRes, err := esdtProc.GetESDTRoles("TKN-abcdef")
+		require.Nil(t, err)
+		require.Equal(t, &data.ESDTRolesResponse{
+			Data: data.ESDTRoles{
+				Addresses: []*data.ESDTAddressRoles{
+					{Address: "erd1a", Roles: []string{"ESDTRoleLocalMint", "ESDTRoleLocalBurn"}},
+					{Address: "erd1b", Roles: []string{"ESDTRoleLocalBurn"}},
+				},
+				Roles: map[string][]string{
+					"ESDTRoleLocalMint": {"erd1a"},
+					"ESDTRoleLocalBurn": {"erd1a", "erd1b"},
+				},
+			},
+			Code: data.ReturnCodeSuccess,
+		}, rolesRes)
+	})
+}
+
+func TestEsdtSupplyProcessor_GetESDTSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	historyConfig := config.ESDTSupplyHistoryConfig{
+		Enabled:             true,
+		Tokens:              []string{"TKN-abcd"},
+		MaxTrackedTokens:    2,
+		MaxEpochs:           10,
+		SampleIntervalInSec: 1,
+	}
+	createBaseProc := func(epoch *uint32, minted *uint32) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, core.MetachainShardId}
+			},
+			GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardID, Address: fmt.Sprintf("shard-%d", shardID)}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				if path == NetworkStatusPath {
+					value.(*data.GenericAPIResponse).Data = map[string]interface{}{
+						"metrics": map[string]interface{}{
+							MetricEpochNumber: float64(atomic.LoadUint32(epoch)),
+						},
+					}
+					return http.StatusOK, nil
+				}
+
+				valResp := value.(*data.ESDTSupplyResponse)
+				valResp.Data.Supply = "1"
+				valResp.Data.Minted = fmt.Sprintf("%d", atomic.LoadUint32(minted))
+				valResp.Data.Burned = "0"
+				return http.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("disabled history should error", func(t *testing.T) {
+		t.Parallel()
+
+		esdtProc, _ := NewESDTSupplyProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, config.ESDTSupplyHistoryConfig{})
+		statusCode, response, err := esdtProc.GetESDTSupplyHistory("TKN-abcd")
+		require.Nil(t, response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, ErrESDTSupplyHistoryDisabled, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := historyConfig
+		cfg.MaxEpochs = 0
+		esdtProc, err := NewESDTSupplyProcessor(&mock.ProcessorStub{}, &mock.SCQueryServiceStub{}, cfg)
+		require.Nil(t, esdtProc)
+		require.True(t, errors.Is(err, ErrInvalidESDTSupplyHistoryConfig))
+	})
+	t.Run("should track the tokens and compute the per-epoch amounts", func(t *testing.T) {
+		t.Parallel()
+
+		epoch, minted := uint32(10), uint32(100)
+		scQueryProc := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{nil, nil, nil, []byte("0")},
+				}, data.BlockInfo{}, nil
+			},
+		}
+		esdtProc, err := NewESDTSupplyProcessor(createBaseProc(&epoch, &minted), scQueryProc, historyConfig)
+		require.Nil(t, err)
+
+		// the configured token is tracked, but not sampled yet
+		statusCode, response, err := esdtProc.GetESDTSupplyHistory("TKN-abcd")
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Empty(t, response.Data.Epochs)
+
+		esdtProc.sampleTrackedSupplies()
+		atomic.StoreUint32(&minted, 150)
+		esdtProc.sampleTrackedSupplies()
+		atomic.StoreUint32(&epoch, 11)
+		atomic.StoreUint32(&minted, 175)
+		esdtProc.sampleTrackedSupplies()
+
+		_, response, _ = esdtProc.GetESDTSupplyHistory("TKN-abcd")
+		require.Equal(t, []*data.ESDTSupplyEpoch{
+			{Epoch: 10, Supply: "1", TotalMinted: "150", TotalBurned: "0"},
+			{Epoch: 11, Supply: "1", Minted: "25", Burned: "0", TotalMinted: "175", TotalBurned: "0"},
+		}, response.Data.Epochs)
+
+		// a new token is sampled right away
+		statusCode, response, err = esdtProc.GetESDTSupplyHistory("NFT-abcd-01")
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, []*data.ESDTSupplyEpoch{
+			{Epoch: 11, Supply: "1", TotalMinted: "175", TotalBurned: "0"},
+		}, response.Data.Epochs)
+
+		statusCode, _, err = esdtProc.GetESDTSupplyHistory("NFT-abcd-02")
+		require.Equal(t, http.StatusTooManyRequests, statusCode)
+		require.True(t, errors.Is(err, ErrTooManyTrackedTokens))
+	})
+}
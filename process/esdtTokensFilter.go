@@ -0,0 +1,84 @@
+package process
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const singleNFTBalance = "1"
+
+// esdtTokenSummary holds the fields of an ESDT token, as returned by the observers, that are needed for filtering
+type esdtTokenSummary struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	Balance         string `json:"balance"`
+	Nonce           uint64 `json:"nonce"`
+}
+
+// filterESDTTokens returns the tokens matching the provided filter, sorted by their identifier. The tokens are kept
+// in the format returned by the observers
+func filterESDTTokens(tokens map[string]json.RawMessage, filter common.ESDTTokensFilterOptions) ([]json.RawMessage, error) {
+	identifiers := make([]string, 0, len(tokens))
+	for identifier := range tokens {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	search := strings.ToLower(filter.Search)
+	filteredTokens := make([]json.RawMessage, 0, len(tokens))
+	for _, identifier := range identifiers {
+		token := tokens[identifier]
+		summary := &esdtTokenSummary{}
+		err := json.Unmarshal(token, summary)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(filter.TokenType) > 0 && computeESDTTypeFilter(summary) != filter.TokenType {
+			continue
+		}
+		if len(search) > 0 && !matchesESDTSearch(identifier, summary, search) {
+			continue
+		}
+
+		filteredTokens = append(filteredTokens, token)
+	}
+
+	return filteredTokens, nil
+}
+
+// computeESDTTypeFilter returns the type filter value matching the provided token. Older observers do not provide the
+// type of the token, in which case it is deduced from the nonce and the balance
+func computeESDTTypeFilter(token *esdtTokenSummary) string {
+	switch strings.TrimPrefix(token.Type, core.Dynamic) {
+	case core.FungibleESDT:
+		return data.ESDTTypeFungible
+	case core.NonFungibleESDT, core.NonFungibleESDTv2:
+		return data.ESDTTypeNFT
+	case core.SemiFungibleESDT:
+		return data.ESDTTypeSFT
+	case core.MetaESDT:
+		return data.ESDTTypeMeta
+	}
+
+	if token.Nonce == 0 {
+		return data.ESDTTypeFungible
+	}
+	if token.Balance == singleNFTBalance {
+		return data.ESDTTypeNFT
+	}
+
+	return data.ESDTTypeSFT
+}
+
+func matchesESDTSearch(identifier string, token *esdtTokenSummary, search string) bool {
+	return strings.Contains(strings.ToLower(identifier), search) ||
+		strings.Contains(strings.ToLower(token.TokenIdentifier), search) ||
+		strings.Contains(strings.ToLower(token.Name), search)
+}
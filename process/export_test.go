@@ -41,3 +41,92 @@ func ApplySortOnScrs(tx *transaction.ApiTransactionResult) *transaction.ApiTrans
 func CheckIfFailed(logs []*transaction.ApiLogs) (bool, string) {
 	return checkIfFailed(logs)
 }
+
+// ComputeJsonDifferences -
+func ComputeJsonDifferences(first []byte, second []byte) []string {
+	return computeJsonDifferences(first, second)
+}
+
+// SetGetTimeHandler -
+func (dp *DrainProcessor) SetGetTimeHandler(handler func() time.Time) {
+	dp.getTimeHandler = handler
+}
+
+// SetGetTimeHandler -
+func (nmp *NonceManagerProcessor) SetGetTimeHandler(handler func() time.Time) {
+	nmp.getTimeHandler = handler
+}
+
+// SetSleepHandler -
+func (fip *FaultInjectionProcessor) SetSleepHandler(handler func(duration time.Duration)) {
+	fip.sleepHandler = handler
+}
+
+// SetGetTimeHandler -
+func (tsw *TransactionStatusWatcher) SetGetTimeHandler(handler func() time.Time) {
+	tsw.getTimeHandler = handler
+}
+
+// CheckWatchedTransactions -
+func (tsw *TransactionStatusWatcher) CheckWatchedTransactions() {
+	tsw.checkWatchedTransactions()
+}
+
+// NumWatchedTransactions -
+func (tsw *TransactionStatusWatcher) NumWatchedTransactions() int {
+	tsw.mutWatches.Lock()
+	defer tsw.mutWatches.Unlock()
+
+	return len(tsw.watchedTxs)
+}
+
+// NumWatchedTransactions -
+func (wp *WebhooksProcessor) NumWatchedTransactions() int {
+	wp.mutWatches.Lock()
+	defer wp.mutWatches.Unlock()
+
+	return len(wp.watches)
+}
+
+// CheckWatchedAddresses -
+func (wp *WebhooksProcessor) CheckWatchedAddresses() {
+	wp.checkWatchedAddresses()
+}
+
+// HandleFeedMessage -
+func (ofp *ObserversFeedProcessor) HandleFeedMessage(message []byte) []byte {
+	return ofp.handleFeedMessage("observer", message)
+}
+
+// NewAuditLogWithFileSink -
+func NewAuditLogWithFileSink(filePath string, maxFileSize int64, maxBackupFiles int) (*AuditLog, error) {
+	sink, err := newAuditFileSink(filePath, maxFileSize, maxBackupFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAuditLog(sink), nil
+}
+
+// SetGetTimeHandler -
+func (al *AuditLog) SetGetTimeHandler(handler func() time.Time) {
+	al.getTimeHandler = handler
+}
+
+// NumSentTransactions -
+func (al *AuditLog) NumSentTransactions() int {
+	al.mutSentTxs.Lock()
+	defer al.mutSentTxs.Unlock()
+
+	return len(al.sentTxs)
+}
+
+// CheckShards -
+func (lsp *LoadSheddingProcessor) CheckShards() {
+	lsp.checkShards()
+}
+
+// SetRandHandler -
+func (al *AccessLog) SetRandHandler(handler func() float64) {
+	al.randHandler = handler
+}
@@ -0,0 +1,77 @@
+package factory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+const (
+	memoryCacheBackend = "memory"
+	redisCacheBackend  = "redis"
+	economicsCacheKey  = "economics"
+)
+
+// ArgsCachers holds the arguments needed for creating the caches
+type ArgsCachers struct {
+	Config                      config.CacheBackendConfig
+	HeartbeatCacheValidity      time.Duration
+	ValidatorStatsCacheValidity time.Duration
+	EconomicsCacheValidity      time.Duration
+}
+
+// Cachers holds the caches of the heartbeats, of the validator statistics and of the economic metrics. If the caches
+// are shared through a Redis server, the SharedStore is its client, otherwise nil
+type Cachers struct {
+	HeartbeatCacher       HeartbeatCacher
+	ValidatorStatsCacher  ValidatorStatisticsCacher
+	EconomicMetricsCacher GenericApiResponseCacher
+	SharedStore           *cache.RedisClient
+}
+
+// CreateCachers will return the caches needed for the configured backend
+func CreateCachers(args ArgsCachers) (*Cachers, error) {
+	switch args.Config.Type {
+	case "", memoryCacheBackend:
+		return &Cachers{
+			HeartbeatCacher:       cache.NewHeartbeatMemoryCacher(),
+			ValidatorStatsCacher:  cache.NewValidatorsStatsMemoryCacher(),
+			EconomicMetricsCacher: cache.NewGenericApiResponseMemoryCacher(),
+		}, nil
+	case redisCacheBackend:
+		return createRedisCachers(args)
+	default:
+		return nil, fmt.Errorf("%w, unknown cache backend %q", cache.ErrInvalidCacheBackend, args.Config.Type)
+	}
+}
+
+func createRedisCachers(args ArgsCachers) (*Cachers, error) {
+	redisClient, err := cache.NewRedisClient(args.Config.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatCacher, err := cache.NewHeartbeatRedisCacher(redisClient, args.HeartbeatCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+	valStatsCacher, err := cache.NewValidatorsStatsRedisCacher(redisClient, args.ValidatorStatsCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+	economicMetricsCacher, err := cache.NewGenericApiResponseRedisCacher(redisClient, economicsCacheKey, args.EconomicsCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("the caches are shared through Redis", "address", args.Config.Redis.Address, "key prefix", args.Config.Redis.KeyPrefix)
+
+	return &Cachers{
+		HeartbeatCacher:       heartbeatCacher,
+		ValidatorStatsCacher:  valStatsCacher,
+		EconomicMetricsCacher: economicMetricsCacher,
+		SharedStore:           redisClient,
+	}, nil
+}
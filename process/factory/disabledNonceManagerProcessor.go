@@ -0,0 +1,33 @@
+package factory
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var errNonceManagerNotEnabled = errors.New("nonce manager not enabled")
+
+type disabledNonceManagerProcessor struct {
+}
+
+// IsEnabled will return false
+func (d *disabledNonceManagerProcessor) IsEnabled() bool {
+	return false
+}
+
+// SendManagedTransaction will return an error that signals that the nonce manager is not enabled
+func (d *disabledNonceManagerProcessor) SendManagedTransaction(_ *data.Transaction) (int, string, error) {
+	return http.StatusBadRequest, "", errNonceManagerNotEnabled
+}
+
+// ReserveNonces will return an error that signals that the nonce manager is not enabled
+func (d *disabledNonceManagerProcessor) ReserveNonces(_ *data.NonceReservationRequest) (int, *data.NonceReservation, error) {
+	return http.StatusBadRequest, nil, errNonceManagerNotEnabled
+}
+
+// ReleaseNonces will return an error that signals that the nonce manager is not enabled
+func (d *disabledNonceManagerProcessor) ReleaseNonces(_ *data.NonceReleaseRequest) (int, error) {
+	return http.StatusBadRequest, errNonceManagerNotEnabled
+}
@@ -0,0 +1,42 @@
+package factory
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type disabledObserversFeedProcessor struct {
+}
+
+// IsEnabled will return false
+func (d *disabledObserversFeedProcessor) IsEnabled() bool {
+	return false
+}
+
+// GetLatestBlocks will return an empty slice
+func (d *disabledObserversFeedProcessor) GetLatestBlocks() []*data.FeedBlock {
+	return make([]*data.FeedBlock, 0)
+}
+
+// WatchBlocks will return a closed channel
+func (d *disabledObserversFeedProcessor) WatchBlocks(_ context.Context, _ uint32) <-chan *data.BlockNotification {
+	chanNotifications := make(chan *data.BlockNotification)
+	close(chanNotifications)
+
+	return chanNotifications
+}
+
+// IsTransactionIncluded will return false
+func (d *disabledObserversFeedProcessor) IsTransactionIncluded(_ string) bool {
+	return false
+}
+
+// StartConsuming does nothing
+func (d *disabledObserversFeedProcessor) StartConsuming() {
+}
+
+// Close returns nil
+func (d *disabledObserversFeedProcessor) Close() error {
+	return nil
+}
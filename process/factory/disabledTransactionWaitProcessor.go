@@ -0,0 +1,24 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var errTransactionWaitNotEnabled = errors.New("transaction wait not enabled")
+
+type disabledTransactionWaitProcessor struct {
+}
+
+// IsEnabled will return false
+func (d *disabledTransactionWaitProcessor) IsEnabled() bool {
+	return false
+}
+
+// WaitForExecution will return an error that signals that the transaction wait is not enabled
+func (d *disabledTransactionWaitProcessor) WaitForExecution(_ context.Context, _ string, _ time.Duration) (*data.TransactionExecutionResult, error) {
+	return nil, errTransactionWaitNotEnabled
+}
@@ -0,0 +1,48 @@
+package factory
+
+import (
+	"errors"
+)
+
+var errWebhooksNotEnabled = errors.New("webhooks not enabled")
+
+type disabledWebhooksProcessor struct {
+}
+
+// IsEnabled will return false
+func (d *disabledWebhooksProcessor) IsEnabled() bool {
+	return false
+}
+
+// WatchTransaction will return an error that signals that the webhooks are not enabled
+func (d *disabledWebhooksProcessor) WatchTransaction(_ string, _ string) error {
+	return errWebhooksNotEnabled
+}
+
+// RegisterSentTransaction does nothing
+func (d *disabledWebhooksProcessor) RegisterSentTransaction(_ string, _ string) {
+}
+
+// WatchAddresses will return an error that signals that the webhooks are not enabled
+func (d *disabledWebhooksProcessor) WatchAddresses(_ string, _ []string) error {
+	return errWebhooksNotEnabled
+}
+
+// UnwatchAddress will return an error that signals that the webhooks are not enabled
+func (d *disabledWebhooksProcessor) UnwatchAddress(_ string, _ string) error {
+	return errWebhooksNotEnabled
+}
+
+// GetWatchedAddresses will return an error that signals that the webhooks are not enabled
+func (d *disabledWebhooksProcessor) GetWatchedAddresses(_ string) ([]string, error) {
+	return nil, errWebhooksNotEnabled
+}
+
+// StartWatchingAddresses does nothing
+func (d *disabledWebhooksProcessor) StartWatchingAddresses() {
+}
+
+// Close returns nil
+func (d *disabledWebhooksProcessor) Close() error {
+	return nil
+}
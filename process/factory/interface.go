@@ -1,18 +1,30 @@
 package factory
 
 import (
+	"context"
+	"io"
+	"net/http"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
+	"github.com/multiversx/mx-chain-proxy-go/process"
 )
 
 // Processor defines what a processor should be able to do
 type Processor interface {
 	ComputeShardId(addressBuff []byte) (uint32, error)
 	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointWithContext(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointStream(ctx context.Context, address string, path string) (int, io.ReadCloser, error)
+	CallGetRestEndPointHedged(ctx context.Context, observers []*data.NodeData, path string, value interface{}) (*data.NodeData, int, error)
+	CallGetRestEndPointWithTruncation(ctx context.Context, address string, path string, value interface{}, numItemsToSkip uint64) (int, data.ResponseTruncation, error)
 	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallPostRestEndPointWithContext(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
+	CallRawRestEndPoint(ctx context.Context, address string, request *data.RawObserverRequest) (int, *http.Response, error)
 	GetObserversOnePerShard(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	GetFullHistoryNodesOnePerShard(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
@@ -31,3 +43,36 @@ type Processor interface {
 type PrivateKeysLoaderHandler interface {
 	PrivateKeysByShard() (map[uint32][]crypto.PrivateKey, error)
 }
+
+// WebhooksProcessor defines what the webhooks processor created by the factory should do
+type WebhooksProcessor interface {
+	facade.WebhooksProcessor
+	StartWatchingAddresses()
+	Close() error
+}
+
+// ObserversFeedProcessor defines what the observers feed processor created by the factory should do
+type ObserversFeedProcessor interface {
+	facade.ObserversFeedProcessor
+	IsTransactionIncluded(txHash string) bool
+	StartConsuming()
+	Close() error
+}
+
+// HeartbeatCacher defines what a heartbeats cache, reporting its metrics, should do
+type HeartbeatCacher interface {
+	process.HeartbeatCacheHandler
+	GetCacheMetrics() data.CacheMetrics
+}
+
+// ValidatorStatisticsCacher defines what a validator statistics cache, reporting its metrics, should do
+type ValidatorStatisticsCacher interface {
+	process.ValidatorStatisticsCacheHandler
+	GetCacheMetrics() data.CacheMetrics
+}
+
+// GenericApiResponseCacher defines what a generic api response cache, reporting its metrics, should do
+type GenericApiResponseCacher interface {
+	process.GenericApiResponseCacheHandler
+	GetCacheMetrics() data.CacheMetrics
+}
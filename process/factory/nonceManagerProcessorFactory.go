@@ -0,0 +1,32 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/faucet"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CreateNonceManagerProcessor will return the nonce manager processor needed for current settings
+func CreateNonceManagerProcessor(
+	accountProc process.ManagedSenderAccountHandler,
+	txProc process.ManagedTransactionsHandler,
+	shardCoordinator common.Coordinator,
+	pubKeyConverter core.PubkeyConverter,
+	cfg config.NonceManagerConfig,
+) (facade.NonceManagerProcessor, error) {
+	if !cfg.Enabled {
+		log.Info("nonce manager is disabled")
+		return &disabledNonceManagerProcessor{}, nil
+	}
+
+	log.Info("nonce manager is enabled", "pem file location", cfg.SendersPemFile)
+	privKeysLoader, err := faucet.NewPrivateKeysLoader(shardCoordinator, cfg.SendersPemFile, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return process.NewNonceManagerProcessor(accountProc, txProc, privKeysLoader, pubKeyConverter, cfg)
+}
@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CreateObserversFeedProcessor will return the observers feed processor needed for current settings
+func CreateObserversFeedProcessor(
+	marshalizer marshal.Marshalizer,
+	cfg config.ObserversFeedConfig,
+) (ObserversFeedProcessor, error) {
+	if !cfg.Enabled {
+		log.Info("observers feed is disabled")
+		return &disabledObserversFeedProcessor{}, nil
+	}
+
+	log.Info("observers feed is enabled", "num observers", len(cfg.Observers))
+
+	return process.NewObserversFeedProcessor(marshalizer, cfg)
+}
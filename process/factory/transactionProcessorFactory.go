@@ -4,6 +4,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/facade"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/logsevents"
@@ -17,6 +21,12 @@ func CreateTransactionProcessor(
 	hasher hashing.Hasher,
 	marshalizer marshal.Marshalizer,
 	allowEntireTxPoolFetch bool,
+	sendTxQuorumConfig config.SendTransactionQuorumConfig,
+	txsPolicyConfig config.TransactionsPolicyConfig,
+	sentTxsRecorder process.SentTransactionsRecorder,
+	duplicateNonceCheckConfig config.DuplicateNonceCheckConfig,
+	senderAccountHandler process.SenderAccountHandler,
+	signatureCheckConfig config.SignatureCheckConfig,
 ) (facade.TransactionProcessor, error) {
 	newTxCostProcessor := func() (process.TransactionCostHandler, error) {
 		return txcost.NewTransactionCostProcessor(
@@ -30,7 +40,7 @@ func CreateTransactionProcessor(
 		return nil, err
 	}
 
-	return process.NewTransactionProcessor(
+	txProc, err := process.NewTransactionProcessor(
 		proc,
 		pubKeyConverter,
 		hasher,
@@ -38,5 +48,31 @@ func CreateTransactionProcessor(
 		newTxCostProcessor,
 		logsMerger,
 		allowEntireTxPoolFetch,
+		sendTxQuorumConfig,
+		txsPolicyConfig,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = txProc.SetSentTransactionsRecorder(sentTxsRecorder)
+	if err != nil {
+		return nil, err
+	}
+
+	if duplicateNonceCheckConfig.Enabled {
+		err = txProc.SetSenderAccountHandler(senderAccountHandler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if signatureCheckConfig.Enabled {
+		err = txProc.SetSignatureChecker(signing.NewKeyGenerator(ed25519.NewEd25519()), &singlesig.Ed25519Signer{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return txProc, nil
 }
@@ -0,0 +1,23 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CreateTransactionWaitProcessor will return the transaction wait processor needed for current settings
+func CreateTransactionWaitProcessor(
+	txProc process.TransactionExecutionHandler,
+	statusWatcher process.TransactionStatusWatcherHandler,
+	cfg config.TransactionWaitConfig,
+) (facade.TransactionWaitProcessor, error) {
+	if !cfg.Enabled {
+		log.Info("transaction wait is disabled")
+		return &disabledTransactionWaitProcessor{}, nil
+	}
+
+	log.Info("transaction wait is enabled", "default timeout in sec", cfg.DefaultTimeoutInSec, "max timeout in sec", cfg.MaxTimeoutInSec)
+
+	return process.NewTransactionWaitProcessor(txProc, statusWatcher, cfg)
+}
@@ -0,0 +1,25 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CreateWebhooksProcessor will return the webhooks processor needed for current settings
+func CreateWebhooksProcessor(
+	statusWatcher process.TransactionStatusWatcherHandler,
+	accountsHandler process.WatchedAccountsHandler,
+	hyperblockHandler process.HyperblockNonceHandler,
+	cfg config.WebhooksConfig,
+) (WebhooksProcessor, error) {
+	if !cfg.Enabled {
+		log.Info("webhooks are disabled")
+		return &disabledWebhooksProcessor{}, nil
+	}
+
+	log.Info("webhooks are enabled",
+		"num webhooks", len(cfg.Webhooks),
+		"max watched addresses", cfg.MaxWatchedAddresses)
+
+	return process.NewWebhooksProcessor(statusWatcher, accountsHandler, hyperblockHandler, cfg)
+}
@@ -0,0 +1,241 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	maxFaultInjectionPercentage   = 100
+	maxFaultInjectionDelay        = time.Minute
+	defaultFaultInjectionHttpCode = http.StatusServiceUnavailable
+	faultInjectionResponseBody    = `{"data":null,"error":"fault injected by proxy","code":"internal_issue"}`
+)
+
+// FaultInjectionProcessor injects faults (delays, error responses and truncated bodies) in the calls towards the
+// observers, following the active scenario. The random decisions are taken using the seed of the scenario, so the same
+// sequence of requests always receives the same sequence of faults
+type FaultInjectionProcessor struct {
+	mutScenario  sync.Mutex
+	enabled      bool
+	scenario     *data.FaultInjectionScenario
+	randomizer   *rand.Rand
+	sleepHandler func(duration time.Duration)
+}
+
+// NewFaultInjectionProcessor creates a new instance of FaultInjectionProcessor. While disabled, no scenario can be set
+func NewFaultInjectionProcessor(enabled bool) *FaultInjectionProcessor {
+	if enabled {
+		log.Warn("Proxy started with fault injection enabled! Do not use this mode in production!")
+	}
+
+	return &FaultInjectionProcessor{
+		enabled:      enabled,
+		sleepHandler: time.Sleep,
+	}
+}
+
+// SetScenario activates the provided scenario, replacing the previous one. A nil scenario stops the fault injection
+func (fip *FaultInjectionProcessor) SetScenario(scenario *data.FaultInjectionScenario) error {
+	if !fip.enabled {
+		return ErrFaultInjectionNotEnabled
+	}
+	err := checkFaultInjectionScenario(scenario)
+	if err != nil {
+		return err
+	}
+
+	fip.mutScenario.Lock()
+	defer fip.mutScenario.Unlock()
+
+	if scenario == nil {
+		fip.scenario = nil
+		fip.randomizer = nil
+		log.Info("fault injection scenario cleared")
+		return nil
+	}
+
+	scenarioCopy := *scenario
+	scenarioCopy.PathPrefixes = append([]string(nil), scenario.PathPrefixes...)
+	if scenarioCopy.ErrorStatusCode == 0 {
+		scenarioCopy.ErrorStatusCode = defaultFaultInjectionHttpCode
+	}
+	fip.scenario = &scenarioCopy
+	fip.randomizer = rand.New(rand.NewSource(scenarioCopy.Seed))
+	log.Info("fault injection scenario set", "scenario", fmt.Sprintf("%+v", scenarioCopy))
+
+	return nil
+}
+
+func checkFaultInjectionScenario(scenario *data.FaultInjectionScenario) error {
+	if scenario == nil {
+		return nil
+	}
+
+	percentages := map[string]int{
+		"delayPercentage":    scenario.DelayPercentage,
+		"errorPercentage":    scenario.ErrorPercentage,
+		"truncatePercentage": scenario.TruncatePercentage,
+	}
+	for name, percentage := range percentages {
+		if percentage < 0 || percentage > maxFaultInjectionPercentage {
+			return fmt.Errorf("%w, %s: %d", ErrInvalidFaultInjectionScenario, name, percentage)
+		}
+	}
+	if time.Duration(scenario.DelayInMs)*time.Millisecond > maxFaultInjectionDelay {
+		return fmt.Errorf("%w, delayInMs: %d", ErrInvalidFaultInjectionScenario, scenario.DelayInMs)
+	}
+	if scenario.ErrorStatusCode != 0 && (scenario.ErrorStatusCode < http.StatusBadRequest || scenario.ErrorStatusCode > 599) {
+		return fmt.Errorf("%w, errorStatusCode: %d", ErrInvalidFaultInjectionScenario, scenario.ErrorStatusCode)
+	}
+
+	return nil
+}
+
+// GetStatus returns the fault injection state
+func (fip *FaultInjectionProcessor) GetStatus() *data.FaultInjectionStatus {
+	fip.mutScenario.Lock()
+	defer fip.mutScenario.Unlock()
+
+	status := &data.FaultInjectionStatus{
+		Enabled: fip.enabled,
+	}
+	if fip.scenario != nil {
+		scenarioCopy := *fip.scenario
+		status.Scenario = &scenarioCopy
+	}
+
+	return status
+}
+
+type faultInjectionDecision struct {
+	delay    time.Duration
+	httpCode int
+	truncate bool
+}
+
+func (fip *FaultInjectionProcessor) decide(path string) (faultInjectionDecision, bool) {
+	fip.mutScenario.Lock()
+	defer fip.mutScenario.Unlock()
+
+	if fip.scenario == nil || !matchesAnyPathPrefix(path, fip.scenario.PathPrefixes) {
+		return faultInjectionDecision{}, false
+	}
+
+	decision := faultInjectionDecision{}
+	if fip.isHit(fip.scenario.DelayPercentage) {
+		decision.delay = time.Duration(fip.scenario.DelayInMs) * time.Millisecond
+	}
+	if fip.isHit(fip.scenario.ErrorPercentage) {
+		decision.httpCode = fip.scenario.ErrorStatusCode
+	}
+	decision.truncate = fip.isHit(fip.scenario.TruncatePercentage)
+
+	return decision, true
+}
+
+// isHit must be called under mutex protection. It always consumes a random number, so the sequence of decisions
+// only depends on the seed and on the number of requests
+func (fip *FaultInjectionProcessor) isHit(percentage int) bool {
+	return fip.randomizer.Intn(maxFaultInjectionPercentage) < percentage
+}
+
+func matchesAnyPathPrefix(path string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wrapTransport returns a round tripper that injects the faults of the active scenario in the calls made using the
+// provided transport
+func (fip *FaultInjectionProcessor) wrapTransport(transport http.RoundTripper) http.RoundTripper {
+	if !fip.enabled {
+		return transport
+	}
+
+	return &faultInjectionTransport{
+		transport:      transport,
+		faultInjection: fip,
+	}
+}
+
+type faultInjectionTransport struct {
+	transport      http.RoundTripper
+	faultInjection *FaultInjectionProcessor
+}
+
+// RoundTrip executes the request, injecting the faults of the active scenario
+func (fit *faultInjectionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	decision, ok := fit.faultInjection.decide(req.URL.Path)
+	if !ok {
+		return fit.transport.RoundTrip(req)
+	}
+
+	if decision.delay > 0 {
+		log.Debug("fault injection: delaying observer request", "path", req.URL.Path, "delay", decision.delay)
+		fit.faultInjection.sleepHandler(decision.delay)
+	}
+	if decision.httpCode != 0 {
+		log.Debug("fault injection: failing observer request", "path", req.URL.Path, "code", decision.httpCode)
+		return createFaultInjectionResponse(req, decision.httpCode), nil
+	}
+
+	resp, err := fit.transport.RoundTrip(req)
+	if err != nil || !decision.truncate {
+		return resp, err
+	}
+
+	log.Debug("fault injection: truncating observer response", "path", req.URL.Path)
+	return truncateResponseBody(resp)
+}
+
+func createFaultInjectionResponse(req *http.Request, httpCode int) *http.Response {
+	body := []byte(faultInjectionResponseBody)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", httpCode, http.StatusText(httpCode)),
+		StatusCode:    httpCode,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// truncateResponseBody keeps only the first half of the response body, as if the connection was dropped
+func truncateResponseBody(resp *http.Response) (*http.Response, error) {
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	truncatedBody := body[:len(body)/2]
+	resp.Body = io.NopCloser(bytes.NewReader(truncatedBody))
+	resp.ContentLength = int64(len(truncatedBody))
+	resp.Header.Del("Content-Length")
+
+	return resp, nil
+}
@@ -0,0 +1,220 @@
+package process_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBaseProcessorWithFaultInjection(t *testing.T, faultInjection *process.FaultInjectionProcessor) *process.BaseProcessor {
+	bp, err := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
+	require.Nil(t, err)
+	require.Nil(t, bp.SetFaultInjectionProcessor(faultInjection))
+
+	return bp
+}
+
+func createCountingTestServer(response []byte, numCalls *uint32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddUint32(numCalls, 1)
+		_, _ = rw.Write(response)
+	}))
+}
+
+func TestBaseProcessor_SetFaultInjectionProcessorNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
+
+	err := bp.SetFaultInjectionProcessor(nil)
+	assert.Equal(t, process.ErrNilFaultInjectionProcessor, err)
+}
+
+func TestFaultInjectionProcessor_SetScenario(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		fip := process.NewFaultInjectionProcessor(false)
+		err := fip.SetScenario(&data.FaultInjectionScenario{ErrorPercentage: 100})
+		assert.Equal(t, process.ErrFaultInjectionNotEnabled, err)
+		assert.Equal(t, &data.FaultInjectionStatus{}, fip.GetStatus())
+	})
+	t.Run("invalid scenarios should error", func(t *testing.T) {
+		t.Parallel()
+
+		fip := process.NewFaultInjectionProcessor(true)
+		invalidScenarios := []*data.FaultInjectionScenario{
+			{DelayPercentage: -1},
+			{ErrorPercentage: 101},
+			{TruncatePercentage: 200},
+			{DelayInMs: 60001},
+			{ErrorStatusCode: http.StatusOK},
+			{ErrorStatusCode: 600},
+		}
+		for _, scenario := range invalidScenarios {
+			err := fip.SetScenario(scenario)
+			assert.True(t, errors.Is(err, process.ErrInvalidFaultInjectionScenario))
+		}
+		assert.Nil(t, fip.GetStatus().Scenario)
+	})
+	t.Run("should set and clear the scenario", func(t *testing.T) {
+		t.Parallel()
+
+		fip := process.NewFaultInjectionProcessor(true)
+		err := fip.SetScenario(&data.FaultInjectionScenario{ErrorPercentage: 30, Seed: 5})
+		require.Nil(t, err)
+
+		expectedStatus := &data.FaultInjectionStatus{
+			Enabled: true,
+			Scenario: &data.FaultInjectionScenario{
+				ErrorPercentage: 30,
+				ErrorStatusCode: http.StatusServiceUnavailable,
+				Seed:            5,
+			},
+		}
+		assert.Equal(t, expectedStatus, fip.GetStatus())
+
+		err = fip.SetScenario(nil)
+		require.Nil(t, err)
+		assert.Equal(t, &data.FaultInjectionStatus{Enabled: true}, fip.GetStatus())
+	})
+}
+
+func TestFaultInjectionProcessor_ErrorsShouldNotReachTheObserver(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	response, _ := json.Marshal(&testStruct{Nonce: 1})
+	server := createCountingTestServer(response, &numCalls)
+	defer server.Close()
+
+	fip := process.NewFaultInjectionProcessor(true)
+	bp := createBaseProcessorWithFaultInjection(t, fip)
+	err := fip.SetScenario(&data.FaultInjectionScenario{
+		ErrorPercentage: 100,
+		ErrorStatusCode: http.StatusBadGateway,
+	})
+	require.Nil(t, err)
+
+	responseCode, err := bp.CallGetRestEndPoint(server.URL, "/some/path", &testStruct{})
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadGateway, responseCode)
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalls))
+
+	_ = fip.SetScenario(nil)
+	responseCode, err = bp.CallGetRestEndPoint(server.URL, "/some/path", &testStruct{})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, responseCode)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestFaultInjectionProcessor_PathPrefixes(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	response, _ := json.Marshal(&testStruct{Nonce: 1})
+	server := createCountingTestServer(response, &numCalls)
+	defer server.Close()
+
+	fip := process.NewFaultInjectionProcessor(true)
+	bp := createBaseProcessorWithFaultInjection(t, fip)
+	err := fip.SetScenario(&data.FaultInjectionScenario{
+		PathPrefixes:    []string{"/transaction"},
+		ErrorPercentage: 100,
+	})
+	require.Nil(t, err)
+
+	responseCode, err := bp.CallGetRestEndPoint(server.URL, "/address/erd1", &testStruct{})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, responseCode)
+
+	responseCode, _ = bp.CallGetRestEndPoint(server.URL, "/transaction/pool", &testStruct{})
+	assert.Equal(t, http.StatusServiceUnavailable, responseCode)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestFaultInjectionProcessor_TruncatedBodyAndDelay(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	response, _ := json.Marshal(&testStruct{Nonce: 1, Name: "a name long enough to be truncated"})
+	server := createCountingTestServer(response, &numCalls)
+	defer server.Close()
+
+	fip := process.NewFaultInjectionProcessor(true)
+	var sleptDuration time.Duration
+	fip.SetSleepHandler(func(duration time.Duration) {
+		sleptDuration += duration
+	})
+	bp := createBaseProcessorWithFaultInjection(t, fip)
+	err := fip.SetScenario(&data.FaultInjectionScenario{
+		DelayInMs:          250,
+		DelayPercentage:    100,
+		TruncatePercentage: 100,
+	})
+	require.Nil(t, err)
+
+	_, err = bp.CallGetRestEndPoint(server.URL, "/some/path", &testStruct{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 250*time.Millisecond, sleptDuration)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestFaultInjectionProcessor_SameSeedShouldInjectTheSameFaults(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	response, _ := json.Marshal(&testStruct{Nonce: 1})
+	server := createCountingTestServer(response, &numCalls)
+	defer server.Close()
+
+	fip := process.NewFaultInjectionProcessor(true)
+	bp := createBaseProcessorWithFaultInjection(t, fip)
+	scenario := &data.FaultInjectionScenario{
+		ErrorPercentage: 50,
+		Seed:            42,
+	}
+
+	getResponseCodes := func() []int {
+		err := fip.SetScenario(scenario)
+		require.Nil(t, err)
+
+		codes := make([]int, 0, 20)
+		for i := 0; i < 20; i++ {
+			code, _ := bp.CallGetRestEndPoint(server.URL, "/some/path", &testStruct{})
+			codes = append(codes, code)
+		}
+
+		return codes
+	}
+
+	firstRun := getResponseCodes()
+	secondRun := getResponseCodes()
+	assert.Equal(t, firstRun, secondRun)
+	assert.Contains(t, firstRun, http.StatusOK)
+	assert.Contains(t, firstRun, http.StatusServiceUnavailable)
+}
@@ -0,0 +1,30 @@
+package process
+
+import proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+
+// getNodesForEpoch returns the full history nodes of the shard holding the provided epoch, so the archival storage can
+// be split by epochs across more nodes. If no full history node is configured, the observers are returned instead
+func getNodesForEpoch(proc Processor, shardID uint32, epoch uint32) ([]*proxyData.NodeData, error) {
+	fullHistoryNodes, err := proc.GetFullHistoryNodes(shardID, proxyData.AvailabilityAll)
+	if err == nil {
+		return filterNodesByEpoch(fullHistoryNodes, epoch), nil
+	}
+
+	return proc.GetObservers(shardID, proxyData.AvailabilityAll)
+}
+
+// filterNodesByEpoch returns the nodes holding the provided epoch. If none of them holds it, all the nodes are returned,
+// as the configured ranges might be outdated
+func filterNodesByEpoch(nodes []*proxyData.NodeData, epoch uint32) []*proxyData.NodeData {
+	nodesHoldingEpoch := make([]*proxyData.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if node.HoldsEpoch(epoch) {
+			nodesHoldingEpoch = append(nodesHoldingEpoch, node)
+		}
+	}
+	if len(nodesHoldingEpoch) == 0 {
+		return nodes
+	}
+
+	return nodesHoldingEpoch
+}
@@ -0,0 +1,194 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const medianPercentile = 50
+
+// hedgedRequestsHandler decides the order in which the observers are called by the hedged requests and how long to wait
+// for the fastest of them before sending a second request, based on their recent response times
+type hedgedRequestsHandler struct {
+	delayPercentile float64
+	minDelay        time.Duration
+	maxDelay        time.Duration
+	responses       *observersResponsesTracker
+}
+
+type hedgedResponse struct {
+	observer   *proxyData.NodeData
+	body       json.RawMessage
+	statusCode int
+	err        error
+}
+
+func newHedgedRequestsHandler(cfg config.HedgedRequestsConfig, responses *observersResponsesTracker) (*hedgedRequestsHandler, error) {
+	if cfg.DelayPercentile <= 0 || cfg.DelayPercentile > 100 {
+		return nil, fmt.Errorf("%w, DelayPercentile: %f", ErrInvalidHedgedRequestsConfig, cfg.DelayPercentile)
+	}
+	if cfg.MinDelayInMs < 1 {
+		return nil, fmt.Errorf("%w, MinDelayInMs: %d", ErrInvalidHedgedRequestsConfig, cfg.MinDelayInMs)
+	}
+	if cfg.MaxDelayInMs < cfg.MinDelayInMs {
+		return nil, fmt.Errorf("%w, MaxDelayInMs: %d is lower than MinDelayInMs", ErrInvalidHedgedRequestsConfig, cfg.MaxDelayInMs)
+	}
+
+	return &hedgedRequestsHandler{
+		delayPercentile: cfg.DelayPercentile,
+		minDelay:        time.Duration(cfg.MinDelayInMs) * time.Millisecond,
+		maxDelay:        time.Duration(cfg.MaxDelayInMs) * time.Millisecond,
+		responses:       responses,
+	}, nil
+}
+
+// sortByLatency returns the observers sorted by their median response time. The observers without recorded response
+// times come last, in their original order
+func (hrh *hedgedRequestsHandler) sortByLatency(observers []*proxyData.NodeData) []*proxyData.NodeData {
+	medians := make(map[string]time.Duration, len(observers))
+	for _, observer := range observers {
+		median, found := hrh.responses.getLatencyPercentile(observer.Address, medianPercentile)
+		if found {
+			medians[observer.Address] = median
+		}
+	}
+
+	sortedObservers := make([]*proxyData.NodeData, len(observers))
+	copy(sortedObservers, observers)
+	sort.SliceStable(sortedObservers, func(i, j int) bool {
+		medianI, foundI := medians[sortedObservers[i].Address]
+		medianJ, foundJ := medians[sortedObservers[j].Address]
+		if foundI != foundJ {
+			return foundI
+		}
+
+		return medianI < medianJ
+	})
+
+	return sortedObservers
+}
+
+// getHedgeDelay returns the duration to wait for the provided observer before sending a second request
+func (hrh *hedgedRequestsHandler) getHedgeDelay(address string) time.Duration {
+	delay, found := hrh.responses.getLatencyPercentile(address, hrh.delayPercentile)
+	if !found || delay > hrh.maxDelay {
+		return hrh.maxDelay
+	}
+	if delay < hrh.minDelay {
+		return hrh.minDelay
+	}
+
+	return delay
+}
+
+// CallGetRestEndPointHedged calls the provided observers until one of them responds successfully, returning it. When the
+// hedged requests are enabled, the fastest observer is called first and, if it does not respond in time, a second
+// request is sent to the next one, the first successful response being kept while the other request is canceled. A
+// failed request is immediately followed by a request to the next observer. When the hedged requests are disabled, the
+// observers are called one after the other, in the provided order
+func (bp *BaseProcessor) CallGetRestEndPointHedged(
+	ctx context.Context,
+	observers []*proxyData.NodeData,
+	path string,
+	value interface{},
+) (*proxyData.NodeData, int, error) {
+	if len(observers) == 0 {
+		return nil, http.StatusInternalServerError, ErrMissingObserver
+	}
+
+	if bp.hedgedRequests == nil {
+		return bp.callGetRestEndPointSequentially(ctx, observers, path, value)
+	}
+
+	return bp.callGetRestEndPointHedged(ctx, observers, path, value)
+}
+
+func (bp *BaseProcessor) callGetRestEndPointSequentially(
+	ctx context.Context,
+	observers []*proxyData.NodeData,
+	path string,
+	value interface{},
+) (*proxyData.NodeData, int, error) {
+	var statusCode int
+	var err error
+	for _, observer := range observers {
+		statusCode, err = bp.CallGetRestEndPointWithContext(ctx, observer.Address, path, value)
+		if err == nil {
+			return observer, statusCode, nil
+		}
+
+		log.Debug("hedged request: observer failed", "observer", observer.Address, "path", path, "error", err.Error())
+	}
+
+	return nil, statusCode, err
+}
+
+func (bp *BaseProcessor) callGetRestEndPointHedged(
+	parentCtx context.Context,
+	observers []*proxyData.NodeData,
+	path string,
+	value interface{},
+) (*proxyData.NodeData, int, error) {
+	sortedObservers := bp.hedgedRequests.sortByLatency(observers)
+
+	ctx, cancel := context.WithCancel(parentCtx)
+	// the pending requests are canceled as soon as a response is returned, or when the client request is canceled
+	defer cancel()
+
+	// buffered, so the canceled requests do not block their go routines
+	chanResponses := make(chan *hedgedResponse, len(sortedObservers))
+	nextIndex := 0
+	numPending := 0
+	sendNextRequest := func() {
+		observer := sortedObservers[nextIndex]
+		nextIndex++
+		numPending++
+
+		go func() {
+			response := &hedgedResponse{observer: observer}
+			response.statusCode, response.err = bp.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response.body)
+			chanResponses <- response
+		}()
+	}
+
+	sendNextRequest()
+	hedgeTimer := time.NewTimer(bp.hedgedRequests.getHedgeDelay(sortedObservers[0].Address))
+	defer hedgeTimer.Stop()
+
+	var lastResponse *hedgedResponse
+	for numPending > 0 {
+		select {
+		case <-hedgeTimer.C:
+			if nextIndex < len(sortedObservers) {
+				log.Debug("hedged request: sending a second request", "slow observer", sortedObservers[0].Address, "path", path)
+				sendNextRequest()
+			}
+
+		case response := <-chanResponses:
+			numPending--
+			if response.err == nil {
+				return response.observer, response.statusCode, json.Unmarshal(response.body, value)
+			}
+
+			log.Debug("hedged request: observer failed", "observer", response.observer.Address, "path", path, "error", response.err.Error())
+			lastResponse = response
+			if nextIndex < len(sortedObservers) {
+				sendNextRequest()
+			}
+		}
+	}
+
+	// the body of the last failed response is still decoded, so the callers can read the error it holds
+	if len(lastResponse.body) > 0 {
+		_ = json.Unmarshal(lastResponse.body, value)
+	}
+
+	return nil, lastResponse.statusCode, lastResponse.err
+}
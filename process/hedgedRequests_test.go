@@ -0,0 +1,109 @@
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createHedgedRequestsConfig() config.HedgedRequestsConfig {
+	return config.HedgedRequestsConfig{
+		Enabled:         true,
+		DelayPercentile: 90,
+		MinDelayInMs:    10,
+		MaxDelayInMs:    100,
+	}
+}
+
+func TestNewHedgedRequestsHandler(t *testing.T) {
+	t.Parallel()
+
+	invalidConfigs := map[string]func(cfg *config.HedgedRequestsConfig){
+		"zero percentile":        func(cfg *config.HedgedRequestsConfig) { cfg.DelayPercentile = 0 },
+		"percentile above 100":   func(cfg *config.HedgedRequestsConfig) { cfg.DelayPercentile = 101 },
+		"zero min delay":         func(cfg *config.HedgedRequestsConfig) { cfg.MinDelayInMs = 0 },
+		"max lower than the min": func(cfg *config.HedgedRequestsConfig) { cfg.MaxDelayInMs = 5 },
+	}
+	for name, alterConfig := range invalidConfigs {
+		cfg := createHedgedRequestsConfig()
+		alterConfig(&cfg)
+		hrh, err := newHedgedRequestsHandler(cfg, newObserversResponsesTracker())
+		require.Nil(t, hrh, name)
+		require.True(t, errors.Is(err, ErrInvalidHedgedRequestsConfig), name)
+	}
+
+	hrh, err := newHedgedRequestsHandler(createHedgedRequestsConfig(), newObserversResponsesTracker())
+	require.NoError(t, err)
+	require.NotNil(t, hrh)
+}
+
+func TestHedgedRequestsHandler_SortByLatency(t *testing.T) {
+	t.Parallel()
+
+	responses := newObserversResponsesTracker()
+	responses.recordResponse("slow", 300*time.Millisecond)
+	responses.recordResponse("fast", 10*time.Millisecond)
+	responses.recordResponse("fast", 20*time.Millisecond)
+	responses.recordResponse("medium", 50*time.Millisecond)
+	hrh, _ := newHedgedRequestsHandler(createHedgedRequestsConfig(), responses)
+
+	observers := []*proxyData.NodeData{
+		{Address: "unknown-1"},
+		{Address: "slow"},
+		{Address: "unknown-2"},
+		{Address: "medium"},
+		{Address: "fast"},
+	}
+	sortedObservers := hrh.sortByLatency(observers)
+
+	sortedAddresses := make([]string, 0, len(sortedObservers))
+	for _, observer := range sortedObservers {
+		sortedAddresses = append(sortedAddresses, observer.Address)
+	}
+	require.Equal(t, []string{"fast", "medium", "slow", "unknown-1", "unknown-2"}, sortedAddresses)
+	require.Equal(t, "unknown-1", observers[0].Address)
+}
+
+func TestHedgedRequestsHandler_GetHedgeDelay(t *testing.T) {
+	t.Parallel()
+
+	responses := newObserversResponsesTracker()
+	for i := 1; i <= 10; i++ {
+		responses.recordResponse("observer", time.Duration(i*5)*time.Millisecond)
+	}
+	responses.recordResponse("very-fast", time.Millisecond)
+	responses.recordResponse("very-slow", time.Second)
+	hrh, _ := newHedgedRequestsHandler(createHedgedRequestsConfig(), responses)
+
+	require.Equal(t, 45*time.Millisecond, hrh.getHedgeDelay("observer"))
+	require.Equal(t, 10*time.Millisecond, hrh.getHedgeDelay("very-fast"))
+	require.Equal(t, 100*time.Millisecond, hrh.getHedgeDelay("very-slow"))
+	require.Equal(t, 100*time.Millisecond, hrh.getHedgeDelay("unknown"))
+}
+
+func TestObserversResponsesTracker_GetLatencyPercentile(t *testing.T) {
+	t.Parallel()
+
+	tracker := newObserversResponsesTracker()
+	_, found := tracker.getLatencyPercentile("observer", 50)
+	require.False(t, found)
+
+	// the oldest samples are overwritten once the maximum number of samples is reached
+	for i := 0; i < maxLatencySamplesPerObserver; i++ {
+		tracker.recordResponse("observer", time.Hour)
+	}
+	for i := 1; i <= maxLatencySamplesPerObserver; i++ {
+		tracker.recordResponse("observer", time.Duration(i)*time.Millisecond)
+	}
+
+	latency, found := tracker.getLatencyPercentile("observer", 50)
+	require.True(t, found)
+	require.Equal(t, 50*time.Millisecond, latency)
+
+	latency, _ = tracker.getLatencyPercentile("observer", 100)
+	require.Equal(t, 100*time.Millisecond, latency)
+}
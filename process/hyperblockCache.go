@@ -0,0 +1,169 @@
+package process
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+const defaultHyperblockNonceEntriesTTL = 6 * time.Second
+
+type hyperblockCacheEntry struct {
+	response       *data.HyperblockApiResponse
+	nonceKey       string
+	hashKey        string
+	nonceExpiresAt time.Time
+}
+
+// hyperblockCache holds the most recently assembled hyperblocks, reachable both by the nonce and by the hash of their
+// metachain block, so the repeated requests of the same hyperblock do not trigger again its assembly out of the blocks
+// of all the shards. Once full, the oldest assembled hyperblock is dropped. As the metachain block with a given nonce
+// might not be final yet, the nonce keys expire after a short while, while the hash keys live until the entry is dropped
+type hyperblockCache struct {
+	mut             sync.Mutex
+	maxEntries      int
+	nonceEntriesTTL time.Duration
+	entries         map[string]*hyperblockCacheEntry
+	order           []*hyperblockCacheEntry
+	metrics         cache.MetricsCounters
+	getTimeHandler  func() time.Time
+}
+
+func newHyperblockCache(cfg config.HyperblockCacheConfig) (*hyperblockCache, error) {
+	if cfg.MaxEntries <= 0 {
+		return nil, fmt.Errorf("%w, MaxEntries should be positive", ErrInvalidHyperblockCacheConfig)
+	}
+	if cfg.NonceEntriesTTLInSec < 0 {
+		return nil, fmt.Errorf("%w, NonceEntriesTTLInSec should not be negative", ErrInvalidHyperblockCacheConfig)
+	}
+
+	nonceEntriesTTL := time.Duration(cfg.NonceEntriesTTLInSec) * time.Second
+	if nonceEntriesTTL == 0 {
+		nonceEntriesTTL = defaultHyperblockNonceEntriesTTL
+	}
+
+	return &hyperblockCache{
+		maxEntries:      cfg.MaxEntries,
+		nonceEntriesTTL: nonceEntriesTTL,
+		entries:         make(map[string]*hyperblockCacheEntry),
+		order:           make([]*hyperblockCacheEntry, 0, cfg.MaxEntries),
+		getTimeHandler:  time.Now,
+	}, nil
+}
+
+// createHyperblockCacheKeyPrefix returns the part of the key built out of the options, as they change the content of
+// the assembled hyperblock
+func createHyperblockCacheKeyPrefix(options common.HyperblockQueryOptions) string {
+	return fmt.Sprintf("%t|%t|%t|%s|",
+		options.WithLogs,
+		options.NotarizedAtSource,
+		options.WithAlteredAccounts,
+		options.AlteredAccountsOptions.TokensFilter,
+	)
+}
+
+func createHyperblockNonceCacheKey(nonce uint64, options common.HyperblockQueryOptions) string {
+	return fmt.Sprintf("%snonce:%d", createHyperblockCacheKeyPrefix(options), nonce)
+}
+
+func createHyperblockHashCacheKey(hash string, options common.HyperblockQueryOptions) string {
+	return createHyperblockCacheKeyPrefix(options) + "hash:" + strings.ToLower(hash)
+}
+
+// getByNonce returns the cached hyperblock of the metachain block with the provided nonce, unless the nonce key expired
+func (hc *hyperblockCache) getByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, bool) {
+	key := createHyperblockNonceCacheKey(nonce, options)
+
+	hc.mut.Lock()
+	entry, found := hc.entries[key]
+	if found && !hc.getTimeHandler().Before(entry.nonceExpiresAt) {
+		delete(hc.entries, key)
+		found = false
+	}
+	hc.mut.Unlock()
+
+	if !found {
+		hc.metrics.AddMiss()
+		return nil, false
+	}
+
+	hc.metrics.AddHit()
+	return entry.response, true
+}
+
+// getByHash returns the cached hyperblock of the metachain block with the provided hash
+func (hc *hyperblockCache) getByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, bool) {
+	key := createHyperblockHashCacheKey(hash, options)
+
+	hc.mut.Lock()
+	entry, found := hc.entries[key]
+	hc.mut.Unlock()
+
+	if !found {
+		hc.metrics.AddMiss()
+		return nil, false
+	}
+
+	hc.metrics.AddHit()
+	return entry.response, true
+}
+
+// put stores the assembled hyperblock under both the nonce and the hash of its metachain block. The nonce key of an
+// already cached hyperblock is refreshed, as it was assembled again out of the metachain block currently holding the nonce
+func (hc *hyperblockCache) put(response *data.HyperblockApiResponse, options common.HyperblockQueryOptions) {
+	hyperblock := response.Data.Hyperblock
+	entry := &hyperblockCacheEntry{
+		response: response,
+		nonceKey: createHyperblockNonceCacheKey(hyperblock.Nonce, options),
+		hashKey:  createHyperblockHashCacheKey(hyperblock.Hash, options),
+	}
+
+	hc.mut.Lock()
+	defer hc.mut.Unlock()
+
+	nonceExpiresAt := hc.getTimeHandler().Add(hc.nonceEntriesTTL)
+	existingEntry, exists := hc.entries[entry.hashKey]
+	if exists {
+		hc.setNonceKey(existingEntry, nonceExpiresAt)
+		return
+	}
+
+	if len(hc.order) >= hc.maxEntries {
+		oldest := hc.order[0]
+		hc.order = hc.order[1:]
+		hc.removeEntry(oldest)
+		hc.metrics.AddEvictions(1)
+	}
+
+	hc.removeEntry(hc.entries[entry.nonceKey])
+	hc.entries[entry.hashKey] = entry
+	hc.setNonceKey(entry, nonceExpiresAt)
+	hc.order = append(hc.order, entry)
+}
+
+// setNonceKey points the nonce key to the entry until the provided time. Should be called under the mutex
+func (hc *hyperblockCache) setNonceKey(entry *hyperblockCacheEntry, nonceExpiresAt time.Time) {
+	entry.nonceExpiresAt = nonceExpiresAt
+	hc.entries[entry.nonceKey] = entry
+}
+
+// removeEntry drops the keys of the entry still pointing to it, as the nonce key might have been taken over by the
+// hyperblock of another metachain block with the same nonce. Should be called under the mutex
+func (hc *hyperblockCache) removeEntry(entry *hyperblockCacheEntry) {
+	if entry == nil {
+		return
+	}
+
+	if hc.entries[entry.nonceKey] == entry {
+		delete(hc.entries, entry.nonceKey)
+	}
+	if hc.entries[entry.hashKey] == entry {
+		delete(hc.entries, entry.hashKey)
+	}
+}
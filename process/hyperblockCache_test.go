@@ -0,0 +1,146 @@
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createHyperblockResponse(nonce uint64, hash string) *data.HyperblockApiResponse {
+	return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce, Hash: hash})
+}
+
+func TestNewHyperblockCache(t *testing.T) {
+	t.Parallel()
+
+	hc, err := newHyperblockCache(config.HyperblockCacheConfig{Enabled: true})
+	require.Nil(t, hc)
+	require.True(t, errors.Is(err, ErrInvalidHyperblockCacheConfig))
+
+	hc, err = newHyperblockCache(config.HyperblockCacheConfig{Enabled: true, MaxEntries: 2, NonceEntriesTTLInSec: -1})
+	require.Nil(t, hc)
+	require.True(t, errors.Is(err, ErrInvalidHyperblockCacheConfig))
+
+	hc, err = newHyperblockCache(config.HyperblockCacheConfig{Enabled: true, MaxEntries: 2})
+	require.NoError(t, err)
+	require.NotNil(t, hc)
+	require.Equal(t, defaultHyperblockNonceEntriesTTL, hc.nonceEntriesTTL)
+
+	hc, err = newHyperblockCache(config.HyperblockCacheConfig{Enabled: true, MaxEntries: 2, NonceEntriesTTLInSec: 12})
+	require.NoError(t, err)
+	require.Equal(t, 12*time.Second, hc.nonceEntriesTTL)
+}
+
+func TestHyperblockCache_GetAndPut(t *testing.T) {
+	t.Parallel()
+
+	hc, _ := newHyperblockCache(config.HyperblockCacheConfig{Enabled: true, MaxEntries: 2})
+	options := common.HyperblockQueryOptions{WithLogs: true}
+
+	response := createHyperblockResponse(10, "aabb")
+	hc.put(response, options)
+
+	cached, found := hc.getByNonce(10, options)
+	require.True(t, found)
+	require.True(t, response == cached)
+	cached, found = hc.getByHash("AABB", options)
+	require.True(t, found)
+	require.True(t, response == cached)
+
+	// the options change the content of the hyperblock
+	_, found = hc.getByNonce(10, common.HyperblockQueryOptions{})
+	require.False(t, found)
+
+	metrics := hc.metrics.GetCacheMetrics()
+	require.Equal(t, uint64(2), metrics.NumHits)
+	require.Equal(t, uint64(1), metrics.NumMisses)
+}
+
+func TestHyperblockCache_Eviction(t *testing.T) {
+	t.Parallel()
+
+	hc, _ := newHyperblockCache(config.HyperblockCacheConfig{Enabled: true, MaxEntries: 2})
+	options := common.HyperblockQueryOptions{}
+
+	hc.put(createHyperblockResponse(10, "hash10"), options)
+	hc.put(createHyperblockResponse(11, "hash11"), options)
+	hc.put(createHyperblockResponse(12, "hash12"), options)
+
+	_, found := hc.getByNonce(10, options)
+	require.False(t, found)
+	_, found = hc.getByHash("hash10", options)
+	require.False(t, found)
+	_, found = hc.getByNonce(11, options)
+	require.True(t, found)
+	_, found = hc.getByNonce(12, options)
+	require.True(t, found)
+	require.Equal(t, uint64(1), hc.metrics.GetCacheMetrics().NumEvictions)
+}
+
+func TestHyperblockCache_SameNonceOtherHash(t *testing.T) {
+	t.Parallel()
+
+	hc, _ := newHyperblockCache(config.HyperblockCacheConfig{Enabled: true, MaxEntries: 10})
+	options := common.HyperblockQueryOptions{}
+
+	hc.put(createHyperblockResponse(10, "reverted"), options)
+	response := createHyperblockResponse(10, "final")
+	hc.put(response, options)
+
+	cached, found := hc.getByNonce(10, options)
+	require.True(t, found)
+	require.True(t, response == cached)
+	_, found = hc.getByHash("reverted", options)
+	require.False(t, found)
+}
+
+func TestHyperblockCache_NonceEntriesShouldExpire(t *testing.T) {
+	t.Parallel()
+
+	hc, _ := newHyperblockCache(config.HyperblockCacheConfig{Enabled: true, MaxEntries: 10, NonceEntriesTTLInSec: 6})
+	currentTime := time.Unix(1000, 0)
+	hc.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+	options := common.HyperblockQueryOptions{}
+
+	response := createHyperblockResponse(10, "aabb")
+	hc.put(response, options)
+
+	currentTime = currentTime.Add(5 * time.Second)
+	_, found := hc.getByNonce(10, options)
+	require.True(t, found)
+
+	// the nonce key expired, while the hash key is still served
+	currentTime = currentTime.Add(time.Second)
+	_, found = hc.getByNonce(10, options)
+	require.False(t, found)
+	cached, found := hc.getByHash("aabb", options)
+	require.True(t, found)
+	require.True(t, response == cached)
+
+	// assembling again the hyperblock of the same metachain block refreshes the nonce key
+	hc.put(createHyperblockResponse(10, "aabb"), options)
+	cached, found = hc.getByNonce(10, options)
+	require.True(t, found)
+	require.True(t, response == cached)
+
+	// the nonce is taken over by the metachain block replacing the cached one
+	replacingResponse := createHyperblockResponse(10, "ccdd")
+	hc.put(replacingResponse, options)
+	cached, found = hc.getByNonce(10, options)
+	require.True(t, found)
+	require.True(t, replacingResponse == cached)
+	_, found = hc.getByHash("aabb", options)
+	require.False(t, found)
+
+	metrics := hc.metrics.GetCacheMetrics()
+	require.Equal(t, uint64(4), metrics.NumHits)
+	require.Equal(t, uint64(2), metrics.NumMisses)
+}
@@ -1,6 +1,8 @@
 package process
 
 import (
+	"context"
+	"io"
 	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -23,7 +25,13 @@ type Processor interface {
 	GetShardIDs() []uint32
 	ComputeShardId(addressBuff []byte) (uint32, error)
 	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointWithContext(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointStream(ctx context.Context, address string, path string) (int, io.ReadCloser, error)
+	CallGetRestEndPointHedged(ctx context.Context, observers []*data.NodeData, path string, value interface{}) (*data.NodeData, int, error)
+	CallGetRestEndPointWithTruncation(ctx context.Context, address string, path string, value interface{}, numItemsToSkip uint64) (int, data.ResponseTruncation, error)
 	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallPostRestEndPointWithContext(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
+	CallRawRestEndPoint(ctx context.Context, address string, request *data.RawObserverRequest) (int, *http.Response, error)
 	GetShardCoordinator() common.Coordinator
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
@@ -39,7 +47,15 @@ type PrivateKeysLoaderHandler interface {
 // HeartbeatCacheHandler will define what a real heartbeat cacher should do
 type HeartbeatCacheHandler interface {
 	LoadHeartbeats() (*data.HeartbeatResponse, error)
+	LoadHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error)
 	StoreHeartbeats(hbts *data.HeartbeatResponse) error
+	ShouldRefresh() bool
+	IsInterfaceNil() bool
+}
+
+// HeartbeatsProvider defines what a component providing the heartbeats of the network nodes should do
+type HeartbeatsProvider interface {
+	GetHeartbeatData() (*data.HeartbeatResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -47,6 +63,7 @@ type HeartbeatCacheHandler interface {
 type ValidatorStatisticsCacheHandler interface {
 	LoadValStats() (map[string]*data.ValidatorApiResponse, error)
 	StoreValStats(valStats map[string]*data.ValidatorApiResponse) error
+	ShouldRefresh() bool
 	IsInterfaceNil() bool
 }
 
@@ -54,6 +71,7 @@ type ValidatorStatisticsCacheHandler interface {
 type GenericApiResponseCacheHandler interface {
 	Load() (*data.GenericAPIResponse, error)
 	Store(response *data.GenericAPIResponse)
+	ShouldRefresh() bool
 	IsInterfaceNil() bool
 }
 
@@ -70,7 +88,7 @@ type LogsMergerHandler interface {
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
 	IsInterfaceNil() bool
 }
 
@@ -78,6 +96,7 @@ type SCQueryService interface {
 type StatusMetricsProvider interface {
 	GetAll() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheus() string
+	GetCachesMetrics() map[string]*data.CacheMetrics
 	IsInterfaceNil() bool
 }
 
@@ -85,3 +104,131 @@ type StatusMetricsProvider interface {
 type HttpClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// TransactionsHistoryConnector defines what a connector towards a transactions history backend should be able to do
+type TransactionsHistoryConnector interface {
+	GetTransactionsByAddress(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, int, error)
+	GetTransfersByAddress(address string, options common.TransactionsHistoryOptions) ([]data.Transfer, int, error)
+	IsInterfaceNil() bool
+}
+
+// CollectionsConnector defines what a connector towards a backend indexing the NFTs of the collections should be able to do
+type CollectionsConnector interface {
+	GetCollectionNFTs(collection string, options common.PaginationOptions) ([]data.CollectionNFT, int, error)
+	IsInterfaceNil() bool
+}
+
+// ManagedSenderAccountHandler defines the component able to fetch the on-chain state of a managed sender
+type ManagedSenderAccountHandler interface {
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// SenderAccountHandler defines the component able to fetch the on-chain state of the sender of a transaction
+type SenderAccountHandler interface {
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	IsInterfaceNil() bool
+}
+
+// ManagedTransactionsHandler defines the component able to relay the transactions of the managed senders
+type ManagedTransactionsHandler interface {
+	SendTransaction(tx *data.Transaction) (int, string, error)
+	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+}
+
+// TransactionProcessStatusHandler defines the component able to compute the process status of a transaction
+type TransactionProcessStatusHandler interface {
+	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
+}
+
+// TransactionExecutionHandler defines the component able to provide the process status and the results of a transaction
+type TransactionExecutionHandler interface {
+	TransactionProcessStatusHandler
+	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+}
+
+// TransactionStatusWatcherHandler defines the component tracking the process status of the watched transactions
+type TransactionStatusWatcherHandler interface {
+	Watch(txHash string, sink TransactionStatusSink, options TransactionWatchOptions) (func(), error)
+}
+
+// WatchedAccountsHandler defines the component fetching the accounts of the addresses watched by the webhooks
+type WatchedAccountsHandler interface {
+	GetShardIDForAddress(address string) (uint32, error)
+	GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+}
+
+// HyperblockNonceHandler defines the component returning the nonce of the latest fully synchronized hyperblock
+type HyperblockNonceHandler interface {
+	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+}
+
+// TransactionsFeedHandler defines the component able to tell whether a transaction was included in a block, as
+// received through the observers feed
+type TransactionsFeedHandler interface {
+	IsEnabled() bool
+	IsTransactionIncluded(txHash string) bool
+}
+
+// WarmUpStatusHandler defines the component reporting the status of the warm-up run at startup
+type WarmUpStatusHandler interface {
+	IsReady() bool
+	GetWarmUpStatus() *data.WarmUpStatus
+	IsInterfaceNil() bool
+}
+
+// ObserversHealthHandler defines the component exposing the health of the observers of each shard
+type ObserversHealthHandler interface {
+	GetShardIDs() []uint32
+	GetObserversHealth() []*data.ObserverHealth
+	IsInterfaceNil() bool
+}
+
+// ReadinessStatusHandler defines the component scoring the health of the observers of each shard
+type ReadinessStatusHandler interface {
+	GetReadinessStatus() *data.ReadinessStatus
+	IsInterfaceNil() bool
+}
+
+// AddressShardComputer defines the component able to compute the shard of an address
+type AddressShardComputer interface {
+	ComputeShardId(addressBuff []byte) (uint32, error)
+	GetPubKeyConverter() core.PubkeyConverter
+	IsInterfaceNil() bool
+}
+
+// ObserversReloader defines the component able to reload the observers lists out of the main config file
+type ObserversReloader interface {
+	ReloadObservers() data.NodesReloadResponse
+	ReloadFullHistoryObservers() data.NodesReloadResponse
+	IsInterfaceNil() bool
+}
+
+// SentTransactionsRecorder defines the component recording the observers which accepted the sent transactions
+type SentTransactionsRecorder interface {
+	RecordSentTransaction(txHash string, observer string)
+	IsInterfaceNil() bool
+}
+
+// ConsensusBlocksProvider defines the blocks and validators info sources used to resolve the consensus group of a round
+type ConsensusBlocksProvider interface {
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+}
+
+// BlocksNetworkProvider defines the network metrics sources used to map the timestamps to the metachain blocks
+type BlocksNetworkProvider interface {
+	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
+}
+
+// EpochChangeNetworkProvider defines the source of the status metrics used to detect the epoch change window
+type EpochChangeNetworkProvider interface {
+	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
+}
+
+// ConsensusNetworkProvider defines the network metrics sources used to resolve the consensus group of a round
+type ConsensusNetworkProvider interface {
+	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
+	GetRatingsConfig() (*data.GenericAPIResponse, error)
+}
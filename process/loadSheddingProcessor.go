@@ -0,0 +1,177 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+// LoadSheddingProcessor decides, out of the health of the observers scored by the readiness component, which shards
+// have their non-critical read traffic shed. A shard is shed while it has fewer healthy observers than configured
+type LoadSheddingProcessor struct {
+	isEnabled           bool
+	minHealthyObservers int
+	checkInterval       time.Duration
+	readinessHandler    ReadinessStatusHandler
+	shardComputer       AddressShardComputer
+	shedShards          map[uint32]struct{}
+	cancelFunc          func()
+	mutState            sync.RWMutex
+}
+
+// NewLoadSheddingProcessor creates a new instance of LoadSheddingProcessor
+func NewLoadSheddingProcessor(
+	loadSheddingConfig config.LoadSheddingConfig,
+	readinessHandler ReadinessStatusHandler,
+) (*LoadSheddingProcessor, error) {
+	if check.IfNil(readinessHandler) {
+		return nil, ErrNilReadinessStatusHandler
+	}
+	if loadSheddingConfig.Enabled {
+		if loadSheddingConfig.MinHealthyObservers <= 0 {
+			return nil, fmt.Errorf("%w, MinHealthyObservers should be positive", ErrInvalidLoadSheddingConfig)
+		}
+		if loadSheddingConfig.CheckIntervalInMs <= 0 {
+			return nil, fmt.Errorf("%w, CheckIntervalInMs should be positive", ErrInvalidLoadSheddingConfig)
+		}
+		if loadSheddingConfig.RetryAfterInSec <= 0 {
+			return nil, fmt.Errorf("%w, RetryAfterInSec should be positive", ErrInvalidLoadSheddingConfig)
+		}
+	}
+
+	return &LoadSheddingProcessor{
+		isEnabled:           loadSheddingConfig.Enabled,
+		minHealthyObservers: loadSheddingConfig.MinHealthyObservers,
+		checkInterval:       time.Duration(loadSheddingConfig.CheckIntervalInMs) * time.Millisecond,
+		readinessHandler:    readinessHandler,
+		shedShards:          make(map[uint32]struct{}),
+	}, nil
+}
+
+// SetAddressShardComputer sets the component computing the shard of the addresses found in the requests. Until set,
+// the requests are identified as targeting a shard only by their shard parameter
+func (lsp *LoadSheddingProcessor) SetAddressShardComputer(shardComputer AddressShardComputer) error {
+	if check.IfNil(shardComputer) {
+		return ErrNilAddressShardComputer
+	}
+
+	lsp.mutState.Lock()
+	lsp.shardComputer = shardComputer
+	lsp.mutState.Unlock()
+
+	return nil
+}
+
+// Start periodically checks the health of the observers in background. If the load shedding is disabled, no shard
+// is ever shed
+func (lsp *LoadSheddingProcessor) Start() {
+	if !lsp.isEnabled {
+		return
+	}
+
+	var ctx context.Context
+	lsp.mutState.Lock()
+	if lsp.cancelFunc != nil {
+		lsp.mutState.Unlock()
+		log.Error("LoadSheddingProcessor - already started")
+		return
+	}
+	ctx, lsp.cancelFunc = context.WithCancel(context.Background())
+	lsp.mutState.Unlock()
+
+	go lsp.checkShardsContinuously(ctx)
+}
+
+func (lsp *LoadSheddingProcessor) checkShardsContinuously(ctx context.Context) {
+	timer := time.NewTimer(lsp.checkInterval)
+	defer timer.Stop()
+
+	for {
+		lsp.checkShards()
+
+		timer.Reset(lsp.checkInterval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			log.Debug("finishing LoadSheddingProcessor shards checks...")
+			return
+		}
+	}
+}
+
+// checkShards recomputes the shed shards out of the number of healthy observers of each shard
+func (lsp *LoadSheddingProcessor) checkShards() {
+	status := lsp.readinessHandler.GetReadinessStatus()
+
+	shedShards := make(map[uint32]struct{})
+	for _, shard := range status.Shards {
+		if shard.NumHealthyObservers < lsp.minHealthyObservers {
+			shedShards[shard.ShardID] = struct{}{}
+		}
+	}
+
+	lsp.mutState.Lock()
+	defer lsp.mutState.Unlock()
+
+	for shardID := range shedShards {
+		_, wasShed := lsp.shedShards[shardID]
+		if !wasShed {
+			log.Warn("too few healthy observers, shedding the read requests of the shard", "shard", shardID)
+		}
+	}
+	for shardID := range lsp.shedShards {
+		_, isShed := shedShards[shardID]
+		if !isShed {
+			log.Info("the observers recovered, serving again the read requests of the shard", "shard", shardID)
+		}
+	}
+	lsp.shedShards = shedShards
+}
+
+// IsShardShed returns true if the non-critical read requests of the shard should be rejected
+func (lsp *LoadSheddingProcessor) IsShardShed(shardID uint32) bool {
+	lsp.mutState.RLock()
+	defer lsp.mutState.RUnlock()
+
+	_, isShed := lsp.shedShards[shardID]
+	return isShed
+}
+
+// ComputeShardOfAddress returns the shard of the provided bech32 address
+func (lsp *LoadSheddingProcessor) ComputeShardOfAddress(address string) (uint32, error) {
+	lsp.mutState.RLock()
+	shardComputer := lsp.shardComputer
+	lsp.mutState.RUnlock()
+	if check.IfNil(shardComputer) {
+		return 0, ErrNilAddressShardComputer
+	}
+
+	addressBytes, err := shardComputer.GetPubKeyConverter().Decode(address)
+	if err != nil {
+		return 0, err
+	}
+
+	return shardComputer.ComputeShardId(addressBytes)
+}
+
+// Close stops the health checks
+func (lsp *LoadSheddingProcessor) Close() error {
+	lsp.mutState.RLock()
+	cancelFunc := lsp.cancelFunc
+	lsp.mutState.RUnlock()
+
+	if cancelFunc != nil {
+		cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lsp *LoadSheddingProcessor) IsInterfaceNil() bool {
+	return lsp == nil
+}
@@ -0,0 +1,108 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createLoadSheddingConfig() config.LoadSheddingConfig {
+	return config.LoadSheddingConfig{
+		Enabled:             true,
+		MinHealthyObservers: 1,
+		CheckIntervalInMs:   100,
+		RetryAfterInSec:     10,
+	}
+}
+
+func TestNewLoadSheddingProcessor(t *testing.T) {
+	t.Parallel()
+
+	lsp, err := process.NewLoadSheddingProcessor(createLoadSheddingConfig(), nil)
+	require.Nil(t, lsp)
+	require.Equal(t, process.ErrNilReadinessStatusHandler, err)
+
+	cfg := createLoadSheddingConfig()
+	cfg.MinHealthyObservers = 0
+	lsp, err = process.NewLoadSheddingProcessor(cfg, &mock.ReadinessStatusHandlerStub{})
+	require.Nil(t, lsp)
+	require.True(t, errors.Is(err, process.ErrInvalidLoadSheddingConfig))
+
+	cfg = createLoadSheddingConfig()
+	cfg.CheckIntervalInMs = 0
+	lsp, err = process.NewLoadSheddingProcessor(cfg, &mock.ReadinessStatusHandlerStub{})
+	require.Nil(t, lsp)
+	require.True(t, errors.Is(err, process.ErrInvalidLoadSheddingConfig))
+
+	cfg = createLoadSheddingConfig()
+	cfg.RetryAfterInSec = 0
+	lsp, err = process.NewLoadSheddingProcessor(cfg, &mock.ReadinessStatusHandlerStub{})
+	require.Nil(t, lsp)
+	require.True(t, errors.Is(err, process.ErrInvalidLoadSheddingConfig))
+
+	lsp, err = process.NewLoadSheddingProcessor(config.LoadSheddingConfig{}, &mock.ReadinessStatusHandlerStub{})
+	require.Nil(t, err)
+	require.False(t, lsp.IsInterfaceNil())
+	require.Equal(t, process.ErrNilAddressShardComputer, lsp.SetAddressShardComputer(nil))
+}
+
+func TestLoadSheddingProcessor_IsShardShed(t *testing.T) {
+	t.Parallel()
+
+	shards := []*data.ShardReadiness{
+		{ShardID: 0, NumObservers: 2, NumHealthyObservers: 0},
+		{ShardID: 1, NumObservers: 2, NumHealthyObservers: 1},
+		{ShardID: core.MetachainShardId, NumObservers: 2, NumHealthyObservers: 2},
+	}
+	lsp, _ := process.NewLoadSheddingProcessor(createLoadSheddingConfig(), &mock.ReadinessStatusHandlerStub{
+		GetReadinessStatusCalled: func() *data.ReadinessStatus {
+			return &data.ReadinessStatus{Shards: shards}
+		},
+	})
+	require.False(t, lsp.IsShardShed(0))
+
+	lsp.CheckShards()
+	require.True(t, lsp.IsShardShed(0))
+	require.False(t, lsp.IsShardShed(1))
+	require.False(t, lsp.IsShardShed(core.MetachainShardId))
+
+	shards = []*data.ShardReadiness{
+		{ShardID: 0, NumObservers: 2, NumHealthyObservers: 1},
+		{ShardID: 1, NumObservers: 2, NumHealthyObservers: 0},
+		{ShardID: core.MetachainShardId, NumObservers: 2, NumHealthyObservers: 2},
+	}
+	lsp.CheckShards()
+	require.False(t, lsp.IsShardShed(0))
+	require.True(t, lsp.IsShardShed(1))
+}
+
+func TestLoadSheddingProcessor_ComputeShardOfAddress(t *testing.T) {
+	t.Parallel()
+
+	lsp, _ := process.NewLoadSheddingProcessor(createLoadSheddingConfig(), &mock.ReadinessStatusHandlerStub{})
+	_, err := lsp.ComputeShardOfAddress("aabb")
+	require.Equal(t, process.ErrNilAddressShardComputer, err)
+
+	err = lsp.SetAddressShardComputer(&mock.ProcessorStub{
+		GetPubKeyConverterCalled: func() core.PubkeyConverter {
+			return &mock.PubKeyConverterMock{}
+		},
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return uint32(addressBuff[len(addressBuff)-1]), nil
+		},
+	})
+	require.Nil(t, err)
+
+	shardID, err := lsp.ComputeShardOfAddress("aa01")
+	require.Nil(t, err)
+	require.Equal(t, uint32(1), shardID)
+
+	_, err = lsp.ComputeShardOfAddress("not hex")
+	require.NotNil(t, err)
+}
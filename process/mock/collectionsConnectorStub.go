@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// CollectionsConnectorStub -
+type CollectionsConnectorStub struct {
+	GetCollectionNFTsCalled func(collection string, options common.PaginationOptions) ([]data.CollectionNFT, int, error)
+}
+
+// GetCollectionNFTs -
+func (stub *CollectionsConnectorStub) GetCollectionNFTs(collection string, options common.PaginationOptions) ([]data.CollectionNFT, int, error) {
+	if stub.GetCollectionNFTsCalled != nil {
+		return stub.GetCollectionNFTsCalled(collection, options)
+	}
+
+	return nil, 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *CollectionsConnectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
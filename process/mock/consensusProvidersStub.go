@@ -0,0 +1,65 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ConsensusBlocksProviderStub -
+type ConsensusBlocksProviderStub struct {
+	GetBlockByNonceCalled                       func(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+}
+
+// GetBlockByNonce -
+func (stub *ConsensusBlocksProviderStub) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if stub.GetBlockByNonceCalled != nil {
+		return stub.GetBlockByNonceCalled(ctx, shardID, nonce, options)
+	}
+
+	return &data.BlockApiResponse{}, nil
+}
+
+// GetInternalStartOfEpochValidatorsInfo -
+func (stub *ConsensusBlocksProviderStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+	if stub.GetInternalStartOfEpochValidatorsInfoCalled != nil {
+		return stub.GetInternalStartOfEpochValidatorsInfoCalled(epoch)
+	}
+
+	return &data.ValidatorsInfoApiResponse{}, nil
+}
+
+// ConsensusNetworkProviderStub -
+type ConsensusNetworkProviderStub struct {
+	GetNetworkStatusMetricsCalled func(shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkConfigMetricsCalled func() (*data.GenericAPIResponse, error)
+	GetRatingsConfigCalled        func() (*data.GenericAPIResponse, error)
+}
+
+// GetNetworkStatusMetrics -
+func (stub *ConsensusNetworkProviderStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+	if stub.GetNetworkStatusMetricsCalled != nil {
+		return stub.GetNetworkStatusMetricsCalled(shardID)
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// GetNetworkConfigMetrics -
+func (stub *ConsensusNetworkProviderStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+	if stub.GetNetworkConfigMetricsCalled != nil {
+		return stub.GetNetworkConfigMetricsCalled()
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// GetRatingsConfig -
+func (stub *ConsensusNetworkProviderStub) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+	if stub.GetRatingsConfigCalled != nil {
+		return stub.GetRatingsConfigCalled()
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
@@ -9,7 +9,8 @@ import (
 
 // GenericApiResponseCacherMock -
 type GenericApiResponseCacherMock struct {
-	Data *data.GenericAPIResponse
+	Data        *data.GenericAPIResponse
+	SkipRefresh bool
 	sync.RWMutex
 }
 
@@ -32,6 +33,11 @@ func (g *GenericApiResponseCacherMock) Store(response *data.GenericAPIResponse)
 	g.Unlock()
 }
 
+// ShouldRefresh -
+func (g *GenericApiResponseCacherMock) ShouldRefresh() bool {
+	return !g.SkipRefresh
+}
+
 // IsInterfaceNil -
 func (g *GenericApiResponseCacherMock) IsInterfaceNil() bool {
 	return g == nil
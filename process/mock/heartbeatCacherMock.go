@@ -7,7 +7,9 @@ import (
 )
 
 type HeartbeatCacherMock struct {
-	Data *data.HeartbeatResponse
+	Data                       *data.HeartbeatResponse
+	LoadHeartbeatChangesCalled func(sinceMillis int64) (*data.HeartbeatChangesResponse, error)
+	SkipRefresh                bool
 }
 
 func (hcm *HeartbeatCacherMock) LoadHeartbeats() (*data.HeartbeatResponse, error) {
@@ -18,11 +20,26 @@ func (hcm *HeartbeatCacherMock) LoadHeartbeats() (*data.HeartbeatResponse, error
 	return hcm.Data, nil
 }
 
+func (hcm *HeartbeatCacherMock) LoadHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+	if hcm.LoadHeartbeatChangesCalled != nil {
+		return hcm.LoadHeartbeatChangesCalled(sinceMillis)
+	}
+	if hcm.Data == nil {
+		return nil, errors.New("nil Data")
+	}
+
+	return &data.HeartbeatChangesResponse{Heartbeats: hcm.Data.Heartbeats, IsFullSnapshot: true}, nil
+}
+
 func (hcm *HeartbeatCacherMock) StoreHeartbeats(data *data.HeartbeatResponse) error {
 	hcm.Data = data
 	return nil
 }
 
+func (hcm *HeartbeatCacherMock) ShouldRefresh() bool {
+	return !hcm.SkipRefresh
+}
+
 func (hcm *HeartbeatCacherMock) IsInterfaceNil() bool {
 	return hcm == nil
 }
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// HeartbeatsProviderStub -
+type HeartbeatsProviderStub struct {
+	GetHeartbeatDataCalled func() (*data.HeartbeatResponse, error)
+}
+
+// GetHeartbeatData -
+func (stub *HeartbeatsProviderStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+	if stub.GetHeartbeatDataCalled != nil {
+		return stub.GetHeartbeatDataCalled()
+	}
+
+	return &data.HeartbeatResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *HeartbeatsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
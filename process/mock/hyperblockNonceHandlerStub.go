@@ -0,0 +1,15 @@
+package mock
+
+// HyperblockNonceHandlerStub -
+type HyperblockNonceHandlerStub struct {
+	GetLatestFullySynchronizedHyperblockNonceCalled func() (uint64, error)
+}
+
+// GetLatestFullySynchronizedHyperblockNonce -
+func (stub *HyperblockNonceHandlerStub) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+	if stub.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
+		return stub.GetLatestFullySynchronizedHyperblockNonceCalled()
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,52 @@
+package mock
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ManagedSenderAccountHandlerStub -
+type ManagedSenderAccountHandlerStub struct {
+	GetAccountCalled func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// GetAccount -
+func (stub *ManagedSenderAccountHandlerStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	if stub.GetAccountCalled != nil {
+		return stub.GetAccountCalled(ctx, address, options)
+	}
+
+	return &data.AccountModel{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *ManagedSenderAccountHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// ManagedTransactionsHandlerStub -
+type ManagedTransactionsHandlerStub struct {
+	SendTransactionCalled                       func(tx *data.Transaction) (int, string, error)
+	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*data.TransactionsPoolNonceGaps, error)
+}
+
+// SendTransaction -
+func (stub *ManagedTransactionsHandlerStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+	if stub.SendTransactionCalled != nil {
+		return stub.SendTransactionCalled(tx)
+	}
+
+	return http.StatusOK, "", nil
+}
+
+// GetTransactionsPoolNonceGapsForSender -
+func (stub *ManagedTransactionsHandlerStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+	if stub.GetTransactionsPoolNonceGapsForSenderCalled != nil {
+		return stub.GetTransactionsPoolNonceGapsForSenderCalled(sender)
+	}
+
+	return &data.TransactionsPoolNonceGaps{}, nil
+}
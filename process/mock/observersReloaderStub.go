@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ObserversReloaderStub -
+type ObserversReloaderStub struct {
+	ReloadObserversCalled            func() data.NodesReloadResponse
+	ReloadFullHistoryObserversCalled func() data.NodesReloadResponse
+}
+
+// ReloadObservers -
+func (stub *ObserversReloaderStub) ReloadObservers() data.NodesReloadResponse {
+	if stub.ReloadObserversCalled != nil {
+		return stub.ReloadObserversCalled()
+	}
+
+	return data.NodesReloadResponse{OkRequest: true, Description: "reloaded"}
+}
+
+// ReloadFullHistoryObservers -
+func (stub *ObserversReloaderStub) ReloadFullHistoryObservers() data.NodesReloadResponse {
+	if stub.ReloadFullHistoryObserversCalled != nil {
+		return stub.ReloadFullHistoryObserversCalled()
+	}
+
+	return data.NodesReloadResponse{OkRequest: true, Description: "reloaded"}
+}
+
+// IsInterfaceNil -
+func (stub *ObserversReloaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
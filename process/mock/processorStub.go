@@ -1,6 +1,10 @@
 package mock
 
 import (
+	"context"
+	"io"
+	"net/http"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
@@ -12,21 +16,27 @@ import (
 var errNotImplemented = errors.New("not implemented")
 
 type ProcessorStub struct {
-	ApplyConfigCalled                    func(cfg *config.Config) error
-	GetObserversCalled                   func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetAllObserversCalled                func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetObserversOnePerShardCalled        func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetFullHistoryNodesOnePerShardCalled func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetFullHistoryNodesCalled            func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetAllFullHistoryNodesCalled         func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetShardIDsCalled                    func() []uint32
-	ComputeShardIdCalled                 func(addressBuff []byte) (uint32, error)
-	CallGetRestEndPointCalled            func(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPointCalled           func(address string, path string, data interface{}, response interface{}) (int, error)
-	GetShardCoordinatorCalled            func() common.Coordinator
-	GetPubKeyConverterCalled             func() core.PubkeyConverter
-	GetObserverProviderCalled            func() observer.NodesProviderHandler
-	GetFullHistoryNodesProviderCalled    func() observer.NodesProviderHandler
+	ApplyConfigCalled                       func(cfg *config.Config) error
+	GetObserversCalled                      func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetAllObserversCalled                   func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetObserversOnePerShardCalled           func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetFullHistoryNodesOnePerShardCalled    func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetFullHistoryNodesCalled               func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetAllFullHistoryNodesCalled            func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetShardIDsCalled                       func() []uint32
+	ComputeShardIdCalled                    func(addressBuff []byte) (uint32, error)
+	CallGetRestEndPointCalled               func(address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointWithContextCalled    func(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallGetRestEndPointStreamCalled         func(ctx context.Context, address string, path string) (int, io.ReadCloser, error)
+	CallPostRestEndPointCalled              func(address string, path string, data interface{}, response interface{}) (int, error)
+	CallPostRestEndPointWithContextCalled   func(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
+	CallRawRestEndPointCalled               func(ctx context.Context, address string, request *data.RawObserverRequest) (int, *http.Response, error)
+	CallGetRestEndPointHedgedCalled         func(ctx context.Context, observers []*data.NodeData, path string, value interface{}) (*data.NodeData, int, error)
+	CallGetRestEndPointWithTruncationCalled func(ctx context.Context, address string, path string, value interface{}, numItemsToSkip uint64) (int, data.ResponseTruncation, error)
+	GetShardCoordinatorCalled               func() common.Coordinator
+	GetPubKeyConverterCalled                func() core.PubkeyConverter
+	GetObserverProviderCalled               func() observer.NodesProviderHandler
+	GetFullHistoryNodesProviderCalled       func() observer.NodesProviderHandler
 }
 
 // GetShardCoordinator -
@@ -101,6 +111,62 @@ func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value
 	return 0, errNotImplemented
 }
 
+// CallGetRestEndPointWithContext will call the CallGetRestEndPointWithContextCalled if not nil, falling back to
+// the CallGetRestEndPointCalled
+func (ps *ProcessorStub) CallGetRestEndPointWithContext(ctx context.Context, address string, path string, value interface{}) (int, error) {
+	if ps.CallGetRestEndPointWithContextCalled != nil {
+		return ps.CallGetRestEndPointWithContextCalled(ctx, address, path, value)
+	}
+
+	return ps.CallGetRestEndPoint(address, path, value)
+}
+
+// CallGetRestEndPointStream will call the CallGetRestEndPointStreamCalled if not nil
+func (ps *ProcessorStub) CallGetRestEndPointStream(ctx context.Context, address string, path string) (int, io.ReadCloser, error) {
+	if ps.CallGetRestEndPointStreamCalled != nil {
+		return ps.CallGetRestEndPointStreamCalled(ctx, address, path)
+	}
+
+	return 0, nil, errNotImplemented
+}
+
+// CallRawRestEndPoint -
+func (ps *ProcessorStub) CallRawRestEndPoint(ctx context.Context, address string, request *data.RawObserverRequest) (int, *http.Response, error) {
+	if ps.CallRawRestEndPointCalled != nil {
+		return ps.CallRawRestEndPointCalled(ctx, address, request)
+	}
+
+	return 0, nil, errNotImplemented
+}
+
+// CallGetRestEndPointHedged calls the observers one after the other, through CallGetRestEndPointWithContext, if no handler is provided
+func (ps *ProcessorStub) CallGetRestEndPointHedged(ctx context.Context, observers []*data.NodeData, path string, value interface{}) (*data.NodeData, int, error) {
+	if ps.CallGetRestEndPointHedgedCalled != nil {
+		return ps.CallGetRestEndPointHedgedCalled(ctx, observers, path, value)
+	}
+
+	var statusCode int
+	err := errNotImplemented
+	for _, observer := range observers {
+		statusCode, err = ps.CallGetRestEndPointWithContext(ctx, observer.Address, path, value)
+		if err == nil {
+			return observer, statusCode, nil
+		}
+	}
+
+	return nil, statusCode, err
+}
+
+// CallGetRestEndPointWithTruncation calls CallGetRestEndPointWithContext, without skipping or truncating, if no handler is provided
+func (ps *ProcessorStub) CallGetRestEndPointWithTruncation(ctx context.Context, address string, path string, value interface{}, numItemsToSkip uint64) (int, data.ResponseTruncation, error) {
+	if ps.CallGetRestEndPointWithTruncationCalled != nil {
+		return ps.CallGetRestEndPointWithTruncationCalled(ctx, address, path, value, numItemsToSkip)
+	}
+
+	statusCode, err := ps.CallGetRestEndPointWithContext(ctx, address, path, value)
+	return statusCode, data.ResponseTruncation{}, err
+}
+
 // CallPostRestEndPoint will call the CallPostRestEndPoint if not nil
 func (ps *ProcessorStub) CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error) {
 	if ps.CallPostRestEndPointCalled != nil {
@@ -110,6 +176,16 @@ func (ps *ProcessorStub) CallPostRestEndPoint(address string, path string, data
 	return 0, errNotImplemented
 }
 
+// CallPostRestEndPointWithContext will call the CallPostRestEndPointWithContextCalled if not nil, falling back to
+// the CallPostRestEndPointCalled
+func (ps *ProcessorStub) CallPostRestEndPointWithContext(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
+	if ps.CallPostRestEndPointWithContextCalled != nil {
+		return ps.CallPostRestEndPointWithContextCalled(ctx, address, path, data, response)
+	}
+
+	return ps.CallPostRestEndPoint(address, path, data, response)
+}
+
 // GetShardIDs will call the GetShardIDsCalled if not nil
 func (ps *ProcessorStub) GetShardIDs() []uint32 {
 	if ps.GetShardIDsCalled != nil {
@@ -0,0 +1,80 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// WarmUpStatusHandlerStub -
+type WarmUpStatusHandlerStub struct {
+	IsReadyCalled         func() bool
+	GetWarmUpStatusCalled func() *data.WarmUpStatus
+}
+
+// IsReady -
+func (stub *WarmUpStatusHandlerStub) IsReady() bool {
+	if stub.IsReadyCalled != nil {
+		return stub.IsReadyCalled()
+	}
+
+	return true
+}
+
+// GetWarmUpStatus -
+func (stub *WarmUpStatusHandlerStub) GetWarmUpStatus() *data.WarmUpStatus {
+	if stub.GetWarmUpStatusCalled != nil {
+		return stub.GetWarmUpStatusCalled()
+	}
+
+	return &data.WarmUpStatus{IsFinished: true}
+}
+
+// IsInterfaceNil -
+func (stub *WarmUpStatusHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// ObserversHealthHandlerStub -
+type ObserversHealthHandlerStub struct {
+	GetShardIDsCalled        func() []uint32
+	GetObserversHealthCalled func() []*data.ObserverHealth
+}
+
+// GetShardIDs -
+func (stub *ObserversHealthHandlerStub) GetShardIDs() []uint32 {
+	if stub.GetShardIDsCalled != nil {
+		return stub.GetShardIDsCalled()
+	}
+
+	return nil
+}
+
+// GetObserversHealth -
+func (stub *ObserversHealthHandlerStub) GetObserversHealth() []*data.ObserverHealth {
+	if stub.GetObserversHealthCalled != nil {
+		return stub.GetObserversHealthCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ObserversHealthHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// ReadinessStatusHandlerStub -
+type ReadinessStatusHandlerStub struct {
+	GetReadinessStatusCalled func() *data.ReadinessStatus
+}
+
+// GetReadinessStatus -
+func (stub *ReadinessStatusHandlerStub) GetReadinessStatus() *data.ReadinessStatus {
+	if stub.GetReadinessStatusCalled != nil {
+		return stub.GetReadinessStatusCalled()
+	}
+
+	return &data.ReadinessStatus{}
+}
+
+// IsInterfaceNil -
+func (stub *ReadinessStatusHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/config"
+
+// RuntimeConfigHandlerStub -
+type RuntimeConfigHandlerStub struct {
+	CheckConfigCalled func(cfg *config.Config) error
+	ApplyConfigCalled func(cfg *config.Config) error
+}
+
+// CheckConfig -
+func (stub *RuntimeConfigHandlerStub) CheckConfig(cfg *config.Config) error {
+	if stub.CheckConfigCalled != nil {
+		return stub.CheckConfigCalled(cfg)
+	}
+
+	return nil
+}
+
+// ApplyConfig -
+func (stub *RuntimeConfigHandlerStub) ApplyConfig(cfg *config.Config) error {
+	if stub.ApplyConfigCalled != nil {
+		return stub.ApplyConfigCalled(cfg)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RuntimeConfigHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
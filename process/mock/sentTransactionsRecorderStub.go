@@ -0,0 +1,18 @@
+package mock
+
+// SentTransactionsRecorderStub -
+type SentTransactionsRecorderStub struct {
+	RecordSentTransactionCalled func(txHash string, observer string)
+}
+
+// RecordSentTransaction -
+func (stub *SentTransactionsRecorderStub) RecordSentTransaction(txHash string, observer string) {
+	if stub.RecordSentTransactionCalled != nil {
+		stub.RecordSentTransactionCalled(txHash, observer)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *SentTransactionsRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
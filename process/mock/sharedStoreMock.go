@@ -0,0 +1,71 @@
+package mock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+// SharedStoreMock -
+type SharedStoreMock struct {
+	mut    sync.Mutex
+	values map[string][]byte
+}
+
+// NewSharedStoreMock -
+func NewSharedStoreMock() *SharedStoreMock {
+	return &SharedStoreMock{
+		values: make(map[string][]byte),
+	}
+}
+
+// Get -
+func (ssm *SharedStoreMock) Get(key string) ([]byte, error) {
+	ssm.mut.Lock()
+	defer ssm.mut.Unlock()
+
+	value, found := ssm.values[key]
+	if !found {
+		return nil, cache.ErrKeyNotFoundInSharedStore
+	}
+
+	return value, nil
+}
+
+// Set -
+func (ssm *SharedStoreMock) Set(key string, value []byte, _ time.Duration) error {
+	ssm.mut.Lock()
+	ssm.values[key] = value
+	ssm.mut.Unlock()
+
+	return nil
+}
+
+// SetIfNotExists -
+func (ssm *SharedStoreMock) SetIfNotExists(key string, value []byte, _ time.Duration) (bool, error) {
+	ssm.mut.Lock()
+	defer ssm.mut.Unlock()
+
+	_, found := ssm.values[key]
+	if found {
+		return false, nil
+	}
+
+	ssm.values[key] = value
+	return true, nil
+}
+
+// Delete -
+func (ssm *SharedStoreMock) Delete(key string) error {
+	ssm.mut.Lock()
+	delete(ssm.values, key)
+	ssm.mut.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ssm *SharedStoreMock) IsInterfaceNil() bool {
+	return ssm == nil
+}
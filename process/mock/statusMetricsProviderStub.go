@@ -8,6 +8,7 @@ import (
 type StatusMetricsProviderStub struct {
 	GetAllCalled                  func() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheusCalled func() string
+	GetCachesMetricsCalled        func() map[string]*data.CacheMetrics
 }
 
 // GetMetricsForPrometheus -
@@ -28,6 +29,15 @@ func (s *StatusMetricsProviderStub) GetAll() map[string]*data.EndpointMetrics {
 	return make(map[string]*data.EndpointMetrics)
 }
 
+// GetCachesMetrics -
+func (s *StatusMetricsProviderStub) GetCachesMetrics() map[string]*data.CacheMetrics {
+	if s.GetCachesMetricsCalled != nil {
+		return s.GetCachesMetricsCalled()
+	}
+
+	return make(map[string]*data.CacheMetrics)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *StatusMetricsProviderStub) IsInterfaceNil() bool {
 	return s == nil
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionExecutionHandlerStub -
+type TransactionExecutionHandlerStub struct {
+	GetProcessedTransactionStatusCalled func(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionCalled                func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+}
+
+// GetProcessedTransactionStatus -
+func (stub *TransactionExecutionHandlerStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+	if stub.GetProcessedTransactionStatusCalled != nil {
+		return stub.GetProcessedTransactionStatusCalled(txHash)
+	}
+
+	return &data.ProcessStatusResponse{Status: string(data.TxStatusUnknown)}, nil
+}
+
+// GetTransaction -
+func (stub *TransactionExecutionHandlerStub) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	if stub.GetTransactionCalled != nil {
+		return stub.GetTransactionCalled(txHash, withResults)
+	}
+
+	return &transaction.ApiTransactionResult{}, nil
+}
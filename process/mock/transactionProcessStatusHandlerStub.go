@@ -0,0 +1,19 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionProcessStatusHandlerStub -
+type TransactionProcessStatusHandlerStub struct {
+	GetProcessedTransactionStatusCalled func(txHash string) (*data.ProcessStatusResponse, error)
+}
+
+// GetProcessedTransactionStatus -
+func (stub *TransactionProcessStatusHandlerStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+	if stub.GetProcessedTransactionStatusCalled != nil {
+		return stub.GetProcessedTransactionStatusCalled(txHash)
+	}
+
+	return &data.ProcessStatusResponse{Status: string(data.TxStatusUnknown)}, nil
+}
@@ -0,0 +1,25 @@
+package mock
+
+// TransactionsFeedHandlerStub -
+type TransactionsFeedHandlerStub struct {
+	IsEnabledCalled             func() bool
+	IsTransactionIncludedCalled func(txHash string) bool
+}
+
+// IsEnabled -
+func (stub *TransactionsFeedHandlerStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// IsTransactionIncluded -
+func (stub *TransactionsFeedHandlerStub) IsTransactionIncluded(txHash string) bool {
+	if stub.IsTransactionIncludedCalled != nil {
+		return stub.IsTransactionIncludedCalled(txHash)
+	}
+
+	return false
+}
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionsHistoryConnectorStub -
+type TransactionsHistoryConnectorStub struct {
+	GetTransactionsByAddressCalled func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, int, error)
+	GetTransfersByAddressCalled    func(address string, options common.TransactionsHistoryOptions) ([]data.Transfer, int, error)
+}
+
+// GetTransactionsByAddress -
+func (stub *TransactionsHistoryConnectorStub) GetTransactionsByAddress(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, int, error) {
+	if stub.GetTransactionsByAddressCalled != nil {
+		return stub.GetTransactionsByAddressCalled(address, options)
+	}
+
+	return nil, 0, nil
+}
+
+// GetTransfersByAddress -
+func (stub *TransactionsHistoryConnectorStub) GetTransfersByAddress(address string, options common.TransactionsHistoryOptions) ([]data.Transfer, int, error) {
+	if stub.GetTransfersByAddressCalled != nil {
+		return stub.GetTransfersByAddressCalled(address, options)
+	}
+
+	return nil, 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionsHistoryConnectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -8,7 +8,8 @@ import (
 
 // ValStatsCacherMock --
 type ValStatsCacherMock struct {
-	Data map[string]*data.ValidatorApiResponse
+	Data        map[string]*data.ValidatorApiResponse
+	SkipRefresh bool
 }
 
 // LoadValStats --
@@ -26,6 +27,11 @@ func (vscm *ValStatsCacherMock) StoreValStats(valStats map[string]*data.Validato
 	return nil
 }
 
+// ShouldRefresh --
+func (vscm *ValStatsCacherMock) ShouldRefresh() bool {
+	return !vscm.SkipRefresh
+}
+
 // IsInterfaceNil --
 func (vscm *ValStatsCacherMock) IsInterfaceNil() bool {
 	return vscm == nil
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// WatchedAccountsHandlerStub -
+type WatchedAccountsHandlerStub struct {
+	GetShardIDForAddressCalled func(address string) (uint32, error)
+	GetAccountsCalled          func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+}
+
+// GetShardIDForAddress -
+func (stub *WatchedAccountsHandlerStub) GetShardIDForAddress(address string) (uint32, error) {
+	if stub.GetShardIDForAddressCalled != nil {
+		return stub.GetShardIDForAddressCalled(address)
+	}
+
+	return 0, nil
+}
+
+// GetAccounts -
+func (stub *WatchedAccountsHandlerStub) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+	if stub.GetAccountsCalled != nil {
+		return stub.GetAccountsCalled(addresses, options)
+	}
+
+	return &data.AccountsModel{}, nil
+}
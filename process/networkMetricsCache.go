@@ -0,0 +1,65 @@
+package process
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+type cachedNetworkMetrics struct {
+	response  *data.GenericAPIResponse
+	timestamp time.Time
+}
+
+// networkMetricsCache holds the network metrics which rarely change, such as the network config and the activation
+// epochs, keyed by the observer path they were fetched from. A zero validity duration disables the cache
+type networkMetricsCache struct {
+	entries          map[string]*cachedNetworkMetrics
+	validityDuration time.Duration
+	getTimeHandler   func() time.Time
+	mut              sync.Mutex
+	metrics          cache.MetricsCounters
+}
+
+func newNetworkMetricsCache(validityDuration time.Duration) *networkMetricsCache {
+	return &networkMetricsCache{
+		entries:          make(map[string]*cachedNetworkMetrics),
+		validityDuration: validityDuration,
+		getTimeHandler:   time.Now,
+	}
+}
+
+func (nmc *networkMetricsCache) get(path string) (*data.GenericAPIResponse, bool) {
+	nmc.mut.Lock()
+	defer nmc.mut.Unlock()
+
+	entry, found := nmc.entries[path]
+	if !found {
+		nmc.metrics.AddMiss()
+		return nil, false
+	}
+	if nmc.getTimeHandler().Sub(entry.timestamp) > nmc.validityDuration {
+		delete(nmc.entries, path)
+		nmc.metrics.AddMiss()
+		nmc.metrics.AddEvictions(1)
+		return nil, false
+	}
+
+	nmc.metrics.AddHit()
+	return entry.response, true
+}
+
+func (nmc *networkMetricsCache) put(path string, response *data.GenericAPIResponse) {
+	if nmc.validityDuration == 0 {
+		return
+	}
+
+	nmc.mut.Lock()
+	nmc.entries[path] = &cachedNetworkMetrics{
+		response:  response,
+		timestamp: nmc.getTimeHandler(),
+	}
+	nmc.mut.Unlock()
+}
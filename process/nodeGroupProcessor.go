@@ -119,6 +119,28 @@ func (ngp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, erro
 	return ngp.getHeartbeatsFromApi()
 }
 
+// GetHeartbeatChanges will return the heartbeats that changed since the provided moment, expressed in unix milliseconds
+func (ngp *NodeGroupProcessor) GetHeartbeatChanges(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+	changes, err := ngp.cacher.LoadHeartbeatChanges(sinceMillis)
+	if err == nil {
+		return changes, nil
+	}
+
+	log.Info("heartbeat changes: cannot get from cache. Will fetch from API", "error", err.Error())
+
+	hbts, err := ngp.getHeartbeatsFromApi()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ngp.cacher.StoreHeartbeats(hbts)
+	if err != nil {
+		return nil, err
+	}
+
+	return ngp.cacher.LoadHeartbeatChanges(sinceMillis)
+}
+
 func (ngp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse, error) {
 	shardIDs := ngp.proc.GetShardIDs()
 
@@ -229,6 +251,11 @@ func (ngp *NodeGroupProcessor) StartCacheUpdate() {
 }
 
 func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate() {
+	if !ngp.cacher.ShouldRefresh() {
+		// another proxy instance refreshes the shared cache
+		return
+	}
+
 	hbts, err := ngp.getHeartbeatsFromApi()
 	if err != nil {
 		log.Warn("heartbeat: get from API", "error", err.Error())
@@ -279,3 +306,8 @@ func (ngp *NodeGroupProcessor) Close() error {
 
 	return nil
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ngp *NodeGroupProcessor) IsInterfaceNil() bool {
+	return ngp == nil
+}
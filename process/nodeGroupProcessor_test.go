@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -396,6 +397,72 @@ func TestNodeGroupProcessor_GetHeartbeatDataShouldReturnDataFromCacher(t *testin
 	assert.Equal(t, *res, hbtsResp)
 }
 
+func TestNodeGroupProcessor_GetHeartbeatChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the changes from the cacher", func(t *testing.T) {
+		t.Parallel()
+
+		expectedChanges := &data.HeartbeatChangesResponse{RemovedPublicKeys: []string{"pk1"}, Timestamp: 1000}
+		cacher := &mock.HeartbeatCacherMock{
+			LoadHeartbeatChangesCalled: func(sinceMillis int64) (*data.HeartbeatChangesResponse, error) {
+				assert.Equal(t, int64(500), sinceMillis)
+				return expectedChanges, nil
+			},
+		}
+		hp, _ := process.NewNodeGroupProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+
+		res, err := hp.GetHeartbeatChanges(500)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedChanges, res)
+	})
+
+	t.Run("empty cache should fetch from API and store in cache", func(t *testing.T) {
+		t.Parallel()
+
+		cacher := &mock.HeartbeatCacherMock{}
+		hp, _ := process.NewNodeGroupProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: 0, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				valResp := value.(*data.HeartbeatApiResponse)
+				valResp.Data.Heartbeats = []data.PubKeyHeartbeat{{PublicKey: "pk1", ComputedShardID: 0}}
+				return http.StatusOK, nil
+			},
+		}, cacher, time.Millisecond)
+
+		res, err := hp.GetHeartbeatChanges(0)
+		assert.Nil(t, err)
+		assert.True(t, res.IsFullSnapshot)
+		assert.Equal(t, "pk1", res.Heartbeats[0].PublicKey)
+		assert.NotNil(t, cacher.Data)
+	})
+
+	t.Run("empty cache and API error should error", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := process.NewNodeGroupProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: 0, Address: "addr"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return http.StatusInternalServerError, errors.New("observer error")
+			},
+		}, &mock.HeartbeatCacherMock{}, time.Millisecond)
+
+		res, err := hp.GetHeartbeatChanges(0)
+		assert.Nil(t, res)
+		assert.Equal(t, process.ErrHeartbeatNotAvailable, err)
+	})
+}
+
 func TestNodeGroupProcessor_CacheShouldUpdate(t *testing.T) {
 	t.Parallel()
 
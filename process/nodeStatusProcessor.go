@@ -6,6 +6,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -55,14 +56,43 @@ const (
 
 	// MetricNonce is the metric for monitoring the nonce of a node
 	MetricNonce = "erd_nonce"
+
+	// MetricProbableHighestNonce is the metric holding the highest nonce known by a node
+	MetricProbableHighestNonce = "erd_probable_highest_nonce"
+
+	// MetricIsSyncing is the metric signaling whether a node is still synchronizing
+	MetricIsSyncing = "erd_is_syncing"
+
+	// MetricCurrentRound is the metric holding the current round of a node
+	MetricCurrentRound = "erd_current_round"
+
+	// MetricSynchronizedRound is the metric holding the last round a node is synchronized with
+	MetricSynchronizedRound = "erd_synchronized_round"
+
+	// MetricEpochNumber is the metric holding the current epoch of a node
+	MetricEpochNumber = "erd_epoch_number"
+
+	// MetricTrieSyncNumProcessedNodes is the metric holding the number of trie nodes processed during the state sync
+	MetricTrieSyncNumProcessedNodes = "erd_trie_sync_num_nodes_processed"
+
+	// MetricTrieSyncNumReceivedBytes is the metric holding the number of bytes received during the state sync
+	MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_bytes_received"
+
+	syncProgressCacheValidityDuration = 5 * time.Second
 )
 
 // NodeStatusProcessor handles the action needed for fetching data related to status metrics from nodes
 type NodeStatusProcessor struct {
 	proc                  Processor
 	economicMetricsCacher GenericApiResponseCacheHandler
+	economicsHistory      *economicMetricsHistory
 	cacheValidityDuration time.Duration
+	networkMetricsCache   *networkMetricsCache
+	mutSyncProgress       sync.Mutex
+	lastSyncProgress      *data.SyncProgress
+	lastSyncProgressTime  time.Time
 	cancelFunc            func()
+	getTimeHandler        func() time.Time
 }
 
 // NewNodeStatusProcessor creates a new instance of NodeStatusProcessor
@@ -70,6 +100,8 @@ func NewNodeStatusProcessor(
 	processor Processor,
 	economicMetricsCacher GenericApiResponseCacheHandler,
 	cacheValidityDuration time.Duration,
+	economicsHistorySize int,
+	networkMetricsCacheValidityDuration time.Duration,
 ) (*NodeStatusProcessor, error) {
 	if check.IfNil(processor) {
 		return nil, ErrNilCoreProcessor
@@ -80,11 +112,20 @@ func NewNodeStatusProcessor(
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
+	if economicsHistorySize < 0 {
+		return nil, ErrInvalidEconomicsHistorySize
+	}
+	if networkMetricsCacheValidityDuration < 0 {
+		return nil, ErrInvalidCacheValidityDuration
+	}
 
 	return &NodeStatusProcessor{
 		proc:                  processor,
 		economicMetricsCacher: economicMetricsCacher,
+		economicsHistory:      newEconomicMetricsHistory(economicsHistorySize),
 		cacheValidityDuration: cacheValidityDuration,
+		networkMetricsCache:   newNetworkMetricsCache(networkMetricsCacheValidityDuration),
+		getTimeHandler:        time.Now,
 	}, nil
 }
 
@@ -112,8 +153,12 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 	return nil, WrapObserversError(responseNetworkMetrics.Error)
 }
 
-// GetNetworkConfigMetrics will simply forward the network config metrics from an observer in the given shard
+// GetNetworkConfigMetrics will return the network config metrics from cache, or will forward them from an observer
 func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+	return nsp.getCachedNetworkMetrics(NetworkConfigPath, nsp.getNetworkConfigMetricsFromApi)
+}
+
+func (nsp *NodeStatusProcessor) getNetworkConfigMetricsFromApi() (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -136,8 +181,12 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 	return nil, WrapObserversError(responseNetworkMetrics.Error)
 }
 
-// GetEnableEpochsMetrics will simply forward the activation epochs config metrics from an observer
+// GetEnableEpochsMetrics will return the activation epochs config metrics from cache, or will forward them from an observer
 func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+	return nsp.getCachedNetworkMetrics(EnableEpochsPath, nsp.getEnableEpochsMetricsFromApi)
+}
+
+func (nsp *NodeStatusProcessor) getEnableEpochsMetricsFromApi() (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -159,6 +208,30 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 	return nil, WrapObserversError(responseEnableEpochsMetrics.Error)
 }
 
+func (nsp *NodeStatusProcessor) getCachedNetworkMetrics(
+	path string,
+	getFromApiHandler func() (*data.GenericAPIResponse, error),
+) (*data.GenericAPIResponse, error) {
+	response, found := nsp.networkMetricsCache.get(path)
+	if found {
+		return response, nil
+	}
+
+	response, err := getFromApiHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	nsp.networkMetricsCache.put(path, response)
+
+	return response, nil
+}
+
+// GetNetworkMetricsCacheMetrics returns the hits, the misses and the evictions of the network metrics cache
+func (nsp *NodeStatusProcessor) GetNetworkMetricsCacheMetrics() data.CacheMetrics {
+	return nsp.networkMetricsCache.metrics.GetCacheMetrics()
+}
+
 // GetAllIssuedESDTs will forward the issued ESDTs based on the provided type
 func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
 	if !data.IsValidEsdtPath(tokenType) && tokenType != "" {
@@ -331,6 +404,118 @@ func (nsp *NodeStatusProcessor) GetTriesStatistics(shardID uint32) (*data.TrieSt
 	return getTrieStatistics(nodeStatusResponse.Data)
 }
 
+// GetSyncProgress will return the synchronization progress of all the observers, synced or not, grouped by shard.
+// The result is cached for a few seconds, as each call queries the status of all the observers
+func (nsp *NodeStatusProcessor) GetSyncProgress() *data.SyncProgress {
+	nsp.mutSyncProgress.Lock()
+	defer nsp.mutSyncProgress.Unlock()
+
+	now := nsp.getTimeHandler()
+	if nsp.lastSyncProgress != nil && now.Sub(nsp.lastSyncProgressTime) < syncProgressCacheValidityDuration {
+		return nsp.lastSyncProgress
+	}
+
+	nsp.lastSyncProgress = nsp.computeSyncProgress()
+	nsp.lastSyncProgressTime = now
+
+	return nsp.lastSyncProgress
+}
+
+func (nsp *NodeStatusProcessor) computeSyncProgress() *data.SyncProgress {
+	observers := nsp.proc.GetObserverProvider().GetAllNodesWithSyncState()
+	observersProgress := make([]*data.ObserverSyncProgress, len(observers))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(observers))
+	for idx, observer := range observers {
+		go func(idx int, observer *data.NodeData) {
+			defer wg.Done()
+
+			observersProgress[idx] = nsp.getObserverSyncProgress(observer)
+		}(idx, observer)
+	}
+	wg.Wait()
+
+	syncProgress := &data.SyncProgress{
+		IsSynced: true,
+		Shards:   make([]*data.ShardSyncProgress, 0),
+	}
+	shardsProgress := make(map[uint32]*data.ShardSyncProgress)
+	for _, shardID := range nsp.proc.GetShardIDs() {
+		shardProgress := &data.ShardSyncProgress{
+			ShardID:   shardID,
+			Observers: make([]*data.ObserverSyncProgress, 0),
+		}
+		shardsProgress[shardID] = shardProgress
+		syncProgress.Shards = append(syncProgress.Shards, shardProgress)
+	}
+
+	for idx, observer := range observers {
+		shardProgress, found := shardsProgress[observer.ShardId]
+		if !found {
+			continue
+		}
+
+		observerProgress := observersProgress[idx]
+		shardProgress.Observers = append(shardProgress.Observers, observerProgress)
+		if observerProgress.IsSynced {
+			shardProgress.NumSyncedObservers++
+		}
+		if observerProgress.Nonce > shardProgress.HighestNonce {
+			shardProgress.HighestNonce = observerProgress.Nonce
+		}
+		if observerProgress.ProbableHighestNonce > shardProgress.ProbableHighestNonce {
+			shardProgress.ProbableHighestNonce = observerProgress.ProbableHighestNonce
+		}
+	}
+
+	for _, shardProgress := range syncProgress.Shards {
+		shardProgress.IsSynced = shardProgress.NumSyncedObservers > 0
+		syncProgress.IsSynced = syncProgress.IsSynced && shardProgress.IsSynced
+	}
+
+	return syncProgress
+}
+
+func (nsp *NodeStatusProcessor) getObserverSyncProgress(observer *data.NodeData) *data.ObserverSyncProgress {
+	observerProgress := &data.ObserverSyncProgress{
+		Address:  observer.Address,
+		IsSynced: observer.IsSynced,
+	}
+
+	response := data.GenericAPIResponse{}
+	_, err := nsp.proc.CallGetRestEndPoint(observer.Address, NodeStatusPath, &response)
+	if err != nil {
+		log.Debug("sync progress request", "observer", observer.Address, "error", err.Error())
+		observerProgress.Error = err.Error()
+		return observerProgress
+	}
+
+	observerProgress.Nonce = getUintMetric(response.Data, MetricNonce)
+	observerProgress.ProbableHighestNonce = getUintMetric(response.Data, MetricProbableHighestNonce)
+	if observerProgress.ProbableHighestNonce > observerProgress.Nonce {
+		observerProgress.NoncesBehind = observerProgress.ProbableHighestNonce - observerProgress.Nonce
+	}
+	observerProgress.IsSyncing = getUintMetric(response.Data, MetricIsSyncing) != 0
+	observerProgress.CurrentRound = getUintMetric(response.Data, MetricCurrentRound)
+	observerProgress.SynchronizedRound = getUintMetric(response.Data, MetricSynchronizedRound)
+	observerProgress.Epoch = getUintMetric(response.Data, MetricEpochNumber)
+	observerProgress.TrieSyncNumProcessedNodes = getUintMetric(response.Data, MetricTrieSyncNumProcessedNodes)
+	observerProgress.TrieSyncNumReceivedBytes = getUintMetric(response.Data, MetricTrieSyncNumReceivedBytes)
+	observerProgress.AccountsSnapshotNumNodes = getUintMetric(response.Data, MetricAccountsSnapshotNumNodes)
+
+	return observerProgress
+}
+
+func getUintMetric(nodeStatusData interface{}, metric string) uint64 {
+	value, ok := getMetric(nodeStatusData, metric)
+	if !ok {
+		return 0
+	}
+
+	return getUint(value)
+}
+
 func getMinNonce(noncesSlice []uint64) uint64 {
 	// initialize min with max uint64 value
 	min := uint64(math.MaxUint64)
@@ -494,7 +679,7 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 
 // GetEpochStartData will return the epoch-start data for the given epoch and shard
 func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
-	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityAll)
+	observers, err := getNodesForEpoch(nsp.proc, shardID, epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -3,11 +3,13 @@ package process
 import (
 	"encoding/json"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +17,7 @@ import (
 func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.GenericApiResponseCacherMock{}, time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.GenericApiResponseCacherMock{}, time.Second, 0, 0)
 
 	require.Equal(t, ErrNilCoreProcessor, err)
 	require.Nil(t, nodeStatusProc)
@@ -24,7 +26,7 @@ func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 func TestNewNodeStatusProcessor_NilCacher(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, time.Second, 0, 0)
 
 	require.Equal(t, ErrNilEconomicMetricsCacher, err)
 	require.Nil(t, nodeStatusProc)
@@ -33,12 +35,21 @@ func TestNewNodeStatusProcessor_NilCacher(t *testing.T) {
 func TestNewNodeStatusProcessor_InvalidCacheValidityDuration(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, -1*time.Second)
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, -1*time.Second, 0, 0)
 
 	require.Equal(t, ErrInvalidCacheValidityDuration, err)
 	require.Nil(t, nodeStatusProc)
 }
 
+func TestNewNodeStatusProcessor_InvalidEconomicsHistorySize(t *testing.T) {
+	t.Parallel()
+
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.GenericApiResponseCacherMock{}, time.Second, -1, 0)
+
+	require.Equal(t, ErrInvalidEconomicsHistorySize, err)
+	require.Nil(t, nodeStatusProc)
+}
+
 func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T) {
 	t.Parallel()
 
@@ -55,6 +66,8 @@ func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetNetworkConfigMetrics()
@@ -83,6 +96,8 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics()
@@ -98,6 +113,48 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 
 }
 
+func TestNodeStatusProcessor_GetNetworkMetricsShouldBeCached(t *testing.T) {
+	t.Parallel()
+
+	numCalls := make(map[string]int)
+	nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{
+		GetAllObserversCalled: func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{
+				{Address: "address1", ShardId: 0},
+			}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			numCalls[path]++
+			valueResponse := value.(*data.GenericAPIResponse)
+			valueResponse.Data = map[string]interface{}{"path": path}
+
+			return 0, nil
+		},
+	},
+		&mock.GenericApiResponseCacherMock{},
+		time.Nanosecond,
+		0,
+		time.Minute,
+	)
+
+	for i := 0; i < 3; i++ {
+		networkConfig, err := nodeStatusProc.GetNetworkConfigMetrics()
+		require.Nil(t, err)
+		require.Equal(t, map[string]interface{}{"path": NetworkConfigPath}, networkConfig.Data)
+
+		enableEpochs, err := nodeStatusProc.GetEnableEpochsMetrics()
+		require.Nil(t, err)
+		require.Equal(t, map[string]interface{}{"path": EnableEpochsPath}, enableEpochs.Data)
+	}
+
+	require.Equal(t, 1, numCalls[NetworkConfigPath])
+	require.Equal(t, 1, numCalls[EnableEpochsPath])
+
+	cacheMetrics := nodeStatusProc.GetNetworkMetricsCacheMetrics()
+	require.Equal(t, uint64(4), cacheMetrics.NumHits)
+	require.Equal(t, uint64(2), cacheMetrics.NumMisses)
+}
+
 func TestNodeStatusProcessor_GetNetworkMetricsGetObserversFailedShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -109,6 +166,8 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetObserversFailedShouldErr(t *tes
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
@@ -132,6 +191,8 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
@@ -160,6 +221,8 @@ func TestNodeStatusProcessor_GetNetworkMetrics(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(0)
@@ -221,6 +284,8 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
@@ -239,6 +304,8 @@ func TestNodeStatusProcessor_GetAllIssuedEDTsGetObserversFailedShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetAllIssuedESDTs("")
@@ -262,6 +329,8 @@ func TestNodeStatusProcessor_GetAllIssuedESDTsGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetAllIssuedESDTs("")
@@ -288,6 +357,8 @@ func TestNodeStatusProcessor_GetAllIssuedESDTs(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs("")
@@ -325,6 +396,8 @@ func TestNodeStatusProcessor_ApiPathIsCorrect(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	_, err := nodeStatusProc.GetAllIssuedESDTs(data.SemiFungibleTokens)
@@ -342,6 +415,8 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetObserversFailedShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetDelegatedInfo()
@@ -365,6 +440,8 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetRestEndPointError(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetDelegatedInfo()
@@ -390,6 +467,8 @@ func TestNodeStatusProcessor_GetDelegatedInfo(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	actualResponse, err := nodeStatusProc.GetDelegatedInfo()
@@ -408,6 +487,8 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetObserversFailedShouldErr(t *t
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetDirectStakedInfo()
@@ -431,6 +512,8 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetRestEndPointError(t *testing.
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetDirectStakedInfo()
@@ -456,6 +539,8 @@ func TestNodeStatusProcessor_GetDirectStakedInfo(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	actualResponse, err := nodeStatusProc.GetDirectStakedInfo()
@@ -479,6 +564,8 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetEndpointErr(t *testing.T)
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodesStatusProc.GetEnableEpochsMetrics()
@@ -509,6 +596,8 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsShouldWork(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics()
@@ -534,6 +623,8 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetObserversShouldErr(t *test
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetEnableEpochsMetrics()
@@ -552,6 +643,8 @@ func TestNodeStatusProcessor_GetRatingsConfigGetAllObserversShouldFail(t *testin
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	status, err := nodeStatusProc.GetRatingsConfig()
@@ -577,6 +670,8 @@ func TestNodeStatusProcessor_GetRatingsConfig(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	actualResponse, err := nodeStatusProc.GetRatingsConfig()
@@ -602,6 +697,8 @@ func TestNodeStatusProcessor_GetGenesisNodesPubKeys(t *testing.T) {
 	},
 		&mock.GenericApiResponseCacherMock{},
 		time.Nanosecond,
+		0,
+		0,
 	)
 
 	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys()
@@ -627,6 +724,8 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			0,
+			0,
 		)
 
 		actualResponse, err := nodeStatusProc.GetGasConfigs()
@@ -652,6 +751,8 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			0,
+			0,
 		)
 
 		actualResponse, err := nodeStatusProc.GetGasConfigs()
@@ -677,7 +778,8 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 			},
 		},
 			&mock.GenericApiResponseCacherMock{},
-			time.Second,
+			time.Second, 0,
+			0,
 		)
 
 		response, err := nodeStatusProc.GetTriesStatistics(0)
@@ -705,7 +807,8 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 			},
 		},
 			&mock.GenericApiResponseCacherMock{},
-			time.Second,
+			time.Second, 0,
+			0,
 		)
 
 		response, err := nodeStatusProc.GetTriesStatistics(0)
@@ -737,6 +840,8 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			0,
+			0,
 		)
 
 		response, err := nodeStatusProc.GetTriesStatistics(0)
@@ -763,6 +868,8 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			0,
+			0,
 		)
 
 		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
@@ -788,6 +895,8 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 		},
 			&mock.GenericApiResponseCacherMock{},
 			time.Nanosecond,
+			0,
+			0,
 		)
 
 		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
@@ -795,3 +904,90 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 		require.Equal(t, expectedResp, actualResponse)
 	})
 }
+
+func TestNodeStatusProcessor_GetSyncProgress(t *testing.T) {
+	t.Parallel()
+
+	numStatusCalls := uint32(0)
+	nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0, core.MetachainShardId}
+		},
+		GetObserverProviderCalled: func() observer.NodesProviderHandler {
+			return &mock.ObserversProviderStub{
+				GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+					return []*data.NodeData{
+						{Address: "synced0", ShardId: 0, IsSynced: true},
+						{Address: "syncing0", ShardId: 0, IsSynced: false},
+						{Address: "down-meta", ShardId: core.MetachainShardId, IsSynced: false},
+					}
+				},
+			}
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			atomic.AddUint32(&numStatusCalls, 1)
+			require.Equal(t, NodeStatusPath, path)
+
+			metrics := map[string]interface{}{
+				MetricNonce:                float64(100),
+				MetricProbableHighestNonce: float64(100),
+				MetricIsSyncing:            float64(0),
+				MetricEpochNumber:          float64(5),
+			}
+			switch address {
+			case "down-meta":
+				return 0, errors.New("observer down")
+			case "syncing0":
+				metrics[MetricNonce] = float64(40)
+				metrics[MetricIsSyncing] = float64(1)
+				metrics[MetricTrieSyncNumProcessedNodes] = float64(12345)
+				metrics[MetricTrieSyncNumReceivedBytes] = float64(678910)
+			}
+
+			genericResp := &data.GenericAPIResponse{Data: map[string]interface{}{"metrics": metrics}}
+			genRespBytes, _ := json.Marshal(genericResp)
+			return 200, json.Unmarshal(genRespBytes, value)
+		},
+	},
+		&mock.GenericApiResponseCacherMock{},
+		time.Second, 0,
+		0,
+	)
+	currentTime := time.Unix(1000, 0)
+	nodeStatusProc.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	syncProgress := nodeStatusProc.GetSyncProgress()
+	require.Equal(t, uint32(3), atomic.LoadUint32(&numStatusCalls))
+	require.False(t, syncProgress.IsSynced)
+	require.Len(t, syncProgress.Shards, 2)
+
+	shard0 := syncProgress.Shards[0]
+	require.True(t, shard0.IsSynced)
+	require.Equal(t, 1, shard0.NumSyncedObservers)
+	require.Equal(t, uint64(100), shard0.HighestNonce)
+	require.Equal(t, &data.ObserverSyncProgress{
+		Address:                   "syncing0",
+		IsSyncing:                 true,
+		Nonce:                     40,
+		ProbableHighestNonce:      100,
+		NoncesBehind:              60,
+		Epoch:                     5,
+		TrieSyncNumProcessedNodes: 12345,
+		TrieSyncNumReceivedBytes:  678910,
+	}, shard0.Observers[1])
+
+	meta := syncProgress.Shards[1]
+	require.False(t, meta.IsSynced)
+	require.Equal(t, "observer down", meta.Observers[0].Error)
+
+	// served from cache
+	currentTime = currentTime.Add(time.Second)
+	require.Equal(t, syncProgress, nodeStatusProc.GetSyncProgress())
+	require.Equal(t, uint32(3), atomic.LoadUint32(&numStatusCalls))
+
+	currentTime = currentTime.Add(syncProgressCacheValidityDuration)
+	_ = nodeStatusProc.GetSyncProgress()
+	require.Equal(t, uint32(6), atomic.LoadUint32(&numStatusCalls))
+}
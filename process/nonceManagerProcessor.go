@@ -0,0 +1,369 @@
+package process
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// inFlightGracePeriod is the duration for which a sent nonce is not reassigned, even if it is reported as a nonce
+// gap, giving the transaction the time to propagate towards all the observers of the shard
+const inFlightGracePeriod = 30 * time.Second
+
+const reservationIDLength = 16
+
+type nonceReservation struct {
+	fromNonce uint64
+	toNonce   uint64
+	expiresAt time.Time
+}
+
+type managedSenderState struct {
+	mut          sync.Mutex
+	privKey      crypto.PrivateKey
+	nextNonce    uint64
+	inFlight     map[uint64]time.Time
+	reservations map[string]*nonceReservation
+}
+
+// NonceManagerProcessor assigns the nonces of the transactions sent on behalf of the hosted senders, whose keys are
+// loaded by the proxy, so that the clients do not have to track the nonces on their side
+type NonceManagerProcessor struct {
+	accountProc     ManagedSenderAccountHandler
+	txProc          ManagedTransactionsHandler
+	pubKeyConverter core.PubkeyConverter
+	singleSigner    crypto.SingleSigner
+	senders         map[string]*managedSenderState
+	maxReservation  uint64
+	maxTTL          time.Duration
+	getTimeHandler  func() time.Time
+}
+
+// NewNonceManagerProcessor will create a new instance of NonceManagerProcessor
+func NewNonceManagerProcessor(
+	accountProc ManagedSenderAccountHandler,
+	txProc ManagedTransactionsHandler,
+	privKeysLoader PrivateKeysLoaderHandler,
+	pubKeyConverter core.PubkeyConverter,
+	cfg config.NonceManagerConfig,
+) (*NonceManagerProcessor, error) {
+	if accountProc == nil {
+		return nil, ErrNilAccountProcessor
+	}
+	if txProc == nil {
+		return nil, ErrNilTransactionProcessor
+	}
+	if privKeysLoader == nil {
+		return nil, ErrNilPrivateKeysLoader
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if cfg.MaxReservationSize == 0 {
+		return nil, fmt.Errorf("%w, MaxReservationSize should be positive", ErrInvalidNonceManagerConfig)
+	}
+	if cfg.MaxReservationTTLInSec <= 0 {
+		return nil, fmt.Errorf("%w, MaxReservationTTLInSec should be positive", ErrInvalidNonceManagerConfig)
+	}
+
+	privKeysByShard, err := privKeysLoader.PrivateKeysByShard()
+	if err != nil {
+		return nil, err
+	}
+
+	senders := make(map[string]*managedSenderState)
+	for _, privKeys := range privKeysByShard {
+		for _, privKey := range privKeys {
+			pubKeyBytes, errPk := privKey.GeneratePublic().ToByteArray()
+			if errPk != nil {
+				return nil, errPk
+			}
+
+			address, errEncode := pubKeyConverter.Encode(pubKeyBytes)
+			if errEncode != nil {
+				return nil, errEncode
+			}
+
+			senders[address] = &managedSenderState{
+				privKey:      privKey,
+				inFlight:     make(map[uint64]time.Time),
+				reservations: make(map[string]*nonceReservation),
+			}
+		}
+	}
+	if len(senders) == 0 {
+		return nil, ErrNoManagedSenders
+	}
+
+	return &NonceManagerProcessor{
+		accountProc:     accountProc,
+		txProc:          txProc,
+		pubKeyConverter: pubKeyConverter,
+		singleSigner:    getSingleSigner(),
+		senders:         senders,
+		maxReservation:  cfg.MaxReservationSize,
+		maxTTL:          time.Duration(cfg.MaxReservationTTLInSec) * time.Second,
+		getTimeHandler:  time.Now,
+	}, nil
+}
+
+// SendManagedTransaction assigns the next nonce of the hosted sender to the provided transaction, signs it and relays it
+// to the observers. The transactions of the same sender are processed one at a time, so no nonce is assigned twice
+func (nmp *NonceManagerProcessor) SendManagedTransaction(tx *data.Transaction) (int, string, error) {
+	if len(tx.GuardianAddr) > 0 || len(tx.RelayerAddr) > 0 || tx.Options != 0 {
+		return http.StatusBadRequest, "", ErrUnsupportedManagedTransaction
+	}
+
+	sender, ok := nmp.senders[tx.Sender]
+	if !ok {
+		return http.StatusBadRequest, "", ErrSenderNotManaged
+	}
+
+	sender.mut.Lock()
+	defer sender.mut.Unlock()
+
+	nonce, err := nmp.computeNextNonce(tx.Sender, sender)
+	if err != nil {
+		return http.StatusInternalServerError, "", err
+	}
+
+	tx.Nonce = nonce
+	tx.Signature = ""
+	err = nmp.signTransaction(tx, sender.privKey)
+	if err != nil {
+		return http.StatusInternalServerError, "", err
+	}
+
+	statusCode, txHash, err := nmp.txProc.SendTransaction(tx)
+	if err != nil {
+		return statusCode, "", err
+	}
+
+	sender.inFlight[nonce] = nmp.getTimeHandler()
+	if nonce >= sender.nextNonce {
+		sender.nextNonce = nonce + 1
+	}
+
+	log.Debug("managed transaction sent", "sender", tx.Sender, "nonce", nonce, "hash", txHash)
+
+	return statusCode, txHash, nil
+}
+
+// ReserveNonces reserves a range of consecutive nonces of the hosted sender for the provided duration, so that the
+// workers of the same wallet can sign and send their transactions concurrently without nonce collisions. The reserved
+// nonces are never assigned to the managed transactions, even if they are reported as nonce gaps
+func (nmp *NonceManagerProcessor) ReserveNonces(request *data.NonceReservationRequest) (int, *data.NonceReservation, error) {
+	sender, ok := nmp.senders[request.Sender]
+	if !ok {
+		return http.StatusBadRequest, nil, ErrSenderNotManaged
+	}
+	if request.NumNonces == 0 || request.NumNonces > nmp.maxReservation {
+		return http.StatusBadRequest, nil, fmt.Errorf("%w, the number of nonces should be between 1 and %d",
+			ErrInvalidNonceReservation, nmp.maxReservation)
+	}
+
+	ttl := nmp.maxTTL
+	if request.TTLInSec != 0 {
+		ttl = time.Duration(request.TTLInSec) * time.Second
+	}
+	if ttl <= 0 || ttl > nmp.maxTTL {
+		return http.StatusBadRequest, nil, fmt.Errorf("%w, the TTL should be between 1 and %d seconds",
+			ErrInvalidNonceReservation, int(nmp.maxTTL.Seconds()))
+	}
+
+	reservationID, err := generateReservationID()
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	sender.mut.Lock()
+	defer sender.mut.Unlock()
+
+	_, err = nmp.refreshSenderState(request.Sender, sender)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	reservation := &nonceReservation{
+		fromNonce: sender.nextNonce,
+		toNonce:   sender.nextNonce + request.NumNonces - 1,
+		expiresAt: nmp.getTimeHandler().Add(ttl),
+	}
+	sender.reservations[reservationID] = reservation
+	sender.nextNonce = reservation.toNonce + 1
+
+	log.Debug("managed sender nonces reserved",
+		"sender", request.Sender,
+		"reservation", reservationID,
+		"from nonce", reservation.fromNonce,
+		"to nonce", reservation.toNonce)
+
+	return http.StatusOK, &data.NonceReservation{
+		ReservationID: reservationID,
+		Sender:        request.Sender,
+		FromNonce:     reservation.fromNonce,
+		ToNonce:       reservation.toNonce,
+		ExpiresAt:     reservation.expiresAt.Unix(),
+	}, nil
+}
+
+// ReleaseNonces releases a reservation of the hosted sender, before its expiry. The nonces of the reservation not used by
+// the workers are given back: if the reservation is the last one handed out, the following nonces are assigned again,
+// otherwise they become nonce gaps, filled by the next managed transactions
+func (nmp *NonceManagerProcessor) ReleaseNonces(request *data.NonceReleaseRequest) (int, error) {
+	sender, ok := nmp.senders[request.Sender]
+	if !ok {
+		return http.StatusBadRequest, ErrSenderNotManaged
+	}
+
+	sender.mut.Lock()
+	defer sender.mut.Unlock()
+
+	nmp.removeExpiredReservations(sender)
+	reservation, found := sender.reservations[request.ReservationID]
+	if !found {
+		return http.StatusNotFound, ErrNonceReservationNotFound
+	}
+
+	numReserved := reservation.toNonce - reservation.fromNonce + 1
+	if request.NumUsed > numReserved {
+		return http.StatusBadRequest, fmt.Errorf("%w, only %d nonces were reserved", ErrInvalidNonceReservation, numReserved)
+	}
+
+	delete(sender.reservations, request.ReservationID)
+	if reservation.toNonce+1 == sender.nextNonce {
+		sender.nextNonce = reservation.fromNonce + request.NumUsed
+	}
+
+	log.Debug("managed sender nonces released",
+		"sender", request.Sender,
+		"reservation", request.ReservationID,
+		"num used", request.NumUsed,
+		"next nonce", sender.nextNonce)
+
+	return http.StatusOK, nil
+}
+
+func (nmp *NonceManagerProcessor) removeExpiredReservations(sender *managedSenderState) {
+	for reservationID, reservation := range sender.reservations {
+		if !nmp.getTimeHandler().Before(reservation.expiresAt) {
+			delete(sender.reservations, reservationID)
+		}
+	}
+}
+
+func (nmp *NonceManagerProcessor) isNonceReserved(sender *managedSenderState, nonce uint64) bool {
+	for _, reservation := range sender.reservations {
+		if nonce >= reservation.fromNonce && nonce <= reservation.toNonce {
+			return true
+		}
+	}
+
+	return false
+}
+
+func generateReservationID() (string, error) {
+	buff := make([]byte, reservationIDLength)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buff), nil
+}
+
+// refreshSenderState drops the expired reservations and the in flight nonces already executed, then moves the next
+// nonce of the sender ahead of its account nonce. Returns the account nonce
+func (nmp *NonceManagerProcessor) refreshSenderState(address string, sender *managedSenderState) (uint64, error) {
+	account, err := nmp.accountProc.GetAccount(context.Background(), address, common.AccountQueryOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	nmp.removeExpiredReservations(sender)
+
+	accountNonce := account.Account.Nonce
+	for nonce := range sender.inFlight {
+		if nonce < accountNonce {
+			delete(sender.inFlight, nonce)
+		}
+	}
+	if sender.nextNonce < accountNonce {
+		sender.nextNonce = accountNonce
+	}
+
+	return accountNonce, nil
+}
+
+// computeNextNonce returns the lowest nonce of the sender that is missing from the pool: either the first nonce gap
+// (which would otherwise keep the following transactions stuck) or the nonce after the last one already sent
+func (nmp *NonceManagerProcessor) computeNextNonce(address string, sender *managedSenderState) (uint64, error) {
+	accountNonce, err := nmp.refreshSenderState(address, sender)
+	if err != nil {
+		return 0, err
+	}
+
+	nonceGaps, err := nmp.txProc.GetTransactionsPoolNonceGapsForSender(address)
+	if err != nil {
+		log.Debug("cannot get nonce gaps for managed sender, using the tracked nonce", "sender", address, "error", err)
+		return sender.nextNonce, nil
+	}
+
+	for _, gap := range nonceGaps.Gaps {
+		for nonce := gap.From; nonce <= gap.To && nonce < sender.nextNonce; nonce++ {
+			if nonce < accountNonce || nmp.isNonceReserved(sender, nonce) {
+				continue
+			}
+
+			sentTimestamp, isInFlight := sender.inFlight[nonce]
+			if !isInFlight || nmp.getTimeHandler().Sub(sentTimestamp) > inFlightGracePeriod {
+				return nonce, nil
+			}
+		}
+	}
+
+	return sender.nextNonce, nil
+}
+
+func (nmp *NonceManagerProcessor) signTransaction(tx *data.Transaction, privKey crypto.PrivateKey) error {
+	txBytes, err := json.Marshal(erdTransaction{
+		Nonce:    tx.Nonce,
+		Value:    tx.Value,
+		RcvAddr:  tx.Receiver,
+		SndAddr:  tx.Sender,
+		GasPrice: tx.GasPrice,
+		GasLimit: tx.GasLimit,
+		Data:     tx.Data,
+		ChainID:  tx.ChainID,
+		Version:  tx.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	signature, err := nmp.singleSigner.Sign(privKey, txBytes)
+	if err != nil {
+		return err
+	}
+
+	tx.Signature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+// IsEnabled returns true
+func (nmp *NonceManagerProcessor) IsEnabled() bool {
+	return true
+}
@@ -0,0 +1,322 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createPrivKeysLoaderStub(keys ...crypto.PrivateKey) *mock.PrivateKeysLoaderStub {
+	return &mock.PrivateKeysLoaderStub{
+		PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+			return map[uint32][]crypto.PrivateKey{0: keys}, nil
+		},
+	}
+}
+
+func createNonceManagerConfig() config.NonceManagerConfig {
+	return config.NonceManagerConfig{
+		Enabled:                true,
+		MaxReservationSize:     100,
+		MaxReservationTTLInSec: 60,
+	}
+}
+
+func createAccountHandlerWithNonce(nonce uint64) *mock.ManagedSenderAccountHandlerStub {
+	return &mock.ManagedSenderAccountHandlerStub{
+		GetAccountCalled: func(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{Account: data.Account{Nonce: nonce}}, nil
+		},
+	}
+}
+
+func TestNewNonceManagerProcessor(t *testing.T) {
+	t.Parallel()
+
+	sk := getPrivKey()
+
+	nmp, err := process.NewNonceManagerProcessor(nil, &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+	require.Nil(t, nmp)
+	require.Equal(t, process.ErrNilAccountProcessor, err)
+
+	nmp, err = process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, nil, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+	require.Nil(t, nmp)
+	require.Equal(t, process.ErrNilTransactionProcessor, err)
+
+	nmp, err = process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, &mock.ManagedTransactionsHandlerStub{}, nil, &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+	require.Nil(t, nmp)
+	require.Equal(t, process.ErrNilPrivateKeysLoader, err)
+
+	nmp, err = process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(sk), nil, createNonceManagerConfig())
+	require.Nil(t, nmp)
+	require.Equal(t, process.ErrNilPubKeyConverter, err)
+
+	nmp, err = process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+	require.Nil(t, nmp)
+	require.Equal(t, process.ErrNoManagedSenders, err)
+
+	cfg := createNonceManagerConfig()
+	cfg.MaxReservationSize = 0
+	nmp, err = process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, cfg)
+	require.Nil(t, nmp)
+	require.True(t, errors.Is(err, process.ErrInvalidNonceManagerConfig))
+
+	cfg = createNonceManagerConfig()
+	cfg.MaxReservationTTLInSec = 0
+	nmp, err = process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, cfg)
+	require.Nil(t, nmp)
+	require.True(t, errors.Is(err, process.ErrInvalidNonceManagerConfig))
+
+	nmp, err = process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+	require.NoError(t, err)
+	require.True(t, nmp.IsEnabled())
+}
+
+func TestNonceManagerProcessor_SendManagedTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		nmp, _ := process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(getPrivKey()), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+		statusCode, _, err := nmp.SendManagedTransaction(&data.Transaction{Sender: "unknown"})
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, process.ErrSenderNotManaged, err)
+	})
+	t.Run("guarded transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		sk := getPrivKey()
+		nmp, _ := process.NewNonceManagerProcessor(&mock.ManagedSenderAccountHandlerStub{}, &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+		statusCode, _, err := nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk), GuardianAddr: "guardian"})
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, process.ErrUnsupportedManagedTransaction, err)
+	})
+	t.Run("consecutive transactions should get consecutive nonces", func(t *testing.T) {
+		t.Parallel()
+
+		sk := getPrivKey()
+		sentNonces := make([]uint64, 0)
+		txProc := &mock.ManagedTransactionsHandlerStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				require.NotEmpty(t, tx.Signature)
+				sentNonces = append(sentNonces, tx.Nonce)
+				return http.StatusOK, "hash", nil
+			},
+		}
+		nmp, _ := process.NewNonceManagerProcessor(createAccountHandlerWithNonce(5), txProc, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+
+		for i := 0; i < 3; i++ {
+			_, txHash, err := nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk)})
+			require.NoError(t, err)
+			require.Equal(t, "hash", txHash)
+		}
+		require.Equal(t, []uint64{5, 6, 7}, sentNonces)
+	})
+	t.Run("failed send should release the nonce", func(t *testing.T) {
+		t.Parallel()
+
+		sk := getPrivKey()
+		expectedErr := errors.New("expected error")
+		sentNonces := make([]uint64, 0)
+		shouldFail := true
+		txProc := &mock.ManagedTransactionsHandlerStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentNonces = append(sentNonces, tx.Nonce)
+				if shouldFail {
+					return http.StatusBadRequest, "", expectedErr
+				}
+				return http.StatusOK, "hash", nil
+			},
+		}
+		nmp, _ := process.NewNonceManagerProcessor(createAccountHandlerWithNonce(5), txProc, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+
+		statusCode, _, err := nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk)})
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, expectedErr, err)
+
+		shouldFail = false
+		_, _, err = nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk)})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{5, 5}, sentNonces)
+	})
+	t.Run("nonce gaps should be filled once the in flight grace period passed", func(t *testing.T) {
+		t.Parallel()
+
+		sk := getPrivKey()
+		sentNonces := make([]uint64, 0)
+		gaps := make([]data.NonceGap, 0)
+		txProc := &mock.ManagedTransactionsHandlerStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentNonces = append(sentNonces, tx.Nonce)
+				return http.StatusOK, "hash", nil
+			},
+			GetTransactionsPoolNonceGapsForSenderCalled: func(sender string) (*data.TransactionsPoolNonceGaps, error) {
+				return &data.TransactionsPoolNonceGaps{Gaps: gaps}, nil
+			},
+		}
+		nmp, _ := process.NewNonceManagerProcessor(createAccountHandlerWithNonce(5), txProc, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+		currentTime := time.Unix(1000, 0)
+		nmp.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		for i := 0; i < 3; i++ {
+			_, _, err := nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk)})
+			require.NoError(t, err)
+		}
+
+		// nonce 6 did not reach the pool yet, but it is still in flight
+		gaps = []data.NonceGap{{From: 6, To: 6}}
+		_, _, err := nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk)})
+		require.NoError(t, err)
+
+		// nonce 6 was dropped
+		currentTime = currentTime.Add(time.Minute)
+		_, _, err = nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk)})
+		require.NoError(t, err)
+
+		require.Equal(t, []uint64{5, 6, 7, 8, 6}, sentNonces)
+	})
+	t.Run("account nonce ahead of the tracked one should be used", func(t *testing.T) {
+		t.Parallel()
+
+		sk := getPrivKey()
+		accountNonce := uint64(1)
+		sentNonces := make([]uint64, 0)
+		accountProc := &mock.ManagedSenderAccountHandlerStub{
+			GetAccountCalled: func(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Nonce: accountNonce}}, nil
+			},
+		}
+		txProc := &mock.ManagedTransactionsHandlerStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentNonces = append(sentNonces, tx.Nonce)
+				return http.StatusOK, "hash", nil
+			},
+		}
+		nmp, _ := process.NewNonceManagerProcessor(accountProc, txProc, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+
+		_, _, err := nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk)})
+		require.NoError(t, err)
+
+		accountNonce = 10
+		_, _, err = nmp.SendManagedTransaction(&data.Transaction{Sender: hexPubKeyFromSk(sk)})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 10}, sentNonces)
+	})
+}
+
+func TestNonceManagerProcessor_ReserveAndReleaseNonces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		sk := getPrivKey()
+		nmp, _ := process.NewNonceManagerProcessor(createAccountHandlerWithNonce(5), &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+
+		statusCode, _, err := nmp.ReserveNonces(&data.NonceReservationRequest{Sender: "unknown", NumNonces: 1})
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, process.ErrSenderNotManaged, err)
+
+		statusCode, _, err = nmp.ReserveNonces(&data.NonceReservationRequest{Sender: hexPubKeyFromSk(sk), NumNonces: 101})
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.True(t, errors.Is(err, process.ErrInvalidNonceReservation))
+
+		statusCode, _, err = nmp.ReserveNonces(&data.NonceReservationRequest{Sender: hexPubKeyFromSk(sk), NumNonces: 1, TTLInSec: 61})
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.True(t, errors.Is(err, process.ErrInvalidNonceReservation))
+
+		statusCode, err = nmp.ReleaseNonces(&data.NonceReleaseRequest{Sender: hexPubKeyFromSk(sk), ReservationID: "missing"})
+		require.Equal(t, http.StatusNotFound, statusCode)
+		require.Equal(t, process.ErrNonceReservationNotFound, err)
+	})
+	t.Run("reservations should not overlap with each other or with the managed transactions", func(t *testing.T) {
+		t.Parallel()
+
+		sk := getPrivKey()
+		sentNonces := make([]uint64, 0)
+		gaps := make([]data.NonceGap, 0)
+		txProc := &mock.ManagedTransactionsHandlerStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentNonces = append(sentNonces, tx.Nonce)
+				return http.StatusOK, "hash", nil
+			},
+			GetTransactionsPoolNonceGapsForSenderCalled: func(sender string) (*data.TransactionsPoolNonceGaps, error) {
+				return &data.TransactionsPoolNonceGaps{Gaps: gaps}, nil
+			},
+		}
+		nmp, _ := process.NewNonceManagerProcessor(createAccountHandlerWithNonce(5), txProc, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+		currentTime := time.Unix(1000, 0)
+		nmp.SetGetTimeHandler(func() time.Time {
+			return currentTime
+		})
+		sender := hexPubKeyFromSk(sk)
+
+		_, first, err := nmp.ReserveNonces(&data.NonceReservationRequest{Sender: sender, NumNonces: 10})
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), first.FromNonce)
+		require.Equal(t, uint64(14), first.ToNonce)
+		require.Equal(t, currentTime.Add(time.Minute).Unix(), first.ExpiresAt)
+
+		_, second, err := nmp.ReserveNonces(&data.NonceReservationRequest{Sender: sender, NumNonces: 5, TTLInSec: 10})
+		require.NoError(t, err)
+		require.NotEqual(t, first.ReservationID, second.ReservationID)
+		require.Equal(t, uint64(15), second.FromNonce)
+		require.Equal(t, uint64(19), second.ToNonce)
+
+		// the reserved nonces not yet sent by the workers are not assigned to the managed transactions
+		gaps = []data.NonceGap{{From: 5, To: 19}}
+		_, _, err = nmp.SendManagedTransaction(&data.Transaction{Sender: sender})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{20}, sentNonces)
+
+		// the first reservation used only 8 nonces, so 13 and 14 become gaps filled by the managed transactions
+		statusCode, err := nmp.ReleaseNonces(&data.NonceReleaseRequest{Sender: sender, ReservationID: first.ReservationID, NumUsed: 8})
+		require.Equal(t, http.StatusOK, statusCode)
+		require.NoError(t, err)
+		gaps = []data.NonceGap{{From: 13, To: 19}}
+		_, _, err = nmp.SendManagedTransaction(&data.Transaction{Sender: sender})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{20, 13}, sentNonces)
+
+		// the second reservation expired
+		currentTime = currentTime.Add(11 * time.Second)
+		statusCode, err = nmp.ReleaseNonces(&data.NonceReleaseRequest{Sender: sender, ReservationID: second.ReservationID})
+		require.Equal(t, http.StatusNotFound, statusCode)
+		require.Equal(t, process.ErrNonceReservationNotFound, err)
+	})
+	t.Run("releasing the last reservation should give back its unused nonces", func(t *testing.T) {
+		t.Parallel()
+
+		sk := getPrivKey()
+		nmp, _ := process.NewNonceManagerProcessor(createAccountHandlerWithNonce(5), &mock.ManagedTransactionsHandlerStub{}, createPrivKeysLoaderStub(sk), &mock.PubKeyConverterMock{}, createNonceManagerConfig())
+		sender := hexPubKeyFromSk(sk)
+
+		_, reservation, err := nmp.ReserveNonces(&data.NonceReservationRequest{Sender: sender, NumNonces: 10})
+		require.NoError(t, err)
+
+		statusCode, err := nmp.ReleaseNonces(&data.NonceReleaseRequest{Sender: sender, ReservationID: reservation.ReservationID, NumUsed: 11})
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.True(t, errors.Is(err, process.ErrInvalidNonceReservation))
+
+		_, err = nmp.ReleaseNonces(&data.NonceReleaseRequest{Sender: sender, ReservationID: reservation.ReservationID, NumUsed: 3})
+		require.NoError(t, err)
+
+		_, reservation, err = nmp.ReserveNonces(&data.NonceReservationRequest{Sender: sender, NumNonces: 2})
+		require.NoError(t, err)
+		require.Equal(t, uint64(8), reservation.FromNonce)
+		require.Equal(t, uint64(9), reservation.ToNonce)
+	})
+}
@@ -0,0 +1,281 @@
+package process
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const (
+	// ObserverAuthTypeHMAC identifies the authentication of the requests through their HMAC-SHA256 signature
+	ObserverAuthTypeHMAC = "hmac"
+	// ObserverAuthTypeJWT identifies the authentication of the requests through a HS256 JSON Web Token
+	ObserverAuthTypeJWT = "jwt"
+
+	defaultObserverAuthHeader   = "Authorization"
+	defaultObserverAuthTokenTTL = 5 * time.Minute
+	observerAuthIssuer          = "mx-chain-proxy"
+	hmacAuthScheme              = "HMAC-SHA256"
+	jwtAuthScheme               = "Bearer"
+)
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+	KeyID     string `json:"kid,omitempty"`
+}
+
+type jwtClaims struct {
+	Issuer    string `json:"iss"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+type observerAuthEntry struct {
+	addresses      map[string]struct{}
+	shardIDs       map[uint32]struct{}
+	authType       string
+	headerName     string
+	keyID          string
+	secret         []byte
+	tokenTTL       time.Duration
+	getTimeHandler func() time.Time
+
+	mutToken       sync.Mutex
+	token          string
+	tokenExpiresAt time.Time
+}
+
+// observersAuth holds the authentication settings of the observers requiring authenticated access on their REST API,
+// matched by address or by shard
+type observersAuth struct {
+	entries []*observerAuthEntry
+}
+
+func newObserversAuth(cfgs []config.ObserverAuthConfig) (*observersAuth, error) {
+	entries := make([]*observerAuthEntry, 0, len(cfgs))
+	for idx, cfg := range cfgs {
+		entry, err := createObserverAuthEntry(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%w, entry %d: %s", ErrInvalidObserverAuthConfig, idx, err.Error())
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &observersAuth{
+		entries: entries,
+	}, nil
+}
+
+func createObserverAuthEntry(cfg config.ObserverAuthConfig) (*observerAuthEntry, error) {
+	if len(cfg.Addresses) == 0 && len(cfg.ShardIDs) == 0 {
+		return nil, fmt.Errorf("no Addresses or ShardIDs provided")
+	}
+
+	authType := strings.ToLower(cfg.Type)
+	if authType != ObserverAuthTypeHMAC && authType != ObserverAuthTypeJWT {
+		return nil, fmt.Errorf("unknown Type %s, should be %s or %s", cfg.Type, ObserverAuthTypeHMAC, ObserverAuthTypeJWT)
+	}
+	if cfg.TokenTTLInSec < 0 {
+		return nil, fmt.Errorf("TokenTTLInSec should not be negative")
+	}
+
+	secret, err := os.ReadFile(cfg.SecretFile)
+	if err != nil {
+		return nil, err
+	}
+	secret = bytes.TrimSpace(secret)
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("empty secret in %s", cfg.SecretFile)
+	}
+
+	entry := &observerAuthEntry{
+		addresses:      make(map[string]struct{}, len(cfg.Addresses)),
+		shardIDs:       make(map[uint32]struct{}, len(cfg.ShardIDs)),
+		authType:       authType,
+		headerName:     cfg.HeaderName,
+		keyID:          cfg.KeyID,
+		secret:         secret,
+		tokenTTL:       time.Duration(cfg.TokenTTLInSec) * time.Second,
+		getTimeHandler: time.Now,
+	}
+	if len(entry.headerName) == 0 {
+		entry.headerName = defaultObserverAuthHeader
+	}
+	if entry.tokenTTL == 0 {
+		entry.tokenTTL = defaultObserverAuthTokenTTL
+	}
+	for _, address := range cfg.Addresses {
+		entry.addresses[address] = struct{}{}
+	}
+	for _, shardID := range cfg.ShardIDs {
+		entry.shardIDs[shardID] = struct{}{}
+	}
+
+	return entry, nil
+}
+
+// getEntry returns the authentication settings of the provided observer, or nil if none applies. An entry matching the
+// address takes precedence over one matching the shard of the observer
+func (oa *observersAuth) getEntry(address string, shardOfObserver func(address string) (uint32, bool)) *observerAuthEntry {
+	for _, entry := range oa.entries {
+		_, found := entry.addresses[address]
+		if found {
+			return entry
+		}
+	}
+
+	if shardOfObserver == nil {
+		return nil
+	}
+	shardID, ok := shardOfObserver(address)
+	if !ok {
+		return nil
+	}
+	for _, entry := range oa.entries {
+		_, found := entry.shardIDs[shardID]
+		if found {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+// wrapTransport returns a transport attaching the authentication header to the requests towards the observer, or the
+// provided transport if the observer does not require authentication
+func (oa *observersAuth) wrapTransport(
+	address string,
+	transport http.RoundTripper,
+	shardOfObserver func(address string) (uint32, bool),
+) http.RoundTripper {
+	entry := oa.getEntry(address, shardOfObserver)
+	if entry == nil {
+		return transport
+	}
+
+	return &authTransport{
+		entry:     entry,
+		transport: transport,
+	}
+}
+
+// computeHeaderValue returns the value of the authentication header of the provided request. The HMAC signature covers
+// the method, the path and query, the timestamp and the SHA256 hash of the body
+func (entry *observerAuthEntry) computeHeaderValue(req *http.Request, body []byte) (string, error) {
+	if entry.authType == ObserverAuthTypeJWT {
+		token, err := entry.getToken()
+		if err != nil {
+			return "", err
+		}
+
+		return jwtAuthScheme + " " + token, nil
+	}
+
+	timestamp := entry.getTimeHandler().Unix()
+	bodyHash := sha256.Sum256(body)
+	payload := fmt.Sprintf("%s\n%s\n%d\n%s", req.Method, req.URL.RequestURI(), timestamp, hex.EncodeToString(bodyHash[:]))
+	signature := entry.sign([]byte(payload))
+
+	return fmt.Sprintf("%s KeyId=%s, Timestamp=%d, Signature=%s", hmacAuthScheme, entry.keyID, timestamp, hex.EncodeToString(signature)), nil
+}
+
+// getToken returns the JSON Web Token presented to the observers, issuing a new one once half of its lifetime passed
+func (entry *observerAuthEntry) getToken() (string, error) {
+	entry.mutToken.Lock()
+	defer entry.mutToken.Unlock()
+
+	now := entry.getTimeHandler()
+	if len(entry.token) > 0 && now.Add(entry.tokenTTL/2).Before(entry.tokenExpiresAt) {
+		return entry.token, nil
+	}
+
+	expiresAt := now.Add(entry.tokenTTL)
+	header, err := json.Marshal(jwtHeader{Algorithm: "HS256", Type: "JWT", KeyID: entry.keyID})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(jwtClaims{Issuer: observerAuthIssuer, IssuedAt: now.Unix(), ExpiresAt: expiresAt.Unix()})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	entry.token = signingInput + "." + base64.RawURLEncoding.EncodeToString(entry.sign([]byte(signingInput)))
+	entry.tokenExpiresAt = expiresAt
+
+	return entry.token, nil
+}
+
+func (entry *observerAuthEntry) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, entry.secret)
+	_, _ = mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// authTransport attaches the authentication header to the requests towards an observer
+type authTransport struct {
+	entry     *observerAuthEntry
+	transport http.RoundTripper
+}
+
+// RoundTrip forwards a copy of the request, holding the authentication header, to the wrapped transport
+func (at *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	headerValue, err := at.entry.computeHeaderValue(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	authReq := req.Clone(req.Context())
+	authReq.Header.Set(at.entry.headerName, headerValue)
+	// the body of a request unable to provide a copy of it was consumed while computing the header
+	if req.GetBody == nil && req.Body != nil && req.Body != http.NoBody {
+		authReq.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	return at.transport.RoundTrip(authReq)
+}
+
+// readRequestBody returns the body of the request, without consuming the body to be sent if the request can provide
+// a new copy of it
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody == nil {
+		defer func() {
+			_ = req.Body.Close()
+		}()
+
+		return io.ReadAll(req.Body)
+	}
+
+	bodyCopy, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = bodyCopy.Close()
+	}()
+
+	return io.ReadAll(bodyCopy)
+}
@@ -0,0 +1,140 @@
+package process
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+const testObserverAuthSecret = "observers-secret"
+
+func writeSecretFile(t *testing.T, secret string) string {
+	filePath := filepath.Join(t.TempDir(), "secret")
+	err := os.WriteFile(filePath, []byte(secret+"\n"), 0600)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func computeTestHmac(payload string) string {
+	mac := hmac.New(sha256.New, []byte(testObserverAuthSecret))
+	_, _ = mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestNewObserversAuth(t *testing.T) {
+	t.Parallel()
+
+	secretFile := writeSecretFile(t, testObserverAuthSecret)
+	testInvalidConfig := func(cfg config.ObserverAuthConfig) {
+		oa, err := newObserversAuth([]config.ObserverAuthConfig{cfg})
+		require.Nil(t, oa)
+		require.True(t, errors.Is(err, ErrInvalidObserverAuthConfig))
+	}
+
+	testInvalidConfig(config.ObserverAuthConfig{Type: ObserverAuthTypeHMAC, SecretFile: secretFile})
+	testInvalidConfig(config.ObserverAuthConfig{ShardIDs: []uint32{0}, Type: "basic", SecretFile: secretFile})
+	testInvalidConfig(config.ObserverAuthConfig{ShardIDs: []uint32{0}, Type: ObserverAuthTypeJWT, SecretFile: secretFile, TokenTTLInSec: -1})
+	testInvalidConfig(config.ObserverAuthConfig{ShardIDs: []uint32{0}, Type: ObserverAuthTypeHMAC, SecretFile: "missing-file"})
+	testInvalidConfig(config.ObserverAuthConfig{ShardIDs: []uint32{0}, Type: ObserverAuthTypeHMAC, SecretFile: writeSecretFile(t, " ")})
+
+	oa, err := newObserversAuth([]config.ObserverAuthConfig{
+		{Addresses: []string{"http://observer"}, Type: "HMAC", SecretFile: secretFile},
+		{ShardIDs: []uint32{1}, Type: ObserverAuthTypeJWT, HeaderName: "X-Auth", SecretFile: secretFile},
+	})
+	require.Nil(t, err)
+
+	shardOfObserver := func(address string) (uint32, bool) {
+		return 1, address == "http://observer-shard-1"
+	}
+	require.Equal(t, ObserverAuthTypeHMAC, oa.getEntry("http://observer", shardOfObserver).authType)
+	entry := oa.getEntry("http://observer-shard-1", shardOfObserver)
+	require.Equal(t, ObserverAuthTypeJWT, entry.authType)
+	require.Equal(t, "X-Auth", entry.headerName)
+	require.Equal(t, defaultObserverAuthTokenTTL, entry.tokenTTL)
+	require.Nil(t, oa.getEntry("http://other", shardOfObserver))
+}
+
+func TestObserversAuth_HmacShouldSignTheRequests(t *testing.T) {
+	t.Parallel()
+
+	var receivedHeader string
+	var receivedBody []byte
+	observer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeader = r.Header.Get(defaultObserverAuthHeader)
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer observer.Close()
+
+	clients, err := newObserversHttpClients(time.Second, config.ObserversHttpClientConfig{
+		Auth: []config.ObserverAuthConfig{
+			{Addresses: []string{observer.URL}, Type: ObserverAuthTypeHMAC, KeyID: "proxy-1", SecretFile: writeSecretFile(t, testObserverAuthSecret)},
+		},
+	})
+	require.Nil(t, err)
+	entry := clients.auth.getEntry(observer.URL, nil)
+	entry.getTimeHandler = func() time.Time {
+		return time.Unix(1000, 0)
+	}
+
+	body := []byte(`{"nonce":1}`)
+	req, _ := http.NewRequest(http.MethodPost, observer.URL+"/transaction/send?checkSignature=false", bytes.NewReader(body))
+	resp, err := clients.getClient(observer.URL).Do(req)
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+
+	bodyHash := sha256.Sum256(body)
+	expectedSignature := computeTestHmac(fmt.Sprintf("POST\n/transaction/send?checkSignature=false\n1000\n%s", hex.EncodeToString(bodyHash[:])))
+	require.Equal(t, "HMAC-SHA256 KeyId=proxy-1, Timestamp=1000, Signature="+expectedSignature, receivedHeader)
+	require.Equal(t, body, receivedBody)
+	require.Empty(t, req.Header.Get(defaultObserverAuthHeader))
+}
+
+func TestObserversAuth_JwtShouldBeReusedUntilHalfOfItsLifetime(t *testing.T) {
+	t.Parallel()
+
+	oa, _ := newObserversAuth([]config.ObserverAuthConfig{
+		{ShardIDs: []uint32{0}, Type: ObserverAuthTypeJWT, KeyID: "proxy-1", TokenTTLInSec: 60, SecretFile: writeSecretFile(t, testObserverAuthSecret)},
+	})
+	entry := oa.entries[0]
+	currentTime := time.Unix(1000, 0)
+	entry.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	token, err := entry.getToken()
+	require.Nil(t, err)
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	header, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	require.Equal(t, `{"alg":"HS256","typ":"JWT","kid":"proxy-1"}`, string(header))
+	claims, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	require.Equal(t, `{"iss":"mx-chain-proxy","iat":1000,"exp":1060}`, string(claims))
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	require.Equal(t, computeTestHmac(parts[0]+"."+parts[1]), hex.EncodeToString(signature))
+
+	currentTime = currentTime.Add(29 * time.Second)
+	sameToken, _ := entry.getToken()
+	require.Equal(t, token, sameToken)
+
+	currentTime = currentTime.Add(time.Second)
+	newToken, _ := entry.getToken()
+	require.NotEqual(t, token, newToken)
+}
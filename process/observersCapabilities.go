@@ -0,0 +1,118 @@
+package process
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// releaseVersionRegex matches the release part of the application version reported by the nodes, such as
+// v1.6.18-0-gd2f56d3/go1.20.7/linux-amd64/a2b6e5e7a1
+var releaseVersionRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)`)
+
+type nodeVersion [3]uint64
+
+func (nv nodeVersion) isLowerThan(other nodeVersion) bool {
+	for idx := range nv {
+		if nv[idx] != other[idx] {
+			return nv[idx] < other[idx]
+		}
+	}
+
+	return false
+}
+
+func parseNodeVersion(version string) (nodeVersion, bool) {
+	matches := releaseVersionRegex.FindStringSubmatch(version)
+	if len(matches) != len(nodeVersion{})+1 {
+		return nodeVersion{}, false
+	}
+
+	parsed := nodeVersion{}
+	for idx := range parsed {
+		value, err := strconv.ParseUint(matches[idx+1], 10, 64)
+		if err != nil {
+			return nodeVersion{}, false
+		}
+		parsed[idx] = value
+	}
+
+	return parsed, true
+}
+
+type capabilityMinVersion struct {
+	name       string
+	minVersion nodeVersion
+}
+
+// observersCapabilitiesHandler deduces the optional API capabilities of the observers out of the versions they report
+type observersCapabilitiesHandler struct {
+	capabilities []capabilityMinVersion
+}
+
+func newObserversCapabilitiesHandler(cfg config.ObserversCapabilitiesConfig) (*observersCapabilitiesHandler, error) {
+	capabilities := make([]capabilityMinVersion, 0, len(cfg.Capabilities))
+	for _, capabilityConfig := range cfg.Capabilities {
+		if len(capabilityConfig.Name) == 0 {
+			return nil, fmt.Errorf("%w, empty capability name", ErrInvalidObserversCapabilitiesConfig)
+		}
+
+		minVersion, ok := parseNodeVersion(capabilityConfig.MinVersion)
+		if !ok {
+			return nil, fmt.Errorf("%w, invalid MinVersion %s for capability %s",
+				ErrInvalidObserversCapabilitiesConfig, capabilityConfig.MinVersion, capabilityConfig.Name)
+		}
+
+		capabilities = append(capabilities, capabilityMinVersion{
+			name:       capabilityConfig.Name,
+			minVersion: minVersion,
+		})
+	}
+
+	return &observersCapabilitiesHandler{
+		capabilities: capabilities,
+	}, nil
+}
+
+// updateNodeCapabilities stores the reported version on the node, along with the capabilities that version lacks
+func (och *observersCapabilitiesHandler) updateNodeCapabilities(node *proxyData.NodeData, appVersion string) {
+	node.Version = appVersion
+
+	version, ok := parseNodeVersion(appVersion)
+	if !ok {
+		node.UnsupportedCapabilities = nil
+		return
+	}
+
+	unsupportedCapabilities := make([]string, 0)
+	for _, capability := range och.capabilities {
+		if version.isLowerThan(capability.minVersion) {
+			unsupportedCapabilities = append(unsupportedCapabilities, capability.name)
+		}
+	}
+	if len(unsupportedCapabilities) > 0 {
+		log.Debug("observer lacks API capabilities", "address", node.Address, "version", appVersion,
+			"unsupported capabilities", unsupportedCapabilities)
+	}
+
+	node.UnsupportedCapabilities = unsupportedCapabilities
+}
+
+// filterNodesByCapability returns the nodes supporting the provided capability. If none does, all the nodes are
+// returned, so the request is still attempted as it would have been without the capabilities knowledge
+func filterNodesByCapability(nodes []*proxyData.NodeData, capability string) []*proxyData.NodeData {
+	capableNodes := make([]*proxyData.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if node.SupportsCapability(capability) {
+			capableNodes = append(capableNodes, node)
+		}
+	}
+	if len(capableNodes) == 0 {
+		return nodes
+	}
+
+	return capableNodes
+}
@@ -0,0 +1,106 @@
+package process
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createCapabilitiesConfig() config.ObserversCapabilitiesConfig {
+	return config.ObserversCapabilitiesConfig{
+		Enabled: true,
+		Capabilities: []config.ObserverCapabilityConfig{
+			{Name: data.CapabilityTxPoolNonceGaps, MinVersion: "v1.4.0"},
+			{Name: data.CapabilityBlockWithLogs, MinVersion: "v1.6.10"},
+		},
+	}
+}
+
+func TestParseNodeVersion(t *testing.T) {
+	t.Parallel()
+
+	version, ok := parseNodeVersion("v1.6.18-0-gd2f56d3/go1.20.7/linux-amd64/a2b6e5e7a1")
+	require.True(t, ok)
+	require.Equal(t, nodeVersion{1, 6, 18}, version)
+
+	version, ok = parseNodeVersion("1.7.2")
+	require.True(t, ok)
+	require.Equal(t, nodeVersion{1, 7, 2}, version)
+
+	_, ok = parseNodeVersion("undefined")
+	require.False(t, ok)
+
+	_, ok = parseNodeVersion("")
+	require.False(t, ok)
+
+	require.True(t, nodeVersion{1, 6, 18}.isLowerThan(nodeVersion{1, 7, 0}))
+	require.True(t, nodeVersion{1, 6, 9}.isLowerThan(nodeVersion{1, 6, 10}))
+	require.False(t, nodeVersion{1, 6, 10}.isLowerThan(nodeVersion{1, 6, 10}))
+	require.False(t, nodeVersion{2, 0, 0}.isLowerThan(nodeVersion{1, 9, 9}))
+}
+
+func TestNewObserversCapabilitiesHandler(t *testing.T) {
+	t.Parallel()
+
+	cfg := createCapabilitiesConfig()
+	cfg.Capabilities[0].Name = ""
+	och, err := newObserversCapabilitiesHandler(cfg)
+	require.Nil(t, och)
+	require.True(t, errors.Is(err, ErrInvalidObserversCapabilitiesConfig))
+
+	cfg = createCapabilitiesConfig()
+	cfg.Capabilities[1].MinVersion = "latest"
+	och, err = newObserversCapabilitiesHandler(cfg)
+	require.Nil(t, och)
+	require.True(t, errors.Is(err, ErrInvalidObserversCapabilitiesConfig))
+
+	och, err = newObserversCapabilitiesHandler(createCapabilitiesConfig())
+	require.Nil(t, err)
+	require.Len(t, och.capabilities, 2)
+}
+
+func TestObserversCapabilitiesHandler_UpdateNodeCapabilities(t *testing.T) {
+	t.Parallel()
+
+	och, _ := newObserversCapabilitiesHandler(createCapabilitiesConfig())
+
+	node := &data.NodeData{Address: "observer0"}
+	och.updateNodeCapabilities(node, "v1.5.3-0-gabcdef")
+	require.Equal(t, "v1.5.3-0-gabcdef", node.Version)
+	require.Equal(t, []string{data.CapabilityBlockWithLogs}, node.UnsupportedCapabilities)
+	require.True(t, node.SupportsCapability(data.CapabilityTxPoolNonceGaps))
+	require.False(t, node.SupportsCapability(data.CapabilityBlockWithLogs))
+
+	och.updateNodeCapabilities(node, "v1.6.10")
+	require.Empty(t, node.UnsupportedCapabilities)
+	require.True(t, node.SupportsCapability(data.CapabilityBlockWithLogs))
+
+	och.updateNodeCapabilities(node, "v1.3.0")
+	require.Equal(t, []string{data.CapabilityTxPoolNonceGaps, data.CapabilityBlockWithLogs}, node.UnsupportedCapabilities)
+
+	// an unknown version should not restrict the node
+	och.updateNodeCapabilities(node, "undefined")
+	require.Equal(t, "undefined", node.Version)
+	require.Nil(t, node.UnsupportedCapabilities)
+	require.True(t, node.SupportsCapability(data.CapabilityTxPoolNonceGaps))
+}
+
+func TestFilterNodesByCapability(t *testing.T) {
+	t.Parallel()
+
+	oldNode := &data.NodeData{Address: "old", UnsupportedCapabilities: []string{data.CapabilityBlockWithLogs}}
+	newNode := &data.NodeData{Address: "new"}
+
+	filtered := filterNodesByCapability([]*data.NodeData{oldNode, newNode}, data.CapabilityBlockWithLogs)
+	require.Equal(t, []*data.NodeData{newNode}, filtered)
+
+	filtered = filterNodesByCapability([]*data.NodeData{oldNode, newNode}, data.CapabilityTxPoolNonceGaps)
+	require.Equal(t, []*data.NodeData{oldNode, newNode}, filtered)
+
+	// when no node supports the capability, all the nodes are still tried
+	filtered = filterNodesByCapability([]*data.NodeData{oldNode}, data.CapabilityBlockWithLogs)
+	require.Equal(t, []*data.NodeData{oldNode}, filtered)
+}
@@ -0,0 +1,238 @@
+package process
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const minObserversConsistencyQuorum = 2
+
+type observerEviction struct {
+	evictedUntil time.Time
+	numEvictions uint64
+}
+
+// observersConsistencyMonitor cross-checks the block hashes reported by the synced observers of each shard at a nonce
+// all of them already reached. The observers diverging from the hash agreed upon by the shard quorum are most likely
+// on a fork, so they are evicted from rotation for a while, by being considered out of sync
+type observersConsistencyMonitor struct {
+	mut              sync.Mutex
+	nonceOffset      uint64
+	minQuorum        int
+	evictionDuration time.Duration
+	nodesNonces      map[string]uint64
+	evictions        map[string]*observerEviction
+	blockHashFetcher func(address string, nonce uint64) (string, error)
+	getTimeHandler   func() time.Time
+}
+
+func newObserversConsistencyMonitor(
+	cfg config.ObserversConsistencyConfig,
+	blockHashFetcher func(address string, nonce uint64) (string, error),
+) (*observersConsistencyMonitor, error) {
+	if cfg.MinQuorum < minObserversConsistencyQuorum {
+		return nil, fmt.Errorf("%w, MinQuorum should be at least %d", ErrInvalidObserversConsistencyConfig, minObserversConsistencyQuorum)
+	}
+	if cfg.EvictionDurationInSec <= 0 {
+		return nil, fmt.Errorf("%w, EvictionDurationInSec should be positive", ErrInvalidObserversConsistencyConfig)
+	}
+
+	return &observersConsistencyMonitor{
+		nonceOffset:      cfg.NonceOffset,
+		minQuorum:        cfg.MinQuorum,
+		evictionDuration: time.Duration(cfg.EvictionDurationInSec) * time.Second,
+		nodesNonces:      make(map[string]uint64),
+		evictions:        make(map[string]*observerEviction),
+		blockHashFetcher: blockHashFetcher,
+		getTimeHandler:   time.Now,
+	}, nil
+}
+
+// recordNodeNonce stores the nonce reported by the node on its last status check
+func (ocm *observersConsistencyMonitor) recordNodeNonce(address string, nonce uint64) {
+	ocm.mut.Lock()
+	ocm.nodesNonces[address] = nonce
+	ocm.mut.Unlock()
+}
+
+// checkNodes cross-checks the synced nodes of each shard and marks the evicted nodes as out of sync
+func (ocm *observersConsistencyMonitor) checkNodes(nodes []*proxyData.NodeData) {
+	syncedNodesByShard := make(map[uint32][]*proxyData.NodeData)
+	for _, node := range nodes {
+		if node.IsSynced && !ocm.isEvicted(node.Address) {
+			syncedNodesByShard[node.ShardId] = append(syncedNodesByShard[node.ShardId], node)
+		}
+	}
+
+	for shardID, syncedNodes := range syncedNodesByShard {
+		ocm.checkShardNodes(shardID, syncedNodes)
+	}
+
+	for _, node := range nodes {
+		if ocm.isEvicted(node.Address) {
+			node.IsSynced = false
+		}
+	}
+}
+
+func (ocm *observersConsistencyMonitor) checkShardNodes(shardID uint32, nodes []*proxyData.NodeData) {
+	if len(nodes) < ocm.minQuorum {
+		return
+	}
+
+	nonce, ok := ocm.getCrossCheckNonce(nodes)
+	if !ok {
+		return
+	}
+
+	hashes := ocm.fetchBlockHashes(nodes, nonce)
+	quorumHash, numVotes := getQuorumBlockHash(hashes)
+	if numVotes < ocm.minQuorum || 2*numVotes <= len(hashes) {
+		log.Warn("observers consistency: no quorum on the block hash",
+			"shard", shardID,
+			"nonce", nonce,
+			"num responses", len(hashes),
+			"num votes", numVotes)
+		return
+	}
+
+	for address, hash := range hashes {
+		if hash == quorumHash {
+			continue
+		}
+
+		eviction := ocm.evict(address)
+		log.Error("observers consistency: observer evicted, its block hash diverges from the shard quorum",
+			"address", address,
+			"shard", shardID,
+			"nonce", nonce,
+			"hash", hash,
+			"quorum hash", quorumHash,
+			"evicted until", eviction.evictedUntil,
+			"num evictions", eviction.numEvictions)
+	}
+}
+
+// getCrossCheckNonce returns the nonce used for the cross-check, behind the lowest nonce of the provided nodes with the
+// configured offset, so that all of them had the time to finalize the block
+func (ocm *observersConsistencyMonitor) getCrossCheckNonce(nodes []*proxyData.NodeData) (uint64, bool) {
+	ocm.mut.Lock()
+	defer ocm.mut.Unlock()
+
+	lowestNonce := uint64(0)
+	for idx, node := range nodes {
+		nonce, found := ocm.nodesNonces[node.Address]
+		if !found {
+			return 0, false
+		}
+		if idx == 0 || nonce < lowestNonce {
+			lowestNonce = nonce
+		}
+	}
+	if lowestNonce <= ocm.nonceOffset {
+		return 0, false
+	}
+
+	return lowestNonce - ocm.nonceOffset, true
+}
+
+// fetchBlockHashes returns the block hashes reported by the nodes at the provided nonce. The nodes which fail to
+// respond are not taken into account
+func (ocm *observersConsistencyMonitor) fetchBlockHashes(nodes []*proxyData.NodeData, nonce uint64) map[string]string {
+	mutHashes := sync.Mutex{}
+	hashes := make(map[string]string, len(nodes))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(nodes))
+	for _, node := range nodes {
+		go func(address string) {
+			defer wg.Done()
+
+			hash, err := ocm.blockHashFetcher(address, nonce)
+			if err != nil {
+				log.Debug("observers consistency: cannot fetch block hash", "address", address, "nonce", nonce, "error", err)
+				return
+			}
+
+			mutHashes.Lock()
+			hashes[address] = hash
+			mutHashes.Unlock()
+		}(node.Address)
+	}
+	wg.Wait()
+
+	return hashes
+}
+
+// getQuorumBlockHash returns the most reported block hash, along with its number of votes. The ties are broken by the
+// hash value, so the result does not depend on the map iteration order
+func getQuorumBlockHash(hashes map[string]string) (string, int) {
+	votes := make(map[string]int)
+	for _, hash := range hashes {
+		votes[hash]++
+	}
+
+	sortedHashes := make([]string, 0, len(votes))
+	for hash := range votes {
+		sortedHashes = append(sortedHashes, hash)
+	}
+	sort.Slice(sortedHashes, func(i, j int) bool {
+		if votes[sortedHashes[i]] != votes[sortedHashes[j]] {
+			return votes[sortedHashes[i]] > votes[sortedHashes[j]]
+		}
+		return sortedHashes[i] < sortedHashes[j]
+	})
+	if len(sortedHashes) == 0 {
+		return "", 0
+	}
+
+	return sortedHashes[0], votes[sortedHashes[0]]
+}
+
+func (ocm *observersConsistencyMonitor) evict(address string) observerEviction {
+	ocm.mut.Lock()
+	defer ocm.mut.Unlock()
+
+	eviction, found := ocm.evictions[address]
+	if !found {
+		eviction = &observerEviction{}
+		ocm.evictions[address] = eviction
+	}
+	eviction.evictedUntil = ocm.getTimeHandler().Add(ocm.evictionDuration)
+	eviction.numEvictions++
+
+	return *eviction
+}
+
+func (ocm *observersConsistencyMonitor) isEvicted(address string) bool {
+	ocm.mut.Lock()
+	defer ocm.mut.Unlock()
+
+	eviction, found := ocm.evictions[address]
+	if !found {
+		return false
+	}
+
+	return ocm.getTimeHandler().Before(eviction.evictedUntil)
+}
+
+// getEviction returns the end of the current eviction of the node, if any, along with its total number of evictions
+func (ocm *observersConsistencyMonitor) getEviction(address string) (time.Time, uint64) {
+	ocm.mut.Lock()
+	defer ocm.mut.Unlock()
+
+	eviction, found := ocm.evictions[address]
+	if !found {
+		return time.Time{}, 0
+	}
+	if !ocm.getTimeHandler().Before(eviction.evictedUntil) {
+		return time.Time{}, eviction.numEvictions
+	}
+
+	return eviction.evictedUntil, eviction.numEvictions
+}
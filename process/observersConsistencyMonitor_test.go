@@ -0,0 +1,171 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createConsistencyConfig() config.ObserversConsistencyConfig {
+	return config.ObserversConsistencyConfig{
+		Enabled:               true,
+		NonceOffset:           5,
+		MinQuorum:             2,
+		EvictionDurationInSec: 60,
+	}
+}
+
+func TestNewObserversConsistencyMonitor(t *testing.T) {
+	t.Parallel()
+
+	cfg := createConsistencyConfig()
+	cfg.MinQuorum = 1
+	ocm, err := newObserversConsistencyMonitor(cfg, nil)
+	require.Nil(t, ocm)
+	require.True(t, errors.Is(err, ErrInvalidObserversConsistencyConfig))
+
+	cfg = createConsistencyConfig()
+	cfg.EvictionDurationInSec = 0
+	ocm, err = newObserversConsistencyMonitor(cfg, nil)
+	require.Nil(t, ocm)
+	require.True(t, errors.Is(err, ErrInvalidObserversConsistencyConfig))
+
+	ocm, err = newObserversConsistencyMonitor(createConsistencyConfig(), nil)
+	require.NoError(t, err)
+	require.NotNil(t, ocm)
+}
+
+func TestObserversConsistencyMonitor_CheckNodes(t *testing.T) {
+	t.Parallel()
+
+	createNodes := func() []*data.NodeData {
+		return []*data.NodeData{
+			{Address: "addr0", ShardId: 0, IsSynced: true},
+			{Address: "addr1", ShardId: 0, IsSynced: true},
+			{Address: "addr2", ShardId: 0, IsSynced: true},
+			{Address: "addr3", ShardId: 1, IsSynced: true},
+		}
+	}
+	hashes := map[string]string{
+		"addr0": "hash",
+		"addr1": "forked hash",
+		"addr2": "hash",
+		"addr3": "other shard hash",
+	}
+
+	t.Run("the node diverging from the quorum should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		requestedNonces := make(chan uint64, 10)
+		ocm, _ := newObserversConsistencyMonitor(createConsistencyConfig(), func(address string, nonce uint64) (string, error) {
+			requestedNonces <- nonce
+			return hashes[address], nil
+		})
+		currentTime := time.Unix(1000, 0)
+		ocm.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+		ocm.recordNodeNonce("addr0", 100)
+		ocm.recordNodeNonce("addr1", 98)
+		ocm.recordNodeNonce("addr2", 101)
+		ocm.recordNodeNonce("addr3", 50)
+
+		nodes := createNodes()
+		ocm.checkNodes(nodes)
+		require.True(t, nodes[0].IsSynced)
+		require.False(t, nodes[1].IsSynced)
+		require.True(t, nodes[2].IsSynced)
+		require.True(t, nodes[3].IsSynced)
+		require.Len(t, requestedNonces, 3)
+		for i := 0; i < 3; i++ {
+			require.Equal(t, uint64(93), <-requestedNonces)
+		}
+
+		evictedUntil, numEvictions := ocm.getEviction("addr1")
+		require.Equal(t, currentTime.Add(time.Minute), evictedUntil)
+		require.Equal(t, uint64(1), numEvictions)
+
+		// the evicted node is not cross-checked again while evicted, so a shard of 2 nodes is still checked
+		nodes = createNodes()
+		ocm.checkNodes(nodes)
+		require.False(t, nodes[1].IsSynced)
+		require.Len(t, requestedNonces, 2)
+		_, numEvictions = ocm.getEviction("addr1")
+		require.Equal(t, uint64(1), numEvictions)
+
+		currentTime = currentTime.Add(time.Minute)
+		hashes := map[string]string{"addr1": "hash"}
+		ocm.blockHashFetcher = func(address string, nonce uint64) (string, error) {
+			hash, found := hashes[address]
+			if !found {
+				return "hash", nil
+			}
+			return hash, nil
+		}
+		nodes = createNodes()
+		ocm.checkNodes(nodes)
+		require.True(t, nodes[1].IsSynced)
+		evictedUntil, numEvictions = ocm.getEviction("addr1")
+		require.True(t, evictedUntil.IsZero())
+		require.Equal(t, uint64(1), numEvictions)
+	})
+	t.Run("no quorum should not evict", func(t *testing.T) {
+		t.Parallel()
+
+		ocm, _ := newObserversConsistencyMonitor(createConsistencyConfig(), func(address string, nonce uint64) (string, error) {
+			if address == "addr2" {
+				return "", fmt.Errorf("observer unavailable")
+			}
+			return hashes[address], nil
+		})
+		for _, node := range createNodes() {
+			ocm.recordNodeNonce(node.Address, 100)
+		}
+
+		nodes := createNodes()
+		ocm.checkNodes(nodes)
+		for _, node := range nodes {
+			require.True(t, node.IsSynced)
+		}
+	})
+	t.Run("unknown nonces or nonces below the offset should not cross-check", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		ocm, _ := newObserversConsistencyMonitor(createConsistencyConfig(), func(address string, nonce uint64) (string, error) {
+			numCalls++
+			return hashes[address], nil
+		})
+		ocm.recordNodeNonce("addr0", 100)
+		ocm.recordNodeNonce("addr1", 100)
+
+		nodes := createNodes()
+		ocm.checkNodes(nodes)
+		require.Zero(t, numCalls)
+
+		ocm.recordNodeNonce("addr2", 5)
+		ocm.checkNodes(nodes)
+		require.Zero(t, numCalls)
+	})
+}
+
+func TestGetQuorumBlockHash(t *testing.T) {
+	t.Parallel()
+
+	hash, numVotes := getQuorumBlockHash(map[string]string{})
+	require.Empty(t, hash)
+	require.Zero(t, numVotes)
+
+	hash, numVotes = getQuorumBlockHash(map[string]string{"a": "h2", "b": "h1", "c": "h2"})
+	require.Equal(t, "h2", hash)
+	require.Equal(t, 2, numVotes)
+
+	hash, numVotes = getQuorumBlockHash(map[string]string{"a": "h2", "b": "h1"})
+	require.Equal(t, "h1", hash)
+	require.Equal(t, 1, numVotes)
+}
@@ -0,0 +1,471 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	feedOrigin = "http://localhost/"
+
+	// blocksWatcherBufferSize is the number of notifications a slow blocks watcher can fall behind before missing some
+	blocksWatcherBufferSize = 100
+)
+
+type blocksWatcher struct {
+	shardID           uint32
+	chanNotifications chan *data.BlockNotification
+}
+
+// ObserversFeedProcessor consumes the outport feed exposed by the WebSocket host driver of the designated observers. It
+// keeps the latest block of each shard and the hashes of the recently included transactions, so these can be served
+// without polling the observers' REST API
+type ObserversFeedProcessor struct {
+	observers          []*data.NodeData
+	marshalizer        marshal.Marshalizer
+	retryDuration      time.Duration
+	maxTrackedTxs      int
+	mutState           sync.RWMutex
+	latestBlocks       map[uint32]*data.FeedBlock
+	includedTxs        map[string]struct{}
+	includedTxsOrder   []string
+	blocksWatchers     map[*blocksWatcher]struct{}
+	cancelFunc         func()
+	getTimeHandler     func() time.Time
+	dialFeedConnection func(ctx context.Context, address string) (*websocket.Conn, error)
+}
+
+// NewObserversFeedProcessor will create a new instance of ObserversFeedProcessor
+func NewObserversFeedProcessor(marshalizer marshal.Marshalizer, cfg config.ObserversFeedConfig) (*ObserversFeedProcessor, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	err := checkObserversFeedConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObserversFeedProcessor{
+		observers:          cfg.Observers,
+		marshalizer:        marshalizer,
+		retryDuration:      time.Duration(cfg.RetryDurationInSec) * time.Second,
+		maxTrackedTxs:      cfg.MaxTrackedTransactions,
+		latestBlocks:       make(map[uint32]*data.FeedBlock),
+		includedTxs:        make(map[string]struct{}),
+		includedTxsOrder:   make([]string, 0),
+		blocksWatchers:     make(map[*blocksWatcher]struct{}),
+		getTimeHandler:     time.Now,
+		dialFeedConnection: dialFeedConnection,
+	}, nil
+}
+
+func checkObserversFeedConfig(cfg config.ObserversFeedConfig) error {
+	if cfg.RetryDurationInSec < 1 {
+		return fmt.Errorf("%w, RetryDurationInSec: %d", ErrInvalidObserversFeedConfig, cfg.RetryDurationInSec)
+	}
+	if cfg.MaxTrackedTransactions < 1 {
+		return fmt.Errorf("%w, MaxTrackedTransactions: %d", ErrInvalidObserversFeedConfig, cfg.MaxTrackedTransactions)
+	}
+	if len(cfg.Observers) == 0 {
+		return fmt.Errorf("%w, no observer provided", ErrInvalidObserversFeedConfig)
+	}
+	for _, observer := range cfg.Observers {
+		if observer == nil {
+			return fmt.Errorf("%w, nil observer", ErrInvalidObserversFeedConfig)
+		}
+
+		parsedURL, err := url.Parse(observer.Address)
+		if err != nil || (parsedURL.Scheme != "ws" && parsedURL.Scheme != "wss") || len(parsedURL.Host) == 0 {
+			return fmt.Errorf("%w, invalid observer address: %s", ErrInvalidObserversFeedConfig, observer.Address)
+		}
+	}
+
+	return nil
+}
+
+func dialFeedConnection(ctx context.Context, address string) (*websocket.Conn, error) {
+	wsConfig, err := websocket.NewConfig(address, feedOrigin)
+	if err != nil {
+		return nil, err
+	}
+
+	return wsConfig.DialContext(ctx)
+}
+
+// StartConsuming starts a go routine for each observer, which connects to its feed and reconnects after each failure
+func (ofp *ObserversFeedProcessor) StartConsuming() {
+	if ofp.cancelFunc != nil {
+		log.Error("ObserversFeedProcessor - consuming already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, ofp.cancelFunc = context.WithCancel(context.Background())
+
+	for _, observer := range ofp.observers {
+		go ofp.consumeFeed(ctx, observer)
+	}
+}
+
+func (ofp *ObserversFeedProcessor) consumeFeed(ctx context.Context, observer *data.NodeData) {
+	for {
+		err := ofp.consumeFeedConnection(ctx, observer)
+		if ctx.Err() != nil {
+			log.Debug("finishing ObserversFeedProcessor consuming...", "observer", observer.Address)
+			return
+		}
+
+		log.Warn("observers feed: connection lost, retrying",
+			"observer", observer.Address,
+			"retry after", ofp.retryDuration,
+			"error", err)
+
+		select {
+		case <-time.After(ofp.retryDuration):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (ofp *ObserversFeedProcessor) consumeFeedConnection(ctx context.Context, observer *data.NodeData) error {
+	conn, err := ofp.dialFeedConnection(ctx, observer.Address)
+	if err != nil {
+		return err
+	}
+
+	chanDone := make(chan struct{})
+	defer close(chanDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-chanDone:
+		}
+
+		_ = conn.Close()
+	}()
+
+	log.Info("observers feed: connected", "observer", observer.Address, "shard", observer.ShardId)
+
+	for {
+		var message []byte
+		err = websocket.Message.Receive(conn, &message)
+		if err != nil {
+			return err
+		}
+
+		ack := ofp.handleFeedMessage(observer.Address, message)
+		if ack == nil {
+			continue
+		}
+
+		err = websocket.Message.Send(conn, ack)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handleFeedMessage processes a message received from the feed and returns the acknowledge to be sent back, if the
+// observer requested one. The messages that cannot be processed are acknowledged as well, so they do not block the
+// host driver of the observer
+func (ofp *ObserversFeedProcessor) handleFeedMessage(observerAddress string, message []byte) []byte {
+	feedMessage := &data.FeedMessage{}
+	err := json.Unmarshal(message, feedMessage)
+	if err != nil {
+		log.Warn("observers feed: cannot unmarshal message", "observer", observerAddress, "error", err.Error())
+		return nil
+	}
+	if feedMessage.Type == data.FeedAckMessage {
+		return nil
+	}
+
+	err = ofp.handlePayload(feedMessage.Topic, feedMessage.Payload)
+	if err != nil {
+		log.Warn("observers feed: cannot handle payload",
+			"observer", observerAddress,
+			"topic", feedMessage.Topic,
+			"error", err.Error())
+	}
+
+	if !feedMessage.WithAcknowledge {
+		return nil
+	}
+
+	ack, err := json.Marshal(&data.FeedMessage{
+		Type:    data.FeedAckMessage,
+		Counter: feedMessage.Counter,
+		Topic:   feedMessage.Topic,
+		Version: feedMessage.Version,
+	})
+	if err != nil {
+		log.Warn("observers feed: cannot marshal acknowledge", "observer", observerAddress, "error", err.Error())
+		return nil
+	}
+
+	return ack
+}
+
+func (ofp *ObserversFeedProcessor) handlePayload(topic string, payload []byte) error {
+	switch topic {
+	case outport.TopicSaveBlock:
+		return ofp.handleSavedBlock(payload)
+	case outport.TopicRevertIndexedBlock:
+		return ofp.handleRevertedBlock(payload)
+	case outport.TopicFinalizedBlock:
+		return ofp.handleFinalizedBlock(payload)
+	default:
+		return nil
+	}
+}
+
+func (ofp *ObserversFeedProcessor) handleSavedBlock(payload []byte) error {
+	outportBlock := &outport.OutportBlock{}
+	err := json.Unmarshal(payload, outportBlock)
+	if err != nil {
+		return err
+	}
+	if outportBlock.BlockData == nil {
+		return ErrMissingFeedBlockData
+	}
+
+	header, err := ofp.unmarshalHeader(outportBlock.BlockData.HeaderType, outportBlock.BlockData.HeaderBytes)
+	if err != nil {
+		return err
+	}
+
+	feedBlock := &data.FeedBlock{
+		ShardID:    outportBlock.ShardID,
+		Nonce:      header.GetNonce(),
+		Round:      header.GetRound(),
+		Epoch:      header.GetEpoch(),
+		Hash:       hex.EncodeToString(outportBlock.BlockData.HeaderHash),
+		Timestamp:  header.GetTimeStamp(),
+		ReceivedAt: ofp.getTimeHandler().Unix(),
+	}
+	if outportBlock.TransactionPool != nil {
+		for txHash := range outportBlock.TransactionPool.Transactions {
+			feedBlock.TxsHashes = append(feedBlock.TxsHashes, txHash)
+		}
+		for txHash := range outportBlock.TransactionPool.InvalidTxs {
+			feedBlock.InvalidTxHashes = append(feedBlock.InvalidTxHashes, txHash)
+		}
+	}
+	feedBlock.NumTxs = len(feedBlock.TxsHashes) + len(feedBlock.InvalidTxHashes)
+
+	ofp.addBlock(feedBlock)
+
+	return nil
+}
+
+func (ofp *ObserversFeedProcessor) unmarshalHeader(headerType string, headerBytes []byte) (coreData.HeaderHandler, error) {
+	var header coreData.HeaderHandler
+	switch core.HeaderType(headerType) {
+	case core.MetaHeader:
+		header = &block.MetaBlock{}
+	case core.ShardHeaderV1:
+		header = &block.Header{}
+	case core.ShardHeaderV2:
+		header = &block.HeaderV2{}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFeedHeaderType, headerType)
+	}
+
+	err := ofp.marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// addBlock records the block as the latest one of its shard, unless a higher block was already received (the same
+// block is usually received from more observers of the shard), and tracks its transactions
+func (ofp *ObserversFeedProcessor) addBlock(feedBlock *data.FeedBlock) {
+	ofp.mutState.Lock()
+	defer ofp.mutState.Unlock()
+
+	latestBlock, found := ofp.latestBlocks[feedBlock.ShardID]
+	if found && latestBlock.Nonce > feedBlock.Nonce {
+		return
+	}
+	if found && latestBlock.Hash == feedBlock.Hash {
+		return
+	}
+
+	ofp.latestBlocks[feedBlock.ShardID] = feedBlock
+	ofp.trackTransactions(feedBlock.TxsHashes)
+	ofp.trackTransactions(feedBlock.InvalidTxHashes)
+	ofp.notifyBlocksWatchers(feedBlock)
+
+	log.Trace("observers feed: new block",
+		"shard", feedBlock.ShardID,
+		"nonce", feedBlock.Nonce,
+		"hash", feedBlock.Hash,
+		"num txs", feedBlock.NumTxs)
+}
+
+func (ofp *ObserversFeedProcessor) trackTransactions(txsHashes []string) {
+	for _, txHash := range txsHashes {
+		_, found := ofp.includedTxs[txHash]
+		if found {
+			continue
+		}
+
+		ofp.includedTxs[txHash] = struct{}{}
+		ofp.includedTxsOrder = append(ofp.includedTxsOrder, txHash)
+	}
+
+	numEvicted := len(ofp.includedTxsOrder) - ofp.maxTrackedTxs
+	if numEvicted <= 0 {
+		return
+	}
+
+	for _, txHash := range ofp.includedTxsOrder[:numEvicted] {
+		delete(ofp.includedTxs, txHash)
+	}
+	ofp.includedTxsOrder = append(make([]string, 0, ofp.maxTrackedTxs), ofp.includedTxsOrder[numEvicted:]...)
+}
+
+// notifyBlocksWatchers sends the notification of the new block to the watchers of its shard. The watchers not keeping
+// up miss the notification, instead of blocking the feed. Should be called under the mutex
+func (ofp *ObserversFeedProcessor) notifyBlocksWatchers(feedBlock *data.FeedBlock) {
+	for watcher := range ofp.blocksWatchers {
+		if watcher.shardID != feedBlock.ShardID {
+			continue
+		}
+
+		notification := &data.BlockNotification{
+			ShardID: feedBlock.ShardID,
+			Nonce:   feedBlock.Nonce,
+			Hash:    feedBlock.Hash,
+			Round:   feedBlock.Round,
+			TxCount: feedBlock.NumTxs,
+		}
+		select {
+		case watcher.chanNotifications <- notification:
+		default:
+			log.Debug("observers feed: slow blocks watcher, notification dropped",
+				"shard", feedBlock.ShardID,
+				"nonce", feedBlock.Nonce)
+		}
+	}
+}
+
+// WatchBlocks returns the channel on which the notifications of the new blocks of the provided shard are sent, as
+// received through the feed. The channel is closed once the provided context is done
+func (ofp *ObserversFeedProcessor) WatchBlocks(ctx context.Context, shardID uint32) <-chan *data.BlockNotification {
+	watcher := &blocksWatcher{
+		shardID:           shardID,
+		chanNotifications: make(chan *data.BlockNotification, blocksWatcherBufferSize),
+	}
+
+	ofp.mutState.Lock()
+	ofp.blocksWatchers[watcher] = struct{}{}
+	ofp.mutState.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		ofp.mutState.Lock()
+		delete(ofp.blocksWatchers, watcher)
+		close(watcher.chanNotifications)
+		ofp.mutState.Unlock()
+	}()
+
+	return watcher.chanNotifications
+}
+
+func (ofp *ObserversFeedProcessor) handleRevertedBlock(payload []byte) error {
+	blockData := &outport.BlockData{}
+	err := json.Unmarshal(payload, blockData)
+	if err != nil {
+		return err
+	}
+
+	ofp.mutState.Lock()
+	defer ofp.mutState.Unlock()
+
+	latestBlock, found := ofp.latestBlocks[blockData.ShardID]
+	if found && latestBlock.Hash == hex.EncodeToString(blockData.HeaderHash) {
+		delete(ofp.latestBlocks, blockData.ShardID)
+	}
+
+	return nil
+}
+
+func (ofp *ObserversFeedProcessor) handleFinalizedBlock(payload []byte) error {
+	finalizedBlock := &outport.FinalizedBlock{}
+	err := json.Unmarshal(payload, finalizedBlock)
+	if err != nil {
+		return err
+	}
+
+	ofp.mutState.Lock()
+	defer ofp.mutState.Unlock()
+
+	latestBlock, found := ofp.latestBlocks[finalizedBlock.ShardID]
+	if found && latestBlock.Hash == hex.EncodeToString(finalizedBlock.HeaderHash) {
+		latestBlock.IsFinalized = true
+	}
+
+	return nil
+}
+
+// GetLatestBlocks returns the latest block of each shard, as received through the feed, sorted by shard
+func (ofp *ObserversFeedProcessor) GetLatestBlocks() []*data.FeedBlock {
+	ofp.mutState.RLock()
+	defer ofp.mutState.RUnlock()
+
+	blocks := make([]*data.FeedBlock, 0, len(ofp.latestBlocks))
+	for _, latestBlock := range ofp.latestBlocks {
+		blockCopy := *latestBlock
+		blocks = append(blocks, &blockCopy)
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].ShardID < blocks[j].ShardID
+	})
+
+	return blocks
+}
+
+// IsTransactionIncluded returns true if the transaction was recently included in a block received through the feed
+func (ofp *ObserversFeedProcessor) IsTransactionIncluded(txHash string) bool {
+	ofp.mutState.RLock()
+	defer ofp.mutState.RUnlock()
+
+	_, found := ofp.includedTxs[txHash]
+
+	return found
+}
+
+// IsEnabled returns true
+func (ofp *ObserversFeedProcessor) IsEnabled() bool {
+	return true
+}
+
+// Close will stop consuming the feed and close the connections towards the observers
+func (ofp *ObserversFeedProcessor) Close() error {
+	if ofp.cancelFunc != nil {
+		ofp.cancelFunc()
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+var feedMarshalizer = &marshal.GogoProtoMarshalizer{}
+
+func createObserversFeedConfig(address string) config.ObserversFeedConfig {
+	return config.ObserversFeedConfig{
+		Enabled:                true,
+		RetryDurationInSec:     1,
+		MaxTrackedTransactions: 10,
+		Observers: []*data.NodeData{
+			{ShardId: 1, Address: address},
+		},
+	}
+}
+
+func createFeedMessage(t *testing.T, topic string, payload interface{}, counter uint64, withAcknowledge bool) []byte {
+	payloadBytes, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	message, err := json.Marshal(&data.FeedMessage{
+		WithAcknowledge: withAcknowledge,
+		Counter:         counter,
+		Type:            data.FeedPayloadMessage,
+		Payload:         payloadBytes,
+		Topic:           topic,
+		Version:         1,
+	})
+	require.NoError(t, err)
+
+	return message
+}
+
+func createSaveBlockMessage(t *testing.T, shardID uint32, nonce uint64, hash string, txsHashes ...string) []byte {
+	headerBytes, err := feedMarshalizer.Marshal(&block.HeaderV2{
+		Header: &block.Header{Nonce: nonce, Round: nonce + 1, Epoch: 2, TimeStamp: 1700000000, ShardID: shardID},
+	})
+	require.NoError(t, err)
+
+	transactions := make(map[string]*outport.TxInfo)
+	for _, txHash := range txsHashes {
+		transactions[txHash] = &outport.TxInfo{}
+	}
+
+	outportBlock := &outport.OutportBlock{
+		ShardID: shardID,
+		BlockData: &outport.BlockData{
+			ShardID:     shardID,
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV2),
+			HeaderHash:  []byte(hash),
+		},
+		TransactionPool: &outport.TransactionPool{
+			Transactions: transactions,
+			InvalidTxs:   map[string]*outport.TxInfo{"invalid": {}},
+		},
+	}
+
+	return createFeedMessage(t, outport.TopicSaveBlock, outportBlock, nonce, true)
+}
+
+func TestNewObserversFeedProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ofp, err := process.NewObserversFeedProcessor(nil, createObserversFeedConfig("ws://127.0.0.1:22111/save"))
+		require.Nil(t, ofp)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidConfig := func(modifier func(cfg *config.ObserversFeedConfig), expectedMessage string) {
+			cfg := createObserversFeedConfig("ws://127.0.0.1:22111/save")
+			modifier(&cfg)
+
+			ofp, err := process.NewObserversFeedProcessor(feedMarshalizer, cfg)
+			require.Nil(t, ofp)
+			require.True(t, errors.Is(err, process.ErrInvalidObserversFeedConfig))
+			require.True(t, strings.Contains(err.Error(), expectedMessage))
+		}
+
+		testInvalidConfig(func(cfg *config.ObserversFeedConfig) { cfg.RetryDurationInSec = 0 }, "RetryDurationInSec")
+		testInvalidConfig(func(cfg *config.ObserversFeedConfig) { cfg.MaxTrackedTransactions = 0 }, "MaxTrackedTransactions")
+		testInvalidConfig(func(cfg *config.ObserversFeedConfig) { cfg.Observers = nil }, "no observer provided")
+		testInvalidConfig(func(cfg *config.ObserversFeedConfig) { cfg.Observers[0] = nil }, "nil observer")
+		testInvalidConfig(func(cfg *config.ObserversFeedConfig) { cfg.Observers[0].Address = "http://127.0.0.1:8080" }, "invalid observer address")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ofp, err := process.NewObserversFeedProcessor(feedMarshalizer, createObserversFeedConfig("ws://127.0.0.1:22111/save"))
+		require.NoError(t, err)
+		require.True(t, ofp.IsEnabled())
+		require.Empty(t, ofp.GetLatestBlocks())
+		require.NoError(t, ofp.Close())
+	})
+}
+
+func TestObserversFeedProcessor_HandleFeedMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("saved block should be tracked and acknowledged", func(t *testing.T) {
+		t.Parallel()
+
+		ofp, _ := process.NewObserversFeedProcessor(feedMarshalizer, createObserversFeedConfig("ws://127.0.0.1:22111/save"))
+
+		ack := ofp.HandleFeedMessage(createSaveBlockMessage(t, 1, 10, "hash10", "tx1", "tx2"))
+		ackMessage := &data.FeedMessage{}
+		require.NoError(t, json.Unmarshal(ack, ackMessage))
+		require.Equal(t, data.FeedAckMessage, ackMessage.Type)
+		require.Equal(t, uint64(10), ackMessage.Counter)
+
+		latestBlocks := ofp.GetLatestBlocks()
+		require.Len(t, latestBlocks, 1)
+		require.Equal(t, uint32(1), latestBlocks[0].ShardID)
+		require.Equal(t, uint64(10), latestBlocks[0].Nonce)
+		require.Equal(t, uint64(11), latestBlocks[0].Round)
+		require.Equal(t, uint32(2), latestBlocks[0].Epoch)
+		require.Equal(t, hex.EncodeToString([]byte("hash10")), latestBlocks[0].Hash)
+		require.Equal(t, 3, latestBlocks[0].NumTxs)
+		require.False(t, latestBlocks[0].IsFinalized)
+
+		require.True(t, ofp.IsTransactionIncluded("tx1"))
+		require.True(t, ofp.IsTransactionIncluded("tx2"))
+		require.True(t, ofp.IsTransactionIncluded("invalid"))
+		require.False(t, ofp.IsTransactionIncluded("tx3"))
+	})
+	t.Run("older block should not replace the latest one", func(t *testing.T) {
+		t.Parallel()
+
+		ofp, _ := process.NewObserversFeedProcessor(feedMarshalizer, createObserversFeedConfig("ws://127.0.0.1:22111/save"))
+
+		_ = ofp.HandleFeedMessage(createSaveBlockMessage(t, 1, 10, "hash10"))
+		_ = ofp.HandleFeedMessage(createSaveBlockMessage(t, 1, 9, "hash9", "tx9"))
+
+		latestBlocks := ofp.GetLatestBlocks()
+		require.Equal(t, uint64(10), latestBlocks[0].Nonce)
+		require.False(t, ofp.IsTransactionIncluded("tx9"))
+	})
+	t.Run("finalized and reverted blocks", func(t *testing.T) {
+		t.Parallel()
+
+		ofp, _ := process.NewObserversFeedProcessor(feedMarshalizer, createObserversFeedConfig("ws://127.0.0.1:22111/save"))
+
+		_ = ofp.HandleFeedMessage(createSaveBlockMessage(t, 1, 10, "hash10"))
+		_ = ofp.HandleFeedMessage(createFeedMessage(t, outport.TopicFinalizedBlock, &outport.FinalizedBlock{ShardID: 1, HeaderHash: []byte("hash10")}, 11, false))
+		require.True(t, ofp.GetLatestBlocks()[0].IsFinalized)
+
+		_ = ofp.HandleFeedMessage(createSaveBlockMessage(t, 1, 11, "hash11"))
+		_ = ofp.HandleFeedMessage(createFeedMessage(t, outport.TopicRevertIndexedBlock, &outport.BlockData{ShardID: 1, HeaderHash: []byte("hash11")}, 12, false))
+		require.Empty(t, ofp.GetLatestBlocks())
+	})
+	t.Run("oldest transactions should be forgotten", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createObserversFeedConfig("ws://127.0.0.1:22111/save")
+		cfg.MaxTrackedTransactions = 3
+		ofp, _ := process.NewObserversFeedProcessor(feedMarshalizer, cfg)
+
+		_ = ofp.HandleFeedMessage(createSaveBlockMessage(t, 1, 10, "hash10", "tx1"))
+		_ = ofp.HandleFeedMessage(createSaveBlockMessage(t, 1, 11, "hash11", "tx2", "tx3"))
+
+		require.False(t, ofp.IsTransactionIncluded("tx1"))
+		require.True(t, ofp.IsTransactionIncluded("tx2"))
+		require.True(t, ofp.IsTransactionIncluded("tx3"))
+	})
+	t.Run("invalid payload should still be acknowledged", func(t *testing.T) {
+		t.Parallel()
+
+		ofp, _ := process.NewObserversFeedProcessor(feedMarshalizer, createObserversFeedConfig("ws://127.0.0.1:22111/save"))
+
+		ack := ofp.HandleFeedMessage(createFeedMessage(t, outport.TopicSaveBlock, "not a block", 5, true))
+		require.NotNil(t, ack)
+		require.Empty(t, ofp.GetLatestBlocks())
+
+		require.Nil(t, ofp.HandleFeedMessage([]byte("not a message")))
+	})
+}
+
+func TestObserversFeedProcessor_WatchBlocks(t *testing.T) {
+	t.Parallel()
+
+	ofp, _ := process.NewObserversFeedProcessor(feedMarshalizer, createObserversFeedConfig("ws://127.0.0.1:22111/save"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chanNotifications := ofp.WatchBlocks(ctx, 1)
+
+	_ = ofp.HandleFeedMessage(createSaveBlockMessage(t, 0, 20, "hash20"))
+	_ = ofp.HandleFeedMessage(createSaveBlockMessage(t, 1, 10, "hash10", "tx1"))
+
+	select {
+	case notification := <-chanNotifications:
+		require.Equal(t, &data.BlockNotification{
+			ShardID: 1,
+			Nonce:   10,
+			Hash:    hex.EncodeToString([]byte("hash10")),
+			Round:   11,
+			TxCount: 2,
+		}, notification)
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout waiting for the block notification")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-chanNotifications:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout waiting for the channel to be closed")
+	}
+}
+
+func TestObserversFeedProcessor_StartConsuming(t *testing.T) {
+	t.Parallel()
+
+	chanAcks := make(chan *data.FeedMessage, 1)
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		err := websocket.Message.Send(conn, createSaveBlockMessage(t, 1, 10, "hash10", "tx1"))
+		require.NoError(t, err)
+
+		var ack []byte
+		err = websocket.Message.Receive(conn, &ack)
+		require.NoError(t, err)
+
+		ackMessage := &data.FeedMessage{}
+		require.NoError(t, json.Unmarshal(ack, ackMessage))
+		chanAcks <- ackMessage
+
+		// keep the connection open until the processor closes it
+		_ = websocket.Message.Receive(conn, &ack)
+	}))
+	defer server.Close()
+
+	address := "ws" + strings.TrimPrefix(server.URL, "http")
+	ofp, _ := process.NewObserversFeedProcessor(feedMarshalizer, createObserversFeedConfig(address))
+	ofp.StartConsuming()
+	defer func() {
+		_ = ofp.Close()
+	}()
+
+	select {
+	case ackMessage := <-chanAcks:
+		require.Equal(t, data.FeedAckMessage, ackMessage.Type)
+		require.Equal(t, uint64(10), ackMessage.Counter)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for the acknowledge")
+	}
+
+	require.True(t, ofp.IsTransactionIncluded("tx1"))
+	require.Len(t, ofp.GetLatestBlocks(), 1)
+}
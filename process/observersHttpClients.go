@@ -0,0 +1,233 @@
+package process
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const (
+	defaultMaxIdleConnsPerHost   = 100
+	defaultIdleConnTimeout       = 90 * time.Second
+	defaultStreamingThreshold    = 1024 * 1024
+	dialTimeout                  = 30 * time.Second
+	dialKeepAlive                = 30 * time.Second
+	tlsHandshakeTimeout          = 10 * time.Second
+	expectContinueTimeout        = 1 * time.Second
+	minIdleConnTimeoutInSec      = 0
+	minMaxConnsOrIdleConnsValues = 0
+)
+
+// observerClientKey identifies the http client of an observer. The TLS and the authentication settings of the
+// observers configured by shard are chosen when the client is created, so the client is bound to the observers list it
+// was created for: the clients are dropped, and the generation is increased, whenever the observers change
+type observerClientKey struct {
+	address    string
+	generation uint64
+}
+
+// observersHttpClients holds a dedicated http client, with its own tuned transport, for each observer address.
+// This way, the connections towards an observer are kept alive and reused instead of opening a new connection
+// (and consuming a new ephemeral port) for each request
+type observersHttpClients struct {
+	mutClients     sync.RWMutex
+	clients        map[observerClientKey]*http.Client
+	generation     uint64
+	requestTimeout time.Duration
+	config         config.ObserversHttpClientConfig
+	tlsConfigs     *observersTLSConfigs
+	auth           *observersAuth
+	inFlight       *observersInFlightBudgets
+	faultInjection *FaultInjectionProcessor
+	responses      *observersResponsesTracker
+	// shardOfObserver is used for finding the TLS and the authentication settings of the observers configured by shard
+	shardOfObserver func(address string) (uint32, bool)
+}
+
+func newObserversHttpClients(requestTimeout time.Duration, cfg config.ObserversHttpClientConfig) (*observersHttpClients, error) {
+	err := checkObserversHttpClientConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.MaxIdleConnsPerHost == 0 {
+		cfg.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+	if cfg.StreamingThresholdInBytes == 0 {
+		cfg.StreamingThresholdInBytes = defaultStreamingThreshold
+	}
+
+	tlsConfigs, err := newObserversTLSConfigs(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := newObserversAuth(cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	inFlight, err := newObserversInFlightBudgets(cfg.MaxInFlightRequestsPerHost, cfg.InFlightBudgets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &observersHttpClients{
+		clients:        make(map[observerClientKey]*http.Client),
+		requestTimeout: requestTimeout,
+		config:         cfg,
+		tlsConfigs:     tlsConfigs,
+		auth:           auth,
+		inFlight:       inFlight,
+		responses:      newObserversResponsesTracker(),
+	}, nil
+}
+
+func checkObserversHttpClientConfig(cfg config.ObserversHttpClientConfig) error {
+	if cfg.MaxIdleConnsPerHost < minMaxConnsOrIdleConnsValues {
+		return fmt.Errorf("%w, MaxIdleConnsPerHost: %d", ErrInvalidObserversHttpClientConfig, cfg.MaxIdleConnsPerHost)
+	}
+	if cfg.MaxConnsPerHost < minMaxConnsOrIdleConnsValues {
+		return fmt.Errorf("%w, MaxConnsPerHost: %d", ErrInvalidObserversHttpClientConfig, cfg.MaxConnsPerHost)
+	}
+	if cfg.IdleConnTimeoutInSec < minIdleConnTimeoutInSec {
+		return fmt.Errorf("%w, IdleConnTimeoutInSec: %d", ErrInvalidObserversHttpClientConfig, cfg.IdleConnTimeoutInSec)
+	}
+	if cfg.StreamingThresholdInBytes < 0 {
+		return fmt.Errorf("%w, StreamingThresholdInBytes: %d", ErrInvalidObserversHttpClientConfig, cfg.StreamingThresholdInBytes)
+	}
+
+	return nil
+}
+
+// getClient returns the http client dedicated to the provided observer address, creating it if needed
+func (ohc *observersHttpClients) getClient(address string) *http.Client {
+	ohc.mutClients.RLock()
+	client, found := ohc.clients[observerClientKey{address: address, generation: ohc.generation}]
+	ohc.mutClients.RUnlock()
+	if found {
+		return client
+	}
+
+	ohc.mutClients.Lock()
+	defer ohc.mutClients.Unlock()
+
+	key := observerClientKey{
+		address:    address,
+		generation: ohc.generation,
+	}
+	client, found = ohc.clients[key]
+	if found {
+		return client
+	}
+
+	// the shard of the observer is resolved only once, as it requires scanning the nodes lists
+	shardOfObserver := ohc.resolveShardOfObserver(address)
+
+	var transport http.RoundTripper = ohc.createTransport(address, shardOfObserver)
+	transport = ohc.auth.wrapTransport(address, transport, shardOfObserver)
+	if ohc.faultInjection != nil {
+		transport = ohc.faultInjection.wrapTransport(transport)
+	}
+	// the responses are tracked after the fault injection, so the injected errors are seen as missing responses
+	transport = ohc.responses.wrapTransport(address, transport)
+	// the saturated observers reject the requests before reaching the tracker, as they are not missing responses
+	transport = ohc.inFlight.wrapTransport(address, transport, shardOfObserver)
+
+	client = &http.Client{
+		Transport: transport,
+		Timeout:   ohc.requestTimeout,
+	}
+	ohc.clients[key] = client
+
+	return client
+}
+
+func (ohc *observersHttpClients) resolveShardOfObserver(address string) func(address string) (uint32, bool) {
+	if ohc.shardOfObserver == nil {
+		return nil
+	}
+
+	shardID, isShardKnown := ohc.shardOfObserver(address)
+	return func(_ string) (uint32, bool) {
+		return shardID, isShardKnown
+	}
+}
+
+// onObserversChanged drops the created clients, as the shards of the observers they were created for might have
+// changed. The generation is increased as well, so a client created for the previous observers list by a concurrent
+// call is never returned afterwards
+func (ohc *observersHttpClients) onObserversChanged() {
+	ohc.mutClients.Lock()
+	defer ohc.mutClients.Unlock()
+
+	ohc.generation++
+	ohc.dropClients()
+}
+
+func (ohc *observersHttpClients) dropClients() {
+	for _, client := range ohc.clients {
+		client.CloseIdleConnections()
+	}
+	ohc.clients = make(map[observerClientKey]*http.Client)
+}
+
+func (ohc *observersHttpClients) createTransport(address string, shardOfObserver func(address string) (uint32, bool)) http.RoundTripper {
+	idleConnTimeout := defaultIdleConnTimeout
+	if ohc.config.IdleConnTimeoutInSec > 0 {
+		idleConnTimeout = time.Duration(ohc.config.IdleConnTimeoutInSec) * time.Second
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: dialKeepAlive,
+	}
+
+	// HTTP/2 will only be negotiated with the observers exposing their REST API over TLS
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     !ohc.config.DisableHTTP2,
+		MaxIdleConns:          ohc.config.MaxIdleConnsPerHost,
+		MaxIdleConnsPerHost:   ohc.config.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       ohc.config.MaxConnsPerHost,
+		IdleConnTimeout:       idleConnTimeout,
+		TLSHandshakeTimeout:   tlsHandshakeTimeout,
+		ExpectContinueTimeout: expectContinueTimeout,
+	}
+
+	tlsConfig := ohc.tlsConfigs.getTLSConfig(address, shardOfObserver)
+	if tlsConfig == nil {
+		return transport
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return &httpsOnlyTransport{
+		address:   address,
+		transport: transport,
+	}
+}
+
+// setFaultInjectionProcessor sets the component injecting faults in the observers calls. The already created clients
+// are dropped, so all the next calls will go through the fault injection transport
+func (ohc *observersHttpClients) setFaultInjectionProcessor(faultInjection *FaultInjectionProcessor) {
+	ohc.mutClients.Lock()
+	defer ohc.mutClients.Unlock()
+
+	ohc.dropClients()
+	ohc.faultInjection = faultInjection
+}
+
+// closeIdleConnections closes the idle connections of all the created clients
+func (ohc *observersHttpClients) closeIdleConnections() {
+	ohc.mutClients.RLock()
+	defer ohc.mutClients.RUnlock()
+
+	for _, client := range ohc.clients {
+		client.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,70 @@
+package process
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserversHttpClients_GetClient(t *testing.T) {
+	t.Parallel()
+
+	const address = "http://observer"
+	createHttpClients := func(shardID *uint32, numShardLookups *int) *observersHttpClients {
+		httpClients, err := newObserversHttpClients(time.Second, config.ObserversHttpClientConfig{})
+		require.Nil(t, err)
+		httpClients.shardOfObserver = func(_ string) (uint32, bool) {
+			*numShardLookups++
+			return *shardID, true
+		}
+
+		return httpClients
+	}
+
+	t.Run("same observer should reuse the client", func(t *testing.T) {
+		t.Parallel()
+
+		shardID := uint32(0)
+		numShardLookups := 0
+		httpClients := createHttpClients(&shardID, &numShardLookups)
+
+		client := httpClients.getClient(address)
+		require.True(t, client == httpClients.getClient(address))
+		require.False(t, client == httpClients.getClient("http://other-observer"))
+		require.Equal(t, 2, numShardLookups)
+	})
+	t.Run("cached client should not resolve the shard again", func(t *testing.T) {
+		t.Parallel()
+
+		shardID := uint32(0)
+		numShardLookups := 0
+		httpClients := createHttpClients(&shardID, &numShardLookups)
+
+		client := httpClients.getClient(address)
+		shardID = 1
+		for i := 0; i < 10; i++ {
+			require.True(t, client == httpClients.getClient(address))
+		}
+		require.Equal(t, 1, numShardLookups)
+	})
+	t.Run("observers change should drop the clients", func(t *testing.T) {
+		t.Parallel()
+
+		shardID := uint32(0)
+		numShardLookups := 0
+		httpClients := createHttpClients(&shardID, &numShardLookups)
+
+		client := httpClients.getClient(address)
+		shardID = 1
+		httpClients.onObserversChanged()
+		require.Empty(t, httpClients.clients)
+
+		newClient := httpClients.getClient(address)
+		require.False(t, client == newClient)
+		require.True(t, newClient == httpClients.getClient(address))
+		require.Len(t, httpClients.clients, 1)
+		require.Equal(t, 2, numShardLookups)
+	})
+}
@@ -0,0 +1,153 @@
+package process
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+type observerInFlightBudgetEntry struct {
+	addresses           map[string]struct{}
+	shardIDs            map[uint32]struct{}
+	maxInFlightRequests int
+}
+
+// observersInFlightBudgets holds the maximum number of requests in progress towards each observer, matched by address
+// or by shard, falling back to the default limit
+type observersInFlightBudgets struct {
+	defaultMaxInFlightRequests int
+	entries                    []*observerInFlightBudgetEntry
+}
+
+func newObserversInFlightBudgets(defaultMaxInFlightRequests int, cfgs []config.ObserverInFlightBudgetConfig) (*observersInFlightBudgets, error) {
+	if defaultMaxInFlightRequests < 0 {
+		return nil, fmt.Errorf("%w, MaxInFlightRequestsPerHost: %d", ErrInvalidObserversHttpClientConfig, defaultMaxInFlightRequests)
+	}
+
+	entries := make([]*observerInFlightBudgetEntry, 0, len(cfgs))
+	for idx, cfg := range cfgs {
+		if len(cfg.Addresses) == 0 && len(cfg.ShardIDs) == 0 {
+			return nil, fmt.Errorf("%w, in flight budget %d: no Addresses or ShardIDs provided", ErrInvalidObserversHttpClientConfig, idx)
+		}
+		if cfg.MaxInFlightRequests < 0 {
+			return nil, fmt.Errorf("%w, in flight budget %d: MaxInFlightRequests: %d", ErrInvalidObserversHttpClientConfig, idx, cfg.MaxInFlightRequests)
+		}
+
+		entry := &observerInFlightBudgetEntry{
+			addresses:           make(map[string]struct{}, len(cfg.Addresses)),
+			shardIDs:            make(map[uint32]struct{}, len(cfg.ShardIDs)),
+			maxInFlightRequests: cfg.MaxInFlightRequests,
+		}
+		for _, address := range cfg.Addresses {
+			entry.addresses[address] = struct{}{}
+		}
+		for _, shardID := range cfg.ShardIDs {
+			entry.shardIDs[shardID] = struct{}{}
+		}
+		entries = append(entries, entry)
+	}
+
+	return &observersInFlightBudgets{
+		defaultMaxInFlightRequests: defaultMaxInFlightRequests,
+		entries:                    entries,
+	}, nil
+}
+
+// getMaxInFlightRequests returns the maximum number of requests in progress towards the provided observer, 0 meaning
+// no limit. An entry matching the address takes precedence over one matching the shard of the observer
+func (oifb *observersInFlightBudgets) getMaxInFlightRequests(address string, shardOfObserver func(address string) (uint32, bool)) int {
+	for _, entry := range oifb.entries {
+		_, found := entry.addresses[address]
+		if found {
+			return entry.maxInFlightRequests
+		}
+	}
+
+	if shardOfObserver == nil {
+		return oifb.defaultMaxInFlightRequests
+	}
+	shardID, ok := shardOfObserver(address)
+	if !ok {
+		return oifb.defaultMaxInFlightRequests
+	}
+	for _, entry := range oifb.entries {
+		_, found := entry.shardIDs[shardID]
+		if found {
+			return entry.maxInFlightRequests
+		}
+	}
+
+	return oifb.defaultMaxInFlightRequests
+}
+
+// wrapTransport returns a transport limiting the requests in progress towards the observer, or the provided transport
+// if the observer has no limit
+func (oifb *observersInFlightBudgets) wrapTransport(
+	address string,
+	transport http.RoundTripper,
+	shardOfObserver func(address string) (uint32, bool),
+) http.RoundTripper {
+	maxInFlightRequests := oifb.getMaxInFlightRequests(address, shardOfObserver)
+	if maxInFlightRequests == 0 {
+		return transport
+	}
+
+	return &inFlightLimitingTransport{
+		address:   address,
+		transport: transport,
+		slots:     make(chan struct{}, maxInFlightRequests),
+	}
+}
+
+// inFlightLimitingTransport rejects the requests exceeding the in flight budget of an observer, instead of queueing
+// them, so the callers can move on to the next observer of the shard right away. A request stays in flight until its
+// response body is closed
+type inFlightLimitingTransport struct {
+	address   string
+	transport http.RoundTripper
+	slots     chan struct{}
+}
+
+// RoundTrip forwards the request to the wrapped transport if the observer still has room for it
+func (iflt *inFlightLimitingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case iflt.slots <- struct{}{}:
+	default:
+		return nil, fmt.Errorf("%w, observer %s, max in flight requests: %d", ErrObserverSaturated, iflt.address, cap(iflt.slots))
+	}
+
+	resp, err := iflt.transport.RoundTrip(req)
+	if err != nil {
+		iflt.release()
+		return nil, err
+	}
+
+	resp.Body = &inFlightResponseBody{
+		ReadCloser: resp.Body,
+		release:    iflt.release,
+	}
+
+	return resp, nil
+}
+
+func (iflt *inFlightLimitingTransport) release() {
+	<-iflt.slots
+}
+
+// inFlightResponseBody releases the in flight slot of the request once the response body is closed
+type inFlightResponseBody struct {
+	io.ReadCloser
+	releaseOnce sync.Once
+	release     func()
+}
+
+// Close closes the wrapped body and releases the in flight slot of the request
+func (ifrb *inFlightResponseBody) Close() error {
+	err := ifrb.ReadCloser.Close()
+	ifrb.releaseOnce.Do(ifrb.release)
+
+	return err
+}
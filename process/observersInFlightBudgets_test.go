@@ -0,0 +1,116 @@
+package process
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewObserversInFlightBudgets(t *testing.T) {
+	t.Parallel()
+
+	t.Run("negative default limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		budgets, err := newObserversInFlightBudgets(-1, nil)
+		require.Nil(t, budgets)
+		require.True(t, errors.Is(err, ErrInvalidObserversHttpClientConfig))
+	})
+
+	t.Run("entry without addresses or shards should error", func(t *testing.T) {
+		t.Parallel()
+
+		budgets, err := newObserversInFlightBudgets(0, []config.ObserverInFlightBudgetConfig{{MaxInFlightRequests: 5}})
+		require.Nil(t, budgets)
+		require.True(t, errors.Is(err, ErrInvalidObserversHttpClientConfig))
+	})
+
+	t.Run("negative entry limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		budgets, err := newObserversInFlightBudgets(0, []config.ObserverInFlightBudgetConfig{{ShardIDs: []uint32{0}, MaxInFlightRequests: -5}})
+		require.Nil(t, budgets)
+		require.True(t, errors.Is(err, ErrInvalidObserversHttpClientConfig))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		budgets, err := newObserversInFlightBudgets(10, []config.ObserverInFlightBudgetConfig{{ShardIDs: []uint32{0}, MaxInFlightRequests: 5}})
+		require.NoError(t, err)
+		require.NotNil(t, budgets)
+	})
+}
+
+func TestObserversInFlightBudgets_GetMaxInFlightRequests(t *testing.T) {
+	t.Parallel()
+
+	budgets, _ := newObserversInFlightBudgets(10, []config.ObserverInFlightBudgetConfig{
+		{ShardIDs: []uint32{1}, MaxInFlightRequests: 5},
+		{Addresses: []string{"observer-shard-1"}, MaxInFlightRequests: 0},
+	})
+	shardOfObserver := func(address string) (uint32, bool) {
+		switch address {
+		case "observer-shard-1", "other-observer-shard-1":
+			return 1, true
+		case "observer-shard-0":
+			return 0, true
+		default:
+			return 0, false
+		}
+	}
+
+	require.Equal(t, 0, budgets.getMaxInFlightRequests("observer-shard-1", shardOfObserver))
+	require.Equal(t, 5, budgets.getMaxInFlightRequests("other-observer-shard-1", shardOfObserver))
+	require.Equal(t, 10, budgets.getMaxInFlightRequests("observer-shard-0", shardOfObserver))
+	require.Equal(t, 10, budgets.getMaxInFlightRequests("unknown-observer", shardOfObserver))
+	require.Equal(t, 10, budgets.getMaxInFlightRequests("other-observer-shard-1", nil))
+}
+
+func TestObserversHttpClients_InFlightBudget(t *testing.T) {
+	t.Parallel()
+
+	chanSlowArrived := make(chan struct{})
+	chanRelease := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/slow" {
+			close(chanSlowArrived)
+			<-chanRelease
+		}
+		_, _ = rw.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	httpClients, err := newObserversHttpClients(time.Second*5, config.ObserversHttpClientConfig{MaxInFlightRequestsPerHost: 1})
+	require.NoError(t, err)
+	client := httpClients.getClient(server.URL)
+
+	chanSlowDone := make(chan error, 1)
+	go func() {
+		resp, errGet := client.Get(server.URL + "/slow")
+		if errGet == nil {
+			_ = resp.Body.Close()
+		}
+		chanSlowDone <- errGet
+	}()
+
+	// the slow request occupies the only slot of the observer
+	<-chanSlowArrived
+	_, err = client.Get(server.URL + "/fast")
+	require.True(t, errors.Is(err, ErrObserverSaturated))
+
+	close(chanRelease)
+	require.NoError(t, <-chanSlowDone)
+
+	resp, err := client.Get(server.URL + "/fast")
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "ok", string(body))
+}
@@ -0,0 +1,131 @@
+package process
+
+import (
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const maxLatencySamplesPerObserver = 100
+
+// observersResponsesTracker records the last moment each observer responded, regardless of the response status, along
+// with its most recent response times. A request which failed before getting a response (connection refused, timeout)
+// is not recorded
+type observersResponsesTracker struct {
+	mutResponses   sync.RWMutex
+	lastResponses  map[string]time.Time
+	latencies      map[string]*latencySamples
+	getTimeHandler func() time.Time
+}
+
+// latencySamples holds the most recent response times of an observer, as a ring buffer
+type latencySamples struct {
+	values    []time.Duration
+	nextIndex int
+}
+
+func newObserversResponsesTracker() *observersResponsesTracker {
+	return &observersResponsesTracker{
+		lastResponses:  make(map[string]time.Time),
+		latencies:      make(map[string]*latencySamples),
+		getTimeHandler: time.Now,
+	}
+}
+
+func (ort *observersResponsesTracker) recordResponse(address string, latency time.Duration) {
+	ort.mutResponses.Lock()
+	defer ort.mutResponses.Unlock()
+
+	ort.lastResponses[address] = ort.getTimeHandler()
+
+	samples, found := ort.latencies[address]
+	if !found {
+		samples = &latencySamples{
+			values: make([]time.Duration, 0, maxLatencySamplesPerObserver),
+		}
+		ort.latencies[address] = samples
+	}
+	if len(samples.values) < maxLatencySamplesPerObserver {
+		samples.values = append(samples.values, latency)
+		return
+	}
+
+	samples.values[samples.nextIndex] = latency
+	samples.nextIndex = (samples.nextIndex + 1) % maxLatencySamplesPerObserver
+}
+
+// getLatencyPercentile returns the provided percentile of the observer's recent response times, if any was recorded
+func (ort *observersResponsesTracker) getLatencyPercentile(address string, percentile float64) (time.Duration, bool) {
+	ort.mutResponses.RLock()
+	samples, found := ort.latencies[address]
+	if !found {
+		ort.mutResponses.RUnlock()
+		return 0, false
+	}
+	sortedValues := make([]time.Duration, len(samples.values))
+	copy(sortedValues, samples.values)
+	ort.mutResponses.RUnlock()
+
+	sort.Slice(sortedValues, func(i, j int) bool {
+		return sortedValues[i] < sortedValues[j]
+	})
+
+	index := int(math.Ceil(percentile/100*float64(len(sortedValues)))) - 1
+	if index < 0 {
+		index = 0
+	}
+
+	return sortedValues[index], true
+}
+
+// restoreLastResponseTime sets the moment the observer last responded, as found in a topology snapshot, unless a newer
+// response was already recorded
+func (ort *observersResponsesTracker) restoreLastResponseTime(address string, lastResponse time.Time) {
+	ort.mutResponses.Lock()
+	defer ort.mutResponses.Unlock()
+
+	recordedResponse, found := ort.lastResponses[address]
+	if found && recordedResponse.After(lastResponse) {
+		return
+	}
+
+	ort.lastResponses[address] = lastResponse
+}
+
+// getLastResponseTime returns the moment the observer last responded, if it ever did
+func (ort *observersResponsesTracker) getLastResponseTime(address string) (time.Time, bool) {
+	ort.mutResponses.RLock()
+	defer ort.mutResponses.RUnlock()
+
+	lastResponse, found := ort.lastResponses[address]
+
+	return lastResponse, found
+}
+
+func (ort *observersResponsesTracker) wrapTransport(address string, transport http.RoundTripper) http.RoundTripper {
+	return &responsesTrackingTransport{
+		address:   address,
+		transport: transport,
+		tracker:   ort,
+	}
+}
+
+type responsesTrackingTransport struct {
+	address   string
+	transport http.RoundTripper
+	tracker   *observersResponsesTracker
+}
+
+// RoundTrip executes the request and records the response of the observer, if any, along with the time it took until
+// the response headers were received
+func (rtt *responsesTrackingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := rtt.transport.RoundTrip(req)
+	if err == nil {
+		rtt.tracker.recordResponse(rtt.address, time.Since(start))
+	}
+
+	return resp, err
+}
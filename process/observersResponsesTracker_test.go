@@ -0,0 +1,53 @@
+package process
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperStub struct {
+	roundTripCalled func(req *http.Request) (*http.Response, error)
+}
+
+func (stub *roundTripperStub) RoundTrip(req *http.Request) (*http.Response, error) {
+	return stub.roundTripCalled(req)
+}
+
+func TestObserversResponsesTracker_WrapTransport(t *testing.T) {
+	t.Parallel()
+
+	responseTime := time.Unix(1700000000, 0)
+	tracker := newObserversResponsesTracker()
+	tracker.getTimeHandler = func() time.Time {
+		return responseTime
+	}
+
+	errRoundTrip := errors.New("connection refused")
+	failingTransport := tracker.wrapTransport("failing", &roundTripperStub{
+		roundTripCalled: func(req *http.Request) (*http.Response, error) {
+			return nil, errRoundTrip
+		},
+	})
+	respondingTransport := tracker.wrapTransport("responding", &roundTripperStub{
+		roundTripCalled: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusInternalServerError}, nil
+		},
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://observer/node/status", nil)
+	_, err := failingTransport.RoundTrip(req)
+	require.Equal(t, errRoundTrip, err)
+	_, found := tracker.getLastResponseTime("failing")
+	require.False(t, found)
+
+	resp, err := respondingTransport.RoundTrip(req)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	lastResponse, found := tracker.getLastResponseTime("responding")
+	require.True(t, found)
+	require.Equal(t, responseTime, lastResponse)
+}
@@ -0,0 +1,133 @@
+package process
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const httpsScheme = "https"
+
+type observerTLSEntry struct {
+	addresses map[string]struct{}
+	shardIDs  map[uint32]struct{}
+	tlsConfig *tls.Config
+}
+
+// observersTLSConfigs holds the TLS settings of the observers reached over HTTPS, matched by address or by shard
+type observersTLSConfigs struct {
+	entries []*observerTLSEntry
+}
+
+func newObserversTLSConfigs(cfgs []config.ObserverTLSConfig) (*observersTLSConfigs, error) {
+	entries := make([]*observerTLSEntry, 0, len(cfgs))
+	for idx, cfg := range cfgs {
+		entry, err := createObserverTLSEntry(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%w, entry %d: %s", ErrInvalidObserverTLSConfig, idx, err.Error())
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &observersTLSConfigs{
+		entries: entries,
+	}, nil
+}
+
+func createObserverTLSEntry(cfg config.ObserverTLSConfig) (*observerTLSEntry, error) {
+	if len(cfg.Addresses) == 0 && len(cfg.ShardIDs) == 0 {
+		return nil, fmt.Errorf("no Addresses or ShardIDs provided")
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+
+	if len(cfg.CACertificateFile) > 0 {
+		caBundle, err := os.ReadFile(cfg.CACertificateFile)
+		if err != nil {
+			return nil, err
+		}
+
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no PEM certificate found in %s", cfg.CACertificateFile)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	hasClientCertificate := len(cfg.ClientCertificateFile) > 0 || len(cfg.ClientKeyFile) > 0
+	if hasClientCertificate {
+		clientCertificate, err := tls.LoadX509KeyPair(cfg.ClientCertificateFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load the client certificate: %s", err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+	}
+
+	entry := &observerTLSEntry{
+		addresses: make(map[string]struct{}, len(cfg.Addresses)),
+		shardIDs:  make(map[uint32]struct{}, len(cfg.ShardIDs)),
+		tlsConfig: tlsConfig,
+	}
+	for _, address := range cfg.Addresses {
+		if !strings.HasPrefix(address, httpsScheme+"://") {
+			return nil, fmt.Errorf("observer %s is not an https:// address", address)
+		}
+		entry.addresses[address] = struct{}{}
+	}
+	for _, shardID := range cfg.ShardIDs {
+		entry.shardIDs[shardID] = struct{}{}
+	}
+
+	return entry, nil
+}
+
+// getTLSConfig returns the TLS settings of the provided observer, or nil if none applies. An entry matching the
+// address takes precedence over one matching the shard of the observer
+func (otc *observersTLSConfigs) getTLSConfig(address string, shardOfObserver func(address string) (uint32, bool)) *tls.Config {
+	for _, entry := range otc.entries {
+		_, found := entry.addresses[address]
+		if found {
+			return entry.tlsConfig.Clone()
+		}
+	}
+
+	if shardOfObserver == nil {
+		return nil
+	}
+	shardID, ok := shardOfObserver(address)
+	if !ok {
+		return nil
+	}
+	for _, entry := range otc.entries {
+		_, found := entry.shardIDs[shardID]
+		if found {
+			return entry.tlsConfig.Clone()
+		}
+	}
+
+	return nil
+}
+
+// httpsOnlyTransport rejects the calls that would reach an observer configured with TLS over plain HTTP
+type httpsOnlyTransport struct {
+	address   string
+	transport http.RoundTripper
+}
+
+// RoundTrip forwards the https requests to the wrapped transport
+func (hot *httpsOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != httpsScheme {
+		return nil, fmt.Errorf("%w, observer %s", ErrObserverTLSRequired, hot.address)
+	}
+
+	return hot.transport.RoundTrip(req)
+}
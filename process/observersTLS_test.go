@@ -0,0 +1,199 @@
+package process
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func writePEMFile(t *testing.T, fileName string, blockType string, bytes []byte) string {
+	filePath := filepath.Join(t.TempDir(), fileName)
+	err := os.WriteFile(filePath, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+// createClientCertificate generates a self-signed client certificate, returning it along with the paths of its PEM files
+func createClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "proxy"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	certificateFile := writePEMFile(t, "client.pem", "CERTIFICATE", certificateBytes)
+	keyFile := writePEMFile(t, "client-key.pem", "EC PRIVATE KEY", keyBytes)
+
+	return certificate, certificateFile, keyFile
+}
+
+// startMutualTLSObserver starts an observer requiring the provided client certificate, returning it along with the
+// path of the PEM file holding its own certificate
+func startMutualTLSObserver(t *testing.T, clientCertificate *x509.Certificate) (*httptest.Server, string) {
+	observer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCertificate)
+	observer.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	observer.StartTLS()
+	t.Cleanup(observer.Close)
+
+	return observer, writePEMFile(t, "observer-ca.pem", "CERTIFICATE", observer.Certificate().Raw)
+}
+
+func TestNewObserversTLSConfigs(t *testing.T) {
+	t.Parallel()
+
+	_, clientCertificateFile, clientKeyFile := createClientCertificate(t)
+	invalidCAFile := filepath.Join(t.TempDir(), "invalid-ca.pem")
+	require.Nil(t, os.WriteFile(invalidCAFile, []byte("not a certificate"), 0600))
+
+	testCases := map[string]config.ObserverTLSConfig{
+		"no addresses or shards":  {CACertificateFile: clientCertificateFile},
+		"plain http address":      {Addresses: []string{"http://observer:8080"}},
+		"missing CA file":         {ShardIDs: []uint32{0}, CACertificateFile: "missing.pem"},
+		"invalid CA file":         {ShardIDs: []uint32{0}, CACertificateFile: invalidCAFile},
+		"client key missing":      {ShardIDs: []uint32{0}, ClientCertificateFile: clientCertificateFile},
+		"client certificate file": {ShardIDs: []uint32{0}, ClientCertificateFile: clientKeyFile, ClientKeyFile: clientKeyFile},
+	}
+	for name, cfg := range testCases {
+		otc, err := newObserversTLSConfigs([]config.ObserverTLSConfig{cfg})
+		require.Nil(t, otc, name)
+		require.True(t, errors.Is(err, ErrInvalidObserverTLSConfig), name)
+	}
+
+	otc, err := newObserversTLSConfigs([]config.ObserverTLSConfig{
+		{
+			Addresses:             []string{"https://observer:8080"},
+			ShardIDs:              []uint32{0, 1},
+			CACertificateFile:     clientCertificateFile,
+			ClientCertificateFile: clientCertificateFile,
+			ClientKeyFile:         clientKeyFile,
+		},
+	})
+	require.Nil(t, err)
+	require.Len(t, otc.entries, 1)
+}
+
+func TestObserversTLSConfigs_GetTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	otc, _ := newObserversTLSConfigs([]config.ObserverTLSConfig{
+		{ShardIDs: []uint32{0}, ServerName: "shard-0"},
+		{Addresses: []string{"https://observer-a"}, ServerName: "observer-a"},
+	})
+	shardOfObserver := func(address string) (uint32, bool) {
+		if address == "https://unknown" {
+			return 0, false
+		}
+		return 0, true
+	}
+
+	require.Equal(t, "observer-a", otc.getTLSConfig("https://observer-a", shardOfObserver).ServerName)
+	require.Equal(t, "shard-0", otc.getTLSConfig("https://observer-b", shardOfObserver).ServerName)
+	require.Nil(t, otc.getTLSConfig("https://unknown", shardOfObserver))
+	require.Nil(t, otc.getTLSConfig("https://observer-b", nil))
+}
+
+func TestObserversHttpClients_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	clientCertificate, clientCertificateFile, clientKeyFile := createClientCertificate(t)
+	observer, observerCAFile := startMutualTLSObserver(t, clientCertificate)
+
+	doRequest := func(tlsConfigs []config.ObserverTLSConfig, address string) error {
+		httpClients, err := newObserversHttpClients(time.Second, config.ObserversHttpClientConfig{TLS: tlsConfigs})
+		require.Nil(t, err)
+		httpClients.shardOfObserver = func(address string) (uint32, bool) {
+			return 1, true
+		}
+
+		resp, err := httpClients.getClient(address).Get(address)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		return nil
+	}
+
+	t.Run("system CAs should not trust the observer", func(t *testing.T) {
+		t.Parallel()
+
+		err := doRequest(nil, observer.URL)
+		require.NotNil(t, err)
+	})
+	t.Run("missing client certificate should fail", func(t *testing.T) {
+		t.Parallel()
+
+		err := doRequest([]config.ObserverTLSConfig{{Addresses: []string{observer.URL}, CACertificateFile: observerCAFile}}, observer.URL)
+		require.NotNil(t, err)
+	})
+	t.Run("plain http call towards a TLS observer should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		plainAddress := strings.Replace(observer.URL, "https://", "http://", 1)
+		err := doRequest([]config.ObserverTLSConfig{{ShardIDs: []uint32{1}, CACertificateFile: observerCAFile}}, plainAddress)
+		require.True(t, errors.Is(err, ErrObserverTLSRequired))
+	})
+	t.Run("should work by address", func(t *testing.T) {
+		t.Parallel()
+
+		err := doRequest([]config.ObserverTLSConfig{
+			{
+				Addresses:             []string{observer.URL},
+				CACertificateFile:     observerCAFile,
+				ClientCertificateFile: clientCertificateFile,
+				ClientKeyFile:         clientKeyFile,
+			},
+		}, observer.URL)
+		require.Nil(t, err)
+	})
+	t.Run("should work by shard", func(t *testing.T) {
+		t.Parallel()
+
+		err := doRequest([]config.ObserverTLSConfig{
+			{
+				ShardIDs:              []uint32{1},
+				CACertificateFile:     observerCAFile,
+				ClientCertificateFile: clientCertificateFile,
+				ClientKeyFile:         clientKeyFile,
+			},
+		}, observer.URL)
+		require.Nil(t, err)
+	})
+}
@@ -29,13 +29,41 @@ func NewProofProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*P
 	}, nil
 }
 
+const (
+	proofResponseField     = "proof"
+	rootHashResponseField  = "rootHash"
+	verifiedResponseField  = "verified"
+	verifyProofResultField = "ok"
+)
+
 // GetProof sends the request to the right observer and then replies with the returned answer
 func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
-	observers, err := pp.getObserversForAddress(address)
+	response, _, err := pp.getProof(rootHash, address)
+	return response, err
+}
+
+// GetVerifiedProof fetches the Merkle proof for the given address and verifies it against the root hash before
+// replying. The verification is requested from another observer of the shard, whenever there is one
+func (pp *ProofProcessor) GetVerifiedProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+	response, observer, err := pp.getProof(rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pp.verifyProofResponse(rootHash, address, response, observer)
 	if err != nil {
 		return nil, err
 	}
 
+	return response, nil
+}
+
+func (pp *ProofProcessor) getProof(rootHash string, address string) (*data.GenericAPIResponse, *data.NodeData, error) {
+	observers, err := pp.getObserversForAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	responseGetProof := data.GenericAPIResponse{}
 	getProofEndpoint := "/proof/root-hash/" + rootHash + "/address/" + address
 	for _, observer := range observers {
@@ -43,7 +71,7 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
-			return nil, errors.New(responseGetProof.Error)
+			return nil, nil, errors.New(responseGetProof.Error)
 		}
 
 		if err != nil {
@@ -65,11 +93,11 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 				"http code", respCode,
 			)
 
-			return &responseGetProof, nil
+			return &responseGetProof, observer, nil
 		}
 	}
 
-	return nil, WrapObserversError(responseGetProof.Error)
+	return nil, nil, WrapObserversError(responseGetProof.Error)
 }
 
 // GetProofDataTrie sends the request to the right observer and then replies with the returned answer
@@ -117,11 +145,41 @@ func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key
 
 // GetProofCurrentRootHash sends the request to the right observer and then replies with the returned answer
 func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
-	observers, err := pp.getObserversForAddress(address)
+	response, _, err := pp.getProofCurrentRootHash(address)
+	return response, err
+}
+
+// GetVerifiedProofCurrentRootHash fetches the Merkle proof for the given address and verifies it against the returned
+// root hash before replying. The verification is requested from another observer of the shard, whenever there is one
+func (pp *ProofProcessor) GetVerifiedProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+	response, observer, err := pp.getProofCurrentRootHash(address)
 	if err != nil {
 		return nil, err
 	}
 
+	responseData, ok := response.Data.(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidProofResponse
+	}
+	rootHash, ok := responseData[rootHashResponseField].(string)
+	if !ok {
+		return nil, ErrInvalidProofResponse
+	}
+
+	err = pp.verifyProofResponse(rootHash, address, response, observer)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (pp *ProofProcessor) getProofCurrentRootHash(address string) (*data.GenericAPIResponse, *data.NodeData, error) {
+	observers, err := pp.getObserversForAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	responseGetProof := data.GenericAPIResponse{}
 	getProofEndpoint := "/proof/address/" + address
 	for _, observer := range observers {
@@ -129,7 +187,7 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
-			return nil, errors.New(responseGetProof.Error)
+			return nil, nil, errors.New(responseGetProof.Error)
 		}
 
 		if err != nil {
@@ -150,11 +208,11 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 				"http code", respCode,
 			)
 
-			return &responseGetProof, nil
+			return &responseGetProof, observer, nil
 		}
 	}
 
-	return nil, WrapObserversError(responseGetProof.Error)
+	return nil, nil, WrapObserversError(responseGetProof.Error)
 }
 
 // VerifyProof sends the request to the right observer and then replies with the returned answer
@@ -164,6 +222,10 @@ func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []s
 		return nil, err
 	}
 
+	return pp.verifyProof(rootHash, address, proof, observers)
+}
+
+func (pp *ProofProcessor) verifyProof(rootHash string, address string, proof []string, observers []*data.NodeData) (*data.GenericAPIResponse, error) {
 	verifyProofEndpoint := "/proof/verify"
 	requestParams := data.VerifyProofRequest{
 		RootHash: rootHash,
@@ -206,6 +268,81 @@ func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []s
 	return nil, WrapObserversError(responseVerifyProof.Error)
 }
 
+// verifyProofResponse checks the proof held by the response against the root hash and marks the response as verified
+func (pp *ProofProcessor) verifyProofResponse(
+	rootHash string,
+	address string,
+	response *data.GenericAPIResponse,
+	proofProvider *data.NodeData,
+) error {
+	responseData, ok := response.Data.(map[string]interface{})
+	if !ok {
+		return ErrInvalidProofResponse
+	}
+	proof, err := extractProof(responseData)
+	if err != nil {
+		return err
+	}
+
+	observers, err := pp.getObserversForAddress(address)
+	if err != nil {
+		return err
+	}
+
+	verifyResponse, err := pp.verifyProof(rootHash, address, proof, moveObserverLast(observers, proofProvider))
+	if err != nil {
+		return err
+	}
+	if !isProofVerified(verifyResponse) {
+		log.Warn("proof verification failed", "address", address, "rootHash", rootHash, "observer", proofProvider.Address)
+		return ErrProofVerificationFailed
+	}
+
+	responseData[verifiedResponseField] = true
+	return nil
+}
+
+func extractProof(responseData map[string]interface{}) ([]string, error) {
+	proofItems, ok := responseData[proofResponseField].([]interface{})
+	if !ok || len(proofItems) == 0 {
+		return nil, ErrInvalidProofResponse
+	}
+
+	proof := make([]string, 0, len(proofItems))
+	for _, item := range proofItems {
+		proofItem, isString := item.(string)
+		if !isString {
+			return nil, ErrInvalidProofResponse
+		}
+
+		proof = append(proof, proofItem)
+	}
+
+	return proof, nil
+}
+
+func isProofVerified(verifyResponse *data.GenericAPIResponse) bool {
+	responseData, ok := verifyResponse.Data.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	verified, ok := responseData[verifyProofResultField].(bool)
+	return ok && verified
+}
+
+// moveObserverLast returns the observers with the provided one at the end, so another observer is tried first
+func moveObserverLast(observers []*data.NodeData, observer *data.NodeData) []*data.NodeData {
+	reordered := make([]*data.NodeData, 0, len(observers))
+	for _, obs := range observers {
+		if obs.Address != observer.Address {
+			reordered = append(reordered, obs)
+		}
+	}
+
+	return append(reordered, observer)
+}
+
 func (pp *ProofProcessor) getObserversForAddress(address string) ([]*data.NodeData, error) {
 	addressBytes, err := pp.pubKeyConverter.Decode(address)
 	if err != nil {
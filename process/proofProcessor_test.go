@@ -243,3 +243,101 @@ func TestProofProcessor_GetProofCurrentRootHashSendingFailsOnFirstObserverShould
 	assert.Equal(t, returnedProof[0], proofs[0])
 	assert.Equal(t, returnedProof[1], proofs[1])
 }
+
+func createProofProcessorStubForVerification(verifiedByObserver map[string]bool, verifiedBy *[]string) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+			return 0, nil
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+			return []*data.NodeData{
+				{Address: "observer0", ShardId: 0},
+				{Address: "observer1", ShardId: 0},
+			}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			valRespond := value.(*data.GenericAPIResponse)
+			valRespond.Data = map[string]interface{}{
+				"proof":    []interface{}{"aa", "bb"},
+				"value":    "cc",
+				"rootHash": "dd",
+			}
+			return http.StatusOK, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+			*verifiedBy = append(*verifiedBy, address)
+			request := value.(data.VerifyProofRequest)
+			if request.RootHash != "dd" || len(request.Proof) != 2 {
+				return http.StatusBadRequest, fmt.Errorf("unexpected request")
+			}
+
+			valRespond := response.(*data.GenericAPIResponse)
+			valRespond.Data = map[string]interface{}{"ok": verifiedByObserver[address]}
+			return http.StatusOK, nil
+		},
+	}
+}
+
+func TestProofProcessor_GetVerifiedProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid proof should be marked as verified by another observer", func(t *testing.T) {
+		t.Parallel()
+
+		verifiedBy := make([]string, 0)
+		procStub := createProofProcessorStubForVerification(map[string]bool{"observer1": true}, &verifiedBy)
+		pp, _ := process.NewProofProcessor(procStub, &mock.PubKeyConverterMock{})
+
+		response, err := pp.GetVerifiedProof("dd", "deadbeef")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"observer1"}, verifiedBy)
+
+		responseData := response.Data.(map[string]interface{})
+		assert.Equal(t, true, responseData["verified"])
+		assert.Equal(t, "cc", responseData["value"])
+	})
+	t.Run("proof failing the verification should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifiedBy := make([]string, 0)
+		procStub := createProofProcessorStubForVerification(map[string]bool{}, &verifiedBy)
+		pp, _ := process.NewProofProcessor(procStub, &mock.PubKeyConverterMock{})
+
+		response, err := pp.GetVerifiedProof("dd", "deadbeef")
+		assert.Nil(t, response)
+		assert.Equal(t, process.ErrProofVerificationFailed, err)
+	})
+	t.Run("malformed proof response should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifiedBy := make([]string, 0)
+		procStub := createProofProcessorStubForVerification(map[string]bool{"observer1": true}, &verifiedBy)
+		procStub.CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			valRespond := value.(*data.GenericAPIResponse)
+			valRespond.Data = map[string]interface{}{"proof": "aabb"}
+			return http.StatusOK, nil
+		}
+		pp, _ := process.NewProofProcessor(procStub, &mock.PubKeyConverterMock{})
+
+		response, err := pp.GetVerifiedProof("dd", "deadbeef")
+		assert.Nil(t, response)
+		assert.Equal(t, process.ErrInvalidProofResponse, err)
+		assert.Empty(t, verifiedBy)
+	})
+}
+
+func TestProofProcessor_GetVerifiedProofCurrentRootHash(t *testing.T) {
+	t.Parallel()
+
+	verifiedBy := make([]string, 0)
+	procStub := createProofProcessorStubForVerification(map[string]bool{"observer1": true}, &verifiedBy)
+	pp, _ := process.NewProofProcessor(procStub, &mock.PubKeyConverterMock{})
+
+	response, err := pp.GetVerifiedProofCurrentRootHash("deadbeef")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"observer1"}, verifiedBy)
+
+	responseData := response.Data.(map[string]interface{})
+	assert.Equal(t, true, responseData["verified"])
+	assert.Equal(t, "dd", responseData["rootHash"])
+}
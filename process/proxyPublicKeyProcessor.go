@@ -0,0 +1,42 @@
+package process
+
+import (
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type proxyPublicKeyProcessor struct {
+	publicKey []byte
+}
+
+// NewProxyPublicKeyProcessor creates a new instance of proxy public key processor. An empty public key means that
+// the response signing is not enabled
+func NewProxyPublicKeyProcessor(publicKey []byte) *proxyPublicKeyProcessor {
+	return &proxyPublicKeyProcessor{
+		publicKey: publicKey,
+	}
+}
+
+// GetProxyPublicKey returns the public key that can be used for verifying the signatures of the proxy responses
+func (pkp *proxyPublicKeyProcessor) GetProxyPublicKey() (*data.GenericAPIResponse, error) {
+	if len(pkp.publicKey) == 0 {
+		return nil, ErrResponseSigningNotEnabled
+	}
+
+	return &data.GenericAPIResponse{
+		Data: data.ProxyPublicKey{
+			PublicKey:       hex.EncodeToString(pkp.publicKey),
+			SignatureScheme: common.ProxySignatureScheme,
+			SignatureHeader: common.ProxySignatureHeader,
+		},
+		Error: "",
+		Code:  data.ReturnCodeSuccess,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pkp *proxyPublicKeyProcessor) IsInterfaceNil() bool {
+	return pkp == nil
+}
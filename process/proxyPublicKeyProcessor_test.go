@@ -0,0 +1,40 @@
+package process_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyPublicKeyProcessor_GetProxyPublicKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("signing not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		pkp := process.NewProxyPublicKeyProcessor(nil)
+		require.False(t, pkp.IsInterfaceNil())
+
+		response, err := pkp.GetProxyPublicKey()
+		require.Nil(t, response)
+		require.Equal(t, process.ErrResponseSigningNotEnabled, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pkp := process.NewProxyPublicKeyProcessor([]byte{0xaa, 0xbb, 0xcc})
+
+		response, err := pkp.GetProxyPublicKey()
+		require.NoError(t, err)
+		require.Equal(t, data.ReturnCodeSuccess, response.Code)
+		require.Equal(t, data.ProxyPublicKey{
+			PublicKey:       "aabbcc",
+			SignatureScheme: common.ProxySignatureScheme,
+			SignatureHeader: common.ProxySignatureHeader,
+		}, response.Data)
+	})
+}
@@ -0,0 +1,112 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// hopByHopHeaders holds the headers that are meaningful only for a single connection, so they are not forwarded.
+// The Authorization header holds the credentials of the proxy caller and should never reach the observers
+var hopByHopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+	"Authorization",
+}
+
+// RawPassThroughProcessor forwards arbitrary requests to the observers of a shard, so the node endpoints that are not
+// yet supported by the proxy can still be reached
+type RawPassThroughProcessor struct {
+	proc Processor
+}
+
+// NewRawPassThroughProcessor creates a new instance of RawPassThroughProcessor
+func NewRawPassThroughProcessor(proc Processor) (*RawPassThroughProcessor, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+
+	return &RawPassThroughProcessor{
+		proc: proc,
+	}, nil
+}
+
+// ForwardRequest forwards the request to the first responsive synced observer of the provided shard and returns its
+// response, as it is. The forwarding stops once the provided context is done. The caller is responsible for closing
+// the body of the returned response
+func (rptp *RawPassThroughProcessor) ForwardRequest(ctx context.Context, shardID uint32, request *data.RawObserverRequest) (int, *http.Response, error) {
+	if !rptp.isKnownShard(shardID) {
+		return http.StatusBadRequest, nil, fmt.Errorf("%w: %d", ErrInvalidShardForRawRequest, shardID)
+	}
+
+	observers, err := rptp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	forwardedRequest := *request
+	forwardedRequest.Header = removeHopByHopHeaders(request.Header)
+	if !strings.HasPrefix(forwardedRequest.Path, "/") {
+		forwardedRequest.Path = "/" + forwardedRequest.Path
+	}
+
+	for _, observer := range observers {
+		respCode, resp, errCall := rptp.proc.CallRawRestEndPoint(ctx, observer.Address, &forwardedRequest)
+		if errCall == nil {
+			log.Info("raw request forwarded",
+				"observer", observer.Address,
+				"shard ID", shardID,
+				"method", forwardedRequest.Method,
+				"path", forwardedRequest.Path,
+				"status code", respCode)
+			return respCode, resp, nil
+		}
+
+		if ctx.Err() != nil {
+			return http.StatusGatewayTimeout, nil, errCall
+		}
+
+		// if observer was down (or didn't respond in time), skip to the next one
+		log.Warn("raw request forwarding failed", "observer", observer.Address, "error", errCall.Error())
+	}
+
+	return http.StatusBadGateway, nil, ErrSendingRequest
+}
+
+func (rptp *RawPassThroughProcessor) isKnownShard(shardID uint32) bool {
+	for _, knownShardID := range rptp.proc.GetShardIDs() {
+		if knownShardID == shardID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeHopByHopHeaders(header http.Header) http.Header {
+	result := header.Clone()
+	if result == nil {
+		return make(http.Header)
+	}
+
+	for _, connectionHeader := range result.Values("Connection") {
+		for _, name := range strings.Split(connectionHeader, ",") {
+			result.Del(strings.TrimSpace(name))
+		}
+	}
+	for _, name := range hopByHopHeaders {
+		result.Del(name)
+	}
+
+	return result
+}
@@ -0,0 +1,234 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRawPassThroughProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		rptp, err := process.NewRawPassThroughProcessor(nil)
+		assert.Nil(t, rptp)
+		assert.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rptp, err := process.NewRawPassThroughProcessor(&mock.ProcessorStub{})
+		assert.NotNil(t, rptp)
+		assert.Nil(t, err)
+	})
+}
+
+func TestRawPassThroughProcessor_ForwardRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		rptp, _ := process.NewRawPassThroughProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+
+		statusCode, resp, err := rptp.ForwardRequest(context.Background(), 2, &data.RawObserverRequest{Method: http.MethodGet, Path: "/node/status"})
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+		assert.Nil(t, resp)
+		assert.True(t, errors.Is(err, process.ErrInvalidShardForRawRequest))
+	})
+	t.Run("get observers error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("no observers")
+		rptp, _ := process.NewRawPassThroughProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return nil, expectedErr
+			},
+		})
+
+		statusCode, _, err := rptp.ForwardRequest(context.Background(), 0, &data.RawObserverRequest{Method: http.MethodGet, Path: "/node/status"})
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("all observers down should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		rptp, _ := process.NewRawPassThroughProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "address1"}, {Address: "address2"}}, nil
+			},
+			CallRawRestEndPointCalled: func(ctx context.Context, address string, request *data.RawObserverRequest) (int, *http.Response, error) {
+				numCalls++
+				return http.StatusNotFound, nil, errors.New("connection refused")
+			},
+		})
+
+		statusCode, _, err := rptp.ForwardRequest(context.Background(), 0, &data.RawObserverRequest{Method: http.MethodGet, Path: "/node/status"})
+		assert.Equal(t, http.StatusBadGateway, statusCode)
+		assert.Equal(t, process.ErrSendingRequest, err)
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("should skip the unavailable observers and strip the hop-by-hop headers", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponse := &http.Response{StatusCode: http.StatusInternalServerError, Body: http.NoBody}
+		request := &data.RawObserverRequest{
+			Method: http.MethodGet,
+			Path:   "node/status",
+			Header: http.Header{
+				"Authorization": []string{"Basic secret"},
+				"Connection":    []string{"X-Hop"},
+				"X-Hop":         []string{"hop"},
+				"X-Kept":        []string{"kept"},
+			},
+		}
+		rptp, _ := process.NewRawPassThroughProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				assert.Equal(t, data.AvailabilityRecent, dataAvailability)
+				return []*data.NodeData{{Address: "address1"}, {Address: "address2"}}, nil
+			},
+			CallRawRestEndPointCalled: func(ctx context.Context, address string, forwardedRequest *data.RawObserverRequest) (int, *http.Response, error) {
+				if address == "address1" {
+					return http.StatusRequestTimeout, nil, errors.New("timeout")
+				}
+
+				assert.Equal(t, "/node/status", forwardedRequest.Path)
+				assert.Equal(t, http.Header{"X-Kept": []string{"kept"}}, forwardedRequest.Header)
+				return http.StatusInternalServerError, expectedResponse, nil
+			},
+		})
+
+		statusCode, resp, err := rptp.ForwardRequest(context.Background(), 0, request)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+		assert.Equal(t, expectedResponse, resp)
+		assert.Equal(t, "Basic secret", request.Header.Get("Authorization"))
+	})
+}
+
+func TestBaseProcessor_CallRawRestEndPoint(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/new/endpoint", req.URL.Path)
+		assert.Equal(t, "a=b", req.URL.RawQuery)
+		assert.Equal(t, "value", req.Header.Get("X-Custom"))
+		assert.Equal(t, "request body", string(body))
+
+		rw.WriteHeader(http.StatusTeapot)
+		_, _ = rw.Write([]byte("response body"))
+	}))
+	defer server.Close()
+
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
+
+	statusCode, resp, err := bp.CallRawRestEndPoint(context.Background(), server.URL, &data.RawObserverRequest{
+		Method:   http.MethodPost,
+		Path:     "/new/endpoint",
+		RawQuery: "a=b",
+		Header:   http.Header{"X-Custom": []string{"value"}},
+		Body:     []byte("request body"),
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusTeapot, statusCode)
+	assert.Equal(t, "response body", strings.TrimSpace(string(body)))
+}
+
+func TestRawPassThroughProcessor_ForwardRequestContextDoneShouldNotTryOtherObservers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	numCalls := 0
+	rptp, _ := process.NewRawPassThroughProcessor(&mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0}
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "address1"}, {Address: "address2"}}, nil
+		},
+		CallRawRestEndPointCalled: func(ctxCall context.Context, address string, request *data.RawObserverRequest) (int, *http.Response, error) {
+			numCalls++
+			<-ctxCall.Done()
+			return http.StatusRequestTimeout, nil, ctxCall.Err()
+		},
+	})
+
+	statusCode, _, err := rptp.ForwardRequest(ctx, 0, &data.RawObserverRequest{Method: http.MethodGet, Path: "/node/status"})
+	assert.Equal(t, http.StatusGatewayTimeout, statusCode)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestBaseProcessor_CallGetRestEndPointWithContextDoneShouldReturnContextError(t *testing.T) {
+	t.Parallel()
+
+	unblockServer := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		<-unblockServer
+	}))
+	defer server.Close()
+	defer close(unblockServer)
+
+	bp, _ := process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec:        5,
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	startTime := time.Now()
+	statusCode, err := bp.CallGetRestEndPointWithContext(ctx, server.URL, "/node/status", &data.GenericAPIResponse{})
+	assert.Equal(t, http.StatusRequestTimeout, statusCode)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Less(t, time.Since(startTime), time.Second)
+}
@@ -0,0 +1,121 @@
+package process
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ReadinessProcessor computes whether the proxy is ready to serve requests: the warm-up has to be finished and each
+// configured shard needs at least one healthy observer, meaning a synced observer that responded recently
+type ReadinessProcessor struct {
+	warmUpHandler      WarmUpStatusHandler
+	observersHealth    ObserversHealthHandler
+	maxObserverSilence time.Duration
+	getTimeHandler     func() time.Time
+	mutObserversHealth sync.RWMutex
+}
+
+// NewReadinessProcessor creates a new instance of ReadinessProcessor
+func NewReadinessProcessor(warmUpHandler WarmUpStatusHandler, readinessConfig config.ReadinessConfig) (*ReadinessProcessor, error) {
+	if check.IfNil(warmUpHandler) {
+		return nil, ErrNilWarmUpStatusHandler
+	}
+	if readinessConfig.MaxObserverSilenceInSec < 0 {
+		return nil, ErrInvalidMaxObserverSilence
+	}
+
+	return &ReadinessProcessor{
+		warmUpHandler:      warmUpHandler,
+		maxObserverSilence: time.Duration(readinessConfig.MaxObserverSilenceInSec) * time.Second,
+		getTimeHandler:     time.Now,
+	}, nil
+}
+
+// SetObserversHealthHandler sets the component exposing the health of the observers. Until set, the proxy is not ready
+func (rp *ReadinessProcessor) SetObserversHealthHandler(observersHealth ObserversHealthHandler) error {
+	if check.IfNil(observersHealth) {
+		return ErrNilObserversHealthHandler
+	}
+
+	rp.mutObserversHealth.Lock()
+	rp.observersHealth = observersHealth
+	rp.mutObserversHealth.Unlock()
+
+	return nil
+}
+
+// GetReadinessStatus returns the readiness status of the proxy, along with the details of each shard
+func (rp *ReadinessProcessor) GetReadinessStatus() *data.ReadinessStatus {
+	status := &data.ReadinessStatus{
+		WarmUp: rp.warmUpHandler.GetWarmUpStatus(),
+		Shards: make([]*data.ShardReadiness, 0),
+	}
+
+	rp.mutObserversHealth.RLock()
+	observersHealth := rp.observersHealth
+	rp.mutObserversHealth.RUnlock()
+	if check.IfNil(observersHealth) {
+		return status
+	}
+
+	shards := make(map[uint32]*data.ShardReadiness)
+	for _, shardID := range observersHealth.GetShardIDs() {
+		shards[shardID] = &data.ShardReadiness{
+			ShardID:   shardID,
+			Observers: make([]*data.ObserverHealth, 0),
+		}
+	}
+	for _, observerHealth := range observersHealth.GetObserversHealth() {
+		shard, found := shards[observerHealth.ShardID]
+		if !found {
+			continue
+		}
+
+		observerHealth.IsHealthy = rp.isObserverHealthy(observerHealth)
+		shard.NumObservers++
+		if observerHealth.IsHealthy {
+			shard.NumHealthyObservers++
+		}
+		shard.Observers = append(shard.Observers, observerHealth)
+	}
+
+	areAllShardsReady := len(shards) > 0
+	for _, shard := range shards {
+		shard.IsReady = shard.NumHealthyObservers > 0
+		areAllShardsReady = areAllShardsReady && shard.IsReady
+		status.Shards = append(status.Shards, shard)
+	}
+	sort.Slice(status.Shards, func(i, j int) bool {
+		return status.Shards[i].ShardID < status.Shards[j].ShardID
+	})
+
+	status.IsReady = areAllShardsReady && rp.warmUpHandler.IsReady()
+
+	return status
+}
+
+func (rp *ReadinessProcessor) isObserverHealthy(observerHealth *data.ObserverHealth) bool {
+	if !observerHealth.IsSynced {
+		return false
+	}
+	if rp.maxObserverSilence == 0 {
+		return true
+	}
+	if observerHealth.LastResponseTimestamp == 0 {
+		return false
+	}
+
+	silence := rp.getTimeHandler().Sub(time.Unix(observerHealth.LastResponseTimestamp, 0))
+
+	return silence <= rp.maxObserverSilence
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rp *ReadinessProcessor) IsInterfaceNil() bool {
+	return rp == nil
+}
@@ -0,0 +1,140 @@
+package process_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadinessProcessor(t *testing.T) {
+	t.Parallel()
+
+	rp, err := process.NewReadinessProcessor(nil, config.ReadinessConfig{})
+	require.Nil(t, rp)
+	require.Equal(t, process.ErrNilWarmUpStatusHandler, err)
+
+	rp, err = process.NewReadinessProcessor(&mock.WarmUpStatusHandlerStub{}, config.ReadinessConfig{MaxObserverSilenceInSec: -1})
+	require.Nil(t, rp)
+	require.Equal(t, process.ErrInvalidMaxObserverSilence, err)
+
+	rp, err = process.NewReadinessProcessor(&mock.WarmUpStatusHandlerStub{}, config.ReadinessConfig{MaxObserverSilenceInSec: 10})
+	require.Nil(t, err)
+	require.False(t, rp.IsInterfaceNil())
+	require.Equal(t, process.ErrNilObserversHealthHandler, rp.SetObserversHealthHandler(nil))
+}
+
+func TestReadinessProcessor_GetReadinessStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Unix()
+	createObserversHealthHandler := func(observers []*data.ObserverHealth) *mock.ObserversHealthHandlerStub {
+		return &mock.ObserversHealthHandlerStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, core.MetachainShardId}
+			},
+			GetObserversHealthCalled: func() []*data.ObserverHealth {
+				return observers
+			},
+		}
+	}
+
+	t.Run("observers health handler not set should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := process.NewReadinessProcessor(&mock.WarmUpStatusHandlerStub{}, config.ReadinessConfig{})
+		status := rp.GetReadinessStatus()
+		require.False(t, status.IsReady)
+		require.Empty(t, status.Shards)
+	})
+	t.Run("all shards with healthy observers should be ready", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := process.NewReadinessProcessor(&mock.WarmUpStatusHandlerStub{}, config.ReadinessConfig{MaxObserverSilenceInSec: 60})
+		_ = rp.SetObserversHealthHandler(createObserversHealthHandler([]*data.ObserverHealth{
+			{Address: "meta", ShardID: core.MetachainShardId, IsSynced: true, LastResponseTimestamp: now},
+			{Address: "shard0-a", ShardID: 0, IsSynced: true, LastResponseTimestamp: now - 10},
+			{Address: "shard0-b", ShardID: 0, IsSynced: false, LastResponseTimestamp: now},
+		}))
+
+		status := rp.GetReadinessStatus()
+		require.True(t, status.IsReady)
+		require.Len(t, status.Shards, 2)
+		require.Equal(t, uint32(0), status.Shards[0].ShardID)
+		require.True(t, status.Shards[0].IsReady)
+		require.Equal(t, 2, status.Shards[0].NumObservers)
+		require.Equal(t, 1, status.Shards[0].NumHealthyObservers)
+		require.True(t, status.Shards[0].Observers[0].IsHealthy)
+		require.False(t, status.Shards[0].Observers[1].IsHealthy)
+		require.Equal(t, core.MetachainShardId, status.Shards[1].ShardID)
+		require.True(t, status.Shards[1].IsReady)
+	})
+	t.Run("shard without recent responses should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := process.NewReadinessProcessor(&mock.WarmUpStatusHandlerStub{}, config.ReadinessConfig{MaxObserverSilenceInSec: 60})
+		_ = rp.SetObserversHealthHandler(createObserversHealthHandler([]*data.ObserverHealth{
+			{Address: "meta", ShardID: core.MetachainShardId, IsSynced: true, LastResponseTimestamp: now},
+			{Address: "shard0-a", ShardID: 0, IsSynced: true, LastResponseTimestamp: now - 120},
+			{Address: "shard0-b", ShardID: 0, IsSynced: true},
+		}))
+
+		status := rp.GetReadinessStatus()
+		require.False(t, status.IsReady)
+		require.False(t, status.Shards[0].IsReady)
+		require.Zero(t, status.Shards[0].NumHealthyObservers)
+		require.True(t, status.Shards[1].IsReady)
+	})
+	t.Run("shard without observers should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := process.NewReadinessProcessor(&mock.WarmUpStatusHandlerStub{}, config.ReadinessConfig{})
+		_ = rp.SetObserversHealthHandler(createObserversHealthHandler([]*data.ObserverHealth{
+			{Address: "meta", ShardID: core.MetachainShardId, IsSynced: true},
+		}))
+
+		status := rp.GetReadinessStatus()
+		require.False(t, status.IsReady)
+		require.False(t, status.Shards[0].IsReady)
+		require.Zero(t, status.Shards[0].NumObservers)
+		require.True(t, status.Shards[1].IsReady)
+	})
+	t.Run("zero max silence should only check the sync state", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := process.NewReadinessProcessor(&mock.WarmUpStatusHandlerStub{}, config.ReadinessConfig{})
+		_ = rp.SetObserversHealthHandler(createObserversHealthHandler([]*data.ObserverHealth{
+			{Address: "meta", ShardID: core.MetachainShardId, IsSynced: true},
+			{Address: "shard0", ShardID: 0, IsSynced: true},
+		}))
+
+		require.True(t, rp.GetReadinessStatus().IsReady)
+	})
+	t.Run("warm-up in progress should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		warmUpStatus := &data.WarmUpStatus{Tasks: []*data.WarmUpTaskStatus{{Name: "network config"}}}
+		rp, _ := process.NewReadinessProcessor(&mock.WarmUpStatusHandlerStub{
+			IsReadyCalled: func() bool {
+				return false
+			},
+			GetWarmUpStatusCalled: func() *data.WarmUpStatus {
+				return warmUpStatus
+			},
+		}, config.ReadinessConfig{})
+		_ = rp.SetObserversHealthHandler(createObserversHealthHandler([]*data.ObserverHealth{
+			{Address: "meta", ShardID: core.MetachainShardId, IsSynced: true},
+			{Address: "shard0", ShardID: 0, IsSynced: true},
+		}))
+
+		status := rp.GetReadinessStatus()
+		require.False(t, status.IsReady)
+		require.Equal(t, warmUpStatus, status.WarmUp)
+		require.True(t, status.Shards[0].IsReady)
+	})
+}
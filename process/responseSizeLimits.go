@@ -0,0 +1,284 @@
+package process
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type responseSizeLimitClass struct {
+	name           string
+	paths          []string
+	maxSizeInBytes int
+	truncate       bool
+}
+
+// responseSizeLimits holds the maximum sizes of the observers responses, per class of observer endpoints
+type responseSizeLimits struct {
+	classes []*responseSizeLimitClass
+}
+
+func newResponseSizeLimits(cfg config.ResponseSizeLimitsConfig) (*responseSizeLimits, error) {
+	classes := make([]*responseSizeLimitClass, 0, len(cfg.Classes))
+	for idx, classCfg := range cfg.Classes {
+		if len(classCfg.Name) == 0 {
+			return nil, fmt.Errorf("%w, class %d: empty Name", ErrInvalidResponseSizeLimitsConfig, idx)
+		}
+		if len(classCfg.Paths) == 0 {
+			return nil, fmt.Errorf("%w, class %s: no Paths provided", ErrInvalidResponseSizeLimitsConfig, classCfg.Name)
+		}
+		for _, path := range classCfg.Paths {
+			if !strings.HasPrefix(path, "/") {
+				return nil, fmt.Errorf("%w, class %s: path %s does not start with /", ErrInvalidResponseSizeLimitsConfig, classCfg.Name, path)
+			}
+		}
+		if classCfg.MaxSizeInBytes <= 0 {
+			return nil, fmt.Errorf("%w, class %s: MaxSizeInBytes: %d", ErrInvalidResponseSizeLimitsConfig, classCfg.Name, classCfg.MaxSizeInBytes)
+		}
+
+		classes = append(classes, &responseSizeLimitClass{
+			name:           classCfg.Name,
+			paths:          classCfg.Paths,
+			maxSizeInBytes: classCfg.MaxSizeInBytes,
+			truncate:       classCfg.Truncate,
+		})
+	}
+
+	return &responseSizeLimits{
+		classes: classes,
+	}, nil
+}
+
+// getClass returns the class with the longest path matching the provided observer path, or nil if none matches
+func (rsl *responseSizeLimits) getClass(path string) *responseSizeLimitClass {
+	path, _, _ = strings.Cut(path, "?")
+
+	var matchedClass *responseSizeLimitClass
+	matchedPathLen := 0
+	for _, class := range rsl.classes {
+		for _, classPath := range class.paths {
+			if strings.HasPrefix(path, classPath) && len(classPath) > matchedPathLen {
+				matchedClass = class
+				matchedPathLen = len(classPath)
+			}
+		}
+	}
+
+	return matchedClass
+}
+
+// sizeLimitedReader fails the reads once more than the maximum size of the class has been read
+type sizeLimitedReader struct {
+	reader   io.Reader
+	class    *responseSizeLimitClass
+	numBytes int
+}
+
+func newSizeLimitedReader(reader io.Reader, class *responseSizeLimitClass) *sizeLimitedReader {
+	return &sizeLimitedReader{
+		reader: io.LimitReader(reader, int64(class.maxSizeInBytes)+1),
+		class:  class,
+	}
+}
+
+// Read reads from the wrapped reader, failing if the maximum size has been exceeded
+func (slr *sizeLimitedReader) Read(p []byte) (int, error) {
+	n, err := slr.reader.Read(p)
+	slr.numBytes += n
+	if slr.numBytes > slr.class.maxSizeInBytes {
+		return n, newResponseTooLargeError(slr.class)
+	}
+
+	return n, err
+}
+
+func newResponseTooLargeError(class *responseSizeLimitClass) error {
+	return fmt.Errorf("%w, class %s, max size in bytes: %d", ErrObserverResponseTooLarge, class.name, class.maxSizeInBytes)
+}
+
+type jsonContainer struct {
+	isArray   bool
+	numValues int
+	expectKey bool
+}
+
+// jsonItemsTruncator copies a JSON document, skipping the first items and dropping the items that would exceed the
+// maximum size. The items are the elements of the top level lists, meaning the lists not nested in other lists (such
+// as the transactions lists of a transactions pool response). Each item is either fully kept or fully dropped and the
+// document is read only until the maximum size is reached, so at most one item above the maximum size is held in memory
+type jsonItemsTruncator struct {
+	decoder      *json.Decoder
+	output       bytes.Buffer
+	containers   []*jsonContainer
+	numArrays    int
+	maxSize      int
+	numToSkip    uint64
+	truncation   proxyData.ResponseTruncation
+	isTruncating bool
+}
+
+func truncateJSONItems(reader io.Reader, maxSize int, numToSkip uint64) ([]byte, proxyData.ResponseTruncation, error) {
+	jit := &jsonItemsTruncator{
+		decoder:   json.NewDecoder(reader),
+		maxSize:   maxSize,
+		numToSkip: numToSkip,
+	}
+	jit.decoder.UseNumber()
+
+	err := jit.copyDocument()
+	if err != nil {
+		return nil, proxyData.ResponseTruncation{}, err
+	}
+
+	return jit.output.Bytes(), jit.truncation, nil
+}
+
+func (jit *jsonItemsTruncator) copyDocument() error {
+	for {
+		if jit.isAtItem() {
+			err := jit.copyItem()
+			if err != nil {
+				return err
+			}
+			if jit.isTruncating {
+				jit.closeContainers()
+				return nil
+			}
+			continue
+		}
+
+		token, err := jit.decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		jit.copyToken(token)
+		if len(jit.containers) == 0 {
+			return nil
+		}
+	}
+}
+
+// isAtItem returns true if the next value to be read is an element of a top level list
+func (jit *jsonItemsTruncator) isAtItem() bool {
+	if len(jit.containers) == 0 || jit.numArrays != 1 {
+		return false
+	}
+
+	return jit.topContainer().isArray && jit.decoder.More()
+}
+
+func (jit *jsonItemsTruncator) copyItem() error {
+	item := json.RawMessage{}
+	err := jit.decoder.Decode(&item)
+	if err != nil {
+		return err
+	}
+
+	if jit.truncation.NumSkipped < jit.numToSkip {
+		jit.truncation.NumSkipped++
+		return nil
+	}
+	// an item larger than the maximum size is still returned when alone, so the cursor can move past it
+	if jit.output.Len()+len(item) > jit.maxSize && jit.truncation.NumReturned > 0 {
+		jit.truncation.Truncated = true
+		jit.isTruncating = true
+		return nil
+	}
+
+	jit.writeValuePrefix()
+	jit.output.Write(item)
+	jit.truncation.NumReturned++
+
+	return nil
+}
+
+func (jit *jsonItemsTruncator) copyToken(token json.Token) {
+	delim, isDelim := token.(json.Delim)
+	if !isDelim {
+		jit.copyScalar(token)
+		return
+	}
+
+	switch delim {
+	case '{', '[':
+		jit.writeValuePrefix()
+		jit.output.WriteByte(byte(delim))
+		jit.containers = append(jit.containers, &jsonContainer{
+			isArray:   delim == '[',
+			expectKey: delim == '{',
+		})
+		if delim == '[' {
+			jit.numArrays++
+		}
+	default:
+		jit.output.WriteByte(byte(delim))
+		jit.popContainer()
+	}
+}
+
+func (jit *jsonItemsTruncator) copyScalar(token json.Token) {
+	encoded, _ := json.Marshal(token)
+	if len(jit.containers) > 0 && jit.topContainer().expectKey {
+		container := jit.topContainer()
+		if container.numValues > 0 {
+			jit.output.WriteByte(',')
+		}
+		container.numValues++
+		container.expectKey = false
+		jit.output.Write(encoded)
+		jit.output.WriteByte(':')
+		return
+	}
+
+	jit.writeValuePrefix()
+	jit.output.Write(encoded)
+}
+
+// writeValuePrefix writes the separator needed before a value and marks the value as written in its container
+func (jit *jsonItemsTruncator) writeValuePrefix() {
+	if len(jit.containers) == 0 {
+		return
+	}
+
+	container := jit.topContainer()
+	if !container.isArray {
+		// the separator was written along with the key
+		container.expectKey = true
+		return
+	}
+	if container.numValues > 0 {
+		jit.output.WriteByte(',')
+	}
+	container.numValues++
+}
+
+func (jit *jsonItemsTruncator) popContainer() {
+	if jit.topContainer().isArray {
+		jit.numArrays--
+	}
+	jit.containers = jit.containers[:len(jit.containers)-1]
+}
+
+func (jit *jsonItemsTruncator) closeContainers() {
+	for len(jit.containers) > 0 {
+		if jit.topContainer().isArray {
+			jit.output.WriteByte(']')
+		} else {
+			jit.output.WriteByte('}')
+		}
+		jit.popContainer()
+	}
+}
+
+func (jit *jsonItemsTruncator) topContainer() *jsonContainer {
+	return jit.containers[len(jit.containers)-1]
+}
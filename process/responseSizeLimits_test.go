@@ -0,0 +1,152 @@
+package process
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResponseSizeLimits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		limits, err := newResponseSizeLimits(config.ResponseSizeLimitsConfig{
+			Classes: []config.ResponseSizeLimitClassConfig{{Paths: []string{"/block/"}, MaxSizeInBytes: 10}},
+		})
+		require.Nil(t, limits)
+		require.True(t, errors.Is(err, ErrInvalidResponseSizeLimitsConfig))
+	})
+
+	t.Run("no paths should error", func(t *testing.T) {
+		t.Parallel()
+
+		limits, err := newResponseSizeLimits(config.ResponseSizeLimitsConfig{
+			Classes: []config.ResponseSizeLimitClassConfig{{Name: "blocks", MaxSizeInBytes: 10}},
+		})
+		require.Nil(t, limits)
+		require.True(t, errors.Is(err, ErrInvalidResponseSizeLimitsConfig))
+	})
+
+	t.Run("relative path should error", func(t *testing.T) {
+		t.Parallel()
+
+		limits, err := newResponseSizeLimits(config.ResponseSizeLimitsConfig{
+			Classes: []config.ResponseSizeLimitClassConfig{{Name: "blocks", Paths: []string{"block/"}, MaxSizeInBytes: 10}},
+		})
+		require.Nil(t, limits)
+		require.True(t, errors.Is(err, ErrInvalidResponseSizeLimitsConfig))
+	})
+
+	t.Run("invalid max size should error", func(t *testing.T) {
+		t.Parallel()
+
+		limits, err := newResponseSizeLimits(config.ResponseSizeLimitsConfig{
+			Classes: []config.ResponseSizeLimitClassConfig{{Name: "blocks", Paths: []string{"/block/"}}},
+		})
+		require.Nil(t, limits)
+		require.True(t, errors.Is(err, ErrInvalidResponseSizeLimitsConfig))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		limits, err := newResponseSizeLimits(config.ResponseSizeLimitsConfig{
+			Classes: []config.ResponseSizeLimitClassConfig{{Name: "blocks", Paths: []string{"/block/"}, MaxSizeInBytes: 10}},
+		})
+		require.NoError(t, err)
+		require.Len(t, limits.classes, 1)
+	})
+}
+
+func TestResponseSizeLimits_GetClass(t *testing.T) {
+	t.Parallel()
+
+	limits, _ := newResponseSizeLimits(config.ResponseSizeLimitsConfig{
+		Classes: []config.ResponseSizeLimitClassConfig{
+			{Name: "transactions", Paths: []string{"/transaction/"}, MaxSizeInBytes: 10},
+			{Name: "transactions-pool", Paths: []string{"/transaction/pool"}, MaxSizeInBytes: 20, Truncate: true},
+		},
+	})
+
+	require.Nil(t, limits.getClass("/block/by-nonce/1"))
+	require.Equal(t, "transactions", limits.getClass("/transaction/aabb").name)
+	require.Equal(t, "transactions-pool", limits.getClass("/transaction/pool?fields=sender").name)
+	require.Equal(t, "transactions", limits.getClass("/transaction/aabb?data=/transaction/pool").name)
+}
+
+func TestSizeLimitedReader(t *testing.T) {
+	t.Parallel()
+
+	class := &responseSizeLimitClass{name: "test", maxSizeInBytes: 5}
+
+	readBytes, err := io.ReadAll(newSizeLimitedReader(strings.NewReader("12345"), class))
+	require.NoError(t, err)
+	require.Equal(t, "12345", string(readBytes))
+
+	_, err = io.ReadAll(newSizeLimitedReader(strings.NewReader("123456"), class))
+	require.True(t, errors.Is(err, ErrObserverResponseTooLarge))
+}
+
+func TestTruncateJSONItems(t *testing.T) {
+	t.Parallel()
+
+	response := `{"data":{"txPool":{"regularTransactions":[{"hash":"aa"},{"hash":"bb"}],"rewards":[],"smartContractResults":[{"hash":"cc","nonce":1}]}},"error":"","code":"successful"}`
+
+	t.Run("no limit should copy the document", func(t *testing.T) {
+		t.Parallel()
+
+		output, truncation, err := truncateJSONItems(strings.NewReader(response), len(response), 0)
+		require.NoError(t, err)
+		require.Equal(t, response, string(output))
+		require.False(t, truncation.Truncated)
+		require.Equal(t, uint64(3), truncation.NumReturned)
+	})
+
+	t.Run("should skip the first items", func(t *testing.T) {
+		t.Parallel()
+
+		output, truncation, err := truncateJSONItems(strings.NewReader(response), len(response), 2)
+		require.NoError(t, err)
+		expected := `{"data":{"txPool":{"regularTransactions":[],"rewards":[],"smartContractResults":[{"hash":"cc","nonce":1}]}},"error":"","code":"successful"}`
+		require.Equal(t, expected, string(output))
+		require.False(t, truncation.Truncated)
+		require.Equal(t, uint64(2), truncation.NumSkipped)
+		require.Equal(t, uint64(1), truncation.NumReturned)
+	})
+
+	t.Run("should drop the items above the limit", func(t *testing.T) {
+		t.Parallel()
+
+		output, truncation, err := truncateJSONItems(strings.NewReader(response), 70, 0)
+		require.NoError(t, err)
+		expected := `{"data":{"txPool":{"regularTransactions":[{"hash":"aa"},{"hash":"bb"}],"rewards":[],"smartContractResults":[]}}}`
+		require.Equal(t, expected, string(output))
+		require.True(t, truncation.Truncated)
+		require.Equal(t, uint64(2), truncation.NumReturned)
+	})
+
+	t.Run("single item above the limit should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		output, truncation, err := truncateJSONItems(strings.NewReader(response), 10, 0)
+		require.NoError(t, err)
+		expected := `{"data":{"txPool":{"regularTransactions":[{"hash":"aa"}]}}}`
+		require.Equal(t, expected, string(output))
+		require.True(t, truncation.Truncated)
+		require.Equal(t, uint64(1), truncation.NumReturned)
+	})
+
+	t.Run("invalid document should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := truncateJSONItems(bytes.NewReader([]byte(`{"data":[{"hash":`)), 100, 0)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,24 @@
+package process
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// decodeResponseBody decodes the JSON body into the provided value. The bodies not larger than the streaming threshold
+// are fully read and the raw bytes are returned as well. The larger bodies are decoded directly from the reader,
+// without holding the raw bytes in memory, so nil raw bytes are returned
+func decodeResponseBody(body io.Reader, streamingThreshold int, value interface{}) ([]byte, error) {
+	head, err := io.ReadAll(io.LimitReader(body, int64(streamingThreshold)+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(head) <= streamingThreshold {
+		return head, json.Unmarshal(head, value)
+	}
+
+	decoder := json.NewDecoder(io.MultiReader(bytes.NewReader(head), body))
+	return nil, decoder.Decode(value)
+}
@@ -0,0 +1,268 @@
+package process
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+)
+
+const (
+	latestBlockCacheKey         = "latest"
+	sharedSCQueryCacheKeyPrefix = "sc-query:"
+)
+
+type scQueryCacheEntry struct {
+	vmOutput  *vm.VMOutputApi
+	blockInfo data.BlockInfo
+	shardID   uint32
+	isLatest  bool
+	expiresAt time.Time
+}
+
+// scQueryCache holds the results of the smart contract queries for a short while. The results of the queries against
+// the latest state are dropped as soon as a newer block of their shard is observed in the responses of the observers
+type scQueryCache struct {
+	mut            sync.Mutex
+	ttl            time.Duration
+	maxEntries     int
+	entries        map[string]*scQueryCacheEntry
+	latestNonces   map[uint32]uint64
+	getTimeHandler func() time.Time
+	metrics        cache.MetricsCounters
+	sharedStore    cache.SharedStore
+}
+
+// sharedSCQueryCacheEntry is the form of a cached result in the store shared by several proxy instances
+type sharedSCQueryCacheEntry struct {
+	VMOutput        *vm.VMOutputApi `json:"vmOutput"`
+	BlockInfo       data.BlockInfo  `json:"blockInfo"`
+	ShardID         uint32          `json:"shardID"`
+	IsLatest        bool            `json:"isLatest"`
+	ExpiresAtMillis int64           `json:"expiresAtMillis"`
+}
+
+func newSCQueryCache(cfg config.SCQueryCacheConfig) (*scQueryCache, error) {
+	if cfg.TTLInMilliseconds <= 0 {
+		return nil, fmt.Errorf("%w, TTLInMilliseconds should be positive", ErrInvalidSCQueryCacheConfig)
+	}
+	if cfg.MaxEntries <= 0 {
+		return nil, fmt.Errorf("%w, MaxEntries should be positive", ErrInvalidSCQueryCacheConfig)
+	}
+
+	return &scQueryCache{
+		ttl:            time.Duration(cfg.TTLInMilliseconds) * time.Millisecond,
+		maxEntries:     cfg.MaxEntries,
+		entries:        make(map[string]*scQueryCacheEntry),
+		latestNonces:   make(map[uint32]uint64),
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// createSCQueryCacheKey returns the key of the query, built out of the contract, the function, the caller, the call
+// value, the arguments and the targeted block
+func createSCQueryCacheKey(query *data.SCQuery) string {
+	block := latestBlockCacheKey
+	if query.BlockNonce.HasValue {
+		block = fmt.Sprintf("nonce:%d", query.BlockNonce.Value)
+	}
+	if len(query.BlockHash) > 0 {
+		block = "hash:" + hex.EncodeToString(query.BlockHash)
+	}
+
+	args := make([]string, len(query.Arguments))
+	for i, arg := range query.Arguments {
+		args[i] = hex.EncodeToString(arg)
+	}
+
+	return strings.Join([]string{
+		query.ScAddress,
+		query.FuncName,
+		query.CallerAddr,
+		query.CallValue,
+		strings.Join(args, "@"),
+		block,
+	}, "|")
+}
+
+// createSharedSCQueryCacheKey returns the key of the query in the shared store, bounded in size by hashing the key
+func createSharedSCQueryCacheKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return sharedSCQueryCacheKeyPrefix + hex.EncodeToString(hash[:])
+}
+
+func isLatestStateQuery(query *data.SCQuery) bool {
+	return !query.BlockNonce.HasValue && len(query.BlockHash) == 0
+}
+
+// get returns the cached result of the query, if not expired. If not cached locally, the result stored in the shared
+// store by another proxy instance is used, if any
+func (sqc *scQueryCache) get(key string) (*vm.VMOutputApi, data.BlockInfo, bool) {
+	entry, found := sqc.getEntry(key)
+	if !found && !check.IfNil(sqc.sharedStore) {
+		entry, found = sqc.getSharedEntry(key)
+	}
+	if !found {
+		sqc.metrics.AddMiss()
+		return nil, data.BlockInfo{}, false
+	}
+
+	sqc.metrics.AddHit()
+	return entry.vmOutput, entry.blockInfo, true
+}
+
+func (sqc *scQueryCache) getEntry(key string) (*scQueryCacheEntry, bool) {
+	sqc.mut.Lock()
+	defer sqc.mut.Unlock()
+
+	entry, found := sqc.entries[key]
+	if !found {
+		return nil, false
+	}
+	if !sqc.getTimeHandler().Before(entry.expiresAt) {
+		delete(sqc.entries, key)
+		sqc.metrics.AddEvictions(1)
+		return nil, false
+	}
+
+	return entry, true
+}
+
+// getSharedEntry returns the result stored in the shared store, which is also cached locally. A result of a query
+// against the latest state older than the latest block seen for its shard is ignored
+func (sqc *scQueryCache) getSharedEntry(key string) (*scQueryCacheEntry, bool) {
+	buff, err := sqc.sharedStore.Get(createSharedSCQueryCacheKey(key))
+	if err != nil {
+		if !errors.Is(err, cache.ErrKeyNotFoundInSharedStore) {
+			log.Debug("SC query cache: get from shared store", "error", err.Error())
+		}
+		return nil, false
+	}
+
+	sharedEntry := &sharedSCQueryCacheEntry{}
+	err = json.Unmarshal(buff, sharedEntry)
+	if err != nil {
+		log.Debug("SC query cache: unmarshal from shared store", "error", err.Error())
+		return nil, false
+	}
+
+	entry := &scQueryCacheEntry{
+		vmOutput:  sharedEntry.VMOutput,
+		blockInfo: sharedEntry.BlockInfo,
+		shardID:   sharedEntry.ShardID,
+		isLatest:  sharedEntry.IsLatest,
+		expiresAt: time.UnixMilli(sharedEntry.ExpiresAtMillis),
+	}
+
+	sqc.mut.Lock()
+	defer sqc.mut.Unlock()
+
+	if !sqc.getTimeHandler().Before(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry, sqc.addEntry(key, entry)
+}
+
+// put stores the result of the query. A result of a query against the latest state reporting a block newer than the
+// ones already seen for the shard drops the cached results of the shard's latest state, while a result reporting an
+// older block (from a lagging observer) is not stored at all. The stored result is also written in the shared store
+func (sqc *scQueryCache) put(key string, shardID uint32, isLatest bool, vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo) {
+	sqc.mut.Lock()
+	entry := &scQueryCacheEntry{
+		vmOutput:  vmOutput,
+		blockInfo: blockInfo,
+		shardID:   shardID,
+		isLatest:  isLatest,
+		expiresAt: sqc.getTimeHandler().Add(sqc.ttl),
+	}
+	isAdded := sqc.addEntry(key, entry)
+	sqc.mut.Unlock()
+
+	if isAdded && !check.IfNil(sqc.sharedStore) {
+		sqc.putSharedEntry(key, entry)
+	}
+}
+
+func (sqc *scQueryCache) putSharedEntry(key string, entry *scQueryCacheEntry) {
+	buff, err := json.Marshal(&sharedSCQueryCacheEntry{
+		VMOutput:        entry.vmOutput,
+		BlockInfo:       entry.blockInfo,
+		ShardID:         entry.shardID,
+		IsLatest:        entry.isLatest,
+		ExpiresAtMillis: entry.expiresAt.UnixMilli(),
+	})
+	if err != nil {
+		log.Debug("SC query cache: marshal for shared store", "error", err.Error())
+		return
+	}
+
+	err = sqc.sharedStore.Set(createSharedSCQueryCacheKey(key), buff, sqc.ttl)
+	if err != nil {
+		log.Debug("SC query cache: put in shared store", "error", err.Error())
+	}
+}
+
+// addEntry adds the entry, returning false if it was not added because it reports a block older than the latest one
+// seen for its shard. Should be called under the mutex
+func (sqc *scQueryCache) addEntry(key string, entry *scQueryCacheEntry) bool {
+	if entry.isLatest {
+		latestNonce := sqc.latestNonces[entry.shardID]
+		if entry.blockInfo.Nonce < latestNonce {
+			return false
+		}
+		if entry.blockInfo.Nonce > latestNonce {
+			sqc.invalidateLatestState(entry.shardID)
+			sqc.latestNonces[entry.shardID] = entry.blockInfo.Nonce
+		}
+	}
+
+	_, exists := sqc.entries[key]
+	if !exists && len(sqc.entries) >= sqc.maxEntries {
+		sqc.evict(sqc.getTimeHandler())
+	}
+
+	sqc.entries[key] = entry
+	return true
+}
+
+func (sqc *scQueryCache) invalidateLatestState(shardID uint32) {
+	for key, entry := range sqc.entries {
+		if entry.isLatest && entry.shardID == shardID {
+			delete(sqc.entries, key)
+			sqc.metrics.AddEvictions(1)
+		}
+	}
+}
+
+// evict drops the expired entries. If none expired, the entry closest to expiry is dropped
+func (sqc *scQueryCache) evict(now time.Time) {
+	oldestKey := ""
+	var oldestExpiry time.Time
+	for key, entry := range sqc.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(sqc.entries, key)
+			sqc.metrics.AddEvictions(1)
+			continue
+		}
+		if len(oldestKey) == 0 || entry.expiresAt.Before(oldestExpiry) {
+			oldestKey = key
+			oldestExpiry = entry.expiresAt
+		}
+	}
+
+	if len(sqc.entries) >= sqc.maxEntries {
+		delete(sqc.entries, oldestKey)
+		sqc.metrics.AddEvictions(1)
+	}
+}
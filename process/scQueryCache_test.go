@@ -0,0 +1,189 @@
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createSCQueryCacheConfig() config.SCQueryCacheConfig {
+	return config.SCQueryCacheConfig{
+		Enabled:           true,
+		TTLInMilliseconds: 1000,
+		MaxEntries:        2,
+	}
+}
+
+func TestNewSCQueryCache(t *testing.T) {
+	t.Parallel()
+
+	cfg := createSCQueryCacheConfig()
+	cfg.TTLInMilliseconds = 0
+	sqc, err := newSCQueryCache(cfg)
+	require.Nil(t, sqc)
+	require.True(t, errors.Is(err, ErrInvalidSCQueryCacheConfig))
+
+	cfg = createSCQueryCacheConfig()
+	cfg.MaxEntries = 0
+	sqc, err = newSCQueryCache(cfg)
+	require.Nil(t, sqc)
+	require.True(t, errors.Is(err, ErrInvalidSCQueryCacheConfig))
+
+	sqc, err = newSCQueryCache(createSCQueryCacheConfig())
+	require.NoError(t, err)
+	require.NotNil(t, sqc)
+}
+
+func TestCreateSCQueryCacheKey(t *testing.T) {
+	t.Parallel()
+
+	query := &data.SCQuery{
+		ScAddress:  "contract",
+		FuncName:   "getSum",
+		CallerAddr: "caller",
+		CallValue:  "10",
+		Arguments:  [][]byte{{1}, {2, 3}},
+	}
+	require.Equal(t, "contract|getSum|caller|10|01@0203|latest", createSCQueryCacheKey(query))
+
+	query.BlockNonce = core.OptionalUint64{Value: 37, HasValue: true}
+	require.Equal(t, "contract|getSum|caller|10|01@0203|nonce:37", createSCQueryCacheKey(query))
+
+	query.BlockNonce = core.OptionalUint64{}
+	query.BlockHash = []byte{0xab}
+	require.Equal(t, "contract|getSum|caller|10|01@0203|hash:ab", createSCQueryCacheKey(query))
+}
+
+func TestSCQueryCache_GetPut(t *testing.T) {
+	t.Parallel()
+
+	output := &vm.VMOutputApi{ReturnCode: "ok"}
+
+	t.Run("entries should expire after the TTL", func(t *testing.T) {
+		t.Parallel()
+
+		sqc, _ := newSCQueryCache(createSCQueryCacheConfig())
+		currentTime := time.Unix(1000, 0)
+		sqc.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		sqc.put("key", 0, true, output, data.BlockInfo{Nonce: 10})
+		cachedOutput, blockInfo, found := sqc.get("key")
+		require.True(t, found)
+		require.True(t, cachedOutput == output)
+		require.Equal(t, uint64(10), blockInfo.Nonce)
+
+		currentTime = currentTime.Add(time.Second)
+		_, _, found = sqc.get("key")
+		require.False(t, found)
+		require.Empty(t, sqc.entries)
+		require.Equal(t, data.CacheMetrics{NumHits: 1, NumMisses: 1, NumEvictions: 1, HitRatio: 0.5}, sqc.metrics.GetCacheMetrics())
+	})
+	t.Run("a newer block should invalidate the latest state of the shard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createSCQueryCacheConfig()
+		cfg.MaxEntries = 10
+		sqc, _ := newSCQueryCache(cfg)
+		sqc.put("latest0", 0, true, output, data.BlockInfo{Nonce: 10})
+		sqc.put("past0", 0, false, output, data.BlockInfo{Nonce: 5})
+		sqc.put("latest1", 1, true, output, data.BlockInfo{Nonce: 20})
+
+		// a lagging observer should not be cached nor invalidate anything
+		sqc.put("lagging0", 0, true, output, data.BlockInfo{Nonce: 9})
+		_, _, found := sqc.get("lagging0")
+		require.False(t, found)
+		_, _, found = sqc.get("latest0")
+		require.True(t, found)
+
+		sqc.put("other0", 0, true, output, data.BlockInfo{Nonce: 11})
+		_, _, found = sqc.get("latest0")
+		require.False(t, found)
+		_, _, found = sqc.get("other0")
+		require.True(t, found)
+		_, _, found = sqc.get("past0")
+		require.True(t, found)
+		_, _, found = sqc.get("latest1")
+		require.True(t, found)
+		require.Equal(t, uint64(1), sqc.metrics.GetCacheMetrics().NumEvictions)
+	})
+	t.Run("the entry closest to expiry should be evicted when full", func(t *testing.T) {
+		t.Parallel()
+
+		sqc, _ := newSCQueryCache(createSCQueryCacheConfig())
+		currentTime := time.Unix(1000, 0)
+		sqc.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		sqc.put("key0", 0, false, output, data.BlockInfo{})
+		currentTime = currentTime.Add(time.Millisecond)
+		sqc.put("key1", 0, false, output, data.BlockInfo{})
+		sqc.put("key1", 0, false, output, data.BlockInfo{})
+		require.Len(t, sqc.entries, 2)
+
+		sqc.put("key2", 0, false, output, data.BlockInfo{})
+		require.Len(t, sqc.entries, 2)
+		_, _, found := sqc.get("key0")
+		require.False(t, found)
+		_, _, found = sqc.get("key1")
+		require.True(t, found)
+		_, _, found = sqc.get("key2")
+		require.True(t, found)
+		require.Equal(t, uint64(1), sqc.metrics.GetCacheMetrics().NumEvictions)
+	})
+}
+
+func TestSCQueryCache_SharedStore(t *testing.T) {
+	t.Parallel()
+
+	output := &vm.VMOutputApi{ReturnCode: "ok"}
+	sharedStore := mock.NewSharedStoreMock()
+	currentTime := time.Unix(1000, 0)
+	getTime := func() time.Time {
+		return currentTime
+	}
+
+	cfg := createSCQueryCacheConfig()
+	cfg.MaxEntries = 10
+	firstCache := newSCQueryCacheWithStore(t, cfg, sharedStore, getTime)
+	secondCache := newSCQueryCacheWithStore(t, cfg, sharedStore, getTime)
+
+	firstCache.put("latest0", 0, true, output, data.BlockInfo{Nonce: 10})
+	firstCache.put("past0", 0, false, output, data.BlockInfo{Nonce: 5})
+
+	cachedOutput, blockInfo, found := secondCache.get("past0")
+	require.True(t, found)
+	require.Equal(t, output, cachedOutput)
+	require.Equal(t, uint64(5), blockInfo.Nonce)
+	require.Len(t, secondCache.entries, 1)
+
+	// the second instance already saw a newer block of the shard, the shared latest state is outdated
+	secondCache.put("other0", 0, true, output, data.BlockInfo{Nonce: 11})
+	_, _, found = secondCache.get("latest0")
+	require.False(t, found)
+
+	// the shared entries expire along with the local ones
+	currentTime = currentTime.Add(time.Second)
+	_, _, found = secondCache.get("past0")
+	require.False(t, found)
+	_, _, found = newSCQueryCacheWithStore(t, cfg, sharedStore, getTime).get("past0")
+	require.False(t, found)
+}
+
+func newSCQueryCacheWithStore(t *testing.T, cfg config.SCQueryCacheConfig, sharedStore *mock.SharedStoreMock, getTime func() time.Time) *scQueryCache {
+	sqc, err := newSCQueryCache(cfg)
+	require.Nil(t, err)
+	sqc.sharedStore = sharedStore
+	sqc.getTimeHandler = getTime
+
+	return sqc
+}
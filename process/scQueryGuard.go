@@ -0,0 +1,200 @@
+package process
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	scQueryGuardWindow = time.Second
+
+	// scQueryGuardIdleDuration is the duration after which a contract without queries can be forgotten, to make room
+	// for new contracts once the maximum number of tracked contracts is reached
+	scQueryGuardIdleDuration = time.Minute
+)
+
+type scQueryContractCost struct {
+	numQueries          uint64
+	numRejected         uint64
+	totalExecutionTime  time.Duration
+	totalResponseSize   uint64
+	lastQueryAt         time.Time
+	windowStart         time.Time
+	windowQueries       int
+	windowExecutionTime time.Duration
+}
+
+// scQueryGuard accounts the cost of the smart contract queries of each contract (the number of queries, the time spent
+// waiting for the observers and the size of the results) and rejects the queries of the contracts exceeding the
+// configured limits within the current one second window
+type scQueryGuard struct {
+	mut                       sync.Mutex
+	maxQueriesPerSecond       int
+	maxExecutionTimePerSecond time.Duration
+	maxResponseSize           int
+	maxTrackedContracts       int
+	contracts                 map[string]*scQueryContractCost
+	getTimeHandler            func() time.Time
+}
+
+func newSCQueryGuard(cfg config.SCQueryGuardConfig) (*scQueryGuard, error) {
+	if cfg.MaxQueriesPerSecondPerContract < 0 {
+		return nil, fmt.Errorf("%w, MaxQueriesPerSecondPerContract should not be negative", ErrInvalidSCQueryGuardConfig)
+	}
+	if cfg.MaxExecutionTimePerSecondPerContractInMs < 0 {
+		return nil, fmt.Errorf("%w, MaxExecutionTimePerSecondPerContractInMs should not be negative", ErrInvalidSCQueryGuardConfig)
+	}
+	if cfg.MaxResponseSizeInBytes < 0 {
+		return nil, fmt.Errorf("%w, MaxResponseSizeInBytes should not be negative", ErrInvalidSCQueryGuardConfig)
+	}
+	if cfg.MaxTrackedContracts <= 0 {
+		return nil, fmt.Errorf("%w, MaxTrackedContracts should be positive", ErrInvalidSCQueryGuardConfig)
+	}
+
+	return &scQueryGuard{
+		maxQueriesPerSecond:       cfg.MaxQueriesPerSecondPerContract,
+		maxExecutionTimePerSecond: time.Duration(cfg.MaxExecutionTimePerSecondPerContractInMs) * time.Millisecond,
+		maxResponseSize:           cfg.MaxResponseSizeInBytes,
+		maxTrackedContracts:       cfg.MaxTrackedContracts,
+		contracts:                 make(map[string]*scQueryContractCost),
+		getTimeHandler:            time.Now,
+	}, nil
+}
+
+// checkQuery returns an error if the contract reached its limits within the current window. Otherwise, the query is
+// counted. The contracts above the maximum number of tracked contracts are not limited
+func (guard *scQueryGuard) checkQuery(scAddress string) error {
+	guard.mut.Lock()
+	defer guard.mut.Unlock()
+
+	now := guard.getTimeHandler()
+	cost := guard.getOrCreateCost(scAddress, now)
+	if cost == nil {
+		log.Debug("SC queries guard: too many tracked contracts", "contract", scAddress)
+		return nil
+	}
+
+	cost.lastQueryAt = now
+	if now.Sub(cost.windowStart) >= scQueryGuardWindow {
+		cost.windowStart = now
+		cost.windowQueries = 0
+		cost.windowExecutionTime = 0
+	}
+
+	if guard.maxQueriesPerSecond > 0 && cost.windowQueries >= guard.maxQueriesPerSecond {
+		cost.numRejected++
+		return fmt.Errorf("%w, contract %s, max queries per second: %d", data.ErrSCQueryLimitReached, scAddress, guard.maxQueriesPerSecond)
+	}
+	if guard.maxExecutionTimePerSecond > 0 && cost.windowExecutionTime >= guard.maxExecutionTimePerSecond {
+		cost.numRejected++
+		return fmt.Errorf("%w, contract %s, max execution time per second: %s", data.ErrSCQueryLimitReached, scAddress, guard.maxExecutionTimePerSecond)
+	}
+
+	cost.windowQueries++
+	cost.numQueries++
+
+	return nil
+}
+
+func (guard *scQueryGuard) getOrCreateCost(scAddress string, now time.Time) *scQueryContractCost {
+	cost, found := guard.contracts[scAddress]
+	if found {
+		return cost
+	}
+
+	if len(guard.contracts) >= guard.maxTrackedContracts {
+		guard.removeIdleContracts(now)
+	}
+	if len(guard.contracts) >= guard.maxTrackedContracts {
+		return nil
+	}
+
+	cost = &scQueryContractCost{}
+	guard.contracts[scAddress] = cost
+
+	return cost
+}
+
+func (guard *scQueryGuard) removeIdleContracts(now time.Time) {
+	for scAddress, cost := range guard.contracts {
+		if now.Sub(cost.lastQueryAt) >= scQueryGuardIdleDuration {
+			delete(guard.contracts, scAddress)
+		}
+	}
+}
+
+// recordExecution accounts the time spent by the observers on the query and the size of its result. Returns an error
+// if the result exceeds the maximum allowed size
+func (guard *scQueryGuard) recordExecution(scAddress string, executionTime time.Duration, vmOutput *vm.VMOutputApi) error {
+	responseSize := computeVMOutputSize(vmOutput)
+
+	guard.mut.Lock()
+	defer guard.mut.Unlock()
+
+	cost, found := guard.contracts[scAddress]
+	if found {
+		cost.totalExecutionTime += executionTime
+		cost.windowExecutionTime += executionTime
+		cost.totalResponseSize += uint64(responseSize)
+	}
+
+	if guard.maxResponseSize > 0 && responseSize > guard.maxResponseSize {
+		if found {
+			cost.numRejected++
+		}
+		return fmt.Errorf("%w, contract %s, size in bytes: %d, max size in bytes: %d", ErrSCQueryResponseTooLarge, scAddress, responseSize, guard.maxResponseSize)
+	}
+
+	return nil
+}
+
+func computeVMOutputSize(vmOutput *vm.VMOutputApi) int {
+	if vmOutput == nil {
+		return 0
+	}
+
+	size := len(vmOutput.ReturnMessage)
+	for _, returnData := range vmOutput.ReturnData {
+		size += len(returnData)
+	}
+
+	return size
+}
+
+// getCosts returns the costs of the tracked contracts, sorted by their total execution time, the most expensive first
+func (guard *scQueryGuard) getCosts() *data.SCQueryCosts {
+	guard.mut.Lock()
+	defer guard.mut.Unlock()
+
+	costs := &data.SCQueryCosts{
+		Contracts: make([]data.SCQueryContractCost, 0, len(guard.contracts)),
+	}
+	for scAddress, cost := range guard.contracts {
+		contractCost := data.SCQueryContractCost{
+			ScAddress:                scAddress,
+			NumQueries:               cost.numQueries,
+			NumRejected:              cost.numRejected,
+			TotalExecutionTimeMs:     uint64(cost.totalExecutionTime.Milliseconds()),
+			TotalResponseSizeInBytes: cost.totalResponseSize,
+		}
+		if cost.numQueries > 0 {
+			contractCost.AverageExecutionTimeMs = contractCost.TotalExecutionTimeMs / cost.numQueries
+		}
+		costs.Contracts = append(costs.Contracts, contractCost)
+	}
+
+	sort.Slice(costs.Contracts, func(i, j int) bool {
+		if costs.Contracts[i].TotalExecutionTimeMs != costs.Contracts[j].TotalExecutionTimeMs {
+			return costs.Contracts[i].TotalExecutionTimeMs > costs.Contracts[j].TotalExecutionTimeMs
+		}
+		return costs.Contracts[i].ScAddress < costs.Contracts[j].ScAddress
+	})
+
+	return costs
+}
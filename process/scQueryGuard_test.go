@@ -0,0 +1,147 @@
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createSCQueryGuardConfig() config.SCQueryGuardConfig {
+	return config.SCQueryGuardConfig{
+		Enabled:                                  true,
+		MaxQueriesPerSecondPerContract:           2,
+		MaxExecutionTimePerSecondPerContractInMs: 100,
+		MaxResponseSizeInBytes:                   10,
+		MaxTrackedContracts:                      2,
+	}
+}
+
+func TestNewSCQueryGuard(t *testing.T) {
+	t.Parallel()
+
+	testInvalidConfig := func(modifier func(cfg *config.SCQueryGuardConfig)) {
+		cfg := createSCQueryGuardConfig()
+		modifier(&cfg)
+		guard, err := newSCQueryGuard(cfg)
+		require.Nil(t, guard)
+		require.True(t, errors.Is(err, ErrInvalidSCQueryGuardConfig))
+	}
+
+	testInvalidConfig(func(cfg *config.SCQueryGuardConfig) { cfg.MaxQueriesPerSecondPerContract = -1 })
+	testInvalidConfig(func(cfg *config.SCQueryGuardConfig) { cfg.MaxExecutionTimePerSecondPerContractInMs = -1 })
+	testInvalidConfig(func(cfg *config.SCQueryGuardConfig) { cfg.MaxResponseSizeInBytes = -1 })
+	testInvalidConfig(func(cfg *config.SCQueryGuardConfig) { cfg.MaxTrackedContracts = 0 })
+
+	guard, err := newSCQueryGuard(createSCQueryGuardConfig())
+	require.NoError(t, err)
+	require.NotNil(t, guard)
+}
+
+func TestSCQueryGuard_QueriesLimit(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	guard, _ := newSCQueryGuard(createSCQueryGuardConfig())
+	guard.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	require.NoError(t, guard.checkQuery("contract"))
+	require.NoError(t, guard.checkQuery("contract"))
+	err := guard.checkQuery("contract")
+	require.True(t, errors.Is(err, data.ErrSCQueryLimitReached))
+
+	// the other contracts have their own limits
+	require.NoError(t, guard.checkQuery("other"))
+
+	currentTime = currentTime.Add(time.Second)
+	require.NoError(t, guard.checkQuery("contract"))
+
+	costs := guard.getCosts()
+	require.Equal(t, []data.SCQueryContractCost{
+		{ScAddress: "contract", NumQueries: 3, NumRejected: 1},
+		{ScAddress: "other", NumQueries: 1},
+	}, costs.Contracts)
+}
+
+func TestSCQueryGuard_ExecutionTimeLimit(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	cfg := createSCQueryGuardConfig()
+	cfg.MaxQueriesPerSecondPerContract = 0
+	guard, _ := newSCQueryGuard(cfg)
+	guard.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	require.NoError(t, guard.checkQuery("contract"))
+	require.NoError(t, guard.recordExecution("contract", 60*time.Millisecond, nil))
+	require.NoError(t, guard.checkQuery("contract"))
+	require.NoError(t, guard.recordExecution("contract", 60*time.Millisecond, nil))
+
+	err := guard.checkQuery("contract")
+	require.True(t, errors.Is(err, data.ErrSCQueryLimitReached))
+
+	currentTime = currentTime.Add(time.Second)
+	require.NoError(t, guard.checkQuery("contract"))
+
+	costs := guard.getCosts()
+	require.Equal(t, []data.SCQueryContractCost{
+		{
+			ScAddress:              "contract",
+			NumQueries:             3,
+			NumRejected:            1,
+			TotalExecutionTimeMs:   120,
+			AverageExecutionTimeMs: 40,
+		},
+	}, costs.Contracts)
+}
+
+func TestSCQueryGuard_ResponseSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	guard, _ := newSCQueryGuard(createSCQueryGuardConfig())
+	require.NoError(t, guard.checkQuery("contract"))
+	err := guard.recordExecution("contract", time.Millisecond, &vm.VMOutputApi{ReturnData: [][]byte{make([]byte, 6), make([]byte, 4)}})
+	require.NoError(t, err)
+
+	require.NoError(t, guard.checkQuery("contract"))
+	err = guard.recordExecution("contract", time.Millisecond, &vm.VMOutputApi{ReturnData: [][]byte{make([]byte, 11)}})
+	require.True(t, errors.Is(err, ErrSCQueryResponseTooLarge))
+
+	costs := guard.getCosts()
+	require.Equal(t, uint64(1), costs.Contracts[0].NumRejected)
+	require.Equal(t, uint64(21), costs.Contracts[0].TotalResponseSizeInBytes)
+}
+
+func TestSCQueryGuard_TrackedContractsLimit(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	guard, _ := newSCQueryGuard(createSCQueryGuardConfig())
+	guard.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	require.NoError(t, guard.checkQuery("contract0"))
+	require.NoError(t, guard.checkQuery("contract1"))
+
+	// not tracked, so not limited
+	for i := 0; i < 5; i++ {
+		require.NoError(t, guard.checkQuery("contract2"))
+	}
+	require.Len(t, guard.getCosts().Contracts, 2)
+
+	// the idle contracts make room for the new ones
+	currentTime = currentTime.Add(scQueryGuardIdleDuration)
+	require.NoError(t, guard.checkQuery("contract2"))
+	costs := guard.getCosts()
+	require.Len(t, costs.Contracts, 1)
+	require.Equal(t, "contract2", costs.Contracts[0].ScAddress)
+}
@@ -1,16 +1,20 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/availabilityCommon"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
 )
 
 // scQueryServicePath defines the get values path at which the nodes answer
@@ -23,10 +27,17 @@ type SCQueryProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
 	availabilityProvider availabilityCommon.AvailabilityProvider
+	cache                *scQueryCache
+	guard                *scQueryGuard
 }
 
 // NewSCQueryProcessor creates a new instance of SCQueryProcessor
-func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*SCQueryProcessor, error) {
+func NewSCQueryProcessor(
+	proc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	cacheConfig config.SCQueryCacheConfig,
+	guardConfig config.SCQueryGuardConfig,
+) (*SCQueryProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
@@ -34,15 +45,73 @@ func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (
 		return nil, ErrNilPubKeyConverter
 	}
 
+	var cache *scQueryCache
+	if cacheConfig.Enabled {
+		var err error
+		cache, err = newSCQueryCache(cacheConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var guard *scQueryGuard
+	if guardConfig.Enabled {
+		var err error
+		guard, err = newSCQueryGuard(guardConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &SCQueryProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
+		cache:                cache,
+		guard:                guard,
 	}, nil
 }
 
-// ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer
-func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+// SetSharedCacheStore sets the store shared by several proxy instances, through which the results of the queries are
+// also shared. Has no effect if the cache is not enabled
+func (scQueryProcessor *SCQueryProcessor) SetSharedCacheStore(sharedStore cache.SharedStore) error {
+	if check.IfNil(sharedStore) {
+		return ErrNilSharedCacheStore
+	}
+	if scQueryProcessor.cache == nil {
+		return nil
+	}
+
+	scQueryProcessor.cache.mut.Lock()
+	scQueryProcessor.cache.sharedStore = sharedStore
+	scQueryProcessor.cache.mut.Unlock()
+
+	return nil
+}
+
+// GetCacheMetrics returns the hits, the misses and the evictions of the queries cache, if enabled
+func (scQueryProcessor *SCQueryProcessor) GetCacheMetrics() data.CacheMetrics {
+	if scQueryProcessor.cache == nil {
+		return data.CacheMetrics{}
+	}
+
+	return scQueryProcessor.cache.metrics.GetCacheMetrics()
+}
+
+// GetQueryCosts returns the cost of the smart contract queries of each tracked contract, if the guard is enabled
+func (scQueryProcessor *SCQueryProcessor) GetQueryCosts() *data.SCQueryCosts {
+	if scQueryProcessor.guard == nil {
+		return &data.SCQueryCosts{Contracts: make([]data.SCQueryContractCost, 0)}
+	}
+
+	return scQueryProcessor.guard.getCosts()
+}
+
+// ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer.
+// The requests towards the observers are canceled once the provided context is done. If the cache is enabled, the
+// identical queries are answered from it while the result is still valid. If the guard is enabled, the queries of the
+// contracts exceeding their limits are rejected before reaching the observers
+func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
@@ -53,12 +122,53 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 		return nil, data.BlockInfo{}, err
 	}
 
+	cacheKey := ""
+	if scQueryProcessor.cache != nil {
+		cacheKey = createSCQueryCacheKey(query)
+		vmOutput, blockInfo, found := scQueryProcessor.cache.get(cacheKey)
+		if found {
+			return vmOutput, blockInfo, nil
+		}
+	}
+
+	if scQueryProcessor.guard != nil {
+		err = scQueryProcessor.guard.checkQuery(query.ScAddress)
+		if err != nil {
+			return nil, data.BlockInfo{}, err
+		}
+	}
+
 	availability := scQueryProcessor.availabilityProvider.AvailabilityForVmQuery(query)
 	observers, err := scQueryProcessor.proc.GetObservers(shardID, availability)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
 	}
 
+	startTime := time.Now()
+	vmOutput, blockInfo, err := scQueryProcessor.executeQueryOnObservers(ctx, query, observers, shardID)
+	if scQueryProcessor.guard != nil {
+		errGuard := scQueryProcessor.guard.recordExecution(query.ScAddress, time.Since(startTime), vmOutput)
+		if err == nil {
+			err = errGuard
+		}
+	}
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
+
+	if scQueryProcessor.cache != nil {
+		scQueryProcessor.cache.put(cacheKey, shardID, isLatestStateQuery(query), vmOutput, blockInfo)
+	}
+
+	return vmOutput, blockInfo, nil
+}
+
+func (scQueryProcessor *SCQueryProcessor) executeQueryOnObservers(
+	ctx context.Context,
+	query *data.SCQuery,
+	observers []*data.NodeData,
+	shardID uint32,
+) (*vm.VMOutputApi, data.BlockInfo, error) {
 	response := data.ResponseVmValue{}
 	for _, observer := range observers {
 		request := scQueryProcessor.createRequestFromQuery(query)
@@ -77,7 +187,12 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 			path = path + "?" + queryParams
 		}
 
-		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(observer.Address, path, request, &response)
+		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPointWithContext(ctx, observer.Address, path, request, &response)
+		if ctx.Err() != nil {
+			// the client gave up or its deadline expired, there is no point in trying the other observers
+			return nil, data.BlockInfo{}, ctx.Err()
+		}
+
 		isObserverDown := httpStatus == http.StatusNotFound || httpStatus == http.StatusRequestTimeout
 		isOk := httpStatus == http.StatusOK
 		responseHasExplicitError := len(response.Error) > 0
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
@@ -21,7 +23,7 @@ var dummyScAddress = "erd1l453hd0gt5gzdp7czpuall8ggt2dcv5zwmfdf3sd3lguxseux2fsms
 func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilCoreProcessor, err)
 }
@@ -29,7 +31,7 @@ func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewSCQueryProcessor_NilPubConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilPubKeyConverter, err)
 }
@@ -37,7 +39,7 @@ func TestNewSCQueryProcessor_NilPubConverterShouldErr(t *testing.T) {
 func TestNewSCQueryProcessor_WithCoreProcessor(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 	require.NotNil(t, processor)
 	require.Nil(t, err)
 }
@@ -50,9 +52,9 @@ func TestSCQueryProcessor_ExecuteQueryComputeShardIdFailsShouldErr(t *testing.T)
 		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
 			return 0, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -68,9 +70,9 @@ func TestSCQueryProcessor_ExecuteQueryGetObserversFailsShouldErr(t *testing.T) {
 		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
 			return nil, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -92,9 +94,9 @@ func TestSCQueryProcessor_ExecuteQuerySendingFailsOnAllObserversShouldErr(t *tes
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusNotFound, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.True(t, errors.Is(err, ErrSendingRequest))
 }
@@ -124,9 +126,9 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 
 			return http.StatusOK, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 
-	value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+	value, blockInfo, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -168,9 +170,9 @@ func TestSCQueryProcessor_ExecuteQueryWithCoordinates(t *testing.T) {
 
 			return http.StatusOK, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 
-	value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+	value, blockInfo, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -203,9 +205,9 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnRandomErrorShouldErr(t *testing.T)
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusInternalServerError, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -228,9 +230,85 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnBadRequestWithExplicitErrorShouldEr
 			response.(*data.ResponseVmValue).Error = errExpected.Error()
 			return http.StatusBadRequest, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
+
+func TestSCQueryProcessor_ExecuteQueryContextDoneShouldNotTryOtherObservers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	numCalls := 0
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+			return 0, nil
+		},
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+			return []*data.NodeData{{Address: "address1"}, {Address: "address2"}}, nil
+		},
+		CallPostRestEndPointWithContextCalled: func(ctxCall context.Context, address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			numCalls++
+			require.Equal(t, ctx, ctxCall)
+			cancel()
+			return http.StatusRequestTimeout, ctxCall.Err()
+		},
+	}, testPubKeyConverter, config.SCQueryCacheConfig{}, config.SCQueryGuardConfig{})
+
+	value, _, err := processor.ExecuteQuery(ctx, &data.SCQuery{ScAddress: dummyScAddress})
+	require.Nil(t, value)
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, 1, numCalls)
+}
+
+func TestSCQueryProcessor_ExecuteQueryWithCacheShouldServeIdenticalQueries(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.SCQueryCacheConfig{Enabled: true, TTLInMilliseconds: 1000}
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, cfg, config.SCQueryGuardConfig{})
+	require.Nil(t, processor)
+	require.True(t, errors.Is(err, ErrInvalidSCQueryCacheConfig))
+
+	numCalls := 0
+	cfg.MaxEntries = 10
+	processor, _ = NewSCQueryProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+			return 0, nil
+		},
+		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+			return []*data.NodeData{
+				{Address: "address1", ShardId: 0},
+			}, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			numCalls++
+			response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{
+				ReturnData: [][]byte{{byte(numCalls)}},
+			}
+			response.(*data.ResponseVmValue).Data.BlockInfo = data.BlockInfo{Nonce: 100}
+
+			return http.StatusOK, nil
+		},
+	}, testPubKeyConverter, cfg, config.SCQueryGuardConfig{})
+
+	query := &data.SCQuery{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		Arguments: [][]byte{[]byte("aa")},
+	}
+	for i := 0; i < 3; i++ {
+		value, blockInfo, err := processor.ExecuteQuery(context.Background(), query)
+		require.Nil(t, err)
+		require.Equal(t, byte(1), value.ReturnData[0][0])
+		require.Equal(t, uint64(100), blockInfo.Nonce)
+	}
+	require.Equal(t, 1, numCalls)
+
+	query.Arguments = [][]byte{[]byte("bb")}
+	value, _, err := processor.ExecuteQuery(context.Background(), query)
+	require.Nil(t, err)
+	require.Equal(t, byte(2), value.ReturnData[0][0])
+	require.Equal(t, 2, numCalls)
+}
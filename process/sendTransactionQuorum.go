@@ -0,0 +1,125 @@
+package process
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type sendTransactionResult struct {
+	observer   string
+	statusCode int
+	txHash     string
+	err        error
+}
+
+func (result *sendTransactionResult) isAcknowledged() bool {
+	return result.statusCode == http.StatusOK && result.err == nil
+}
+
+// isObserverUnavailable returns true if the observer was down or didn't respond in time, so the next observer can be tried
+func (result *sendTransactionResult) isObserverUnavailable() bool {
+	return result.statusCode == http.StatusNotFound || result.statusCode == http.StatusRequestTimeout
+}
+
+func checkSendTransactionQuorumConfig(cfg config.SendTransactionQuorumConfig) error {
+	if cfg.NumObservers < 0 {
+		return fmt.Errorf("%w, NumObservers: %d", ErrInvalidSendTransactionQuorum, cfg.NumObservers)
+	}
+	if cfg.NumObservers <= 1 {
+		return nil
+	}
+	if cfg.MinAcknowledgements < 1 || cfg.MinAcknowledgements > cfg.NumObservers {
+		return fmt.Errorf("%w, MinAcknowledgements: %d, NumObservers: %d",
+			ErrInvalidSendTransactionQuorum, cfg.MinAcknowledgements, cfg.NumObservers)
+	}
+
+	return nil
+}
+
+// sendTransactionWithQuorum posts the transaction, in parallel, to the configured number of observers from the shard.
+// The unavailable observers are replaced by the next ones from the list. The transaction is considered sent if at
+// least the configured minimum number of observers accepted it
+func (tp *TransactionProcessor) sendTransactionWithQuorum(tx *data.Transaction, shardID uint32, observers []*data.NodeData) (int, string, error) {
+	numAcknowledgements := 0
+	txHash := ""
+	var rejection *sendTransactionResult
+	remainingObservers := observers
+	for numAcknowledgements < tp.sendTxQuorum.NumObservers && len(remainingObservers) > 0 {
+		numObserversToCall := tp.sendTxQuorum.NumObservers - numAcknowledgements
+		if numObserversToCall > len(remainingObservers) {
+			numObserversToCall = len(remainingObservers)
+		}
+
+		results := tp.sendTransactionToObservers(tx, remainingObservers[:numObserversToCall])
+		remainingObservers = remainingObservers[numObserversToCall:]
+		for _, result := range results {
+			switch {
+			case result.isAcknowledged():
+				numAcknowledgements++
+				if len(txHash) > 0 && txHash != result.txHash {
+					log.Warn("observers computed different hashes for the same transaction",
+						"observer", result.observer, "hash", result.txHash, "previous hash", txHash)
+				}
+				txHash = result.txHash
+				tp.sentTxsRecorder.RecordSentTransaction(result.txHash, result.observer)
+			case result.isObserverUnavailable():
+				log.LogIfError(result.err)
+			default:
+				log.Debug("transaction rejected by observer", "observer", result.observer, "status code", result.statusCode, "error", result.err)
+				if rejection == nil {
+					rejection = result
+				}
+			}
+		}
+
+		// the transaction is invalid, there is no reason to post it to other observers
+		if rejection != nil {
+			break
+		}
+	}
+
+	if numAcknowledgements >= tp.sendTxQuorum.MinAcknowledgements {
+		log.Info(fmt.Sprintf("Transaction sent successfully to %d observers from shard %v, received tx hash %s",
+			numAcknowledgements,
+			shardID,
+			txHash,
+		))
+		return http.StatusOK, txHash, nil
+	}
+	if rejection != nil && numAcknowledgements == 0 {
+		return rejection.statusCode, "", rejection.err
+	}
+
+	return http.StatusInternalServerError, "", fmt.Errorf("%w, acknowledged by %d observers out of the required %d",
+		ErrSendTransactionQuorumNotReached, numAcknowledgements, tp.sendTxQuorum.MinAcknowledgements)
+}
+
+func (tp *TransactionProcessor) sendTransactionToObservers(tx *data.Transaction, observers []*data.NodeData) []*sendTransactionResult {
+	results := make([]*sendTransactionResult, len(observers))
+	wg := sync.WaitGroup{}
+	wg.Add(len(observers))
+	for idx, observer := range observers {
+		go func(idx int, observer *data.NodeData) {
+			defer wg.Done()
+
+			txResponse := data.ResponseTransaction{}
+			respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, TransactionSendPath, tx, &txResponse)
+			if respCode != http.StatusOK && err == nil {
+				err = WrapObserversError(txResponse.Error)
+			}
+			results[idx] = &sendTransactionResult{
+				observer:   observer.Address,
+				statusCode: respCode,
+				txHash:     txResponse.Data.TxHash,
+				err:        err,
+			}
+		}(idx, observer)
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,253 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	minShadowTrafficPercentage         = 1
+	maxShadowTrafficPercentage         = 100
+	defaultMaxConcurrentShadowRequests = 100
+	maxReportedShadowDifferences       = 10
+	rootJsonPath                       = "$"
+)
+
+// shadowTrafficHandler duplicates a percentage of the GET requests served by the observers towards the canary observers
+// of the same shard. The canary responses are compared against the original ones and the differences are only logged,
+// the canary responses are never returned to the clients
+type shadowTrafficHandler struct {
+	percentage      int
+	canaries        map[uint32][]*proxyData.NodeData
+	httpClients     *observersHttpClients
+	shardOfObserver func(address string) (uint32, bool)
+	randIntn        func(n int) int
+	chanSemaphore   chan struct{}
+}
+
+func newShadowTrafficHandler(
+	cfg config.ShadowTrafficConfig,
+	httpClients *observersHttpClients,
+	shardOfObserver func(address string) (uint32, bool),
+) (*shadowTrafficHandler, error) {
+	err := checkShadowTrafficConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	maxConcurrentRequests := cfg.MaxConcurrentRequests
+	if maxConcurrentRequests == 0 {
+		maxConcurrentRequests = defaultMaxConcurrentShadowRequests
+	}
+
+	canaries := make(map[uint32][]*proxyData.NodeData)
+	for _, canary := range cfg.CanaryObservers {
+		canaries[canary.ShardId] = append(canaries[canary.ShardId], canary)
+	}
+
+	return &shadowTrafficHandler{
+		percentage:      cfg.Percentage,
+		canaries:        canaries,
+		httpClients:     httpClients,
+		shardOfObserver: shardOfObserver,
+		randIntn:        rand.Intn,
+		chanSemaphore:   make(chan struct{}, maxConcurrentRequests),
+	}, nil
+}
+
+func checkShadowTrafficConfig(cfg config.ShadowTrafficConfig) error {
+	if cfg.Percentage < minShadowTrafficPercentage || cfg.Percentage > maxShadowTrafficPercentage {
+		return fmt.Errorf("%w, Percentage: %d", ErrInvalidShadowTrafficConfig, cfg.Percentage)
+	}
+	if cfg.MaxConcurrentRequests < 0 {
+		return fmt.Errorf("%w, MaxConcurrentRequests: %d", ErrInvalidShadowTrafficConfig, cfg.MaxConcurrentRequests)
+	}
+	if len(cfg.CanaryObservers) == 0 {
+		return fmt.Errorf("%w, no canary observer provided", ErrInvalidShadowTrafficConfig)
+	}
+	for _, canary := range cfg.CanaryObservers {
+		if canary == nil || len(canary.Address) == 0 {
+			return fmt.Errorf("%w, empty canary observer address", ErrInvalidShadowTrafficConfig)
+		}
+	}
+
+	return nil
+}
+
+// shadowGetRequest will asynchronously send the same GET request towards a canary observer from the shard of the
+// provided observer, if the request is sampled and the concurrency limit allows it
+func (sth *shadowTrafficHandler) shadowGetRequest(observerAddress string, path string, statusCode int, responseBody []byte) {
+	if sth.randIntn(maxShadowTrafficPercentage) >= sth.percentage {
+		return
+	}
+
+	shardID, found := sth.shardOfObserver(observerAddress)
+	if !found {
+		return
+	}
+	canaries := sth.canaries[shardID]
+	if len(canaries) == 0 {
+		return
+	}
+	canary := canaries[sth.randIntn(len(canaries))]
+
+	select {
+	case sth.chanSemaphore <- struct{}{}:
+	default:
+		log.Trace("shadow traffic: too many concurrent requests, skipping", "path", path)
+		return
+	}
+
+	go func() {
+		defer func() {
+			<-sth.chanSemaphore
+		}()
+
+		sth.compareWithCanary(canary.Address, observerAddress, path, statusCode, responseBody)
+	}()
+}
+
+func (sth *shadowTrafficHandler) compareWithCanary(
+	canaryAddress string,
+	observerAddress string,
+	path string,
+	statusCode int,
+	responseBody []byte,
+) {
+	canaryStatusCode, canaryResponseBody, err := sth.callCanary(canaryAddress, path)
+	if err != nil {
+		log.Warn("shadow traffic: canary request failed",
+			"path", path,
+			"observer", observerAddress,
+			"canary", canaryAddress,
+			"error", err.Error())
+		return
+	}
+
+	differences := computeJsonDifferences(responseBody, canaryResponseBody)
+	if statusCode == canaryStatusCode && len(differences) == 0 {
+		log.Debug("shadow traffic: canary response matches", "path", path, "canary", canaryAddress)
+		return
+	}
+
+	log.Warn("shadow traffic: canary response differs",
+		"path", path,
+		"observer", observerAddress,
+		"canary", canaryAddress,
+		"observer status code", statusCode,
+		"canary status code", canaryStatusCode,
+		"differences", differences)
+}
+
+func (sth *shadowTrafficHandler) callCanary(canaryAddress string, path string) (int, []byte, error) {
+	req, err := http.NewRequest("GET", canaryAddress+path, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "Multiversx Proxy / 1.0.0 <Shadowing requests towards canary nodes>")
+
+	resp, err := sth.httpClients.getClient(canaryAddress).Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("shadow traffic: close body", "error", errNotCritical.Error())
+		}
+	}()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return resp.StatusCode, responseBody, nil
+}
+
+// computeJsonDifferences returns the (dot separated) paths of the fields that differ between the two JSON documents.
+// The comparison does not depend on the fields order or on the formatting. At most maxReportedShadowDifferences paths
+// are returned
+func computeJsonDifferences(first []byte, second []byte) []string {
+	var firstValue, secondValue interface{}
+	errFirst := json.Unmarshal(first, &firstValue)
+	errSecond := json.Unmarshal(second, &secondValue)
+	if errFirst != nil || errSecond != nil {
+		if string(first) == string(second) {
+			return nil
+		}
+
+		return []string{"<raw body>"}
+	}
+
+	differences := make([]string, 0)
+	appendJsonDifferences(&differences, "", firstValue, secondValue)
+
+	return differences
+}
+
+func appendJsonDifferences(differences *[]string, path string, first interface{}, second interface{}) {
+	if len(*differences) >= maxReportedShadowDifferences {
+		return
+	}
+
+	firstMap, isFirstMap := first.(map[string]interface{})
+	secondMap, isSecondMap := second.(map[string]interface{})
+	if isFirstMap && isSecondMap {
+		keys := make(map[string]struct{}, len(firstMap))
+		for key := range firstMap {
+			keys[key] = struct{}{}
+		}
+		for key := range secondMap {
+			keys[key] = struct{}{}
+		}
+
+		sortedKeys := make([]string, 0, len(keys))
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+
+		for _, key := range sortedKeys {
+			appendJsonDifferences(differences, joinJsonPath(path, key), firstMap[key], secondMap[key])
+		}
+		return
+	}
+
+	firstSlice, isFirstSlice := first.([]interface{})
+	secondSlice, isSecondSlice := second.([]interface{})
+	if isFirstSlice && isSecondSlice && len(firstSlice) == len(secondSlice) {
+		for i := range firstSlice {
+			appendJsonDifferences(differences, joinJsonPath(path, strconv.Itoa(i)), firstSlice[i], secondSlice[i])
+		}
+		return
+	}
+
+	if reflect.DeepEqual(first, second) {
+		return
+	}
+	if len(path) == 0 {
+		path = rootJsonPath
+	}
+	*differences = append(*differences, path)
+}
+
+func joinJsonPath(path string, element string) string {
+	if len(path) == 0 {
+		return element
+	}
+
+	return path + "." + element
+}
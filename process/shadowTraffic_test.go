@@ -0,0 +1,158 @@
+package process_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createBaseProcessorWithShadowTraffic(shadowTrafficConfig config.ShadowTrafficConfig, observers []*data.NodeData) (*process.BaseProcessor, error) {
+	return process.NewBaseProcessor(process.ArgsBaseProcessor{
+		RequestTimeoutSec: 5,
+		ShardCoordinator:  &mock.ShardCoordinatorMock{},
+		ObserversProvider: &mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return observers
+			},
+		},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+		ShadowTrafficConfig:      shadowTrafficConfig,
+	})
+}
+
+func TestNewBaseProcessor_InvalidShadowTrafficConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	canaries := []*data.NodeData{{ShardId: 0, Address: "http://canary"}}
+	testCases := map[string]config.ShadowTrafficConfig{
+		"zero percentage":            {Enabled: true, Percentage: 0, CanaryObservers: canaries},
+		"percentage over 100":        {Enabled: true, Percentage: 101, CanaryObservers: canaries},
+		"negative concurrency limit": {Enabled: true, Percentage: 10, MaxConcurrentRequests: -1, CanaryObservers: canaries},
+		"no canary observers":        {Enabled: true, Percentage: 10},
+		"empty canary address":       {Enabled: true, Percentage: 10, CanaryObservers: []*data.NodeData{{ShardId: 0}}},
+	}
+
+	for name, shadowTrafficConfig := range testCases {
+		cfg := shadowTrafficConfig
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bp, err := createBaseProcessorWithShadowTraffic(cfg, nil)
+			require.Nil(t, bp)
+			require.True(t, errors.Is(err, process.ErrInvalidShadowTrafficConfig))
+		})
+	}
+
+	t.Run("disabled shadow traffic should not check the config", func(t *testing.T) {
+		t.Parallel()
+
+		bp, err := createBaseProcessorWithShadowTraffic(config.ShadowTrafficConfig{Enabled: false}, nil)
+		require.NotNil(t, bp)
+		require.Nil(t, err)
+	})
+}
+
+func TestBaseProcessor_CallGetRestEndPointWithShadowTraffic(t *testing.T) {
+	t.Parallel()
+
+	observerServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"nonce":1,"name":"observer"}`))
+	}))
+	defer observerServer.Close()
+
+	chanCanaryPaths := make(chan string, 10)
+	canaryServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		chanCanaryPaths <- req.URL.String()
+		_, _ = rw.Write([]byte(`{"nonce":1,"name":"canary"}`))
+	}))
+	defer canaryServer.Close()
+
+	t.Run("request towards an observer from a shard with canaries should be duplicated", func(t *testing.T) {
+		bp, err := createBaseProcessorWithShadowTraffic(
+			config.ShadowTrafficConfig{
+				Enabled:         true,
+				Percentage:      100,
+				CanaryObservers: []*data.NodeData{{ShardId: 1, Address: canaryServer.URL}},
+			},
+			[]*data.NodeData{{ShardId: 1, Address: observerServer.URL}},
+		)
+		require.Nil(t, err)
+
+		response := &testStruct{}
+		statusCode, err := bp.CallGetRestEndPoint(observerServer.URL, "/some/path?withData=true", response)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, &testStruct{Nonce: 1, Name: "observer"}, response)
+
+		select {
+		case path := <-chanCanaryPaths:
+			require.Equal(t, "/some/path?withData=true", path)
+		case <-time.After(time.Second):
+			require.Fail(t, "the request was not duplicated towards the canary observer")
+		}
+	})
+
+	t.Run("request towards an observer from a shard without canaries should not be duplicated", func(t *testing.T) {
+		bp, err := createBaseProcessorWithShadowTraffic(
+			config.ShadowTrafficConfig{
+				Enabled:         true,
+				Percentage:      100,
+				CanaryObservers: []*data.NodeData{{ShardId: 0, Address: canaryServer.URL}},
+			},
+			[]*data.NodeData{{ShardId: 1, Address: observerServer.URL}},
+		)
+		require.Nil(t, err)
+
+		response := &testStruct{}
+		_, err = bp.CallGetRestEndPoint(observerServer.URL, "/some/path", response)
+		require.Nil(t, err)
+		require.Equal(t, "observer", response.Name)
+
+		select {
+		case path := <-chanCanaryPaths:
+			require.Fail(t, "unexpected canary request", path)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+func TestComputeJsonDifferences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same documents with different formatting should not differ", func(t *testing.T) {
+		t.Parallel()
+
+		differences := process.ComputeJsonDifferences(
+			[]byte(`{"data":{"a":1,"b":[1,2]},"code":"successful"}`),
+			[]byte(`{ "code": "successful", "data": { "b": [1, 2], "a": 1 } }`),
+		)
+		require.Empty(t, differences)
+	})
+
+	t.Run("should return the paths of the different fields", func(t *testing.T) {
+		t.Parallel()
+
+		differences := process.ComputeJsonDifferences(
+			[]byte(`{"data":{"a":1,"b":[1,2],"c":"x"},"code":"successful"}`),
+			[]byte(`{"data":{"a":2,"b":[1,3],"d":"x"},"code":"successful"}`),
+		)
+		require.Equal(t, []string{"data.a", "data.b.1", "data.c", "data.d"}, differences)
+	})
+
+	t.Run("different root values should differ", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, []string{"$"}, process.ComputeJsonDifferences([]byte(`[1]`), []byte(`[1,2]`)))
+		require.Equal(t, []string{"<raw body>"}, process.ComputeJsonDifferences([]byte(`not json`), []byte(`{}`)))
+		require.Empty(t, process.ComputeJsonDifferences([]byte(`not json`), []byte(`not json`)))
+	})
+}
@@ -0,0 +1,101 @@
+package process
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// shardsTopologyTracker keeps the epoch and the shard reported by each observer on its last status check, so the
+// number of shards can be checked again once a new epoch starts and the observers can be moved to their new shards.
+// When the heartbeats are tracked as well, the shard found in the heartbeat of an observer, matched by its public key,
+// takes precedence over the shard reported on its status
+type shardsTopologyTracker struct {
+	mut              sync.Mutex
+	nodesShards      map[string]uint32
+	nodesPublicKeys  map[string]string
+	heartbeatsShards map[string]uint32
+	highestEpoch     uint32
+	checkedEpoch     uint32
+	isStarted        bool
+}
+
+func newShardsTopologyTracker() *shardsTopologyTracker {
+	return &shardsTopologyTracker{
+		nodesShards:      make(map[string]uint32),
+		nodesPublicKeys:  make(map[string]string),
+		heartbeatsShards: make(map[string]uint32),
+	}
+}
+
+// recordNodeStatus stores the epoch, the shard and the public key reported by the node on its last status check
+func (stt *shardsTopologyTracker) recordNodeStatus(address string, epoch uint32, shardID *uint32, publicKey string) {
+	stt.mut.Lock()
+	defer stt.mut.Unlock()
+
+	if epoch > stt.highestEpoch {
+		stt.highestEpoch = epoch
+	}
+	if shardID != nil {
+		stt.nodesShards[address] = *shardID
+	}
+	if len(publicKey) > 0 {
+		stt.nodesPublicKeys[address] = publicKey
+	}
+}
+
+// recordHeartbeats replaces the shards known from the heartbeats with the ones of the provided active heartbeats
+func (stt *shardsTopologyTracker) recordHeartbeats(heartbeats []data.PubKeyHeartbeat) {
+	heartbeatsShards := make(map[string]uint32, len(heartbeats))
+	for _, heartbeat := range heartbeats {
+		if !heartbeat.IsActive {
+			continue
+		}
+
+		heartbeatsShards[heartbeat.PublicKey] = heartbeat.ReceivedShardID
+	}
+
+	stt.mut.Lock()
+	stt.heartbeatsShards = heartbeatsShards
+	stt.mut.Unlock()
+}
+
+// getEpochToCheck returns the new epoch reported by the observers since the last check of the number of shards. The
+// first epoch seen is not returned, as the number of shards was fetched from the network on the proxy start
+func (stt *shardsTopologyTracker) getEpochToCheck() (uint32, bool) {
+	stt.mut.Lock()
+	defer stt.mut.Unlock()
+
+	if !stt.isStarted {
+		stt.isStarted = true
+		stt.checkedEpoch = stt.highestEpoch
+		return 0, false
+	}
+
+	return stt.highestEpoch, stt.highestEpoch > stt.checkedEpoch
+}
+
+// setEpochChecked marks the number of shards as checked for the provided epoch
+func (stt *shardsTopologyTracker) setEpochChecked(epoch uint32) {
+	stt.mut.Lock()
+	stt.checkedEpoch = epoch
+	stt.mut.Unlock()
+}
+
+// getNodeShard returns the shard found in the active heartbeat of the node, if any, otherwise the shard reported by
+// the node on its last status check
+func (stt *shardsTopologyTracker) getNodeShard(address string) (uint32, bool) {
+	stt.mut.Lock()
+	defer stt.mut.Unlock()
+
+	publicKey, hasPublicKey := stt.nodesPublicKeys[address]
+	if hasPublicKey {
+		shardID, found := stt.heartbeatsShards[publicKey]
+		if found {
+			return shardID, true
+		}
+	}
+
+	shardID, found := stt.nodesShards[address]
+	return shardID, found
+}
@@ -0,0 +1,75 @@
+package process
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardsTopologyTracker(t *testing.T) {
+	t.Parallel()
+
+	stt := newShardsTopologyTracker()
+	shardID := uint32(1)
+	stt.recordNodeStatus("addr0", 5, nil, "")
+	stt.recordNodeStatus("addr1", 4, &shardID, "")
+
+	// the first epoch seen should not trigger a check
+	_, shouldCheck := stt.getEpochToCheck()
+	require.False(t, shouldCheck)
+	_, found := stt.getNodeShard("addr0")
+	require.False(t, found)
+	reportedShardID, found := stt.getNodeShard("addr1")
+	require.True(t, found)
+	require.Equal(t, shardID, reportedShardID)
+
+	stt.recordNodeStatus("addr0", 6, nil, "")
+	epoch, shouldCheck := stt.getEpochToCheck()
+	require.True(t, shouldCheck)
+	require.Equal(t, uint32(6), epoch)
+
+	// not marked as checked, so it should be checked again
+	_, shouldCheck = stt.getEpochToCheck()
+	require.True(t, shouldCheck)
+
+	stt.setEpochChecked(6)
+	_, shouldCheck = stt.getEpochToCheck()
+	require.False(t, shouldCheck)
+}
+
+func TestShardsTopologyTracker_HeartbeatsShardShouldTakePrecedence(t *testing.T) {
+	t.Parallel()
+
+	stt := newShardsTopologyTracker()
+	shardID := uint32(1)
+	stt.recordNodeStatus("addr0", 5, &shardID, "pk0")
+	stt.recordNodeStatus("addr1", 5, &shardID, "pk1")
+	stt.recordNodeStatus("addr2", 5, nil, "pk2")
+	stt.recordHeartbeats([]data.PubKeyHeartbeat{
+		{PublicKey: "pk0", ReceivedShardID: 2, IsActive: true},
+		{PublicKey: "pk1", ReceivedShardID: 0, IsActive: false},
+		{PublicKey: "pk2", ReceivedShardID: 0, IsActive: true},
+	})
+
+	reportedShardID, found := stt.getNodeShard("addr0")
+	require.True(t, found)
+	require.Equal(t, uint32(2), reportedShardID)
+
+	// the inactive heartbeats are ignored
+	reportedShardID, found = stt.getNodeShard("addr1")
+	require.True(t, found)
+	require.Equal(t, uint32(1), reportedShardID)
+
+	reportedShardID, found = stt.getNodeShard("addr2")
+	require.True(t, found)
+	require.Equal(t, uint32(0), reportedShardID)
+
+	// the heartbeats are replaced on each update
+	stt.recordHeartbeats(nil)
+	reportedShardID, found = stt.getNodeShard("addr0")
+	require.True(t, found)
+	require.Equal(t, uint32(1), reportedShardID)
+	_, found = stt.getNodeShard("addr2")
+	require.False(t, found)
+}
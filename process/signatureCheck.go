@@ -0,0 +1,175 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// signatureChecker verifies the Ed25519 signatures of the transactions. The transactions are signed over their JSON
+// representation or, if the sign with hash option is set, over the Keccak hash of it, as the protocol does
+type signatureChecker struct {
+	keyGen     crypto.KeyGenerator
+	signer     crypto.SingleSigner
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// SetSignatureChecker enables the verification of the signatures of the transactions before relaying them, the
+// provided components being used to load the public keys and to verify the signatures
+func (tp *TransactionProcessor) SetSignatureChecker(keyGen crypto.KeyGenerator, signer crypto.SingleSigner) error {
+	if check.IfNil(keyGen) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(signer) {
+		return ErrNilSingleSigner
+	}
+
+	tp.signatureChecker = &signatureChecker{
+		keyGen:     keyGen,
+		signer:     signer,
+		marshaller: &marshal.JsonMarshalizer{},
+		hasher:     keccak.NewKeccak(),
+	}
+
+	return nil
+}
+
+// checkSignatures verifies the signature of the sender and, if provided, the signatures of the guardian and of the
+// relayer of the transaction. It does nothing unless the signature check is enabled
+func (tp *TransactionProcessor) checkSignatures(tx *data.Transaction) error {
+	if tp.signatureChecker == nil {
+		return nil
+	}
+
+	protocolTx, err := tp.createTransactionForSigning(tx)
+	if err != nil {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidSignature.Error(),
+			Reason:  err.Error(),
+		}
+	}
+	dataToSign, err := protocolTx.GetDataForSigning(tp.pubKeyConverter, tp.signatureChecker.marshaller, tp.signatureChecker.hasher)
+	if err != nil {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidSignature.Error(),
+			Reason:  err.Error(),
+		}
+	}
+
+	err = tp.signatureChecker.verify(protocolTx.SndAddr, dataToSign, protocolTx.Signature, "sender")
+	if err != nil {
+		return err
+	}
+	if len(protocolTx.GuardianSignature) > 0 {
+		err = tp.signatureChecker.verify(protocolTx.GuardianAddr, dataToSign, protocolTx.GuardianSignature, "guardian")
+		if err != nil {
+			return err
+		}
+	}
+	if len(protocolTx.RelayerSignature) > 0 {
+		return tp.signatureChecker.verify(protocolTx.RelayerAddr, dataToSign, protocolTx.RelayerSignature, "relayer")
+	}
+
+	return nil
+}
+
+func (sc *signatureChecker) verify(signerAddress []byte, dataToSign []byte, signature []byte, signerRole string) error {
+	if len(signature) == 0 {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidSignature.Error(),
+			Reason:  fmt.Sprintf("missing %s signature", signerRole),
+		}
+	}
+
+	publicKey, err := sc.keyGen.PublicKeyFromByteArray(signerAddress)
+	if err != nil {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidSignature.Error(),
+			Reason:  fmt.Sprintf("invalid %s public key: %s", signerRole, err.Error()),
+		}
+	}
+
+	err = sc.signer.Verify(publicKey, dataToSign, signature)
+	if err != nil {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidSignature.Error(),
+			Reason:  fmt.Sprintf("%s signature does not match the transaction: %s", signerRole, err.Error()),
+		}
+	}
+
+	return nil
+}
+
+// createTransactionForSigning returns the protocol transaction holding the fields covered by the signatures, along with
+// the signatures themselves
+func (tp *TransactionProcessor) createTransactionForSigning(tx *data.Transaction) (*transaction.Transaction, error) {
+	valueBig, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return nil, ErrInvalidTransactionValueField
+	}
+	receiverAddress, err := tp.pubKeyConverter.Decode(tx.Receiver)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	senderAddress, err := tp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	signatureBytes, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return nil, ErrInvalidSignatureBytes
+	}
+
+	protocolTx := &transaction.Transaction{
+		Nonce:       tx.Nonce,
+		Value:       valueBig,
+		RcvAddr:     receiverAddress,
+		RcvUserName: tx.ReceiverUsername,
+		SndAddr:     senderAddress,
+		SndUserName: tx.SenderUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     []byte(tx.ChainID),
+		Version:     tx.Version,
+		Signature:   signatureBytes,
+		Options:     tx.Options,
+	}
+
+	if len(tx.GuardianAddr) > 0 {
+		protocolTx.GuardianAddr, err = tp.pubKeyConverter.Decode(tx.GuardianAddr)
+		if err != nil {
+			return nil, errors.ErrInvalidGuardianAddress
+		}
+	}
+	if len(tx.GuardianSignature) > 0 {
+		protocolTx.GuardianSignature, err = hex.DecodeString(tx.GuardianSignature)
+		if err != nil {
+			return nil, errors.ErrInvalidGuardianSignatureHex
+		}
+	}
+	if len(tx.RelayerAddr) > 0 {
+		protocolTx.RelayerAddr, err = tp.pubKeyConverter.Decode(tx.RelayerAddr)
+		if err != nil {
+			return nil, ErrInvalidAddress
+		}
+	}
+	if len(tx.RelayerSignature) > 0 {
+		protocolTx.RelayerSignature, err = hex.DecodeString(tx.RelayerSignature)
+		if err != nil {
+			return nil, ErrInvalidSignatureBytes
+		}
+	}
+
+	return protocolTx, nil
+}
@@ -0,0 +1,210 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	validatorContractAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqplllst77y4l"
+	totalStakedTopUpFunc     = "getTotalStakedTopUpStakedBlsKeys"
+	userActiveStakeFunc      = "getUserActiveStake"
+	userUnStakedValueFunc    = "getUserUnStakedValue"
+	claimableRewardsFunc     = "getClaimableRewards"
+	vmOutputOkReturnCode     = "ok"
+
+	// MaxStakingProvidersInPortfolio defines the maximum number of staking providers that can be queried at once
+	MaxStakingProvidersInPortfolio = 50
+)
+
+var delegationPositionFuncs = []string{userActiveStakeFunc, userUnStakedValueFunc, claimableRewardsFunc}
+
+type stakingPortfolioProcessor struct {
+	scQueryProc               SCQueryService
+	pubKeyConverter           core.PubkeyConverter
+	legacyDelegationContracts []string
+}
+
+// NewStakingPortfolioProcessor will create a new instance of the staking portfolio processor. An empty legacy delegation
+// contract address means that the legacy delegation position won't be queried
+func NewStakingPortfolioProcessor(
+	scQueryProc SCQueryService,
+	pubKeyConverter core.PubkeyConverter,
+	legacyDelegationContractAddress string,
+) (*stakingPortfolioProcessor, error) {
+	if check.IfNil(scQueryProc) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	legacyDelegationContracts := make([]string, 0, 1)
+	if len(legacyDelegationContractAddress) > 0 {
+		_, err := pubKeyConverter.Decode(legacyDelegationContractAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the legacy delegation contract: %s", ErrInvalidAddress, err.Error())
+		}
+
+		legacyDelegationContracts = append(legacyDelegationContracts, legacyDelegationContractAddress)
+	}
+
+	return &stakingPortfolioProcessor{
+		scQueryProc:               scQueryProc,
+		pubKeyConverter:           pubKeyConverter,
+		legacyDelegationContracts: legacyDelegationContracts,
+	}, nil
+}
+
+// GetStakingPortfolio returns the consolidated staking positions of the provided address: the validator stake, the
+// legacy delegation position and the positions held in the provided staking providers. All the needed smart contract
+// queries are executed in parallel
+func (spp *stakingPortfolioProcessor) GetStakingPortfolio(address string, stakingProviders []string) (*data.GenericAPIResponse, error) {
+	if len(stakingProviders) > MaxStakingProvidersInPortfolio {
+		return nil, fmt.Errorf("%w, provided %d, maximum %d", ErrTooManyStakingProviders, len(stakingProviders), MaxStakingProvidersInPortfolio)
+	}
+
+	addressBytes, err := spp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+	for _, provider := range stakingProviders {
+		_, err = spp.pubKeyConverter.Decode(provider)
+		if err != nil {
+			return nil, fmt.Errorf("%w for staking provider %s: %s", ErrInvalidAddress, provider, err.Error())
+		}
+	}
+
+	delegationContracts := make([]string, 0, len(spp.legacyDelegationContracts)+len(stakingProviders))
+	delegationContracts = append(delegationContracts, spp.legacyDelegationContracts...)
+	delegationContracts = append(delegationContracts, stakingProviders...)
+	queries := make([]*data.SCQuery, 0, 1+len(delegationContracts)*len(delegationPositionFuncs))
+	queries = append(queries, &data.SCQuery{
+		ScAddress:  validatorContractAddress,
+		FuncName:   totalStakedTopUpFunc,
+		CallerAddr: validatorContractAddress,
+		Arguments:  [][]byte{addressBytes},
+	})
+	for _, contract := range delegationContracts {
+		for _, funcName := range delegationPositionFuncs {
+			queries = append(queries, &data.SCQuery{
+				ScAddress: contract,
+				FuncName:  funcName,
+				Arguments: [][]byte{addressBytes},
+			})
+		}
+	}
+
+	outputs, err := executeSCQueriesInParallel(spp.scQueryProc, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorStake, totalStaked := newValidatorStakePosition(outputs[0])
+	portfolio := &data.StakingPortfolio{
+		Address:        address,
+		ValidatorStake: validatorStake,
+		Delegations:    make([]*data.DelegationPosition, 0, len(stakingProviders)),
+	}
+	totalActiveStake := big.NewInt(0).Set(totalStaked)
+
+	outputIndex := 1
+	for i, contract := range delegationContracts {
+		position, activeStake := newDelegationPosition(contract, outputs[outputIndex:outputIndex+len(delegationPositionFuncs)])
+		outputIndex += len(delegationPositionFuncs)
+		totalActiveStake.Add(totalActiveStake, activeStake)
+
+		isLegacyDelegation := i < len(spp.legacyDelegationContracts)
+		if isLegacyDelegation {
+			portfolio.LegacyDelegation = position
+			continue
+		}
+
+		portfolio.Delegations = append(portfolio.Delegations, position)
+	}
+	portfolio.TotalActiveStake = totalActiveStake.String()
+
+	return &data.GenericAPIResponse{
+		Data:  data.StakingPortfolioResponseData{Portfolio: portfolio},
+		Error: "",
+		Code:  data.ReturnCodeSuccess,
+	}, nil
+}
+
+// executeSCQueriesInParallel executes the provided queries in parallel, returning their outputs in the same order
+func executeSCQueriesInParallel(scQueryProc SCQueryService, queries []*data.SCQuery) ([]*vm.VMOutputApi, error) {
+	outputs := make([]*vm.VMOutputApi, len(queries))
+	errs := make([]error, len(queries))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(queries))
+	for i := range queries {
+		go func(idx int) {
+			defer wg.Done()
+
+			outputs[idx], _, errs[idx] = scQueryProc.ExecuteQuery(context.Background(), queries[idx])
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("%w while executing %s on %s", err, queries[i].FuncName, queries[i].ScAddress)
+		}
+	}
+
+	return outputs, nil
+}
+
+// newValidatorStakePosition parses the output of the getTotalStakedTopUpStakedBlsKeys function, which returns the top up,
+// the total staked value, the number of nodes and their BLS keys. A failed query means that the address is not a validator
+func newValidatorStakePosition(output *vm.VMOutputApi) (*data.ValidatorStakePosition, *big.Int) {
+	if !isSuccessfulVMOutput(output) || len(output.ReturnData) < 3 {
+		return &data.ValidatorStakePosition{
+			TotalStaked: zeroBigIntStr,
+			TopUp:       zeroBigIntStr,
+		}, big.NewInt(0)
+	}
+
+	totalStaked := big.NewInt(0).SetBytes(output.ReturnData[1])
+	return &data.ValidatorStakePosition{
+		TotalStaked: totalStaked.String(),
+		TopUp:       big.NewInt(0).SetBytes(output.ReturnData[0]).String(),
+		NumNodes:    big.NewInt(0).SetBytes(output.ReturnData[2]).Uint64(),
+	}, totalStaked
+}
+
+// newDelegationPosition builds the position held in a delegation contract from the outputs of the functions defined
+// in delegationPositionFuncs. A failed query means that the address is not a delegator of that contract
+func newDelegationPosition(contract string, outputs []*vm.VMOutputApi) (*data.DelegationPosition, *big.Int) {
+	values := make([]*big.Int, len(outputs))
+	for i, output := range outputs {
+		values[i] = big.NewInt(0)
+		if isSuccessfulVMOutput(output) && len(output.ReturnData) > 0 {
+			values[i].SetBytes(output.ReturnData[0])
+		}
+	}
+
+	return &data.DelegationPosition{
+		Contract:         contract,
+		ActiveStake:      values[0].String(),
+		UnStakedValue:    values[1].String(),
+		ClaimableRewards: values[2].String(),
+	}, values[0]
+}
+
+func isSuccessfulVMOutput(output *vm.VMOutputApi) bool {
+	return output != nil && output.ReturnCode == vmOutputOkReturnCode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (spp *stakingPortfolioProcessor) IsInterfaceNil() bool {
+	return spp == nil
+}
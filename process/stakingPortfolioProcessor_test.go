@@ -0,0 +1,170 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDelegatorAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testLegacyDelegation = "erd1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q6shuwt"
+	testStakingProvider  = "erd1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpgqqqqqqqqqlllssjlzkn"
+)
+
+func TestNewStakingPortfolioProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sc query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		spp, err := process.NewStakingPortfolioProcessor(nil, testPubkeyConverter, "")
+		require.True(t, check.IfNil(spp))
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		spp, err := process.NewStakingPortfolioProcessor(&mock.SCQueryServiceStub{}, nil, "")
+		require.True(t, check.IfNil(spp))
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("invalid legacy delegation address should error", func(t *testing.T) {
+		t.Parallel()
+
+		spp, err := process.NewStakingPortfolioProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, "invalid")
+		require.True(t, check.IfNil(spp))
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		spp, err := process.NewStakingPortfolioProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, testLegacyDelegation)
+		require.False(t, check.IfNil(spp))
+		require.NoError(t, err)
+	})
+}
+
+func TestStakingPortfolioProcessor_GetStakingPortfolio(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many staking providers should error", func(t *testing.T) {
+		t.Parallel()
+
+		spp, _ := process.NewStakingPortfolioProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, "")
+		providers := make([]string, process.MaxStakingProvidersInPortfolio+1)
+		response, err := spp.GetStakingPortfolio(testDelegatorAddress, providers)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrTooManyStakingProviders))
+	})
+
+	t.Run("invalid staking provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		spp, _ := process.NewStakingPortfolioProcessor(&mock.SCQueryServiceStub{}, testPubkeyConverter, "")
+		response, err := spp.GetStakingPortfolio(testDelegatorAddress, []string{"invalid"})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if query.FuncName == "getClaimableRewards" {
+					return nil, data.BlockInfo{}, expectedErr
+				}
+
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{}, nil
+			},
+		}
+		spp, _ := process.NewStakingPortfolioProcessor(scQueryStub, testPubkeyConverter, testLegacyDelegation)
+		response, err := spp.GetStakingPortfolio(testDelegatorAddress, nil)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+
+	t.Run("should aggregate all the positions", func(t *testing.T) {
+		t.Parallel()
+
+		mutQueries := sync.Mutex{}
+		executedQueries := make([]string, 0)
+		scQueryStub := &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				mutQueries.Lock()
+				executedQueries = append(executedQueries, query.ScAddress+"/"+query.FuncName)
+				mutQueries.Unlock()
+
+				delegatorBytes, _ := testPubkeyConverter.Decode(testDelegatorAddress)
+				require.Equal(t, [][]byte{delegatorBytes}, query.Arguments)
+
+				switch {
+				case query.FuncName == "getTotalStakedTopUpStakedBlsKeys":
+					require.Equal(t, query.ScAddress, query.CallerAddr)
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{big.NewInt(100).Bytes(), big.NewInt(5100).Bytes(), big.NewInt(2).Bytes(), []byte("bls1"), []byte("bls2")},
+					}, data.BlockInfo{}, nil
+				case query.ScAddress == testLegacyDelegation:
+					// not a delegator of the legacy delegation contract
+					return &vm.VMOutputApi{ReturnCode: "user error"}, data.BlockInfo{}, nil
+				case query.FuncName == "getUserActiveStake":
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{big.NewInt(1000).Bytes()}}, data.BlockInfo{}, nil
+				case query.FuncName == "getUserUnStakedValue":
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{big.NewInt(50).Bytes()}}, data.BlockInfo{}, nil
+				default:
+					return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{big.NewInt(7).Bytes()}}, data.BlockInfo{}, nil
+				}
+			},
+		}
+		spp, _ := process.NewStakingPortfolioProcessor(scQueryStub, testPubkeyConverter, testLegacyDelegation)
+		response, err := spp.GetStakingPortfolio(testDelegatorAddress, []string{testStakingProvider})
+		require.NoError(t, err)
+		require.Equal(t, data.ReturnCodeSuccess, response.Code)
+		require.Len(t, executedQueries, 7)
+
+		expectedPortfolio := &data.StakingPortfolio{
+			Address:        testDelegatorAddress,
+			ValidatorStake: &data.ValidatorStakePosition{TotalStaked: "5100", TopUp: "100", NumNodes: 2},
+			LegacyDelegation: &data.DelegationPosition{
+				Contract:         testLegacyDelegation,
+				ActiveStake:      "0",
+				UnStakedValue:    "0",
+				ClaimableRewards: "0",
+			},
+			Delegations: []*data.DelegationPosition{
+				{
+					Contract:         testStakingProvider,
+					ActiveStake:      "1000",
+					UnStakedValue:    "50",
+					ClaimableRewards: "7",
+				},
+			},
+			TotalActiveStake: "6100",
+		}
+		require.Equal(t, expectedPortfolio, response.Data.(data.StakingPortfolioResponseData).Portfolio)
+
+		numLegacyQueries := 0
+		for _, query := range executedQueries {
+			if strings.HasPrefix(query, testLegacyDelegation) {
+				numLegacyQueries++
+			}
+		}
+		require.Equal(t, 3, numLegacyQueries)
+	})
+}
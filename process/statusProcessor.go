@@ -31,6 +31,11 @@ func (sp *StatusProcessor) GetMetrics() map[string]*data.EndpointMetrics {
 	return sp.statusMetricsProvider.GetAll()
 }
 
+// GetCachesMetrics returns the hits, the misses and the evictions of each cache
+func (sp *StatusProcessor) GetCachesMetrics() map[string]*data.CacheMetrics {
+	return sp.statusMetricsProvider.GetCachesMetrics()
+}
+
 // GetMetricsForPrometheus returns the metrics in a prometheus format
 func (sp *StatusProcessor) GetMetricsForPrometheus() string {
 	return sp.statusMetricsProvider.GetMetricsForPrometheus()
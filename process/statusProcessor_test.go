@@ -74,3 +74,20 @@ func TestStatusProcessor_GetMetricsForPrometheus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, metrics)
 }
+
+func TestStatusProcessor_GetCachesMetrics(t *testing.T) {
+	t.Parallel()
+
+	expectedMetrics := map[string]*data.CacheMetrics{
+		"sc-query": {NumHits: 3, NumMisses: 1, HitRatio: 0.75},
+	}
+	statusProvider := &mock.StatusMetricsProviderStub{
+		GetCachesMetricsCalled: func() map[string]*data.CacheMetrics {
+			return expectedMetrics
+		},
+	}
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedMetrics, sp.GetCachesMetrics())
+}
@@ -0,0 +1,131 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	esdtTransferFunc         = "ESDTTransfer"
+	esdtNFTTransferFunc      = "ESDTNFTTransfer"
+	multiESDTNFTTransferFunc = "MultiESDTNFTTransfer"
+
+	tokenIdentifierSeparator = "-"
+)
+
+// decodeBlockTokenTransfers returns the token transfers of the successful transactions of the block, mapped by the
+// transaction hash. The transactions without token transfers are not included
+func decodeBlockTokenTransfers(block *api.Block, pubKeyConverter core.PubkeyConverter) map[string][]*data.TokenTransfer {
+	tokenTransfers := make(map[string][]*data.TokenTransfer)
+	for _, miniBlock := range block.MiniBlocks {
+		for _, tx := range miniBlock.Transactions {
+			if tx.Status == transaction.TxStatusFail || tx.Status == transaction.TxStatusInvalid {
+				continue
+			}
+
+			transfers, err := decodeTokenTransfers(tx, pubKeyConverter)
+			if err != nil {
+				log.Debug("cannot decode token transfers", "txHash", tx.Hash, "error", err)
+				continue
+			}
+			if len(transfers) > 0 {
+				tokenTransfers[tx.Hash] = transfers
+			}
+		}
+	}
+
+	return tokenTransfers
+}
+
+// decodeTokenTransfers decodes the ESDTTransfer, ESDTNFTTransfer and MultiESDTNFTTransfer calls from the data field of
+// the transaction. For the NFT transfers, the transaction is sent to the sender itself, the real receiver being an
+// argument of the call
+func decodeTokenTransfers(tx *transaction.ApiTransactionResult, pubKeyConverter core.PubkeyConverter) ([]*data.TokenTransfer, error) {
+	tokens := strings.Split(string(tx.Data), esdtArgumentsSeparator)
+	function := tokens[0]
+	if function != esdtTransferFunc && function != esdtNFTTransferFunc && function != multiESDTNFTTransferFunc {
+		return nil, nil
+	}
+
+	arguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		argument, err := hex.DecodeString(token)
+		if err != nil {
+			return nil, err
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	switch function {
+	case esdtTransferFunc:
+		if len(arguments) < 2 {
+			return nil, fmt.Errorf("%s: not enough arguments", function)
+		}
+
+		return []*data.TokenTransfer{newTokenTransfer(arguments[0], nil, arguments[1], tx.Sender, tx.Receiver)}, nil
+	case esdtNFTTransferFunc:
+		if len(arguments) < 4 {
+			return nil, fmt.Errorf("%s: not enough arguments", function)
+		}
+
+		receiver, err := pubKeyConverter.Encode(arguments[3])
+		if err != nil {
+			return nil, err
+		}
+
+		return []*data.TokenTransfer{newTokenTransfer(arguments[0], arguments[1], arguments[2], tx.Sender, receiver)}, nil
+	default:
+		return decodeMultiTokenTransfers(arguments, tx.Sender, pubKeyConverter)
+	}
+}
+
+// decodeMultiTokenTransfers decodes the receiver@numTransfers@(token@nonce@amount)... arguments of a
+// MultiESDTNFTTransfer call
+func decodeMultiTokenTransfers(arguments [][]byte, sender string, pubKeyConverter core.PubkeyConverter) ([]*data.TokenTransfer, error) {
+	if len(arguments) < 2 {
+		return nil, fmt.Errorf("%s: not enough arguments", multiESDTNFTTransferFunc)
+	}
+
+	receiver, err := pubKeyConverter.Encode(arguments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	numTransfers := big.NewInt(0).SetBytes(arguments[1]).Uint64()
+	if uint64(len(arguments)-2) < numTransfers*3 {
+		return nil, fmt.Errorf("%s: not enough arguments for %d transfers", multiESDTNFTTransferFunc, numTransfers)
+	}
+
+	transfers := make([]*data.TokenTransfer, 0, numTransfers)
+	for i := uint64(0); i < numTransfers; i++ {
+		transferArguments := arguments[2+i*3 : 5+i*3]
+		transfers = append(transfers, newTokenTransfer(transferArguments[0], transferArguments[1], transferArguments[2], sender, receiver))
+	}
+
+	return transfers, nil
+}
+
+func newTokenTransfer(token []byte, nonceBytes []byte, amount []byte, sender string, receiver string) *data.TokenTransfer {
+	nonce := big.NewInt(0).SetBytes(nonceBytes).Uint64()
+	identifier := string(token)
+	if nonce > 0 {
+		identifier += tokenIdentifierSeparator + hex.EncodeToString(big.NewInt(0).SetUint64(nonce).Bytes())
+	}
+
+	return &data.TokenTransfer{
+		Token:      string(token),
+		Identifier: identifier,
+		Nonce:      nonce,
+		Amount:     big.NewInt(0).SetBytes(amount).String(),
+		Sender:     sender,
+		Receiver:   receiver,
+	}
+}
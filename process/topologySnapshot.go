@@ -0,0 +1,129 @@
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const topologySnapshotFilePermissions = 0644
+
+// topologySnapshotHandler persists to disk the knowledge the proxy gathered about its observers (the sync state, the
+// last response of each observer and their shards), so a restarted proxy can resume from it instead of probing all
+// the observers at once
+type topologySnapshotHandler struct {
+	filePath       string
+	saveInterval   time.Duration
+	maxAge         time.Duration
+	getTimeHandler func() time.Time
+}
+
+func newTopologySnapshotHandler(cfg config.TopologySnapshotConfig) (*topologySnapshotHandler, error) {
+	if len(cfg.FilePath) == 0 {
+		return nil, fmt.Errorf("%w, empty FilePath", ErrInvalidTopologySnapshotConfig)
+	}
+	if cfg.SaveIntervalInSec <= 0 {
+		return nil, fmt.Errorf("%w, SaveIntervalInSec: %d", ErrInvalidTopologySnapshotConfig, cfg.SaveIntervalInSec)
+	}
+	if cfg.MaxAgeInSec <= 0 {
+		return nil, fmt.Errorf("%w, MaxAgeInSec: %d", ErrInvalidTopologySnapshotConfig, cfg.MaxAgeInSec)
+	}
+
+	return &topologySnapshotHandler{
+		filePath:       cfg.FilePath,
+		saveInterval:   time.Duration(cfg.SaveIntervalInSec) * time.Second,
+		maxAge:         time.Duration(cfg.MaxAgeInSec) * time.Second,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// save writes the snapshot in a temporary file first, so a crash while saving does not corrupt the previous snapshot
+func (tsh *topologySnapshotHandler) save(snapshot *proxyData.TopologySnapshot) error {
+	snapshot.Timestamp = tsh.getTimeHandler().Unix()
+	snapshotBytes, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(tsh.filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := tsh.filePath + ".tmp"
+	err = os.WriteFile(tempFilePath, snapshotBytes, topologySnapshotFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, tsh.filePath)
+}
+
+// load reads the snapshot, rejecting it if it is older than the configured maximum age
+func (tsh *topologySnapshotHandler) load() (*proxyData.TopologySnapshot, error) {
+	snapshotBytes, err := os.ReadFile(tsh.filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &proxyData.TopologySnapshot{}
+	err = json.Unmarshal(snapshotBytes, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	age := tsh.getTimeHandler().Sub(time.Unix(snapshot.Timestamp, 0))
+	if age > tsh.maxAge {
+		return nil, fmt.Errorf("%w, age: %v, max age: %v", ErrTopologySnapshotTooOld, age.Truncate(time.Second), tsh.maxAge)
+	}
+
+	return snapshot, nil
+}
+
+func createNodesSnapshot(nodes []*proxyData.NodeData, responses *observersResponsesTracker) []*proxyData.NodeSnapshot {
+	nodesSnapshot := make([]*proxyData.NodeSnapshot, 0, len(nodes))
+	for _, node := range nodes {
+		nodeSnapshot := &proxyData.NodeSnapshot{
+			Address:        node.Address,
+			ShardID:        node.ShardId,
+			IsSynced:       node.IsSynced,
+			IsFallback:     node.IsFallback,
+			IsSnapshotless: node.IsSnapshotless,
+		}
+		lastResponse, found := responses.getLastResponseTime(node.Address)
+		if found {
+			nodeSnapshot.LastResponseTimestamp = lastResponse.Unix()
+		}
+
+		nodesSnapshot = append(nodesSnapshot, nodeSnapshot)
+	}
+
+	return nodesSnapshot
+}
+
+// restoreNodesSyncState applies the sync state from the snapshot on the provided nodes and returns true if all of them
+// were found in the snapshot, in the same shard. A node moved to another shard keeps its current state
+func restoreNodesSyncState(nodes []*proxyData.NodeData, nodesSnapshot []*proxyData.NodeSnapshot) bool {
+	snapshotsByAddress := make(map[string]*proxyData.NodeSnapshot, len(nodesSnapshot))
+	for _, nodeSnapshot := range nodesSnapshot {
+		snapshotsByAddress[nodeSnapshot.Address] = nodeSnapshot
+	}
+
+	areAllNodesRestored := true
+	for _, node := range nodes {
+		nodeSnapshot, found := snapshotsByAddress[node.Address]
+		if !found || nodeSnapshot.ShardID != node.ShardId {
+			areAllNodesRestored = false
+			continue
+		}
+
+		node.IsSynced = nodeSnapshot.IsSynced
+	}
+
+	return areAllNodesRestored
+}
@@ -0,0 +1,188 @@
+package process
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTopologySnapshotConfig(dir string) config.TopologySnapshotConfig {
+	return config.TopologySnapshotConfig{
+		Enabled:           true,
+		FilePath:          filepath.Join(dir, "snapshots", "topology.json"),
+		SaveIntervalInSec: 60,
+		MaxAgeInSec:       300,
+	}
+}
+
+func TestNewTopologySnapshotHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTopologySnapshotConfig(t.TempDir())
+		cfg.FilePath = ""
+		tsh, err := newTopologySnapshotHandler(cfg)
+		assert.Nil(t, tsh)
+		assert.ErrorIs(t, err, ErrInvalidTopologySnapshotConfig)
+	})
+	t.Run("invalid save interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTopologySnapshotConfig(t.TempDir())
+		cfg.SaveIntervalInSec = 0
+		tsh, err := newTopologySnapshotHandler(cfg)
+		assert.Nil(t, tsh)
+		assert.ErrorIs(t, err, ErrInvalidTopologySnapshotConfig)
+	})
+	t.Run("invalid max age should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTopologySnapshotConfig(t.TempDir())
+		cfg.MaxAgeInSec = -1
+		tsh, err := newTopologySnapshotHandler(cfg)
+		assert.Nil(t, tsh)
+		assert.ErrorIs(t, err, ErrInvalidTopologySnapshotConfig)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tsh, err := newTopologySnapshotHandler(createTopologySnapshotConfig(t.TempDir()))
+		assert.NotNil(t, tsh)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTopologySnapshotHandler_SaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsh, _ := newTopologySnapshotHandler(createTopologySnapshotConfig(t.TempDir()))
+		snapshot, err := tsh.load()
+		assert.Nil(t, snapshot)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("too old snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		tsh, _ := newTopologySnapshotHandler(createTopologySnapshotConfig(t.TempDir()))
+		tsh.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		err := tsh.save(&proxyData.TopologySnapshot{NumShards: 3})
+		require.NoError(t, err)
+
+		currentTime = currentTime.Add(301 * time.Second)
+		snapshot, err := tsh.load()
+		assert.Nil(t, snapshot)
+		assert.ErrorIs(t, err, ErrTopologySnapshotTooOld)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		tsh, _ := newTopologySnapshotHandler(createTopologySnapshotConfig(t.TempDir()))
+		tsh.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		savedSnapshot := &proxyData.TopologySnapshot{
+			NumShards: 3,
+			Observers: []*proxyData.NodeSnapshot{
+				{Address: "observer0", ShardID: 0, IsSynced: true, LastResponseTimestamp: 990},
+				{Address: "observer1", ShardID: 1, IsSynced: false, IsFallback: true},
+			},
+			FullHistoryNodes: []*proxyData.NodeSnapshot{
+				{Address: "fullHistory0", ShardID: 0, IsSynced: true, IsSnapshotless: true},
+			},
+		}
+		err := tsh.save(savedSnapshot)
+		require.NoError(t, err)
+
+		currentTime = currentTime.Add(time.Minute)
+		snapshot, err := tsh.load()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), snapshot.Timestamp)
+		assert.Equal(t, savedSnapshot, snapshot)
+	})
+}
+
+func TestCreateNodesSnapshot(t *testing.T) {
+	t.Parallel()
+
+	responses := newObserversResponsesTracker()
+	responses.restoreLastResponseTime("observer0", time.Unix(990, 0))
+
+	nodes := []*proxyData.NodeData{
+		{Address: "observer0", ShardId: 0, IsSynced: true},
+		{Address: "observer1", ShardId: 1, IsFallback: true, IsSnapshotless: true},
+	}
+	expectedSnapshot := []*proxyData.NodeSnapshot{
+		{Address: "observer0", ShardID: 0, IsSynced: true, LastResponseTimestamp: 990},
+		{Address: "observer1", ShardID: 1, IsFallback: true, IsSnapshotless: true},
+	}
+	assert.Equal(t, expectedSnapshot, createNodesSnapshot(nodes, responses))
+}
+
+func TestRestoreNodesSyncState(t *testing.T) {
+	t.Parallel()
+
+	nodesSnapshot := []*proxyData.NodeSnapshot{
+		{Address: "observer0", ShardID: 0, IsSynced: false},
+		{Address: "observer1", ShardID: 1, IsSynced: true},
+	}
+
+	t.Run("all nodes found should restore all", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := []*proxyData.NodeData{
+			{Address: "observer0", ShardId: 0, IsSynced: true},
+			{Address: "observer1", ShardId: 1, IsSynced: false},
+		}
+		assert.True(t, restoreNodesSyncState(nodes, nodesSnapshot))
+		assert.False(t, nodes[0].IsSynced)
+		assert.True(t, nodes[1].IsSynced)
+	})
+	t.Run("new node or node moved to another shard should keep their state", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := []*proxyData.NodeData{
+			{Address: "observer0", ShardId: 0, IsSynced: true},
+			{Address: "observer1", ShardId: 2, IsSynced: false},
+			{Address: "observer2", ShardId: 1, IsSynced: true},
+		}
+		assert.False(t, restoreNodesSyncState(nodes, nodesSnapshot))
+		assert.False(t, nodes[0].IsSynced)
+		assert.False(t, nodes[1].IsSynced)
+		assert.True(t, nodes[2].IsSynced)
+	})
+}
+
+func TestObserversResponsesTracker_RestoreLastResponseTime(t *testing.T) {
+	t.Parallel()
+
+	ort := newObserversResponsesTracker()
+	ort.getTimeHandler = func() time.Time {
+		return time.Unix(1000, 0)
+	}
+	ort.recordResponse("observer0", time.Millisecond)
+
+	ort.restoreLastResponseTime("observer0", time.Unix(900, 0))
+	ort.restoreLastResponseTime("observer1", time.Unix(900, 0))
+
+	lastResponse, _ := ort.getLastResponseTime("observer0")
+	assert.Equal(t, time.Unix(1000, 0), lastResponse)
+	lastResponse, _ = ort.getLastResponseTime("observer1")
+	assert.Equal(t, time.Unix(900, 0), lastResponse)
+}
@@ -1,11 +1,16 @@
 package process
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math/big"
 	"net/http"
 	"sort"
+	"strconv"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -13,7 +18,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 )
 
 // TransactionPath defines the transaction group path of the node
@@ -48,6 +56,11 @@ const (
 	relayedV2TransactionDescriptor  = "RelayedTxV2"
 	relayedV3TransactionDescriptor  = "RelayedTxV3"
 	emptyDataStr                    = ""
+
+	// maxAggregatedScrs bounds the number of smart contract results fetched when computing the process status
+	maxAggregatedScrs = 100
+	// maxConcurrentScrsRequests bounds the number of smart contract results fetched at once
+	maxConcurrentScrsRequests = 10
 )
 
 type requestType int
@@ -85,6 +98,11 @@ type TransactionProcessor struct {
 	newTxCostProcessor           func() (TransactionCostHandler, error)
 	mergeLogsHandler             LogsMergerHandler
 	shouldAllowEntireTxPoolFetch bool
+	sendTxQuorum                 config.SendTransactionQuorumConfig
+	txsPolicy                    *transactionsPolicy
+	sentTxsRecorder              SentTransactionsRecorder
+	senderAccountHandler         SenderAccountHandler
+	signatureChecker             *signatureChecker
 }
 
 // NewTransactionProcessor creates a new instance of TransactionProcessor
@@ -96,6 +114,8 @@ func NewTransactionProcessor(
 	newTxCostProcessor func() (TransactionCostHandler, error),
 	logsMerger LogsMergerHandler,
 	allowEntireTxPoolFetch bool,
+	sendTxQuorum config.SendTransactionQuorumConfig,
+	txsPolicyConfig config.TransactionsPolicyConfig,
 ) (*TransactionProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -115,6 +135,14 @@ func NewTransactionProcessor(
 	if check.IfNil(logsMerger) {
 		return nil, ErrNilLogsMerger
 	}
+	err := checkSendTransactionQuorumConfig(sendTxQuorum)
+	if err != nil {
+		return nil, err
+	}
+	txsPolicy, err := newTransactionsPolicy(txsPolicyConfig, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
 
 	// no reason to get this from configs. If we are going to change the marshaller for the relayed transaction v1,
 	// we will need also an enable epoch handler
@@ -128,15 +156,41 @@ func NewTransactionProcessor(
 		mergeLogsHandler:             logsMerger,
 		shouldAllowEntireTxPoolFetch: allowEntireTxPoolFetch,
 		relayedTxsMarshaller:         relayedTxsMarshaller,
+		sendTxQuorum:                 sendTxQuorum,
+		txsPolicy:                    txsPolicy,
+		sentTxsRecorder:              &disabled.SentTransactionsRecorder{},
 	}, nil
 }
 
+// SetSentTransactionsRecorder sets the component recording the observers which accepted the sent transactions
+func (tp *TransactionProcessor) SetSentTransactionsRecorder(recorder SentTransactionsRecorder) error {
+	if check.IfNil(recorder) {
+		return ErrNilSentTransactionsRecorder
+	}
+
+	tp.sentTxsRecorder = recorder
+
+	return nil
+}
+
 // SendTransaction relays the post request by sending the request to the right observer and replies back the answer
 func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, string, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return http.StatusBadRequest, "", err
 	}
+	err = tp.checkSignatures(tx)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+	err = tp.txsPolicy.checkTransaction(tx)
+	if err != nil {
+		return http.StatusForbidden, "", err
+	}
+	statusCode, err := tp.checkNonceNotUsed(tx)
+	if err != nil {
+		return statusCode, "", err
+	}
 
 	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
@@ -153,6 +207,10 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 		return http.StatusInternalServerError, "", err
 	}
 
+	if tp.sendTxQuorum.NumObservers > 1 {
+		return tp.sendTransactionWithQuorum(tx, shardID, observers)
+	}
+
 	txResponse := data.ResponseTransaction{}
 	for _, observer := range observers {
 
@@ -163,6 +221,7 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 				shardID,
 				txResponse.Data.TxHash,
 			))
+			tp.sentTxsRecorder.RecordSentTransaction(txResponse.Data.TxHash, observer.Address)
 			return respCode, txResponse.Data.TxHash, nil
 		}
 
@@ -185,6 +244,12 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 	if err != nil {
 		return nil, err
 	}
+	if checkSignature {
+		err = tp.checkSignatures(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
@@ -287,19 +352,36 @@ func (tp *TransactionProcessor) simulateTransaction(
 func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction) (
 	data.MultipleTransactionsResponseData, error,
 ) {
-	// TODO: Analyze and improve the robustness of this function. Currently, an error within `GetObservers`
-	// breaks the function and returns nothing (but an error) even if some transactions were actually sent, successfully.
-
-	totalTxsSent := uint64(0)
-	txsToSend := make([]*data.Transaction, 0)
+	failedTxs := make(map[int]string)
+	txsToSend := make([]*data.Transaction, 0, len(txs))
 	for i := 0; i < len(txs); i++ {
 		currentTx := txs[i]
+		currentTx.Index = i
 		err := tp.checkTransactionFields(currentTx)
 		if err != nil {
 			log.Warn("invalid tx received",
 				"sender", currentTx.Sender,
 				"receiver", currentTx.Receiver,
 				"error", err)
+			failedTxs[i] = err.Error()
+			continue
+		}
+		err = tp.checkSignatures(currentTx)
+		if err != nil {
+			log.Warn("tx with invalid signature received",
+				"sender", currentTx.Sender,
+				"receiver", currentTx.Receiver,
+				"error", err)
+			failedTxs[i] = err.Error()
+			continue
+		}
+		err = tp.txsPolicy.checkTransaction(currentTx)
+		if err != nil {
+			log.Warn("tx denied by policy",
+				"sender", currentTx.Sender,
+				"receiver", currentTx.Receiver,
+				"error", err)
+			failedTxs[i] = err.Error()
 			continue
 		}
 		txsToSend = append(txsToSend, currentTx)
@@ -308,42 +390,281 @@ func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction
 		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
 	}
 
-	txsHashes := make(map[int]string)
-	txsByShardID := tp.groupTxsByShard(txsToSend)
+	response := data.MultipleTransactionsResponseData{
+		TxsHashes: make(map[int]string),
+		FailedTxs: failedTxs,
+	}
+	txsByShardID := tp.groupTxsByShard(txsToSend, failedTxs)
 	for shardID, groupOfTxs := range txsByShardID {
-		observersInShard, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
+		tp.sendGroupOfTransactions(shardID, sortTxsBySenderNonce(groupOfTxs), &response)
+	}
+
+	return response, nil
+}
+
+// DryRunMultipleTransactions runs the checks of a send-multiple request on each of the provided transactions, without
+// relaying any of them. When requested, each valid transaction is also simulated on its sender shard and, if cross-shard,
+// on its receiver shard, against the current state and independently of the other transactions of the batch
+func (tp *TransactionProcessor) DryRunMultipleTransactions(
+	txs []*data.Transaction,
+	options common.TransactionsDryRunOptions,
+) *data.MultipleTransactionsDryRunResponseData {
+	response := &data.MultipleTransactionsDryRunResponseData{
+		Verdicts: make([]*data.TransactionDryRunVerdict, 0, len(txs)),
+	}
+	for i, tx := range txs {
+		tx.Index = i
+		verdict := tp.dryRunTransaction(tx, options)
+		if verdict.Valid {
+			response.NumValid++
+		} else {
+			response.NumInvalid++
+		}
+
+		response.Verdicts = append(response.Verdicts, verdict)
+	}
+
+	return response
+}
+
+func (tp *TransactionProcessor) dryRunTransaction(tx *data.Transaction, options common.TransactionsDryRunOptions) *data.TransactionDryRunVerdict {
+	verdict := &data.TransactionDryRunVerdict{
+		Index: tx.Index,
+	}
+
+	err := tp.checkTransactionFields(tx)
+	if err != nil {
+		verdict.Error = err.Error()
+		return verdict
+	}
+	if options.CheckSignature {
+		err = tp.checkSignatures(tx)
 		if err != nil {
-			return data.MultipleTransactionsResponseData{}, ErrMissingObserver
+			verdict.Error = err.Error()
+			return verdict
 		}
+	}
+	err = tp.txsPolicy.checkTransaction(tx)
+	if err != nil {
+		verdict.Error = err.Error()
+		return verdict
+	}
 
-		for _, observer := range observersInShard {
-			txResponse := &data.ResponseMultipleTransactions{}
-			respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, MultipleTransactionsPath, groupOfTxs, txResponse)
-			if respCode == http.StatusOK && err == nil {
-				log.Info("transactions sent",
-					"observer", observer.Address,
-					"shard ID", shardID,
-					"total processed", txResponse.Data.NumOfTxs,
-				)
-				totalTxsSent += txResponse.Data.NumOfTxs
+	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		verdict.Error = err.Error()
+		return verdict
+	}
+	senderShardID, err := tp.proc.ComputeShardId(senderBuff)
+	if err != nil {
+		verdict.Error = err.Error()
+		return verdict
+	}
+	verdict.SenderShard = &senderShardID
 
-				for key, hash := range txResponse.Data.TxsHashes {
-					txsHashes[groupOfTxs[key].Index] = hash
-				}
+	verdict.Hash, err = tp.ComputeTransactionHash(tx)
+	if err != nil {
+		verdict.Error = err.Error()
+		return verdict
+	}
 
-				break
-			}
+	if !options.Simulate {
+		verdict.Valid = true
+		return verdict
+	}
 
-			log.LogIfError(err)
+	simulationResponse, err := tp.SimulateTransaction(tx, options.CheckSignature)
+	if err != nil {
+		verdict.Error = err.Error()
+		return verdict
+	}
+	if len(simulationResponse.Error) > 0 {
+		verdict.Error = simulationResponse.Error
+		return verdict
+	}
+
+	verdict.Simulation = simulationResponse.Data
+	verdict.Error = getSimulationFailReason(simulationResponse.Data)
+	verdict.Valid = len(verdict.Error) == 0
+
+	return verdict
+}
+
+// getSimulationFailReason returns the fail reason of a failed simulation, on any of the shards it was run on
+func getSimulationFailReason(simulationData interface{}) string {
+	switch simulation := simulationData.(type) {
+	case data.TransactionSimulationResponseData:
+		return getSimulationResultFailReason(simulation.Result)
+	case data.TransactionSimulationResponseDataCrossShard:
+		for _, shard := range []string{"senderShard", "receiverShard"} {
+			failReason := getSimulationResultFailReason(simulation.Result[shard])
+			if len(failReason) > 0 {
+				return fmt.Sprintf("%s: %s", shard, failReason)
+			}
 		}
 	}
 
-	return data.MultipleTransactionsResponseData{
-		NumOfTxs:  totalTxsSent,
-		TxsHashes: txsHashes,
+	return ""
+}
+
+func getSimulationResultFailReason(result data.TransactionSimulationResults) string {
+	if result.Status != transaction.TxStatusFail {
+		return ""
+	}
+	if len(result.FailReason) == 0 {
+		return string(transaction.TxStatusFail)
+	}
+
+	return result.FailReason
+}
+
+// ComputeTransactionFee computes the fee of the provided transaction from the economics parameters of the network config,
+// the same way the protocol does: the move balance gas (including the data field gas and the extra gas of the guarded
+// and relayed transactions) is paid at the full gas price, while the rest of the gas limit, consumed by the smart
+// contract calls, is paid at the gas price reduced by the gas price modifier
+func (tp *TransactionProcessor) ComputeTransactionFee(tx *data.Transaction, networkConfig *data.NetworkConfig) (*data.TransactionFeeResponseData, error) {
+	gasPriceModifier, err := strconv.ParseFloat(networkConfig.Config.GasPriceModifier, 64)
+	if err != nil || gasPriceModifier <= 0 || gasPriceModifier > 1 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidGasPriceModifier, networkConfig.Config.GasPriceModifier)
+	}
+
+	moveBalanceGas := networkConfig.Config.MinGasLimit + uint64(len(tx.Data))*networkConfig.Config.GasPerDataByte
+	if tx.Options&transaction.MaskGuardedTransaction > 0 {
+		moveBalanceGas += networkConfig.Config.ExtraGasLimitGuarded
+	}
+	if len(tx.RelayerAddr) > 0 {
+		moveBalanceGas += networkConfig.Config.MinGasLimit
+	}
+
+	if tx.GasPrice < networkConfig.Config.MinGasPrice {
+		return nil, fmt.Errorf("%w: provided %d, minimum %d", ErrInsufficientGasPrice, tx.GasPrice, networkConfig.Config.MinGasPrice)
+	}
+	if tx.GasLimit < moveBalanceGas {
+		return nil, fmt.Errorf("%w: provided %d, needed at least %d", ErrInsufficientGasLimit, tx.GasLimit, moveBalanceGas)
+	}
+	if networkConfig.Config.MaxGasPerTransaction > 0 && tx.GasLimit > networkConfig.Config.MaxGasPerTransaction {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", ErrHigherGasLimitThanAllowed, tx.GasLimit, networkConfig.Config.MaxGasPerTransaction)
+	}
+
+	processingGas := tx.GasLimit - moveBalanceGas
+	processingGasPrice := uint64(float64(tx.GasPrice) * gasPriceModifier)
+
+	moveBalanceFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(moveBalanceGas), big.NewInt(0).SetUint64(tx.GasPrice))
+	processingFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(processingGas), big.NewInt(0).SetUint64(processingGasPrice))
+
+	return &data.TransactionFeeResponseData{
+		Fee:                big.NewInt(0).Add(moveBalanceFee, processingFee).String(),
+		GasLimit:           tx.GasLimit,
+		GasPrice:           tx.GasPrice,
+		MoveBalanceGas:     moveBalanceGas,
+		ProcessingGas:      processingGas,
+		ProcessingGasPrice: processingGasPrice,
+		GasPriceModifier:   gasPriceModifier,
 	}, nil
 }
 
+// sendGroupOfTransactions sends the transactions of a shard to its observers. The transactions not accepted by an
+// observer are retried on the next one, while the ones not accepted by any observer are reported as failed
+func (tp *TransactionProcessor) sendGroupOfTransactions(
+	shardID uint32,
+	groupOfTxs []*data.Transaction,
+	response *data.MultipleTransactionsResponseData,
+) {
+	observersInShard, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		log.Warn("cannot send transactions", "shard ID", shardID, "error", err)
+		markTxsAsFailed(groupOfTxs, ErrMissingObserver.Error(), response.FailedTxs)
+		return
+	}
+
+	pendingTxs := groupOfTxs
+	lastError := ErrMissingObserver.Error()
+	for _, observer := range observersInShard {
+		txResponse := &data.ResponseMultipleTransactions{}
+		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, MultipleTransactionsPath, pendingTxs, txResponse)
+		if respCode != http.StatusOK || err != nil {
+			lastError = getMultipleTransactionsError(respCode, err, txResponse)
+			log.Warn("cannot send transactions",
+				"observer", observer.Address,
+				"shard ID", shardID,
+				"num txs", len(pendingTxs),
+				"error", lastError,
+			)
+			continue
+		}
+
+		log.Info("transactions sent",
+			"observer", observer.Address,
+			"shard ID", shardID,
+			"total processed", txResponse.Data.NumOfTxs,
+		)
+		response.NumOfTxs += txResponse.Data.NumOfTxs
+
+		notAcceptedTxs := make([]*data.Transaction, 0)
+		for idx, tx := range pendingTxs {
+			hash, accepted := txResponse.Data.TxsHashes[idx]
+			if !accepted {
+				notAcceptedTxs = append(notAcceptedTxs, tx)
+				continue
+			}
+
+			response.TxsHashes[tx.Index] = hash
+			tp.sentTxsRecorder.RecordSentTransaction(hash, observer.Address)
+		}
+
+		pendingTxs = notAcceptedTxs
+		if len(pendingTxs) == 0 {
+			return
+		}
+		lastError = ErrTransactionNotAccepted.Error()
+	}
+
+	markTxsAsFailed(pendingTxs, lastError, response.FailedTxs)
+}
+
+func getMultipleTransactionsError(respCode int, err error, txResponse *data.ResponseMultipleTransactions) string {
+	if len(txResponse.Error) > 0 {
+		return txResponse.Error
+	}
+	if err != nil {
+		return err.Error()
+	}
+
+	return fmt.Sprintf("observer replied with http code %d", respCode)
+}
+
+func markTxsAsFailed(txs []*data.Transaction, reason string, failedTxs map[int]string) {
+	for _, tx := range txs {
+		failedTxs[tx.Index] = reason
+	}
+}
+
+// sortTxsBySenderNonce orders the transactions of each sender by their nonces, as the observers would otherwise reject
+// a transaction received before the ones with lower nonces of the same sender. The senders keep the order in which
+// they first appear
+func sortTxsBySenderNonce(txs []*data.Transaction) []*data.Transaction {
+	senders := make([]string, 0)
+	txsBySender := make(map[string][]*data.Transaction)
+	for _, tx := range txs {
+		_, found := txsBySender[tx.Sender]
+		if !found {
+			senders = append(senders, tx.Sender)
+		}
+		txsBySender[tx.Sender] = append(txsBySender[tx.Sender], tx)
+	}
+
+	sortedTxs := make([]*data.Transaction, 0, len(txs))
+	for _, sender := range senders {
+		senderTxs := txsBySender[sender]
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].Nonce < senderTxs[j].Nonce
+		})
+		sortedTxs = append(sortedTxs, senderTxs...)
+	}
+
+	return sortedTxs
+}
+
 // TransactionCostRequest should return how many gas units a transaction will cost
 func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
 	err := tp.checkTransactionFields(tx)
@@ -359,6 +680,55 @@ func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*d
 	return newTxCostProcessor.ResolveCostRequest(tx)
 }
 
+// TransactionCostDetailedRequest should return how many gas units a transaction will cost, along with the returned data,
+// the return message and the gas breakdown of each smart contract result generated during the cost simulation
+func (tp *TransactionProcessor) TransactionCostDetailedRequest(tx *data.Transaction) (*data.TxCostDetailedResponse, error) {
+	costResponse, err := tp.TransactionCostRequest(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTxCostDetailedResponse(costResponse), nil
+}
+
+func newTxCostDetailedResponse(costResponse *data.TxCostResponseData) *data.TxCostDetailedResponse {
+	gasBreakdown := make([]*data.SCRGasBreakdown, 0, len(costResponse.ScResults))
+	for scrHash, scr := range costResponse.ScResults {
+		if scr == nil || scr.ApiSmartContractResult == nil {
+			continue
+		}
+
+		gasBreakdown = append(gasBreakdown, &data.SCRGasBreakdown{
+			Hash:          scrHash,
+			Sender:        scr.SndAddr,
+			Receiver:      scr.RcvAddr,
+			Function:      scr.Function,
+			GasLimit:      scr.GasLimit,
+			GasPrice:      scr.GasPrice,
+			IsRefund:      scr.IsRefund,
+			ReturnMessage: scr.ReturnMessage,
+		})
+	}
+
+	sort.Slice(gasBreakdown, func(i, j int) bool {
+		return gasBreakdown[i].Hash < gasBreakdown[j].Hash
+	})
+
+	returnData := costResponse.ReturnData
+	if returnData == nil {
+		returnData = make([][]byte, 0)
+	}
+
+	return &data.TxCostDetailedResponse{
+		TxCost:       costResponse.TxCost,
+		RetMessage:   costResponse.RetMessage,
+		ReturnData:   returnData,
+		GasBreakdown: gasBreakdown,
+		ScResults:    costResponse.ScResults,
+		Logs:         costResponse.Logs,
+	}
+}
+
 // GetTransaction should return a transaction from observer
 func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	tx, err := tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, withResults)
@@ -372,6 +742,34 @@ func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool)
 	return tx, nil
 }
 
+// GetRelayedTransactionOfInner returns the relayed transaction wrapping the provided inner transaction, located through
+// the original transaction hash reported by the observers for the inner transaction. A nil transaction is returned if
+// the provided transaction is not the inner transaction of a relayed one
+func (tp *TransactionProcessor) GetRelayedTransactionOfInner(innerTx *transaction.ApiTransactionResult, withResults bool) (*transaction.ApiTransactionResult, error) {
+	if innerTx == nil || len(innerTx.OriginalTransactionHash) == 0 || innerTx.OriginalTransactionHash == innerTx.Hash {
+		return nil, nil
+	}
+
+	originalTx, err := tp.GetTransaction(innerTx.OriginalTransactionHash, withResults)
+	if err != nil {
+		return nil, err
+	}
+	if !isRelayedTransaction(originalTx) {
+		return nil, nil
+	}
+
+	return originalTx, nil
+}
+
+func isRelayedTransaction(tx *transaction.ApiTransactionResult) bool {
+	switch tx.ProcessingTypeOnSource {
+	case relayedV1TransactionDescriptor, relayedV2TransactionDescriptor, relayedV3TransactionDescriptor:
+		return true
+	default:
+		return false
+	}
+}
+
 // GetTransactionByHashAndSenderAddress returns a transaction
 func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(
 	txHash string,
@@ -386,6 +784,166 @@ func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(
 	return tx, http.StatusOK, nil
 }
 
+// GetTransactionProtobuf returns the protocol representation of a transaction, marshaled with the protocol marshalizer.
+// The optional sender address is used to directly target the observers of the sender's shard
+func (tp *TransactionProcessor) GetTransactionProtobuf(txHash string, sndAddr string) ([]byte, error) {
+	var tx *transaction.ApiTransactionResult
+	var err error
+	if len(sndAddr) > 0 {
+		tx, err = tp.getTxWithSenderAddr(txHash, sndAddr, false)
+	} else {
+		tx, err = tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	protocolTx, err := tp.convertToProtocolTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tp.marshalizer.Marshal(protocolTx)
+}
+
+// DecodeRawTransaction unmarshals the signed transaction bytes produced by the protocol marshalizer. The bytes have to be
+// canonical (equal to the ones obtained by marshaling the decoded transaction again), so that the hash of the relayed
+// transaction is the hash of the provided bytes
+func (tp *TransactionProcessor) DecodeRawTransaction(txBytes []byte) (*data.Transaction, error) {
+	protocolTx := &transaction.Transaction{}
+	err := tp.marshalizer.Unmarshal(protocolTx, txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRawTransaction, err.Error())
+	}
+
+	canonicalBytes, err := tp.marshalizer.Marshal(protocolTx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRawTransaction, err.Error())
+	}
+	if !bytes.Equal(canonicalBytes, txBytes) {
+		return nil, ErrNonCanonicalRawTransaction
+	}
+
+	return tp.convertFromProtocolTransaction(protocolTx)
+}
+
+func (tp *TransactionProcessor) convertFromProtocolTransaction(protocolTx *transaction.Transaction) (*data.Transaction, error) {
+	if protocolTx.Value == nil {
+		return nil, ErrInvalidTransactionValueField
+	}
+	receiver, err := tp.pubKeyConverter.Encode(protocolTx.RcvAddr)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	sender, err := tp.pubKeyConverter.Encode(protocolTx.SndAddr)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+
+	tx := &data.Transaction{
+		Nonce:            protocolTx.Nonce,
+		Value:            protocolTx.Value.String(),
+		Receiver:         receiver,
+		Sender:           sender,
+		SenderUsername:   protocolTx.SndUserName,
+		ReceiverUsername: protocolTx.RcvUserName,
+		GasPrice:         protocolTx.GasPrice,
+		GasLimit:         protocolTx.GasLimit,
+		Data:             protocolTx.Data,
+		Signature:        hex.EncodeToString(protocolTx.Signature),
+		ChainID:          string(protocolTx.ChainID),
+		Version:          protocolTx.Version,
+		Options:          protocolTx.Options,
+	}
+
+	if len(protocolTx.GuardianAddr) > 0 {
+		tx.GuardianAddr, err = tp.pubKeyConverter.Encode(protocolTx.GuardianAddr)
+		if err != nil {
+			return nil, errors.ErrInvalidGuardianAddress
+		}
+	}
+	if len(protocolTx.GuardianSignature) > 0 {
+		tx.GuardianSignature = hex.EncodeToString(protocolTx.GuardianSignature)
+	}
+	if len(protocolTx.RelayerAddr) > 0 {
+		tx.RelayerAddr, err = tp.pubKeyConverter.Encode(protocolTx.RelayerAddr)
+		if err != nil {
+			return nil, ErrInvalidAddress
+		}
+	}
+	if len(protocolTx.RelayerSignature) > 0 {
+		tx.RelayerSignature = hex.EncodeToString(protocolTx.RelayerSignature)
+	}
+
+	return tx, nil
+}
+
+func (tp *TransactionProcessor) convertToProtocolTransaction(tx *transaction.ApiTransactionResult) (*transaction.Transaction, error) {
+	if tx.Type != string(transaction.TxTypeNormal) && tx.Type != string(transaction.TxTypeInvalid) {
+		return nil, fmt.Errorf("%w for type %s", ErrProtobufNotSupportedForTransactionType, tx.Type)
+	}
+
+	valueBig, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return nil, ErrInvalidTransactionValueField
+	}
+	receiverAddress, err := tp.pubKeyConverter.Decode(tx.Receiver)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	senderAddress, err := tp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	signatureBytes, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return nil, ErrInvalidSignatureBytes
+	}
+
+	protocolTx := &transaction.Transaction{
+		Nonce:       tx.Nonce,
+		Value:       valueBig,
+		RcvAddr:     receiverAddress,
+		RcvUserName: tx.ReceiverUsername,
+		SndAddr:     senderAddress,
+		SndUserName: tx.SenderUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     []byte(tx.ChainID),
+		Version:     tx.Version,
+		Signature:   signatureBytes,
+		Options:     tx.Options,
+	}
+
+	if len(tx.GuardianAddr) > 0 {
+		protocolTx.GuardianAddr, err = tp.pubKeyConverter.Decode(tx.GuardianAddr)
+		if err != nil {
+			return nil, errors.ErrInvalidGuardianAddress
+		}
+	}
+	if len(tx.GuardianSignature) > 0 {
+		protocolTx.GuardianSignature, err = hex.DecodeString(tx.GuardianSignature)
+		if err != nil {
+			return nil, errors.ErrInvalidGuardianSignatureHex
+		}
+	}
+	if len(tx.RelayerAddress) > 0 {
+		protocolTx.RelayerAddr, err = tp.pubKeyConverter.Decode(tx.RelayerAddress)
+		if err != nil {
+			return nil, ErrInvalidAddress
+		}
+	}
+	if len(tx.RelayerSignature) > 0 {
+		protocolTx.RelayerSignature, err = hex.DecodeString(tx.RelayerSignature)
+		if err != nil {
+			return nil, ErrInvalidSignatureBytes
+		}
+	}
+
+	return protocolTx, nil
+}
+
 func (tp *TransactionProcessor) getShardByAddress(address string) (uint32, error) {
 	var shardID uint32
 	if metachainIDStr := fmt.Sprintf("%d", core.MetachainShardId); address != metachainIDStr {
@@ -462,6 +1020,12 @@ func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTran
 	}
 
 	allLogs, allScrs, err := tp.gatherAllLogsAndScrs(tx)
+	if err == ErrTooManySmartContractResults {
+		return &data.ProcessStatusResponse{
+			Status: string(data.TxStatusUnknown),
+			Reason: err.Error(),
+		}
+	}
 	if err != nil {
 		log.Warn("error in TransactionProcessor.computeTransactionStatus", "error", err)
 		return &data.ProcessStatusResponse{
@@ -704,8 +1268,12 @@ func findIdentifierInSingleLog(log *transaction.ApiLogs, identifier string) (boo
 	return false, []byte(emptyDataStr)
 }
 
+// gatherAllLogsAndScrs aggregates the smart contract results generated by the transaction, as fetched from the
+// observers of the shards executing them, along with the smart contract results these generated in turn, so the
+// cross-shard effects are all considered. A smart contract result not yet found on the observers is reported as pending,
+// as its execution on the destination shard is not completed yet. The status cannot be computed out of partial data,
+// so ErrTooManySmartContractResults is returned if the transaction generated more than maxAggregatedScrs of them
 func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransactionResult) ([]*transaction.ApiLogs, []*transaction.ApiTransactionResult, error) {
-	const withResults = true
 	allLogs := make([]*transaction.ApiLogs, 0)
 	allScrs := make([]*transaction.ApiTransactionResult, 0)
 
@@ -713,24 +1281,97 @@ func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransact
 		allLogs = append(allLogs, tx.Logs)
 	}
 
-	for _, scrFromTx := range tx.SmartContractResults {
-		scr, err := tp.GetTransaction(scrFromTx.Hash, withResults)
+	visitedHashes := map[string]struct{}{tx.Hash: {}}
+	scrsToFetch := getUnvisitedScrsHashes(tx.SmartContractResults, visitedHashes)
+	for len(scrsToFetch) > 0 {
+		if len(allScrs)+len(scrsToFetch) > maxAggregatedScrs {
+			log.Debug("TransactionProcessor.gatherAllLogsAndScrs: too many smart contract results", "tx hash", tx.Hash)
+			return nil, nil, ErrTooManySmartContractResults
+		}
+
+		scrs, err := tp.fetchScrs(scrsToFetch)
 		if err != nil {
-			return nil, nil, fmt.Errorf("%w for scr hash %s", err, scrFromTx.Hash)
+			return nil, nil, err
 		}
 
+		scrsToFetch = make([]string, 0)
+		for _, scr := range scrs {
+			allScrs = append(allScrs, scr)
+			scrsToFetch = append(scrsToFetch, getUnvisitedScrsHashes(scr.SmartContractResults, visitedHashes)...)
+
+			if scr.Logs == nil {
+				continue
+			}
+			allLogs = append(allLogs, scr.Logs)
+		}
+	}
+
+	return allLogs, allScrs, nil
+}
+
+// fetchScrs fetches the provided smart contract results, at most maxConcurrentScrsRequests at once. The results keep
+// the order of the hashes
+func (tp *TransactionProcessor) fetchScrs(hashes []string) ([]*transaction.ApiTransactionResult, error) {
+	results := make([]*transaction.ApiTransactionResult, len(hashes))
+	errs := make([]error, len(hashes))
+	semaphore := make(chan struct{}, maxConcurrentScrsRequests)
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(hashes))
+	for idx, hash := range hashes {
+		semaphore <- struct{}{}
+		go func(idx int, scrHash string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			results[idx], errs[idx] = tp.fetchScr(scrHash)
+		}(idx, hash)
+	}
+	wg.Wait()
+
+	scrs := make([]*transaction.ApiTransactionResult, 0, len(hashes))
+	for idx, scr := range results {
+		if errs[idx] != nil {
+			return nil, fmt.Errorf("%w for scr hash %s", errs[idx], hashes[idx])
+		}
 		if scr == nil {
 			continue
 		}
-		allScrs = append(allScrs, scr)
 
-		if scr.Logs == nil {
+		scrs = append(scrs, scr)
+	}
+
+	return scrs, nil
+}
+
+func (tp *TransactionProcessor) fetchScr(scrHash string) (*transaction.ApiTransactionResult, error) {
+	const withResults = true
+	scr, err := tp.GetTransaction(scrHash, withResults)
+	if err == errors.ErrTransactionNotFound {
+		return &transaction.ApiTransactionResult{
+			Hash:   scrHash,
+			Status: transaction.TxStatusPending,
+		}, nil
+	}
+
+	return scr, err
+}
+
+func getUnvisitedScrsHashes(scrs []*transaction.ApiSmartContractResult, visitedHashes map[string]struct{}) []string {
+	hashes := make([]string, 0, len(scrs))
+	for _, scr := range scrs {
+		_, visited := visitedHashes[scr.Hash]
+		if visited {
 			continue
 		}
-		allLogs = append(allLogs, scr.Logs)
+
+		visitedHashes[scr.Hash] = struct{}{}
+		hashes = append(hashes, scr.Hash)
 	}
 
-	return allLogs, allScrs, nil
+	return hashes
 }
 
 func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
@@ -1109,20 +1750,21 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 	return nil, false
 }
 
-func (tp *TransactionProcessor) groupTxsByShard(txs []*data.Transaction) map[uint32][]*data.Transaction {
+func (tp *TransactionProcessor) groupTxsByShard(txs []*data.Transaction, failedTxs map[int]string) map[uint32][]*data.Transaction {
 	txsMap := make(map[uint32][]*data.Transaction)
-	for idx, tx := range txs {
+	for _, tx := range txs {
 		senderBytes, err := tp.pubKeyConverter.Decode(tx.Sender)
 		if err != nil {
+			failedTxs[tx.Index] = err.Error()
 			continue
 		}
 
 		senderShardID, err := tp.proc.ComputeShardId(senderBytes)
 		if err != nil {
+			failedTxs[tx.Index] = err.Error()
 			continue
 		}
 
-		tx.Index = idx
 		txsMap[senderShardID] = append(txsMap[senderShardID], tx)
 	}
 
@@ -1168,8 +1810,27 @@ func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) err
 		}
 	}
 
+	return tp.checkGuardianFields(tx)
+}
+
+func (tp *TransactionProcessor) checkGuardianFields(tx *data.Transaction) error {
+	isGuardedTx := tx.Options&transaction.MaskGuardedTransaction > 0
+	hasGuardianFields := len(tx.GuardianAddr) > 0 || len(tx.GuardianSignature) > 0
+	if !isGuardedTx && hasGuardianFields {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrGuardianFieldsOnNonGuardedTx.Error(),
+			Reason:  fmt.Sprintf("options %d", tx.Options),
+		}
+	}
+	if isGuardedTx && len(tx.GuardianAddr) == 0 {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrMissingGuardianAddress.Error(),
+			Reason:  "no guardian address",
+		}
+	}
+
 	if len(tx.GuardianSignature) > 0 {
-		_, err = hex.DecodeString(tx.GuardianSignature)
+		_, err := hex.DecodeString(tx.GuardianSignature)
 		if err != nil {
 			return &errors.ErrInvalidTxFields{
 				Message: errors.ErrInvalidGuardianSignatureHex.Error(),
@@ -1178,7 +1839,7 @@ func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) err
 		}
 	}
 	if len(tx.GuardianAddr) > 0 {
-		_, err = tp.pubKeyConverter.Decode(tx.GuardianAddr)
+		_, err := tp.pubKeyConverter.Decode(tx.GuardianAddr)
 		if err != nil {
 			return &errors.ErrInvalidTxFields{
 				Message: errors.ErrInvalidGuardianAddress.Error(),
@@ -1260,13 +1921,14 @@ func (tp *TransactionProcessor) getNodesInShard(shardID uint32, reqType requestT
 	return observers, err
 }
 
-// GetTransactionsPool should return all transactions from all shards pool
-func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+// GetTransactionsPool should return all transactions from all shards pool. The cursor holds the number of transactions
+// already delivered by the previous, truncated, responses
+func (tp *TransactionProcessor) GetTransactionsPool(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPool(fields)
+	txPool, err := tp.getTxPool(ctx, fields, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -1275,19 +1937,54 @@ func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.Transa
 }
 
 // GetTransactionsPoolForShard should return transactions pool from one observer from shard
-func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string, cursor uint64) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPoolForShard(shardID, fields)
+	txPool, truncation, err := tp.getTxPoolForShard(ctx, shardID, fields, cursor)
 	if err != nil {
 		return nil, err
 	}
+	if truncation.Truncated {
+		txPool.Truncated = true
+		txPool.NextCursor = cursor + truncation.NumReturned
+	}
 
 	return txPool, nil
 }
 
+// GetTransactionsPoolForShardStream returns the transactions pool response of one observer from the shard, without
+// decoding it, so it can be relayed as it is received. The caller is responsible for closing the returned body
+func (tp *TransactionProcessor) GetTransactionsPoolForShardStream(ctx context.Context, shardID uint32, fields string) (io.ReadCloser, error) {
+	if !tp.shouldAllowEntireTxPoolFetch {
+		return nil, errors.ErrOperationNotAllowed
+	}
+
+	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
+	if err != nil {
+		return nil, err
+	}
+
+	apiPath := TransactionsPoolPath + fieldsParam + fields
+	for _, observer := range observers {
+		respCode, body, errCall := tp.proc.CallGetRestEndPointStream(ctx, observer.Address, apiPath)
+		if errCall != nil {
+			log.Trace("cannot get tx pool", "address", observer.Address, "error", errCall)
+
+			if respCode == http.StatusTooManyRequests {
+				log.Warn("too many requests while getting tx pool", "address", observer.Address)
+			}
+
+			continue
+		}
+
+		return body, nil
+	}
+
+	return nil, errors.ErrTransactionsNotFoundInPool
+}
+
 // GetTransactionsPoolForSender should return transactions for sender from observer's pool
 func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
 	txPool, err := tp.getTxPoolForSender(sender, fields)
@@ -1322,15 +2019,19 @@ func (tp *TransactionProcessor) getShardObserversForSender(sender string, observ
 	return observers, sndShardID, nil
 }
 
-func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool, error) {
+// getTxPool aggregates the pools of all shards, skipping the first cursor transactions. The aggregation stops at the
+// first truncated shard pool, so the next request can continue from there
+func (tp *TransactionProcessor) getTxPool(ctx context.Context, fields string, cursor uint64) (*data.TransactionsPool, error) {
 	shardIDs := tp.proc.GetShardIDs()
 	txs := &data.TransactionsPool{
 		RegularTransactions:  make([]data.WrappedTransaction, 0),
 		SmartContractResults: make([]data.WrappedTransaction, 0),
 		Rewards:              make([]data.WrappedTransaction, 0),
 	}
+	numToSkip := cursor
+	numReturned := uint64(0)
 	for _, shard := range shardIDs {
-		intraShardTxs, err := tp.getTxPoolForShard(shard, fields)
+		intraShardTxs, truncation, err := tp.getTxPoolForShard(ctx, shard, fields, numToSkip)
 		if err != nil {
 			continue
 		}
@@ -1338,39 +2039,49 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 		txs.RegularTransactions = append(txs.RegularTransactions, intraShardTxs.RegularTransactions...)
 		txs.Rewards = append(txs.Rewards, intraShardTxs.Rewards...)
 		txs.SmartContractResults = append(txs.SmartContractResults, intraShardTxs.SmartContractResults...)
+
+		numToSkip -= truncation.NumSkipped
+		numReturned += truncation.NumReturned
+		if truncation.Truncated {
+			txs.Truncated = true
+			txs.NextCursor = cursor + numReturned
+			break
+		}
 	}
 
 	return txs, nil
 }
 
-func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPoolForShard(ctx context.Context, shardID uint32, fields string, numToSkip uint64) (*data.TransactionsPool, data.ResponseTruncation, error) {
 	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
 	if err != nil {
 		log.Trace("cannot get observers for shard", "shard", shardID, "error", err)
-		return nil, err
+		return nil, data.ResponseTruncation{}, err
 	}
 
 	for _, observer := range observers {
-		txs, ok := tp.getTxPoolFromObserver(observer, fields)
+		txs, truncation, ok := tp.getTxPoolFromObserver(ctx, observer, fields, numToSkip)
 		if !ok {
 			continue
 		}
 
-		return txs, nil
+		return txs, truncation, nil
 	}
 
 	log.Trace("cannot get tx pool for shard", "shard", shardID, "error", errors.ErrTransactionsNotFoundInPool.Error())
-	return nil, errors.ErrTransactionsNotFoundInPool
+	return nil, data.ResponseTruncation{}, errors.ErrTransactionsNotFoundInPool
 }
 
 func (tp *TransactionProcessor) getTxPoolFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	fields string,
-) (*data.TransactionsPool, bool) {
+	numToSkip uint64,
+) (*data.TransactionsPool, data.ResponseTruncation, bool) {
 	txsPoolResponse := &data.TransactionsPoolApiResponse{}
 	apiPath := TransactionsPoolPath + fieldsParam + fields
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, truncation, err := tp.proc.CallGetRestEndPointWithTruncation(ctx, observer.Address, apiPath, txsPoolResponse, numToSkip)
 	if err != nil {
 		log.Trace("cannot get tx pool", "address", observer.Address, "error", err)
 
@@ -1378,14 +2089,14 @@ func (tp *TransactionProcessor) getTxPoolFromObserver(
 			log.Warn("too many requests while getting tx pool", "address", observer.Address)
 		}
 
-		return nil, false
+		return nil, data.ResponseTruncation{}, false
 	}
 
 	if respCode != http.StatusOK {
-		return nil, false
+		return nil, data.ResponseTruncation{}, false
 	}
 
-	return &txsPoolResponse.Data.Transactions, true
+	return &txsPoolResponse.Data.Transactions, truncation, true
 }
 
 func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
@@ -1482,6 +2193,7 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 	if err != nil {
 		return nil, err
 	}
+	observers = filterNodesByCapability(observers, data.CapabilityTxPoolNonceGaps)
 
 	nonceGaps := &data.TransactionsPoolNonceGaps{
 		Gaps: []data.NonceGap{},
@@ -2,10 +2,12 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"net/http"
 	"os"
@@ -17,10 +19,17 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/logsevents"
@@ -92,6 +101,8 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 		funcNewTxCostHandler,
 		logsMerger,
 		false,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	return tp
@@ -100,7 +111,7 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
@@ -109,7 +120,7 @@ func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -118,7 +129,7 @@ func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilHasher, err)
@@ -127,7 +138,7 @@ func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilMarshalizer, err)
@@ -136,7 +147,7 @@ func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilLogsMergerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilLogsMerger, err)
@@ -145,7 +156,7 @@ func TestNewTransactionProcessor_NilLogsMergerShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	require.NotNil(t, tp)
 	require.Nil(t, err)
@@ -156,7 +167,7 @@ func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{
 		Sender: "invalid hex number",
 	})
@@ -170,7 +181,7 @@ func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testin
 func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{})
 
 	require.Empty(t, txHash)
@@ -182,7 +193,7 @@ func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 func TestTransactionProcessor_SendTransactionNoVersionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{
 		ChainID: "chainID",
 	})
@@ -193,6 +204,65 @@ func TestTransactionProcessor_SendTransactionNoVersionShouldErr(t *testing.T) {
 	require.Equal(t, http.StatusBadRequest, rc)
 }
 
+func TestTransactionProcessor_SendTransactionGuardianFields(t *testing.T) {
+	t.Parallel()
+
+	guardedOption := transaction.MaskGuardedTransaction
+	createTx := func(options uint32, guardianAddr string, guardianSignature string) *data.Transaction {
+		return &data.Transaction{
+			ChainID:           "chainID",
+			Version:           2,
+			Options:           options,
+			GuardianAddr:      guardianAddr,
+			GuardianSignature: guardianSignature,
+		}
+	}
+
+	t.Run("guardian fields without guarded option should err", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
+		rc, txHash, err := tp.SendTransaction(createTx(0, "aabb", ""))
+
+		require.Empty(t, txHash)
+		require.Contains(t, err.Error(), apiErrors.ErrGuardianFieldsOnNonGuardedTx.Error())
+		require.Equal(t, http.StatusBadRequest, rc)
+	})
+
+	t.Run("guarded option without guardian address should err", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
+		rc, txHash, err := tp.SendTransaction(createTx(guardedOption, "", "aabb"))
+
+		require.Empty(t, txHash)
+		require.Contains(t, err.Error(), apiErrors.ErrMissingGuardianAddress.Error())
+		require.Equal(t, http.StatusBadRequest, rc)
+	})
+
+	t.Run("invalid guardian signature should err", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
+		rc, txHash, err := tp.SendTransaction(createTx(guardedOption, "aabb", "not hex"))
+
+		require.Empty(t, txHash)
+		require.Contains(t, err.Error(), apiErrors.ErrInvalidGuardianSignatureHex.Error())
+		require.Equal(t, http.StatusBadRequest, rc)
+	})
+
+	t.Run("invalid guardian address should err", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
+		rc, txHash, err := tp.SendTransaction(createTx(guardedOption, "invalid guardian", "aabb"))
+
+		require.Empty(t, txHash)
+		require.Contains(t, err.Error(), apiErrors.ErrInvalidGuardianAddress.Error())
+		require.Equal(t, http.StatusBadRequest, rc)
+	})
+}
+
 func TestTransactionProcessor_SendTransactionComputeShardIdFailsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -209,6 +279,8 @@ func TestTransactionProcessor_SendTransactionComputeShardIdFailsShouldErr(t *tes
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{
 		ChainID: "chain",
@@ -239,6 +311,8 @@ func TestTransactionProcessor_SendTransactionGetObserversFailsShouldErr(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 	address := "DEADBEEF"
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{
@@ -277,6 +351,8 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnAllObserversShouldErr
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 	address := "DEADBEEF"
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{
@@ -318,6 +394,8 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 	address := "DEADBEEF"
 	rc, resultedTxHash, err := tp.SendTransaction(&data.Transaction{
@@ -331,6 +409,483 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 	require.Equal(t, http.StatusOK, rc)
 }
 
+func TestTransactionProcessor_SendTransactionWithSignatureCheck(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	signer := &singlesig.Ed25519Signer{}
+	senderSk, senderPk := keyGen.GeneratePair()
+	guardianSk, guardianPk := keyGen.GeneratePair()
+	senderBytes, _ := senderPk.ToByteArray()
+	guardianBytes, _ := guardianPk.ToByteArray()
+	receiver := testPubkeyConverter.SilentEncode(bytes.Repeat([]byte{1}, 32), testLogger)
+
+	signTx := func(tx *data.Transaction, sk crypto.PrivateKey) string {
+		protocolTx := &transaction.Transaction{
+			Nonce:    tx.Nonce,
+			Value:    big.NewInt(0),
+			RcvAddr:  bytes.Repeat([]byte{1}, 32),
+			SndAddr:  senderBytes,
+			GasPrice: tx.GasPrice,
+			GasLimit: tx.GasLimit,
+			Data:     tx.Data,
+			ChainID:  []byte(tx.ChainID),
+			Version:  tx.Version,
+			Options:  tx.Options,
+		}
+		if len(tx.GuardianAddr) > 0 {
+			protocolTx.GuardianAddr = guardianBytes
+		}
+		dataToSign, err := protocolTx.GetDataForSigning(testPubkeyConverter, &marshal.JsonMarshalizer{}, keccak.NewKeccak())
+		require.NoError(t, err)
+		signature, err := signer.Sign(sk, dataToSign)
+		require.NoError(t, err)
+
+		return hex.EncodeToString(signature)
+	}
+	createTx := func(options uint32) *data.Transaction {
+		tx := &data.Transaction{
+			Nonce:    7,
+			Value:    "0",
+			Receiver: receiver,
+			Sender:   testPubkeyConverter.SilentEncode(senderBytes, testLogger),
+			GasPrice: 1000000000,
+			GasLimit: 50000,
+			Data:     []byte("hello"),
+			ChainID:  "T",
+			Version:  2,
+			Options:  options,
+		}
+		tx.Signature = signTx(tx, senderSk)
+
+		return tx
+	}
+	createProcessor := func(numSent *int) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer", ShardId: 0}}, nil
+				},
+				CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+					*numSent++
+					switch typedResponse := response.(type) {
+					case *data.ResponseTransaction:
+						typedResponse.Data.TxHash = "hash"
+					case *data.ResponseMultipleTransactions:
+						sentTxs := value.([]*data.Transaction)
+						typedResponse.Data.NumOfTxs = uint64(len(sentTxs))
+						typedResponse.Data.TxsHashes = map[int]string{0: "hash"}
+					}
+					return http.StatusOK, nil
+				},
+			},
+			testPubkeyConverter,
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			config.SendTransactionQuorumConfig{},
+			config.TransactionsPolicyConfig{},
+		)
+		err := tp.SetSignatureChecker(keyGen, signer)
+		require.NoError(t, err)
+
+		return tp
+	}
+
+	t.Run("nil components should error", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		tp := createProcessor(&numSent)
+		require.Equal(t, process.ErrNilKeyGenerator, tp.SetSignatureChecker(nil, signer))
+		require.Equal(t, process.ErrNilSingleSigner, tp.SetSignatureChecker(keyGen, nil))
+	})
+	t.Run("valid signatures should send", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		tp := createProcessor(&numSent)
+
+		statusCode, txHash, err := tp.SendTransaction(createTx(0))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "hash", txHash)
+
+		_, _, err = tp.SendTransaction(createTx(transaction.MaskSignedWithHash))
+		require.NoError(t, err)
+		require.Equal(t, 2, numSent)
+	})
+	t.Run("altered transaction should not be sent", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		tp := createProcessor(&numSent)
+
+		tx := createTx(0)
+		tx.GasLimit++
+		statusCode, _, err := tp.SendTransaction(tx)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, err.Error(), apiErrors.ErrInvalidSignature.Error())
+		require.Contains(t, err.Error(), "sender signature does not match")
+		require.Zero(t, numSent)
+	})
+	t.Run("invalid guardian signature should not be sent", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		tp := createProcessor(&numSent)
+
+		tx := createTx(transaction.MaskGuardedTransaction)
+		tx.GuardianAddr = testPubkeyConverter.SilentEncode(guardianBytes, testLogger)
+		tx.Signature = signTx(tx, senderSk)
+		tx.GuardianSignature = signTx(tx, senderSk)
+		statusCode, _, err := tp.SendTransaction(tx)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, err.Error(), "guardian signature does not match")
+
+		tx.GuardianSignature = signTx(tx, guardianSk)
+		statusCode, _, err = tp.SendTransaction(tx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, 1, numSent)
+	})
+	t.Run("send multiple should skip the transactions with invalid signatures", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		tp := createProcessor(&numSent)
+
+		invalidTx := createTx(0)
+		invalidTx.Nonce++
+		response, err := tp.SendMultipleTransactions([]*data.Transaction{createTx(0), invalidTx})
+		require.NoError(t, err)
+		require.Len(t, response.FailedTxs, 1)
+		require.Contains(t, response.FailedTxs[1], apiErrors.ErrInvalidSignature.Error())
+		require.Equal(t, 1, numSent)
+	})
+}
+
+func TestTransactionProcessor_SendTransactionWithDuplicateNonceCheck(t *testing.T) {
+	t.Parallel()
+
+	accountNonce := uint64(10)
+	lastPoolNonce := uint64(14)
+	poolNonces := []uint64{10, 11, 12, 14}
+	createProcessor := func(numSent *int) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+					return []*data.NodeData{{Address: "observer", ShardId: 0}}, nil
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+					switch response := value.(type) {
+					case *data.TransactionsPoolLastNonceForSenderApiResponse:
+						response.Data.Nonce = lastPoolNonce
+					case *data.TransactionsPoolForSenderApiResponse:
+						require.Contains(t, path, "fields=nonce")
+						for _, nonce := range poolNonces {
+							response.Data.TxPool.Transactions = append(response.Data.TxPool.Transactions, data.WrappedTransaction{
+								TxFields: map[string]interface{}{"nonce": float64(nonce)},
+							})
+						}
+					}
+					return http.StatusOK, nil
+				},
+				CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+					*numSent++
+					response.(*data.ResponseTransaction).Data.TxHash = "hash"
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			config.SendTransactionQuorumConfig{},
+			config.TransactionsPolicyConfig{},
+		)
+		err := tp.SetSenderAccountHandler(&mock.ManagedSenderAccountHandlerStub{
+			GetAccountCalled: func(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Nonce: accountNonce}}, nil
+			},
+		})
+		require.Nil(t, err)
+
+		return tp
+	}
+	sendWithNonce := func(tp *process.TransactionProcessor, nonce uint64) (int, error) {
+		statusCode, _, err := tp.SendTransaction(&data.Transaction{
+			Sender:  "DEADBEEF",
+			Nonce:   nonce,
+			ChainID: "chain",
+			Version: 1,
+		})
+		return statusCode, err
+	}
+
+	t.Run("nil sender account handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(new(int))
+		require.Equal(t, process.ErrNilSenderAccountHandler, tp.SetSenderAccountHandler(nil))
+	})
+
+	t.Run("nonce lower than the account nonce should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		statusCode, err := sendWithNonce(createProcessor(&numSent), 9)
+		require.Equal(t, http.StatusConflict, statusCode)
+		require.True(t, errors.Is(err, apiErrors.ErrNonceAlreadyUsed))
+		require.Zero(t, numSent)
+	})
+
+	t.Run("nonce already in pool should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		statusCode, err := sendWithNonce(createProcessor(&numSent), 12)
+		require.Equal(t, http.StatusConflict, statusCode)
+		require.True(t, errors.Is(err, apiErrors.ErrNonceAlreadyUsed))
+		require.Zero(t, numSent)
+	})
+
+	t.Run("nonce filling a gap or following the pool should be sent", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		tp := createProcessor(&numSent)
+		statusCode, err := sendWithNonce(tp, 13)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+
+		statusCode, err = sendWithNonce(tp, 15)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, 2, numSent)
+	})
+}
+
+func TestTransactionProcessor_SetSentTransactionsRecorder(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(callPostHandler func(address string, path string, value interface{}, response interface{}) (int, error)) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+					return []*data.NodeData{
+						{Address: "observer1", ShardId: 0},
+						{Address: "observer2", ShardId: 0},
+					}, nil
+				},
+				CallPostRestEndPointCalled: callPostHandler,
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			config.SendTransactionQuorumConfig{},
+			config.TransactionsPolicyConfig{},
+		)
+
+		return tp
+	}
+
+	t.Run("nil recorder should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(nil)
+		err := tp.SetSentTransactionsRecorder(nil)
+		require.Equal(t, process.ErrNilSentTransactionsRecorder, err)
+	})
+	t.Run("should record the observer of the sent transaction", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(func(address string, path string, value interface{}, response interface{}) (int, error) {
+			if address == "observer1" {
+				return http.StatusRequestTimeout, errors.New("timeout")
+			}
+
+			response.(*data.ResponseTransaction).Data.TxHash = "hash"
+			return http.StatusOK, nil
+		})
+		recorded := make(map[string]string)
+		err := tp.SetSentTransactionsRecorder(&mock.SentTransactionsRecorderStub{
+			RecordSentTransactionCalled: func(txHash string, observer string) {
+				recorded[txHash] = observer
+			},
+		})
+		require.NoError(t, err)
+
+		_, _, err = tp.SendTransaction(&data.Transaction{Sender: "DEADBEEF", ChainID: "chain", Version: 1})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"hash": "observer2"}, recorded)
+	})
+	t.Run("should record the observer of the sent transactions", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(func(address string, path string, value interface{}, response interface{}) (int, error) {
+			response.(*data.ResponseMultipleTransactions).Data.TxsHashes = map[int]string{0: "hash1", 1: "hash2"}
+			return http.StatusOK, nil
+		})
+		recorded := make(map[string]string)
+		err := tp.SetSentTransactionsRecorder(&mock.SentTransactionsRecorderStub{
+			RecordSentTransactionCalled: func(txHash string, observer string) {
+				recorded[txHash] = observer
+			},
+		})
+		require.NoError(t, err)
+
+		txsToSend := []*data.Transaction{
+			{Receiver: "aaaaaa", Sender: hex.EncodeToString([]byte("cccccc")), ChainID: "chain", Version: 1},
+			{Receiver: "bbbbbb", Sender: hex.EncodeToString([]byte("dddddd")), ChainID: "chain", Version: 1},
+		}
+		_, err = tp.SendMultipleTransactions(txsToSend)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"hash1": "observer1", "hash2": "observer1"}, recorded)
+	})
+}
+
+func TestNewTransactionProcessor_InvalidSendTransactionQuorumShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sendTxQuorum := config.SendTransactionQuorumConfig{NumObservers: 2, MinAcknowledgements: 3}
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, sendTxQuorum, config.TransactionsPolicyConfig{})
+
+	require.Nil(t, tp)
+	require.True(t, errors.Is(err, process.ErrInvalidSendTransactionQuorum))
+}
+
+func TestTransactionProcessor_SendTransactionWithQuorum(t *testing.T) {
+	t.Parallel()
+
+	txHash := "DEADBEEF01234567890"
+	shardObservers := []*data.NodeData{
+		{Address: "address1", ShardId: 0},
+		{Address: "address2", ShardId: 0},
+		{Address: "address3", ShardId: 0},
+		{Address: "address4", ShardId: 0},
+	}
+	createTxProcessor := func(sendTxQuorum config.SendTransactionQuorumConfig, statusCodes map[string]int, numCalls *uint32) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+					return shardObservers, nil
+				},
+				CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+					atomic.AddUint32(numCalls, 1)
+					statusCode, found := statusCodes[address]
+					if !found {
+						statusCode = http.StatusOK
+					}
+					txResponse := response.(*data.ResponseTransaction)
+					if statusCode != http.StatusOK {
+						txResponse.Error = "observer error"
+						return statusCode, errors.New("observer error")
+					}
+
+					txResponse.Data.TxHash = txHash
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			sendTxQuorum,
+			config.TransactionsPolicyConfig{},
+		)
+
+		return tp
+	}
+	tx := &data.Transaction{
+		Sender:  "DEADBEEF",
+		ChainID: "chain",
+		Version: 1,
+	}
+
+	t.Run("all observers acknowledged", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		tp := createTxProcessor(config.SendTransactionQuorumConfig{NumObservers: 3, MinAcknowledgements: 2}, nil, &numCalls)
+		rc, resultedTxHash, err := tp.SendTransaction(tx)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, rc)
+		require.Equal(t, txHash, resultedTxHash)
+		require.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+	})
+
+	t.Run("unavailable observers should be replaced", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		statusCodes := map[string]int{
+			"address1": http.StatusNotFound,
+			"address2": http.StatusRequestTimeout,
+		}
+		tp := createTxProcessor(config.SendTransactionQuorumConfig{NumObservers: 2, MinAcknowledgements: 2}, statusCodes, &numCalls)
+		rc, resultedTxHash, err := tp.SendTransaction(tx)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusOK, rc)
+		require.Equal(t, txHash, resultedTxHash)
+		require.Equal(t, uint32(4), atomic.LoadUint32(&numCalls))
+	})
+
+	t.Run("quorum not reached should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		statusCodes := map[string]int{
+			"address1": http.StatusNotFound,
+			"address2": http.StatusNotFound,
+			"address3": http.StatusNotFound,
+		}
+		tp := createTxProcessor(config.SendTransactionQuorumConfig{NumObservers: 3, MinAcknowledgements: 2}, statusCodes, &numCalls)
+		rc, resultedTxHash, err := tp.SendTransaction(tx)
+		require.True(t, errors.Is(err, process.ErrSendTransactionQuorumNotReached))
+		require.Equal(t, http.StatusInternalServerError, rc)
+		require.Empty(t, resultedTxHash)
+		require.Equal(t, uint32(4), atomic.LoadUint32(&numCalls))
+	})
+
+	t.Run("rejected transaction should return the observer error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		statusCodes := map[string]int{
+			"address1": http.StatusBadRequest,
+			"address2": http.StatusBadRequest,
+		}
+		tp := createTxProcessor(config.SendTransactionQuorumConfig{NumObservers: 2, MinAcknowledgements: 1}, statusCodes, &numCalls)
+		rc, resultedTxHash, err := tp.SendTransaction(tx)
+		require.NotNil(t, err)
+		require.Equal(t, http.StatusBadRequest, rc)
+		require.Empty(t, resultedTxHash)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+	})
+}
+
 // //------- SendMultipleTransactions
 
 func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
@@ -369,6 +924,8 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	response, err := tp.SendMultipleTransactions(txsToSend)
@@ -442,6 +999,8 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	response, err := tp.SendMultipleTransactions(txsToSend)
@@ -457,6 +1016,333 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 	)
 }
 
+func TestTransactionProcessor_SendMultipleTransactionsShouldOrderByNonceAndReportFailures(t *testing.T) {
+	t.Parallel()
+
+	sndrShard0 := hex.EncodeToString([]byte("bbbbbb"))
+	otherSndrShard0 := hex.EncodeToString([]byte("dddddd"))
+	sndrShard1 := hex.EncodeToString([]byte("cccccc"))
+	txsToSend := []*data.Transaction{
+		{Receiver: "aaaaaa", Sender: sndrShard0, Nonce: 2, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: otherSndrShard0, Nonce: 7, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard0, Nonce: 1, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard0, Nonce: 3},
+		{Receiver: "aaaaaa", Sender: sndrShard1, Nonce: 1, ChainID: "chain", Version: 1},
+	}
+
+	sentBatches := make(map[string][][]uint64)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				if hex.EncodeToString(addressBuff) == sndrShard1 {
+					return 1, nil
+				}
+				return 0, nil
+			},
+			GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				if shardID == 1 {
+					return []*data.NodeData{{Address: "observer2", ShardId: 1}}, nil
+				}
+				return []*data.NodeData{
+					{Address: "observer0", ShardId: 0},
+					{Address: "observer1", ShardId: 0},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, _ string, value interface{}, response interface{}) (int, error) {
+				receivedTxs := value.([]*data.Transaction)
+				nonces := make([]uint64, 0, len(receivedTxs))
+				for _, tx := range receivedTxs {
+					nonces = append(nonces, tx.Nonce)
+				}
+				sentBatches[address] = append(sentBatches[address], nonces)
+
+				resp := response.(*data.ResponseMultipleTransactions)
+				switch address {
+				case "observer0":
+					// the first observer does not accept the transaction of the other sender
+					resp.Data.NumOfTxs = 2
+					resp.Data.TxsHashes = map[int]string{0: "hash-nonce-1", 1: "hash-nonce-2"}
+					return http.StatusOK, nil
+				case "observer1":
+					resp.Data.NumOfTxs = 1
+					resp.Data.TxsHashes = map[int]string{0: "hash-other-sender"}
+					return http.StatusOK, nil
+				default:
+					resp.Error = "observer is down"
+					return http.StatusInternalServerError, errors.New("internal error")
+				}
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
+	)
+
+	response, err := tp.SendMultipleTransactions(txsToSend)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), response.NumOfTxs)
+	require.Equal(t, map[int]string{0: "hash-nonce-2", 1: "hash-other-sender", 2: "hash-nonce-1"}, response.TxsHashes)
+	require.Len(t, response.FailedTxs, 2)
+	require.Contains(t, response.FailedTxs[3], "chainID")
+	require.Equal(t, "observer is down", response.FailedTxs[4])
+
+	require.Equal(t, [][]uint64{{1, 2, 7}}, sentBatches["observer0"])
+	require.Equal(t, [][]uint64{{7}}, sentBatches["observer1"])
+	require.Equal(t, [][]uint64{{1}}, sentBatches["observer2"])
+}
+
+func TestTransactionProcessor_DryRunMultipleTransactions(t *testing.T) {
+	t.Parallel()
+
+	sndrShard0 := hex.EncodeToString([]byte("bbbbbb"))
+	sndrShard1 := hex.EncodeToString([]byte("cccccc"))
+	createTxs := func() []*data.Transaction {
+		return []*data.Transaction{
+			{Receiver: sndrShard0, Sender: sndrShard0, Nonce: 1, Value: "10", ChainID: "chain", Version: 1},
+			{Receiver: sndrShard0, Sender: sndrShard0, Nonce: 2, Value: "10"},
+			{Receiver: sndrShard0, Sender: sndrShard1, Nonce: 1, Value: "10", ChainID: "chain", Version: 1},
+			{Receiver: sndrShard0, Sender: sndrShard1, Nonce: 2, Value: "invalid", ChainID: "chain", Version: 1},
+		}
+	}
+	createProcessor := func(simulatedShards map[string]int) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					if hex.EncodeToString(addressBuff) == sndrShard1 {
+						return 1, nil
+					}
+					return 0, nil
+				},
+				GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardID), ShardId: shardID}}, nil
+				},
+				CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+					require.Equal(t, process.TransactionSimulatePath, path)
+					simulatedShards[address]++
+
+					tx := value.(*data.Transaction)
+					resp := response.(*data.ResponseTransactionSimulation)
+					resp.Data.Result.Status = transaction.TxStatusSuccess
+					if tx.Sender == sndrShard1 && address == "observer0" {
+						resp.Data.Result.Status = transaction.TxStatusFail
+						resp.Data.Result.FailReason = "insufficient funds"
+					}
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			config.SendTransactionQuorumConfig{},
+			config.TransactionsPolicyConfig{},
+		)
+
+		return tp
+	}
+
+	t.Run("without simulation should only check the transactions", func(t *testing.T) {
+		t.Parallel()
+
+		simulatedShards := make(map[string]int)
+		txs := createTxs()
+		tp := createProcessor(simulatedShards)
+		response := tp.DryRunMultipleTransactions(txs, common.TransactionsDryRunOptions{})
+		require.Empty(t, simulatedShards)
+		require.Equal(t, 2, response.NumValid)
+		require.Equal(t, 2, response.NumInvalid)
+		require.Len(t, response.Verdicts, 4)
+
+		expectedHash, _ := tp.ComputeTransactionHash(txs[0])
+		require.Equal(t, 0, response.Verdicts[0].Index)
+		require.True(t, response.Verdicts[0].Valid)
+		require.Equal(t, expectedHash, response.Verdicts[0].Hash)
+		require.Equal(t, uint32(0), *response.Verdicts[0].SenderShard)
+
+		require.Equal(t, 1, response.Verdicts[1].Index)
+		require.False(t, response.Verdicts[1].Valid)
+		require.Contains(t, response.Verdicts[1].Error, "chainID")
+		require.Nil(t, response.Verdicts[1].SenderShard)
+
+		require.True(t, response.Verdicts[2].Valid)
+		require.Equal(t, uint32(1), *response.Verdicts[2].SenderShard)
+
+		require.False(t, response.Verdicts[3].Valid)
+		require.Equal(t, process.ErrInvalidTransactionValueField.Error(), response.Verdicts[3].Error)
+	})
+	t.Run("with simulation should report the failed simulations", func(t *testing.T) {
+		t.Parallel()
+
+		simulatedShards := make(map[string]int)
+		tp := createProcessor(simulatedShards)
+		response := tp.DryRunMultipleTransactions(createTxs(), common.TransactionsDryRunOptions{Simulate: true, CheckSignature: true})
+		require.Equal(t, map[string]int{"observer0": 2, "observer1": 1}, simulatedShards)
+		require.Equal(t, 1, response.NumValid)
+		require.Equal(t, 3, response.NumInvalid)
+
+		require.True(t, response.Verdicts[0].Valid)
+		require.IsType(t, data.TransactionSimulationResponseData{}, response.Verdicts[0].Simulation)
+
+		require.False(t, response.Verdicts[2].Valid)
+		require.Equal(t, "receiverShard: insufficient funds", response.Verdicts[2].Error)
+		require.IsType(t, data.TransactionSimulationResponseDataCrossShard{}, response.Verdicts[2].Simulation)
+	})
+}
+
+func TestTransactionProcessor_DecodeRawTransaction(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
+	)
+
+	t.Run("invalid bytes should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := tp.DecodeRawTransaction([]byte("not a transaction"))
+		require.Nil(t, tx)
+		require.True(t, errors.Is(err, process.ErrInvalidRawTransaction))
+	})
+	t.Run("non canonical bytes should error", func(t *testing.T) {
+		t.Parallel()
+
+		txBytes, _ := marshalizer.Marshal(&transaction.Transaction{Nonce: 1, Value: big.NewInt(10), RcvAddr: []byte("rcv"), SndAddr: []byte("snd")})
+		// the nonce field is encoded twice, the last occurrence being the one decoded
+		txBytes = append([]byte{0x08, 0x05}, txBytes...)
+
+		tx, err := tp.DecodeRawTransaction(txBytes)
+		require.Nil(t, tx)
+		require.Equal(t, process.ErrNonCanonicalRawTransaction, err)
+	})
+	t.Run("should decode the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		protocolTx := &transaction.Transaction{
+			Nonce:             7,
+			Value:             big.NewInt(1000),
+			RcvAddr:           []byte("receiver"),
+			SndAddr:           []byte("sender"),
+			GasPrice:          1000000000,
+			GasLimit:          70000,
+			Data:              []byte("data"),
+			ChainID:           []byte("1"),
+			Version:           2,
+			Signature:         []byte("signature"),
+			Options:           2,
+			GuardianAddr:      []byte("guardian"),
+			GuardianSignature: []byte("guardian signature"),
+		}
+		txBytes, _ := marshalizer.Marshal(protocolTx)
+
+		tx, err := tp.DecodeRawTransaction(txBytes)
+		require.NoError(t, err)
+		require.Equal(t, &data.Transaction{
+			Nonce:             7,
+			Value:             "1000",
+			Receiver:          hex.EncodeToString([]byte("receiver")),
+			Sender:            hex.EncodeToString([]byte("sender")),
+			GasPrice:          1000000000,
+			GasLimit:          70000,
+			Data:              []byte("data"),
+			Signature:         hex.EncodeToString([]byte("signature")),
+			ChainID:           "1",
+			Version:           2,
+			Options:           2,
+			GuardianAddr:      hex.EncodeToString([]byte("guardian")),
+			GuardianSignature: hex.EncodeToString([]byte("guardian signature")),
+		}, tx)
+	})
+}
+
+func TestTransactionProcessor_ComputeTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	createNetworkConfig := func() *data.NetworkConfig {
+		networkConfig := &data.NetworkConfig{}
+		networkConfig.Config.MinGasLimit = 50000
+		networkConfig.Config.MinGasPrice = 1000000000
+		networkConfig.Config.GasPerDataByte = 1500
+		networkConfig.Config.GasPriceModifier = "0.01"
+		networkConfig.Config.ExtraGasLimitGuarded = 50000
+		networkConfig.Config.MaxGasPerTransaction = 600000000
+		return networkConfig
+	}
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
+	)
+
+	t.Run("move balance should work", func(t *testing.T) {
+		t.Parallel()
+
+		fee, err := tp.ComputeTransactionFee(&data.Transaction{GasLimit: 50000, GasPrice: 1000000000}, createNetworkConfig())
+		require.NoError(t, err)
+		require.Equal(t, "50000000000000", fee.Fee)
+		require.Equal(t, uint64(50000), fee.MoveBalanceGas)
+		require.Zero(t, fee.ProcessingGas)
+	})
+	t.Run("smart contract call should apply the gas price modifier", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.Transaction{GasLimit: 5000000, GasPrice: 1000000000, Data: []byte("claim")}
+		fee, err := tp.ComputeTransactionFee(tx, createNetworkConfig())
+		require.NoError(t, err)
+		require.Equal(t, uint64(57500), fee.MoveBalanceGas)
+		require.Equal(t, uint64(4942500), fee.ProcessingGas)
+		require.Equal(t, uint64(10000000), fee.ProcessingGasPrice)
+		require.Equal(t, 0.01, fee.GasPriceModifier)
+		require.Equal(t, "106925000000000", fee.Fee)
+	})
+	t.Run("guarded and relayed transactions should pay the extra gas", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.Transaction{GasLimit: 150000, GasPrice: 1000000000, Options: 2, RelayerAddr: "relayer"}
+		fee, err := tp.ComputeTransactionFee(tx, createNetworkConfig())
+		require.NoError(t, err)
+		require.Equal(t, uint64(150000), fee.MoveBalanceGas)
+		require.Equal(t, "150000000000000", fee.Fee)
+	})
+	t.Run("invalid gas values should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := tp.ComputeTransactionFee(&data.Transaction{GasLimit: 50000, GasPrice: 1}, createNetworkConfig())
+		require.True(t, errors.Is(err, process.ErrInsufficientGasPrice))
+
+		_, err = tp.ComputeTransactionFee(&data.Transaction{GasLimit: 50000, GasPrice: 1000000000, Data: []byte("a")}, createNetworkConfig())
+		require.True(t, errors.Is(err, process.ErrInsufficientGasLimit))
+
+		_, err = tp.ComputeTransactionFee(&data.Transaction{GasLimit: 600000001, GasPrice: 1000000000}, createNetworkConfig())
+		require.True(t, errors.Is(err, process.ErrHigherGasLimitThanAllowed))
+
+		networkConfig := createNetworkConfig()
+		networkConfig.Config.GasPriceModifier = ""
+		_, err = tp.ComputeTransactionFee(&data.Transaction{GasLimit: 50000, GasPrice: 1000000000}, networkConfig)
+		require.True(t, errors.Is(err, process.ErrInvalidGasPriceModifier))
+	})
+}
+
 func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -486,6 +1372,8 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	response, err := tp.SimulateTransaction(txsToSimulate, true)
@@ -495,6 +1383,111 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 	require.Equal(t, expectedFailReason, respData.Result.FailReason)
 }
 
+func TestTransactionProcessor_TransactionCostDetailedRequest(t *testing.T) {
+	t.Parallel()
+
+	txToEstimate := &data.Transaction{Receiver: "aaaaaa", Sender: hex.EncodeToString([]byte("cccccc")), ChainID: "chain", Version: 1}
+
+	t.Run("cost handler error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			func() (process.TransactionCostHandler, error) {
+				return &mock.TransactionCostHandlerStub{
+					RezolveCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+						return nil, expectedErr
+					},
+				}, nil
+			},
+			logsMerger,
+			true,
+			config.SendTransactionQuorumConfig{},
+			config.TransactionsPolicyConfig{},
+		)
+
+		response, err := tp.TransactionCostDetailedRequest(txToEstimate)
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should return the gas breakdown sorted by hash", func(t *testing.T) {
+		t.Parallel()
+
+		scResults := map[string]*data.ExtendedApiSmartContractResult{
+			"scr2": {
+				ApiSmartContractResult: &transaction.ApiSmartContractResult{
+					SndAddr:  "contract",
+					RcvAddr:  "sender",
+					GasLimit: 0,
+					GasPrice: 1000000000,
+					IsRefund: true,
+				},
+			},
+			"scr1": {
+				ApiSmartContractResult: &transaction.ApiSmartContractResult{
+					SndAddr:       "sender",
+					RcvAddr:       "contract",
+					Function:      "claim",
+					GasLimit:      500000,
+					GasPrice:      1000000000,
+					ReturnMessage: "ok",
+				},
+			},
+		}
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			func() (process.TransactionCostHandler, error) {
+				return &mock.TransactionCostHandlerStub{
+					RezolveCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+						return &data.TxCostResponseData{
+							TxCost:     1500000,
+							RetMessage: "",
+							ReturnData: [][]byte{[]byte("ok")},
+							ScResults:  scResults,
+						}, nil
+					},
+				}, nil
+			},
+			logsMerger,
+			true,
+			config.SendTransactionQuorumConfig{},
+			config.TransactionsPolicyConfig{},
+		)
+
+		response, err := tp.TransactionCostDetailedRequest(txToEstimate)
+		require.Nil(t, err)
+		require.Equal(t, uint64(1500000), response.TxCost)
+		require.Equal(t, [][]byte{[]byte("ok")}, response.ReturnData)
+		require.Equal(t, scResults, response.ScResults)
+		require.Equal(t, []*data.SCRGasBreakdown{
+			{
+				Hash:          "scr1",
+				Sender:        "sender",
+				Receiver:      "contract",
+				Function:      "claim",
+				GasLimit:      500000,
+				GasPrice:      1000000000,
+				ReturnMessage: "ok",
+			},
+			{
+				Hash:     "scr2",
+				Sender:   "contract",
+				Receiver: "sender",
+				GasPrice: 1000000000,
+				IsRefund: true,
+			},
+		}, response.GasBreakdown)
+	})
+}
+
 func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnReceiverShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -541,6 +1534,8 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	response, err := tp.SimulateTransaction(txsToSimulate, true)
@@ -611,6 +1606,8 @@ func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
@@ -669,6 +1666,8 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransaction(t *testi
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
@@ -740,6 +1739,8 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinati
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
@@ -809,6 +1810,8 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressCrossShard(t
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
@@ -831,6 +1834,8 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderInvaidSender(t *test
 		marshalizer, funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	txStatus, err := tp.GetTransactionStatus(string(hash0), "blablabla")
@@ -887,6 +1892,8 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
@@ -911,7 +1918,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidTransactionValue(t *testi
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidTransactionValueField, err)
@@ -934,7 +1941,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidReceiverAddress(t *testin
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -956,7 +1963,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSenderAddress(t *testing.
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -978,7 +1985,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSignaturesBytes(t *testin
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidSignatureBytes, err)
@@ -1001,7 +2008,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork1(t *testing.T) {
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	txHashHex := "891694ae6307ee9f17f861816187a6729268397f8fabc055d5b334f552cd3cfb"
 	txHash, err := tp.ComputeTransactionHash(tx)
@@ -1028,7 +2035,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork2(t *testing.T) {
 	protoTxHash := hex.EncodeToString(protoTxHashBytes)
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 
 	txHash, err := tp.ComputeTransactionHash(&data.Transaction{
 		Nonce:     protoTx.Nonce,
@@ -1105,6 +2112,8 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	tx, err := tp.GetTransaction(string(hash0), false)
@@ -1112,6 +2121,74 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 	assert.Equal(t, expectedNonce, tx.Nonce)
 }
 
+func TestTransactionProcessor_GetRelayedTransactionOfInner(t *testing.T) {
+	t.Parallel()
+
+	txs := map[string]transaction.ApiTransactionResult{
+		"relayedHash": {Hash: "relayedHash", ProcessingTypeOnSource: "RelayedTx", ProcessingTypeOnDestination: "RelayedTx"},
+		"regularHash": {Hash: "regularHash", ProcessingTypeOnSource: "SCInvoking", ProcessingTypeOnDestination: "SCInvoking"},
+	}
+	requestedPaths := make([]string, 0)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				requestedPaths = append(requestedPaths, path)
+				for hash, tx := range txs {
+					if strings.Contains(path, hash) {
+						value.(*data.GetTransactionResponse).Data.Transaction = tx
+						return http.StatusOK, nil
+					}
+				}
+
+				return http.StatusInternalServerError, errors.New("transaction not found")
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
+	)
+
+	t.Run("transaction without original hash should not look up", func(t *testing.T) {
+		relayedTx, err := tp.GetRelayedTransactionOfInner(&transaction.ApiTransactionResult{Hash: "innerHash"}, false)
+		require.NoError(t, err)
+		require.Nil(t, relayedTx)
+
+		relayedTx, err = tp.GetRelayedTransactionOfInner(&transaction.ApiTransactionResult{Hash: "hash", OriginalTransactionHash: "hash"}, false)
+		require.NoError(t, err)
+		require.Nil(t, relayedTx)
+		require.Empty(t, requestedPaths)
+	})
+	t.Run("inner transaction of a relayed one should return the relayed transaction", func(t *testing.T) {
+		innerTx := &transaction.ApiTransactionResult{Hash: "innerHash", OriginalTransactionHash: "relayedHash"}
+		relayedTx, err := tp.GetRelayedTransactionOfInner(innerTx, false)
+		require.NoError(t, err)
+		require.Equal(t, "relayedHash", relayedTx.Hash)
+	})
+	t.Run("result of a regular transaction should not return it", func(t *testing.T) {
+		scr := &transaction.ApiTransactionResult{Hash: "scrHash", OriginalTransactionHash: "regularHash"}
+		relayedTx, err := tp.GetRelayedTransactionOfInner(scr, false)
+		require.NoError(t, err)
+		require.Nil(t, relayedTx)
+	})
+	t.Run("missing original transaction should error", func(t *testing.T) {
+		innerTx := &transaction.ApiTransactionResult{Hash: "innerHash", OriginalTransactionHash: "missingHash"}
+		relayedTx, err := tp.GetRelayedTransactionOfInner(innerTx, false)
+		require.Error(t, err)
+		require.Nil(t, relayedTx)
+	})
+}
+
 func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttpError(t *testing.T) {
 	t.Parallel()
 
@@ -1155,6 +2232,8 @@ func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttp
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	_, _ = tp.GetTransaction(string(hash0), false)
@@ -1201,6 +2280,8 @@ func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfN
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	_, _ = tp.GetTransaction(string(hash0), false)
@@ -1314,6 +2395,8 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	tx, err := tp.GetTransaction(string(hash0), true)
@@ -1329,10 +2412,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPool, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("")
+		txs, err := tp.GetTransactionsPool(context.Background(), "", 0)
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1370,10 +2453,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce", 0)
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1483,7 +2566,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1491,19 +2574,58 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTxSh0, scrTxSh1},
 			Rewards:              []data.WrappedTransaction{rewardsTxSh0, rewardsTxSh1},
 		}
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce", 0)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
 
+	t.Run("GetTransactionsPool, truncated shard pool should stop the aggregation", func(t *testing.T) {
+		t.Parallel()
+
+		tx := data.WrappedTransaction{TxFields: map[string]interface{}{"nonce": float64(1)}}
+		skipsPerShard := make(map[string]uint64)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, 2}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointWithTruncationCalled: func(_ context.Context, address string, path string, value interface{}, numItemsToSkip uint64) (int, data.ResponseTruncation, error) {
+				skipsPerShard[address] = numItemsToSkip
+				response := value.(*data.TransactionsPoolApiResponse)
+				switch address {
+				case "observer0":
+					// 3 transactions in pool, all of them already delivered
+					return http.StatusOK, data.ResponseTruncation{NumSkipped: 3}, nil
+				case "observer1":
+					// 5 transactions in pool, 1 already delivered, 2 fitting in the response
+					response.Data.Transactions.RegularTransactions = []data.WrappedTransaction{tx, tx}
+					return http.StatusOK, data.ResponseTruncation{NumSkipped: 1, NumReturned: 2, Truncated: true}, nil
+				default:
+					require.Fail(t, "should not have been called")
+					return http.StatusInternalServerError, data.ResponseTruncation{}, nil
+				}
+			},
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
+		require.NotNil(t, tp)
+
+		txs, err := tp.GetTransactionsPool(context.Background(), "", 4)
+		require.Nil(t, err)
+		require.Len(t, txs.RegularTransactions, 2)
+		require.True(t, txs.Truncated)
+		require.Equal(t, uint64(6), txs.NextCursor)
+		require.Equal(t, map[string]uint64{"observer0": 4, "observer1": 1}, skipsPerShard)
+	})
+
 	// GetTransactionsPoolForShard
 	t.Run("GetTransactionsPoolForShard, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "", 0)
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1533,10 +2655,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce", 0)
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1628,7 +2750,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1636,11 +2758,65 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTx0, scrTx1},
 			Rewards:              []data.WrappedTransaction{rewardsTx0, rewardsTx1},
 		}
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce", 0)
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
 
+	// GetTransactionsPoolForShardStream
+	t.Run("GetTransactionsPoolForShardStream, flag not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
+		require.NotNil(t, tp)
+
+		body, err := tp.GetTransactionsPoolForShardStream(context.Background(), 0, "")
+		assert.Nil(t, body)
+		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
+	})
+	t.Run("GetTransactionsPoolForShardStream, should relay the first available observer response", func(t *testing.T) {
+		t.Parallel()
+
+		observerResponse := `{"data":{"txPool":{}},"error":"","code":"successful"}`
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: "observer0", ShardId: shardId},
+					{Address: "observer1", ShardId: shardId},
+				}, nil
+			},
+			CallGetRestEndPointStreamCalled: func(_ context.Context, address string, path string) (int, io.ReadCloser, error) {
+				assert.Equal(t, "/transaction/pool?fields=sender,nonce", path)
+				if address == "observer0" {
+					return http.StatusNotFound, nil, errors.New("observer down")
+				}
+
+				return http.StatusOK, io.NopCloser(strings.NewReader(observerResponse)), nil
+			},
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
+
+		body, err := tp.GetTransactionsPoolForShardStream(context.Background(), 0, "sender,nonce")
+		require.Nil(t, err)
+		receivedResponse, _ := io.ReadAll(body)
+		assert.Equal(t, observerResponse, string(receivedResponse))
+	})
+	t.Run("GetTransactionsPoolForShardStream, all observers fail should error", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointStreamCalled: func(_ context.Context, address string, path string) (int, io.ReadCloser, error) {
+				return http.StatusTooManyRequests, nil, errors.New("too many requests")
+			},
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
+
+		body, err := tp.GetTransactionsPoolForShardStream(context.Background(), 0, "")
+		assert.Nil(t, body)
+		assert.Equal(t, apiErrors.ErrTransactionsNotFoundInPool, err)
+	})
+
 	// GetTransactionsPoolForSender + GetLastPoolNonceForSender + GetTransactionsPoolNonceGapsForSender
 	t.Run("no txs in pool", func(t *testing.T) {
 		t.Parallel()
@@ -1676,7 +2852,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 		require.NotNil(t, tp)
 
 		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
@@ -1753,7 +2929,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, config.TransactionsPolicyConfig{})
 		require.NotNil(t, tp)
 
 		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
@@ -2061,6 +3237,8 @@ func TestTransactionProcessor_GetProcessedTransactionStatus(t *testing.T) {
 		funcNewTxCostHandler,
 		logsMerger,
 		true,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	status, err := tp.GetProcessedTransactionStatus(string(hash0))
@@ -2068,6 +3246,93 @@ func TestTransactionProcessor_GetProcessedTransactionStatus(t *testing.T) {
 	assert.Equal(t, string(transaction.TxStatusPending), status.Status) // not a move balance tx with missing finish markers
 }
 
+func TestTransactionProcessor_GetTransactionProtobuf(t *testing.T) {
+	t.Parallel()
+
+	createProcessor := func(apiTx transaction.ApiTransactionResult) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetShardIDsCalled: func() []uint32 {
+					return []uint32{0}
+				},
+				GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+				},
+				CallGetRestEndPointCalled: func(_ string, _ string, value interface{}) (int, error) {
+					txResponse := value.(*data.GetTransactionResponse)
+					txResponse.Data.Transaction = apiTx
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			true,
+			config.SendTransactionQuorumConfig{},
+			config.TransactionsPolicyConfig{},
+		)
+
+		return tp
+	}
+
+	t.Run("unsupported transaction type should err", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(transaction.ApiTransactionResult{Type: string(transaction.TxTypeUnsigned)})
+		txBytes, err := tp.GetTransactionProtobuf("hash", "")
+		require.Nil(t, txBytes)
+		require.True(t, errors.Is(err, process.ErrProtobufNotSupportedForTransactionType))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(transaction.ApiTransactionResult{
+			Type:              string(transaction.TxTypeNormal),
+			Nonce:             7,
+			Value:             "1000",
+			Receiver:          "aaaa",
+			Sender:            "bbbb",
+			GasPrice:          1000000000,
+			GasLimit:          50000,
+			Data:              []byte("data"),
+			Signature:         "cccc",
+			ChainID:           "1",
+			Version:           2,
+			Options:           2,
+			GuardianAddr:      "dddd",
+			GuardianSignature: "eeee",
+			Status:            transaction.TxStatusSuccess,
+		})
+		txBytes, err := tp.GetTransactionProtobuf("hash", "")
+		require.NoError(t, err)
+
+		protocolTx := &transaction.Transaction{}
+		err = marshalizer.Unmarshal(protocolTx, txBytes)
+		require.NoError(t, err)
+		expectedTx := &transaction.Transaction{
+			Nonce:             7,
+			Value:             big.NewInt(1000),
+			RcvAddr:           []byte{0xaa, 0xaa},
+			SndAddr:           []byte{0xbb, 0xbb},
+			GasPrice:          1000000000,
+			GasLimit:          50000,
+			Data:              []byte("data"),
+			ChainID:           []byte("1"),
+			Version:           2,
+			Signature:         []byte{0xcc, 0xcc},
+			Options:           2,
+			GuardianAddr:      []byte{0xdd, 0xdd},
+			GuardianSignature: []byte{0xee, 0xee},
+		}
+		require.Equal(t, expectedTx, protocolTx)
+	})
+}
+
 func TestTransactionProcessor_GetProcessedStatusIntraShardTxWithPendingSCR(t *testing.T) {
 	txWithSCRs := loadJsonIntoTxAndScrs(t, "./testdata/transactionWithScrs.json")
 
@@ -2104,6 +3369,8 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardTxWithPendingSCR(t *te
 		funcNewTxCostHandler,
 		logsMerger,
 		false,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	status := tp.ComputeTransactionStatus(txWithSCRs.Transaction, true)
@@ -2111,6 +3378,85 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardTxWithPendingSCR(t *te
 
 }
 
+func TestTransactionProcessor_GetProcessedStatusShouldAggregateAllScrs(t *testing.T) {
+	t.Parallel()
+
+	const withResults = true
+	t.Run("scr not yet found on the observers should be pending", func(t *testing.T) {
+		t.Parallel()
+
+		testData := loadJsonIntoTxAndScrs(t, "./testdata/finishedOKSCCall.json")
+		testData.SCRs = testData.SCRs[1:]
+		tp := createTestProcessorFromScenarioData(testData)
+
+		status := tp.ComputeTransactionStatus(testData.Transaction, withResults)
+		require.Equal(t, string(transaction.TxStatusPending), status.Status)
+	})
+	t.Run("pending scr generated by another scr should be pending", func(t *testing.T) {
+		t.Parallel()
+
+		testData := loadJsonIntoTxAndScrs(t, "./testdata/finishedOKSCCall.json")
+		testData.SCRs[0].SmartContractResults = []*transaction.ApiSmartContractResult{{Hash: "SCR-hash4"}}
+		testData.SCRs = append(testData.SCRs, &transaction.ApiTransactionResult{
+			Hash:   "SCR-hash4",
+			Status: transaction.TxStatusPending,
+		})
+		tp := createTestProcessorFromScenarioData(testData)
+
+		status := tp.ComputeTransactionStatus(testData.Transaction, withResults)
+		require.Equal(t, string(transaction.TxStatusPending), status.Status)
+	})
+	t.Run("executed scr generated by another scr should be success", func(t *testing.T) {
+		t.Parallel()
+
+		testData := loadJsonIntoTxAndScrs(t, "./testdata/finishedOKSCCall.json")
+		testData.SCRs[0].SmartContractResults = []*transaction.ApiSmartContractResult{{Hash: "SCR-hash4"}, {Hash: "SCR-hash1"}}
+		testData.SCRs = append(testData.SCRs, &transaction.ApiTransactionResult{
+			Hash:   "SCR-hash4",
+			Status: transaction.TxStatusSuccess,
+		})
+		tp := createTestProcessorFromScenarioData(testData)
+
+		status := tp.ComputeTransactionStatus(testData.Transaction, withResults)
+		require.Equal(t, string(transaction.TxStatusSuccess), status.Status)
+	})
+	t.Run("executed scrs fanning out should be success", func(t *testing.T) {
+		t.Parallel()
+
+		testData := loadJsonIntoTxAndScrs(t, "./testdata/finishedOKSCCall.json")
+		for i := 0; i < 50; i++ {
+			scrHash := fmt.Sprintf("SCR-fan-out-hash%d", i)
+			testData.SCRs[0].SmartContractResults = append(testData.SCRs[0].SmartContractResults, &transaction.ApiSmartContractResult{Hash: scrHash})
+			testData.SCRs = append(testData.SCRs, &transaction.ApiTransactionResult{
+				Hash:   scrHash,
+				Status: transaction.TxStatusSuccess,
+			})
+		}
+		tp := createTestProcessorFromScenarioData(testData)
+
+		status := tp.ComputeTransactionStatus(testData.Transaction, withResults)
+		require.Equal(t, string(transaction.TxStatusSuccess), status.Status)
+	})
+	t.Run("too many scrs should not compute the status out of partial data", func(t *testing.T) {
+		t.Parallel()
+
+		testData := loadJsonIntoTxAndScrs(t, "./testdata/finishedOKSCCall.json")
+		for i := 0; i < 200; i++ {
+			scrHash := fmt.Sprintf("SCR-fan-out-hash%d", i)
+			testData.SCRs[0].SmartContractResults = append(testData.SCRs[0].SmartContractResults, &transaction.ApiSmartContractResult{Hash: scrHash})
+			testData.SCRs = append(testData.SCRs, &transaction.ApiTransactionResult{
+				Hash:   scrHash,
+				Status: transaction.TxStatusSuccess,
+			})
+		}
+		tp := createTestProcessorFromScenarioData(testData)
+
+		status := tp.ComputeTransactionStatus(testData.Transaction, withResults)
+		require.Equal(t, string(data.TxStatusUnknown), status.Status)
+		require.Equal(t, process.ErrTooManySmartContractResults.Error(), status.Reason)
+	})
+}
+
 func TestCheckIfFailed(t *testing.T) {
 	t.Parallel()
 
@@ -2188,6 +3534,8 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardRelayedV3WithMoveBalan
 		funcNewTxCostHandler,
 		logsMerger,
 		false,
+		config.SendTransactionQuorumConfig{},
+		config.TransactionsPolicyConfig{},
 	)
 
 	status := tp.ComputeTransactionStatus(txWithSCRs.Transaction, true)
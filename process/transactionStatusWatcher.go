@@ -0,0 +1,487 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	minStatusWatcherTickIntervalInMs = 10
+
+	// unknownWatchShardID groups the watched transactions whose sender is not known
+	unknownWatchShardID = core.AllShardId
+)
+
+// TransactionStatusSink defines a destination of the events emitted by the transaction status watcher. The events are
+// emitted from the watching go routine, so the sinks should not block
+type TransactionStatusSink interface {
+	OnTransactionStatus(event *data.TransactionStatusEvent)
+}
+
+// TransactionWatchOptions holds the options of a single watch
+type TransactionWatchOptions struct {
+	Sender          string
+	PollingInterval time.Duration
+	Timeout         time.Duration
+}
+
+type statusSubscription struct {
+	sink            TransactionStatusSink
+	pollingInterval time.Duration
+	timeout         time.Duration
+	registeredAt    time.Time
+}
+
+type watchedTransaction struct {
+	txHash        string
+	shardID       uint32
+	lastStatus    *data.ProcessStatusResponse
+	lastCheckedAt time.Time
+	subscriptions map[uint64]*statusSubscription
+}
+
+type statusCheckResult struct {
+	txHash string
+	status *data.ProcessStatusResponse
+}
+
+// TransactionStatusWatcher tracks the process status of the watched transactions and emits an event to the sinks of
+// each watch once the transaction reaches a final status or the watch times out. A transaction watched by more
+// consumers is only polled once, at the shortest polling interval of its watches
+type TransactionStatusWatcher struct {
+	proc                      Processor
+	pubKeyConverter           core.PubkeyConverter
+	txProc                    TransactionProcessStatusHandler
+	txsFeed                   TransactionsFeedHandler
+	tickInterval              time.Duration
+	maxWatched                int
+	maxQueriesPerShardPerTick int
+	maxTransactionsPerStream  int
+	streamTimeout             time.Duration
+	mutWatches                sync.Mutex
+	watchedTxs                map[string]*watchedTransaction
+	lastSubscriptionID        uint64
+	cancelFunc                func()
+	getTimeHandler            func() time.Time
+}
+
+// NewTransactionStatusWatcher will create a new instance of TransactionStatusWatcher
+func NewTransactionStatusWatcher(
+	proc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	txProc TransactionProcessStatusHandler,
+	txsFeed TransactionsFeedHandler,
+	cfg config.TransactionStatusWatcherConfig,
+) (*TransactionStatusWatcher, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if txProc == nil {
+		return nil, ErrNilTransactionProcessor
+	}
+	if txsFeed == nil {
+		return nil, ErrNilTransactionsFeedHandler
+	}
+	err := checkTransactionStatusWatcherConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransactionStatusWatcher{
+		proc:                      proc,
+		pubKeyConverter:           pubKeyConverter,
+		txProc:                    txProc,
+		txsFeed:                   txsFeed,
+		tickInterval:              time.Duration(cfg.TickIntervalInMs) * time.Millisecond,
+		maxWatched:                cfg.MaxWatchedTransactions,
+		maxQueriesPerShardPerTick: cfg.MaxQueriesPerShardPerTick,
+		maxTransactionsPerStream:  cfg.MaxTransactionsPerStream,
+		streamTimeout:             time.Duration(cfg.StreamTimeoutInSec) * time.Second,
+		watchedTxs:                make(map[string]*watchedTransaction),
+		getTimeHandler:            time.Now,
+	}, nil
+}
+
+func checkTransactionStatusWatcherConfig(cfg config.TransactionStatusWatcherConfig) error {
+	if cfg.TickIntervalInMs < minStatusWatcherTickIntervalInMs {
+		return fmt.Errorf("%w, TickIntervalInMs: %d", ErrInvalidTransactionStatusWatcherConfig, cfg.TickIntervalInMs)
+	}
+	if cfg.MaxWatchedTransactions < 1 {
+		return fmt.Errorf("%w, MaxWatchedTransactions: %d", ErrInvalidTransactionStatusWatcherConfig, cfg.MaxWatchedTransactions)
+	}
+	if cfg.MaxQueriesPerShardPerTick < 1 {
+		return fmt.Errorf("%w, MaxQueriesPerShardPerTick: %d", ErrInvalidTransactionStatusWatcherConfig, cfg.MaxQueriesPerShardPerTick)
+	}
+	if cfg.MaxTransactionsPerStream < 1 {
+		return fmt.Errorf("%w, MaxTransactionsPerStream: %d", ErrInvalidTransactionStatusWatcherConfig, cfg.MaxTransactionsPerStream)
+	}
+	if cfg.StreamTimeoutInSec < 1 {
+		return fmt.Errorf("%w, StreamTimeoutInSec: %d", ErrInvalidTransactionStatusWatcherConfig, cfg.StreamTimeoutInSec)
+	}
+
+	return nil
+}
+
+// Watch registers the sink for the transaction with the provided hash. The returned function cancels the watch, no
+// event being emitted afterwards. The sender, if known, places the transaction in the polling batch of its shard
+func (tsw *TransactionStatusWatcher) Watch(txHash string, sink TransactionStatusSink, options TransactionWatchOptions) (func(), error) {
+	if sink == nil {
+		return nil, ErrNilTransactionStatusSink
+	}
+
+	now := tsw.getTimeHandler()
+	shardID := tsw.getShardOfSender(options.Sender)
+
+	tsw.mutWatches.Lock()
+	defer tsw.mutWatches.Unlock()
+
+	watchedTx, found := tsw.watchedTxs[txHash]
+	if !found {
+		if len(tsw.watchedTxs) >= tsw.maxWatched {
+			return nil, ErrTooManyWatchedTransactions
+		}
+
+		watchedTx = &watchedTransaction{
+			txHash:        txHash,
+			shardID:       shardID,
+			lastStatus:    &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)},
+			subscriptions: make(map[uint64]*statusSubscription),
+		}
+		tsw.watchedTxs[txHash] = watchedTx
+	}
+	if watchedTx.shardID == unknownWatchShardID {
+		watchedTx.shardID = shardID
+	}
+
+	tsw.lastSubscriptionID++
+	subscriptionID := tsw.lastSubscriptionID
+	watchedTx.subscriptions[subscriptionID] = &statusSubscription{
+		sink:            sink,
+		pollingInterval: options.PollingInterval,
+		timeout:         options.Timeout,
+		registeredAt:    now,
+	}
+
+	return func() {
+		tsw.unwatch(txHash, subscriptionID)
+	}, nil
+}
+
+func (tsw *TransactionStatusWatcher) unwatch(txHash string, subscriptionID uint64) {
+	tsw.mutWatches.Lock()
+	defer tsw.mutWatches.Unlock()
+
+	watchedTx, found := tsw.watchedTxs[txHash]
+	if !found {
+		return
+	}
+
+	delete(watchedTx.subscriptions, subscriptionID)
+	if len(watchedTx.subscriptions) == 0 {
+		delete(tsw.watchedTxs, txHash)
+	}
+}
+
+func (tsw *TransactionStatusWatcher) getShardOfSender(sender string) uint32 {
+	if len(sender) == 0 {
+		return unknownWatchShardID
+	}
+
+	senderBytes, err := tsw.pubKeyConverter.Decode(sender)
+	if err != nil {
+		return unknownWatchShardID
+	}
+
+	shardID, err := tsw.proc.ComputeShardId(senderBytes)
+	if err != nil {
+		return unknownWatchShardID
+	}
+
+	return shardID
+}
+
+// WatchTransactions watches the provided transactions until all of them reached a final status, the stream timeout
+// elapsed or the context is done. The events are written on the returned channel, which is closed afterwards
+func (tsw *TransactionStatusWatcher) WatchTransactions(ctx context.Context, txHashes []string) (<-chan *data.TransactionStatusEvent, error) {
+	uniqueTxHashes := make(map[string]struct{}, len(txHashes))
+	for _, txHash := range txHashes {
+		txHashBytes, err := hex.DecodeString(txHash)
+		if err != nil || len(txHashBytes) != webhookTxHashLength {
+			return nil, ErrInvalidWatchedTransactionHash
+		}
+
+		uniqueTxHashes[txHash] = struct{}{}
+	}
+	if len(uniqueTxHashes) > tsw.maxTransactionsPerStream {
+		return nil, fmt.Errorf("%w, max: %d", ErrTooManyStreamedTransactions, tsw.maxTransactionsPerStream)
+	}
+
+	sink := NewChannelStatusSink(len(uniqueTxHashes))
+	options := TransactionWatchOptions{
+		PollingInterval: tsw.tickInterval,
+		Timeout:         tsw.streamTimeout,
+	}
+	cancelFuncs := make([]func(), 0, len(uniqueTxHashes))
+	unwatchAll := func() {
+		for _, cancel := range cancelFuncs {
+			cancel()
+		}
+	}
+	for txHash := range uniqueTxHashes {
+		cancel, err := tsw.Watch(txHash, sink, options)
+		if err != nil {
+			unwatchAll()
+			return nil, err
+		}
+
+		cancelFuncs = append(cancelFuncs, cancel)
+	}
+
+	chanEvents := make(chan *data.TransactionStatusEvent, len(uniqueTxHashes))
+	go func() {
+		defer close(chanEvents)
+		defer unwatchAll()
+
+		for numEvents := 0; numEvents < len(uniqueTxHashes); numEvents++ {
+			select {
+			case event := <-sink.Events():
+				chanEvents <- event
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return chanEvents, nil
+}
+
+// StartWatching starts the go routine which periodically checks the process status of the watched transactions
+func (tsw *TransactionStatusWatcher) StartWatching() {
+	if tsw.cancelFunc != nil {
+		log.Error("TransactionStatusWatcher - watching already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, tsw.cancelFunc = context.WithCancel(context.Background())
+
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(tsw.tickInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				tsw.checkWatchedTransactions()
+
+			case <-ctx.Done():
+				log.Debug("finishing TransactionStatusWatcher watching...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+// checkWatchedTransactions queries the process status of the due transactions, shard by shard in parallel, then emits
+// the events of the transactions that reached a final status and of the expired watches
+func (tsw *TransactionStatusWatcher) checkWatchedTransactions() {
+	now := tsw.getTimeHandler()
+	dueTxHashesByShard := tsw.getDueTransactionsByShard(now)
+
+	chanResults := make(chan *statusCheckResult, tsw.maxQueriesPerShardPerTick*len(dueTxHashesByShard))
+	wg := sync.WaitGroup{}
+	for shardID, txHashes := range dueTxHashesByShard {
+		wg.Add(1)
+		go func(shardID uint32, txHashes []string) {
+			defer wg.Done()
+
+			for _, txHash := range txHashes {
+				status, err := tsw.txProc.GetProcessedTransactionStatus(txHash)
+				if err != nil || status == nil {
+					// the transaction might not be known by the observers yet
+					log.Trace("status watcher: cannot get the process status", "shard", shardID, "hash", txHash, "error", err)
+					status = nil
+				}
+
+				chanResults <- &statusCheckResult{txHash: txHash, status: status}
+			}
+		}(shardID, txHashes)
+	}
+	wg.Wait()
+	close(chanResults)
+
+	tsw.mutWatches.Lock()
+	defer tsw.mutWatches.Unlock()
+
+	for result := range chanResults {
+		tsw.applyStatusCheckResult(result, now)
+	}
+	tsw.removeExpiredWatches(now)
+}
+
+// getDueTransactionsByShard returns the transactions whose polling interval elapsed, grouped by shard, at most
+// maxQueriesPerShardPerTick per shard, the ones checked the longest time ago first
+func (tsw *TransactionStatusWatcher) getDueTransactionsByShard(now time.Time) map[uint32][]string {
+	tsw.mutWatches.Lock()
+	dueTxsByShard := make(map[uint32][]*watchedTransaction)
+	for _, watchedTx := range tsw.watchedTxs {
+		if !tsw.isDue(watchedTx, now) || tsw.isAwaitingInclusion(watchedTx, now) {
+			continue
+		}
+
+		dueTxsByShard[watchedTx.shardID] = append(dueTxsByShard[watchedTx.shardID], watchedTx)
+	}
+
+	dueTxHashesByShard := make(map[uint32][]string, len(dueTxsByShard))
+	for shardID, dueTxs := range dueTxsByShard {
+		sort.Slice(dueTxs, func(i, j int) bool {
+			return dueTxs[i].lastCheckedAt.Before(dueTxs[j].lastCheckedAt)
+		})
+		if len(dueTxs) > tsw.maxQueriesPerShardPerTick {
+			dueTxs = dueTxs[:tsw.maxQueriesPerShardPerTick]
+		}
+
+		txHashes := make([]string, 0, len(dueTxs))
+		for _, dueTx := range dueTxs {
+			txHashes = append(txHashes, dueTx.txHash)
+		}
+		dueTxHashesByShard[shardID] = txHashes
+	}
+	tsw.mutWatches.Unlock()
+
+	return dueTxHashesByShard
+}
+
+// isDue returns true if the shortest polling interval of the watches of the transaction elapsed since its last check.
+// Half of the tick interval is tolerated, so the polling intervals are not delayed by a whole tick
+func (tsw *TransactionStatusWatcher) isDue(watchedTx *watchedTransaction, now time.Time) bool {
+	if watchedTx.lastCheckedAt.IsZero() {
+		return true
+	}
+
+	elapsed := now.Sub(watchedTx.lastCheckedAt) + tsw.tickInterval/2
+	for _, subscription := range watchedTx.subscriptions {
+		if elapsed >= subscription.pollingInterval {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAwaitingInclusion returns true if the observers feed is enabled and did not report the transaction as included in
+// a block yet, in which case there is no need to poll the observers. The transactions watched for more than half of
+// the watch timeout are polled anyway, as their inclusion could have been missed (or forgotten) by the feed
+func (tsw *TransactionStatusWatcher) isAwaitingInclusion(watchedTx *watchedTransaction, now time.Time) bool {
+	if !tsw.txsFeed.IsEnabled() || tsw.txsFeed.IsTransactionIncluded(watchedTx.txHash) {
+		return false
+	}
+
+	for _, subscription := range watchedTx.subscriptions {
+		if now.Sub(subscription.registeredAt) >= subscription.timeout/2 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (tsw *TransactionStatusWatcher) applyStatusCheckResult(result *statusCheckResult, now time.Time) {
+	watchedTx, found := tsw.watchedTxs[result.txHash]
+	if !found {
+		// all the watches were canceled in the meantime
+		return
+	}
+
+	watchedTx.lastCheckedAt = now
+	if result.status == nil {
+		return
+	}
+
+	watchedTx.lastStatus = result.status
+	if !isFinalTransactionStatus(result.status.Status) {
+		return
+	}
+
+	event := createTransactionStatusEvent(watchedTx, false, now)
+	for _, subscription := range watchedTx.subscriptions {
+		subscription.sink.OnTransactionStatus(event)
+	}
+	delete(tsw.watchedTxs, watchedTx.txHash)
+}
+
+func (tsw *TransactionStatusWatcher) removeExpiredWatches(now time.Time) {
+	for txHash, watchedTx := range tsw.watchedTxs {
+		for subscriptionID, subscription := range watchedTx.subscriptions {
+			if now.Sub(subscription.registeredAt) < subscription.timeout {
+				continue
+			}
+
+			subscription.sink.OnTransactionStatus(createTransactionStatusEvent(watchedTx, true, now))
+			delete(watchedTx.subscriptions, subscriptionID)
+		}
+
+		if len(watchedTx.subscriptions) == 0 {
+			delete(tsw.watchedTxs, txHash)
+			log.Debug("status watcher: watch expired", "hash", txHash)
+		}
+	}
+}
+
+func createTransactionStatusEvent(watchedTx *watchedTransaction, timedOut bool, now time.Time) *data.TransactionStatusEvent {
+	return &data.TransactionStatusEvent{
+		TxHash:    watchedTx.txHash,
+		Status:    watchedTx.lastStatus.Status,
+		Reason:    watchedTx.lastStatus.Reason,
+		TimedOut:  timedOut,
+		Timestamp: now.Unix(),
+	}
+}
+
+// Close will stop the watching go routine
+func (tsw *TransactionStatusWatcher) Close() error {
+	if tsw.cancelFunc != nil {
+		tsw.cancelFunc()
+	}
+
+	return nil
+}
+
+// ChannelStatusSink writes the received events on a buffered channel, dropping them if the channel is full
+type ChannelStatusSink struct {
+	chanEvents chan *data.TransactionStatusEvent
+}
+
+// NewChannelStatusSink will create a new instance of ChannelStatusSink, able to buffer the provided number of events
+func NewChannelStatusSink(size int) *ChannelStatusSink {
+	return &ChannelStatusSink{
+		chanEvents: make(chan *data.TransactionStatusEvent, size),
+	}
+}
+
+// OnTransactionStatus writes the event on the channel, without blocking
+func (css *ChannelStatusSink) OnTransactionStatus(event *data.TransactionStatusEvent) {
+	select {
+	case css.chanEvents <- event:
+	default:
+		log.Warn("status watcher: channel sink full, event dropped", "hash", event.TxHash)
+	}
+}
+
+// Events returns the channel the events are written on
+func (css *ChannelStatusSink) Events() <-chan *data.TransactionStatusEvent {
+	return css.chanEvents
+}
@@ -0,0 +1,382 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const thirdWatchedTxHash = "cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"
+
+func createTransactionStatusWatcherConfig() config.TransactionStatusWatcherConfig {
+	return config.TransactionStatusWatcherConfig{
+		TickIntervalInMs:          20,
+		MaxWatchedTransactions:    10,
+		MaxQueriesPerShardPerTick: 10,
+		MaxTransactionsPerStream:  2,
+		StreamTimeoutInSec:        1,
+	}
+}
+
+func createTransactionStatusWatcher(
+	statusHandler process.TransactionProcessStatusHandler,
+	txsFeed process.TransactionsFeedHandler,
+) *process.TransactionStatusWatcher {
+	watcher, _ := process.NewTransactionStatusWatcher(
+		&mock.ProcessorStub{},
+		&mock.PubKeyConverterMock{},
+		statusHandler,
+		txsFeed,
+		createTransactionStatusWatcherConfig(),
+	)
+
+	return watcher
+}
+
+func TestNewTransactionStatusWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsw, err := process.NewTransactionStatusWatcher(nil, &mock.PubKeyConverterMock{}, &mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}, createTransactionStatusWatcherConfig())
+		require.Nil(t, tsw)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsw, err := process.NewTransactionStatusWatcher(&mock.ProcessorStub{}, nil, &mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}, createTransactionStatusWatcherConfig())
+		require.Nil(t, tsw)
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("nil transaction processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsw, err := process.NewTransactionStatusWatcher(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, &mock.TransactionsFeedHandlerStub{}, createTransactionStatusWatcherConfig())
+		require.Nil(t, tsw)
+		require.Equal(t, process.ErrNilTransactionProcessor, err)
+	})
+	t.Run("nil transactions feed should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsw, err := process.NewTransactionStatusWatcher(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.TransactionProcessStatusHandlerStub{}, nil, createTransactionStatusWatcherConfig())
+		require.Nil(t, tsw)
+		require.Equal(t, process.ErrNilTransactionsFeedHandler, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidConfig := func(modifier func(cfg *config.TransactionStatusWatcherConfig), expectedMessage string) {
+			cfg := createTransactionStatusWatcherConfig()
+			modifier(&cfg)
+
+			tsw, err := process.NewTransactionStatusWatcher(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}, cfg)
+			require.Nil(t, tsw)
+			require.True(t, errors.Is(err, process.ErrInvalidTransactionStatusWatcherConfig))
+			require.True(t, strings.Contains(err.Error(), expectedMessage))
+		}
+
+		testInvalidConfig(func(cfg *config.TransactionStatusWatcherConfig) { cfg.TickIntervalInMs = 1 }, "TickIntervalInMs")
+		testInvalidConfig(func(cfg *config.TransactionStatusWatcherConfig) { cfg.MaxWatchedTransactions = 0 }, "MaxWatchedTransactions")
+		testInvalidConfig(func(cfg *config.TransactionStatusWatcherConfig) { cfg.MaxQueriesPerShardPerTick = 0 }, "MaxQueriesPerShardPerTick")
+		testInvalidConfig(func(cfg *config.TransactionStatusWatcherConfig) { cfg.MaxTransactionsPerStream = 0 }, "MaxTransactionsPerStream")
+		testInvalidConfig(func(cfg *config.TransactionStatusWatcherConfig) { cfg.StreamTimeoutInSec = 0 }, "StreamTimeoutInSec")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tsw, err := process.NewTransactionStatusWatcher(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}, createTransactionStatusWatcherConfig())
+		require.NoError(t, err)
+		require.NoError(t, tsw.Close())
+	})
+}
+
+func TestTransactionStatusWatcher_Watch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sink should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsw := createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{})
+		unwatch, err := tsw.Watch(watchedTxHash, nil, process.TransactionWatchOptions{})
+		require.Nil(t, unwatch)
+		require.Equal(t, process.ErrNilTransactionStatusSink, err)
+	})
+	t.Run("too many watched transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTransactionStatusWatcherConfig()
+		cfg.MaxWatchedTransactions = 1
+		tsw, _ := process.NewTransactionStatusWatcher(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}, cfg)
+
+		_, err := tsw.Watch(watchedTxHash, process.NewChannelStatusSink(1), process.TransactionWatchOptions{Timeout: time.Minute})
+		require.NoError(t, err)
+		_, err = tsw.Watch(watchedTxHash, process.NewChannelStatusSink(1), process.TransactionWatchOptions{Timeout: time.Minute})
+		require.NoError(t, err)
+		_, err = tsw.Watch(otherWatchedTxHash, process.NewChannelStatusSink(1), process.TransactionWatchOptions{Timeout: time.Minute})
+		require.Equal(t, process.ErrTooManyWatchedTransactions, err)
+		require.Equal(t, 1, tsw.NumWatchedTransactions())
+	})
+	t.Run("unwatch should drop the transaction after its last watch", func(t *testing.T) {
+		t.Parallel()
+
+		tsw := createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{})
+		unwatchFirst, _ := tsw.Watch(watchedTxHash, process.NewChannelStatusSink(1), process.TransactionWatchOptions{Timeout: time.Minute})
+		unwatchSecond, _ := tsw.Watch(watchedTxHash, process.NewChannelStatusSink(1), process.TransactionWatchOptions{Timeout: time.Minute})
+
+		unwatchFirst()
+		require.Equal(t, 1, tsw.NumWatchedTransactions())
+		unwatchSecond()
+		require.Equal(t, 0, tsw.NumWatchedTransactions())
+	})
+}
+
+func TestTransactionStatusWatcher_CheckWatchedTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("transaction watched more times should be polled once and notify all the sinks", func(t *testing.T) {
+		t.Parallel()
+
+		numStatusCalls := 0
+		statusHandler := &mock.TransactionProcessStatusHandlerStub{
+			GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+				numStatusCalls++
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusFail), Reason: "out of gas"}, nil
+			},
+		}
+		tsw := createTransactionStatusWatcher(statusHandler, &mock.TransactionsFeedHandlerStub{})
+		firstSink := process.NewChannelStatusSink(1)
+		secondSink := process.NewChannelStatusSink(1)
+		_, _ = tsw.Watch(watchedTxHash, firstSink, process.TransactionWatchOptions{Timeout: time.Minute})
+		_, _ = tsw.Watch(watchedTxHash, secondSink, process.TransactionWatchOptions{Timeout: time.Minute})
+
+		tsw.CheckWatchedTransactions()
+		require.Equal(t, 1, numStatusCalls)
+		require.Equal(t, 0, tsw.NumWatchedTransactions())
+
+		for _, sink := range []*process.ChannelStatusSink{firstSink, secondSink} {
+			event := <-sink.Events()
+			require.Equal(t, watchedTxHash, event.TxHash)
+			require.Equal(t, string(transaction.TxStatusFail), event.Status)
+			require.Equal(t, "out of gas", event.Reason)
+			require.False(t, event.TimedOut)
+		}
+	})
+	t.Run("transactions should be polled at most the configured number per shard", func(t *testing.T) {
+		t.Parallel()
+
+		mutCalls := sync.Mutex{}
+		polledTxHashes := make([]string, 0)
+		statusHandler := &mock.TransactionProcessStatusHandlerStub{
+			GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+				mutCalls.Lock()
+				polledTxHashes = append(polledTxHashes, txHash)
+				mutCalls.Unlock()
+
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)}, nil
+			},
+		}
+		proc := &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return uint32(addressBuff[0]), nil
+			},
+		}
+		cfg := createTransactionStatusWatcherConfig()
+		cfg.MaxQueriesPerShardPerTick = 1
+		tsw, _ := process.NewTransactionStatusWatcher(proc, &mock.PubKeyConverterMock{}, statusHandler, &mock.TransactionsFeedHandlerStub{}, cfg)
+		startTime := time.Now()
+		tsw.SetGetTimeHandler(func() time.Time {
+			return startTime
+		})
+
+		options := process.TransactionWatchOptions{PollingInterval: 100 * time.Millisecond, Timeout: time.Minute}
+		options.Sender = "00"
+		_, _ = tsw.Watch(watchedTxHash, process.NewChannelStatusSink(1), options)
+		_, _ = tsw.Watch(otherWatchedTxHash, process.NewChannelStatusSink(1), options)
+		options.Sender = "01"
+		_, _ = tsw.Watch(thirdWatchedTxHash, process.NewChannelStatusSink(1), options)
+
+		tsw.CheckWatchedTransactions()
+		require.Len(t, polledTxHashes, 2)
+		require.Contains(t, polledTxHashes, thirdWatchedTxHash)
+
+		// the transactions checked recently are not due yet, the remaining one is
+		tsw.CheckWatchedTransactions()
+		require.Len(t, polledTxHashes, 3)
+		require.ElementsMatch(t, []string{watchedTxHash, otherWatchedTxHash, thirdWatchedTxHash}, polledTxHashes)
+
+		tsw.SetGetTimeHandler(func() time.Time {
+			return startTime.Add(100 * time.Millisecond)
+		})
+		tsw.CheckWatchedTransactions()
+		require.Len(t, polledTxHashes, 5)
+	})
+	t.Run("transaction not included yet should not be polled when the feed is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		numStatusCalls := 0
+		statusHandler := &mock.TransactionProcessStatusHandlerStub{
+			GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+				numStatusCalls++
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)}, nil
+			},
+		}
+		isIncluded := false
+		txsFeed := &mock.TransactionsFeedHandlerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			IsTransactionIncludedCalled: func(txHash string) bool {
+				return isIncluded
+			},
+		}
+		tsw := createTransactionStatusWatcher(statusHandler, txsFeed)
+		startTime := time.Now()
+		tsw.SetGetTimeHandler(func() time.Time {
+			return startTime
+		})
+		_, _ = tsw.Watch(watchedTxHash, process.NewChannelStatusSink(1), process.TransactionWatchOptions{Timeout: time.Minute})
+
+		tsw.CheckWatchedTransactions()
+		require.Equal(t, 0, numStatusCalls)
+
+		isIncluded = true
+		tsw.CheckWatchedTransactions()
+		require.Equal(t, 1, numStatusCalls)
+
+		// a transaction watched for more than half of the timeout is polled even if the feed did not report it
+		isIncluded = false
+		tsw.SetGetTimeHandler(func() time.Time {
+			return startTime.Add(30 * time.Second)
+		})
+		tsw.CheckWatchedTransactions()
+		require.Equal(t, 2, numStatusCalls)
+	})
+	t.Run("expired watch should emit the last known status", func(t *testing.T) {
+		t.Parallel()
+
+		statusHandler := &mock.TransactionProcessStatusHandlerStub{
+			GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending), Reason: "in pool"}, nil
+			},
+		}
+		tsw := createTransactionStatusWatcher(statusHandler, &mock.TransactionsFeedHandlerStub{})
+		startTime := time.Now()
+		tsw.SetGetTimeHandler(func() time.Time {
+			return startTime
+		})
+		shortSink := process.NewChannelStatusSink(1)
+		longSink := process.NewChannelStatusSink(1)
+		_, _ = tsw.Watch(watchedTxHash, shortSink, process.TransactionWatchOptions{Timeout: time.Second})
+		_, _ = tsw.Watch(watchedTxHash, longSink, process.TransactionWatchOptions{Timeout: time.Minute})
+
+		tsw.SetGetTimeHandler(func() time.Time {
+			return startTime.Add(time.Second)
+		})
+		tsw.CheckWatchedTransactions()
+		require.Equal(t, 1, tsw.NumWatchedTransactions())
+		require.Len(t, longSink.Events(), 0)
+
+		event := <-shortSink.Events()
+		require.Equal(t, &data.TransactionStatusEvent{
+			TxHash:    watchedTxHash,
+			Status:    string(transaction.TxStatusPending),
+			Reason:    "in pool",
+			TimedOut:  true,
+			Timestamp: startTime.Add(time.Second).Unix(),
+		}, event)
+	})
+}
+
+func TestTransactionStatusWatcher_WatchTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsw := createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{})
+		chanEvents, err := tsw.WatchTransactions(context.Background(), []string{watchedTxHash, otherWatchedTxHash, thirdWatchedTxHash})
+		require.Nil(t, chanEvents)
+		require.True(t, errors.Is(err, process.ErrTooManyStreamedTransactions))
+	})
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsw := createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{})
+		chanEvents, err := tsw.WatchTransactions(context.Background(), []string{watchedTxHash, "aabb"})
+		require.Nil(t, chanEvents)
+		require.Equal(t, process.ErrInvalidWatchedTransactionHash, err)
+		require.Equal(t, 0, tsw.NumWatchedTransactions())
+	})
+	t.Run("should stream the final statuses then close the channel", func(t *testing.T) {
+		t.Parallel()
+
+		statusHandler := &mock.TransactionProcessStatusHandlerStub{
+			GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+				if txHash == watchedTxHash {
+					return &data.ProcessStatusResponse{Status: string(transaction.TxStatusSuccess)}, nil
+				}
+
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusFail)}, nil
+			},
+		}
+		tsw := createTransactionStatusWatcher(statusHandler, &mock.TransactionsFeedHandlerStub{})
+		tsw.StartWatching()
+		defer func() {
+			_ = tsw.Close()
+		}()
+
+		chanEvents, err := tsw.WatchTransactions(context.Background(), []string{watchedTxHash, otherWatchedTxHash, watchedTxHash})
+		require.NoError(t, err)
+
+		statuses := make(map[string]string)
+		for event := range chanEvents {
+			statuses[event.TxHash] = event.Status
+		}
+		require.Equal(t, map[string]string{
+			watchedTxHash:      string(transaction.TxStatusSuccess),
+			otherWatchedTxHash: string(transaction.TxStatusFail),
+		}, statuses)
+		require.Equal(t, 0, tsw.NumWatchedTransactions())
+	})
+	t.Run("done context should close the channel and drop the watches", func(t *testing.T) {
+		t.Parallel()
+
+		tsw := createTransactionStatusWatcher(createStatusHandlerWithStatus(transaction.TxStatusPending), &mock.TransactionsFeedHandlerStub{})
+		ctx, cancel := context.WithCancel(context.Background())
+		chanEvents, err := tsw.WatchTransactions(ctx, []string{watchedTxHash})
+		require.NoError(t, err)
+		require.Equal(t, 1, tsw.NumWatchedTransactions())
+
+		cancel()
+		select {
+		case _, ok := <-chanEvents:
+			require.False(t, ok)
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout waiting for the channel to be closed")
+		}
+		require.Equal(t, 0, tsw.NumWatchedTransactions())
+	})
+}
+
+func TestChannelStatusSink_OnTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	sink := process.NewChannelStatusSink(1)
+	sink.OnTransactionStatus(&data.TransactionStatusEvent{TxHash: watchedTxHash})
+	sink.OnTransactionStatus(&data.TransactionStatusEvent{TxHash: otherWatchedTxHash})
+
+	require.Len(t, sink.Events(), 1)
+	require.Equal(t, watchedTxHash, (<-sink.Events()).TxHash)
+}
@@ -0,0 +1,125 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const minTransactionWaitPollingIntervalInMs = 100
+
+// TransactionWaitProcessor waits for the sent transactions to reach a final status, so the simple integrators can get
+// the outcome of a transaction in the same response as its sending, instead of polling the proxy. The process status
+// is tracked by the transaction status watcher
+type TransactionWaitProcessor struct {
+	txProc          TransactionExecutionHandler
+	statusWatcher   TransactionStatusWatcherHandler
+	pollingInterval time.Duration
+	defaultTimeout  time.Duration
+	maxTimeout      time.Duration
+}
+
+// NewTransactionWaitProcessor will create a new instance of TransactionWaitProcessor
+func NewTransactionWaitProcessor(
+	txProc TransactionExecutionHandler,
+	statusWatcher TransactionStatusWatcherHandler,
+	cfg config.TransactionWaitConfig,
+) (*TransactionWaitProcessor, error) {
+	if txProc == nil {
+		return nil, ErrNilTransactionProcessor
+	}
+	if statusWatcher == nil {
+		return nil, ErrNilTransactionStatusWatcher
+	}
+	err := checkTransactionWaitConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransactionWaitProcessor{
+		txProc:          txProc,
+		statusWatcher:   statusWatcher,
+		pollingInterval: time.Duration(cfg.PollingIntervalInMs) * time.Millisecond,
+		defaultTimeout:  time.Duration(cfg.DefaultTimeoutInSec) * time.Second,
+		maxTimeout:      time.Duration(cfg.MaxTimeoutInSec) * time.Second,
+	}, nil
+}
+
+func checkTransactionWaitConfig(cfg config.TransactionWaitConfig) error {
+	if cfg.PollingIntervalInMs < minTransactionWaitPollingIntervalInMs {
+		return fmt.Errorf("%w, PollingIntervalInMs: %d", ErrInvalidTransactionWaitConfig, cfg.PollingIntervalInMs)
+	}
+	if cfg.DefaultTimeoutInSec < 1 {
+		return fmt.Errorf("%w, DefaultTimeoutInSec: %d", ErrInvalidTransactionWaitConfig, cfg.DefaultTimeoutInSec)
+	}
+	if cfg.MaxTimeoutInSec < cfg.DefaultTimeoutInSec {
+		return fmt.Errorf("%w, MaxTimeoutInSec: %d is lower than DefaultTimeoutInSec", ErrInvalidTransactionWaitConfig, cfg.MaxTimeoutInSec)
+	}
+
+	return nil
+}
+
+// WaitForExecution watches the process status of the provided transaction until it is final or the timeout elapses. A
+// zero timeout means the configured default one, while the timeouts above the configured maximum are capped to it.
+// On timeout, the last known status is returned, marked accordingly. An error is returned if the provided context is
+// done before the timeout, e.g. when the client went away
+func (twp *TransactionWaitProcessor) WaitForExecution(ctx context.Context, txHash string, timeout time.Duration) (*data.TransactionExecutionResult, error) {
+	sink := NewChannelStatusSink(1)
+	unwatch, err := twp.statusWatcher.Watch(txHash, sink, TransactionWatchOptions{
+		PollingInterval: twp.pollingInterval,
+		Timeout:         twp.getTimeout(timeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer unwatch()
+
+	select {
+	case event := <-sink.Events():
+		if event.TimedOut {
+			return &data.TransactionExecutionResult{
+				Status:   event.Status,
+				Reason:   event.Reason,
+				TimedOut: true,
+			}, nil
+		}
+
+		return twp.createExecutionResult(txHash, event)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (twp *TransactionWaitProcessor) getTimeout(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return twp.defaultTimeout
+	}
+	if timeout > twp.maxTimeout {
+		return twp.maxTimeout
+	}
+
+	return timeout
+}
+
+func (twp *TransactionWaitProcessor) createExecutionResult(txHash string, status *data.TransactionStatusEvent) (*data.TransactionExecutionResult, error) {
+	tx, err := twp.txProc.GetTransaction(txHash, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.TransactionExecutionResult{
+		Status:               status.Status,
+		Reason:               status.Reason,
+		SmartContractResults: tx.SmartContractResults,
+		Logs:                 tx.Logs,
+	}, nil
+}
+
+// IsEnabled returns true
+func (twp *TransactionWaitProcessor) IsEnabled() bool {
+	return true
+}
@@ -0,0 +1,185 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransactionWaitConfig() config.TransactionWaitConfig {
+	return config.TransactionWaitConfig{
+		Enabled:             true,
+		PollingIntervalInMs: 100,
+		DefaultTimeoutInSec: 1,
+		MaxTimeoutInSec:     2,
+	}
+}
+
+func createStartedTransactionStatusWatcher(t *testing.T, statusHandler process.TransactionProcessStatusHandler) *process.TransactionStatusWatcher {
+	watcher := createTransactionStatusWatcher(statusHandler, &mock.TransactionsFeedHandlerStub{})
+	watcher.StartWatching()
+	t.Cleanup(func() {
+		_ = watcher.Close()
+	})
+
+	return watcher
+}
+
+func TestNewTransactionWaitProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		twp, err := process.NewTransactionWaitProcessor(nil, createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), createTransactionWaitConfig())
+		require.Nil(t, twp)
+		require.Equal(t, process.ErrNilTransactionProcessor, err)
+	})
+	t.Run("nil status watcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		twp, err := process.NewTransactionWaitProcessor(&mock.TransactionExecutionHandlerStub{}, nil, createTransactionWaitConfig())
+		require.Nil(t, twp)
+		require.Equal(t, process.ErrNilTransactionStatusWatcher, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTransactionWaitConfig()
+		cfg.PollingIntervalInMs = 10
+		_, err := process.NewTransactionWaitProcessor(&mock.TransactionExecutionHandlerStub{}, createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), cfg)
+		require.True(t, errors.Is(err, process.ErrInvalidTransactionWaitConfig))
+
+		cfg = createTransactionWaitConfig()
+		cfg.DefaultTimeoutInSec = 0
+		_, err = process.NewTransactionWaitProcessor(&mock.TransactionExecutionHandlerStub{}, createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), cfg)
+		require.True(t, errors.Is(err, process.ErrInvalidTransactionWaitConfig))
+
+		cfg = createTransactionWaitConfig()
+		cfg.MaxTimeoutInSec = 0
+		_, err = process.NewTransactionWaitProcessor(&mock.TransactionExecutionHandlerStub{}, createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), cfg)
+		require.True(t, errors.Is(err, process.ErrInvalidTransactionWaitConfig))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		twp, err := process.NewTransactionWaitProcessor(&mock.TransactionExecutionHandlerStub{}, createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), createTransactionWaitConfig())
+		require.NoError(t, err)
+		require.True(t, twp.IsEnabled())
+	})
+}
+
+func TestTransactionWaitProcessor_WaitForExecution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("final status should return the results", func(t *testing.T) {
+		t.Parallel()
+
+		numStatusCalls := int32(0)
+		expectedResults := []*transaction.ApiSmartContractResult{{Hash: "scr"}}
+		expectedLogs := &transaction.ApiLogs{Address: "erd1contract"}
+		txProc := &mock.TransactionExecutionHandlerStub{
+			GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+				require.Equal(t, watchedTxHash, txHash)
+				if atomic.AddInt32(&numStatusCalls, 1) < 3 {
+					return &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)}, nil
+				}
+
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusFail), Reason: "out of gas"}, nil
+			},
+			GetTransactionCalled: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				require.True(t, withResults)
+				return &transaction.ApiTransactionResult{SmartContractResults: expectedResults, Logs: expectedLogs}, nil
+			},
+		}
+		twp, _ := process.NewTransactionWaitProcessor(txProc, createStartedTransactionStatusWatcher(t, txProc), createTransactionWaitConfig())
+
+		result, err := twp.WaitForExecution(context.Background(), watchedTxHash, 0)
+		require.NoError(t, err)
+		require.Equal(t, &data.TransactionExecutionResult{
+			Status:               string(transaction.TxStatusFail),
+			Reason:               "out of gas",
+			SmartContractResults: expectedResults,
+			Logs:                 expectedLogs,
+		}, result)
+		require.Equal(t, int32(3), atomic.LoadInt32(&numStatusCalls))
+	})
+	t.Run("get transaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		txProc := &mock.TransactionExecutionHandlerStub{
+			GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusSuccess)}, nil
+			},
+			GetTransactionCalled: func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, expectedErr
+			},
+		}
+		twp, _ := process.NewTransactionWaitProcessor(txProc, createStartedTransactionStatusWatcher(t, txProc), createTransactionWaitConfig())
+
+		result, err := twp.WaitForExecution(context.Background(), watchedTxHash, 0)
+		require.Nil(t, result)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("timeout should return the last known status", func(t *testing.T) {
+		t.Parallel()
+
+		numStatusCalls := int32(0)
+		txProc := &mock.TransactionExecutionHandlerStub{
+			GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+				if atomic.AddInt32(&numStatusCalls, 1) == 1 {
+					return &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending), Reason: "in pool"}, nil
+				}
+
+				return nil, errors.New("observer unavailable")
+			},
+		}
+		twp, _ := process.NewTransactionWaitProcessor(txProc, createStartedTransactionStatusWatcher(t, txProc), createTransactionWaitConfig())
+
+		start := time.Now()
+		result, err := twp.WaitForExecution(context.Background(), watchedTxHash, 350*time.Millisecond)
+		require.NoError(t, err)
+		require.Less(t, time.Since(start), time.Second)
+		require.Equal(t, &data.TransactionExecutionResult{
+			Status:   string(transaction.TxStatusPending),
+			Reason:   "in pool",
+			TimedOut: true,
+		}, result)
+	})
+	t.Run("timeout above the maximum should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTransactionWaitConfig()
+		cfg.MaxTimeoutInSec = 1
+		txProc := &mock.TransactionExecutionHandlerStub{}
+		twp, _ := process.NewTransactionWaitProcessor(txProc, createStartedTransactionStatusWatcher(t, txProc), cfg)
+
+		start := time.Now()
+		result, err := twp.WaitForExecution(context.Background(), watchedTxHash, time.Hour)
+		require.NoError(t, err)
+		require.True(t, result.TimedOut)
+		require.Less(t, time.Since(start), 2*time.Second)
+	})
+	t.Run("cancelled context should error", func(t *testing.T) {
+		t.Parallel()
+
+		txProc := &mock.TransactionExecutionHandlerStub{}
+		twp, _ := process.NewTransactionWaitProcessor(txProc, createStartedTransactionStatusWatcher(t, txProc), createTransactionWaitConfig())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+		defer cancel()
+		result, err := twp.WaitForExecution(ctx, watchedTxHash, time.Second)
+		require.Nil(t, result)
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+}
@@ -0,0 +1,105 @@
+package process
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// maxTransactionsHistoryWindow mirrors the default max_result_window of an Elasticsearch index
+const maxTransactionsHistoryWindow = 10000
+
+type transactionsHistoryProcessor struct {
+	connector       TransactionsHistoryConnector
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewTransactionsHistoryProcessor will create a new instance of the transactions history processor
+func NewTransactionsHistoryProcessor(connector TransactionsHistoryConnector, pubKeyConverter core.PubkeyConverter) (*transactionsHistoryProcessor, error) {
+	if check.IfNil(connector) {
+		return nil, ErrNilTransactionsHistoryConnector
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &transactionsHistoryProcessor{
+		connector:       connector,
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// GetTransactionsHistory returns the requested page of the historical transactions sent or received by the address
+func (thp *transactionsHistoryProcessor) GetTransactionsHistory(address string, options common.TransactionsHistoryOptions) (*data.TransactionsHistory, error) {
+	err := thp.checkHistoryRequest(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, numTxs, err := thp.connector.GetTransactionsByAddress(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.TransactionsHistory{
+		Transactions: txs,
+		Pagination:   data.NewPaginationInfo(options.Page, options.Size, numTxs),
+	}, nil
+}
+
+// GetTransfersHistory returns the requested page of the historical transfers of the address: the transactions sent or
+// received by it, along with the smart contract results moving EGLD or ESDT tokens to or from it
+func (thp *transactionsHistoryProcessor) GetTransfersHistory(address string, options common.TransactionsHistoryOptions) (*data.TransfersHistory, error) {
+	err := thp.checkHistoryRequest(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, numTransfers, err := thp.connector.GetTransfersByAddress(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range transfers {
+		transfers[i].Direction = getTransferDirection(address, transfers[i])
+	}
+
+	return &data.TransfersHistory{
+		Transfers:  transfers,
+		Pagination: data.NewPaginationInfo(options.Page, options.Size, numTransfers),
+	}, nil
+}
+
+func (thp *transactionsHistoryProcessor) checkHistoryRequest(address string, options common.TransactionsHistoryOptions) error {
+	_, err := thp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return ErrInvalidAddress
+	}
+	if options.Before > 0 && options.After > options.Before {
+		return ErrInvalidTransactionsHistoryTimeRange
+	}
+	if uint64(options.Page)*uint64(options.Size) > maxTransactionsHistoryWindow {
+		return ErrTransactionsHistoryWindowTooLarge
+	}
+
+	return nil
+}
+
+func getTransferDirection(address string, transfer data.Transfer) string {
+	isSender := transfer.Sender == address
+	isReceiver := transfer.Receiver == address
+	switch {
+	case isSender && isReceiver:
+		return data.TransferDirectionSelf
+	case isSender:
+		return data.TransferDirectionOutgoing
+	default:
+		return data.TransferDirectionIncoming
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (thp *transactionsHistoryProcessor) IsInterfaceNil() bool {
+	return thp == nil
+}
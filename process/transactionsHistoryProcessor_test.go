@@ -0,0 +1,147 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testHistoryAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func TestNewTransactionsHistoryProcessor(t *testing.T) {
+	t.Parallel()
+
+	thp, err := process.NewTransactionsHistoryProcessor(nil, testPubkeyConverter)
+	require.Nil(t, thp)
+	require.Equal(t, process.ErrNilTransactionsHistoryConnector, err)
+
+	thp, err = process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{}, nil)
+	require.Nil(t, thp)
+	require.Equal(t, process.ErrNilPubKeyConverter, err)
+
+	thp, err = process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{}, testPubkeyConverter)
+	require.NoError(t, err)
+	require.False(t, thp.IsInterfaceNil())
+}
+
+func TestTransactionsHistoryProcessor_GetTransactionsHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{}, testPubkeyConverter)
+		res, err := thp.GetTransactionsHistory("invalid", common.TransactionsHistoryOptions{Page: 1, Size: 10})
+		require.Nil(t, res)
+		require.Equal(t, process.ErrInvalidAddress, err)
+	})
+	t.Run("after greater than before should error", func(t *testing.T) {
+		t.Parallel()
+
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{}, testPubkeyConverter)
+		res, err := thp.GetTransactionsHistory(testHistoryAddress, common.TransactionsHistoryOptions{Page: 1, Size: 10, After: 20, Before: 10})
+		require.Nil(t, res)
+		require.Equal(t, process.ErrInvalidTransactionsHistoryTimeRange, err)
+	})
+	t.Run("page beyond the history window should error", func(t *testing.T) {
+		t.Parallel()
+
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{}, testPubkeyConverter)
+		res, err := thp.GetTransactionsHistory(testHistoryAddress, common.TransactionsHistoryOptions{Page: 11, Size: 1000})
+		require.Nil(t, res)
+		require.Equal(t, process.ErrTransactionsHistoryWindowTooLarge, err)
+	})
+	t.Run("connector error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{
+			GetTransactionsByAddressCalled: func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, int, error) {
+				return nil, 0, expectedErr
+			},
+		}, testPubkeyConverter)
+		res, err := thp.GetTransactionsHistory(testHistoryAddress, common.TransactionsHistoryOptions{Page: 1, Size: 10})
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedOptions := common.TransactionsHistoryOptions{Page: 2, Size: 2, After: 10, Before: 20}
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{
+			GetTransactionsByAddressCalled: func(address string, options common.TransactionsHistoryOptions) ([]data.DatabaseTransaction, int, error) {
+				require.Equal(t, testHistoryAddress, address)
+				require.Equal(t, providedOptions, options)
+
+				return []data.DatabaseTransaction{{Hash: "h3"}, {Hash: "h4"}}, 5, nil
+			},
+		}, testPubkeyConverter)
+		res, err := thp.GetTransactionsHistory(testHistoryAddress, providedOptions)
+		require.NoError(t, err)
+		require.Len(t, res.Transactions, 2)
+		require.Equal(t, &data.PaginationInfo{Page: 2, Size: 2, TotalItems: 5, TotalPages: 3}, res.Pagination)
+	})
+}
+
+func TestTransactionsHistoryProcessor_GetTransfersHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{}, testPubkeyConverter)
+		res, err := thp.GetTransfersHistory("invalid", common.TransactionsHistoryOptions{Page: 1, Size: 10})
+		require.Nil(t, res)
+		require.Equal(t, process.ErrInvalidAddress, err)
+	})
+	t.Run("page beyond the history window should error", func(t *testing.T) {
+		t.Parallel()
+
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{}, testPubkeyConverter)
+		res, err := thp.GetTransfersHistory(testHistoryAddress, common.TransactionsHistoryOptions{Page: 11, Size: 1000})
+		require.Nil(t, res)
+		require.Equal(t, process.ErrTransactionsHistoryWindowTooLarge, err)
+	})
+	t.Run("connector error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{
+			GetTransfersByAddressCalled: func(address string, options common.TransactionsHistoryOptions) ([]data.Transfer, int, error) {
+				return nil, 0, expectedErr
+			},
+		}, testPubkeyConverter)
+		res, err := thp.GetTransfersHistory(testHistoryAddress, common.TransactionsHistoryOptions{Page: 1, Size: 10})
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedOptions := common.TransactionsHistoryOptions{Page: 1, Size: 3}
+		thp, _ := process.NewTransactionsHistoryProcessor(&mock.TransactionsHistoryConnectorStub{
+			GetTransfersByAddressCalled: func(address string, options common.TransactionsHistoryOptions) ([]data.Transfer, int, error) {
+				require.Equal(t, testHistoryAddress, address)
+				require.Equal(t, providedOptions, options)
+
+				return []data.Transfer{
+					{Hash: "scr", Type: data.TransferTypeSmartContractResult, Sender: "erd1contract", Receiver: testHistoryAddress, OriginalTxHash: "tx"},
+					{Hash: "tx1", Type: data.TransferTypeTransaction, Sender: testHistoryAddress, Receiver: "erd1contract"},
+					{Hash: "tx2", Type: data.TransferTypeTransaction, Sender: testHistoryAddress, Receiver: testHistoryAddress},
+				}, 3, nil
+			},
+		}, testPubkeyConverter)
+		res, err := thp.GetTransfersHistory(testHistoryAddress, providedOptions)
+		require.NoError(t, err)
+		require.Len(t, res.Transfers, 3)
+		require.Equal(t, data.TransferDirectionIncoming, res.Transfers[0].Direction)
+		require.Equal(t, data.TransferDirectionOutgoing, res.Transfers[1].Direction)
+		require.Equal(t, data.TransferDirectionSelf, res.Transfers[2].Direction)
+		require.Equal(t, &data.PaginationInfo{Page: 1, Size: 3, TotalItems: 3, TotalPages: 1}, res.Pagination)
+	})
+}
@@ -0,0 +1,169 @@
+package process
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const functionSelectorSeparator = "@"
+
+// transactionsPolicy holds the allow and deny lists evaluated on each transaction before relaying it to the observers.
+// An empty allow list allows everything, while the deny lists take precedence over the allow lists. The transactions
+// without a data field are not subject to the functions lists
+type transactionsPolicy struct {
+	enabled          bool
+	pubKeyConverter  core.PubkeyConverter
+	allowedSenders   map[string]struct{}
+	deniedSenders    map[string]struct{}
+	allowedReceivers map[string]struct{}
+	deniedReceivers  map[string]struct{}
+	allowedFunctions map[string]struct{}
+	deniedFunctions  map[string]struct{}
+	maxValue         *big.Int
+}
+
+func newTransactionsPolicy(cfg config.TransactionsPolicyConfig, pubKeyConverter core.PubkeyConverter) (*transactionsPolicy, error) {
+	if !cfg.Enabled {
+		return &transactionsPolicy{}, nil
+	}
+
+	policy := &transactionsPolicy{
+		enabled:          true,
+		pubKeyConverter:  pubKeyConverter,
+		allowedFunctions: createStringsSet(cfg.AllowedFunctions),
+		deniedFunctions:  createStringsSet(cfg.DeniedFunctions),
+	}
+
+	var err error
+	policy.allowedSenders, err = createAddressesSet(cfg.AllowedSenders, pubKeyConverter, "AllowedSenders")
+	if err != nil {
+		return nil, err
+	}
+	policy.deniedSenders, err = createAddressesSet(cfg.DeniedSenders, pubKeyConverter, "DeniedSenders")
+	if err != nil {
+		return nil, err
+	}
+	policy.allowedReceivers, err = createAddressesSet(cfg.AllowedReceivers, pubKeyConverter, "AllowedReceivers")
+	if err != nil {
+		return nil, err
+	}
+	policy.deniedReceivers, err = createAddressesSet(cfg.DeniedReceivers, pubKeyConverter, "DeniedReceivers")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfg.MaxValue) > 0 {
+		maxValue, ok := big.NewInt(0).SetString(cfg.MaxValue, 10)
+		if !ok || maxValue.Sign() < 0 {
+			return nil, fmt.Errorf("%w, MaxValue: %s", ErrInvalidTransactionsPolicy, cfg.MaxValue)
+		}
+		policy.maxValue = maxValue
+	}
+
+	log.Info("Proxy started with transactions policy",
+		"allowed senders", len(policy.allowedSenders),
+		"denied senders", len(policy.deniedSenders),
+		"allowed receivers", len(policy.allowedReceivers),
+		"denied receivers", len(policy.deniedReceivers),
+		"allowed functions", cfg.AllowedFunctions,
+		"denied functions", cfg.DeniedFunctions,
+		"max value", cfg.MaxValue)
+
+	return policy, nil
+}
+
+func createStringsSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+func createAddressesSet(addresses []string, pubKeyConverter core.PubkeyConverter, name string) (map[string]struct{}, error) {
+	set := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		addressBytes, err := pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w, %s: invalid address %s: %v", ErrInvalidTransactionsPolicy, name, address, err)
+		}
+		set[string(addressBytes)] = struct{}{}
+	}
+
+	return set, nil
+}
+
+// checkTransaction returns an error if the transaction is not allowed by the policy. The addresses of the transaction
+// should have been already validated
+func (policy *transactionsPolicy) checkTransaction(tx *data.Transaction) error {
+	if !policy.enabled {
+		return nil
+	}
+
+	senderBytes, err := policy.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return err
+	}
+	if !isAllowedBySets(string(senderBytes), policy.allowedSenders, policy.deniedSenders) {
+		return fmt.Errorf("%w: sender %s is not allowed", ErrTransactionDeniedByPolicy, tx.Sender)
+	}
+
+	receiverBytes, err := policy.pubKeyConverter.Decode(tx.Receiver)
+	if err != nil {
+		return err
+	}
+	if !isAllowedBySets(string(receiverBytes), policy.allowedReceivers, policy.deniedReceivers) {
+		return fmt.Errorf("%w: receiver %s is not allowed", ErrTransactionDeniedByPolicy, tx.Receiver)
+	}
+
+	function := getFunctionSelector(tx.Data)
+	isFunctionCall := len(function) > 0
+	if isFunctionCall && !isAllowedBySets(function, policy.allowedFunctions, policy.deniedFunctions) {
+		return fmt.Errorf("%w: function %s is not allowed", ErrTransactionDeniedByPolicy, function)
+	}
+
+	return policy.checkValue(tx.Value)
+}
+
+func (policy *transactionsPolicy) checkValue(value string) error {
+	if policy.maxValue == nil {
+		return nil
+	}
+
+	txValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return fmt.Errorf("%w: invalid value %s", ErrTransactionDeniedByPolicy, value)
+	}
+	if txValue.Cmp(policy.maxValue) > 0 {
+		return fmt.Errorf("%w: value %s exceeds the maximum value %s", ErrTransactionDeniedByPolicy, value, policy.maxValue.String())
+	}
+
+	return nil
+}
+
+func isAllowedBySets(value string, allowed map[string]struct{}, denied map[string]struct{}) bool {
+	_, isDenied := denied[value]
+	if isDenied {
+		return false
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+
+	_, isAllowed := allowed[value]
+	return isAllowed
+}
+
+// getFunctionSelector returns the function called by the data field of the transaction (the part before the first
+// argument separator). An empty data field (plain transfer) has an empty function selector, for which the functions
+// lists are not evaluated
+func getFunctionSelector(txData []byte) string {
+	function, _, _ := strings.Cut(string(txData), functionSelectorSeparator)
+	return function
+}
@@ -0,0 +1,217 @@
+package process_test
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	policyAddress1 = "aaaaaa"
+	policyAddress2 = "bbbbbb"
+	policyAddress3 = "cccccc"
+)
+
+func createTxProcessorWithPolicy(t *testing.T, txsPolicyConfig config.TransactionsPolicyConfig, numCalls *uint32) *process.TransactionProcessor {
+	tp, err := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "observer", ShardId: 0},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				atomic.AddUint32(numCalls, 1)
+				switch resp := response.(type) {
+				case *data.ResponseTransaction:
+					resp.Data.TxHash = "hash"
+				case *data.ResponseMultipleTransactions:
+					txs := value.([]*data.Transaction)
+					resp.Data.NumOfTxs = uint64(len(txs))
+					resp.Data.TxsHashes = make(map[int]string)
+					for i := range txs {
+						resp.Data.TxsHashes[i] = "hash"
+					}
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		true,
+		config.SendTransactionQuorumConfig{},
+		txsPolicyConfig,
+	)
+	require.Nil(t, err)
+
+	return tp
+}
+
+func createPolicyTestTransaction(sender string, receiver string, value string, txData string) *data.Transaction {
+	return &data.Transaction{
+		Sender:   sender,
+		Receiver: receiver,
+		Value:    value,
+		Data:     []byte(txData),
+		ChainID:  "chain",
+		Version:  1,
+	}
+}
+
+func TestNewTransactionProcessor_InvalidTransactionsPolicyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	invalidConfigs := []config.TransactionsPolicyConfig{
+		{Enabled: true, AllowedSenders: []string{"not hex"}},
+		{Enabled: true, DeniedSenders: []string{"not hex"}},
+		{Enabled: true, AllowedReceivers: []string{"not hex"}},
+		{Enabled: true, DeniedReceivers: []string{"not hex"}},
+		{Enabled: true, MaxValue: "not a number"},
+		{Enabled: true, MaxValue: "-1"},
+	}
+	for _, cfg := range invalidConfigs {
+		tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, cfg)
+		assert.Nil(t, tp)
+		assert.True(t, errors.Is(err, process.ErrInvalidTransactionsPolicy))
+	}
+
+	disabledCfg := invalidConfigs[0]
+	disabledCfg.Enabled = false
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true, config.SendTransactionQuorumConfig{}, disabledCfg)
+	assert.NotNil(t, tp)
+	assert.Nil(t, err)
+}
+
+func TestTransactionProcessor_SendTransactionWithPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled policy should not check", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		tp := createTxProcessorWithPolicy(t, config.TransactionsPolicyConfig{
+			DeniedSenders: []string{policyAddress1},
+		}, &numCalls)
+
+		statusCode, txHash, err := tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "1", ""))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, "hash", txHash)
+	})
+	t.Run("senders lists", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		tp := createTxProcessorWithPolicy(t, config.TransactionsPolicyConfig{
+			Enabled:        true,
+			AllowedSenders: []string{policyAddress1, policyAddress2},
+			DeniedSenders:  []string{policyAddress2},
+		}, &numCalls)
+
+		statusCode, _, err := tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress3, "1", ""))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+
+		statusCode, _, err = tp.SendTransaction(createPolicyTestTransaction(policyAddress2, policyAddress3, "1", ""))
+		assert.True(t, errors.Is(err, process.ErrTransactionDeniedByPolicy))
+		assert.Equal(t, http.StatusForbidden, statusCode)
+
+		statusCode, _, err = tp.SendTransaction(createPolicyTestTransaction(policyAddress3, policyAddress1, "1", ""))
+		assert.True(t, errors.Is(err, process.ErrTransactionDeniedByPolicy))
+		assert.Equal(t, http.StatusForbidden, statusCode)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("receivers lists", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		tp := createTxProcessorWithPolicy(t, config.TransactionsPolicyConfig{
+			Enabled:         true,
+			DeniedReceivers: []string{policyAddress3},
+		}, &numCalls)
+
+		_, _, err := tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "1", ""))
+		assert.Nil(t, err)
+
+		statusCode, _, err := tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress3, "1", ""))
+		assert.True(t, errors.Is(err, process.ErrTransactionDeniedByPolicy))
+		assert.Equal(t, http.StatusForbidden, statusCode)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("functions lists", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		tp := createTxProcessorWithPolicy(t, config.TransactionsPolicyConfig{
+			Enabled:          true,
+			AllowedFunctions: []string{"ESDTTransfer", "claimRewards"},
+			DeniedFunctions:  []string{"claimRewards"},
+		}, &numCalls)
+
+		_, _, err := tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "1", ""))
+		assert.Nil(t, err)
+		_, _, err = tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "0", "ESDTTransfer@544b4e2d313233@0a"))
+		assert.Nil(t, err)
+
+		_, _, err = tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "0", "claimRewards"))
+		assert.True(t, errors.Is(err, process.ErrTransactionDeniedByPolicy))
+		_, _, err = tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "0", "delegate@01"))
+		assert.True(t, errors.Is(err, process.ErrTransactionDeniedByPolicy))
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+	})
+	t.Run("max value", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		tp := createTxProcessorWithPolicy(t, config.TransactionsPolicyConfig{
+			Enabled:  true,
+			MaxValue: "1000000000000000000",
+		}, &numCalls)
+
+		_, _, err := tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "1000000000000000000", ""))
+		assert.Nil(t, err)
+
+		_, _, err = tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "1000000000000000001", ""))
+		assert.True(t, errors.Is(err, process.ErrTransactionDeniedByPolicy))
+		_, _, err = tp.SendTransaction(createPolicyTestTransaction(policyAddress1, policyAddress2, "invalid", ""))
+		assert.True(t, errors.Is(err, process.ErrTransactionDeniedByPolicy))
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+}
+
+func TestTransactionProcessor_SendMultipleTransactionsWithPolicyShouldSkipDeniedTransactions(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	tp := createTxProcessorWithPolicy(t, config.TransactionsPolicyConfig{
+		Enabled:       true,
+		DeniedSenders: []string{policyAddress2},
+	}, &numCalls)
+
+	response, err := tp.SendMultipleTransactions([]*data.Transaction{
+		createPolicyTestTransaction(policyAddress1, policyAddress3, "1", ""),
+		createPolicyTestTransaction(policyAddress2, policyAddress3, "1", ""),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), response.NumOfTxs)
+
+	_, err = tp.SendMultipleTransactions([]*data.Transaction{
+		createPolicyTestTransaction(policyAddress2, policyAddress3, "1", ""),
+	})
+	assert.Equal(t, process.ErrNoValidTransactionToSend, err)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
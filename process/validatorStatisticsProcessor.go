@@ -2,6 +2,8 @@ package process
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -58,6 +60,22 @@ func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.Validat
 	return vsp.getValidatorStatisticsFromApi()
 }
 
+// GetValidatorStatisticsForKey returns the statistics of the validator with the provided BLS key, looked up in the
+// cached statistics, so the clients do not have to fetch the statistics of all the validators to read a single entry
+func (vsp *ValidatorStatisticsProcessor) GetValidatorStatisticsForKey(blsKey string) (*data.ValidatorApiResponse, error) {
+	valStats, err := vsp.GetValidatorStatistics()
+	if err != nil {
+		return nil, err
+	}
+
+	validatorStats, found := valStats.Statistics[strings.ToLower(blsKey)]
+	if !found || validatorStats == nil {
+		return nil, fmt.Errorf("%w: %s", ErrValidatorNotFound, blsKey)
+	}
+
+	return validatorStats, nil
+}
+
 func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.ValidatorStatisticsResponse, error) {
 	observers, errFetchObs := vsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if errFetchObs != nil {
@@ -108,6 +126,11 @@ func (vsp *ValidatorStatisticsProcessor) StartCacheUpdate() {
 }
 
 func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate() {
+	if !vsp.cacher.ShouldRefresh() {
+		// another proxy instance refreshes the shared cache
+		return
+	}
+
 	valStats, err := vsp.getValidatorStatisticsFromApi()
 	if err != nil {
 		log.Warn("validator statistics: get from API", "error", err.Error())
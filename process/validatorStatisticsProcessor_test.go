@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -156,6 +157,46 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 	assert.Equal(t, res.Statistics, valStatsMap)
 }
 
+func TestValidatorStatisticsProcessor_GetValidatorStatisticsForKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("statistics not available should error", func(t *testing.T) {
+		t.Parallel()
+
+		hp, _ := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Millisecond)
+
+		res, err := hp.GetValidatorStatisticsForKey("aa")
+		assert.Nil(t, res)
+		assert.Error(t, err)
+	})
+	t.Run("unknown key should error", func(t *testing.T) {
+		t.Parallel()
+
+		cacher := &mock.ValStatsCacherMock{Data: map[string]*data.ValidatorApiResponse{
+			"aa": {TempRating: 50.7},
+		}}
+		hp, _ := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+
+		res, err := hp.GetValidatorStatisticsForKey("bb")
+		assert.Nil(t, res)
+		assert.True(t, errors.Is(err, process.ErrValidatorNotFound))
+	})
+	t.Run("should return the entry from the cache", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStats := &data.ValidatorApiResponse{TempRating: 50.7}
+		cacher := &mock.ValStatsCacherMock{Data: map[string]*data.ValidatorApiResponse{
+			"aa": expectedStats,
+			"bb": {TempRating: 10},
+		}}
+		hp, _ := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+
+		res, err := hp.GetValidatorStatisticsForKey("AA")
+		assert.Nil(t, err)
+		assert.Equal(t, expectedStats, res)
+	})
+}
+
 func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -190,3 +231,27 @@ func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
 	time.Sleep(5 * time.Millisecond)
 	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
 }
+
+func TestValidatorStatisticsProcessor_CacheShouldNotUpdateIfRefreshedByAnotherInstance(t *testing.T) {
+	t.Parallel()
+
+	numOfTimesHttpWasCalled := int32(0)
+	cacher := &mock.ValStatsCacherMock{SkipRefresh: true}
+	hp, _ := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "obs1", ShardId: core.MetachainShardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
+			return 0, nil
+		},
+	},
+		cacher,
+		25*time.Millisecond)
+
+	hp.StartCacheUpdate()
+	time.Sleep(30 * time.Millisecond)
+	_ = hp.Close()
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+}
@@ -0,0 +1,222 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type warmUpTask struct {
+	name        string
+	handler     func() error
+	isWarm      bool
+	numAttempts int
+	lastError   error
+}
+
+// WarmUpProcessor pre-populates the caches of the proxy at startup, so the first requests after a deploy do not pay
+// the cost of fetching the data from the observers. The proxy reports itself as ready once all the tasks succeeded or
+// once the maximum warm-up duration elapsed
+type WarmUpProcessor struct {
+	isEnabled     bool
+	maxDuration   time.Duration
+	retryInterval time.Duration
+	tasks         []*warmUpTask
+	isStarted     bool
+	isFinished    bool
+	isTimedOut    bool
+	cancelFunc    func()
+	mutState      sync.RWMutex
+}
+
+// NewWarmUpProcessor creates a new instance of WarmUpProcessor
+func NewWarmUpProcessor(warmUpConfig config.WarmUpConfig) (*WarmUpProcessor, error) {
+	if warmUpConfig.Enabled {
+		if warmUpConfig.MaxDurationInSec <= 0 {
+			return nil, fmt.Errorf("%w, MaxDurationInSec: %d", ErrInvalidWarmUpConfig, warmUpConfig.MaxDurationInSec)
+		}
+		if warmUpConfig.RetryIntervalInMs <= 0 {
+			return nil, fmt.Errorf("%w, RetryIntervalInMs: %d", ErrInvalidWarmUpConfig, warmUpConfig.RetryIntervalInMs)
+		}
+	}
+
+	return &WarmUpProcessor{
+		isEnabled:     warmUpConfig.Enabled,
+		maxDuration:   time.Duration(warmUpConfig.MaxDurationInSec) * time.Second,
+		retryInterval: time.Duration(warmUpConfig.RetryIntervalInMs) * time.Millisecond,
+		tasks:         make([]*warmUpTask, 0),
+	}, nil
+}
+
+// AddTask adds a task to be run during the warm-up. The task is retried until it succeeds or the warm-up times out
+func (wup *WarmUpProcessor) AddTask(name string, handler func() error) error {
+	if handler == nil {
+		return ErrNilWarmUpTaskHandler
+	}
+
+	wup.mutState.Lock()
+	defer wup.mutState.Unlock()
+
+	if wup.isStarted {
+		return ErrWarmUpAlreadyStarted
+	}
+
+	wup.tasks = append(wup.tasks, &warmUpTask{
+		name:    name,
+		handler: handler,
+	})
+
+	return nil
+}
+
+// Start runs the warm-up tasks in background. If the warm-up is disabled, the proxy is ready right away
+func (wup *WarmUpProcessor) Start() {
+	wup.mutState.Lock()
+	if wup.isStarted {
+		wup.mutState.Unlock()
+		log.Error("WarmUpProcessor - warm-up already started")
+		return
+	}
+	wup.isStarted = true
+	if !wup.isEnabled {
+		wup.isFinished = true
+		wup.mutState.Unlock()
+		return
+	}
+
+	var ctx context.Context
+	ctx, wup.cancelFunc = context.WithTimeout(context.Background(), wup.maxDuration)
+	wup.mutState.Unlock()
+
+	go wup.runTasks(ctx)
+}
+
+func (wup *WarmUpProcessor) runTasks(ctx context.Context) {
+	log.Info("warm-up started", "num tasks", len(wup.tasks), "max duration", wup.maxDuration)
+	startTime := time.Now()
+
+	timer := time.NewTimer(wup.retryInterval)
+	defer timer.Stop()
+
+	for {
+		numPendingTasks := wup.runPendingTasks()
+		if numPendingTasks == 0 {
+			wup.finish(false)
+			log.Info("warm-up finished", "duration", time.Since(startTime))
+			return
+		}
+
+		timer.Reset(wup.retryInterval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			wup.finish(true)
+			log.Warn("warm-up timed out, the proxy will serve the requests with cold caches", "num pending tasks", numPendingTasks)
+			return
+		}
+	}
+}
+
+// runPendingTasks runs in parallel the tasks which did not succeed yet and returns the number of tasks still pending
+func (wup *WarmUpProcessor) runPendingTasks() int {
+	wup.mutState.RLock()
+	pendingTasks := make([]*warmUpTask, 0, len(wup.tasks))
+	for _, task := range wup.tasks {
+		if !task.isWarm {
+			pendingTasks = append(pendingTasks, task)
+		}
+	}
+	wup.mutState.RUnlock()
+
+	errs := make([]error, len(pendingTasks))
+	wg := sync.WaitGroup{}
+	wg.Add(len(pendingTasks))
+	for idx, task := range pendingTasks {
+		go func(idx int, task *warmUpTask) {
+			defer wg.Done()
+
+			errs[idx] = task.handler()
+		}(idx, task)
+	}
+	wg.Wait()
+
+	numPendingTasks := 0
+	wup.mutState.Lock()
+	for idx, task := range pendingTasks {
+		task.numAttempts++
+		task.lastError = errs[idx]
+		if errs[idx] != nil {
+			numPendingTasks++
+			log.Debug("warm-up task failed", "task", task.name, "attempt", task.numAttempts, "error", errs[idx])
+			continue
+		}
+
+		task.isWarm = true
+		log.Debug("warm-up task done", "task", task.name, "num attempts", task.numAttempts)
+	}
+	wup.mutState.Unlock()
+
+	return numPendingTasks
+}
+
+func (wup *WarmUpProcessor) finish(isTimedOut bool) {
+	wup.mutState.Lock()
+	wup.isFinished = true
+	wup.isTimedOut = isTimedOut
+	wup.mutState.Unlock()
+}
+
+// IsReady returns true if the warm-up is finished, either successfully or by timing out
+func (wup *WarmUpProcessor) IsReady() bool {
+	wup.mutState.RLock()
+	defer wup.mutState.RUnlock()
+
+	return wup.isFinished
+}
+
+// GetWarmUpStatus returns the status of the warm-up and of each of its tasks
+func (wup *WarmUpProcessor) GetWarmUpStatus() *data.WarmUpStatus {
+	wup.mutState.RLock()
+	defer wup.mutState.RUnlock()
+
+	status := &data.WarmUpStatus{
+		IsFinished: wup.isFinished,
+		IsTimedOut: wup.isTimedOut,
+		Tasks:      make([]*data.WarmUpTaskStatus, 0, len(wup.tasks)),
+	}
+	for _, task := range wup.tasks {
+		taskStatus := &data.WarmUpTaskStatus{
+			Name:        task.name,
+			IsWarm:      task.isWarm,
+			NumAttempts: task.numAttempts,
+		}
+		if task.lastError != nil {
+			taskStatus.LastError = task.lastError.Error()
+		}
+		status.Tasks = append(status.Tasks, taskStatus)
+	}
+
+	return status
+}
+
+// Close stops the warm-up, if still running
+func (wup *WarmUpProcessor) Close() error {
+	wup.mutState.RLock()
+	cancelFunc := wup.cancelFunc
+	wup.mutState.RUnlock()
+
+	if cancelFunc != nil {
+		cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wup *WarmUpProcessor) IsInterfaceNil() bool {
+	return wup == nil
+}
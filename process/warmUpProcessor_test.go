@@ -0,0 +1,143 @@
+package process_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createWarmUpConfig() config.WarmUpConfig {
+	return config.WarmUpConfig{
+		Enabled:           true,
+		MaxDurationInSec:  1,
+		RetryIntervalInMs: 10,
+	}
+}
+
+func TestNewWarmUpProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max duration should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createWarmUpConfig()
+		cfg.MaxDurationInSec = 0
+		wup, err := process.NewWarmUpProcessor(cfg)
+		require.Nil(t, wup)
+		require.True(t, errors.Is(err, process.ErrInvalidWarmUpConfig))
+	})
+	t.Run("invalid retry interval should err", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createWarmUpConfig()
+		cfg.RetryIntervalInMs = 0
+		wup, err := process.NewWarmUpProcessor(cfg)
+		require.Nil(t, wup)
+		require.True(t, errors.Is(err, process.ErrInvalidWarmUpConfig))
+	})
+	t.Run("disabled warm-up should not check the config", func(t *testing.T) {
+		t.Parallel()
+
+		wup, err := process.NewWarmUpProcessor(config.WarmUpConfig{})
+		require.Nil(t, err)
+		require.False(t, wup.IsInterfaceNil())
+	})
+}
+
+func TestWarmUpProcessor_AddTask(t *testing.T) {
+	t.Parallel()
+
+	wup, _ := process.NewWarmUpProcessor(config.WarmUpConfig{})
+	require.Equal(t, process.ErrNilWarmUpTaskHandler, wup.AddTask("task", nil))
+	require.Nil(t, wup.AddTask("task", func() error { return nil }))
+
+	wup.Start()
+	require.Equal(t, process.ErrWarmUpAlreadyStarted, wup.AddTask("task", func() error { return nil }))
+}
+
+func TestWarmUpProcessor_Start(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled warm-up should be ready right away", func(t *testing.T) {
+		t.Parallel()
+
+		wup, _ := process.NewWarmUpProcessor(config.WarmUpConfig{})
+		numCalls := uint32(0)
+		_ = wup.AddTask("task", func() error {
+			atomic.AddUint32(&numCalls, 1)
+			return nil
+		})
+		require.False(t, wup.IsReady())
+
+		wup.Start()
+		require.True(t, wup.IsReady())
+		require.Zero(t, atomic.LoadUint32(&numCalls))
+	})
+	t.Run("failed tasks should be retried until they succeed", func(t *testing.T) {
+		t.Parallel()
+
+		wup, _ := process.NewWarmUpProcessor(createWarmUpConfig())
+		numCalls := uint32(0)
+		_ = wup.AddTask("flaky", func() error {
+			if atomic.AddUint32(&numCalls, 1) < 3 {
+				return errors.New("observer not reachable")
+			}
+			return nil
+		})
+		_ = wup.AddTask("stable", func() error {
+			return nil
+		})
+
+		wup.Start()
+		require.Eventually(t, wup.IsReady, time.Second, time.Millisecond)
+
+		status := wup.GetWarmUpStatus()
+		require.True(t, status.IsFinished)
+		require.False(t, status.IsTimedOut)
+		require.Len(t, status.Tasks, 2)
+		require.Equal(t, "flaky", status.Tasks[0].Name)
+		require.True(t, status.Tasks[0].IsWarm)
+		require.Equal(t, 3, status.Tasks[0].NumAttempts)
+		require.Empty(t, status.Tasks[0].LastError)
+		require.True(t, status.Tasks[1].IsWarm)
+		require.Equal(t, 1, status.Tasks[1].NumAttempts)
+	})
+	t.Run("warm-up should time out", func(t *testing.T) {
+		t.Parallel()
+
+		wup, _ := process.NewWarmUpProcessor(createWarmUpConfig())
+		_ = wup.AddTask("failing", func() error {
+			return errors.New("observer not reachable")
+		})
+
+		wup.Start()
+		status := wup.GetWarmUpStatus()
+		require.False(t, status.IsFinished)
+		require.False(t, wup.IsReady())
+
+		require.Eventually(t, wup.IsReady, 3*time.Second, 10*time.Millisecond)
+		status = wup.GetWarmUpStatus()
+		require.True(t, status.IsTimedOut)
+		require.False(t, status.Tasks[0].IsWarm)
+		require.Equal(t, "observer not reachable", status.Tasks[0].LastError)
+	})
+	t.Run("close should stop the warm-up", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createWarmUpConfig()
+		cfg.MaxDurationInSec = 60
+		wup, _ := process.NewWarmUpProcessor(cfg)
+		_ = wup.AddTask("failing", func() error {
+			return errors.New("observer not reachable")
+		})
+
+		wup.Start()
+		require.Nil(t, wup.Close())
+		require.Eventually(t, wup.IsReady, time.Second, time.Millisecond)
+	})
+}
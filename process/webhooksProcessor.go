@@ -0,0 +1,475 @@
+package process
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	minWebhooksPollingIntervalInMs = 100
+	webhookTxHashLength            = 32
+)
+
+type watchKey struct {
+	webhook string
+	txHash  string
+}
+
+type transactionWatch struct {
+	sender string
+}
+
+// WebhooksProcessor watches the registered transactions and POSTs a notification to the configured webhooks once they
+// reach a final status, so the back-office systems do not have to poll the proxy for the outcome of their transactions.
+// The process status is tracked by the transaction status watcher, each watch acting as a sink of its events.
+// If enabled, the processor also checks the balance and the nonce of the addresses on the watchlists of the webhooks on
+// each new hyperblock, notifying the webhooks about the changes (e.g. the deposits)
+type WebhooksProcessor struct {
+	statusWatcher       TransactionStatusWatcherHandler
+	accountsHandler     WatchedAccountsHandler
+	hyperblockHandler   HyperblockNonceHandler
+	httpClient          *http.Client
+	webhooks            map[string]string
+	webhooksBySender    map[string][]string
+	pollingInterval     time.Duration
+	watchTimeout        time.Duration
+	maxWatched          int
+	maxDeliveryAttempts int
+	mutWatches          sync.Mutex
+	watches             map[watchKey]*transactionWatch
+	addressWatchlist    *addressWatchlist
+	addressesInterval   time.Duration
+	isWatchingAddresses bool
+	ctx                 context.Context
+	cancelFunc          func()
+}
+
+// NewWebhooksProcessor will create a new instance of WebhooksProcessor
+func NewWebhooksProcessor(
+	statusWatcher TransactionStatusWatcherHandler,
+	accountsHandler WatchedAccountsHandler,
+	hyperblockHandler HyperblockNonceHandler,
+	cfg config.WebhooksConfig,
+) (*WebhooksProcessor, error) {
+	if statusWatcher == nil {
+		return nil, ErrNilTransactionStatusWatcher
+	}
+	if accountsHandler == nil {
+		return nil, ErrNilWatchedAccountsHandler
+	}
+	if hyperblockHandler == nil {
+		return nil, ErrNilHyperblockNonceHandler
+	}
+	err := checkWebhooksConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make(map[string]string, len(cfg.Webhooks))
+	webhooksBySender := make(map[string][]string)
+	for _, webhook := range cfg.Webhooks {
+		webhooks[webhook.Name] = webhook.URL
+		for _, sender := range webhook.Senders {
+			webhooksBySender[sender] = append(webhooksBySender[sender], webhook.Name)
+		}
+	}
+
+	var watchlist *addressWatchlist
+	if cfg.MaxWatchedAddresses > 0 {
+		watchlist = newAddressWatchlist(cfg.MaxWatchedAddresses)
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return &WebhooksProcessor{
+		statusWatcher:       statusWatcher,
+		accountsHandler:     accountsHandler,
+		hyperblockHandler:   hyperblockHandler,
+		httpClient:          &http.Client{Timeout: time.Duration(cfg.RequestTimeoutInSec) * time.Second},
+		webhooks:            webhooks,
+		webhooksBySender:    webhooksBySender,
+		pollingInterval:     time.Duration(cfg.PollingIntervalInMs) * time.Millisecond,
+		watchTimeout:        time.Duration(cfg.WatchTimeoutInSec) * time.Second,
+		maxWatched:          cfg.MaxWatchedTransactions,
+		maxDeliveryAttempts: cfg.MaxDeliveryAttempts,
+		watches:             make(map[watchKey]*transactionWatch),
+		addressWatchlist:    watchlist,
+		addressesInterval:   time.Duration(cfg.AddressesCheckIntervalInMs) * time.Millisecond,
+		ctx:                 ctx,
+		cancelFunc:          cancelFunc,
+	}, nil
+}
+
+func checkWebhooksConfig(cfg config.WebhooksConfig) error {
+	if cfg.PollingIntervalInMs < minWebhooksPollingIntervalInMs {
+		return fmt.Errorf("%w, PollingIntervalInMs: %d", ErrInvalidWebhooksConfig, cfg.PollingIntervalInMs)
+	}
+	if cfg.WatchTimeoutInSec < 1 {
+		return fmt.Errorf("%w, WatchTimeoutInSec: %d", ErrInvalidWebhooksConfig, cfg.WatchTimeoutInSec)
+	}
+	if cfg.MaxWatchedTransactions < 1 {
+		return fmt.Errorf("%w, MaxWatchedTransactions: %d", ErrInvalidWebhooksConfig, cfg.MaxWatchedTransactions)
+	}
+	if cfg.RequestTimeoutInSec < 1 {
+		return fmt.Errorf("%w, RequestTimeoutInSec: %d", ErrInvalidWebhooksConfig, cfg.RequestTimeoutInSec)
+	}
+	if cfg.MaxDeliveryAttempts < 1 {
+		return fmt.Errorf("%w, MaxDeliveryAttempts: %d", ErrInvalidWebhooksConfig, cfg.MaxDeliveryAttempts)
+	}
+	if cfg.MaxWatchedAddresses < 0 {
+		return fmt.Errorf("%w, MaxWatchedAddresses: %d", ErrInvalidWebhooksConfig, cfg.MaxWatchedAddresses)
+	}
+	if cfg.MaxWatchedAddresses > 0 && cfg.AddressesCheckIntervalInMs < minWebhooksPollingIntervalInMs {
+		return fmt.Errorf("%w, AddressesCheckIntervalInMs: %d", ErrInvalidWebhooksConfig, cfg.AddressesCheckIntervalInMs)
+	}
+	if len(cfg.Webhooks) == 0 {
+		return fmt.Errorf("%w, no webhook provided", ErrInvalidWebhooksConfig)
+	}
+
+	names := make(map[string]struct{}, len(cfg.Webhooks))
+	for _, webhook := range cfg.Webhooks {
+		if len(webhook.Name) == 0 {
+			return fmt.Errorf("%w, empty webhook name", ErrInvalidWebhooksConfig)
+		}
+		_, found := names[webhook.Name]
+		if found {
+			return fmt.Errorf("%w, duplicated webhook name: %s", ErrInvalidWebhooksConfig, webhook.Name)
+		}
+		names[webhook.Name] = struct{}{}
+
+		parsedURL, err := url.Parse(webhook.URL)
+		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || len(parsedURL.Host) == 0 {
+			return fmt.Errorf("%w, invalid URL for webhook %s", ErrInvalidWebhooksConfig, webhook.Name)
+		}
+	}
+
+	return nil
+}
+
+// WatchTransaction registers the transaction with the provided hash for the provided webhook
+func (wp *WebhooksProcessor) WatchTransaction(webhook string, txHash string) error {
+	_, found := wp.webhooks[webhook]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrUnknownWebhook, webhook)
+	}
+
+	txHashBytes, err := hex.DecodeString(txHash)
+	if err != nil || len(txHashBytes) != webhookTxHashLength {
+		return ErrInvalidWatchedTransactionHash
+	}
+
+	return wp.addWatch(watchKey{webhook: webhook, txHash: txHash}, "")
+}
+
+// RegisterSentTransaction registers the transaction for all the webhooks watching its sender, if any. It never fails
+// the sending, the watches that cannot be registered are only logged
+func (wp *WebhooksProcessor) RegisterSentTransaction(sender string, txHash string) {
+	for _, webhook := range wp.webhooksBySender[sender] {
+		err := wp.addWatch(watchKey{webhook: webhook, txHash: txHash}, sender)
+		if err != nil {
+			log.Warn("webhooks: cannot watch the sent transaction",
+				"webhook", webhook,
+				"hash", txHash,
+				"error", err.Error())
+		}
+	}
+}
+
+// addWatch registers the watch with the status watcher. The webhooks lock is not held while calling the status watcher,
+// as the events are emitted while the status watcher holds its own lock
+func (wp *WebhooksProcessor) addWatch(key watchKey, sender string) error {
+	wp.mutWatches.Lock()
+	_, found := wp.watches[key]
+	if found {
+		wp.mutWatches.Unlock()
+		return nil
+	}
+	if len(wp.watches) >= wp.maxWatched {
+		wp.mutWatches.Unlock()
+		return ErrTooManyWatchedTransactions
+	}
+	wp.watches[key] = &transactionWatch{
+		sender: sender,
+	}
+	wp.mutWatches.Unlock()
+
+	sink := &webhookStatusSink{
+		processor: wp,
+		key:       key,
+	}
+	_, err := wp.statusWatcher.Watch(key.txHash, sink, TransactionWatchOptions{
+		Sender:          sender,
+		PollingInterval: wp.pollingInterval,
+		Timeout:         wp.watchTimeout,
+	})
+	if err != nil {
+		wp.removeWatch(key)
+		return err
+	}
+
+	return nil
+}
+
+func (wp *WebhooksProcessor) removeWatch(key watchKey) (*transactionWatch, bool) {
+	wp.mutWatches.Lock()
+	defer wp.mutWatches.Unlock()
+
+	watch, found := wp.watches[key]
+	delete(wp.watches, key)
+
+	return watch, found
+}
+
+// onTransactionStatus notifies the webhook of the watch once the transaction reached a final status. The expired
+// watches are only dropped
+func (wp *WebhooksProcessor) onTransactionStatus(key watchKey, event *data.TransactionStatusEvent) {
+	watch, found := wp.removeWatch(key)
+	if !found {
+		return
+	}
+	if event.TimedOut {
+		log.Debug("webhooks: watch expired", "webhook", key.webhook, "hash", key.txHash)
+		return
+	}
+
+	notification := &data.WebhookNotification{
+		Webhook:   key.webhook,
+		TxHash:    key.txHash,
+		Sender:    watch.sender,
+		Status:    event.Status,
+		Reason:    event.Reason,
+		Timestamp: event.Timestamp,
+	}
+	go wp.deliverNotification(wp.ctx, key.webhook, key.txHash, notification)
+}
+
+// deliverNotification POSTs the notification to the webhook, retrying after each polling interval until the webhook
+// responds with a 2xx status code or the maximum number of attempts is reached. The subject (the transaction hash or
+// the address) is only used for logging
+func (wp *WebhooksProcessor) deliverNotification(ctx context.Context, webhook string, subject string, notification interface{}) {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		log.Warn("webhooks: cannot marshal notification", "subject", subject, "error", err.Error())
+		return
+	}
+
+	webhookURL := wp.webhooks[webhook]
+	for attempt := 1; attempt <= wp.maxDeliveryAttempts; attempt++ {
+		err = wp.postNotification(ctx, webhookURL, payload)
+		if err == nil {
+			log.Debug("webhooks: notification delivered",
+				"webhook", webhook,
+				"subject", subject)
+			return
+		}
+
+		log.Debug("webhooks: notification delivery failed",
+			"webhook", webhook,
+			"subject", subject,
+			"attempt", attempt,
+			"error", err.Error())
+		if attempt == wp.maxDeliveryAttempts {
+			break
+		}
+
+		select {
+		case <-time.After(wp.pollingInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	log.Warn("webhooks: notification dropped",
+		"webhook", webhook,
+		"subject", subject,
+		"error", err.Error())
+}
+
+func (wp *WebhooksProcessor) postNotification(ctx context.Context, webhookURL string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Multiversx Proxy / 1.0.0 <Transactions webhooks>")
+
+	resp, err := wp.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("webhooks: close body", "error", errNotCritical.Error())
+		}
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// WatchAddresses registers the addresses on the watchlist of the provided webhook. The webhook is notified each time
+// the balance or the nonce of one of the addresses changes, the state seen on the first hyperblock checked after the
+// registration being the reference of the first notification
+func (wp *WebhooksProcessor) WatchAddresses(webhook string, addresses []string) error {
+	if wp.addressWatchlist == nil {
+		return ErrAddressWatchlistsNotEnabled
+	}
+	_, found := wp.webhooks[webhook]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrUnknownWebhook, webhook)
+	}
+	if len(addresses) == 0 {
+		return ErrNoWatchedAddressProvided
+	}
+
+	for _, address := range addresses {
+		_, err := wp.accountsHandler.GetShardIDForAddress(address)
+		if err != nil {
+			return fmt.Errorf("%w for address %s", err, address)
+		}
+	}
+
+	return wp.addressWatchlist.add(webhook, addresses)
+}
+
+// UnwatchAddress removes the address from the watchlist of the provided webhook
+func (wp *WebhooksProcessor) UnwatchAddress(webhook string, address string) error {
+	if wp.addressWatchlist == nil {
+		return ErrAddressWatchlistsNotEnabled
+	}
+	_, found := wp.webhooks[webhook]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrUnknownWebhook, webhook)
+	}
+
+	isRemoved := wp.addressWatchlist.remove(webhook, address)
+	if !isRemoved {
+		return fmt.Errorf("%w: %s", ErrAddressNotWatched, address)
+	}
+
+	return nil
+}
+
+// GetWatchedAddresses returns the addresses on the watchlist of the provided webhook
+func (wp *WebhooksProcessor) GetWatchedAddresses(webhook string) ([]string, error) {
+	if wp.addressWatchlist == nil {
+		return nil, ErrAddressWatchlistsNotEnabled
+	}
+	_, found := wp.webhooks[webhook]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownWebhook, webhook)
+	}
+
+	return wp.addressWatchlist.getAddresses(webhook), nil
+}
+
+// StartWatchingAddresses will periodically look for a new hyperblock and check the watched addresses against it, if
+// the address watchlists are enabled
+func (wp *WebhooksProcessor) StartWatchingAddresses() {
+	if wp.addressWatchlist == nil {
+		return
+	}
+	if wp.isWatchingAddresses {
+		log.Error("webhooks: addresses watching already started")
+		return
+	}
+	wp.isWatchingAddresses = true
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(wp.addressesInterval)
+		defer timer.Stop()
+
+		for {
+			timer.Reset(wp.addressesInterval)
+
+			select {
+			case <-timer.C:
+				wp.checkWatchedAddresses()
+			case <-ctx.Done():
+				log.Debug("finishing webhooks addresses watching...")
+				return
+			}
+		}
+	}(wp.ctx)
+}
+
+// checkWatchedAddresses fetches the watched accounts, as of the final blocks, once a new hyperblock is fully
+// synchronized, and notifies the webhooks watching the changed ones
+func (wp *WebhooksProcessor) checkWatchedAddresses() {
+	hyperblockNonce, err := wp.hyperblockHandler.GetLatestFullySynchronizedHyperblockNonce()
+	if err != nil {
+		log.Debug("webhooks: cannot get the latest hyperblock nonce", "error", err.Error())
+		return
+	}
+
+	addresses, shouldCheck := wp.addressWatchlist.getAddressesToCheck(hyperblockNonce)
+	if !shouldCheck {
+		return
+	}
+
+	accounts := make(map[string]*data.Account)
+	if len(addresses) > 0 {
+		response, errGet := wp.accountsHandler.GetAccounts(addresses, common.AccountQueryOptions{OnFinalBlock: true})
+		if errGet != nil {
+			log.Warn("webhooks: cannot fetch the watched accounts",
+				"hyperblock nonce", hyperblockNonce,
+				"num addresses", len(addresses),
+				"error", errGet.Error())
+			return
+		}
+
+		accounts = response.Accounts
+	}
+
+	notifications := wp.addressWatchlist.update(hyperblockNonce, accounts, time.Now().Unix())
+	for _, notification := range notifications {
+		go wp.deliverNotification(wp.ctx, notification.Webhook, notification.Address, notification)
+	}
+}
+
+func isFinalTransactionStatus(status string) bool {
+	return status == string(transaction.TxStatusSuccess) || status == string(transaction.TxStatusFail)
+}
+
+// IsEnabled returns true
+func (wp *WebhooksProcessor) IsEnabled() bool {
+	return true
+}
+
+// Close will stop the pending deliveries
+func (wp *WebhooksProcessor) Close() error {
+	wp.cancelFunc()
+
+	return nil
+}
+
+// webhookStatusSink forwards the events of a watched transaction to the webhooks processor, for the webhook of the watch
+type webhookStatusSink struct {
+	processor *WebhooksProcessor
+	key       watchKey
+}
+
+// OnTransactionStatus forwards the event to the webhooks processor
+func (wss *webhookStatusSink) OnTransactionStatus(event *data.TransactionStatusEvent) {
+	wss.processor.onTransactionStatus(wss.key, event)
+}
@@ -0,0 +1,408 @@
+package process_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	watchedTxHash      = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	otherWatchedTxHash = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func createWebhooksConfig(url string) config.WebhooksConfig {
+	return config.WebhooksConfig{
+		Enabled:                true,
+		PollingIntervalInMs:    100,
+		WatchTimeoutInSec:      60,
+		MaxWatchedTransactions: 10,
+		RequestTimeoutInSec:    1,
+		MaxDeliveryAttempts:    1,
+		Webhooks: []config.WebhookConfig{
+			{Name: "back-office", URL: url, Senders: []string{"erd1sender"}},
+		},
+	}
+}
+
+func createStatusHandlerWithStatus(status transaction.TxStatus) *mock.TransactionProcessStatusHandlerStub {
+	return &mock.TransactionProcessStatusHandlerStub{
+		GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+			return &data.ProcessStatusResponse{Status: string(status)}, nil
+		},
+	}
+}
+
+func startWebhookServer(t *testing.T, statusCodes ...int) (*httptest.Server, chan *data.WebhookNotification) {
+	chanNotifications := make(chan *data.WebhookNotification, 10)
+	numCalls := uint32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callIndex := int(atomic.AddUint32(&numCalls, 1)) - 1
+		if callIndex < len(statusCodes) && statusCodes[callIndex] != http.StatusOK {
+			w.WriteHeader(statusCodes[callIndex])
+			return
+		}
+
+		notification := &data.WebhookNotification{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(notification))
+		chanNotifications <- notification
+	}))
+	t.Cleanup(server.Close)
+
+	return server, chanNotifications
+}
+
+func waitForNotification(t *testing.T, chanNotifications chan *data.WebhookNotification) *data.WebhookNotification {
+	select {
+	case notification := <-chanNotifications:
+		return notification
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for the webhook notification")
+		return nil
+	}
+}
+
+func TestNewWebhooksProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status watcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		wp, err := process.NewWebhooksProcessor(nil, &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig("http://localhost"))
+		require.Nil(t, wp)
+		require.Equal(t, process.ErrNilTransactionStatusWatcher, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidConfig := func(modifier func(cfg *config.WebhooksConfig), expectedMessage string) {
+			cfg := createWebhooksConfig("http://localhost")
+			modifier(&cfg)
+
+			wp, err := process.NewWebhooksProcessor(createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, cfg)
+			require.Nil(t, wp)
+			require.True(t, errors.Is(err, process.ErrInvalidWebhooksConfig))
+			require.True(t, strings.Contains(err.Error(), expectedMessage))
+		}
+
+		testInvalidConfig(func(cfg *config.WebhooksConfig) { cfg.PollingIntervalInMs = 10 }, "PollingIntervalInMs")
+		testInvalidConfig(func(cfg *config.WebhooksConfig) { cfg.WatchTimeoutInSec = 0 }, "WatchTimeoutInSec")
+		testInvalidConfig(func(cfg *config.WebhooksConfig) { cfg.MaxWatchedTransactions = 0 }, "MaxWatchedTransactions")
+		testInvalidConfig(func(cfg *config.WebhooksConfig) { cfg.RequestTimeoutInSec = 0 }, "RequestTimeoutInSec")
+		testInvalidConfig(func(cfg *config.WebhooksConfig) { cfg.MaxDeliveryAttempts = 0 }, "MaxDeliveryAttempts")
+		testInvalidConfig(func(cfg *config.WebhooksConfig) { cfg.Webhooks = nil }, "no webhook provided")
+		testInvalidConfig(func(cfg *config.WebhooksConfig) { cfg.Webhooks[0].Name = "" }, "empty webhook name")
+		testInvalidConfig(func(cfg *config.WebhooksConfig) { cfg.Webhooks[0].URL = "ftp://host" }, "invalid URL")
+		testInvalidConfig(func(cfg *config.WebhooksConfig) {
+			cfg.Webhooks = append(cfg.Webhooks, cfg.Webhooks[0])
+		}, "duplicated webhook name")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wp, err := process.NewWebhooksProcessor(createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig("http://localhost"))
+		require.NoError(t, err)
+		require.True(t, wp.IsEnabled())
+		require.NoError(t, wp.Close())
+	})
+}
+
+func TestWebhooksProcessor_WatchTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown webhook should error", func(t *testing.T) {
+		t.Parallel()
+
+		wp, _ := process.NewWebhooksProcessor(createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig("http://localhost"))
+		err := wp.WatchTransaction("other", watchedTxHash)
+		require.True(t, errors.Is(err, process.ErrUnknownWebhook))
+	})
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		wp, _ := process.NewWebhooksProcessor(createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig("http://localhost"))
+		err := wp.WatchTransaction("back-office", "not a hash")
+		require.Equal(t, process.ErrInvalidWatchedTransactionHash, err)
+
+		err = wp.WatchTransaction("back-office", "aabb")
+		require.Equal(t, process.ErrInvalidWatchedTransactionHash, err)
+	})
+	t.Run("too many watched transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createWebhooksConfig("http://localhost")
+		cfg.MaxWatchedTransactions = 1
+		wp, _ := process.NewWebhooksProcessor(createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, cfg)
+
+		require.NoError(t, wp.WatchTransaction("back-office", watchedTxHash))
+		require.NoError(t, wp.WatchTransaction("back-office", watchedTxHash))
+		require.Equal(t, process.ErrTooManyWatchedTransactions, wp.WatchTransaction("back-office", otherWatchedTxHash))
+		require.Equal(t, 1, wp.NumWatchedTransactions())
+	})
+}
+
+func TestWebhooksProcessor_RegisterSentTransaction(t *testing.T) {
+	t.Parallel()
+
+	wp, _ := process.NewWebhooksProcessor(createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{}), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig("http://localhost"))
+
+	wp.RegisterSentTransaction("erd1other", watchedTxHash)
+	require.Equal(t, 0, wp.NumWatchedTransactions())
+
+	wp.RegisterSentTransaction("erd1sender", watchedTxHash)
+	require.Equal(t, 1, wp.NumWatchedTransactions())
+}
+
+func TestWebhooksProcessor_OnTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pending transaction should remain watched", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createTransactionStatusWatcher(createStatusHandlerWithStatus(transaction.TxStatusPending), &mock.TransactionsFeedHandlerStub{})
+		wp, _ := process.NewWebhooksProcessor(watcher, &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig("http://localhost"))
+		_ = wp.WatchTransaction("back-office", watchedTxHash)
+
+		watcher.CheckWatchedTransactions()
+		require.Equal(t, 1, wp.NumWatchedTransactions())
+		require.Equal(t, 1, watcher.NumWatchedTransactions())
+	})
+	t.Run("expired watch should be dropped", func(t *testing.T) {
+		t.Parallel()
+
+		watcher := createTransactionStatusWatcher(createStatusHandlerWithStatus(transaction.TxStatusPending), &mock.TransactionsFeedHandlerStub{})
+		wp, _ := process.NewWebhooksProcessor(watcher, &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig("http://localhost"))
+		startTime := time.Now()
+		watcher.SetGetTimeHandler(func() time.Time {
+			return startTime
+		})
+		_ = wp.WatchTransaction("back-office", watchedTxHash)
+
+		watcher.SetGetTimeHandler(func() time.Time {
+			return startTime.Add(time.Minute)
+		})
+		watcher.CheckWatchedTransactions()
+		require.Equal(t, 0, wp.NumWatchedTransactions())
+		require.Equal(t, 0, watcher.NumWatchedTransactions())
+	})
+	t.Run("final status should notify the webhook", func(t *testing.T) {
+		t.Parallel()
+
+		server, chanNotifications := startWebhookServer(t)
+		watcher := createTransactionStatusWatcher(createStatusHandlerWithStatus(transaction.TxStatusSuccess), &mock.TransactionsFeedHandlerStub{})
+		wp, _ := process.NewWebhooksProcessor(watcher, &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig(server.URL))
+		wp.RegisterSentTransaction("erd1sender", watchedTxHash)
+
+		watcher.CheckWatchedTransactions()
+		require.Equal(t, 0, wp.NumWatchedTransactions())
+
+		notification := waitForNotification(t, chanNotifications)
+		require.Equal(t, "back-office", notification.Webhook)
+		require.Equal(t, watchedTxHash, notification.TxHash)
+		require.Equal(t, "erd1sender", notification.Sender)
+		require.Equal(t, string(transaction.TxStatusSuccess), notification.Status)
+	})
+	t.Run("failed delivery should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		server, chanNotifications := startWebhookServer(t, http.StatusInternalServerError, http.StatusOK)
+		cfg := createWebhooksConfig(server.URL)
+		cfg.MaxDeliveryAttempts = 2
+		watcher := createTransactionStatusWatcher(createStatusHandlerWithStatus(transaction.TxStatusFail), &mock.TransactionsFeedHandlerStub{})
+		wp, _ := process.NewWebhooksProcessor(watcher, &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, cfg)
+		_ = wp.WatchTransaction("back-office", watchedTxHash)
+
+		watcher.CheckWatchedTransactions()
+
+		notification := waitForNotification(t, chanNotifications)
+		require.Equal(t, watchedTxHash, notification.TxHash)
+		require.Empty(t, notification.Sender)
+		require.Equal(t, string(transaction.TxStatusFail), notification.Status)
+	})
+}
+
+func TestWebhooksProcessor_WithStartedStatusWatcher(t *testing.T) {
+	t.Parallel()
+
+	server, chanNotifications := startWebhookServer(t)
+	numStatusCalls := uint32(0)
+	statusHandler := &mock.TransactionProcessStatusHandlerStub{
+		GetProcessedTransactionStatusCalled: func(txHash string) (*data.ProcessStatusResponse, error) {
+			if atomic.AddUint32(&numStatusCalls, 1) == 1 {
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)}, nil
+			}
+
+			return &data.ProcessStatusResponse{Status: string(transaction.TxStatusSuccess)}, nil
+		},
+	}
+	watcher := createTransactionStatusWatcher(statusHandler, &mock.TransactionsFeedHandlerStub{})
+	wp, _ := process.NewWebhooksProcessor(watcher, &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig(server.URL))
+	_ = wp.WatchTransaction("back-office", watchedTxHash)
+
+	watcher.StartWatching()
+	defer func() {
+		_ = watcher.Close()
+		_ = wp.Close()
+	}()
+
+	notification := waitForNotification(t, chanNotifications)
+	require.Equal(t, watchedTxHash, notification.TxHash)
+	require.GreaterOrEqual(t, atomic.LoadUint32(&numStatusCalls), uint32(2))
+}
+
+func TestWebhooksProcessor_AddressWatchlists(t *testing.T) {
+	t.Parallel()
+
+	createConfig := func(url string) config.WebhooksConfig {
+		cfg := createWebhooksConfig(url)
+		cfg.MaxWatchedAddresses = 2
+		cfg.AddressesCheckIntervalInMs = 100
+		return cfg
+	}
+	createWatcher := func() *process.TransactionStatusWatcher {
+		return createTransactionStatusWatcher(&mock.TransactionProcessStatusHandlerStub{}, &mock.TransactionsFeedHandlerStub{})
+	}
+
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig("http://localhost")
+		cfg.AddressesCheckIntervalInMs = 10
+		wp, err := process.NewWebhooksProcessor(createWatcher(), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, cfg)
+		require.Nil(t, wp)
+		require.True(t, errors.Is(err, process.ErrInvalidWebhooksConfig))
+		require.True(t, strings.Contains(err.Error(), "AddressesCheckIntervalInMs"))
+
+		cfg.MaxWatchedAddresses = -1
+		wp, err = process.NewWebhooksProcessor(createWatcher(), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, cfg)
+		require.Nil(t, wp)
+		require.True(t, strings.Contains(err.Error(), "MaxWatchedAddresses"))
+	})
+	t.Run("nil handlers should error", func(t *testing.T) {
+		t.Parallel()
+
+		wp, err := process.NewWebhooksProcessor(createWatcher(), nil, &mock.HyperblockNonceHandlerStub{}, createConfig("http://localhost"))
+		require.Nil(t, wp)
+		require.Equal(t, process.ErrNilWatchedAccountsHandler, err)
+
+		wp, err = process.NewWebhooksProcessor(createWatcher(), &mock.WatchedAccountsHandlerStub{}, nil, createConfig("http://localhost"))
+		require.Nil(t, wp)
+		require.Equal(t, process.ErrNilHyperblockNonceHandler, err)
+	})
+	t.Run("disabled watchlists should error", func(t *testing.T) {
+		t.Parallel()
+
+		wp, _ := process.NewWebhooksProcessor(createWatcher(), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createWebhooksConfig("http://localhost"))
+		require.Equal(t, process.ErrAddressWatchlistsNotEnabled, wp.WatchAddresses("back-office", []string{"erd1a"}))
+		require.Equal(t, process.ErrAddressWatchlistsNotEnabled, wp.UnwatchAddress("back-office", "erd1a"))
+		_, err := wp.GetWatchedAddresses("back-office")
+		require.Equal(t, process.ErrAddressWatchlistsNotEnabled, err)
+	})
+	t.Run("invalid requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid address")
+		accountsHandler := &mock.WatchedAccountsHandlerStub{
+			GetShardIDForAddressCalled: func(address string) (uint32, error) {
+				if address == "invalid" {
+					return 0, expectedErr
+				}
+				return 0, nil
+			},
+		}
+		wp, _ := process.NewWebhooksProcessor(createWatcher(), accountsHandler, &mock.HyperblockNonceHandlerStub{}, createConfig("http://localhost"))
+		require.True(t, errors.Is(wp.WatchAddresses("other", []string{"erd1a"}), process.ErrUnknownWebhook))
+		require.Equal(t, process.ErrNoWatchedAddressProvided, wp.WatchAddresses("back-office", nil))
+		require.True(t, errors.Is(wp.WatchAddresses("back-office", []string{"erd1a", "invalid"}), expectedErr))
+		require.Equal(t, process.ErrTooManyWatchedAddresses, wp.WatchAddresses("back-office", []string{"erd1a", "erd1b", "erd1c"}))
+		require.True(t, errors.Is(wp.UnwatchAddress("back-office", "erd1a"), process.ErrAddressNotWatched))
+		_, err := wp.GetWatchedAddresses("other")
+		require.True(t, errors.Is(err, process.ErrUnknownWebhook))
+	})
+	t.Run("should watch and unwatch", func(t *testing.T) {
+		t.Parallel()
+
+		wp, _ := process.NewWebhooksProcessor(createWatcher(), &mock.WatchedAccountsHandlerStub{}, &mock.HyperblockNonceHandlerStub{}, createConfig("http://localhost"))
+		require.NoError(t, wp.WatchAddresses("back-office", []string{"erd1b", "erd1a"}))
+		addresses, err := wp.GetWatchedAddresses("back-office")
+		require.NoError(t, err)
+		require.Equal(t, []string{"erd1a", "erd1b"}, addresses)
+
+		require.NoError(t, wp.UnwatchAddress("back-office", "erd1a"))
+		addresses, _ = wp.GetWatchedAddresses("back-office")
+		require.Equal(t, []string{"erd1b"}, addresses)
+	})
+	t.Run("changed accounts should be notified once per hyperblock", func(t *testing.T) {
+		t.Parallel()
+
+		chanNotifications := make(chan *data.AddressChangeNotification, 10)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			notification := &data.AddressChangeNotification{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(notification))
+			chanNotifications <- notification
+		}))
+		defer server.Close()
+
+		hyperblockNonce := uint64(10)
+		balance := "100"
+		numGetAccountsCalls := 0
+		accountsHandler := &mock.WatchedAccountsHandlerStub{
+			GetAccountsCalled: func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+				numGetAccountsCalls++
+				require.Equal(t, []string{"erd1a"}, addresses)
+				require.True(t, options.OnFinalBlock)
+				return &data.AccountsModel{Accounts: map[string]*data.Account{
+					"erd1a": {Address: "erd1a", Balance: balance, Nonce: 3},
+				}}, nil
+			},
+		}
+		hyperblockHandler := &mock.HyperblockNonceHandlerStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return hyperblockNonce, nil
+			},
+		}
+		wp, _ := process.NewWebhooksProcessor(createWatcher(), accountsHandler, hyperblockHandler, createConfig(server.URL))
+		defer func() {
+			_ = wp.Close()
+		}()
+		_ = wp.WatchAddresses("back-office", []string{"erd1a"})
+
+		wp.CheckWatchedAddresses()
+		balance = "250"
+		wp.CheckWatchedAddresses()
+		require.Equal(t, 1, numGetAccountsCalls)
+
+		hyperblockNonce = 11
+		wp.CheckWatchedAddresses()
+		require.Equal(t, 2, numGetAccountsCalls)
+
+		select {
+		case notification := <-chanNotifications:
+			require.Equal(t, &data.AddressChangeNotification{
+				Webhook:         "back-office",
+				Address:         "erd1a",
+				HyperblockNonce: 11,
+				PreviousBalance: "100",
+				Balance:         "250",
+				PreviousNonce:   3,
+				Nonce:           3,
+				Timestamp:       notification.Timestamp,
+			}, notification)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timeout waiting for the webhook notification")
+		}
+	})
+}
@@ -2,6 +2,8 @@ package factory
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -47,4 +49,23 @@ func TestApiConfigParser_GetConfigForVersion(t *testing.T) {
 	endpointConfig, ok := res.APIPackages["testendpoint"]
 	require.True(t, ok)
 	require.Equal(t, 3, len(endpointConfig.Routes))
+	require.Equal(t, []string{"10.0.0.0/8", "127.0.0.1"}, endpointConfig.AllowedCIDRs)
+	require.Equal(t, []string{"10.0.0.0/24"}, endpointConfig.DeniedCIDRs)
+}
+
+func TestApiConfigParser_GetConfigForVersionShippedConfigs(t *testing.T) {
+	shippedConfigsDir := "../../cmd/proxy/config/apiConfig"
+	acp, err := NewApiConfigParser(shippedConfigsDir)
+	require.NoError(t, err)
+
+	versionsFiles, err := filepath.Glob(filepath.Join(shippedConfigsDir, "v*.toml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, versionsFiles)
+
+	for _, versionFile := range versionsFiles {
+		version := strings.TrimSuffix(filepath.Base(versionFile), ".toml")
+		res, err := acp.GetConfigForVersion(version)
+		require.NoError(t, err, version)
+		require.NotEmpty(t, res.APIPackages, version)
+	}
 }
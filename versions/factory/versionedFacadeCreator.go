@@ -3,6 +3,7 @@ package factory
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/api"
+	apiv2_0 "github.com/multiversx/mx-chain-proxy-go/api/groups/v2_0"
 	apiv_next "github.com/multiversx/mx-chain-proxy-go/api/groups/v_next"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/facade"
@@ -29,6 +30,28 @@ type FacadeArgs struct {
 	ESDTSuppliesProcessor        facade.ESDTSupplyProcessor
 	StatusProcessor              facade.StatusProcessor
 	AboutInfoProcessor           facade.AboutInfoProcessor
+	ProxyPublicKeyProcessor      facade.ProxyPublicKeyProcessor
+	StakingPortfolioProcessor    facade.StakingPortfolioProcessor
+	DrainProcessor               facade.DrainProcessor
+	TransactionsHistoryProcessor facade.TransactionsHistoryProcessor
+	NonceManagerProcessor        facade.NonceManagerProcessor
+	FaultInjectionProcessor      facade.FaultInjectionProcessor
+	RawPassThroughProcessor      facade.RawPassThroughProcessor
+	WebhooksProcessor            facade.WebhooksProcessor
+	ObserversFeedProcessor       facade.ObserversFeedProcessor
+	ConfigReloadProcessor        facade.ConfigReloadProcessor
+	DelegationProcessor          facade.DelegationProcessor
+	TransactionWaitProcessor     facade.TransactionWaitProcessor
+	ESDTIssuanceProcessor        facade.ESDTIssuanceProcessor
+	TransactionStatusWatcher     facade.TransactionStatusWatcher
+	BridgeProcessor              facade.BridgeProcessor
+	ConsensusProcessor           facade.ConsensusProcessor
+	CollectionsProcessor         facade.CollectionsProcessor
+	AddressConverterProcessor    facade.AddressConverterProcessor
+	ClientStatsProcessor         facade.ClientStatsProcessor
+
+	// Processor is the core processor, provided to the custom route groups registered by external modules
+	Processor process.Processor
 }
 
 // CreateVersionsRegistry creates the version registry instances and populates it with the versions and their handlers
@@ -45,6 +68,21 @@ func CreateVersionsRegistry(facadeArgs FacadeArgs, apiConfigParser ApiConfigPars
 		return nil, err
 	}
 
+	err = addVersionV2_0(facadeArgs, versionsRegistry, apiConfigParser)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addVersionAlias(versionsRegistry, "v1", "v1.0")
+	if err != nil {
+		return nil, err
+	}
+
+	err = addVersionAlias(versionsRegistry, "v2", "v2.0")
+	if err != nil {
+		return nil, err
+	}
+
 	// un-comment these lines if you want to start proxy also with the v_next
 
 	// err = addVersionV_next(facadeArgs, versionsRegistry)
@@ -69,6 +107,20 @@ func addVersionV1_0AsDefault(versionRegistry data.VersionsRegistryHandler, apiCo
 	return versionRegistry.AddVersion("", v1_0handler)
 }
 
+func addVersionAlias(versionRegistry data.VersionsRegistryHandler, alias string, version string) error {
+	versionsMap, err := versionRegistry.GetAllVersions()
+	if err != nil {
+		return err
+	}
+
+	versionHandler, ok := versionsMap[version]
+	if !ok {
+		return versions.ErrVersionNotFound
+	}
+
+	return versionRegistry.AddVersion(alias, versionHandler)
+}
+
 func addVersionV1_0(facadeArgs FacadeArgs, versionRegistry data.VersionsRegistryHandler, apiConfigParser ApiConfigParser) error {
 	v1_0Facade, err := createVersionV1_0Facade(facadeArgs)
 	if err != nil {
@@ -80,6 +132,11 @@ func addVersionV1_0(facadeArgs FacadeArgs, versionRegistry data.VersionsRegistry
 		return err
 	}
 
+	err = api.AddCustomGroups(apiHandler, api.CustomGroupArgs{Facade: v1_0Facade, Processor: facadeArgs.Processor})
+	if err != nil {
+		return err
+	}
+
 	apiConfig, err := apiConfigParser.GetConfigForVersion("v1_0")
 	if err != nil {
 		return err
@@ -111,6 +168,25 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ProxyFacade
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
 		AboutInfoProcessor:           facadeArgs.AboutInfoProcessor,
+		ProxyPublicKeyProcessor:      facadeArgs.ProxyPublicKeyProcessor,
+		StakingPortfolioProcessor:    facadeArgs.StakingPortfolioProcessor,
+		DrainProcessor:               facadeArgs.DrainProcessor,
+		TransactionsHistoryProcessor: facadeArgs.TransactionsHistoryProcessor,
+		NonceManagerProcessor:        facadeArgs.NonceManagerProcessor,
+		FaultInjectionProcessor:      facadeArgs.FaultInjectionProcessor,
+		RawPassThroughProcessor:      facadeArgs.RawPassThroughProcessor,
+		WebhooksProcessor:            facadeArgs.WebhooksProcessor,
+		ObserversFeedProcessor:       facadeArgs.ObserversFeedProcessor,
+		ConfigReloadProcessor:        facadeArgs.ConfigReloadProcessor,
+		DelegationProcessor:          facadeArgs.DelegationProcessor,
+		TransactionWaitProcessor:     facadeArgs.TransactionWaitProcessor,
+		ESDTIssuanceProcessor:        facadeArgs.ESDTIssuanceProcessor,
+		TransactionStatusWatcher:     facadeArgs.TransactionStatusWatcher,
+		BridgeProcessor:              facadeArgs.BridgeProcessor,
+		ConsensusProcessor:           facadeArgs.ConsensusProcessor,
+		CollectionsProcessor:         facadeArgs.CollectionsProcessor,
+		AddressConverterProcessor:    facadeArgs.AddressConverterProcessor,
+		ClientStatsProcessor:         facadeArgs.ClientStatsProcessor,
 	}
 
 	commonFacade, err := createVersionedFacade(v1_0HandlerArgs)
@@ -121,6 +197,52 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ProxyFacade
 	return &facadeVersions.ProxyFacadeV1_0{ProxyFacade: commonFacade.(*facade.ProxyFacade)}, nil
 }
 
+func addVersionV2_0(facadeArgs FacadeArgs, versionRegistry data.VersionsRegistryHandler, apiConfigParser ApiConfigParser) error {
+	commonFacade, err := createVersionedFacade(facadeArgs)
+	if err != nil {
+		return err
+	}
+
+	v2_0Facade := &facadeVersions.ProxyFacadeV2_0{ProxyFacade: commonFacade.(*facade.ProxyFacade)}
+	apiHandler, err := api.NewApiHandler(v2_0Facade)
+	if err != nil {
+		return err
+	}
+
+	transactionGroup, err := apiHandler.GetGroup("/transaction")
+	if err != nil {
+		return err
+	}
+
+	transactionGroupV2_0, err := apiv2_0.NewTransactionGroupV2_0(transactionGroup, v2_0Facade)
+	if err != nil {
+		return err
+	}
+
+	err = apiHandler.UpdateGroup("/transaction", transactionGroupV2_0.Group())
+	if err != nil {
+		return err
+	}
+
+	err = api.AddCustomGroups(apiHandler, api.CustomGroupArgs{Facade: v2_0Facade, Processor: facadeArgs.Processor})
+	if err != nil {
+		return err
+	}
+
+	apiConfig, err := apiConfigParser.GetConfigForVersion("v2_0")
+	if err != nil {
+		return err
+	}
+
+	return versionRegistry.AddVersion("v2.0",
+		&data.VersionData{
+			Facade:     v2_0Facade,
+			ApiHandler: apiHandler,
+			ApiConfig:  *apiConfig,
+		},
+	)
+}
+
 func addVersionV_next(facadeArgs FacadeArgs, versionsRegistry data.VersionsRegistryHandler) error {
 	v_nextHandler, err := createVersionV_nextFacade(facadeArgs)
 	if err != nil {
@@ -147,6 +269,11 @@ func addVersionV_next(facadeArgs FacadeArgs, versionsRegistry data.VersionsRegis
 		return err
 	}
 
+	err = api.AddCustomGroups(apiHandler, api.CustomGroupArgs{Facade: v_nextHandler, Processor: facadeArgs.Processor})
+	if err != nil {
+		return err
+	}
+
 	return versionsRegistry.AddVersion("v_next",
 		&data.VersionData{
 			Facade:     v_nextHandler,
@@ -169,6 +296,25 @@ func createVersionV_nextFacade(facadeArgs FacadeArgs) (data.FacadeHandler, error
 		PubKeyConverter:              facadeArgs.PubKeyConverter,
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
+		ProxyPublicKeyProcessor:      facadeArgs.ProxyPublicKeyProcessor,
+		StakingPortfolioProcessor:    facadeArgs.StakingPortfolioProcessor,
+		DrainProcessor:               facadeArgs.DrainProcessor,
+		TransactionsHistoryProcessor: facadeArgs.TransactionsHistoryProcessor,
+		NonceManagerProcessor:        facadeArgs.NonceManagerProcessor,
+		FaultInjectionProcessor:      facadeArgs.FaultInjectionProcessor,
+		RawPassThroughProcessor:      facadeArgs.RawPassThroughProcessor,
+		WebhooksProcessor:            facadeArgs.WebhooksProcessor,
+		ObserversFeedProcessor:       facadeArgs.ObserversFeedProcessor,
+		ConfigReloadProcessor:        facadeArgs.ConfigReloadProcessor,
+		DelegationProcessor:          facadeArgs.DelegationProcessor,
+		TransactionWaitProcessor:     facadeArgs.TransactionWaitProcessor,
+		ESDTIssuanceProcessor:        facadeArgs.ESDTIssuanceProcessor,
+		TransactionStatusWatcher:     facadeArgs.TransactionStatusWatcher,
+		BridgeProcessor:              facadeArgs.BridgeProcessor,
+		ConsensusProcessor:           facadeArgs.ConsensusProcessor,
+		CollectionsProcessor:         facadeArgs.CollectionsProcessor,
+		AddressConverterProcessor:    facadeArgs.AddressConverterProcessor,
+		ClientStatsProcessor:         facadeArgs.ClientStatsProcessor,
 	}
 
 	commonFacade, err := createVersionedFacade(v_nextHandlerArgs)
@@ -192,21 +338,40 @@ func createVersionedFacade(args FacadeArgs) (data.FacadeHandler, error) {
 	// always returns a good instance of the object (or an error otherwise)
 	// Also, there are nil checks on the facade's constructors
 
-	return facade.NewProxyFacade(
-		args.ActionsProcessor,
-		args.AccountProcessor,
-		args.TransactionProcessor,
-		args.ScQueryProcessor,
-		args.NodeGroupProcessor,
-		args.ValidatorStatisticsProcessor,
-		args.FaucetProcessor,
-		args.NodeStatusProcessor,
-		args.BlockProcessor,
-		args.BlocksProcessor,
-		args.ProofProcessor,
-		args.PubKeyConverter,
-		args.ESDTSuppliesProcessor,
-		args.StatusProcessor,
-		args.AboutInfoProcessor,
-	)
+	return facade.NewProxyFacade(facade.ArgsProxyFacade{
+		ActionsProcessor:             args.ActionsProcessor,
+		AccountProcessor:             args.AccountProcessor,
+		TransactionProcessor:         args.TransactionProcessor,
+		SCQueryService:               args.ScQueryProcessor,
+		NodeGroupProcessor:           args.NodeGroupProcessor,
+		ValidatorStatisticsProcessor: args.ValidatorStatisticsProcessor,
+		FaucetProcessor:              args.FaucetProcessor,
+		NodeStatusProcessor:          args.NodeStatusProcessor,
+		BlockProcessor:               args.BlockProcessor,
+		BlocksProcessor:              args.BlocksProcessor,
+		ProofProcessor:               args.ProofProcessor,
+		PubKeyConverter:              args.PubKeyConverter,
+		ESDTSuppliesProcessor:        args.ESDTSuppliesProcessor,
+		StatusProcessor:              args.StatusProcessor,
+		AboutInfoProcessor:           args.AboutInfoProcessor,
+		ProxyPublicKeyProcessor:      args.ProxyPublicKeyProcessor,
+		StakingPortfolioProcessor:    args.StakingPortfolioProcessor,
+		DrainProcessor:               args.DrainProcessor,
+		TransactionsHistoryProcessor: args.TransactionsHistoryProcessor,
+		NonceManagerProcessor:        args.NonceManagerProcessor,
+		FaultInjectionProcessor:      args.FaultInjectionProcessor,
+		RawPassThroughProcessor:      args.RawPassThroughProcessor,
+		WebhooksProcessor:            args.WebhooksProcessor,
+		ObserversFeedProcessor:       args.ObserversFeedProcessor,
+		ConfigReloadProcessor:        args.ConfigReloadProcessor,
+		DelegationProcessor:          args.DelegationProcessor,
+		TransactionWaitProcessor:     args.TransactionWaitProcessor,
+		ESDTIssuanceProcessor:        args.ESDTIssuanceProcessor,
+		TransactionStatusWatcher:     args.TransactionStatusWatcher,
+		BridgeProcessor:              args.BridgeProcessor,
+		ConsensusProcessor:           args.ConsensusProcessor,
+		CollectionsProcessor:         args.CollectionsProcessor,
+		AddressConverterProcessor:    args.AddressConverterProcessor,
+		ClientStatsProcessor:         args.ClientStatsProcessor,
+	})
 }